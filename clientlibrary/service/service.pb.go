@@ -25,377 +25,389 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("pb/protos/service/service.proto", fileDescriptor_93a29dc403579097) }
 
 var fileDescriptor_93a29dc403579097 = []byte{
-	// 5912 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x9d, 0xdd, 0x6f, 0x24, 0x49,
-	0x52, 0xc0, 0xcf, 0x3c, 0xb0, 0x50, 0xc7, 0x2d, 0xd0, 0x0b, 0xcb, 0xdd, 0x72, 0x37, 0xdf, 0x63,
-	0xcf, 0x8c, 0xc7, 0xed, 0xd9, 0x99, 0xfd, 0xe2, 0x0e, 0x09, 0x7a, 0xec, 0xb1, 0xb7, 0x6f, 0x6d,
-	0xaf, 0x71, 0xdb, 0x33, 0x62, 0x25, 0x24, 0xca, 0x5d, 0xe9, 0xee, 0xc2, 0xd5, 0x95, 0x75, 0x55,
-	0xd9, 0x9e, 0xe9, 0x43, 0x20, 0x10, 0x88, 0x13, 0x08, 0xc4, 0x89, 0x2f, 0xc1, 0x13, 0x12, 0xe2,
-	0x0f, 0xe0, 0xcf, 0xe0, 0xf1, 0x1e, 0x79, 0x44, 0xbb, 0xff, 0x08, 0xaa, 0xcc, 0xac, 0xfc, 0x88,
-	0x8a, 0xc8, 0x2a, 0x2f, 0x4f, 0x33, 0x72, 0xfc, 0x22, 0x22, 0x3f, 0x23, 0x23, 0xb3, 0xb2, 0xaa,
-	0xa3, 0x9b, 0xc5, 0xf9, 0x76, 0x51, 0x72, 0xc1, 0xab, 0xed, 0x8a, 0x95, 0x57, 0xe9, 0x94, 0x35,
-	0xff, 0x0e, 0xe5, 0x9f, 0x07, 0x6f, 0xc5, 0xf9, 0x4a, 0xac, 0x0a, 0xf6, 0xde, 0xb7, 0x2d, 0x39,
-	0xe5, 0x8b, 0x45, 0x9c, 0x27, 0x95, 0x42, 0xde, 0x7b, 0xd7, 0x4a, 0xd8, 0x15, 0xcb, 0x85, 0xfe,
-	0xfb, 0xd3, 0xff, 0xfc, 0xc9, 0xcf, 0x45, 0x6f, 0xef, 0x64, 0x29, 0xcb, 0xc5, 0x8e, 0xd6, 0x18,
-	0x7c, 0x11, 0x7d, 0x6b, 0x54, 0x14, 0xfb, 0x4c, 0xbc, 0x64, 0x65, 0x95, 0xf2, 0x7c, 0x70, 0x77,
-	0xa8, 0x1d, 0x0c, 0x4f, 0x8a, 0xe9, 0x70, 0x54, 0x14, 0x43, 0x2b, 0x1c, 0x9e, 0xb0, 0x1f, 0x2d,
-	0x59, 0x25, 0xde, 0xbb, 0x17, 0x86, 0xaa, 0x82, 0xe7, 0x15, 0x1b, 0x5c, 0x44, 0xbf, 0x3a, 0x2a,
-	0x8a, 0x09, 0x13, 0xbb, 0xac, 0xae, 0xc0, 0x44, 0xc4, 0x82, 0x0d, 0x36, 0x5a, 0xaa, 0x3e, 0x60,
-	0x7c, 0x3c, 0xe8, 0x06, 0xb5, 0x9f, 0xd3, 0xe8, 0x9b, 0xb5, 0x9f, 0xf9, 0x52, 0x24, 0xfc, 0x75,
-	0x3e, 0xb8, 0xdd, 0x56, 0xd4, 0x22, 0x63, 0xfb, 0x4e, 0x08, 0xd1, 0x56, 0x5f, 0x45, 0xbf, 0xf4,
-	0x2a, 0xce, 0x32, 0x26, 0x76, 0x4a, 0x56, 0x17, 0xdc, 0xd7, 0x51, 0xa2, 0xa1, 0x92, 0x19, 0xbb,
-	0x77, 0x83, 0x8c, 0x36, 0xfc, 0x45, 0xf4, 0x2d, 0x25, 0x39, 0x61, 0x53, 0x7e, 0xc5, 0xca, 0x01,
-	0xaa, 0xa5, 0x85, 0x44, 0x93, 0xb7, 0x20, 0x68, 0x7b, 0x87, 0xe7, 0x57, 0xac, 0x14, 0xb8, 0x6d,
-	0x2d, 0x0c, 0xdb, 0xb6, 0x90, 0xb6, 0xfd, 0xd7, 0x6b, 0xd1, 0x77, 0x47, 0xd3, 0x29, 0x5f, 0xe6,
-	0xe2, 0x80, 0x4f, 0xe3, 0xec, 0x20, 0xcd, 0x2f, 0x8f, 0xd8, 0xeb, 0x9d, 0x79, 0xcd, 0xe7, 0x33,
-	0x36, 0x78, 0xe6, 0xb7, 0xaa, 0x42, 0x87, 0x86, 0x1d, 0xba, 0xb0, 0xf1, 0xfd, 0xc1, 0xf5, 0x94,
-	0x74, 0x59, 0xfe, 0x7e, 0x2d, 0xba, 0x01, 0xcb, 0x32, 0xe1, 0xd9, 0x15, 0xb3, 0xa5, 0xf9, 0xb0,
-	0xc3, 0xb0, 0x8f, 0x9b, 0xf2, 0x7c, 0x74, 0x5d, 0x35, 0x5d, 0xa2, 0x3f, 0x5b, 0x8b, 0xbe, 0x03,
-	0x4b, 0xa4, 0x7a, 0x7e, 0x54, 0x14, 0x83, 0x27, 0x1d, 0x56, 0x0d, 0x69, 0xca, 0xf1, 0xfe, 0x35,
-	0x34, 0x74, 0x11, 0xfe, 0x24, 0xfa, 0x36, 0x2c, 0xc1, 0x41, 0x5a, 0x89, 0x51, 0x51, 0x54, 0x83,
-	0xed, 0x0e, 0x73, 0x0d, 0x68, 0xfc, 0x3f, 0xe9, 0xaf, 0x10, 0x68, 0x81, 0x13, 0x76, 0xc5, 0x2f,
-	0x7b, 0xb5, 0x80, 0x21, 0x7b, 0xb7, 0x80, 0xab, 0xa1, 0x8b, 0x90, 0x45, 0xef, 0xb8, 0x73, 0x76,
-	0xc2, 0x2a, 0x19, 0xd3, 0x1e, 0xd2, 0xd3, 0x52, 0x23, 0xc6, 0xe9, 0xa3, 0x3e, 0xa8, 0xf6, 0x96,
-	0x46, 0x03, 0xed, 0x2d, 0xe3, 0x95, 0x71, 0xf6, 0x00, 0xb5, 0xe0, 0x10, 0xc6, 0xd7, 0xc3, 0x1e,
-	0xa4, 0x76, 0xf5, 0x87, 0xd1, 0x2f, 0xbf, 0xe2, 0xe5, 0x65, 0x55, 0xc4, 0x53, 0xa6, 0xe3, 0xd1,
-	0x7d, 0x5f, 0xbb, 0x91, 0xc2, 0x90, 0xb4, 0xde, 0x85, 0x39, 0x91, 0xa3, 0x11, 0x7e, 0x5e, 0x30,
-	0xb8, 0x10, 0x58, 0xc5, 0x5a, 0x48, 0x45, 0x0e, 0x08, 0x69, 0xdb, 0x97, 0xd1, 0xc0, 0xda, 0x3e,
-	0xff, 0x23, 0x36, 0x15, 0xa3, 0x24, 0x81, 0xbd, 0x62, 0x75, 0x25, 0x31, 0x1c, 0x25, 0x09, 0xd5,
-	0x2b, 0x38, 0xaa, 0x9d, 0xbd, 0x8e, 0xde, 0x05, 0xce, 0xe4, 0x50, 0x4d, 0x92, 0xc1, 0x56, 0xd8,
-	0x8a, 0xc6, 0x8c, 0xd3, 0x61, 0x5f, 0xdc, 0x19, 0xff, 0x88, 0xe7, 0x13, 0xb6, 0xe0, 0x57, 0x0c,
-	0x8c, 0x7f, 0xd4, 0x9a, 0x22, 0x89, 0xf1, 0x1f, 0xd6, 0x40, 0x86, 0xc9, 0x84, 0x65, 0x6c, 0x2a,
-	0xc8, 0x61, 0xa2, 0xc4, 0x9d, 0xc3, 0xc4, 0x60, 0xce, 0x0c, 0x6b, 0x84, 0xfb, 0x4c, 0xec, 0x2c,
-	0xcb, 0x92, 0xe5, 0x82, 0xec, 0x4b, 0x8b, 0x74, 0xf6, 0xa5, 0x87, 0x22, 0xf5, 0xd9, 0x67, 0x62,
-	0x94, 0x65, 0x64, 0x7d, 0x94, 0xb8, 0xb3, 0x3e, 0x06, 0xd3, 0x1e, 0xa6, 0xd1, 0xaf, 0x38, 0x2d,
-	0x26, 0xc6, 0xf9, 0x05, 0x1f, 0xd0, 0x6d, 0x21, 0xe5, 0xc6, 0xc7, 0x46, 0x27, 0x87, 0x54, 0xe3,
-	0xc5, 0x9b, 0x82, 0x97, 0x74, 0xb7, 0x28, 0x71, 0x67, 0x35, 0x0c, 0xa6, 0x3d, 0xfc, 0x41, 0xf4,
-	0xb6, 0x0e, 0x90, 0x4d, 0x52, 0x71, 0x0f, 0x8d, 0x9e, 0x30, 0xab, 0xb8, 0xdf, 0x41, 0xb5, 0xcc,
-	0x1f, 0xa6, 0xb3, 0xb2, 0x8e, 0x3e, 0xb8, 0x79, 0x2d, 0xed, 0x30, 0x6f, 0x29, 0x6d, 0x9e, 0x47,
-	0xbf, 0xe6, 0x9b, 0xdf, 0x89, 0xf3, 0x29, 0xcb, 0x06, 0x8f, 0x42, 0xea, 0x8a, 0x31, 0xae, 0x36,
-	0x7b, 0xb1, 0x36, 0xd8, 0x69, 0x42, 0x07, 0xd3, 0xbb, 0xa8, 0x36, 0x08, 0xa5, 0xf7, 0xc2, 0x50,
-	0xcb, 0xf6, 0x2e, 0xcb, 0x18, 0x69, 0x5b, 0x09, 0x3b, 0x6c, 0x1b, 0x48, 0xdb, 0x2e, 0xa3, 0x5f,
-	0x37, 0xdd, 0x5c, 0x27, 0x67, 0x52, 0x5e, 0x2f, 0x3a, 0x9b, 0x44, 0x3f, 0xba, 0x90, 0xf1, 0xf5,
-	0xb8, 0x1f, 0xdc, 0xaa, 0x8f, 0x8e, 0x28, 0x78, 0x7d, 0x40, 0x3c, 0xb9, 0x17, 0x86, 0xb4, 0xed,
-	0xbf, 0x59, 0x8b, 0xbe, 0xa7, 0x65, 0x2f, 0xf2, 0xf8, 0x3c, 0x63, 0x72, 0x75, 0x3f, 0x62, 0xe2,
-	0x35, 0x2f, 0x2f, 0x27, 0xab, 0x7c, 0x4a, 0xe4, 0x94, 0x38, 0xdc, 0x91, 0x53, 0x92, 0x4a, 0xba,
-	0x30, 0x7f, 0x6c, 0xd2, 0xa7, 0x9d, 0x79, 0x9c, 0xcf, 0xd8, 0x0f, 0x2b, 0x9e, 0x8f, 0x8a, 0x74,
-	0x94, 0x24, 0xe5, 0x60, 0x88, 0x77, 0x3d, 0xe4, 0x4c, 0x09, 0xb6, 0x7b, 0xf3, 0xce, 0x1e, 0x46,
-	0xb7, 0xb2, 0xe0, 0x05, 0xdc, 0xc3, 0x34, 0xcd, 0x27, 0x78, 0x41, 0xed, 0x61, 0x7c, 0xa4, 0x65,
-	0xf5, 0xb0, 0x5e, 0x83, 0x70, 0xab, 0x87, 0xee, 0xa2, 0x73, 0x27, 0x84, 0xd8, 0x35, 0xa0, 0x69,
-	0x28, 0x9e, 0x5f, 0xa4, 0xb3, 0xb3, 0x22, 0xa9, 0xe7, 0xd0, 0x43, 0xbc, 0xce, 0x0e, 0x42, 0xac,
-	0x01, 0x04, 0xaa, 0xbd, 0xfd, 0x9d, 0x4d, 0xf5, 0x75, 0x5c, 0xda, 0x2b, 0xf9, 0xe2, 0x80, 0xcd,
-	0xe2, 0xe9, 0x4a, 0x07, 0xd3, 0x0f, 0x42, 0x51, 0x0c, 0xd2, 0xa6, 0x10, 0x1f, 0x5e, 0x53, 0x4b,
-	0x97, 0xe7, 0xdf, 0xd7, 0xa2, 0x7b, 0xde, 0x38, 0xd1, 0x83, 0x49, 0x95, 0x7e, 0x94, 0x27, 0x27,
-	0xac, 0x12, 0x71, 0x29, 0x06, 0xdf, 0x0f, 0x8c, 0x01, 0x42, 0xc7, 0x94, 0xed, 0x07, 0x5f, 0x4b,
-	0xd7, 0xf6, 0xfa, 0xa4, 0x5e, 0x25, 0x74, 0xfc, 0xf1, 0x7b, 0x5d, 0x4a, 0x60, 0xf4, 0xb9, 0x13,
-	0x42, 0x6c, 0xaf, 0x4b, 0xc1, 0x38, 0xbf, 0x4a, 0x05, 0xdb, 0x67, 0x39, 0x2b, 0xdb, 0xbd, 0xae,
-	0x54, 0x7d, 0x84, 0xe8, 0x75, 0x02, 0xb5, 0x67, 0x07, 0x8e, 0x37, 0x55, 0x71, 0x70, 0x76, 0xe0,
-	0x1a, 0x50, 0x00, 0x71, 0x76, 0x80, 0x82, 0x36, 0xa2, 0x7a, 0xb5, 0x32, 0x19, 0xcd, 0x66, 0xa0,
-	0xb0, 0xad, 0x9c, 0xe6, 0x71, 0x3f, 0x98, 0x68, 0x49, 0xb1, 0x5f, 0x1b, 0x09, 0xb6, 0xa4, 0x42,
-	0x7a, 0xb5, 0xa4, 0x41, 0xd1, 0x96, 0x54, 0x9b, 0xa6, 0x40, 0x4b, 0x2a, 0xa0, 0x47, 0x4b, 0x1a,
-	0xd0, 0x26, 0x39, 0x8e, 0x9f, 0x97, 0x29, 0x7b, 0x0d, 0x92, 0x1c, 0x57, 0xb9, 0x16, 0x13, 0x49,
-	0x0e, 0x82, 0x69, 0x0f, 0x47, 0xd1, 0x2f, 0x4a, 0xe1, 0x0f, 0x79, 0x9a, 0x0f, 0x6e, 0x22, 0x4a,
-	0xb5, 0xc0, 0x58, 0xbd, 0x45, 0x03, 0xa0, 0xc4, 0xf5, 0x5f, 0x75, 0xc6, 0x71, 0x9f, 0x50, 0x02,
-	0xc9, 0xc6, 0x7a, 0x17, 0x66, 0xb3, 0x4b, 0x29, 0xac, 0xa3, 0xf2, 0x64, 0x1e, 0x97, 0x69, 0x3e,
-	0x1b, 0x60, 0xba, 0x8e, 0x9c, 0xc8, 0x2e, 0x31, 0x0e, 0x0c, 0x27, 0xad, 0x38, 0x2a, 0x8a, 0xb2,
-	0x0e, 0xf6, 0xd8, 0x70, 0xf2, 0x91, 0xe0, 0x70, 0x6a, 0xa1, 0xb8, 0xb7, 0x5d, 0x36, 0xcd, 0xd2,
-	0x3c, 0xe8, 0x4d, 0x23, 0x7d, 0xbc, 0x59, 0x14, 0x0c, 0xde, 0x03, 0x16, 0x5f, 0xb1, 0xa6, 0x66,
-	0x58, 0xcb, 0xb8, 0x40, 0x70, 0xf0, 0x02, 0xd0, 0x6e, 0xe5, 0xa5, 0xf8, 0x30, 0xbe, 0x64, 0x75,
-	0x03, 0xb3, 0x3a, 0x55, 0x18, 0x60, 0xfa, 0x1e, 0x41, 0x6c, 0xe5, 0x71, 0x52, 0xbb, 0x5a, 0x46,
-	0xef, 0x4a, 0xf9, 0x71, 0x5c, 0x8a, 0x74, 0x9a, 0x16, 0x71, 0xde, 0x6c, 0x11, 0xb1, 0x28, 0xd2,
-	0xa2, 0x8c, 0xcb, 0xad, 0x9e, 0xb4, 0x76, 0xfb, 0x2f, 0x6b, 0xd1, 0x6d, 0xe8, 0xf7, 0x98, 0x95,
-	0x8b, 0x54, 0x9e, 0x34, 0x54, 0x3a, 0xc2, 0x7e, 0x1c, 0x36, 0xda, 0x52, 0x30, 0xa5, 0xf9, 0xe4,
-	0xfa, 0x8a, 0x36, 0xbf, 0x9c, 0xe8, 0xdd, 0xd7, 0xe7, 0x65, 0xd2, 0x3a, 0x0e, 0x9d, 0x34, 0x5b,
-	0x2a, 0x29, 0x24, 0xf2, 0xcb, 0x16, 0x04, 0x66, 0xf8, 0x59, 0x5e, 0x35, 0xd6, 0xb1, 0x19, 0x6e,
-	0xc5, 0xc1, 0x19, 0xee, 0x61, 0x76, 0x86, 0x1f, 0x2f, 0xcf, 0xb3, 0xb4, 0x9a, 0xa7, 0xf9, 0x4c,
-	0x6f, 0x26, 0x7c, 0x5d, 0x2b, 0x86, 0xfb, 0x89, 0x8d, 0x4e, 0x0e, 0x73, 0xa2, 0x07, 0x0b, 0xe9,
-	0x04, 0x0c, 0x93, 0x8d, 0x4e, 0xce, 0xee, 0xf1, 0xac, 0xf4, 0x20, 0xad, 0x04, 0xd8, 0xe3, 0x39,
-	0xaa, 0xb5, 0x94, 0xd8, 0xe3, 0xb5, 0x29, 0xbb, 0xc7, 0x73, 0xeb, 0x50, 0xf1, 0xec, 0x8a, 0x9d,
-	0x95, 0x29, 0xd8, 0xe3, 0x79, 0xe5, 0x6b, 0x18, 0x62, 0x8f, 0x47, 0xb1, 0x36, 0x50, 0x59, 0x62,
-	0x9f, 0x89, 0x89, 0x88, 0xc5, 0xb2, 0x02, 0x81, 0xca, 0xb1, 0x61, 0x10, 0x22, 0x50, 0x11, 0xa8,
-	0xf6, 0xf6, 0x7b, 0x51, 0xa4, 0xce, 0x65, 0xe4, 0xd9, 0x99, 0xbf, 0xf6, 0xe8, 0x03, 0x1b, 0xef,
-	0xe0, 0xec, 0x76, 0x80, 0xb0, 0x13, 0x43, 0xfd, 0xfd, 0x84, 0x5d, 0x94, 0xac, 0x9a, 0x83, 0x89,
-	0xa1, 0x75, 0xb4, 0x90, 0x98, 0x18, 0x2d, 0xc8, 0xa6, 0x88, 0x4a, 0x24, 0x8f, 0x1b, 0x07, 0x68,
-	0x69, 0xa4, 0x88, 0x48, 0x11, 0x01, 0x02, 0x1b, 0x61, 0x32, 0xe7, 0xaf, 0xf1, 0x46, 0xa8, 0x25,
-	0xe1, 0x46, 0xd0, 0x84, 0x7d, 0x0a, 0xa3, 0x0b, 0x8a, 0x3d, 0x85, 0x69, 0x8a, 0x11, 0x7a, 0x0a,
-	0x03, 0x19, 0x3b, 0x1e, 0x5d, 0xc3, 0xcf, 0x39, 0xbf, 0x5c, 0xc4, 0xe5, 0x25, 0x18, 0x8f, 0x9e,
-	0x72, 0xc3, 0x10, 0xe3, 0x91, 0x62, 0xed, 0x78, 0x74, 0x1d, 0xd6, 0x1b, 0x8c, 0xb3, 0x32, 0x03,
-	0xe3, 0xd1, 0xb3, 0xa1, 0x11, 0x62, 0x3c, 0x12, 0xa8, 0x8d, 0x7c, 0xae, 0xb7, 0x09, 0x83, 0x47,
-	0x4e, 0x9e, 0xfa, 0x84, 0x51, 0x47, 0x4e, 0x08, 0x06, 0x87, 0xd0, 0x7e, 0x19, 0x17, 0x73, 0x7c,
-	0x08, 0x49, 0x51, 0x78, 0x08, 0x35, 0x08, 0xec, 0xef, 0x09, 0x8b, 0xcb, 0xe9, 0x1c, 0xef, 0x6f,
-	0x25, 0x0b, 0xf7, 0xb7, 0x61, 0x60, 0x7f, 0x2b, 0xc1, 0xab, 0x54, 0xcc, 0x0f, 0x99, 0x88, 0xf1,
-	0xfe, 0xf6, 0x99, 0x70, 0x7f, 0xb7, 0x58, 0xbb, 0xb3, 0x70, 0x1d, 0x4e, 0x96, 0xe7, 0xd5, 0xb4,
-	0x4c, 0xcf, 0xd9, 0x20, 0x60, 0xc5, 0x40, 0xc4, 0xce, 0x82, 0x84, 0xb5, 0xcf, 0x9f, 0xae, 0x45,
-	0x37, 0x9b, 0x6e, 0xe7, 0x55, 0xa5, 0xd7, 0x55, 0xdf, 0xfd, 0x87, 0x78, 0xff, 0x12, 0x38, 0xf1,
-	0x5c, 0xac, 0x87, 0x9a, 0x93, 0x77, 0xe0, 0x45, 0x3a, 0xcb, 0x2b, 0x53, 0xa8, 0x8f, 0xfb, 0x58,
-	0x77, 0x14, 0x88, 0xbc, 0xa3, 0x97, 0xa2, 0x4d, 0xf9, 0x74, 0xff, 0x34, 0xb2, 0x71, 0x52, 0x81,
-	0x94, 0xaf, 0x69, 0x6f, 0x87, 0x20, 0x52, 0x3e, 0x9c, 0x84, 0x43, 0x61, 0xbf, 0xe4, 0xcb, 0xa2,
-	0xea, 0x18, 0x0a, 0x00, 0x0a, 0x0f, 0x85, 0x36, 0xac, 0x7d, 0xbe, 0x89, 0x7e, 0xc3, 0x1d, 0x7e,
-	0x6e, 0x63, 0x6f, 0xd1, 0x63, 0x0a, 0x6b, 0xe2, 0x61, 0x5f, 0xdc, 0x66, 0x2b, 0x8d, 0x67, 0xb1,
-	0xcb, 0x44, 0x9c, 0x66, 0xd5, 0x60, 0x1d, 0xb7, 0xd1, 0xc8, 0x89, 0x6c, 0x05, 0xe3, 0x60, 0x7c,
-	0xdb, 0x5d, 0x16, 0x59, 0x3a, 0x6d, 0x3f, 0x10, 0xd3, 0xba, 0x46, 0x1c, 0x8e, 0x6f, 0x2e, 0x06,
-	0xe3, 0x75, 0x9d, 0x56, 0xca, 0xff, 0x9c, 0xae, 0x0a, 0x86, 0xc7, 0x6b, 0x0f, 0x09, 0xc7, 0x6b,
-	0x88, 0xc2, 0xfa, 0x4c, 0x98, 0x38, 0x88, 0x57, 0x7c, 0x49, 0xc4, 0x6b, 0x23, 0x0e, 0xd7, 0xc7,
-	0xc5, 0xec, 0xbe, 0xc3, 0x78, 0x18, 0xe7, 0x82, 0x95, 0x79, 0x9c, 0xed, 0x65, 0xf1, 0xac, 0x1a,
-	0x10, 0x31, 0xc6, 0xa7, 0x88, 0x7d, 0x07, 0x4d, 0x23, 0xcd, 0x38, 0xae, 0xf6, 0xe2, 0x2b, 0x5e,
-	0xa6, 0x82, 0x6e, 0x46, 0x8b, 0x74, 0x36, 0xa3, 0x87, 0xa2, 0xde, 0x46, 0xe5, 0x74, 0x9e, 0x5e,
-	0xb1, 0x24, 0xe0, 0xad, 0x41, 0x7a, 0x78, 0x73, 0x50, 0xa4, 0xd3, 0x26, 0x7c, 0x59, 0x4e, 0x19,
-	0xd9, 0x69, 0x4a, 0xdc, 0xd9, 0x69, 0x06, 0xd3, 0x1e, 0xfe, 0x72, 0x2d, 0xfa, 0x4d, 0x25, 0x75,
-	0x9f, 0x52, 0xed, 0xc6, 0xd5, 0xfc, 0x9c, 0xc7, 0x65, 0x32, 0x78, 0x1f, 0xb3, 0x83, 0xa2, 0xc6,
-	0xf5, 0xd3, 0xeb, 0xa8, 0xc0, 0x66, 0xad, 0x73, 0x7a, 0x3b, 0xe3, 0xd0, 0x66, 0xf5, 0x90, 0x70,
-	0xb3, 0x42, 0x14, 0x06, 0x10, 0x29, 0x57, 0x87, 0x98, 0xeb, 0xa4, 0xbe, 0x7f, 0x92, 0xb9, 0xd1,
-	0xc9, 0xc1, 0xf8, 0x58, 0x0b, 0xfd, 0xd1, 0xb2, 0x45, 0xd9, 0xc0, 0x47, 0xcc, 0xb0, 0x2f, 0x4e,
-	0x7a, 0x36, 0xb3, 0x22, 0xec, 0xb9, 0x35, 0x33, 0x86, 0x7d, 0x71, 0xc2, 0xb3, 0x13, 0xd6, 0x42,
-	0x9e, 0x91, 0xd0, 0x36, 0xec, 0x8b, 0xc3, 0xec, 0x4b, 0x33, 0xcd, 0xba, 0xf0, 0x28, 0x60, 0x07,
-	0xae, 0x0d, 0x9b, 0xbd, 0x58, 0xed, 0xf0, 0x27, 0x6b, 0xd1, 0x77, 0xad, 0xc7, 0x43, 0x9e, 0xa4,
-	0x17, 0x2b, 0x05, 0xbd, 0x8c, 0xb3, 0x25, 0xab, 0x06, 0x4f, 0x29, 0x6b, 0x6d, 0xd6, 0x94, 0xe0,
-	0xd9, 0xb5, 0x74, 0xe0, 0xdc, 0x19, 0x15, 0x45, 0xb6, 0x3a, 0x65, 0x8b, 0x22, 0x23, 0xe7, 0x8e,
-	0x87, 0x84, 0xe7, 0x0e, 0x44, 0x61, 0x56, 0x7e, 0xca, 0xeb, 0x9c, 0x1f, 0xcd, 0xca, 0xa5, 0x28,
-	0x9c, 0x95, 0x37, 0x08, 0xcc, 0x95, 0x4e, 0xf9, 0x0e, 0xcf, 0x32, 0x36, 0x15, 0xed, 0x9b, 0x2e,
-	0x46, 0xd3, 0x12, 0xe1, 0x5c, 0x09, 0x90, 0xf6, 0xc4, 0xaf, 0xd9, 0x43, 0xc6, 0x25, 0x7b, 0xbe,
-	0x3a, 0x48, 0xf3, 0xcb, 0x01, 0x9e, 0x16, 0x58, 0x80, 0x38, 0xf1, 0x43, 0x41, 0xb8, 0x57, 0x3d,
-	0xcb, 0x13, 0x8e, 0xef, 0x55, 0x6b, 0x49, 0x78, 0xaf, 0xaa, 0x09, 0x68, 0xf2, 0x84, 0x51, 0x26,
-	0x6b, 0x49, 0xd8, 0xa4, 0x26, 0xb0, 0x50, 0xa8, 0x9f, 0x76, 0x91, 0xa1, 0x10, 0x3c, 0xdf, 0xda,
-	0xe8, 0xe4, 0xe0, 0x9e, 0x4b, 0x3b, 0x40, 0x47, 0x04, 0x30, 0x7e, 0x37, 0xc8, 0xc0, 0xa1, 0xdf,
-	0xec, 0x86, 0xf7, 0x98, 0x98, 0xce, 0xf1, 0xa1, 0xef, 0x21, 0xe1, 0xa1, 0x0f, 0x51, 0x58, 0x8d,
-	0xf1, 0x82, 0xae, 0x86, 0x92, 0x85, 0xab, 0x61, 0x18, 0xd8, 0x09, 0x4a, 0x20, 0xcf, 0xc6, 0xd6,
-	0x69, 0x45, 0xef, 0x74, 0x6c, 0xa3, 0x93, 0xd3, 0x4e, 0xfe, 0xc9, 0x6c, 0xdd, 0x94, 0xf4, 0x88,
-	0xd7, 0xf3, 0xe2, 0x65, 0x9c, 0xa5, 0x49, 0x2c, 0xd8, 0x29, 0xbf, 0x64, 0x39, 0xbe, 0x4b, 0xd2,
-	0xa5, 0x55, 0xfc, 0xd0, 0x53, 0x08, 0xef, 0x92, 0xc2, 0x8a, 0xb0, 0x0b, 0x15, 0x7d, 0x56, 0xb1,
-	0x9d, 0xb8, 0x22, 0xa2, 0x97, 0x87, 0x84, 0xbb, 0x10, 0xa2, 0x30, 0x47, 0x55, 0xf2, 0x17, 0x6f,
-	0x0a, 0x56, 0xa6, 0x2c, 0x9f, 0x32, 0x3c, 0x47, 0x85, 0x54, 0x38, 0x47, 0x45, 0x68, 0xb8, 0x3f,
-	0xdb, 0x8d, 0x05, 0x7b, 0xbe, 0x3a, 0x4d, 0x17, 0xac, 0x12, 0xf1, 0xa2, 0xc0, 0xf7, 0x67, 0x00,
-	0x0a, 0xef, 0xcf, 0xda, 0x70, 0xeb, 0x38, 0xc8, 0x04, 0xc1, 0xf6, 0xa5, 0x38, 0x48, 0x04, 0x2e,
-	0xc5, 0x11, 0x28, 0x6c, 0x58, 0x0b, 0xa0, 0x0f, 0x1d, 0x5a, 0x56, 0x82, 0x0f, 0x1d, 0x68, 0xba,
-	0x75, 0xc8, 0x66, 0x98, 0x49, 0x3d, 0x35, 0x3b, 0x8a, 0x3e, 0x71, 0xa7, 0xe8, 0x66, 0x2f, 0x16,
-	0x3f, 0xd5, 0x3b, 0x61, 0x59, 0x2c, 0x97, 0xaa, 0xc0, 0xd1, 0x59, 0xc3, 0xf4, 0x39, 0xd5, 0x73,
-	0x58, 0xed, 0xf0, 0xcf, 0xd7, 0xa2, 0xf7, 0x30, 0x8f, 0x9f, 0x17, 0xd2, 0xef, 0x93, 0x6e, 0x5b,
-	0x8a, 0x24, 0x6e, 0xfd, 0x85, 0x35, 0xec, 0xc5, 0x95, 0x46, 0x64, 0x2f, 0x05, 0xea, 0x02, 0xf8,
-	0x89, 0x9a, 0x29, 0x3f, 0xe4, 0x88, 0x8b, 0x2b, 0x21, 0xde, 0xee, 0x81, 0xfc, 0x72, 0x55, 0x60,
-	0x0f, 0x64, 0x6c, 0x68, 0x31, 0xb1, 0x07, 0x42, 0x30, 0x3b, 0x3b, 0xdd, 0xea, 0xbd, 0x4a, 0xc5,
-	0x5c, 0xe6, 0x58, 0x60, 0x76, 0x7a, 0x65, 0x35, 0x10, 0x31, 0x3b, 0x49, 0x18, 0x66, 0x21, 0x0d,
-	0x58, 0xcf, 0x4d, 0x2c, 0x96, 0x1b, 0x43, 0xee, 0xcc, 0x7c, 0xd0, 0x0d, 0xc2, 0xf1, 0xda, 0x88,
-	0xf5, 0x76, 0xe7, 0x51, 0xc8, 0x02, 0xd8, 0xf2, 0x6c, 0xf6, 0x62, 0xb5, 0xc3, 0x3f, 0x8d, 0xbe,
-	0xd3, 0xaa, 0xd8, 0x1e, 0x8b, 0xc5, 0xb2, 0x64, 0x09, 0xb8, 0x24, 0xde, 0x2e, 0x77, 0x03, 0x12,
-	0x97, 0xc4, 0x83, 0x0a, 0xad, 0xbc, 0xbc, 0xe1, 0xd4, 0xb0, 0x32, 0x65, 0x78, 0x1a, 0x32, 0xe9,
-	0xb3, 0xc1, 0xbc, 0x9c, 0xd6, 0x69, 0x6d, 0xad, 0xdd, 0xd1, 0x35, 0xba, 0x8a, 0xd3, 0x4c, 0x3e,
-	0xfc, 0x7d, 0x3f, 0x64, 0xd4, 0x43, 0x83, 0x5b, 0x6b, 0x52, 0xa5, 0x15, 0x99, 0xe5, 0x1c, 0x77,
-	0xb6, 0x64, 0x8f, 0xe9, 0x48, 0x80, 0xec, 0xc8, 0xb6, 0x7a, 0xd2, 0xda, 0xad, 0x68, 0x96, 0xbc,
-	0xfa, 0xcf, 0xee, 0x20, 0xc7, 0xbc, 0x6a, 0x55, 0x64, 0xa4, 0x6f, 0xf5, 0xa4, 0xed, 0x1b, 0x0a,
-	0x6d, 0xaf, 0x7a, 0x21, 0xda, 0xee, 0x34, 0x05, 0xd6, 0xa2, 0x27, 0xfd, 0x15, 0xb4, 0xfb, 0x7f,
-	0x35, 0x67, 0xd1, 0xca, 0xff, 0x94, 0x2f, 0x16, 0x2c, 0x4f, 0x58, 0xd2, 0x68, 0x54, 0xf5, 0x9e,
-	0xe9, 0x13, 0xda, 0xae, 0x51, 0x18, 0xba, 0x1a, 0xa6, 0x44, 0xbf, 0xf5, 0x35, 0x34, 0x75, 0xd1,
-	0xfe, 0x6b, 0x2d, 0x7a, 0x88, 0x16, 0xad, 0x19, 0xb8, 0x5e, 0x11, 0x7f, 0xb7, 0x8f, 0x23, 0x4c,
-	0xd3, 0x14, 0x75, 0xf4, 0xff, 0xb0, 0xa0, 0x8b, 0xfc, 0x6f, 0x6b, 0xd1, 0x1d, 0xab, 0x58, 0x0f,
-	0xef, 0x1d, 0x9e, 0x5f, 0x64, 0xe9, 0x54, 0xc8, 0x27, 0xbc, 0x5a, 0x85, 0x6e, 0x4e, 0x4a, 0xa3,
-	0xbb, 0x39, 0x03, 0x9a, 0xba, 0x6c, 0xff, 0xb8, 0x16, 0xdd, 0x72, 0x9b, 0x53, 0x3e, 0x1e, 0x56,
-	0x27, 0xa2, 0x8d, 0x62, 0x35, 0xf8, 0x88, 0x6e, 0x03, 0x8c, 0x37, 0xe5, 0xfa, 0xf8, 0xda, 0x7a,
-	0x76, 0x1b, 0xfd, 0x69, 0x5a, 0x09, 0x5e, 0xae, 0x26, 0x73, 0xfe, 0xba, 0x79, 0xe3, 0xce, 0x5f,
-	0x2d, 0x34, 0x30, 0x74, 0x08, 0x62, 0x1b, 0x8d, 0x93, 0x2d, 0x57, 0xf6, 0xcd, 0xbc, 0x8a, 0x70,
-	0xe5, 0x10, 0x1d, 0xae, 0x7c, 0xd2, 0xae, 0x95, 0x4d, 0xad, 0xec, 0x6b, 0x84, 0x1b, 0x78, 0x51,
-	0xdb, 0xaf, 0x12, 0x3e, 0xe8, 0x06, 0x6d, 0xc6, 0xac, 0xc5, 0xbb, 0xe9, 0xc5, 0x85, 0xa9, 0x13,
-	0x5e, 0x52, 0x17, 0x21, 0x32, 0x66, 0x02, 0xb5, 0x9b, 0xbe, 0xbd, 0x34, 0x63, 0xf2, 0x29, 0xd2,
-	0xe7, 0x17, 0x17, 0x19, 0x8f, 0x13, 0xb0, 0xe9, 0xab, 0xc5, 0x43, 0x57, 0x4e, 0x6c, 0xfa, 0x30,
-	0xce, 0x3e, 0xe2, 0xaf, 0xa5, 0xf5, 0x9c, 0xcb, 0xa7, 0x69, 0x06, 0xef, 0x8a, 0x4b, 0x4d, 0x23,
-	0x24, 0x1e, 0xf1, 0xb7, 0x20, 0x9b, 0x98, 0xd5, 0xa2, 0x7a, 0xae, 0x34, 0xe5, 0xbf, 0xdf, 0x56,
-	0x74, 0xc4, 0x44, 0x62, 0x86, 0x60, 0xf6, 0xbc, 0xa3, 0x16, 0x9e, 0x15, 0xd2, 0xf8, 0xad, 0xb6,
-	0x96, 0x92, 0x10, 0xe7, 0x1d, 0x3e, 0x61, 0xf7, 0xf0, 0xf5, 0xdf, 0x77, 0xf9, 0xeb, 0x5c, 0x1a,
-	0xbd, 0xd3, 0x56, 0x69, 0x64, 0xc4, 0x1e, 0x1e, 0x32, 0xda, 0xf0, 0x67, 0xd1, 0x2f, 0x48, 0xc3,
-	0x25, 0x2f, 0x06, 0x37, 0x10, 0x85, 0xd2, 0xb9, 0x59, 0x7d, 0x93, 0x94, 0xdb, 0xab, 0x32, 0x66,
-	0x6c, 0x9c, 0x55, 0xf1, 0x0c, 0xbe, 0x0e, 0x61, 0x7b, 0x5c, 0x4a, 0x89, 0xab, 0x32, 0x6d, 0xca,
-	0x1f, 0x15, 0x47, 0x3c, 0xd1, 0xd6, 0x91, 0x1a, 0x1a, 0x61, 0x68, 0x54, 0xb8, 0x90, 0x4d, 0xa6,
-	0x8f, 0xe2, 0xab, 0x74, 0x66, 0x12, 0x1e, 0x15, 0xbe, 0x2a, 0x90, 0x4c, 0x5b, 0x66, 0xe8, 0x40,
-	0x44, 0x32, 0x4d, 0xc2, 0x4e, 0x30, 0xb6, 0xcc, 0x7e, 0x73, 0x42, 0x3c, 0xce, 0x2f, 0x78, 0x9d,
-	0x7a, 0x1f, 0xa4, 0xf9, 0x25, 0x0c, 0xc6, 0x8e, 0x49, 0x9c, 0x27, 0x82, 0x71, 0x1f, 0x3d, 0xbb,
-	0x6b, 0x6a, 0x8e, 0x4f, 0xed, 0x1d, 0x0a, 0xa5, 0x01, 0x76, 0x4d, 0xe6, 0x94, 0x15, 0x72, 0xc4,
-	0xae, 0x29, 0xc4, 0xdb, 0x2e, 0x36, 0xce, 0x33, 0x9e, 0xc3, 0x2e, 0xb6, 0x16, 0x6a, 0x21, 0xd1,
-	0xc5, 0x2d, 0xc8, 0xc6, 0xe3, 0x46, 0xa4, 0x0e, 0xe4, 0x46, 0x59, 0x06, 0xe2, 0xb1, 0x51, 0x35,
-	0x00, 0x11, 0x8f, 0x51, 0x50, 0xfb, 0x39, 0x89, 0xbe, 0x59, 0x37, 0xe9, 0x71, 0xc9, 0xae, 0x52,
-	0x06, 0xaf, 0xfb, 0x38, 0x12, 0x62, 0xfe, 0xfb, 0x84, 0x9d, 0x59, 0x67, 0x79, 0x55, 0x64, 0x71,
-	0x35, 0xd7, 0x17, 0x40, 0xfc, 0x3a, 0x37, 0x42, 0x78, 0x05, 0xe4, 0x7e, 0x07, 0x65, 0x83, 0x7a,
-	0x23, 0x33, 0x21, 0x66, 0x1d, 0x57, 0x6d, 0x85, 0x99, 0x8d, 0x4e, 0xce, 0x3e, 0x65, 0xd9, 0x8f,
-	0xb3, 0x8c, 0x95, 0xab, 0x46, 0x76, 0x18, 0xe7, 0xe9, 0x05, 0xab, 0x04, 0x78, 0xca, 0xa2, 0xa9,
-	0x21, 0xc4, 0x88, 0xa7, 0x2c, 0x01, 0xdc, 0xee, 0x26, 0x81, 0xe7, 0x71, 0x9e, 0xb0, 0x37, 0x60,
-	0x37, 0x09, 0xed, 0x48, 0x86, 0xd8, 0x4d, 0x52, 0xac, 0x7d, 0xda, 0xf0, 0x3c, 0xe3, 0xd3, 0x4b,
-	0xbd, 0x04, 0xf8, 0x1d, 0x2c, 0x25, 0x70, 0x0d, 0xb8, 0x13, 0x42, 0xec, 0x22, 0x20, 0x05, 0x27,
-	0xac, 0xc8, 0xe2, 0x29, 0xbc, 0xf3, 0xa5, 0x74, 0xb4, 0x8c, 0x58, 0x04, 0x20, 0x03, 0x8a, 0xab,
-	0xef, 0x92, 0x61, 0xc5, 0x05, 0x57, 0xc9, 0xee, 0x84, 0x10, 0xbb, 0x0c, 0x4a, 0xc1, 0xa4, 0xc8,
-	0x52, 0x01, 0xa6, 0x81, 0xd2, 0x90, 0x12, 0x62, 0x1a, 0xf8, 0x04, 0x30, 0x79, 0xc8, 0xca, 0x19,
-	0x43, 0x4d, 0x4a, 0x49, 0xd0, 0x64, 0x43, 0xd8, 0xcb, 0xf3, 0xaa, 0xee, 0xbc, 0x58, 0x81, 0xcb,
-	0xf3, 0xba, 0x5a, 0xbc, 0x58, 0x11, 0x97, 0xe7, 0x3d, 0x00, 0x14, 0xf1, 0x38, 0xae, 0x04, 0x5e,
-	0x44, 0x29, 0x09, 0x16, 0xb1, 0x21, 0xec, 0x1a, 0xad, 0x8a, 0xb8, 0x14, 0x60, 0x8d, 0xd6, 0x05,
-	0x70, 0x6e, 0x3d, 0xdc, 0x24, 0xe5, 0x36, 0x92, 0xa8, 0x5e, 0x61, 0x62, 0x2f, 0x65, 0x59, 0x52,
-	0x81, 0x48, 0xa2, 0xdb, 0xbd, 0x91, 0x12, 0x91, 0xa4, 0x4d, 0x81, 0xa1, 0xa4, 0x1f, 0x99, 0x60,
-	0xb5, 0x03, 0x4f, 0x4c, 0xee, 0x84, 0x10, 0x1b, 0x9f, 0x9a, 0x42, 0xef, 0xc4, 0x65, 0x99, 0xd6,
-	0x8b, 0xff, 0x3a, 0x5e, 0xa0, 0x46, 0x4e, 0xc4, 0x27, 0x8c, 0x03, 0xd3, 0xab, 0x09, 0xdc, 0x58,
-	0xc1, 0x60, 0xe8, 0xbe, 0x1b, 0x64, 0x6c, 0xc6, 0x29, 0x25, 0xce, 0x63, 0x7b, 0xac, 0x35, 0x91,
-	0xa7, 0xf6, 0xeb, 0x5d, 0x98, 0xf3, 0xbe, 0xa0, 0x71, 0x71, 0xc8, 0xaf, 0xd8, 0x29, 0x7f, 0xf1,
-	0x26, 0xad, 0xea, 0x4d, 0xa0, 0x5e, 0xb9, 0x9f, 0x11, 0x96, 0x30, 0x98, 0x78, 0x5f, 0xb0, 0x53,
-	0xc9, 0x26, 0x10, 0xa0, 0x2c, 0x47, 0xec, 0x35, 0x9a, 0x40, 0x40, 0x8b, 0x86, 0x23, 0x12, 0x88,
-	0x10, 0x6f, 0xcf, 0xf1, 0x8c, 0x73, 0xfd, 0xa5, 0x8e, 0x53, 0xde, 0xe4, 0x72, 0x94, 0x35, 0x08,
-	0x12, 0x47, 0x29, 0x41, 0x05, 0xbb, 0xbf, 0x34, 0xfe, 0xed, 0x14, 0x7b, 0x40, 0xd8, 0x69, 0x4f,
-	0xb3, 0x87, 0x3d, 0x48, 0xc4, 0x95, 0xbd, 0x7b, 0x42, 0xb9, 0x6a, 0x5f, 0x3d, 0x79, 0xd8, 0x83,
-	0x74, 0xce, 0x04, 0xdd, 0x6a, 0x3d, 0x8f, 0xa7, 0x97, 0xb3, 0x92, 0x2f, 0xf3, 0x64, 0x87, 0x67,
-	0xbc, 0x04, 0x67, 0x82, 0x5e, 0xa9, 0x01, 0x4a, 0x9c, 0x09, 0x76, 0xa8, 0xd8, 0x0c, 0xce, 0x2d,
-	0xc5, 0x28, 0x4b, 0x67, 0x70, 0x47, 0xed, 0x19, 0x92, 0x00, 0x91, 0xc1, 0xa1, 0x20, 0x32, 0x88,
-	0xd4, 0x8e, 0x5b, 0xa4, 0xd3, 0x38, 0x53, 0xfe, 0xb6, 0x69, 0x33, 0x1e, 0xd8, 0x39, 0x88, 0x10,
-	0x05, 0xa4, 0x9e, 0xa7, 0xcb, 0x32, 0x1f, 0xe7, 0x82, 0x93, 0xf5, 0x6c, 0x80, 0xce, 0x7a, 0x3a,
-	0x20, 0x08, 0xab, 0xa7, 0xec, 0x4d, 0x5d, 0x9a, 0xfa, 0x1f, 0x2c, 0xac, 0xd6, 0x7f, 0x1f, 0x6a,
-	0x79, 0x28, 0xac, 0x02, 0x0e, 0x54, 0x46, 0x3b, 0x51, 0x03, 0x26, 0xa0, 0xed, 0x0f, 0x93, 0x07,
-	0xdd, 0x20, 0xee, 0x67, 0x22, 0x56, 0x19, 0x0b, 0xf9, 0x91, 0x40, 0x1f, 0x3f, 0x0d, 0x68, 0x8f,
-	0x5b, 0xbc, 0xfa, 0xcc, 0xd9, 0xf4, 0xb2, 0x75, 0x95, 0xce, 0x2f, 0xa8, 0x42, 0x88, 0xe3, 0x16,
-	0x02, 0xc5, 0xbb, 0x68, 0x3c, 0xe5, 0x79, 0xa8, 0x8b, 0x6a, 0x79, 0x9f, 0x2e, 0xd2, 0x9c, 0xdd,
-	0xfc, 0x1a, 0xa9, 0x1e, 0x99, 0xaa, 0x9b, 0x36, 0x09, 0x0b, 0x2e, 0x44, 0x6c, 0x7e, 0x49, 0xd8,
-	0xe6, 0xe4, 0xd0, 0xe7, 0x61, 0xfb, 0x3d, 0x83, 0x96, 0x95, 0x43, 0xfa, 0x3d, 0x03, 0x8a, 0xa5,
-	0x2b, 0xa9, 0xc6, 0x48, 0x87, 0x15, 0x7f, 0x9c, 0x3c, 0xee, 0x07, 0xdb, 0x2d, 0x8f, 0xe7, 0x73,
-	0x27, 0x63, 0x71, 0xa9, 0xbc, 0x6e, 0x05, 0x0c, 0x59, 0x8c, 0xd8, 0xf2, 0x04, 0x70, 0x10, 0xc2,
-	0x3c, 0xcf, 0x3b, 0x3c, 0x17, 0x2c, 0x17, 0x58, 0x08, 0xf3, 0x8d, 0x69, 0x30, 0x14, 0xc2, 0x28,
-	0x05, 0x30, 0x6e, 0xe5, 0x79, 0x10, 0x13, 0x47, 0xf1, 0x02, 0xcd, 0xd8, 0xd4, 0x59, 0x8f, 0x92,
-	0x87, 0xc6, 0x2d, 0xe0, 0x9c, 0x87, 0xcc, 0xae, 0x97, 0xd3, 0xb8, 0x9c, 0x99, 0xd3, 0x8d, 0x64,
-	0xf0, 0x84, 0xb6, 0xe3, 0x93, 0xc4, 0x43, 0xe6, 0xb0, 0x06, 0x08, 0x3b, 0xe3, 0x45, 0x3c, 0x33,
-	0x35, 0x45, 0x6a, 0x20, 0xe5, 0xad, 0xaa, 0x3e, 0xe8, 0x06, 0x81, 0x9f, 0x97, 0x69, 0xc2, 0x78,
-	0xc0, 0x8f, 0x94, 0xf7, 0xf1, 0x03, 0x41, 0x90, 0xbd, 0xd5, 0xf5, 0xd6, 0xdf, 0xd2, 0xca, 0x13,
-	0xbd, 0x8f, 0x1d, 0x12, 0xcd, 0x03, 0xb8, 0x50, 0xf6, 0x46, 0xf0, 0x60, 0x8e, 0x36, 0x07, 0xb4,
-	0xa1, 0x39, 0x6a, 0xce, 0x5f, 0xfb, 0xcc, 0x51, 0x0c, 0xd6, 0x3e, 0x7f, 0xac, 0xe7, 0xe8, 0x6e,
-	0x2c, 0xe2, 0x3a, 0x6f, 0x7f, 0x99, 0xb2, 0xd7, 0x7a, 0x23, 0x8c, 0xd4, 0xb7, 0xa1, 0x86, 0xf2,
-	0x15, 0x6c, 0xb0, 0x2b, 0xde, 0xee, 0xcd, 0x07, 0x7c, 0xeb, 0x1d, 0x42, 0xa7, 0x6f, 0xb0, 0x55,
-	0xd8, 0xee, 0xcd, 0x07, 0x7c, 0xeb, 0x2f, 0x56, 0x74, 0xfa, 0x06, 0x9f, 0xad, 0xd8, 0xee, 0xcd,
-	0x6b, 0xdf, 0x7f, 0xd1, 0x4c, 0x5c, 0xd7, 0x79, 0x9d, 0x87, 0x4d, 0x45, 0x7a, 0xc5, 0xb0, 0x74,
-	0xd2, 0xb7, 0x67, 0xd0, 0x50, 0x3a, 0x49, 0xab, 0x38, 0x1f, 0xee, 0xc3, 0x4a, 0x71, 0xcc, 0xab,
-	0x54, 0x5e, 0x12, 0x79, 0xd6, 0xc3, 0x68, 0x03, 0x87, 0x36, 0x4d, 0x21, 0x25, 0xfb, 0xb8, 0xdb,
-	0x43, 0xed, 0xcd, 0xf9, 0xc7, 0x01, 0x7b, 0xed, 0x0b, 0xf4, 0x5b, 0x3d, 0x69, 0xfb, 0xe0, 0xd9,
-	0x63, 0x9a, 0x47, 0x86, 0x13, 0x86, 0xae, 0x12, 0xc6, 0x94, 0x79, 0x94, 0xec, 0x3e, 0x3b, 0x7d,
-	0xd2, 0x5f, 0xa1, 0xc3, 0xfd, 0x28, 0x49, 0xfa, 0xb9, 0x77, 0x9f, 0xb9, 0x3f, 0xe9, 0xaf, 0xa0,
-	0xdd, 0xff, 0x55, 0xb3, 0xad, 0x81, 0xfe, 0xf5, 0x1c, 0x7c, 0xda, 0xc7, 0x22, 0x98, 0x87, 0xcf,
-	0xae, 0xa5, 0xa3, 0x0b, 0xf2, 0xb7, 0xcd, 0xfe, 0xbd, 0x41, 0xe5, 0xeb, 0x4b, 0xf2, 0x95, 0x6a,
-	0x3d, 0x25, 0x43, 0xa3, 0xca, 0xc2, 0x70, 0x62, 0x7e, 0x78, 0x4d, 0x2d, 0xe7, 0x2b, 0x92, 0x1e,
-	0xac, 0x5f, 0xe1, 0x75, 0xca, 0x13, 0xb2, 0xec, 0xd0, 0xb0, 0x40, 0x1f, 0x5d, 0x57, 0x8d, 0x9a,
-	0xaa, 0x0e, 0x2c, 0x3f, 0xe1, 0xf3, 0xac, 0xa7, 0x61, 0xef, 0xa3, 0x3e, 0x1f, 0x5c, 0x4f, 0x49,
-	0x97, 0xe5, 0x3f, 0xd6, 0xa2, 0xfb, 0x1e, 0x6b, 0x1f, 0x67, 0x80, 0x43, 0x97, 0x1f, 0x04, 0xec,
-	0x53, 0x4a, 0xa6, 0x70, 0xbf, 0xfd, 0xf5, 0x94, 0xed, 0xd7, 0xfe, 0x3c, 0x95, 0xbd, 0x34, 0x13,
-	0xac, 0x6c, 0x7f, 0xed, 0xcf, 0xb7, 0xab, 0xa8, 0x21, 0xfd, 0xb5, 0xbf, 0x00, 0xee, 0x7c, 0xed,
-	0x0f, 0xf1, 0x8c, 0x7e, 0xed, 0x0f, 0xb5, 0x16, 0xfc, 0xda, 0x5f, 0x58, 0x83, 0x5a, 0x5d, 0x9a,
-	0x22, 0xa8, 0x63, 0xf3, 0x5e, 0x16, 0xfd, 0x53, 0xf4, 0xa7, 0xd7, 0x51, 0x21, 0xd6, 0x57, 0xc5,
-	0xc9, 0x6b, 0x9e, 0x3d, 0xda, 0xd4, 0xbb, 0xea, 0xb9, 0xdd, 0x9b, 0xd7, 0xbe, 0x7f, 0xa4, 0x37,
-	0x57, 0x66, 0x35, 0xe1, 0xa5, 0xfc, 0xd2, 0xe3, 0x66, 0x68, 0x75, 0xa8, 0x2d, 0xb8, 0x3d, 0xff,
-	0xb8, 0x1f, 0x4c, 0x54, 0xb7, 0x26, 0x74, 0xa7, 0x0f, 0xbb, 0x0c, 0x81, 0x2e, 0xdf, 0xee, 0xcd,
-	0x13, 0xcb, 0x88, 0xf2, 0xad, 0x7a, 0xbb, 0x87, 0x31, 0xbf, 0xaf, 0x9f, 0xf4, 0x57, 0xd0, 0xee,
-	0xaf, 0x74, 0xd6, 0xea, 0xba, 0x97, 0xfd, 0xbc, 0xd5, 0x65, 0x6a, 0xe2, 0x75, 0xf3, 0xb0, 0x2f,
-	0x1e, 0xca, 0x5f, 0xdc, 0x25, 0xb4, 0x2b, 0x7f, 0x41, 0x97, 0xd1, 0x0f, 0xae, 0xa7, 0xa4, 0xcb,
-	0xf2, 0x0f, 0x6b, 0xd1, 0x4d, 0xb2, 0x2c, 0x7a, 0x1c, 0x7c, 0xd4, 0xd7, 0x32, 0x18, 0x0f, 0x1f,
-	0x5f, 0x5b, 0x4f, 0x17, 0xea, 0x9f, 0xd7, 0xa2, 0x5b, 0x81, 0x42, 0xa9, 0x01, 0x72, 0x0d, 0xeb,
-	0xfe, 0x40, 0xf9, 0xe4, 0xfa, 0x8a, 0xd4, 0x72, 0xef, 0xe2, 0x93, 0xf6, 0x97, 0xdb, 0x02, 0xb6,
-	0x27, 0xf4, 0x97, 0xdb, 0xba, 0xb5, 0xe0, 0x19, 0x53, 0x7c, 0xde, 0xec, 0xf9, 0xd0, 0x33, 0x26,
-	0x79, 0x41, 0x33, 0xf8, 0xad, 0x16, 0x8c, 0xc3, 0x9c, 0xbc, 0x78, 0x53, 0xc4, 0x79, 0x42, 0x3b,
-	0x51, 0xf2, 0x6e, 0x27, 0x86, 0x83, 0x67, 0x73, 0xb5, 0xf4, 0x84, 0x37, 0xfb, 0xb8, 0x87, 0x94,
-	0xbe, 0x41, 0x82, 0x67, 0x73, 0x2d, 0x94, 0xf0, 0xa6, 0xb3, 0xc6, 0x90, 0x37, 0x90, 0x2c, 0x3e,
-	0xea, 0x83, 0x82, 0x1d, 0x82, 0xf1, 0x66, 0x8e, 0xfc, 0x1f, 0x87, 0xac, 0xb4, 0x8e, 0xfd, 0xb7,
-	0x7a, 0xd2, 0x84, 0xdb, 0x09, 0x13, 0x9f, 0xb2, 0x38, 0x61, 0x65, 0xd0, 0xad, 0xa1, 0x7a, 0xb9,
-	0x75, 0x69, 0xcc, 0xed, 0x0e, 0xcf, 0x96, 0x8b, 0x5c, 0x77, 0x26, 0xe9, 0xd6, 0xa5, 0xba, 0xdd,
-	0x02, 0x1a, 0x9e, 0x4a, 0x5a, 0xb7, 0x32, 0xbd, 0x7c, 0x14, 0x36, 0xe3, 0x65, 0x95, 0x9b, 0xbd,
-	0x58, 0xba, 0x9e, 0x7a, 0x18, 0x75, 0xd4, 0x13, 0x8c, 0xa4, 0xad, 0x9e, 0x34, 0x3c, 0x1e, 0x74,
-	0xdc, 0x9a, 0xf1, 0xb4, 0xdd, 0x61, 0xab, 0x35, 0xa4, 0x9e, 0xf4, 0x57, 0x80, 0x87, 0xb1, 0x7a,
-	0x54, 0x1d, 0xa4, 0x95, 0xd8, 0x4b, 0xb3, 0x6c, 0xb0, 0x19, 0x18, 0x26, 0x0d, 0x14, 0x3c, 0x8c,
-	0x45, 0x60, 0x62, 0x24, 0x37, 0x87, 0x97, 0xf9, 0xa0, 0xcb, 0x8e, 0xa4, 0x7a, 0x8d, 0x64, 0x97,
-	0x06, 0x07, 0x6a, 0x4e, 0x53, 0x9b, 0xda, 0x0e, 0xc3, 0x0d, 0xd7, 0xaa, 0xf0, 0x76, 0x6f, 0x1e,
-	0x3c, 0xed, 0x97, 0x94, 0x5c, 0x59, 0xee, 0x51, 0x26, 0xbc, 0x95, 0xe4, 0x7e, 0x07, 0x05, 0x0e,
-	0x25, 0xd5, 0x34, 0x7a, 0x95, 0x26, 0x33, 0x26, 0xd0, 0x07, 0x55, 0x2e, 0x10, 0x7c, 0x50, 0x05,
-	0x40, 0xd0, 0x75, 0xea, 0xef, 0xe6, 0x34, 0x76, 0x9c, 0x60, 0x5d, 0xa7, 0x95, 0x1d, 0x2a, 0xd4,
-	0x75, 0x28, 0x0d, 0xa2, 0x81, 0x71, 0xab, 0xbf, 0x40, 0xf1, 0x28, 0x64, 0x06, 0x7c, 0x86, 0x62,
-	0xb3, 0x17, 0x0b, 0x56, 0x14, 0xeb, 0x30, 0x5d, 0xa4, 0x02, 0x5b, 0x51, 0x1c, 0x1b, 0x35, 0x12,
-	0x5a, 0x51, 0xda, 0x28, 0x55, 0xbd, 0x3a, 0x47, 0x18, 0x27, 0xe1, 0xea, 0x29, 0xa6, 0x5f, 0xf5,
-	0x0c, 0xdb, 0x7a, 0xae, 0x9a, 0x9b, 0x21, 0x23, 0xe6, 0x7a, 0xb3, 0x8c, 0x8c, 0x6d, 0xe7, 0x07,
-	0x1d, 0x2c, 0x18, 0x8a, 0x3a, 0x94, 0x02, 0x7c, 0x5e, 0xd0, 0xfc, 0x04, 0xc4, 0x84, 0x89, 0x51,
-	0x51, 0xb0, 0xb8, 0x8c, 0xf3, 0x29, 0xba, 0x39, 0x35, 0x3f, 0xe9, 0xe0, 0x91, 0xa1, 0xcd, 0x29,
-	0xa9, 0x01, 0x9e, 0xda, 0xfb, 0xaf, 0xfe, 0x22, 0x53, 0xc1, 0x7c, 0x29, 0xcb, 0x7f, 0xf3, 0xf7,
-	0x61, 0x0f, 0x12, 0x3e, 0xb5, 0x6f, 0x00, 0x73, 0xee, 0xae, 0x9c, 0xbe, 0x1f, 0x30, 0xe5, 0xa3,
-	0xa1, 0x8d, 0x30, 0xad, 0x02, 0x06, 0xb5, 0x73, 0xb6, 0xf8, 0x19, 0x5b, 0x61, 0x83, 0xda, 0x3d,
-	0x24, 0xfc, 0x8c, 0xad, 0x42, 0x83, 0xba, 0x8d, 0x82, 0x3c, 0xd3, 0xdd, 0x07, 0xad, 0x07, 0xf4,
-	0xdd, 0xad, 0xcf, 0x46, 0x27, 0x07, 0x66, 0xce, 0x6e, 0x7a, 0xe5, 0x3d, 0xa6, 0x40, 0x0a, 0xba,
-	0x9b, 0x5e, 0xe1, 0x4f, 0x29, 0x36, 0x7b, 0xb1, 0xf0, 0x46, 0x40, 0x2c, 0xd8, 0x9b, 0xe6, 0x51,
-	0x3d, 0x52, 0x5c, 0x29, 0x6f, 0x3d, 0xab, 0x7f, 0xd0, 0x0d, 0xda, 0xfb, 0xb7, 0xc7, 0x25, 0x9f,
-	0xb2, 0xaa, 0xd2, 0x1f, 0x7e, 0xf5, 0x2f, 0x38, 0x69, 0xd9, 0x10, 0x7c, 0xf6, 0xf5, 0x5e, 0x18,
-	0x72, 0xbe, 0xd6, 0xa8, 0x44, 0xf6, 0x43, 0x4f, 0xeb, 0xa8, 0x66, 0xfb, 0x1b, 0x4f, 0x1b, 0x9d,
-	0x9c, 0x9d, 0x5e, 0x5a, 0xea, 0x7e, 0xd9, 0xe9, 0x01, 0xaa, 0x8e, 0x7d, 0xd4, 0xe9, 0x61, 0x0f,
-	0x52, 0xbb, 0xfa, 0x34, 0x7a, 0xeb, 0x80, 0xcf, 0x26, 0x2c, 0x4f, 0x06, 0xdf, 0xf3, 0x6f, 0xf0,
-	0xf2, 0xd9, 0xb0, 0xfe, 0xb3, 0x31, 0x7a, 0x83, 0x12, 0xdb, 0x3b, 0x88, 0xbb, 0xec, 0x7c, 0x39,
-	0x9b, 0x88, 0x58, 0x80, 0x3b, 0x88, 0xf2, 0xef, 0xc3, 0x5a, 0x40, 0xdc, 0x41, 0xf4, 0x00, 0x60,
-	0xef, 0xb4, 0x64, 0x0c, 0xb5, 0x57, 0x0b, 0x82, 0xf6, 0x34, 0x60, 0xb3, 0x08, 0x63, 0xaf, 0x4e,
-	0xd4, 0xe1, 0x9d, 0x41, 0xab, 0x23, 0xa5, 0x44, 0x16, 0xd1, 0xa6, 0xec, 0xe0, 0x56, 0xd5, 0x97,
-	0x1f, 0xda, 0x59, 0x2e, 0x16, 0x71, 0xb9, 0x02, 0x83, 0x5b, 0xd7, 0xd2, 0x01, 0x88, 0xc1, 0x8d,
-	0x82, 0x76, 0xd6, 0x36, 0xcd, 0x3c, 0xbd, 0xdc, 0xe7, 0x25, 0x5f, 0x8a, 0x34, 0x67, 0xf0, 0x63,
-	0x2b, 0xa6, 0x41, 0x5d, 0x86, 0x98, 0xb5, 0x14, 0x6b, 0xb3, 0x5c, 0x49, 0xa8, 0xeb, 0x8c, 0xf2,
-	0x0b, 0xfb, 0x95, 0xe0, 0x25, 0x7c, 0x9c, 0xa9, 0xac, 0x40, 0x88, 0xc8, 0x72, 0x49, 0x18, 0xf4,
-	0xfd, 0x71, 0x9a, 0xcf, 0xd0, 0xbe, 0x3f, 0x76, 0x3f, 0xa6, 0x7c, 0x8b, 0x06, 0xec, 0x84, 0x52,
-	0x8d, 0xa6, 0x26, 0x80, 0x7e, 0x95, 0x19, 0x6d, 0x74, 0x97, 0x20, 0x26, 0x14, 0x4e, 0x02, 0x57,
-	0x9f, 0x17, 0x2c, 0x67, 0x49, 0x73, 0x69, 0x0f, 0x73, 0xe5, 0x11, 0x41, 0x57, 0x90, 0xb4, 0xb1,
-	0x48, 0xca, 0x4f, 0x96, 0xf9, 0x71, 0xc9, 0x2f, 0xd2, 0x8c, 0x95, 0x20, 0x16, 0x29, 0x75, 0x47,
-	0x4e, 0xc4, 0x22, 0x8c, 0xb3, 0xb7, 0x3f, 0xa4, 0xd4, 0xfb, 0x99, 0x88, 0xd3, 0x32, 0x9e, 0xc2,
-	0xdb, 0x1f, 0xca, 0x46, 0x1b, 0x23, 0x4e, 0x06, 0x03, 0xb8, 0x93, 0xe8, 0x28, 0xd7, 0xf9, 0x4a,
-	0x8e, 0x0f, 0xfd, 0x2a, 0xad, 0xfc, 0xc4, 0x70, 0x05, 0x12, 0x1d, 0x6d, 0x0e, 0x23, 0x89, 0x44,
-	0x27, 0xac, 0x61, 0x97, 0x12, 0xc9, 0x1d, 0xe9, 0x5b, 0x4d, 0x60, 0x29, 0x51, 0x36, 0x1a, 0x21,
-	0xb1, 0x94, 0xb4, 0x20, 0x10, 0x90, 0x9a, 0x69, 0x30, 0x43, 0x03, 0x92, 0x91, 0x06, 0x03, 0x92,
-	0x4b, 0xd9, 0x40, 0x31, 0xce, 0x53, 0x91, 0xc6, 0xd9, 0x84, 0x89, 0xe3, 0xb8, 0x8c, 0x17, 0x4c,
-	0xb0, 0x12, 0x06, 0x0a, 0x8d, 0x0c, 0x3d, 0x86, 0x08, 0x14, 0x14, 0xab, 0x1d, 0xfe, 0x4e, 0xf4,
-	0x4e, 0xbd, 0xee, 0xb3, 0x5c, 0xff, 0xc0, 0xd5, 0x0b, 0xf9, 0xf3, 0x84, 0x83, 0x77, 0x8d, 0x8d,
-	0x89, 0x28, 0x59, 0xbc, 0x68, 0x6c, 0xbf, 0x6d, 0xfe, 0x2e, 0xc1, 0x27, 0x6b, 0xf5, 0x78, 0x3e,
-	0xe2, 0x22, 0xbd, 0xa8, 0xb7, 0xd9, 0xfa, 0x05, 0x26, 0x30, 0x9e, 0x5d, 0xf1, 0x30, 0xf0, 0x29,
-	0x16, 0x8c, 0xb3, 0x71, 0xda, 0x95, 0x9e, 0xb0, 0x22, 0x83, 0x71, 0xda, 0xd3, 0x96, 0x00, 0x11,
-	0xa7, 0x51, 0xd0, 0x4e, 0x4e, 0x57, 0x7c, 0xca, 0xc2, 0x95, 0x39, 0x65, 0xfd, 0x2a, 0x73, 0xea,
-	0xbd, 0x13, 0x92, 0x45, 0xef, 0x1c, 0xb2, 0xc5, 0x39, 0x2b, 0xab, 0x79, 0x5a, 0x50, 0x9f, 0x41,
-	0xb6, 0x44, 0xe7, 0x67, 0x90, 0x09, 0xd4, 0xae, 0x04, 0x16, 0x18, 0x57, 0x47, 0xf1, 0x82, 0xc9,
-	0x0f, 0xcb, 0x80, 0x95, 0xc0, 0x31, 0xe2, 0x40, 0xc4, 0x4a, 0x40, 0xc2, 0xce, 0xeb, 0x65, 0x96,
-	0x39, 0x61, 0xb3, 0x7a, 0x84, 0x95, 0xc7, 0xf1, 0x6a, 0xc1, 0x72, 0xa1, 0x4d, 0x82, 0x33, 0x79,
-	0xc7, 0x24, 0xce, 0x13, 0x67, 0xf2, 0x7d, 0xf4, 0x9c, 0xd0, 0xe4, 0x35, 0xfc, 0x31, 0x2f, 0x85,
-	0xfa, 0xe5, 0xba, 0xb3, 0x32, 0x03, 0xa1, 0xc9, 0x6f, 0x54, 0x8f, 0x24, 0x42, 0x53, 0x58, 0xc3,
-	0xf9, 0xa9, 0x12, 0xaf, 0x0c, 0x2f, 0x59, 0x69, 0xc6, 0xc9, 0x8b, 0x45, 0x9c, 0x66, 0x7a, 0x34,
-	0x7c, 0x3f, 0x60, 0x9b, 0xd0, 0x21, 0x7e, 0xaa, 0xa4, 0xaf, 0xae, 0xf3, 0xe3, 0x2e, 0xe1, 0x12,
-	0x82, 0x47, 0x04, 0x1d, 0xf6, 0x89, 0x47, 0x04, 0xdd, 0x5a, 0x76, 0xe7, 0x6e, 0x59, 0xc9, 0xad,
-	0x24, 0xb1, 0xc3, 0x13, 0x78, 0x5e, 0xe8, 0xd8, 0x04, 0x20, 0xb1, 0x73, 0x0f, 0x2a, 0xd8, 0xd4,
-	0xc0, 0x62, 0x7b, 0x69, 0x1e, 0x67, 0xe9, 0x8f, 0x61, 0x5a, 0xef, 0xd8, 0x69, 0x08, 0x22, 0x35,
-	0xc0, 0x49, 0xcc, 0xd5, 0x3e, 0x13, 0xa7, 0x69, 0x1d, 0xfa, 0x1f, 0x04, 0xda, 0x4d, 0x12, 0xdd,
-	0xae, 0x1c, 0xd2, 0xf9, 0x2c, 0x31, 0x6c, 0xd6, 0x51, 0x51, 0x4c, 0xea, 0x55, 0xf5, 0x84, 0x4d,
-	0x59, 0x5a, 0x88, 0xc1, 0x87, 0xe1, 0xb6, 0x02, 0x38, 0x71, 0xd1, 0xa2, 0x87, 0x1a, 0x16, 0xa8,
-	0xea, 0x3e, 0xd8, 0xd7, 0x3f, 0xfe, 0x46, 0x06, 0x2a, 0x07, 0xea, 0x0e, 0x54, 0x3e, 0x6c, 0x97,
-	0x5b, 0xdf, 0xe7, 0x09, 0x4b, 0x18, 0x5b, 0x0c, 0x1e, 0x85, 0xac, 0x28, 0x86, 0x58, 0x6e, 0x29,
-	0xd6, 0xb9, 0xa3, 0x50, 0x07, 0xcc, 0x89, 0xfa, 0x05, 0xe1, 0xb3, 0x8a, 0x95, 0x3a, 0x9b, 0xda,
-	0x67, 0x02, 0x84, 0x20, 0x87, 0x1b, 0x3a, 0x60, 0xdd, 0x9b, 0x44, 0x08, 0x0a, 0x6b, 0xd8, 0x13,
-	0x4d, 0x87, 0xd3, 0x1f, 0x48, 0x90, 0x77, 0x3a, 0x1f, 0x93, 0xc6, 0x1c, 0x8a, 0x38, 0xd1, 0xa4,
-	0x69, 0x9b, 0x92, 0xb6, 0xdd, 0x8e, 0xf2, 0xd5, 0x18, 0xde, 0x0b, 0x41, 0x2c, 0x49, 0x8c, 0x48,
-	0x49, 0x03, 0xb8, 0x73, 0xe2, 0x5f, 0xf2, 0x38, 0x99, 0xc6, 0x95, 0x38, 0x8e, 0x57, 0x19, 0x8f,
-	0x13, 0x99, 0xbc, 0xc0, 0x13, 0xff, 0x86, 0x19, 0xba, 0x10, 0x75, 0xe2, 0x4f, 0xc1, 0x6e, 0x0a,
-	0x2a, 0x7f, 0x18, 0x59, 0xdf, 0x97, 0x85, 0x29, 0xa8, 0x2c, 0x2f, 0xbc, 0x2b, 0x7b, 0x2f, 0x0c,
-	0xd9, 0xf7, 0xfc, 0x94, 0x48, 0xe6, 0x5a, 0xb7, 0x30, 0x1d, 0x2f, 0xcb, 0xba, 0x1d, 0x20, 0xec,
-	0xb7, 0x67, 0xd4, 0xdf, 0x9b, 0x9f, 0x61, 0x13, 0xfa, 0x0b, 0xf5, 0x8f, 0x31, 0x5d, 0x17, 0xf2,
-	0xae, 0xe1, 0x6d, 0xf5, 0xa4, 0x6d, 0x2e, 0xbd, 0x33, 0x8f, 0xc5, 0x28, 0x49, 0x0e, 0x59, 0x85,
-	0xbc, 0xb4, 0x5f, 0x0b, 0x87, 0x56, 0x4a, 0xe4, 0xd2, 0x6d, 0xca, 0x0e, 0xf4, 0x5a, 0xf6, 0x22,
-	0x49, 0x85, 0x96, 0x35, 0xb7, 0xd0, 0x1f, 0xb7, 0x0d, 0xb4, 0x29, 0xa2, 0x56, 0x34, 0x6d, 0x17,
-	0xac, 0x9a, 0x39, 0xe5, 0xb3, 0x59, 0xc6, 0x34, 0x74, 0xc2, 0x62, 0xf5, 0x81, 0xce, 0xed, 0xb6,
-	0x2d, 0x14, 0x24, 0x16, 0xac, 0xa0, 0x82, 0xcd, 0x95, 0x6b, 0x4c, 0x3d, 0x77, 0x6b, 0x1a, 0x76,
-	0xa3, 0x6d, 0xc6, 0x03, 0x88, 0x5c, 0x19, 0x05, 0xed, 0xbb, 0x85, 0xb5, 0x78, 0x9f, 0x35, 0x2d,
-	0x01, 0x3f, 0x33, 0x26, 0x95, 0x1d, 0x31, 0xf1, 0x6e, 0x21, 0x82, 0xd9, 0xe8, 0x0c, 0x3c, 0x3c,
-	0x5f, 0x8d, 0x13, 0xb8, 0x19, 0x82, 0xfa, 0x92, 0x21, 0xa2, 0x33, 0xc5, 0xfa, 0x5d, 0x67, 0x0e,
-	0xf7, 0x0e, 0xe2, 0xca, 0x56, 0x0e, 0xe9, 0x3a, 0x14, 0x0c, 0x75, 0x1d, 0xa5, 0xe0, 0x37, 0xa9,
-	0x7b, 0x7e, 0x88, 0x34, 0x29, 0x76, 0x78, 0xb8, 0xde, 0x85, 0xd9, 0x0d, 0x4e, 0x2d, 0x3c, 0x61,
-	0x71, 0x62, 0x2a, 0x86, 0xe8, 0xba, 0x72, 0x62, 0x83, 0x83, 0x71, 0xda, 0xc9, 0xef, 0x47, 0x03,
-	0x55, 0x8d, 0xd2, 0x75, 0x73, 0x0b, 0x2b, 0x62, 0x4d, 0x10, 0x81, 0xca, 0x27, 0x9c, 0xec, 0xd4,
-	0xeb, 0xa2, 0x53, 0xae, 0x1d, 0xe8, 0x77, 0x5f, 0x2b, 0x90, 0x9d, 0xfa, 0xcd, 0xde, 0xa2, 0x89,
-	0xec, 0xb4, 0x5b, 0xcb, 0xf9, 0xe2, 0x12, 0xe8, 0xb2, 0xbd, 0x92, 0x2f, 0x60, 0x99, 0x3e, 0x09,
-	0x76, 0x0f, 0xa2, 0x41, 0x7c, 0x71, 0xa9, 0x9f, 0x26, 0xfc, 0xb5, 0x1a, 0x1d, 0x64, 0xf1, 0x5f,
-	0xab, 0xd1, 0xc2, 0xf0, 0xaf, 0xd5, 0x58, 0xc8, 0xbe, 0x6c, 0xdd, 0x8c, 0xa3, 0x51, 0x96, 0x0d,
-	0x6e, 0xe3, 0x43, 0xc3, 0xfd, 0x8a, 0xc5, 0x9d, 0x10, 0xe2, 0xfc, 0xa8, 0xed, 0xf8, 0x55, 0x99,
-	0x8a, 0x34, 0x9f, 0x9d, 0x72, 0x9e, 0xc1, 0xd3, 0xde, 0xd1, 0x78, 0xe8, 0x4a, 0xa9, 0x1f, 0xb5,
-	0x6d, 0x51, 0x76, 0xe1, 0x1c, 0x8d, 0x47, 0x4b, 0xc1, 0x2f, 0xd2, 0x2c, 0x03, 0xe3, 0x71, 0x34,
-	0x1e, 0x36, 0x12, 0x62, 0x3c, 0xfa, 0x84, 0xf3, 0x53, 0xac, 0x63, 0xf9, 0xe0, 0x44, 0x1f, 0x1e,
-	0xdf, 0x85, 0x3a, 0x8e, 0x90, 0xfa, 0x29, 0x56, 0x08, 0x39, 0x3f, 0x2d, 0x3b, 0xc6, 0x7e, 0xa0,
-	0x66, 0x13, 0xaa, 0x23, 0x10, 0xf5, 0xd3, 0xb2, 0x14, 0xec, 0xbc, 0xce, 0x7d, 0xbc, 0xac, 0xe6,
-	0xfe, 0x69, 0x8b, 0xda, 0x57, 0xab, 0x2f, 0xde, 0x3e, 0x03, 0x3f, 0xc1, 0xe4, 0xb3, 0x43, 0x0f,
-	0x26, 0x6e, 0xf6, 0x75, 0x2a, 0x39, 0x5f, 0x26, 0x84, 0xec, 0x84, 0x09, 0xf5, 0xb3, 0x70, 0xf5,
-	0xf6, 0xef, 0x69, 0xd8, 0xac, 0xcb, 0x12, 0xb7, 0xe4, 0xbb, 0x74, 0x54, 0x49, 0x9e, 0xdf, 0xfe,
-	0xef, 0x2f, 0x6f, 0xac, 0xfd, 0xec, 0xcb, 0x1b, 0x6b, 0xff, 0xfb, 0xe5, 0x8d, 0xb5, 0x9f, 0x7e,
-	0x75, 0xe3, 0x1b, 0x3f, 0xfb, 0xea, 0xc6, 0x37, 0xfe, 0xe7, 0xab, 0x1b, 0xdf, 0xf8, 0xe2, 0xad,
-	0x4a, 0xe5, 0x9e, 0xe7, 0x3f, 0x5f, 0x94, 0x5c, 0xf0, 0x67, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff,
-	0x0c, 0x61, 0xed, 0xbd, 0x2f, 0x84, 0x00, 0x00,
+	// 6102 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x9d, 0xdd, 0x6f, 0x1c, 0x59,
+	0x56, 0xc0, 0xd7, 0x3c, 0xb0, 0x50, 0xcb, 0x2e, 0xd0, 0xb3, 0x33, 0xec, 0x0e, 0xbb, 0xf9, 0x8e,
+	0x9d, 0xc4, 0x76, 0xd9, 0x49, 0xe6, 0x8b, 0x5d, 0x24, 0xe8, 0xd8, 0x89, 0xc7, 0x3b, 0x71, 0x62,
+	0xba, 0xed, 0x44, 0x8c, 0x84, 0x44, 0xb9, 0xfb, 0xba, 0x5d, 0xb8, 0xba, 0xaa, 0xb6, 0xaa, 0xda,
+	0x49, 0x2f, 0x02, 0x81, 0x40, 0x20, 0x10, 0x88, 0xe5, 0x53, 0xf0, 0x84, 0xc4, 0x5f, 0xc0, 0x9f,
+	0xc1, 0xe3, 0x3e, 0x22, 0xf1, 0x82, 0x66, 0xfe, 0x11, 0x74, 0xbf, 0xef, 0x3d, 0x75, 0xce, 0xad,
+	0xea, 0xe1, 0x61, 0x94, 0x91, 0xcf, 0xef, 0x9c, 0x73, 0xbf, 0xef, 0xb9, 0x1f, 0x75, 0x3b, 0xba,
+	0x5e, 0x9e, 0xed, 0x94, 0x55, 0xd1, 0x14, 0xf5, 0x4e, 0xcd, 0xaa, 0xab, 0x74, 0xc2, 0xf4, 0xbf,
+	0xb1, 0xf8, 0xf3, 0xe0, 0xeb, 0x49, 0xbe, 0x6c, 0x96, 0x25, 0x7b, 0xff, 0x3b, 0x96, 0x9c, 0x14,
+	0xf3, 0x79, 0x92, 0x4f, 0x6b, 0x89, 0xbc, 0xff, 0x9e, 0x95, 0xb0, 0x2b, 0x96, 0x37, 0xea, 0xef,
+	0x8f, 0xfe, 0xe7, 0xef, 0x7f, 0x2e, 0xfa, 0xd6, 0x5e, 0x96, 0xb2, 0xbc, 0xd9, 0x53, 0x1a, 0x83,
+	0xcf, 0xa3, 0x6f, 0x0e, 0xcb, 0xf2, 0x80, 0x35, 0xaf, 0x58, 0x55, 0xa7, 0x45, 0x3e, 0xb8, 0x1d,
+	0x2b, 0x07, 0xf1, 0xa8, 0x9c, 0xc4, 0xc3, 0xb2, 0x8c, 0xad, 0x30, 0x1e, 0xb1, 0x1f, 0x2f, 0x58,
+	0xdd, 0xbc, 0x7f, 0x27, 0x0c, 0xd5, 0x65, 0x91, 0xd7, 0x6c, 0x70, 0x1e, 0xfd, 0xea, 0xb0, 0x2c,
+	0xc7, 0xac, 0xd9, 0x67, 0x3c, 0x03, 0xe3, 0x26, 0x69, 0xd8, 0x60, 0xa3, 0xa5, 0xea, 0x03, 0xc6,
+	0xc7, 0xbd, 0x6e, 0x50, 0xf9, 0x39, 0x89, 0xbe, 0xc1, 0xfd, 0x5c, 0x2c, 0x9a, 0x69, 0xf1, 0x26,
+	0x1f, 0xdc, 0x6c, 0x2b, 0x2a, 0x91, 0xb1, 0x7d, 0x2b, 0x84, 0x28, 0xab, 0xaf, 0xa3, 0x5f, 0x7a,
+	0x9d, 0x64, 0x19, 0x6b, 0xf6, 0x2a, 0xc6, 0x13, 0xee, 0xeb, 0x48, 0x51, 0x2c, 0x65, 0xc6, 0xee,
+	0xed, 0x20, 0xa3, 0x0c, 0x7f, 0x1e, 0x7d, 0x53, 0x4a, 0x46, 0x6c, 0x52, 0x5c, 0xb1, 0x6a, 0x80,
+	0x6a, 0x29, 0x21, 0x51, 0xe4, 0x2d, 0x08, 0xda, 0xde, 0x2b, 0xf2, 0x2b, 0x56, 0x35, 0xb8, 0x6d,
+	0x25, 0x0c, 0xdb, 0xb6, 0x90, 0xb2, 0xfd, 0x57, 0x6b, 0xd1, 0xf7, 0x86, 0x93, 0x49, 0xb1, 0xc8,
+	0x9b, 0xe7, 0xc5, 0x24, 0xc9, 0x9e, 0xa7, 0xf9, 0xe5, 0x0b, 0xf6, 0x66, 0xef, 0x82, 0xf3, 0xf9,
+	0x8c, 0x0d, 0x1e, 0xfb, 0xa5, 0x2a, 0xd1, 0xd8, 0xb0, 0xb1, 0x0b, 0x1b, 0xdf, 0x1f, 0xac, 0xa6,
+	0xa4, 0xd2, 0xf2, 0x77, 0x6b, 0xd1, 0x35, 0x98, 0x96, 0x71, 0x91, 0x5d, 0x31, 0x9b, 0x9a, 0x0f,
+	0x3b, 0x0c, 0xfb, 0xb8, 0x49, 0xcf, 0x47, 0xab, 0xaa, 0xa9, 0x14, 0xfd, 0xc9, 0x5a, 0xf4, 0x5d,
+	0x98, 0x22, 0x59, 0xf3, 0xc3, 0xb2, 0x1c, 0xec, 0x76, 0x58, 0x35, 0xa4, 0x49, 0xc7, 0xc3, 0x15,
+	0x34, 0x54, 0x12, 0xfe, 0x28, 0xfa, 0x0e, 0x4c, 0xc1, 0xf3, 0xb4, 0x6e, 0x86, 0x65, 0x59, 0x0f,
+	0x76, 0x3a, 0xcc, 0x69, 0xd0, 0xf8, 0xdf, 0xed, 0xaf, 0x10, 0x28, 0x81, 0x11, 0xbb, 0x2a, 0x2e,
+	0x7b, 0x95, 0x80, 0x21, 0x7b, 0x97, 0x80, 0xab, 0xa1, 0x92, 0x90, 0x45, 0xef, 0xb8, 0x7d, 0x76,
+	0xcc, 0x6a, 0x31, 0xa6, 0xdd, 0xa7, 0xbb, 0xa5, 0x42, 0x8c, 0xd3, 0x07, 0x7d, 0x50, 0xe5, 0x2d,
+	0x8d, 0x06, 0xca, 0x5b, 0x56, 0xd4, 0xc6, 0xd9, 0x3d, 0xd4, 0x82, 0x43, 0x18, 0x5f, 0xf7, 0x7b,
+	0x90, 0xca, 0xd5, 0xef, 0x47, 0xbf, 0xfc, 0xba, 0xa8, 0x2e, 0xeb, 0x32, 0x99, 0x30, 0x35, 0x1e,
+	0xdd, 0xf5, 0xb5, 0xb5, 0x14, 0x0e, 0x49, 0xeb, 0x5d, 0x98, 0x33, 0x72, 0x68, 0xe1, 0xcb, 0x92,
+	0xc1, 0x89, 0xc0, 0x2a, 0x72, 0x21, 0x35, 0x72, 0x40, 0x48, 0xd9, 0xbe, 0x8c, 0x06, 0xd6, 0xf6,
+	0xd9, 0x1f, 0xb0, 0x49, 0x33, 0x9c, 0x4e, 0x61, 0xad, 0x58, 0x5d, 0x41, 0xc4, 0xc3, 0xe9, 0x94,
+	0xaa, 0x15, 0x1c, 0x55, 0xce, 0xde, 0x44, 0xef, 0x01, 0x67, 0xa2, 0xa9, 0x4e, 0xa7, 0x83, 0xed,
+	0xb0, 0x15, 0x85, 0x19, 0xa7, 0x71, 0x5f, 0xdc, 0x69, 0xff, 0x88, 0xe7, 0x11, 0x9b, 0x17, 0x57,
+	0x0c, 0xb4, 0x7f, 0xd4, 0x9a, 0x24, 0x89, 0xf6, 0x1f, 0xd6, 0x40, 0x9a, 0xc9, 0x98, 0x65, 0x6c,
+	0xd2, 0x90, 0xcd, 0x44, 0x8a, 0x3b, 0x9b, 0x89, 0xc1, 0x9c, 0x1e, 0xa6, 0x85, 0x07, 0xac, 0xd9,
+	0x5b, 0x54, 0x15, 0xcb, 0x1b, 0xb2, 0x2e, 0x2d, 0xd2, 0x59, 0x97, 0x1e, 0x8a, 0xe4, 0xe7, 0x80,
+	0x35, 0xc3, 0x2c, 0x23, 0xf3, 0x23, 0xc5, 0x9d, 0xf9, 0x31, 0x98, 0xf2, 0x30, 0x89, 0x7e, 0xc5,
+	0x29, 0xb1, 0xe6, 0x30, 0x3f, 0x2f, 0x06, 0x74, 0x59, 0x08, 0xb9, 0xf1, 0xb1, 0xd1, 0xc9, 0x21,
+	0xd9, 0x78, 0xfa, 0xb6, 0x2c, 0x2a, 0xba, 0x5a, 0xa4, 0xb8, 0x33, 0x1b, 0x06, 0x53, 0x1e, 0x7e,
+	0x2f, 0xfa, 0x96, 0x1a, 0x20, 0x75, 0x50, 0x71, 0x07, 0x1d, 0x3d, 0x61, 0x54, 0x71, 0xb7, 0x83,
+	0x6a, 0x99, 0x3f, 0x4a, 0x67, 0x15, 0x1f, 0x7d, 0x70, 0xf3, 0x4a, 0xda, 0x61, 0xde, 0x52, 0xca,
+	0x7c, 0x11, 0x7d, 0xdb, 0x37, 0xbf, 0x97, 0xe4, 0x13, 0x96, 0x0d, 0x1e, 0x84, 0xd4, 0x25, 0x63,
+	0x5c, 0x6d, 0xf6, 0x62, 0xed, 0x60, 0xa7, 0x08, 0x35, 0x98, 0xde, 0x46, 0xb5, 0xc1, 0x50, 0x7a,
+	0x27, 0x0c, 0xb5, 0x6c, 0xef, 0xb3, 0x8c, 0x91, 0xb6, 0xa5, 0xb0, 0xc3, 0xb6, 0x81, 0x94, 0xed,
+	0x2a, 0x7a, 0xd7, 0x54, 0x33, 0x0f, 0xce, 0x84, 0x9c, 0x4f, 0x3a, 0x9b, 0x44, 0x3d, 0xba, 0x90,
+	0xf1, 0xb5, 0xd5, 0x0f, 0x6e, 0xe5, 0x47, 0x8d, 0x28, 0x78, 0x7e, 0xc0, 0x78, 0x72, 0x27, 0x0c,
+	0x29, 0xdb, 0x7f, 0xbd, 0x16, 0x7d, 0x5f, 0xc9, 0x9e, 0xe6, 0xc9, 0x59, 0xc6, 0xc4, 0xec, 0xfe,
+	0x82, 0x35, 0x6f, 0x8a, 0xea, 0x72, 0xbc, 0xcc, 0x27, 0x44, 0x4c, 0x89, 0xc3, 0x1d, 0x31, 0x25,
+	0xa9, 0xa4, 0x12, 0xf3, 0x87, 0x26, 0x7c, 0xda, 0xbb, 0x48, 0xf2, 0x19, 0xfb, 0x51, 0x5d, 0xe4,
+	0xc3, 0x32, 0x1d, 0x4e, 0xa7, 0xd5, 0x20, 0xc6, 0xab, 0x1e, 0x72, 0x26, 0x05, 0x3b, 0xbd, 0x79,
+	0x67, 0x0d, 0xa3, 0x4a, 0xb9, 0x29, 0x4a, 0xb8, 0x86, 0xd1, 0xc5, 0xd7, 0x14, 0x25, 0xb5, 0x86,
+	0xf1, 0x91, 0x96, 0xd5, 0x23, 0x3e, 0x07, 0xe1, 0x56, 0x8f, 0xdc, 0x49, 0xe7, 0x56, 0x08, 0xb1,
+	0x73, 0x80, 0x2e, 0xa8, 0x22, 0x3f, 0x4f, 0x67, 0xa7, 0xe5, 0x94, 0xf7, 0xa1, 0xfb, 0x78, 0x9e,
+	0x1d, 0x84, 0x98, 0x03, 0x08, 0x54, 0x79, 0xfb, 0x5b, 0x1b, 0xea, 0xab, 0x71, 0xe9, 0x59, 0x55,
+	0xcc, 0x9f, 0xb3, 0x59, 0x32, 0x59, 0xaa, 0xc1, 0xf4, 0x83, 0xd0, 0x28, 0x06, 0x69, 0x93, 0x88,
+	0x0f, 0x57, 0xd4, 0x52, 0xe9, 0xf9, 0xf7, 0xb5, 0xe8, 0x8e, 0xd7, 0x4e, 0x54, 0x63, 0x92, 0xa9,
+	0x1f, 0xe6, 0xd3, 0x11, 0xab, 0x9b, 0xa4, 0x6a, 0x06, 0x3f, 0x08, 0xb4, 0x01, 0x42, 0xc7, 0xa4,
+	0xed, 0x87, 0x5f, 0x49, 0xd7, 0xd6, 0xfa, 0xb8, 0x4c, 0x26, 0x4c, 0x8d, 0x3f, 0x7e, 0xad, 0x0b,
+	0x09, 0x1c, 0x7d, 0x6e, 0x85, 0x10, 0x5b, 0xeb, 0x42, 0x70, 0x98, 0x5f, 0xa5, 0x0d, 0x3b, 0x60,
+	0x39, 0xab, 0xda, 0xb5, 0x2e, 0x55, 0x7d, 0x84, 0xa8, 0x75, 0x02, 0xb5, 0x7b, 0x07, 0x8e, 0x37,
+	0x99, 0x71, 0xb0, 0x77, 0xe0, 0x1a, 0x90, 0x00, 0xb1, 0x77, 0x80, 0x82, 0x76, 0x44, 0xf5, 0x72,
+	0x65, 0x22, 0x9a, 0xcd, 0x40, 0x62, 0x5b, 0x31, 0xcd, 0x56, 0x3f, 0x98, 0x28, 0xc9, 0xe6, 0x80,
+	0x1b, 0x09, 0x96, 0xa4, 0x44, 0x7a, 0x95, 0xa4, 0x41, 0xd1, 0x92, 0x94, 0x8b, 0xa6, 0x40, 0x49,
+	0x4a, 0xa0, 0x47, 0x49, 0x1a, 0xd0, 0x06, 0x39, 0x8e, 0x9f, 0x57, 0x29, 0x7b, 0x03, 0x82, 0x1c,
+	0x57, 0x99, 0x8b, 0x89, 0x20, 0x07, 0xc1, 0x94, 0x87, 0x17, 0xd1, 0x2f, 0x0a, 0xe1, 0x8f, 0x8a,
+	0x34, 0x1f, 0x5c, 0x47, 0x94, 0xb8, 0xc0, 0x58, 0xbd, 0x41, 0x03, 0x20, 0xc5, 0xfc, 0xaf, 0x2a,
+	0xe2, 0xb8, 0x4b, 0x28, 0x81, 0x60, 0x63, 0xbd, 0x0b, 0xb3, 0xd1, 0xa5, 0x10, 0xf2, 0x51, 0x79,
+	0x7c, 0x91, 0x54, 0x69, 0x3e, 0x1b, 0x60, 0xba, 0x8e, 0x9c, 0x88, 0x2e, 0x31, 0x0e, 0x34, 0x27,
+	0xa5, 0x38, 0x2c, 0xcb, 0x8a, 0x0f, 0xf6, 0x58, 0x73, 0xf2, 0x91, 0x60, 0x73, 0x6a, 0xa1, 0xb8,
+	0xb7, 0x7d, 0x36, 0xc9, 0xd2, 0x3c, 0xe8, 0x4d, 0x21, 0x7d, 0xbc, 0x59, 0x14, 0x34, 0xde, 0xe7,
+	0x2c, 0xb9, 0x62, 0x3a, 0x67, 0x58, 0xc9, 0xb8, 0x40, 0xb0, 0xf1, 0x02, 0xd0, 0x2e, 0xe5, 0x85,
+	0xf8, 0x28, 0xb9, 0x64, 0xbc, 0x80, 0x19, 0x0f, 0x15, 0x06, 0x98, 0xbe, 0x47, 0x10, 0x4b, 0x79,
+	0x9c, 0x54, 0xae, 0x16, 0xd1, 0x7b, 0x42, 0x7e, 0x9c, 0x54, 0x4d, 0x3a, 0x49, 0xcb, 0x24, 0xd7,
+	0x4b, 0x44, 0x6c, 0x14, 0x69, 0x51, 0xc6, 0xe5, 0x76, 0x4f, 0x5a, 0xb9, 0xfd, 0x97, 0xb5, 0xe8,
+	0x26, 0xf4, 0x7b, 0xcc, 0xaa, 0x79, 0x2a, 0x76, 0x1a, 0x6a, 0x35, 0xc2, 0x7e, 0x1c, 0x36, 0xda,
+	0x52, 0x30, 0xa9, 0xf9, 0x64, 0x75, 0x45, 0x1b, 0x5f, 0x8e, 0xd5, 0xea, 0xeb, 0x65, 0x35, 0x6d,
+	0x6d, 0x87, 0x8e, 0xf5, 0x92, 0x4a, 0x08, 0x89, 0xf8, 0xb2, 0x05, 0x81, 0x1e, 0x7e, 0x9a, 0xd7,
+	0xda, 0x3a, 0xd6, 0xc3, 0xad, 0x38, 0xd8, 0xc3, 0x3d, 0xcc, 0xf6, 0xf0, 0xe3, 0xc5, 0x59, 0x96,
+	0xd6, 0x17, 0x69, 0x3e, 0x53, 0x8b, 0x09, 0x5f, 0xd7, 0x8a, 0xe1, 0x7a, 0x62, 0xa3, 0x93, 0xc3,
+	0x9c, 0xa8, 0xc6, 0x42, 0x3a, 0x01, 0xcd, 0x64, 0xa3, 0x93, 0xb3, 0x6b, 0x3c, 0x2b, 0xe5, 0x9b,
+	0x0b, 0x60, 0x8d, 0xe7, 0xa8, 0x72, 0x29, 0xb1, 0xc6, 0x6b, 0x53, 0x76, 0x8d, 0xe7, 0xe6, 0xa1,
+	0xe6, 0xdb, 0xa8, 0xa7, 0x55, 0x0a, 0xd6, 0x78, 0x5e, 0xfa, 0x34, 0x43, 0xac, 0xf1, 0x28, 0xd6,
+	0x0e, 0x54, 0x96, 0x38, 0x60, 0xcd, 0xb8, 0x49, 0x9a, 0x45, 0x0d, 0x06, 0x2a, 0xc7, 0x86, 0x41,
+	0x88, 0x81, 0x8a, 0x40, 0x95, 0xb7, 0xdf, 0x89, 0x22, 0xb9, 0x2f, 0x23, 0xf6, 0xce, 0xfc, 0xb9,
+	0x47, 0x0a, 0xfc, 0x8d, 0xb3, 0x9b, 0x01, 0xc2, 0x76, 0x0c, 0xf9, 0xf7, 0x11, 0x3b, 0xaf, 0x58,
+	0x7d, 0x01, 0x3a, 0x86, 0xd2, 0x51, 0x42, 0xa2, 0x63, 0xb4, 0x20, 0x1b, 0x22, 0x4a, 0x91, 0xd8,
+	0x6e, 0x1c, 0xa0, 0xa9, 0x11, 0x22, 0x22, 0x44, 0x04, 0x08, 0x2c, 0x84, 0xf1, 0x45, 0xf1, 0x06,
+	0x2f, 0x04, 0x2e, 0x09, 0x17, 0x82, 0x22, 0xec, 0x29, 0x8c, 0x4a, 0x28, 0x76, 0x0a, 0xa3, 0x93,
+	0x11, 0x3a, 0x85, 0x81, 0x8c, 0x6d, 0x8f, 0xae, 0xe1, 0x27, 0x45, 0x71, 0x39, 0x4f, 0xaa, 0x4b,
+	0xd0, 0x1e, 0x3d, 0x65, 0xcd, 0x10, 0xed, 0x91, 0x62, 0x6d, 0x7b, 0x74, 0x1d, 0xf2, 0x05, 0xc6,
+	0x69, 0x95, 0x81, 0xf6, 0xe8, 0xd9, 0x50, 0x08, 0xd1, 0x1e, 0x09, 0xd4, 0x8e, 0x7c, 0xae, 0xb7,
+	0x31, 0x83, 0x5b, 0x4e, 0x9e, 0xfa, 0x98, 0x51, 0x5b, 0x4e, 0x08, 0x06, 0x9b, 0xd0, 0x41, 0x95,
+	0x94, 0x17, 0x78, 0x13, 0x12, 0xa2, 0x70, 0x13, 0xd2, 0x08, 0xac, 0xef, 0x31, 0x4b, 0xaa, 0xc9,
+	0x05, 0x5e, 0xdf, 0x52, 0x16, 0xae, 0x6f, 0xc3, 0xc0, 0xfa, 0x96, 0x82, 0xd7, 0x69, 0x73, 0x71,
+	0xc4, 0x9a, 0x04, 0xaf, 0x6f, 0x9f, 0x09, 0xd7, 0x77, 0x8b, 0xb5, 0x2b, 0x0b, 0xd7, 0xe1, 0x78,
+	0x71, 0x56, 0x4f, 0xaa, 0xf4, 0x8c, 0x0d, 0x02, 0x56, 0x0c, 0x44, 0xac, 0x2c, 0x48, 0x58, 0xf9,
+	0xfc, 0xe9, 0x5a, 0x74, 0x5d, 0x57, 0x7b, 0x51, 0xd7, 0x6a, 0x5e, 0xf5, 0xdd, 0x7f, 0x88, 0xd7,
+	0x2f, 0x81, 0x13, 0xe7, 0x62, 0x3d, 0xd4, 0x9c, 0xb8, 0x03, 0x4f, 0xd2, 0x69, 0x5e, 0x9b, 0x44,
+	0x7d, 0xdc, 0xc7, 0xba, 0xa3, 0x40, 0xc4, 0x1d, 0xbd, 0x14, 0x6d, 0xc8, 0xa7, 0xea, 0x47, 0xcb,
+	0x0e, 0xa7, 0x35, 0x08, 0xf9, 0x74, 0x79, 0x3b, 0x04, 0x11, 0xf2, 0xe1, 0x24, 0x6c, 0x0a, 0x07,
+	0x55, 0xb1, 0x28, 0xeb, 0x8e, 0xa6, 0x00, 0xa0, 0x70, 0x53, 0x68, 0xc3, 0xca, 0xe7, 0xdb, 0xe8,
+	0xd7, 0xdc, 0xe6, 0xe7, 0x16, 0xf6, 0x36, 0xdd, 0xa6, 0xb0, 0x22, 0x8e, 0xfb, 0xe2, 0x36, 0x5a,
+	0xd1, 0x9e, 0x9b, 0x7d, 0xd6, 0x24, 0x69, 0x56, 0x0f, 0xd6, 0x71, 0x1b, 0x5a, 0x4e, 0x44, 0x2b,
+	0x18, 0x07, 0xc7, 0xb7, 0xfd, 0x45, 0x99, 0xa5, 0x93, 0xf6, 0x81, 0x98, 0xd2, 0x35, 0xe2, 0xf0,
+	0xf8, 0xe6, 0x62, 0x70, 0xbc, 0xe6, 0x61, 0xa5, 0xf8, 0x9f, 0x93, 0x65, 0xc9, 0xf0, 0xf1, 0xda,
+	0x43, 0xc2, 0xe3, 0x35, 0x44, 0x61, 0x7e, 0xc6, 0xac, 0x79, 0x9e, 0x2c, 0x8b, 0x05, 0x31, 0x5e,
+	0x1b, 0x71, 0x38, 0x3f, 0x2e, 0x66, 0xd7, 0x1d, 0xc6, 0xc3, 0x61, 0xde, 0xb0, 0x2a, 0x4f, 0xb2,
+	0x67, 0x59, 0x32, 0xab, 0x07, 0xc4, 0x18, 0xe3, 0x53, 0xc4, 0xba, 0x83, 0xa6, 0x91, 0x62, 0x3c,
+	0xac, 0x9f, 0x25, 0x57, 0x45, 0x95, 0x36, 0x74, 0x31, 0x5a, 0xa4, 0xb3, 0x18, 0x3d, 0x14, 0xf5,
+	0x36, 0xac, 0x26, 0x17, 0xe9, 0x15, 0x9b, 0x06, 0xbc, 0x69, 0xa4, 0x87, 0x37, 0x07, 0x45, 0x2a,
+	0x6d, 0x5c, 0x2c, 0xaa, 0x09, 0x23, 0x2b, 0x4d, 0x8a, 0x3b, 0x2b, 0xcd, 0x60, 0xca, 0xc3, 0x9f,
+	0xaf, 0x45, 0xbf, 0x2e, 0xa5, 0xee, 0x29, 0xd5, 0x7e, 0x52, 0x5f, 0x9c, 0x15, 0x49, 0x35, 0x1d,
+	0x3c, 0xc4, 0xec, 0xa0, 0xa8, 0x71, 0xfd, 0x68, 0x15, 0x15, 0x58, 0xac, 0x3c, 0xa6, 0xb7, 0x3d,
+	0x0e, 0x2d, 0x56, 0x0f, 0x09, 0x17, 0x2b, 0x44, 0xe1, 0x00, 0x22, 0xe4, 0x72, 0x13, 0x73, 0x9d,
+	0xd4, 0xf7, 0x77, 0x32, 0x37, 0x3a, 0x39, 0x38, 0x3e, 0x72, 0xa1, 0xdf, 0x5a, 0xb6, 0x29, 0x1b,
+	0x78, 0x8b, 0x89, 0xfb, 0xe2, 0xa4, 0x67, 0xd3, 0x2b, 0xc2, 0x9e, 0x5b, 0x3d, 0x23, 0xee, 0x8b,
+	0x13, 0x9e, 0x9d, 0x61, 0x2d, 0xe4, 0x19, 0x19, 0xda, 0xe2, 0xbe, 0x38, 0x8c, 0xbe, 0x14, 0xa3,
+	0xe7, 0x85, 0x07, 0x01, 0x3b, 0x70, 0x6e, 0xd8, 0xec, 0xc5, 0x2a, 0x87, 0x7f, 0xb9, 0x16, 0x7d,
+	0xcf, 0x7a, 0x3c, 0x2a, 0xa6, 0xe9, 0xf9, 0x52, 0x42, 0xaf, 0x92, 0x6c, 0xc1, 0xea, 0xc1, 0x23,
+	0xca, 0x5a, 0x9b, 0x35, 0x29, 0x78, 0xbc, 0x92, 0x0e, 0xec, 0x3b, 0xc3, 0xb2, 0xcc, 0x96, 0x27,
+	0x6c, 0x5e, 0x66, 0x64, 0xdf, 0xf1, 0x90, 0x70, 0xdf, 0x81, 0x28, 0x8c, 0xca, 0x4f, 0x0a, 0x1e,
+	0xf3, 0xa3, 0x51, 0xb9, 0x10, 0x85, 0xa3, 0x72, 0x8d, 0xc0, 0x58, 0xe9, 0xa4, 0xd8, 0x2b, 0xb2,
+	0x8c, 0x4d, 0x9a, 0xf6, 0x4d, 0x17, 0xa3, 0x69, 0x89, 0x70, 0xac, 0x04, 0x48, 0xbb, 0xe3, 0xa7,
+	0xd7, 0x90, 0x49, 0xc5, 0x9e, 0x2c, 0xf9, 0x55, 0x9f, 0x01, 0x1e, 0x16, 0x58, 0x80, 0xd8, 0xf1,
+	0x43, 0x41, 0xb8, 0x56, 0x3d, 0xcd, 0xa7, 0x05, 0xbe, 0x56, 0xe5, 0x92, 0xf0, 0x5a, 0x55, 0x11,
+	0xd0, 0xe4, 0x88, 0x51, 0x26, 0x47, 0xac, 0xcb, 0xe4, 0x88, 0xb9, 0x26, 0xbd, 0xa1, 0x50, 0x9d,
+	0x76, 0x91, 0x43, 0x21, 0x38, 0xdf, 0xda, 0xe8, 0xe4, 0xe0, 0x9a, 0x4b, 0x39, 0x40, 0x5b, 0x04,
+	0x30, 0x7e, 0x3b, 0xc8, 0xc0, 0xa6, 0xaf, 0x57, 0xc3, 0xcf, 0x58, 0x33, 0xb9, 0xc0, 0x9b, 0xbe,
+	0x87, 0x84, 0x9b, 0x3e, 0x44, 0x61, 0x36, 0x0e, 0xe7, 0x74, 0x36, 0xa4, 0x2c, 0x9c, 0x0d, 0xc3,
+	0xc0, 0x4a, 0x90, 0x02, 0xb1, 0x37, 0xb6, 0x4e, 0x2b, 0x7a, 0xbb, 0x63, 0x1b, 0x9d, 0x9c, 0x72,
+	0xf2, 0x4f, 0x66, 0xe9, 0x26, 0xa5, 0x2f, 0x0a, 0xde, 0x2f, 0x5e, 0x25, 0x59, 0x3a, 0x4d, 0x1a,
+	0x76, 0x52, 0x5c, 0xb2, 0x1c, 0x5f, 0x25, 0xa9, 0xd4, 0x4a, 0x3e, 0xf6, 0x14, 0xc2, 0xab, 0xa4,
+	0xb0, 0x22, 0xac, 0x42, 0x49, 0x9f, 0xd6, 0x6c, 0x2f, 0xa9, 0x89, 0xd1, 0xcb, 0x43, 0xc2, 0x55,
+	0x08, 0x51, 0x18, 0xa3, 0x4a, 0xf9, 0xd3, 0xb7, 0x25, 0xab, 0x52, 0x96, 0x4f, 0x18, 0x1e, 0xa3,
+	0x42, 0x2a, 0x1c, 0xa3, 0x22, 0x34, 0x5c, 0x9f, 0xed, 0x27, 0x0d, 0x7b, 0xb2, 0x3c, 0x49, 0xe7,
+	0xac, 0x6e, 0x92, 0x79, 0x89, 0xaf, 0xcf, 0x00, 0x14, 0x5e, 0x9f, 0xb5, 0xe1, 0xd6, 0x76, 0x90,
+	0x19, 0x04, 0xdb, 0x97, 0xe2, 0x20, 0x11, 0xb8, 0x14, 0x47, 0xa0, 0xb0, 0x60, 0x2d, 0x80, 0x1e,
+	0x3a, 0xb4, 0xac, 0x04, 0x0f, 0x1d, 0x68, 0xba, 0xb5, 0xc9, 0x66, 0x98, 0x31, 0xef, 0x9a, 0x1d,
+	0x49, 0x1f, 0xbb, 0x5d, 0x74, 0xb3, 0x17, 0x8b, 0xef, 0xea, 0x8d, 0x58, 0x96, 0x88, 0xa9, 0x2a,
+	0xb0, 0x75, 0xa6, 0x99, 0x3e, 0xbb, 0x7a, 0x0e, 0xab, 0x1c, 0xfe, 0xe9, 0x5a, 0xf4, 0x3e, 0xe6,
+	0xf1, 0x65, 0x29, 0xfc, 0xee, 0x76, 0xdb, 0x7a, 0x59, 0x7a, 0xde, 0x1f, 0xae, 0xa0, 0x61, 0x2f,
+	0xae, 0x68, 0x91, 0xbd, 0x14, 0xa8, 0x12, 0xe0, 0x07, 0x6a, 0x26, 0xfd, 0x90, 0x23, 0x2e, 0xae,
+	0x84, 0x78, 0xbb, 0x06, 0xf2, 0xd3, 0x55, 0x83, 0x35, 0x90, 0xb1, 0xa1, 0xc4, 0xc4, 0x1a, 0x08,
+	0xc1, 0x6c, 0xef, 0x74, 0xb3, 0xc7, 0x77, 0xda, 0x44, 0x8c, 0x05, 0x7a, 0xa7, 0x97, 0x56, 0x03,
+	0x11, 0xbd, 0x93, 0x84, 0x61, 0x14, 0xa2, 0x41, 0xde, 0x37, 0xb1, 0xb1, 0xdc, 0x18, 0x72, 0x7b,
+	0xe6, 0xbd, 0x6e, 0x10, 0xb6, 0x57, 0x2d, 0x56, 0xcb, 0x9d, 0x07, 0x21, 0x0b, 0x60, 0xc9, 0xb3,
+	0xd9, 0x8b, 0x55, 0x0e, 0xff, 0x38, 0xfa, 0x6e, 0x2b, 0x63, 0xcf, 0x58, 0xd2, 0x2c, 0x2a, 0x36,
+	0x05, 0x97, 0xc4, 0xdb, 0xe9, 0xd6, 0x20, 0x71, 0x49, 0x3c, 0xa8, 0xd0, 0x8a, 0xcb, 0x35, 0x27,
+	0x9b, 0x95, 0x49, 0xc3, 0xa3, 0x90, 0x49, 0x9f, 0x0d, 0xc6, 0xe5, 0xb4, 0x4e, 0x6b, 0x69, 0xed,
+	0xb6, 0xae, 0xe1, 0x55, 0x92, 0x66, 0xe2, 0xf0, 0xf7, 0x61, 0xc8, 0xa8, 0x87, 0x06, 0x97, 0xd6,
+	0xa4, 0x4a, 0x6b, 0x64, 0x16, 0x7d, 0xdc, 0x59, 0x92, 0x6d, 0xd1, 0x23, 0x01, 0xb2, 0x22, 0xdb,
+	0xee, 0x49, 0x2b, 0xb7, 0x4d, 0xf4, 0xae, 0xfd, 0xb3, 0xdb, 0xc8, 0x31, 0xaf, 0x4a, 0x15, 0x69,
+	0xe9, 0xdb, 0x3d, 0x69, 0xfb, 0x85, 0x42, 0xdb, 0xab, 0x9a, 0x88, 0x76, 0x3a, 0x4d, 0x81, 0xb9,
+	0x68, 0xb7, 0xbf, 0x82, 0x72, 0xff, 0xaf, 0x66, 0x2f, 0x5a, 0xfa, 0xe7, 0xdf, 0x4d, 0xb1, 0x7c,
+	0xca, 0xa6, 0x5a, 0xa3, 0xe6, 0x6b, 0xa6, 0x4f, 0x68, 0xbb, 0x46, 0x21, 0x76, 0x35, 0x4c, 0x8a,
+	0x7e, 0xe3, 0x2b, 0x68, 0xaa, 0xa4, 0xfd, 0xe7, 0x5a, 0x74, 0x1f, 0x4d, 0x9a, 0x6e, 0xb8, 0x5e,
+	0x12, 0x7f, 0xbb, 0x8f, 0x23, 0x4c, 0xd3, 0x24, 0x75, 0xf8, 0xff, 0xb0, 0xa0, 0x92, 0xfc, 0x6f,
+	0x6b, 0xd1, 0x2d, 0xab, 0xc8, 0x9b, 0x37, 0xbf, 0x92, 0x96, 0xa5, 0x93, 0x46, 0x9c, 0xf0, 0x2a,
+	0x15, 0xba, 0x38, 0x29, 0x8d, 0xee, 0xe2, 0x0c, 0x68, 0xaa, 0xb4, 0xfd, 0xe3, 0x5a, 0x74, 0xc3,
+	0x2d, 0x4e, 0x71, 0x3c, 0x2c, 0x77, 0x44, 0xb5, 0x62, 0x3d, 0xf8, 0x88, 0x2e, 0x03, 0x8c, 0x37,
+	0xe9, 0xfa, 0x78, 0x65, 0x3d, 0xbb, 0x8c, 0xfe, 0x34, 0xad, 0x9b, 0xa2, 0x5a, 0xf2, 0x43, 0x4e,
+	0xfd, 0xc5, 0x9d, 0x3f, 0x5b, 0x28, 0x20, 0x76, 0x08, 0x62, 0x19, 0x8d, 0x93, 0x2d, 0x57, 0xf6,
+	0xcb, 0xbc, 0x9a, 0x70, 0xe5, 0x10, 0x1d, 0xae, 0x7c, 0xd2, 0xce, 0x95, 0x3a, 0x57, 0x46, 0x0c,
+	0xe6, 0x4a, 0x93, 0xd4, 0xf6, 0xa7, 0x84, 0xf7, 0xba, 0x41, 0x1b, 0x31, 0x2b, 0xf1, 0x7e, 0x7a,
+	0x7e, 0x6e, 0xf2, 0x84, 0xa7, 0xd4, 0x45, 0x88, 0x88, 0x99, 0x40, 0x5b, 0xde, 0x8e, 0x58, 0x35,
+	0x63, 0x3a, 0x5f, 0xb8, 0x37, 0x17, 0xe9, 0xf0, 0x06, 0x50, 0xbb, 0xc4, 0x7c, 0x96, 0x66, 0x4c,
+	0x9c, 0x59, 0xbd, 0x3c, 0x3f, 0xcf, 0x8a, 0x64, 0x0a, 0x96, 0x98, 0x5c, 0x1c, 0xbb, 0x72, 0x62,
+	0x89, 0x89, 0x71, 0xf6, 0x42, 0x01, 0x97, 0xf2, 0x1e, 0x9e, 0x4f, 0xd2, 0x0c, 0xde, 0x4c, 0x17,
+	0x9a, 0x46, 0x48, 0x5c, 0x28, 0x68, 0x41, 0x36, 0x0c, 0xe4, 0x22, 0xde, 0x33, 0x75, 0xfa, 0xef,
+	0xb6, 0x15, 0x1d, 0x31, 0x11, 0x06, 0x22, 0x98, 0xdd, 0x5d, 0xe1, 0xc2, 0xd3, 0x52, 0x18, 0xbf,
+	0xd1, 0xd6, 0x3a, 0x2d, 0x3d, 0xbb, 0x37, 0x03, 0x84, 0xdd, 0x31, 0xe0, 0x7f, 0xdf, 0x2f, 0xde,
+	0xe4, 0xc2, 0xe8, 0xad, 0xb6, 0x8a, 0x96, 0x11, 0x3b, 0x06, 0x90, 0x51, 0x86, 0x3f, 0x8b, 0x7e,
+	0x41, 0x18, 0xae, 0x8a, 0x72, 0x70, 0x0d, 0x51, 0xa8, 0x9c, 0x7b, 0xdc, 0xd7, 0x49, 0xb9, 0xbd,
+	0x98, 0x63, 0xda, 0xc6, 0x69, 0x9d, 0xcc, 0xe0, 0xc7, 0x17, 0xb6, 0xc6, 0x85, 0x94, 0xb8, 0x98,
+	0xd3, 0xa6, 0xfc, 0x56, 0xf1, 0xa2, 0x98, 0x2a, 0xeb, 0x48, 0x0e, 0x8d, 0x30, 0xd4, 0x2a, 0x5c,
+	0xc8, 0x8e, 0x42, 0xba, 0x55, 0x98, 0x7d, 0x7e, 0x38, 0x0a, 0x99, 0x1a, 0xb7, 0x04, 0x31, 0x0a,
+	0xe1, 0xa4, 0xed, 0xaf, 0x5c, 0x2e, 0xba, 0x97, 0xe3, 0x0b, 0xb1, 0x00, 0x10, 0xa2, 0xbf, 0x12,
+	0xa8, 0xbd, 0x8a, 0xfa, 0x24, 0x99, 0x5c, 0x2e, 0xca, 0xd1, 0x02, 0x5e, 0x45, 0x95, 0x7f, 0x8f,
+	0x47, 0x0b, 0xea, 0x2a, 0xaa, 0x07, 0xd8, 0x4a, 0x50, 0xf6, 0x18, 0x1f, 0x26, 0x60, 0x25, 0x68,
+	0x15, 0x29, 0x24, 0x2a, 0xa1, 0x05, 0xd9, 0xf5, 0xd3, 0x8b, 0xe4, 0x2a, 0x9d, 0x99, 0x18, 0x57,
+	0xce, 0x58, 0x35, 0x58, 0x3f, 0x59, 0x26, 0x76, 0x20, 0x62, 0xfd, 0x44, 0xc2, 0xce, 0xfc, 0x6b,
+	0x99, 0x03, 0x7d, 0x28, 0xc0, 0x3f, 0x8b, 0xe2, 0xab, 0x2d, 0xbe, 0x15, 0x0b, 0xe7, 0x5f, 0xc7,
+	0x24, 0xce, 0x13, 0xf3, 0x6f, 0x1f, 0x3d, 0xbb, 0x50, 0xd6, 0x3b, 0xe6, 0xf6, 0xda, 0x8c, 0xd4,
+	0x00, 0x0b, 0x65, 0x8d, 0xc5, 0x90, 0x23, 0x16, 0xca, 0x21, 0xde, 0x56, 0xb1, 0x71, 0x9e, 0x15,
+	0x39, 0xac, 0x62, 0x6b, 0x81, 0x0b, 0x89, 0x2a, 0x6e, 0x41, 0x76, 0x0a, 0xd6, 0x22, 0xb9, 0x07,
+	0xcb, 0xbf, 0x94, 0xdb, 0xc0, 0x55, 0x0d, 0x40, 0x4c, 0xc1, 0x28, 0xa8, 0xfc, 0x8c, 0xa2, 0x6f,
+	0xf0, 0x22, 0x3d, 0xae, 0xd8, 0x15, 0xbf, 0xdf, 0xed, 0xb7, 0x6b, 0x47, 0x42, 0x0c, 0xc2, 0x3e,
+	0x61, 0x87, 0xb7, 0xd3, 0xbc, 0x2e, 0xb3, 0xa4, 0xbe, 0x50, 0x77, 0x7e, 0xfc, 0x3c, 0x6b, 0x21,
+	0xbc, 0xf5, 0x73, 0xb7, 0x83, 0xb2, 0x33, 0xab, 0x96, 0x99, 0x71, 0x7e, 0x1d, 0x57, 0x6d, 0x8d,
+	0xf5, 0x1b, 0x9d, 0x9c, 0x3d, 0x58, 0x3b, 0x48, 0xb2, 0x8c, 0x55, 0x4b, 0x2d, 0x3b, 0x4a, 0xf2,
+	0xf4, 0x9c, 0xd5, 0x0d, 0x38, 0x58, 0x53, 0x54, 0x0c, 0x31, 0xe2, 0x60, 0x2d, 0x80, 0xdb, 0x0d,
+	0x04, 0xe0, 0xf9, 0x30, 0x9f, 0xb2, 0xb7, 0x60, 0x03, 0x01, 0xda, 0x11, 0x0c, 0xb1, 0x81, 0x40,
+	0xb1, 0xf6, 0x80, 0xe9, 0x49, 0x56, 0x4c, 0x2e, 0xd5, 0x3c, 0xec, 0x57, 0xb0, 0x90, 0xc0, 0x89,
+	0xf8, 0x56, 0x08, 0xb1, 0x33, 0xb1, 0x10, 0x8c, 0x58, 0x99, 0x25, 0x13, 0x78, 0xcd, 0x4f, 0xea,
+	0x28, 0x19, 0x31, 0x13, 0x43, 0x06, 0x24, 0x57, 0x5d, 0x1f, 0xc4, 0x92, 0x0b, 0x6e, 0x0f, 0xde,
+	0x0a, 0x21, 0x36, 0x16, 0x11, 0x82, 0x71, 0x99, 0xa5, 0x0d, 0xe8, 0x06, 0x52, 0x43, 0x48, 0x88,
+	0x6e, 0xe0, 0x13, 0xc0, 0xa4, 0x98, 0x72, 0x50, 0x93, 0x42, 0x12, 0x34, 0xa9, 0x09, 0x67, 0x92,
+	0x12, 0x79, 0x2f, 0xca, 0x25, 0x9c, 0xa4, 0x64, 0xb6, 0x8a, 0x72, 0x49, 0x4d, 0x52, 0x2e, 0x00,
+	0x92, 0x78, 0x9c, 0xd4, 0x0d, 0x9e, 0x44, 0x21, 0x09, 0x26, 0x51, 0x13, 0x36, 0x50, 0x92, 0x49,
+	0x5c, 0x34, 0x20, 0x50, 0x52, 0x09, 0x70, 0x2e, 0xba, 0x5c, 0x27, 0xe5, 0x76, 0x24, 0x91, 0xb5,
+	0xc2, 0x9a, 0x67, 0x29, 0xcb, 0xa6, 0x35, 0x18, 0x49, 0x54, 0xb9, 0x6b, 0x29, 0x31, 0x92, 0xb4,
+	0x29, 0xd0, 0x94, 0xd4, 0x29, 0x19, 0x96, 0x3b, 0x70, 0x48, 0x76, 0x2b, 0x84, 0xd8, 0xf1, 0x49,
+	0x27, 0x7a, 0x2f, 0xa9, 0xaa, 0x94, 0x47, 0x60, 0xeb, 0x78, 0x82, 0xb4, 0x9c, 0x18, 0x9f, 0x30,
+	0x0e, 0x74, 0x2f, 0x3d, 0x70, 0x63, 0x09, 0x83, 0x43, 0xf7, 0xed, 0x20, 0x63, 0xc3, 0x7e, 0x21,
+	0x71, 0x6e, 0x6a, 0x60, 0xa5, 0x89, 0x5c, 0xd4, 0x58, 0xef, 0xc2, 0x9c, 0x4f, 0x44, 0x8d, 0x0b,
+	0xfe, 0x1d, 0xe2, 0x49, 0xf1, 0xf4, 0x6d, 0x5a, 0xf3, 0x75, 0xbf, 0x9a, 0xb9, 0x1f, 0x13, 0x96,
+	0x30, 0x98, 0xf8, 0x44, 0xb4, 0x53, 0xc9, 0x06, 0x10, 0x20, 0x2d, 0x2f, 0xd8, 0x1b, 0x34, 0x80,
+	0x80, 0x16, 0x0d, 0x47, 0x04, 0x10, 0x21, 0xde, 0x6e, 0xdd, 0x1a, 0xe7, 0xea, 0x71, 0x96, 0x93,
+	0x42, 0xc7, 0x72, 0x94, 0x35, 0x08, 0x12, 0xbb, 0x67, 0x41, 0x05, 0x1b, 0xcc, 0x1b, 0xff, 0xb6,
+	0x8b, 0xdd, 0x23, 0xec, 0xb4, 0xbb, 0xd9, 0xfd, 0x1e, 0x24, 0xe2, 0xca, 0x5e, 0x37, 0xa2, 0x5c,
+	0xb5, 0x6f, 0x1b, 0xdd, 0xef, 0x41, 0x3a, 0xdb, 0xc0, 0x6e, 0xb6, 0x78, 0x14, 0x3d, 0xab, 0x8a,
+	0x45, 0x3e, 0xdd, 0x2b, 0xb2, 0xa2, 0x02, 0xdb, 0xc0, 0x5e, 0xaa, 0x01, 0x4a, 0x6c, 0x03, 0x77,
+	0xa8, 0xd8, 0x08, 0xce, 0x4d, 0xc5, 0x30, 0x4b, 0x67, 0x70, 0x13, 0xc5, 0x33, 0x24, 0x00, 0x22,
+	0x82, 0x43, 0x41, 0xa4, 0x11, 0xc9, 0x4d, 0x96, 0x26, 0x9d, 0x24, 0x99, 0xf4, 0xb7, 0x43, 0x9b,
+	0xf1, 0xc0, 0xce, 0x46, 0x84, 0x28, 0x20, 0xf9, 0x3c, 0x59, 0x54, 0xf9, 0x61, 0xde, 0x14, 0x64,
+	0x3e, 0x35, 0xd0, 0x99, 0x4f, 0x07, 0x04, 0xc3, 0xea, 0x09, 0x7b, 0xcb, 0x53, 0xc3, 0xff, 0xc1,
+	0x86, 0x55, 0xfe, 0xf7, 0x58, 0xc9, 0x43, 0xc3, 0x2a, 0xe0, 0x40, 0x66, 0x94, 0x13, 0xd9, 0x60,
+	0x02, 0xda, 0x7e, 0x33, 0xb9, 0xd7, 0x0d, 0xe2, 0x7e, 0xc6, 0xcd, 0x32, 0x63, 0x21, 0x3f, 0x02,
+	0xe8, 0xe3, 0x47, 0x83, 0x76, 0x0d, 0xed, 0xe5, 0xe7, 0x82, 0x4d, 0x2e, 0x5b, 0xb7, 0x27, 0xfd,
+	0x84, 0x4a, 0x84, 0x58, 0x43, 0x13, 0x28, 0x5e, 0x45, 0x87, 0x93, 0x22, 0x0f, 0x55, 0x11, 0x97,
+	0xf7, 0xa9, 0x22, 0xc5, 0xd9, 0xc5, 0xaf, 0x91, 0xaa, 0x96, 0x29, 0xab, 0x69, 0x93, 0xb0, 0xe0,
+	0x42, 0xc4, 0xe2, 0x97, 0x84, 0x6d, 0x4c, 0x0e, 0x7d, 0x1e, 0xb5, 0x3f, 0x2d, 0x69, 0x59, 0x39,
+	0xa2, 0x3f, 0x2d, 0xa1, 0x58, 0x3a, 0x93, 0xb2, 0x8d, 0x74, 0x58, 0xf1, 0xdb, 0xc9, 0x56, 0x3f,
+	0xd8, 0x2e, 0x79, 0x3c, 0x9f, 0x7b, 0x19, 0x4b, 0x2a, 0xe9, 0x75, 0x3b, 0x60, 0xc8, 0x62, 0xc4,
+	0x92, 0x27, 0x80, 0x83, 0x21, 0xcc, 0xf3, 0xbc, 0x57, 0xe4, 0x0d, 0xcb, 0x1b, 0x6c, 0x08, 0xf3,
+	0x8d, 0x29, 0x30, 0x34, 0x84, 0x51, 0x0a, 0xa0, 0xdd, 0x8a, 0x4d, 0x39, 0xd6, 0xbc, 0x48, 0xe6,
+	0x68, 0xc4, 0x26, 0x37, 0xdc, 0xa4, 0x3c, 0xd4, 0x6e, 0x01, 0xe7, 0xdc, 0x2b, 0x70, 0xbd, 0x9c,
+	0x24, 0xd5, 0xcc, 0xec, 0x6e, 0x4c, 0x07, 0xbb, 0xb4, 0x1d, 0x9f, 0x24, 0xee, 0x15, 0x84, 0x35,
+	0xc0, 0xb0, 0x73, 0x38, 0x4f, 0x66, 0x26, 0xa7, 0x48, 0x0e, 0x84, 0xbc, 0x95, 0xd5, 0x7b, 0xdd,
+	0x20, 0xf0, 0xf3, 0x2a, 0x9d, 0xb2, 0x22, 0xe0, 0x47, 0xc8, 0xfb, 0xf8, 0x81, 0x20, 0x88, 0xde,
+	0x78, 0xbe, 0xd5, 0xf3, 0x69, 0xf9, 0x54, 0xad, 0x63, 0x63, 0xa2, 0x78, 0x00, 0x17, 0x8a, 0xde,
+	0x08, 0x1e, 0xf4, 0x51, 0xbd, 0x1f, 0x1a, 0xea, 0xa3, 0x66, 0xa3, 0xb3, 0x4f, 0x1f, 0xc5, 0x60,
+	0xe5, 0xf3, 0x27, 0xaa, 0x8f, 0xee, 0x27, 0x4d, 0xc2, 0xe3, 0x76, 0xfe, 0x39, 0xbd, 0x5a, 0x08,
+	0x23, 0xf9, 0xd5, 0x54, 0xcc, 0x31, 0xb8, 0x2a, 0xde, 0xe9, 0xcd, 0x07, 0x7c, 0xab, 0x15, 0x42,
+	0xa7, 0x6f, 0xb0, 0x54, 0xd8, 0xe9, 0xcd, 0x07, 0x7c, 0xab, 0x47, 0x4a, 0x3a, 0x7d, 0x83, 0x97,
+	0x4a, 0x76, 0x7a, 0xf3, 0xca, 0xf7, 0x9f, 0xe9, 0x8e, 0xeb, 0x3a, 0xe7, 0x71, 0xd8, 0xa4, 0x49,
+	0xaf, 0x18, 0x16, 0x4e, 0xfa, 0xf6, 0x0c, 0x1a, 0x0a, 0x27, 0x69, 0x15, 0xe7, 0xad, 0x46, 0x2c,
+	0x15, 0xc7, 0x45, 0x9d, 0x8a, 0x7b, 0x41, 0x8f, 0x7b, 0x18, 0xd5, 0x70, 0x68, 0xd1, 0x14, 0x52,
+	0xb2, 0x37, 0x1c, 0x3c, 0xd4, 0x7e, 0x2c, 0xb1, 0x15, 0xb0, 0xd7, 0xfe, 0x66, 0x62, 0xbb, 0x27,
+	0x6d, 0xef, 0x1a, 0x78, 0x8c, 0x3e, 0x25, 0xe6, 0xe7, 0xe7, 0xa1, 0x5a, 0xd5, 0x5c, 0xec, 0x1e,
+	0x97, 0xef, 0xf6, 0x57, 0xe8, 0x70, 0xcf, 0xef, 0x58, 0xf4, 0x72, 0xef, 0x5e, 0xb3, 0xd8, 0xed,
+	0xaf, 0xa0, 0xdc, 0xff, 0x85, 0x5e, 0xd6, 0x40, 0xff, 0xaa, 0x0f, 0x3e, 0xea, 0x63, 0x11, 0xf4,
+	0xc3, 0xc7, 0x2b, 0xe9, 0xa8, 0x84, 0xfc, 0x8d, 0x5e, 0xbf, 0x6b, 0x54, 0x7c, 0xb1, 0x26, 0xbe,
+	0xa2, 0x57, 0x5d, 0x32, 0xd4, 0xaa, 0x2c, 0x0c, 0x3b, 0xe6, 0x87, 0x2b, 0x6a, 0x39, 0x0f, 0x87,
+	0x7a, 0xb0, 0xfa, 0x6a, 0xdb, 0x49, 0x4f, 0xc8, 0xb2, 0x43, 0xc3, 0x04, 0x7d, 0xb4, 0xaa, 0x1a,
+	0xd5, 0x55, 0x1d, 0x58, 0xbc, 0xda, 0xf4, 0xb8, 0xa7, 0x61, 0xef, 0x1d, 0xa7, 0x0f, 0x56, 0x53,
+	0x52, 0x69, 0xf9, 0x8f, 0xb5, 0xe8, 0xae, 0xc7, 0xda, 0xe3, 0x0c, 0xb0, 0xe9, 0xf2, 0xc3, 0x80,
+	0x7d, 0x4a, 0xc9, 0x24, 0xee, 0x37, 0xbf, 0x9a, 0xb2, 0x7d, 0xe0, 0xd1, 0x53, 0x79, 0x96, 0x66,
+	0x0d, 0xab, 0xda, 0x0f, 0x3c, 0xfa, 0x76, 0x25, 0x15, 0xd3, 0x0f, 0x3c, 0x06, 0x70, 0xe7, 0x81,
+	0x47, 0xc4, 0x33, 0xfa, 0xc0, 0x23, 0x6a, 0x2d, 0xf8, 0xc0, 0x63, 0x58, 0x83, 0x9a, 0x5d, 0x74,
+	0x12, 0xe4, 0xb6, 0x79, 0x2f, 0x8b, 0xfe, 0x2e, 0xfa, 0xa3, 0x55, 0x54, 0x88, 0xf9, 0x55, 0x72,
+	0xe2, 0x66, 0x6f, 0x8f, 0x32, 0xf5, 0x6e, 0xf7, 0xee, 0xf4, 0xe6, 0x95, 0xef, 0x1f, 0x47, 0xdf,
+	0xf6, 0x28, 0x2e, 0xe5, 0x75, 0xbf, 0x19, 0x9a, 0x1d, 0xb8, 0x05, 0xb7, 0xe6, 0xb7, 0xfa, 0xc1,
+	0x44, 0x76, 0x39, 0xa1, 0x2a, 0x3d, 0xee, 0x32, 0x04, 0xaa, 0x7c, 0xa7, 0x37, 0x4f, 0x4c, 0x23,
+	0xd2, 0xb7, 0xac, 0xed, 0x1e, 0xc6, 0xfc, 0xba, 0xde, 0xed, 0xaf, 0xa0, 0xdc, 0x5f, 0x45, 0xef,
+	0x7a, 0x18, 0xa7, 0xf8, 0x7f, 0xc1, 0xae, 0x26, 0x4c, 0x8d, 0xbd, 0x6a, 0x8e, 0xfb, 0xe2, 0xa1,
+	0xf8, 0xc5, 0x9d, 0x42, 0xbb, 0xe2, 0x17, 0x74, 0x1a, 0xfd, 0x60, 0x35, 0x25, 0x95, 0x96, 0x7f,
+	0x58, 0x8b, 0xae, 0x93, 0x69, 0x51, 0xed, 0xe0, 0xa3, 0xbe, 0x96, 0x41, 0x7b, 0xf8, 0x78, 0x65,
+	0x3d, 0x95, 0xa8, 0x7f, 0x5e, 0x8b, 0x6e, 0x04, 0x12, 0x25, 0x1b, 0xc8, 0x0a, 0xd6, 0xfd, 0x86,
+	0xf2, 0xc9, 0xea, 0x8a, 0xd4, 0x74, 0xef, 0xe2, 0xe3, 0xf6, 0x63, 0x7d, 0x01, 0xdb, 0x63, 0xfa,
+	0xb1, 0xbe, 0x6e, 0x2d, 0xb8, 0xc7, 0x94, 0x9c, 0xe9, 0x35, 0x1f, 0xba, 0xc7, 0xc4, 0xc5, 0xe1,
+	0xe7, 0x79, 0x30, 0x0e, 0x73, 0xf2, 0xf4, 0x6d, 0x99, 0xe4, 0x53, 0xda, 0x89, 0x94, 0x77, 0x3b,
+	0x31, 0x1c, 0xdc, 0x9b, 0xe3, 0xd2, 0x51, 0xa1, 0xd7, 0x71, 0xf7, 0x29, 0x7d, 0x83, 0x04, 0xf7,
+	0xe6, 0x5a, 0x28, 0xe1, 0x4d, 0x45, 0x8d, 0x21, 0x6f, 0x20, 0x58, 0x7c, 0xd0, 0x07, 0x05, 0x2b,
+	0x04, 0xe3, 0xcd, 0x6c, 0xf9, 0x6f, 0x85, 0xac, 0xb4, 0xb6, 0xfd, 0xb7, 0x7b, 0xd2, 0x84, 0xdb,
+	0x31, 0x6b, 0x3e, 0x65, 0x09, 0x7f, 0x24, 0x2a, 0xe4, 0xd6, 0x50, 0xbd, 0xdc, 0xba, 0x34, 0xe6,
+	0x76, 0xaf, 0xc8, 0x16, 0xf3, 0x5c, 0x55, 0x26, 0xe9, 0xd6, 0xa5, 0xba, 0xdd, 0x02, 0x1a, 0xee,
+	0x4a, 0x5a, 0xb7, 0x22, 0xbc, 0x7c, 0x10, 0x36, 0xe3, 0x45, 0x95, 0x9b, 0xbd, 0x58, 0x3a, 0x9f,
+	0xaa, 0x19, 0x75, 0xe4, 0x13, 0xb4, 0xa4, 0xed, 0x9e, 0x34, 0xdc, 0x1e, 0x74, 0xdc, 0x9a, 0xf6,
+	0xb4, 0xd3, 0x61, 0xab, 0xd5, 0xa4, 0x76, 0xfb, 0x2b, 0xc0, 0xcd, 0x58, 0xd5, 0xaa, 0xf8, 0xd6,
+	0xcc, 0xb3, 0x34, 0xcb, 0x06, 0x9b, 0x81, 0x66, 0xa2, 0xa1, 0xe0, 0x66, 0x2c, 0x02, 0x13, 0x2d,
+	0x59, 0x6f, 0x5e, 0xe6, 0x83, 0x2e, 0x3b, 0x82, 0xea, 0xd5, 0x92, 0x5d, 0x1a, 0x6c, 0xa8, 0x39,
+	0x45, 0x6d, 0x72, 0x1b, 0x87, 0x0b, 0xae, 0x95, 0xe1, 0x9d, 0xde, 0x3c, 0x38, 0xed, 0x17, 0x94,
+	0x98, 0x59, 0xee, 0x50, 0x26, 0xbc, 0x99, 0xe4, 0x6e, 0x07, 0x05, 0x36, 0x25, 0x65, 0x37, 0x7a,
+	0x9d, 0x4e, 0x67, 0xac, 0x41, 0x0f, 0xaa, 0x5c, 0x20, 0x78, 0x50, 0x05, 0x40, 0x50, 0x75, 0xf2,
+	0xef, 0x66, 0x37, 0xf6, 0x70, 0x8a, 0x55, 0x9d, 0x52, 0x76, 0xa8, 0x50, 0xd5, 0xa1, 0x34, 0x18,
+	0x0d, 0x8c, 0x5b, 0xf5, 0xe8, 0xc8, 0x83, 0x90, 0x19, 0xf0, 0xf2, 0xc8, 0x66, 0x2f, 0x16, 0xcc,
+	0x28, 0xd6, 0x61, 0x3a, 0x4f, 0x1b, 0x6c, 0x46, 0x71, 0x6c, 0x70, 0x24, 0x34, 0xa3, 0xb4, 0x51,
+	0x2a, 0x7b, 0x3c, 0x46, 0x38, 0x9c, 0x86, 0xb3, 0x27, 0x99, 0x7e, 0xd9, 0x33, 0x6c, 0xeb, 0x5c,
+	0x35, 0x37, 0x4d, 0xa6, 0xb9, 0x50, 0x8b, 0x65, 0xa4, 0x6d, 0x3b, 0xbf, 0xe1, 0x61, 0xc1, 0xd0,
+	0xa8, 0x43, 0x29, 0xc0, 0xf3, 0x02, 0xfd, 0xab, 0x1f, 0x7c, 0x53, 0xb0, 0x2c, 0x59, 0x52, 0x25,
+	0xf9, 0x04, 0x5d, 0x9c, 0x9a, 0x5f, 0xf1, 0xf0, 0xc8, 0xd0, 0xe2, 0x94, 0xd4, 0x00, 0xa7, 0xf6,
+	0xfe, 0xd7, 0xde, 0x48, 0x57, 0xd0, 0x40, 0xec, 0x7f, 0xec, 0x7d, 0xbf, 0x07, 0x09, 0x4f, 0xed,
+	0x35, 0x60, 0xf6, 0xdd, 0xa5, 0xd3, 0x87, 0x01, 0x53, 0x3e, 0x1a, 0x5a, 0x08, 0xd3, 0x2a, 0xa0,
+	0x51, 0x3b, 0x7b, 0x8b, 0x9f, 0xb1, 0x25, 0xd6, 0xa8, 0xdd, 0x4d, 0xc2, 0xcf, 0xd8, 0x32, 0xd4,
+	0xa8, 0xdb, 0x28, 0x88, 0x33, 0xdd, 0x75, 0xd0, 0x7a, 0x40, 0xdf, 0x5d, 0xfa, 0x6c, 0x74, 0x72,
+	0xa0, 0xe7, 0xec, 0xa7, 0x57, 0xde, 0x31, 0x05, 0x92, 0xd0, 0xfd, 0xf4, 0x0a, 0x3f, 0xa5, 0xd8,
+	0xec, 0xc5, 0xc2, 0x1b, 0x01, 0x49, 0xc3, 0xde, 0xea, 0xa3, 0x7a, 0x24, 0xb9, 0x42, 0xde, 0x3a,
+	0xab, 0xbf, 0xd7, 0x0d, 0xda, 0xfb, 0xb7, 0xc7, 0x55, 0x31, 0x61, 0x75, 0xad, 0xde, 0xfa, 0xf5,
+	0x2f, 0x38, 0x29, 0x59, 0x0c, 0x5e, 0xfa, 0xbd, 0x13, 0x86, 0x9c, 0x07, 0x3a, 0xa5, 0xc8, 0xbe,
+	0xed, 0xb5, 0x8e, 0x6a, 0xb6, 0x9f, 0xf5, 0xda, 0xe8, 0xe4, 0x6c, 0xf7, 0x52, 0x52, 0xf7, 0x31,
+	0xaf, 0x7b, 0xa8, 0x3a, 0xf6, 0x8e, 0xd7, 0xfd, 0x1e, 0xa4, 0x72, 0xf5, 0x69, 0xf4, 0xf5, 0xe7,
+	0xc5, 0x6c, 0xcc, 0xf2, 0xe9, 0xe0, 0xfb, 0x9e, 0xd6, 0xf3, 0x62, 0x16, 0xf3, 0x3f, 0x1b, 0xa3,
+	0xd7, 0x28, 0xb1, 0xbd, 0x83, 0xb8, 0xcf, 0xce, 0x16, 0xb3, 0x71, 0x93, 0x34, 0xe0, 0x0e, 0xa2,
+	0xf8, 0x7b, 0xcc, 0x05, 0xc4, 0x1d, 0x44, 0x0f, 0x00, 0xf6, 0x4e, 0x2a, 0xc6, 0x50, 0x7b, 0x5c,
+	0x10, 0xb4, 0xa7, 0x00, 0x1b, 0x45, 0x18, 0x7b, 0x3c, 0x50, 0x87, 0x77, 0x06, 0xad, 0x8e, 0x90,
+	0x12, 0x51, 0x44, 0x9b, 0xb2, 0x8d, 0x5b, 0x66, 0x5f, 0xbc, 0xad, 0xb4, 0x98, 0xcf, 0x93, 0x6a,
+	0x09, 0x1a, 0xb7, 0xca, 0xa5, 0x03, 0x10, 0x8d, 0x1b, 0x05, 0x6d, 0xaf, 0xd5, 0xc5, 0x3c, 0xb9,
+	0x3c, 0x28, 0xaa, 0x62, 0xd1, 0xa4, 0x39, 0x83, 0xef, 0xeb, 0x98, 0x02, 0x75, 0x19, 0xa2, 0xd7,
+	0x52, 0xac, 0x8d, 0x72, 0x05, 0x21, 0xaf, 0x33, 0x8a, 0x1f, 0x55, 0x90, 0x1f, 0x2e, 0x60, 0x56,
+	0x20, 0x44, 0x44, 0xb9, 0x24, 0x0c, 0xea, 0xfe, 0x98, 0x3f, 0xa3, 0x8d, 0xd5, 0xfd, 0xb1, 0xfb,
+	0x7e, 0xf6, 0x0d, 0x1a, 0xb0, 0x1d, 0x4a, 0x16, 0x9a, 0xec, 0x00, 0xea, 0xeb, 0x75, 0xb4, 0xd0,
+	0x5d, 0x82, 0xe8, 0x50, 0x38, 0x09, 0x5c, 0xbd, 0x2c, 0x59, 0xce, 0xa6, 0xfa, 0xd2, 0x1e, 0xe6,
+	0xca, 0x23, 0x82, 0xae, 0x20, 0x69, 0xc7, 0x22, 0x21, 0x1f, 0x2d, 0xf2, 0xe3, 0xaa, 0x38, 0x4f,
+	0x33, 0x56, 0x81, 0xb1, 0x48, 0xaa, 0x3b, 0x72, 0x62, 0x2c, 0xc2, 0x38, 0x7b, 0xfb, 0x43, 0x48,
+	0xbd, 0x5f, 0x06, 0x39, 0xa9, 0x92, 0x09, 0xbc, 0xfd, 0x21, 0x6d, 0xb4, 0x31, 0x62, 0x67, 0x30,
+	0x80, 0x3b, 0x81, 0x8e, 0x74, 0x9d, 0x2f, 0x45, 0xfb, 0x50, 0x5f, 0x4f, 0x8b, 0x57, 0xa5, 0x6b,
+	0x10, 0xe8, 0x28, 0x73, 0x18, 0x49, 0x04, 0x3a, 0x61, 0x0d, 0x3b, 0x95, 0x08, 0xee, 0x85, 0xba,
+	0xd5, 0x04, 0xa6, 0x12, 0x69, 0x43, 0x0b, 0x89, 0xa9, 0xa4, 0x05, 0x81, 0x01, 0x49, 0x77, 0x83,
+	0x19, 0x3a, 0x20, 0x19, 0x69, 0x70, 0x40, 0x72, 0x29, 0x3b, 0x50, 0x1c, 0xe6, 0x69, 0x93, 0x26,
+	0x19, 0x3f, 0xab, 0x4d, 0xaa, 0x64, 0xce, 0x1a, 0x56, 0xc1, 0x81, 0x42, 0x21, 0xb1, 0xc7, 0x10,
+	0x03, 0x05, 0xc5, 0x2a, 0x87, 0xbf, 0x15, 0xbd, 0xc3, 0xe7, 0x7d, 0x96, 0xab, 0xdf, 0x34, 0x7b,
+	0x2a, 0x7e, 0x91, 0x72, 0xf0, 0x9e, 0xb1, 0x31, 0x6e, 0x2a, 0x96, 0xcc, 0xb5, 0xed, 0x6f, 0x99,
+	0xbf, 0x0b, 0x70, 0x77, 0x8d, 0xb7, 0x67, 0xfe, 0x44, 0xcd, 0x79, 0x3a, 0x31, 0x1f, 0x30, 0x81,
+	0xf6, 0xec, 0x8a, 0xe3, 0xc0, 0xeb, 0x3b, 0x18, 0x67, 0xc7, 0x69, 0x57, 0x3a, 0x62, 0x65, 0x06,
+	0xc7, 0x69, 0x4f, 0x5b, 0x00, 0xc4, 0x38, 0x8d, 0x82, 0xb6, 0x73, 0xba, 0xe2, 0x13, 0x16, 0xce,
+	0xcc, 0x09, 0xeb, 0x97, 0x99, 0x13, 0xef, 0x9b, 0x90, 0x34, 0x1a, 0xb8, 0xd2, 0x71, 0x5e, 0x14,
+	0x3f, 0x81, 0x81, 0x82, 0xa7, 0x2e, 0x09, 0x62, 0xb0, 0xc1, 0x49, 0xe7, 0x98, 0xd9, 0x05, 0x9e,
+	0xbe, 0x2d, 0xd3, 0x6a, 0x79, 0x5c, 0x64, 0xe9, 0x84, 0x7f, 0x78, 0x0c, 0x8e, 0x99, 0x3d, 0x53,
+	0x2e, 0x1a, 0x1f, 0x38, 0x6b, 0xe7, 0xc7, 0x2b, 0xe9, 0xf4, 0x48, 0xc8, 0x78, 0x85, 0x84, 0x8c,
+	0xbf, 0x42, 0x42, 0xbc, 0x73, 0xff, 0x3c, 0x7a, 0xc7, 0x6b, 0x49, 0x0b, 0x71, 0x7d, 0x0b, 0xf4,
+	0x2f, 0xbf, 0x89, 0x2c, 0x32, 0xe6, 0xf9, 0xdd, 0xec, 0xc5, 0xda, 0xf3, 0x30, 0xe8, 0x4f, 0x74,
+	0x91, 0x2e, 0x23, 0x5e, 0x3f, 0xd9, 0xea, 0x07, 0xdb, 0x03, 0x58, 0xe8, 0x52, 0x1d, 0x83, 0x6c,
+	0x77, 0xd8, 0x01, 0xa7, 0x1f, 0x71, 0x5f, 0xdc, 0x2e, 0xb7, 0x8e, 0xd8, 0xfc, 0x8c, 0x55, 0xf5,
+	0x45, 0x5a, 0x52, 0x4f, 0xba, 0x5b, 0xa2, 0xf3, 0x49, 0x77, 0x02, 0xb5, 0x21, 0x8e, 0x05, 0x0e,
+	0x6b, 0x7e, 0x97, 0x4c, 0x3c, 0x92, 0x05, 0x8a, 0xd6, 0x31, 0xe2, 0x40, 0x44, 0xd1, 0x92, 0xb0,
+	0xf3, 0xdd, 0xa4, 0x65, 0x46, 0x6c, 0xc6, 0x87, 0xce, 0xea, 0x38, 0x59, 0xce, 0x59, 0xde, 0x28,
+	0x93, 0xe0, 0xb0, 0xc9, 0x31, 0x89, 0xf3, 0xc4, 0x61, 0x53, 0x1f, 0x3d, 0x67, 0xce, 0xf5, 0x0a,
+	0xfe, 0xb8, 0xa8, 0x1a, 0xf9, 0x2b, 0x9c, 0xfc, 0x09, 0xf3, 0xdd, 0x40, 0xa1, 0x7a, 0x24, 0x31,
+	0xe7, 0x86, 0x35, 0x9c, 0x9f, 0x5d, 0xf2, 0xd2, 0xf0, 0x8a, 0x55, 0xb6, 0xb7, 0xcf, 0x93, 0x34,
+	0x53, 0xad, 0xe1, 0x07, 0x01, 0xdb, 0x84, 0x0e, 0xf1, 0xb3, 0x4b, 0x7d, 0x75, 0x9d, 0x1f, 0xaa,
+	0x0a, 0xa7, 0x10, 0x9c, 0x7d, 0x75, 0xd8, 0x27, 0xce, 0xbe, 0xba, 0xb5, 0xec, 0x96, 0x94, 0x65,
+	0x05, 0xb7, 0x14, 0xc4, 0x5e, 0x31, 0x85, 0x1b, 0xe1, 0x8e, 0x4d, 0x00, 0x12, 0x5b, 0x52, 0x41,
+	0x05, 0x3b, 0x0d, 0x59, 0xec, 0x59, 0x9a, 0x27, 0x59, 0xda, 0x9a, 0x86, 0x1c, 0x3b, 0x9a, 0x20,
+	0xa6, 0x21, 0x9c, 0xc4, 0x5c, 0x1d, 0xb0, 0xe6, 0x24, 0xe5, 0x31, 0xcd, 0xbd, 0x40, 0xb9, 0x09,
+	0xa2, 0xdb, 0x95, 0x43, 0x3a, 0x4f, 0xac, 0xc3, 0x62, 0xe5, 0xbf, 0x3e, 0xcd, 0xc3, 0xc5, 0x11,
+	0x9b, 0xb0, 0xb4, 0x6c, 0x06, 0x1f, 0x86, 0xcb, 0x0a, 0xe0, 0xc4, 0x0d, 0xa2, 0x1e, 0x6a, 0xd8,
+	0x40, 0xc5, 0xeb, 0xe0, 0x40, 0xfd, 0x90, 0x25, 0x39, 0x50, 0x39, 0x50, 0xf7, 0x40, 0xe5, 0xc3,
+	0x36, 0x8e, 0xf4, 0x7d, 0x8e, 0xd8, 0x94, 0xb1, 0xf9, 0xe0, 0x41, 0xc8, 0x8a, 0x64, 0x88, 0x79,
+	0x8e, 0x62, 0x9d, 0xcb, 0x37, 0x7c, 0xc0, 0x1c, 0xcb, 0x5f, 0x43, 0x3f, 0xad, 0x59, 0xa5, 0x96,
+	0x09, 0x3c, 0xce, 0xd8, 0x05, 0x9f, 0x84, 0x1b, 0x2e, 0x76, 0x40, 0x2f, 0xca, 0x78, 0xb8, 0x82,
+	0x86, 0xdd, 0xaa, 0x77, 0x38, 0xf5, 0xd8, 0x0b, 0xff, 0xcb, 0x60, 0x8b, 0x34, 0xe6, 0x50, 0xc4,
+	0x56, 0x3d, 0x4d, 0xdb, 0xb5, 0x56, 0xdb, 0xed, 0x30, 0x5f, 0x1e, 0xc2, 0x0b, 0x4f, 0x88, 0x25,
+	0x81, 0x51, 0xf3, 0x2d, 0x8d, 0x3b, 0x47, 0x59, 0x55, 0x91, 0x4c, 0x27, 0x49, 0xdd, 0x1c, 0x27,
+	0x4b, 0x7e, 0xa1, 0x59, 0x44, 0xe5, 0xf0, 0x28, 0x4b, 0x33, 0xb1, 0x0b, 0x51, 0x47, 0x59, 0x14,
+	0xec, 0xae, 0xad, 0x78, 0x9a, 0xf4, 0x45, 0x70, 0xb8, 0xb6, 0xe2, 0xb2, 0xd6, 0x25, 0xf0, 0x3b,
+	0x61, 0xc8, 0x7e, 0xc0, 0x2a, 0x45, 0x22, 0x42, 0xba, 0x81, 0xe9, 0x78, 0x61, 0xd1, 0xcd, 0x00,
+	0x61, 0xdf, 0xd1, 0x92, 0x7f, 0xd7, 0x3f, 0x29, 0xd9, 0xa8, 0x5f, 0xdb, 0xd8, 0xc2, 0x74, 0x5d,
+	0xc8, 0x0b, 0xf9, 0xb6, 0x7b, 0xd2, 0x76, 0x91, 0xb8, 0x77, 0x91, 0xf0, 0x7b, 0x4f, 0x47, 0xac,
+	0x46, 0x9e, 0x04, 0xe1, 0xc2, 0xd8, 0x4a, 0x89, 0x45, 0x62, 0x9b, 0xb2, 0x0d, 0x9d, 0xcb, 0x9e,
+	0x4e, 0xd3, 0x46, 0xc9, 0xf4, 0xe7, 0x15, 0x5b, 0x6d, 0x03, 0x6d, 0x8a, 0xc8, 0x15, 0x4d, 0xdb,
+	0x09, 0x8b, 0x33, 0x27, 0xc5, 0x6c, 0x96, 0x31, 0x05, 0x8d, 0x58, 0x22, 0x1f, 0x1b, 0xde, 0x69,
+	0xdb, 0x42, 0x41, 0x62, 0xc2, 0x0a, 0x2a, 0xd8, 0x45, 0x20, 0xc7, 0xe4, 0x81, 0xb2, 0x2e, 0xd8,
+	0x8d, 0xb6, 0x19, 0x0f, 0x20, 0x16, 0x81, 0x28, 0x68, 0x3f, 0x9a, 0xe5, 0xe2, 0x03, 0xa6, 0x4b,
+	0x02, 0x3e, 0x99, 0x28, 0x94, 0x1d, 0x31, 0xf1, 0xd1, 0x2c, 0x82, 0xd9, 0xd1, 0x19, 0x78, 0x78,
+	0xb2, 0xe4, 0xbf, 0x6e, 0xf1, 0x20, 0xa8, 0x2f, 0x18, 0x62, 0x74, 0xa6, 0x58, 0xbf, 0xea, 0xcc,
+	0xae, 0xf5, 0xf3, 0xa4, 0xb6, 0x99, 0x43, 0xaa, 0x0e, 0x05, 0x43, 0x55, 0x47, 0x29, 0xf8, 0x45,
+	0xea, 0x6e, 0x8c, 0x23, 0x45, 0x8a, 0xed, 0x8a, 0xaf, 0x77, 0x61, 0x76, 0xe5, 0xce, 0x85, 0x23,
+	0x96, 0x4c, 0x4d, 0xc6, 0x10, 0x5d, 0x57, 0x4e, 0xac, 0xdc, 0x31, 0x4e, 0x39, 0xf9, 0xdd, 0x68,
+	0x20, 0xb3, 0x51, 0xb9, 0x6e, 0x6e, 0x60, 0x49, 0xe4, 0x04, 0x31, 0x50, 0xf9, 0x84, 0x13, 0x9d,
+	0x7a, 0x55, 0x74, 0x52, 0x28, 0x07, 0xea, 0xa3, 0xee, 0x1a, 0x44, 0xa7, 0x7e, 0xb1, 0xb7, 0x68,
+	0x22, 0x3a, 0xed, 0xd6, 0x72, 0x5e, 0x8f, 0x03, 0x55, 0xc6, 0x2f, 0xfd, 0xc2, 0x34, 0x7d, 0x12,
+	0xac, 0x1e, 0x44, 0x83, 0x78, 0x3d, 0xae, 0x9f, 0x26, 0xfc, 0xe5, 0x2d, 0x35, 0xc8, 0xe2, 0xbf,
+	0xbc, 0xa5, 0x84, 0xe1, 0x5f, 0xde, 0xb2, 0x90, 0x7d, 0x45, 0x40, 0xb7, 0x23, 0xfe, 0x48, 0xcb,
+	0x4d, 0xbc, 0x69, 0xb8, 0xcf, 0xb3, 0xdc, 0x0a, 0x21, 0x36, 0x00, 0x16, 0x95, 0x2b, 0x1e, 0x3f,
+	0x31, 0x0d, 0x07, 0x19, 0x92, 0x7c, 0x82, 0x08, 0x80, 0x71, 0xd2, 0xf9, 0x2d, 0xf0, 0xc3, 0xd7,
+	0x55, 0xca, 0xaf, 0x66, 0x9f, 0x14, 0x45, 0x06, 0x4f, 0x4c, 0x86, 0x87, 0xb1, 0x2b, 0xa5, 0x7e,
+	0x0b, 0xbc, 0x45, 0xd9, 0x39, 0x7a, 0x78, 0x38, 0x5c, 0x34, 0x7c, 0xc7, 0x39, 0x03, 0x4d, 0x7f,
+	0x78, 0x18, 0x6b, 0x09, 0xd1, 0xf4, 0x7d, 0xc2, 0x56, 0xe7, 0xf0, 0x50, 0x1c, 0x3e, 0xaa, 0x03,
+	0x98, 0xdb, 0x50, 0xc7, 0x11, 0x52, 0xbf, 0x60, 0x0d, 0x21, 0xe7, 0x17, 0xb9, 0x0f, 0xb1, 0xdf,
+	0xf5, 0xda, 0x84, 0xea, 0x08, 0x44, 0xfd, 0x22, 0x37, 0x05, 0x3b, 0x4f, 0x22, 0x1c, 0x2f, 0xea,
+	0x0b, 0x7f, 0xc7, 0x52, 0x2e, 0xe1, 0xe5, 0x43, 0xe1, 0x8f, 0xc1, 0x2f, 0xd7, 0xf9, 0x6c, 0xec,
+	0xc1, 0xc4, 0xed, 0xd8, 0x4e, 0x25, 0xe7, 0x41, 0x57, 0xc8, 0xf2, 0x43, 0x5e, 0xf1, 0x6b, 0x9a,
+	0x7c, 0xa5, 0xf9, 0x28, 0x6c, 0xd6, 0x65, 0x89, 0x9d, 0xb7, 0x2e, 0x1d, 0x99, 0x92, 0x27, 0x37,
+	0xff, 0xeb, 0x8b, 0x6b, 0x6b, 0x3f, 0xfb, 0xe2, 0xda, 0xda, 0xff, 0x7e, 0x71, 0x6d, 0xed, 0xa7,
+	0x5f, 0x5e, 0xfb, 0xda, 0xcf, 0xbe, 0xbc, 0xf6, 0xb5, 0xff, 0xfe, 0xf2, 0xda, 0xd7, 0x3e, 0xff,
+	0x7a, 0x2d, 0xc3, 0xdc, 0xb3, 0x9f, 0x2f, 0xab, 0xa2, 0x29, 0x1e, 0xff, 0xdf, 0x00, 0x6c, 0x5e,
+	0xc0, 0xad, 0x66, 0x89, 0x00, 0x00,
 }
 
 // This is a compile-time assertion to ensure that this generated file
@@ -561,6 +573,7 @@ type ClientCommandsHandler interface {
 	HistoryGetVersions(context.Context, *pb.RpcHistoryGetVersionsRequest) *pb.RpcHistoryGetVersionsResponse
 	HistorySetVersion(context.Context, *pb.RpcHistorySetVersionRequest) *pb.RpcHistorySetVersionResponse
 	HistoryDiffVersions(context.Context, *pb.RpcHistoryDiffVersionsRequest) *pb.RpcHistoryDiffVersionsResponse
+	HistoryMergeVersion(context.Context, *pb.RpcHistoryMergeVersionRequest) *pb.RpcHistoryMergeVersionResponse
 	// Files
 	// ***
 	FileSpaceOffload(context.Context, *pb.RpcFileSpaceOffloadRequest) *pb.RpcFileSpaceOffloadResponse
@@ -571,6 +584,10 @@ type ClientCommandsHandler interface {
 	FileDrop(context.Context, *pb.RpcFileDropRequest) *pb.RpcFileDropResponse
 	FileSpaceUsage(context.Context, *pb.RpcFileSpaceUsageRequest) *pb.RpcFileSpaceUsageResponse
 	FileNodeUsage(context.Context, *pb.RpcFileNodeUsageRequest) *pb.RpcFileNodeUsageResponse
+	FileListDuplicates(context.Context, *pb.RpcFileListDuplicatesRequest) *pb.RpcFileListDuplicatesResponse
+	FileMergeDuplicates(context.Context, *pb.RpcFileMergeDuplicatesRequest) *pb.RpcFileMergeDuplicatesResponse
+	BackupRun(context.Context, *pb.RpcBackupRunRequest) *pb.RpcBackupRunResponse
+	BackupRestore(context.Context, *pb.RpcBackupRestoreRequest) *pb.RpcBackupRestoreResponse
 	NavigationListObjects(context.Context, *pb.RpcNavigationListObjectsRequest) *pb.RpcNavigationListObjectsResponse
 	NavigationGetObjectInfoWithLinks(context.Context, *pb.RpcNavigationGetObjectInfoWithLinksRequest) *pb.RpcNavigationGetObjectInfoWithLinksResponse
 	TemplateCreateFromObject(context.Context, *pb.RpcTemplateCreateFromObjectRequest) *pb.RpcTemplateCreateFromObjectResponse
@@ -711,6 +728,12 @@ type ClientCommandsHandler interface {
 	NotificationList(context.Context, *pb.RpcNotificationListRequest) *pb.RpcNotificationListResponse
 	NotificationReply(context.Context, *pb.RpcNotificationReplyRequest) *pb.RpcNotificationReplyResponse
 	NotificationTest(context.Context, *pb.RpcNotificationTestRequest) *pb.RpcNotificationTestResponse
+	NotificationSnooze(context.Context, *pb.RpcNotificationSnoozeRequest) *pb.RpcNotificationSnoozeResponse
+	NotificationExpiryPolicyGet(context.Context, *pb.RpcNotificationExpiryPolicyGetRequest) *pb.RpcNotificationExpiryPolicyGetResponse
+	NotificationExpiryPolicySet(context.Context, *pb.RpcNotificationExpiryPolicySetRequest) *pb.RpcNotificationExpiryPolicySetResponse
+	NotificationRuleSet(context.Context, *pb.RpcNotificationRuleSetRequest) *pb.RpcNotificationRuleSetResponse
+	NotificationRuleList(context.Context, *pb.RpcNotificationRuleListRequest) *pb.RpcNotificationRuleListResponse
+	NotificationRuleRemove(context.Context, *pb.RpcNotificationRuleRemoveRequest) *pb.RpcNotificationRuleRemoveResponse
 	// Membership
 	// ***
 	// Get current subscription status (tier, expiration date, etc.)
@@ -764,6 +787,7 @@ type ClientCommandsHandler interface {
 	ChatUnsubscribeFromMessagePreviews(context.Context, *pb.RpcChatUnsubscribeFromMessagePreviewsRequest) *pb.RpcChatUnsubscribeFromMessagePreviewsResponse
 	ObjectChatAdd(context.Context, *pb.RpcObjectChatAddRequest) *pb.RpcObjectChatAddResponse
 	ChatReadAll(context.Context, *pb.RpcChatReadAllRequest) *pb.RpcChatReadAllResponse
+	ChatSearchMessages(context.Context, *pb.RpcChatSearchMessagesRequest) *pb.RpcChatSearchMessagesResponse
 	// mock AI RPCs for compatibility between branches. Not implemented in main
 	AIWritingTools(context.Context, *pb.RpcAIWritingToolsRequest) *pb.RpcAIWritingToolsResponse
 	AIAutofill(context.Context, *pb.RpcAIAutofillRequest) *pb.RpcAIAutofillResponse
@@ -3418,6 +3442,26 @@ func HistoryDiffVersions(b []byte) (resp []byte) {
 	return resp
 }
 
+func HistoryMergeVersion(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcHistoryMergeVersionResponse{Error: &pb.RpcHistoryMergeVersionResponseError{Code: pb.RpcHistoryMergeVersionResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcHistoryMergeVersionRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcHistoryMergeVersionResponse{Error: &pb.RpcHistoryMergeVersionResponseError{Code: pb.RpcHistoryMergeVersionResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.HistoryMergeVersion(context.Background(), in).Marshal()
+	return resp
+}
+
 func FileSpaceOffload(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -3578,6 +3622,86 @@ func FileNodeUsage(b []byte) (resp []byte) {
 	return resp
 }
 
+func FileListDuplicates(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcFileListDuplicatesResponse{Error: &pb.RpcFileListDuplicatesResponseError{Code: pb.RpcFileListDuplicatesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcFileListDuplicatesRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcFileListDuplicatesResponse{Error: &pb.RpcFileListDuplicatesResponseError{Code: pb.RpcFileListDuplicatesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.FileListDuplicates(context.Background(), in).Marshal()
+	return resp
+}
+
+func FileMergeDuplicates(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcFileMergeDuplicatesResponse{Error: &pb.RpcFileMergeDuplicatesResponseError{Code: pb.RpcFileMergeDuplicatesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcFileMergeDuplicatesRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcFileMergeDuplicatesResponse{Error: &pb.RpcFileMergeDuplicatesResponseError{Code: pb.RpcFileMergeDuplicatesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.FileMergeDuplicates(context.Background(), in).Marshal()
+	return resp
+}
+
+func BackupRun(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcBackupRunResponse{Error: &pb.RpcBackupRunResponseError{Code: pb.RpcBackupRunResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcBackupRunRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcBackupRunResponse{Error: &pb.RpcBackupRunResponseError{Code: pb.RpcBackupRunResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.BackupRun(context.Background(), in).Marshal()
+	return resp
+}
+
+func BackupRestore(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcBackupRestoreResponse{Error: &pb.RpcBackupRestoreResponseError{Code: pb.RpcBackupRestoreResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcBackupRestoreRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcBackupRestoreResponse{Error: &pb.RpcBackupRestoreResponseError{Code: pb.RpcBackupRestoreResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.BackupRestore(context.Background(), in).Marshal()
+	return resp
+}
+
 func NavigationListObjects(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -6018,6 +6142,126 @@ func NotificationTest(b []byte) (resp []byte) {
 	return resp
 }
 
+func NotificationSnooze(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcNotificationSnoozeResponse{Error: &pb.RpcNotificationSnoozeResponseError{Code: pb.RpcNotificationSnoozeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcNotificationSnoozeRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcNotificationSnoozeResponse{Error: &pb.RpcNotificationSnoozeResponseError{Code: pb.RpcNotificationSnoozeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.NotificationSnooze(context.Background(), in).Marshal()
+	return resp
+}
+
+func NotificationExpiryPolicyGet(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcNotificationExpiryPolicyGetResponse{Error: &pb.RpcNotificationExpiryPolicyGetResponseError{Code: pb.RpcNotificationExpiryPolicyGetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcNotificationExpiryPolicyGetRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcNotificationExpiryPolicyGetResponse{Error: &pb.RpcNotificationExpiryPolicyGetResponseError{Code: pb.RpcNotificationExpiryPolicyGetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.NotificationExpiryPolicyGet(context.Background(), in).Marshal()
+	return resp
+}
+
+func NotificationExpiryPolicySet(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcNotificationExpiryPolicySetResponse{Error: &pb.RpcNotificationExpiryPolicySetResponseError{Code: pb.RpcNotificationExpiryPolicySetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcNotificationExpiryPolicySetRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcNotificationExpiryPolicySetResponse{Error: &pb.RpcNotificationExpiryPolicySetResponseError{Code: pb.RpcNotificationExpiryPolicySetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.NotificationExpiryPolicySet(context.Background(), in).Marshal()
+	return resp
+}
+
+func NotificationRuleSet(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcNotificationRuleSetResponse{Error: &pb.RpcNotificationRuleSetResponseError{Code: pb.RpcNotificationRuleSetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcNotificationRuleSetRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcNotificationRuleSetResponse{Error: &pb.RpcNotificationRuleSetResponseError{Code: pb.RpcNotificationRuleSetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.NotificationRuleSet(context.Background(), in).Marshal()
+	return resp
+}
+
+func NotificationRuleList(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcNotificationRuleListResponse{Error: &pb.RpcNotificationRuleListResponseError{Code: pb.RpcNotificationRuleListResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcNotificationRuleListRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcNotificationRuleListResponse{Error: &pb.RpcNotificationRuleListResponseError{Code: pb.RpcNotificationRuleListResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.NotificationRuleList(context.Background(), in).Marshal()
+	return resp
+}
+
+func NotificationRuleRemove(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcNotificationRuleRemoveResponse{Error: &pb.RpcNotificationRuleRemoveResponseError{Code: pb.RpcNotificationRuleRemoveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcNotificationRuleRemoveRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcNotificationRuleRemoveResponse{Error: &pb.RpcNotificationRuleRemoveResponseError{Code: pb.RpcNotificationRuleRemoveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.NotificationRuleRemove(context.Background(), in).Marshal()
+	return resp
+}
+
 func MembershipGetStatus(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -6678,6 +6922,26 @@ func ChatReadAll(b []byte) (resp []byte) {
 	return resp
 }
 
+func ChatSearchMessages(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcChatSearchMessagesResponse{Error: &pb.RpcChatSearchMessagesResponseError{Code: pb.RpcChatSearchMessagesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcChatSearchMessagesRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcChatSearchMessagesResponse{Error: &pb.RpcChatSearchMessagesResponseError{Code: pb.RpcChatSearchMessagesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ChatSearchMessages(context.Background(), in).Marshal()
+	return resp
+}
+
 func AIWritingTools(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -7068,6 +7332,8 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = HistorySetVersion(data)
 		case "HistoryDiffVersions":
 			cd = HistoryDiffVersions(data)
+		case "HistoryMergeVersion":
+			cd = HistoryMergeVersion(data)
 		case "FileSpaceOffload":
 			cd = FileSpaceOffload(data)
 		case "FileReconcile":
@@ -7084,6 +7350,14 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = FileSpaceUsage(data)
 		case "FileNodeUsage":
 			cd = FileNodeUsage(data)
+		case "FileListDuplicates":
+			cd = FileListDuplicates(data)
+		case "FileMergeDuplicates":
+			cd = FileMergeDuplicates(data)
+		case "BackupRun":
+			cd = BackupRun(data)
+		case "BackupRestore":
+			cd = BackupRestore(data)
 		case "NavigationListObjects":
 			cd = NavigationListObjects(data)
 		case "NavigationGetObjectInfoWithLinks":
@@ -7328,6 +7602,18 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = NotificationReply(data)
 		case "NotificationTest":
 			cd = NotificationTest(data)
+		case "NotificationSnooze":
+			cd = NotificationSnooze(data)
+		case "NotificationExpiryPolicyGet":
+			cd = NotificationExpiryPolicyGet(data)
+		case "NotificationExpiryPolicySet":
+			cd = NotificationExpiryPolicySet(data)
+		case "NotificationRuleSet":
+			cd = NotificationRuleSet(data)
+		case "NotificationRuleList":
+			cd = NotificationRuleList(data)
+		case "NotificationRuleRemove":
+			cd = NotificationRuleRemove(data)
 		case "MembershipGetStatus":
 			cd = MembershipGetStatus(data)
 		case "MembershipIsNameValid":
@@ -7394,6 +7680,8 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = ObjectChatAdd(data)
 		case "ChatReadAll":
 			cd = ChatReadAll(data)
+		case "ChatSearchMessages":
+			cd = ChatSearchMessages(data)
 		case "AIWritingTools":
 			cd = AIWritingTools(data)
 		case "AIAutofill":
@@ -9276,6 +9564,20 @@ func (h *ClientCommandsHandlerProxy) HistoryDiffVersions(ctx context.Context, re
 	call, _ := actualCall(ctx, req)
 	return call.(*pb.RpcHistoryDiffVersionsResponse)
 }
+func (h *ClientCommandsHandlerProxy) HistoryMergeVersion(ctx context.Context, req *pb.RpcHistoryMergeVersionRequest) *pb.RpcHistoryMergeVersionResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.HistoryMergeVersion(ctx, req.(*pb.RpcHistoryMergeVersionRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "HistoryMergeVersion", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcHistoryMergeVersionResponse)
+}
 func (h *ClientCommandsHandlerProxy) FileSpaceOffload(ctx context.Context, req *pb.RpcFileSpaceOffloadRequest) *pb.RpcFileSpaceOffloadResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
 		return h.client.FileSpaceOffload(ctx, req.(*pb.RpcFileSpaceOffloadRequest)), nil
@@ -9388,6 +9690,62 @@ func (h *ClientCommandsHandlerProxy) FileNodeUsage(ctx context.Context, req *pb.
 	call, _ := actualCall(ctx, req)
 	return call.(*pb.RpcFileNodeUsageResponse)
 }
+func (h *ClientCommandsHandlerProxy) FileListDuplicates(ctx context.Context, req *pb.RpcFileListDuplicatesRequest) *pb.RpcFileListDuplicatesResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.FileListDuplicates(ctx, req.(*pb.RpcFileListDuplicatesRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "FileListDuplicates", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcFileListDuplicatesResponse)
+}
+func (h *ClientCommandsHandlerProxy) FileMergeDuplicates(ctx context.Context, req *pb.RpcFileMergeDuplicatesRequest) *pb.RpcFileMergeDuplicatesResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.FileMergeDuplicates(ctx, req.(*pb.RpcFileMergeDuplicatesRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "FileMergeDuplicates", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcFileMergeDuplicatesResponse)
+}
+func (h *ClientCommandsHandlerProxy) BackupRun(ctx context.Context, req *pb.RpcBackupRunRequest) *pb.RpcBackupRunResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.BackupRun(ctx, req.(*pb.RpcBackupRunRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "BackupRun", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcBackupRunResponse)
+}
+func (h *ClientCommandsHandlerProxy) BackupRestore(ctx context.Context, req *pb.RpcBackupRestoreRequest) *pb.RpcBackupRestoreResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.BackupRestore(ctx, req.(*pb.RpcBackupRestoreRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "BackupRestore", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcBackupRestoreResponse)
+}
 func (h *ClientCommandsHandlerProxy) NavigationListObjects(ctx context.Context, req *pb.RpcNavigationListObjectsRequest) *pb.RpcNavigationListObjectsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
 		return h.client.NavigationListObjects(ctx, req.(*pb.RpcNavigationListObjectsRequest)), nil
@@ -11096,6 +11454,90 @@ func (h *ClientCommandsHandlerProxy) NotificationTest(ctx context.Context, req *
 	call, _ := actualCall(ctx, req)
 	return call.(*pb.RpcNotificationTestResponse)
 }
+func (h *ClientCommandsHandlerProxy) NotificationSnooze(ctx context.Context, req *pb.RpcNotificationSnoozeRequest) *pb.RpcNotificationSnoozeResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.NotificationSnooze(ctx, req.(*pb.RpcNotificationSnoozeRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "NotificationSnooze", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcNotificationSnoozeResponse)
+}
+func (h *ClientCommandsHandlerProxy) NotificationExpiryPolicyGet(ctx context.Context, req *pb.RpcNotificationExpiryPolicyGetRequest) *pb.RpcNotificationExpiryPolicyGetResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.NotificationExpiryPolicyGet(ctx, req.(*pb.RpcNotificationExpiryPolicyGetRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "NotificationExpiryPolicyGet", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcNotificationExpiryPolicyGetResponse)
+}
+func (h *ClientCommandsHandlerProxy) NotificationExpiryPolicySet(ctx context.Context, req *pb.RpcNotificationExpiryPolicySetRequest) *pb.RpcNotificationExpiryPolicySetResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.NotificationExpiryPolicySet(ctx, req.(*pb.RpcNotificationExpiryPolicySetRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "NotificationExpiryPolicySet", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcNotificationExpiryPolicySetResponse)
+}
+func (h *ClientCommandsHandlerProxy) NotificationRuleSet(ctx context.Context, req *pb.RpcNotificationRuleSetRequest) *pb.RpcNotificationRuleSetResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.NotificationRuleSet(ctx, req.(*pb.RpcNotificationRuleSetRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "NotificationRuleSet", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcNotificationRuleSetResponse)
+}
+func (h *ClientCommandsHandlerProxy) NotificationRuleList(ctx context.Context, req *pb.RpcNotificationRuleListRequest) *pb.RpcNotificationRuleListResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.NotificationRuleList(ctx, req.(*pb.RpcNotificationRuleListRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "NotificationRuleList", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcNotificationRuleListResponse)
+}
+func (h *ClientCommandsHandlerProxy) NotificationRuleRemove(ctx context.Context, req *pb.RpcNotificationRuleRemoveRequest) *pb.RpcNotificationRuleRemoveResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.NotificationRuleRemove(ctx, req.(*pb.RpcNotificationRuleRemoveRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "NotificationRuleRemove", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcNotificationRuleRemoveResponse)
+}
 func (h *ClientCommandsHandlerProxy) MembershipGetStatus(ctx context.Context, req *pb.RpcMembershipGetStatusRequest) *pb.RpcMembershipGetStatusResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
 		return h.client.MembershipGetStatus(ctx, req.(*pb.RpcMembershipGetStatusRequest)), nil
@@ -11558,6 +12000,20 @@ func (h *ClientCommandsHandlerProxy) ChatReadAll(ctx context.Context, req *pb.Rp
 	call, _ := actualCall(ctx, req)
 	return call.(*pb.RpcChatReadAllResponse)
 }
+func (h *ClientCommandsHandlerProxy) ChatSearchMessages(ctx context.Context, req *pb.RpcChatSearchMessagesRequest) *pb.RpcChatSearchMessagesResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.ChatSearchMessages(ctx, req.(*pb.RpcChatSearchMessagesRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "ChatSearchMessages", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcChatSearchMessagesResponse)
+}
 func (h *ClientCommandsHandlerProxy) AIWritingTools(ctx context.Context, req *pb.RpcAIWritingToolsRequest) *pb.RpcAIWritingToolsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
 		return h.client.AIWritingTools(ctx, req.(*pb.RpcAIWritingToolsRequest)), nil
@@ -196,6 +196,7 @@ func (mw *Middleware) AccountConfigUpdate(_ context.Context, req *pb.RpcAccountC
 	code := mapErrorCode(err,
 		errToCode(application.ErrApplicationIsNotRunning, pb.RpcAccountConfigUpdateResponseError_ACCOUNT_IS_NOT_RUNNING),
 		errToCode(application.ErrFailedToWriteConfig, pb.RpcAccountConfigUpdateResponseError_FAILED_TO_WRITE_CONFIG),
+		errToCode(application.ErrBadInput, pb.RpcAccountConfigUpdateResponseError_BAD_INPUT),
 	)
 	return &pb.RpcAccountConfigUpdateResponse{
 		Error: &pb.RpcAccountConfigUpdateResponseError{
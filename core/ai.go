@@ -3,47 +3,89 @@ package core
 import (
 	"context"
 
+	"github.com/anyproto/anytype-heart/core/ai"
+	"github.com/anyproto/anytype-heart/core/ai/llm"
 	"github.com/anyproto/anytype-heart/pb"
 )
 
 func (mw *Middleware) AIWritingTools(ctx context.Context, req *pb.RpcAIWritingToolsRequest) *pb.RpcAIWritingToolsResponse {
-
-	r := &pb.RpcAIWritingToolsResponse{
+	aiService := mustService[ai.AI](mw)
+	text, err := aiService.WritingTools(ctx, req)
+	code := mapErrorCode(err,
+		errToCode(ai.ErrBadInput, pb.RpcAIWritingToolsResponseError_BAD_INPUT),
+		errToCode(ai.ErrProviderNotConfigured, pb.RpcAIWritingToolsResponseError_BAD_INPUT),
+		errToCode(ai.ErrLanguageNotSupported, pb.RpcAIWritingToolsResponseError_LANGUAGE_NOT_SUPPORTED),
+		errToCode(llm.ErrRateLimitExceeded, pb.RpcAIWritingToolsResponseError_RATE_LIMIT_EXCEEDED),
+		errToCode(llm.ErrEndpointNotReachable, pb.RpcAIWritingToolsResponseError_ENDPOINT_NOT_REACHABLE),
+		errToCode(llm.ErrModelNotFound, pb.RpcAIWritingToolsResponseError_MODEL_NOT_FOUND),
+		errToCode(llm.ErrAuthRequired, pb.RpcAIWritingToolsResponseError_AUTH_REQUIRED),
+	)
+	return &pb.RpcAIWritingToolsResponse{
 		Error: &pb.RpcAIWritingToolsResponseError{
-			Code:        pb.RpcAIWritingToolsResponseError_UNKNOWN_ERROR,
-			Description: "not implemented",
+			Code:        code,
+			Description: getErrorDescription(err),
 		},
+		Text: text,
 	}
-
-	return r
 }
 
 func (mw *Middleware) AIAutofill(ctx context.Context, req *pb.RpcAIAutofillRequest) *pb.RpcAIAutofillResponse {
-	r := &pb.RpcAIAutofillResponse{
+	aiService := mustService[ai.AI](mw)
+	text, err := aiService.Autofill(ctx, req)
+	code := mapErrorCode(err,
+		errToCode(ai.ErrBadInput, pb.RpcAIAutofillResponseError_BAD_INPUT),
+		errToCode(ai.ErrProviderNotConfigured, pb.RpcAIAutofillResponseError_BAD_INPUT),
+		errToCode(llm.ErrRateLimitExceeded, pb.RpcAIAutofillResponseError_RATE_LIMIT_EXCEEDED),
+		errToCode(llm.ErrEndpointNotReachable, pb.RpcAIAutofillResponseError_ENDPOINT_NOT_REACHABLE),
+		errToCode(llm.ErrModelNotFound, pb.RpcAIAutofillResponseError_MODEL_NOT_FOUND),
+		errToCode(llm.ErrAuthRequired, pb.RpcAIAutofillResponseError_AUTH_REQUIRED),
+	)
+	return &pb.RpcAIAutofillResponse{
 		Error: &pb.RpcAIAutofillResponseError{
-			Code:        pb.RpcAIAutofillResponseError_UNKNOWN_ERROR,
-			Description: "not implemented",
+			Code:        code,
+			Description: getErrorDescription(err),
 		},
+		Text: text,
 	}
-	return r
 }
 
 func (mw *Middleware) AIListSummary(ctx context.Context, req *pb.RpcAIListSummaryRequest) *pb.RpcAIListSummaryResponse {
-	r := &pb.RpcAIListSummaryResponse{
+	aiService := mustService[ai.AI](mw)
+	objectId, err := aiService.ListSummary(ctx, req)
+	code := mapErrorCode(err,
+		errToCode(ai.ErrBadInput, pb.RpcAIListSummaryResponseError_BAD_INPUT),
+		errToCode(ai.ErrProviderNotConfigured, pb.RpcAIListSummaryResponseError_BAD_INPUT),
+		errToCode(llm.ErrRateLimitExceeded, pb.RpcAIListSummaryResponseError_RATE_LIMIT_EXCEEDED),
+		errToCode(llm.ErrEndpointNotReachable, pb.RpcAIListSummaryResponseError_ENDPOINT_NOT_REACHABLE),
+		errToCode(llm.ErrModelNotFound, pb.RpcAIListSummaryResponseError_MODEL_NOT_FOUND),
+		errToCode(llm.ErrAuthRequired, pb.RpcAIListSummaryResponseError_AUTH_REQUIRED),
+	)
+	return &pb.RpcAIListSummaryResponse{
 		Error: &pb.RpcAIListSummaryResponseError{
-			Code:        pb.RpcAIListSummaryResponseError_UNKNOWN_ERROR,
-			Description: "not implemented",
+			Code:        code,
+			Description: getErrorDescription(err),
 		},
+		ObjectId: objectId,
 	}
-	return r
 }
 
 func (mw *Middleware) AIObjectCreateFromUrl(ctx context.Context, req *pb.RpcAIObjectCreateFromUrlRequest) *pb.RpcAIObjectCreateFromUrlResponse {
-	r := &pb.RpcAIObjectCreateFromUrlResponse{
+	aiService := mustService[ai.AI](mw)
+	objectId, details, err := aiService.CreateObjectFromUrl(ctx, req)
+	code := mapErrorCode(err,
+		errToCode(ai.ErrBadInput, pb.RpcAIObjectCreateFromUrlResponseError_BAD_INPUT),
+		errToCode(ai.ErrProviderNotConfigured, pb.RpcAIObjectCreateFromUrlResponseError_BAD_INPUT),
+		errToCode(llm.ErrRateLimitExceeded, pb.RpcAIObjectCreateFromUrlResponseError_RATE_LIMIT_EXCEEDED),
+		errToCode(llm.ErrEndpointNotReachable, pb.RpcAIObjectCreateFromUrlResponseError_ENDPOINT_NOT_REACHABLE),
+		errToCode(llm.ErrModelNotFound, pb.RpcAIObjectCreateFromUrlResponseError_MODEL_NOT_FOUND),
+		errToCode(llm.ErrAuthRequired, pb.RpcAIObjectCreateFromUrlResponseError_AUTH_REQUIRED),
+	)
+	return &pb.RpcAIObjectCreateFromUrlResponse{
 		Error: &pb.RpcAIObjectCreateFromUrlResponseError{
-			Code:        pb.RpcAIObjectCreateFromUrlResponseError_UNKNOWN_ERROR,
-			Description: "not implemented",
+			Code:        code,
+			Description: getErrorDescription(err),
 		},
+		ObjectId: objectId,
+		Details:  details.ToProto(),
 	}
-	return r
 }
@@ -0,0 +1,333 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/go-shiori/go-readability"
+
+	"github.com/anyproto/anytype-heart/core/ai/llm"
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/template"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/core/wallet"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/linkpreview"
+)
+
+const CName = "ai"
+
+var log = logging.Logger(CName)
+
+// maxContentLength limits the amount of text sent to the model, so small local models don't overflow their context
+const maxContentLength = 32 * 1024
+
+var (
+	ErrBadInput              = errors.New("bad input")
+	ErrLanguageNotSupported  = errors.New("language not supported")
+	ErrProviderNotConfigured = errors.New("ai provider is not configured")
+	ErrEmptyAnswer           = errors.New("model returned empty answer")
+
+	// reasoning models wrap their chain of thought into think tags, it is never a part of the answer
+	thinkTagRegexp = regexp.MustCompile(`(?s)<think>.*?</think>`)
+)
+
+type AI interface {
+	WritingTools(ctx context.Context, req *pb.RpcAIWritingToolsRequest) (string, error)
+	Autofill(ctx context.Context, req *pb.RpcAIAutofillRequest) (string, error)
+	// ListSummary summarizes the objects into a new page and returns its id
+	ListSummary(ctx context.Context, req *pb.RpcAIListSummaryRequest) (string, error)
+	// CreateObjectFromUrl fetches the page, summarizes it into a new object and returns its id and details
+	CreateObjectFromUrl(ctx context.Context, req *pb.RpcAIObjectCreateFromUrlRequest) (string, *domain.Details, error)
+	app.Component
+}
+
+type objectCreator interface {
+	CreateSmartBlockFromState(ctx context.Context, spaceID string, objectTypeKeys []domain.TypeKey, createState *state.State) (id string, newDetails *domain.Details, err error)
+}
+
+// ProviderFactory builds the provider for the resolved account config
+type ProviderFactory func(config *pb.RpcAIProviderConfig) (llm.Provider, error)
+
+type service struct {
+	objectGetter    cache.ObjectGetter
+	objectCreator   objectCreator
+	templateService template.Service
+	linkPreview     linkpreview.LinkPreview
+	eventSender     event.Sender
+	configStore     *configStore
+	newProvider     ProviderFactory
+}
+
+func New() AI {
+	client := llm.NewHttpClient()
+	return &service{
+		newProvider: func(config *pb.RpcAIProviderConfig) (llm.Provider, error) {
+			return llm.New(config, client)
+		},
+	}
+}
+
+// NewWithProviderFactory creates the service which uses the given factory instead of real HTTP providers,
+// e.g. to run with llm.Fake offline
+func NewWithProviderFactory(factory ProviderFactory) AI {
+	return &service{newProvider: factory}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.objectGetter = app.MustComponent[cache.ObjectGetter](a)
+	s.objectCreator = app.MustComponent[objectCreator](a)
+	s.templateService = app.MustComponent[template.Service](a)
+	s.linkPreview = app.MustComponent[linkpreview.LinkPreview](a)
+	s.eventSender = app.MustComponent[event.Sender](a)
+	w := app.MustComponent[wallet.Wallet](a)
+	s.configStore = newConfigStore(w.RepoPath(), w.GetAccountPrivkey())
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) WritingTools(ctx context.Context, req *pb.RpcAIWritingToolsRequest) (string, error) {
+	if strings.TrimSpace(req.Text) == "" {
+		return "", fmt.Errorf("%w: empty text", ErrBadInput)
+	}
+	systemPrompt, err := writingToolsSystemPrompt(req.Mode, req.Language)
+	if err != nil {
+		return "", err
+	}
+	return s.chat(ctx, req.Config, req.StreamId, systemPrompt, truncate(req.Text))
+}
+
+func (s *service) Autofill(ctx context.Context, req *pb.RpcAIAutofillRequest) (string, error) {
+	if len(req.Context) == 0 {
+		return "", fmt.Errorf("%w: empty context", ErrBadInput)
+	}
+	systemPrompt, err := autofillSystemPrompt(req.Mode, req.Options)
+	if err != nil {
+		return "", err
+	}
+	answer, err := s.chat(ctx, req.Config, req.StreamId, systemPrompt, truncate(strings.Join(req.Context, "\n\n")))
+	if err != nil {
+		return "", err
+	}
+	return strings.Trim(answer, "\"'`"), nil
+}
+
+func (s *service) ListSummary(ctx context.Context, req *pb.RpcAIListSummaryRequest) (string, error) {
+	if req.SpaceId == "" || len(req.ObjectIds) == 0 {
+		return "", fmt.Errorf("%w: spaceId and objectIds are required", ErrBadInput)
+	}
+	contents := make([]string, 0, len(req.ObjectIds))
+	for _, objectId := range req.ObjectIds {
+		content, err := s.objectContent(ctx, domain.FullID{SpaceID: req.SpaceId, ObjectID: objectId})
+		if err != nil {
+			return "", fmt.Errorf("get content of %s: %w", objectId, err)
+		}
+		contents = append(contents, content)
+	}
+	systemPrompt := baseSystemPrompt + "\n" + summaryPrompt
+	if req.Prompt != "" {
+		systemPrompt += "\n" + req.Prompt
+	}
+	summary, err := s.chat(ctx, req.Config, req.StreamId, systemPrompt, truncate(strings.Join(contents, "\n\n---\n\n")))
+	if err != nil {
+		return "", err
+	}
+	objectId, _, err := s.createPage(ctx, req.SpaceId, summary, domain.NewDetails())
+	return objectId, err
+}
+
+func (s *service) CreateObjectFromUrl(ctx context.Context, req *pb.RpcAIObjectCreateFromUrlRequest) (string, *domain.Details, error) {
+	pageUrl, err := url.Parse(req.Url)
+	if err != nil || pageUrl.Scheme == "" || pageUrl.Host == "" {
+		return "", nil, fmt.Errorf("%w: invalid url %q", ErrBadInput, req.Url)
+	}
+	if req.SpaceId == "" {
+		return "", nil, fmt.Errorf("%w: spaceId is required", ErrBadInput)
+	}
+	preview, body, isFile, err := s.linkPreview.Fetch(ctx, req.Url)
+	if err != nil {
+		return "", nil, fmt.Errorf("fetch url: %w", err)
+	}
+	if isFile {
+		return "", nil, fmt.Errorf("%w: url points to a file", ErrBadInput)
+	}
+	article, err := readability.FromReader(bytes.NewReader(body), pageUrl)
+	if err != nil {
+		return "", nil, fmt.Errorf("parse page: %w", err)
+	}
+	pageText := article.TextContent
+	if article.Title != "" {
+		pageText = article.Title + "\n\n" + pageText
+	}
+
+	summary, err := s.chat(ctx, req.Config, req.StreamId, baseSystemPrompt+"\n"+urlSummaryPrompt, truncate(pageText))
+	if err != nil {
+		return "", nil, err
+	}
+
+	details := domain.NewDetailsFromProto(req.Details)
+	details.SetString(bundle.RelationKeySource, req.Url)
+	if !details.Has(bundle.RelationKeyName) && preview.Title != "" {
+		details.SetString(bundle.RelationKeyName, preview.Title)
+	}
+	if !details.Has(bundle.RelationKeyDescription) && preview.Description != "" {
+		details.SetString(bundle.RelationKeyDescription, preview.Description)
+	}
+	return s.createPage(ctx, req.SpaceId, summary, details)
+}
+
+// chat returns the cleaned answer of the model. Pieces of the raw answer are sent as events, when streamId is set
+func (s *service) chat(ctx context.Context, config *pb.RpcAIProviderConfig, streamId, systemPrompt, userText string) (string, error) {
+	config, err := s.configStore.resolve(config)
+	if err != nil {
+		return "", err
+	}
+	provider, err := s.newProvider(config)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrBadInput, err)
+	}
+	answer, err := provider.Chat(ctx, llm.Request{
+		Messages: []llm.Message{
+			{Role: llm.RoleSystem, Content: systemPrompt},
+			{Role: llm.RoleUser, Content: userText},
+		},
+		Temperature: config.Temperature,
+	}, s.chunkSender(streamId))
+	if err != nil {
+		return "", err
+	}
+	answer = cleanAnswer(answer)
+	if answer == "" {
+		return "", ErrEmptyAnswer
+	}
+	return answer, nil
+}
+
+func (s *service) chunkSender(streamId string) llm.ChunkHandler {
+	if streamId == "" {
+		return nil
+	}
+	return func(chunk string) {
+		s.eventSender.Broadcast(event.NewEventSingleMessage("", &pb.EventMessageValueOfAiChunk{
+			AiChunk: &pb.EventAIChunk{StreamId: streamId, Text: chunk},
+		}))
+	}
+}
+
+func (s *service) objectContent(ctx context.Context, id domain.FullID) (string, error) {
+	var content strings.Builder
+	err := cache.DoContextFullID(s.objectGetter, ctx, id, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		if name := st.Details().GetString(bundle.RelationKeyName); name != "" {
+			content.WriteString("# " + name + "\n")
+		}
+		return st.Iterate(func(b simple.Block) (isContinue bool) {
+			text := b.Model().GetText()
+			if text == nil || text.Text == "" || b.Model().Id == state.TitleBlockID {
+				return true
+			}
+			content.WriteString(textPrefix(text) + text.Text + "\n")
+			return true
+		})
+	})
+	return content.String(), err
+}
+
+// createPage creates a page with the markdown converted to blocks. The leading level one heading becomes the page name
+func (s *service) createPage(ctx context.Context, spaceId, markdown string, details *domain.Details) (string, *domain.Details, error) {
+	title, body := splitTitle(markdown)
+	if title != "" && !details.Has(bundle.RelationKeyName) {
+		details.SetString(bundle.RelationKeyName, title)
+	}
+	blocks, _, err := anymark.MarkdownToBlocks([]byte(body), "", nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("convert markdown: %w", err)
+	}
+	st, err := s.templateService.CreateTemplateStateWithDetails(template.CreateTemplateRequest{
+		SpaceId: spaceId,
+		Layout:  model.ObjectType_basic,
+		Details: details,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("create state: %w", err)
+	}
+	appendBlocks(st, blocks)
+	return s.objectCreator.CreateSmartBlockFromState(ctx, spaceId, []domain.TypeKey{bundle.TypeKeyPage}, st)
+}
+
+func appendBlocks(st *state.State, blocks []*model.Block) {
+	childIds := make(map[string]struct{}, len(blocks))
+	for _, b := range blocks {
+		for _, childId := range b.ChildrenIds {
+			childIds[childId] = struct{}{}
+		}
+	}
+	root := st.Get(st.RootId())
+	for _, b := range blocks {
+		st.Add(simple.New(b))
+		if _, isChild := childIds[b.Id]; !isChild {
+			root.Model().ChildrenIds = append(root.Model().ChildrenIds, b.Id)
+		}
+	}
+}
+
+func splitTitle(markdown string) (title, body string) {
+	firstLine, rest, _ := strings.Cut(strings.TrimSpace(markdown), "\n")
+	if !strings.HasPrefix(firstLine, "# ") {
+		return "", markdown
+	}
+	return strings.TrimSpace(strings.TrimPrefix(firstLine, "# ")), strings.TrimSpace(rest)
+}
+
+func textPrefix(text *model.BlockContentText) string {
+	switch text.Style {
+	case model.BlockContentText_Header1:
+		return "# "
+	case model.BlockContentText_Header2:
+		return "## "
+	case model.BlockContentText_Header3, model.BlockContentText_Header4:
+		return "### "
+	case model.BlockContentText_Marked:
+		return "- "
+	case model.BlockContentText_Numbered:
+		return "1. "
+	case model.BlockContentText_Quote:
+		return "> "
+	case model.BlockContentText_Checkbox:
+		if text.Checked {
+			return "- [x] "
+		}
+		return "- [ ] "
+	default:
+		return ""
+	}
+}
+
+func cleanAnswer(answer string) string {
+	return strings.TrimSpace(thinkTagRegexp.ReplaceAllString(answer, ""))
+}
+
+func truncate(text string) string {
+	runes := []rune(text)
+	if len(runes) <= maxContentLength {
+		return text
+	}
+	return string(runes[:maxContentLength])
+}
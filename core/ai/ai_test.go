@@ -0,0 +1,272 @@
+package ai
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anyproto/any-sync/util/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/ai/llm"
+	"github.com/anyproto/anytype-heart/core/block/cache/mock_cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/template"
+	"github.com/anyproto/anytype-heart/core/block/template/mock_template"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/event/mock_event"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const spaceId = "space1"
+
+var testConfig = &pb.RpcAIProviderConfig{Provider: pb.RpcAI_OLLAMA, Model: "llama"}
+
+type createdObject struct {
+	spaceId  string
+	typeKeys []domain.TypeKey
+	state    *state.State
+}
+
+type objectCreatorStub struct {
+	created []createdObject
+}
+
+func (c *objectCreatorStub) CreateSmartBlockFromState(_ context.Context, spaceID string, objectTypeKeys []domain.TypeKey, createState *state.State) (string, *domain.Details, error) {
+	c.created = append(c.created, createdObject{spaceId: spaceID, typeKeys: objectTypeKeys, state: createState})
+	return "newObject", createState.CombinedDetails(), nil
+}
+
+type fixture struct {
+	*service
+	provider        *llm.Fake
+	objectGetter    *mock_cache.MockObjectGetter
+	templateService *mock_template.MockService
+	objectCreator   *objectCreatorStub
+	eventSender     *mock_event.MockSender
+	repoPath        string
+	accountKey      crypto.PrivKey
+}
+
+func newFixture(t *testing.T, provider *llm.Fake) *fixture {
+	repoPath := t.TempDir()
+	objectGetter := mock_cache.NewMockObjectGetter(t)
+	templateService := mock_template.NewMockService(t)
+	objectCreator := &objectCreatorStub{}
+	eventSender := mock_event.NewMockSender(t)
+	accountKey, _, err := crypto.GenerateRandomEd25519KeyPair()
+	require.NoError(t, err)
+	s := &service{
+		objectGetter:    objectGetter,
+		objectCreator:   objectCreator,
+		templateService: templateService,
+		eventSender:     eventSender,
+		configStore:     newConfigStore(repoPath, accountKey),
+		newProvider: func(config *pb.RpcAIProviderConfig) (llm.Provider, error) {
+			return provider, nil
+		},
+	}
+	return &fixture{
+		service:         s,
+		provider:        provider,
+		objectGetter:    objectGetter,
+		templateService: templateService,
+		objectCreator:   objectCreator,
+		eventSender:     eventSender,
+		repoPath:        repoPath,
+		accountKey:      accountKey,
+	}
+}
+
+func TestService_WritingTools(t *testing.T) {
+	t.Run("rewrite text", func(t *testing.T) {
+		// given
+		fx := newFixture(t, llm.NewFakeWithAnswer("<think>let me think</think>\n Fixed text \n"))
+
+		// when
+		text, err := fx.WritingTools(context.Background(), &pb.RpcAIWritingToolsRequest{
+			Config: testConfig,
+			Mode:   pb.RpcAIWritingToolsRequest_GRAMMAR,
+			Text:   "fixd txt",
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "Fixed text", text)
+		requests := fx.provider.Requests()
+		require.Len(t, requests, 1)
+		assert.Equal(t, llm.RoleSystem, requests[0].Messages[0].Role)
+		assert.Contains(t, requests[0].Messages[0].Content, "grammar")
+		assert.Equal(t, llm.Message{Role: llm.RoleUser, Content: "fixd txt"}, requests[0].Messages[1])
+	})
+	t.Run("translate uses target language", func(t *testing.T) {
+		fx := newFixture(t, llm.NewFakeWithAnswer("Hola"))
+
+		_, err := fx.WritingTools(context.Background(), &pb.RpcAIWritingToolsRequest{
+			Config:   testConfig,
+			Mode:     pb.RpcAIWritingToolsRequest_TRANSLATE,
+			Language: pb.RpcAIWritingToolsRequest_ES,
+			Text:     "Hello",
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, fx.provider.Requests()[0].Messages[0].Content, "Spanish")
+	})
+	t.Run("unsupported language", func(t *testing.T) {
+		fx := newFixture(t, llm.NewFakeWithAnswer("text"))
+
+		_, err := fx.WritingTools(context.Background(), &pb.RpcAIWritingToolsRequest{
+			Config:   testConfig,
+			Language: pb.RpcAIWritingToolsRequestLanguage(100),
+			Text:     "text",
+		})
+
+		assert.ErrorIs(t, err, ErrLanguageNotSupported)
+	})
+	t.Run("empty answer", func(t *testing.T) {
+		fx := newFixture(t, llm.NewFakeWithAnswer("<think>nothing to say</think>"))
+
+		_, err := fx.WritingTools(context.Background(), &pb.RpcAIWritingToolsRequest{Config: testConfig, Text: "text"})
+
+		assert.ErrorIs(t, err, ErrEmptyAnswer)
+	})
+	t.Run("answer is streamed", func(t *testing.T) {
+		// given
+		fx := newFixture(t, llm.NewFakeWithAnswer("short answer"))
+		var chunks []string
+		fx.eventSender.EXPECT().Broadcast(mock.Anything).Run(func(e *pb.Event) {
+			chunk := e.Messages[0].GetAiChunk()
+			assert.Equal(t, "stream1", chunk.StreamId)
+			chunks = append(chunks, chunk.Text)
+		}).Times(2)
+
+		// when
+		text, err := fx.WritingTools(context.Background(), &pb.RpcAIWritingToolsRequest{Config: testConfig, Text: "text", StreamId: "stream1"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "short answer", text)
+		assert.Equal(t, []string{"short ", "answer"}, chunks)
+	})
+	t.Run("canceled context", func(t *testing.T) {
+		fx := newFixture(t, llm.NewFakeWithAnswer("long answer"))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := fx.WritingTools(ctx, &pb.RpcAIWritingToolsRequest{Config: testConfig, Text: "text"})
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestService_Config(t *testing.T) {
+	t.Run("no config", func(t *testing.T) {
+		fx := newFixture(t, llm.NewFakeWithAnswer("text"))
+
+		_, err := fx.WritingTools(context.Background(), &pb.RpcAIWritingToolsRequest{Text: "text"})
+
+		assert.ErrorIs(t, err, ErrProviderNotConfigured)
+	})
+	t.Run("saved config is used when request has no config", func(t *testing.T) {
+		// given
+		fx := newFixture(t, llm.NewFakeWithAnswer("text"))
+		var configs []*pb.RpcAIProviderConfig
+		fx.newProvider = func(config *pb.RpcAIProviderConfig) (llm.Provider, error) {
+			configs = append(configs, config)
+			return fx.provider, nil
+		}
+		_, err := fx.WritingTools(context.Background(), &pb.RpcAIWritingToolsRequest{Config: testConfig, Text: "text"})
+		require.NoError(t, err)
+
+		// when
+		restarted := newConfigStore(fx.repoPath, fx.accountKey)
+		fx.configStore = restarted
+		_, err = fx.WritingTools(context.Background(), &pb.RpcAIWritingToolsRequest{Text: "text"})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, configs, 2)
+		assert.Equal(t, "llama", configs[1].Model)
+		assert.Equal(t, llm.DefaultEndpoint(pb.RpcAI_OLLAMA), configs[1].Endpoint)
+		_, err = os.Stat(filepath.Join(fx.repoPath, configFileName))
+		assert.NoError(t, err)
+	})
+	t.Run("token is not stored in plain text", func(t *testing.T) {
+		// given
+		fx := newFixture(t, llm.NewFakeWithAnswer("text"))
+		config := &pb.RpcAIProviderConfig{Provider: pb.RpcAI_OPENAI, Model: "gpt", Token: "secret-token"}
+		_, err := fx.WritingTools(context.Background(), &pb.RpcAIWritingToolsRequest{Config: config, Text: "text"})
+		require.NoError(t, err)
+
+		// when
+		restarted := newConfigStore(fx.repoPath, fx.accountKey)
+		resolved, err := restarted.resolve(nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "secret-token", resolved.Token)
+		data, err := os.ReadFile(filepath.Join(fx.repoPath, configFileName))
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "secret-token")
+	})
+}
+
+func TestService_Autofill(t *testing.T) {
+	fx := newFixture(t, llm.NewFakeWithAnswer(`"work"`))
+
+	text, err := fx.Autofill(context.Background(), &pb.RpcAIAutofillRequest{
+		Config:  testConfig,
+		Mode:    pb.RpcAIAutofillRequest_TAG,
+		Options: []string{"work", "home"},
+		Context: []string{"Quarterly report"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "work", text)
+	assert.Contains(t, fx.provider.Requests()[0].Messages[0].Content, "- home")
+}
+
+func TestService_ListSummary(t *testing.T) {
+	// given
+	fx := newFixture(t, llm.NewFakeWithAnswer("# Weekly summary\n\nEverything is done"))
+	sb := smarttest.New("object1")
+	sb.Doc.(*state.State).SetDetail(bundle.RelationKeyName, domain.String("Notes"))
+	sb.AddBlock(simple.New(&model.Block{Id: "object1", ChildrenIds: []string{"text1"}}))
+	sb.AddBlock(simple.New(&model.Block{Id: "text1", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+		Text:  "release the app",
+		Style: model.BlockContentText_Checkbox,
+	}}}))
+	fx.objectGetter.EXPECT().GetObjectByFullID(mock.Anything, domain.FullID{SpaceID: spaceId, ObjectID: "object1"}).Return(sb, nil)
+	fx.templateService.EXPECT().CreateTemplateStateWithDetails(mock.Anything).RunAndReturn(func(req template.CreateTemplateRequest) (*state.State, error) {
+		st := state.NewDoc("root", nil).NewState()
+		st.Add(simple.New(&model.Block{Id: "root"}))
+		st.SetDetails(req.Details)
+		return st, nil
+	})
+
+	// when
+	objectId, err := fx.ListSummary(context.Background(), &pb.RpcAIListSummaryRequest{
+		Config:    testConfig,
+		SpaceId:   spaceId,
+		ObjectIds: []string{"object1"},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "newObject", objectId)
+	assert.Contains(t, fx.provider.Requests()[0].Messages[1].Content, "# Notes\n- [ ] release the app")
+	require.Len(t, fx.objectCreator.created, 1)
+	created := fx.objectCreator.created[0]
+	assert.Equal(t, []domain.TypeKey{bundle.TypeKeyPage}, created.typeKeys)
+	assert.Equal(t, "Weekly summary", created.state.Details().GetString(bundle.RelationKeyName))
+	root := created.state.Pick(created.state.RootId())
+	require.Len(t, root.Model().ChildrenIds, 1)
+	assert.Equal(t, "Everything is done", created.state.Pick(root.Model().ChildrenIds[0]).Model().GetText().Text)
+}
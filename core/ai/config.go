@@ -0,0 +1,126 @@
+package ai
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/anyproto/any-sync/util/crypto"
+
+	"github.com/anyproto/anytype-heart/core/ai/llm"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+const configFileName = "ai.json"
+
+type storedConfig struct {
+	Provider    pb.RpcAIProvider `json:"provider"`
+	Endpoint    string           `json:"endpoint"`
+	Model       string           `json:"model"`
+	Token       string           `json:"-"`
+	Temperature float32          `json:"temperature"`
+}
+
+// configFile is the stored config on disk. The token is sealed with the account key, so it is not readable
+// from the file without the account
+type configFile struct {
+	storedConfig
+	SealedToken []byte `json:"sealedToken,omitempty"`
+}
+
+// configStore keeps the last provider config used by the account, so clients
+// may omit the config in subsequent requests
+type configStore struct {
+	path       string
+	accountKey crypto.PrivKey
+	mu         sync.Mutex
+	config     *storedConfig
+}
+
+func newConfigStore(repoPath string, accountKey crypto.PrivKey) *configStore {
+	return &configStore{path: filepath.Join(repoPath, configFileName), accountKey: accountKey}
+}
+
+// resolve returns the config for the request. Explicit config is normalized and saved as
+// the account default, empty config is replaced with the saved one
+func (s *configStore) resolve(config *pb.RpcAIProviderConfig) (*pb.RpcAIProviderConfig, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if config.GetModel() != "" {
+		resolved := &storedConfig{
+			Provider:    config.Provider,
+			Endpoint:    config.Endpoint,
+			Model:       config.Model,
+			Token:       config.Token,
+			Temperature: config.Temperature,
+		}
+		if resolved.Endpoint == "" {
+			resolved.Endpoint = llm.DefaultEndpoint(resolved.Provider)
+		}
+		if s.config == nil || *s.config != *resolved {
+			if err := s.save(resolved); err != nil {
+				log.Warnf("failed to save ai config: %v", err)
+			}
+		}
+		return resolved.toProto(), nil
+	}
+	if s.config == nil {
+		if err := s.load(); err != nil {
+			return nil, err
+		}
+	}
+	return s.config.toProto(), nil
+}
+
+func (s *configStore) load() error {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrProviderNotConfigured
+	}
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+	file := &configFile{}
+	if err = json.Unmarshal(data, file); err != nil {
+		return fmt.Errorf("unmarshal config: %w", err)
+	}
+	if len(file.SealedToken) > 0 {
+		token, err := s.accountKey.Decrypt(file.SealedToken)
+		if err != nil {
+			return fmt.Errorf("decrypt token: %w", err)
+		}
+		file.Token = string(token)
+	}
+	s.config = &file.storedConfig
+	return nil
+}
+
+func (s *configStore) save(config *storedConfig) error {
+	s.config = config
+	file := &configFile{storedConfig: *config}
+	if config.Token != "" {
+		sealed, err := s.accountKey.GetPublic().Encrypt([]byte(config.Token))
+		if err != nil {
+			return fmt.Errorf("encrypt token: %w", err)
+		}
+		file.SealedToken = sealed
+	}
+	data, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0600)
+}
+
+func (c *storedConfig) toProto() *pb.RpcAIProviderConfig {
+	return &pb.RpcAIProviderConfig{
+		Provider:    c.Provider,
+		Endpoint:    c.Endpoint,
+		Model:       c.Model,
+		Token:       c.Token,
+		Temperature: c.Temperature,
+	}
+}
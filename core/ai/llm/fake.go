@@ -0,0 +1,55 @@
+package llm
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// Fake is an in-process provider which answers without any network calls. It is used in tests
+type Fake struct {
+	mu       sync.Mutex
+	respond  func(req Request) (string, error)
+	requests []Request
+}
+
+// NewFake creates a provider which answers every request using respond
+func NewFake(respond func(req Request) (string, error)) *Fake {
+	return &Fake{respond: respond}
+}
+
+// NewFakeWithAnswer creates a provider which answers every request with the same text
+func NewFakeWithAnswer(answer string) *Fake {
+	return NewFake(func(Request) (string, error) {
+		return answer, nil
+	})
+}
+
+func (f *Fake) Chat(ctx context.Context, req Request, onChunk ChunkHandler) (string, error) {
+	f.mu.Lock()
+	f.requests = append(f.requests, req)
+	f.mu.Unlock()
+
+	answer, err := f.respond(req)
+	if err != nil {
+		return "", err
+	}
+	var result strings.Builder
+	for _, chunk := range strings.SplitAfter(answer, " ") {
+		if err = ctx.Err(); err != nil {
+			return "", err
+		}
+		result.WriteString(chunk)
+		if onChunk != nil {
+			onChunk(chunk)
+		}
+	}
+	return result.String(), nil
+}
+
+// Requests returns all requests received by the provider
+func (f *Fake) Requests() []Request {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Request(nil), f.requests...)
+}
@@ -0,0 +1,93 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ollama talks to the native Ollama API, which streams newline-delimited JSON objects
+type ollama struct {
+	client   *http.Client
+	endpoint string
+	model    string
+}
+
+type ollamaRequest struct {
+	Model    string        `json:"model"`
+	Messages []Message     `json:"messages"`
+	Stream   bool          `json:"stream"`
+	Options  ollamaOptions `json:"options"`
+}
+
+type ollamaOptions struct {
+	Temperature float32 `json:"temperature"`
+}
+
+type ollamaChunk struct {
+	Message Message `json:"message"`
+	Done    bool    `json:"done"`
+	Error   string  `json:"error"`
+}
+
+func (o *ollama) Chat(ctx context.Context, req Request, onChunk ChunkHandler) (string, error) {
+	body, err := json.Marshal(ollamaRequest{
+		Model:    o.model,
+		Messages: req.Messages,
+		Stream:   true,
+		Options:  ollamaOptions{Temperature: req.Temperature},
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal request: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, o.endpoint+"/api/chat", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := o.client.Do(httpReq)
+	if err != nil {
+		return "", transportError(ctx, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxLineSize))
+		return "", statusToError(resp.StatusCode, string(respBody))
+	}
+
+	var result strings.Builder
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var chunk ollamaChunk
+		if err = json.Unmarshal(line, &chunk); err != nil {
+			return "", fmt.Errorf("unmarshal chunk: %w", err)
+		}
+		if chunk.Error != "" {
+			return "", fmt.Errorf("provider error: %s", chunk.Error)
+		}
+		if chunk.Message.Content != "" {
+			result.WriteString(chunk.Message.Content)
+			if onChunk != nil {
+				onChunk(chunk.Message.Content)
+			}
+		}
+		if chunk.Done {
+			break
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return "", transportError(ctx, err)
+	}
+	return result.String(), nil
+}
@@ -0,0 +1,71 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func TestOllama_Chat(t *testing.T) {
+	t.Run("stream is collected into the answer", func(t *testing.T) {
+		// given
+		var received ollamaRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/chat", r.URL.Path)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			for _, chunk := range []string{"one ", "two"} {
+				fmt.Fprintf(w, "{\"message\":{\"role\":\"assistant\",\"content\":%q},\"done\":false}\n", chunk)
+			}
+			fmt.Fprint(w, "{\"message\":{\"role\":\"assistant\",\"content\":\"\"},\"done\":true}\n")
+		}))
+		defer server.Close()
+		provider, err := New(&pb.RpcAIProviderConfig{Provider: pb.RpcAI_OLLAMA, Endpoint: server.URL, Model: "llama"}, server.Client())
+		require.NoError(t, err)
+
+		// when
+		answer, err := provider.Chat(context.Background(), Request{
+			Messages:    []Message{{Role: RoleSystem, Content: "be short"}},
+			Temperature: 0.2,
+		}, nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "one two", answer)
+		assert.Equal(t, "llama", received.Model)
+		assert.Equal(t, float32(0.2), received.Options.Temperature)
+	})
+
+	t.Run("error in stream", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, "{\"error\":\"out of memory\"}\n")
+		}))
+		defer server.Close()
+		provider, err := New(&pb.RpcAIProviderConfig{Provider: pb.RpcAI_OLLAMA, Endpoint: server.URL}, server.Client())
+		require.NoError(t, err)
+
+		_, err = provider.Chat(context.Background(), Request{}, nil)
+
+		assert.ErrorContains(t, err, "out of memory")
+	})
+
+	t.Run("model not found", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, `{"error":"model \"llama\" not found"}`, http.StatusNotFound)
+		}))
+		defer server.Close()
+		provider, err := New(&pb.RpcAIProviderConfig{Provider: pb.RpcAI_OLLAMA, Endpoint: server.URL}, server.Client())
+		require.NoError(t, err)
+
+		_, err = provider.Chat(context.Background(), Request{}, nil)
+
+		assert.ErrorIs(t, err, ErrModelNotFound)
+	})
+}
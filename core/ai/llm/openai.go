@@ -0,0 +1,109 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	sseDataPrefix = "data:"
+	sseDone       = "[DONE]"
+	maxLineSize   = 1 << 20
+)
+
+// openAI talks to any server implementing the OpenAI chat completions API
+type openAI struct {
+	client   *http.Client
+	endpoint string
+	model    string
+	token    string
+}
+
+type openAIRequest struct {
+	Model       string    `json:"model"`
+	Messages    []Message `json:"messages"`
+	Temperature float32   `json:"temperature"`
+	Stream      bool      `json:"stream"`
+}
+
+type openAIChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (o *openAI) Chat(ctx context.Context, req Request, onChunk ChunkHandler) (string, error) {
+	body, err := json.Marshal(openAIRequest{
+		Model:       o.model,
+		Messages:    req.Messages,
+		Temperature: req.Temperature,
+		Stream:      true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal request: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, o.endpoint+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "text/event-stream")
+	if o.token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+o.token)
+	}
+
+	resp, err := o.client.Do(httpReq)
+	if err != nil {
+		return "", transportError(ctx, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxLineSize))
+		return "", statusToError(resp.StatusCode, string(respBody))
+	}
+
+	var result strings.Builder
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, sseDataPrefix) {
+			continue
+		}
+		data := strings.TrimSpace(strings.TrimPrefix(line, sseDataPrefix))
+		if data == sseDone {
+			break
+		}
+		var chunk openAIChunk
+		if err = json.Unmarshal([]byte(data), &chunk); err != nil {
+			return "", fmt.Errorf("unmarshal chunk: %w", err)
+		}
+		if chunk.Error != nil {
+			return "", fmt.Errorf("provider error: %s", chunk.Error.Message)
+		}
+		for _, choice := range chunk.Choices {
+			if choice.Delta.Content == "" {
+				continue
+			}
+			result.WriteString(choice.Delta.Content)
+			if onChunk != nil {
+				onChunk(choice.Delta.Content)
+			}
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return "", transportError(ctx, err)
+	}
+	return result.String(), nil
+}
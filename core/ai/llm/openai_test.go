@@ -0,0 +1,121 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func TestOpenAI_Chat(t *testing.T) {
+	t.Run("stream is collected into the answer", func(t *testing.T) {
+		// given
+		var received openAIRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+			assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.Header().Set("Content-Type", "text/event-stream")
+			for _, chunk := range []string{"Hello", ", ", "world"} {
+				fmt.Fprintf(w, "data: {\"choices\":[{\"delta\":{\"content\":%q}}]}\n\n", chunk)
+			}
+			fmt.Fprint(w, "data: [DONE]\n\n")
+		}))
+		defer server.Close()
+		provider, err := New(&pb.RpcAIProviderConfig{
+			Provider: pb.RpcAI_OPENAI,
+			Endpoint: server.URL + "/v1/",
+			Model:    "gpt",
+			Token:    "secret",
+		}, server.Client())
+		require.NoError(t, err)
+
+		// when
+		var chunks []string
+		answer, err := provider.Chat(context.Background(), Request{
+			Messages:    []Message{{Role: RoleUser, Content: "hi"}},
+			Temperature: 0.5,
+		}, func(chunk string) {
+			chunks = append(chunks, chunk)
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "Hello, world", answer)
+		assert.Equal(t, []string{"Hello", ", ", "world"}, chunks)
+		assert.Equal(t, "gpt", received.Model)
+		assert.True(t, received.Stream)
+		assert.Equal(t, []Message{{Role: RoleUser, Content: "hi"}}, received.Messages)
+	})
+
+	for _, tc := range []struct {
+		status   int
+		expected error
+	}{
+		{http.StatusUnauthorized, ErrAuthRequired},
+		{http.StatusNotFound, ErrModelNotFound},
+		{http.StatusTooManyRequests, ErrRateLimitExceeded},
+	} {
+		t.Run(fmt.Sprintf("status %d", tc.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "failure", tc.status)
+			}))
+			defer server.Close()
+			provider, err := New(&pb.RpcAIProviderConfig{Provider: pb.RpcAI_LMSTUDIO, Endpoint: server.URL}, server.Client())
+			require.NoError(t, err)
+
+			_, err = provider.Chat(context.Background(), Request{}, nil)
+
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+
+	t.Run("endpoint not reachable", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		endpoint := server.URL
+		server.Close()
+		provider, err := New(&pb.RpcAIProviderConfig{Provider: pb.RpcAI_LLAMACPP, Endpoint: endpoint}, nil)
+		require.NoError(t, err)
+
+		_, err = provider.Chat(context.Background(), Request{}, nil)
+
+		assert.ErrorIs(t, err, ErrEndpointNotReachable)
+	})
+
+	t.Run("canceled context stops the stream", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"content\":\"partial\"}}]}\n\n")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		}))
+		defer server.Close()
+		provider, err := New(&pb.RpcAIProviderConfig{Provider: pb.RpcAI_OPENAI, Endpoint: server.URL}, server.Client())
+		require.NoError(t, err)
+
+		_, err = provider.Chat(ctx, Request{}, func(string) {
+			cancel()
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestNew(t *testing.T) {
+	t.Run("unknown provider", func(t *testing.T) {
+		_, err := New(&pb.RpcAIProviderConfig{Provider: pb.RpcAIProvider(100)}, nil)
+		assert.ErrorIs(t, err, ErrUnknownProvider)
+	})
+	t.Run("default endpoint", func(t *testing.T) {
+		provider, err := New(&pb.RpcAIProviderConfig{Provider: pb.RpcAI_OLLAMA}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "http://localhost:11434", provider.(*ollama).endpoint)
+	})
+}
@@ -0,0 +1,111 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+var (
+	ErrRateLimitExceeded    = errors.New("rate limit exceeded")
+	ErrEndpointNotReachable = errors.New("endpoint not reachable")
+	ErrModelNotFound        = errors.New("model not found")
+	ErrAuthRequired         = errors.New("authentication required")
+	ErrUnknownProvider      = errors.New("unknown provider")
+)
+
+const (
+	RoleSystem    = "system"
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+)
+
+const dialTimeout = 10 * time.Second
+
+var defaultEndpoints = map[pb.RpcAIProvider]string{
+	pb.RpcAI_OLLAMA:   "http://localhost:11434",
+	pb.RpcAI_OPENAI:   "https://api.openai.com/v1",
+	pb.RpcAI_LMSTUDIO: "http://localhost:1234/v1",
+	pb.RpcAI_LLAMACPP: "http://localhost:8080/v1",
+}
+
+type Message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type Request struct {
+	Messages    []Message
+	Temperature float32
+}
+
+// ChunkHandler receives the pieces of the completion in the order they arrive from the provider
+type ChunkHandler func(chunk string)
+
+// Provider is a chat completion backend. Implementations stream the answer and must stop
+// as soon as ctx is canceled
+type Provider interface {
+	Chat(ctx context.Context, req Request, onChunk ChunkHandler) (string, error)
+}
+
+// DefaultEndpoint returns the endpoint which is used when the config does not specify one
+func DefaultEndpoint(provider pb.RpcAIProvider) string {
+	return defaultEndpoints[provider]
+}
+
+// New builds a provider for the given config. OpenAI, LM Studio and llama.cpp share the OpenAI-compatible API
+func New(config *pb.RpcAIProviderConfig, client *http.Client) (Provider, error) {
+	if config == nil {
+		return nil, fmt.Errorf("%w: empty config", ErrUnknownProvider)
+	}
+	if client == nil {
+		client = NewHttpClient()
+	}
+	endpoint := strings.TrimRight(config.Endpoint, "/")
+	if endpoint == "" {
+		endpoint = DefaultEndpoint(config.Provider)
+	}
+	switch config.Provider {
+	case pb.RpcAI_OLLAMA:
+		return &ollama{client: client, endpoint: endpoint, model: config.Model}, nil
+	case pb.RpcAI_OPENAI, pb.RpcAI_LMSTUDIO, pb.RpcAI_LLAMACPP:
+		return &openAI{client: client, endpoint: endpoint, model: config.Model, token: config.Token}, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownProvider, config.Provider)
+	}
+}
+
+// NewHttpClient returns a client without an overall timeout: completions are streamed and
+// may take minutes, so the lifetime of a request is controlled by its context only
+func NewHttpClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{Timeout: dialTimeout}).DialContext
+	return &http.Client{Transport: transport}
+}
+
+func statusToError(statusCode int, body string) error {
+	body = strings.TrimSpace(body)
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("%w: %s", ErrAuthRequired, body)
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %s", ErrModelNotFound, body)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("%w: %s", ErrRateLimitExceeded, body)
+	default:
+		return fmt.Errorf("unexpected status code %d: %s", statusCode, body)
+	}
+}
+
+func transportError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	return fmt.Errorf("%w: %w", ErrEndpointNotReachable, err)
+}
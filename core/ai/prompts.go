@@ -0,0 +1,78 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+const baseSystemPrompt = "You are a writing assistant embedded into a note-taking application. " +
+	"Answer with the resulting text only: no introductions, explanations, quotes around the answer or follow-up questions."
+
+var writingModePrompts = map[pb.RpcAIWritingToolsRequestWritingMode]string{
+	pb.RpcAIWritingToolsRequest_DEFAULT:         "Improve the following text: make it clear and easy to read while keeping its meaning and length.",
+	pb.RpcAIWritingToolsRequest_SUMMARIZE:       "Summarize the following text in a few sentences, keeping the key facts.",
+	pb.RpcAIWritingToolsRequest_GRAMMAR:         "Fix spelling, grammar and punctuation mistakes in the following text. Do not change its wording otherwise.",
+	pb.RpcAIWritingToolsRequest_SHORTEN:         "Make the following text shorter while keeping its meaning.",
+	pb.RpcAIWritingToolsRequest_EXPAND:          "Expand the following text with more details while keeping its meaning and tone.",
+	pb.RpcAIWritingToolsRequest_BULLET:          "Turn the following text into a markdown bullet list.",
+	pb.RpcAIWritingToolsRequest_TABLE:           "Turn the following text into a markdown table.",
+	pb.RpcAIWritingToolsRequest_CASUAL:          "Rewrite the following text in a casual tone.",
+	pb.RpcAIWritingToolsRequest_FUNNY:           "Rewrite the following text in a funny tone.",
+	pb.RpcAIWritingToolsRequest_CONFIDENT:       "Rewrite the following text in a confident tone.",
+	pb.RpcAIWritingToolsRequest_STRAIGHTFORWARD: "Rewrite the following text in a straightforward tone.",
+	pb.RpcAIWritingToolsRequest_PROFESSIONAL:    "Rewrite the following text in a professional tone.",
+	pb.RpcAIWritingToolsRequest_TRANSLATE:       "Translate the following text into %s.",
+}
+
+var languageNames = map[pb.RpcAIWritingToolsRequestLanguage]string{
+	pb.RpcAIWritingToolsRequest_EN: "English",
+	pb.RpcAIWritingToolsRequest_ES: "Spanish",
+	pb.RpcAIWritingToolsRequest_FR: "French",
+	pb.RpcAIWritingToolsRequest_DE: "German",
+	pb.RpcAIWritingToolsRequest_IT: "Italian",
+	pb.RpcAIWritingToolsRequest_PT: "Portuguese",
+	pb.RpcAIWritingToolsRequest_HI: "Hindi",
+	pb.RpcAIWritingToolsRequest_TH: "Thai",
+}
+
+var autofillModePrompts = map[pb.RpcAIAutofillRequestAutofillMode]string{
+	pb.RpcAIAutofillRequest_TAG:         "Choose the tags from the options which describe the content best. Answer with the chosen options separated by commas.",
+	pb.RpcAIAutofillRequest_RELATION:    "Choose the property from the options which fits the content best. Answer with one option.",
+	pb.RpcAIAutofillRequest_TYPE:        "Choose the object type from the options which fits the content best. Answer with one option.",
+	pb.RpcAIAutofillRequest_TITLE:       "Write a short title for the content. Answer with the title only.",
+	pb.RpcAIAutofillRequest_DESCRIPTION: "Write a one sentence description of the content.",
+}
+
+const summaryPrompt = "Summarize the following notes into a single markdown document. " +
+	"Start with a level one heading containing a short title, group related facts under subheadings and keep the language of the notes."
+
+const urlSummaryPrompt = "Summarize the following web page into a markdown document with a short overview paragraph " +
+	"followed by the key points as a bullet list. Keep the language of the page."
+
+func writingToolsSystemPrompt(mode pb.RpcAIWritingToolsRequestWritingMode, language pb.RpcAIWritingToolsRequestLanguage) (string, error) {
+	prompt, ok := writingModePrompts[mode]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown writing mode %d", ErrBadInput, mode)
+	}
+	languageName, ok := languageNames[language]
+	if !ok {
+		return "", fmt.Errorf("%w: %d", ErrLanguageNotSupported, language)
+	}
+	if mode == pb.RpcAIWritingToolsRequest_TRANSLATE {
+		return baseSystemPrompt + "\n" + fmt.Sprintf(prompt, languageName), nil
+	}
+	return baseSystemPrompt + "\n" + prompt + " Answer in the language of the text.", nil
+}
+
+func autofillSystemPrompt(mode pb.RpcAIAutofillRequestAutofillMode, options []string) (string, error) {
+	prompt, ok := autofillModePrompts[mode]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown autofill mode %d", ErrBadInput, mode)
+	}
+	if len(options) > 0 {
+		prompt += "\nOptions:\n- " + strings.Join(options, "\n- ")
+	}
+	return baseSystemPrompt + "\n" + prompt, nil
+}
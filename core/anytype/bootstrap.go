@@ -33,9 +33,11 @@ import (
 	"github.com/anyproto/any-sync/paymentservice/paymentserviceclient"
 
 	"github.com/anyproto/anytype-heart/core/acl"
+	"github.com/anyproto/anytype-heart/core/ai"
 	"github.com/anyproto/anytype-heart/core/anytype/account"
 	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/core/api"
+	"github.com/anyproto/anytype-heart/core/backup"
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/block/backlinks"
 	"github.com/anyproto/anytype-heart/core/block/bookmark"
@@ -80,6 +82,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/invitestore"
 	"github.com/anyproto/anytype-heart/core/kanban"
 	"github.com/anyproto/anytype-heart/core/nameservice"
+	"github.com/anyproto/anytype-heart/core/notificationrules"
 	"github.com/anyproto/anytype-heart/core/notifications"
 	"github.com/anyproto/anytype-heart/core/payments"
 	paymentscache "github.com/anyproto/anytype-heart/core/payments/cache"
@@ -88,6 +91,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/publish"
 	"github.com/anyproto/anytype-heart/core/pushnotification"
 	"github.com/anyproto/anytype-heart/core/pushnotification/pushclient"
+	"github.com/anyproto/anytype-heart/core/reminders"
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/core/spaceview"
 	"github.com/anyproto/anytype-heart/core/subscription"
@@ -300,6 +304,7 @@ func Bootstrap(a *app.App, components ...app.Component) {
 		Register(gateway.New()).
 		Register(export.New()).
 		Register(linkpreview.New()).
+		Register(ai.New()).
 		Register(unsplash.New()).
 		Register(debug.New()).
 		Register(syncsubscriptions.New()).
@@ -307,6 +312,7 @@ func Bootstrap(a *app.App, components ...app.Component) {
 		Register(gallery.New()).
 		Register(bookmark.New()).
 		Register(importer.New()).
+		Register(backup.New()).
 		Register(decorator.New()).
 		Register(objectcreator.NewCreator()).
 		Register(kanban.New()).
@@ -318,6 +324,8 @@ func Bootstrap(a *app.App, components ...app.Component) {
 		Register(identity.New(30*time.Second, 10*time.Second)).
 		Register(templateimpl.New()).
 		Register(notifications.New(time.Second * 10)).
+		Register(reminders.New()).
+		Register(notificationrules.New()).
 		Register(paymentserviceclient.New()).
 		Register(nameservice.New()).
 		Register(nameserviceclient.New()).
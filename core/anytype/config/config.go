@@ -8,6 +8,10 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync/atomic"
+	"time"
+	// time zone database for platforms that don't ship one, e.g. iOS
+	_ "time/tzdata"
 
 	anystore "github.com/anyproto/any-store"
 	"github.com/anyproto/any-sync/app"
@@ -61,7 +65,8 @@ type ConfigRequired struct {
 	HostAddr            string `json:",omitempty"`
 	CustomFileStorePath string `json:",omitempty"`
 	LegacyFileStorePath string `json:",omitempty"`
-	NetworkId           string `json:""` // in case this account was at least once connected to the network on this device, this field will be set to the network id
+	NetworkId           string `json:""`           // in case this account was at least once connected to the network on this device, this field will be set to the network id
+	TimeZone            string `json:",omitempty"` // IANA name of the account time zone, device time zone is used when empty
 }
 
 type Config struct {
@@ -91,6 +96,7 @@ type Config struct {
 	DisableFileConfig bool `ignored:"true"` // set in order to skip reading/writing config from/to file
 
 	nodeConf nodeconf.Configuration
+	location atomic.Value // *time.Location
 }
 
 func (c *Config) IsLocalOnlyMode() bool {
@@ -285,6 +291,10 @@ func (c *Config) initFromFileAndEnv(repoPath string) error {
 		log.Errorf("failed to read config from env: %v", err)
 	}
 
+	if err = c.SetTimeZone(c.TimeZone); err != nil {
+		log.Errorf("failed to apply time zone from config: %v", err)
+	}
+
 	c.nodeConf, err = c.GetNodeConfWithError()
 	if err != nil {
 		return err
@@ -292,6 +302,27 @@ func (c *Config) initFromFileAndEnv(repoPath string) error {
 	return nil
 }
 
+// SetTimeZone applies the account time zone, relative dates are resolved in it. Empty name stands for the device time zone
+func (c *Config) SetTimeZone(name string) error {
+	var loc *time.Location
+	if name != "" {
+		var err error
+		loc, err = time.LoadLocation(name)
+		if err != nil {
+			return fmt.Errorf("load location: %w", err)
+		}
+	}
+	c.TimeZone = name
+	c.location.Store(loc)
+	return nil
+}
+
+// AccountLocation returns the time zone of the account, nil stands for the device time zone
+func (c *Config) AccountLocation() *time.Location {
+	loc, _ := c.location.Load().(*time.Location)
+	return loc
+}
+
 func (c *Config) Name() (name string) {
 	return CName
 }
@@ -169,6 +169,251 @@ func (_c *MockClientCommands_BlockCreate_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// BlockFileSetTargetObjectId provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockFileSetTargetObjectId(_a0 context.Context, _a1 *pb.RpcBlockFileSetTargetObjectIdRequest) *pb.RpcBlockFileSetTargetObjectIdResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockFileSetTargetObjectId")
+	}
+
+	var r0 *pb.RpcBlockFileSetTargetObjectIdResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockFileSetTargetObjectIdRequest) *pb.RpcBlockFileSetTargetObjectIdResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockFileSetTargetObjectIdResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockFileSetTargetObjectId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockFileSetTargetObjectId'
+type MockClientCommands_BlockFileSetTargetObjectId_Call struct {
+	*mock.Call
+}
+
+// BlockFileSetTargetObjectId is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockFileSetTargetObjectIdRequest
+func (_e *MockClientCommands_Expecter) BlockFileSetTargetObjectId(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockFileSetTargetObjectId_Call {
+	return &MockClientCommands_BlockFileSetTargetObjectId_Call{Call: _e.mock.On("BlockFileSetTargetObjectId", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockFileSetTargetObjectId_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockFileSetTargetObjectIdRequest)) *MockClientCommands_BlockFileSetTargetObjectId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockFileSetTargetObjectIdRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockFileSetTargetObjectId_Call) Return(_a0 *pb.RpcBlockFileSetTargetObjectIdResponse) *MockClientCommands_BlockFileSetTargetObjectId_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockFileSetTargetObjectId_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockFileSetTargetObjectIdRequest) *pb.RpcBlockFileSetTargetObjectIdResponse) *MockClientCommands_BlockFileSetTargetObjectId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockListDelete provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockListDelete(_a0 context.Context, _a1 *pb.RpcBlockListDeleteRequest) *pb.RpcBlockListDeleteResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockListDelete")
+	}
+
+	var r0 *pb.RpcBlockListDeleteResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockListDeleteRequest) *pb.RpcBlockListDeleteResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockListDeleteResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockListDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockListDelete'
+type MockClientCommands_BlockListDelete_Call struct {
+	*mock.Call
+}
+
+// BlockListDelete is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockListDeleteRequest
+func (_e *MockClientCommands_Expecter) BlockListDelete(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockListDelete_Call {
+	return &MockClientCommands_BlockListDelete_Call{Call: _e.mock.On("BlockListDelete", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockListDelete_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockListDeleteRequest)) *MockClientCommands_BlockListDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockListDeleteRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockListDelete_Call) Return(_a0 *pb.RpcBlockListDeleteResponse) *MockClientCommands_BlockListDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockListDelete_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockListDeleteRequest) *pb.RpcBlockListDeleteResponse) *MockClientCommands_BlockListDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockListMoveToExistingObject provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockListMoveToExistingObject(_a0 context.Context, _a1 *pb.RpcBlockListMoveToExistingObjectRequest) *pb.RpcBlockListMoveToExistingObjectResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockListMoveToExistingObject")
+	}
+
+	var r0 *pb.RpcBlockListMoveToExistingObjectResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockListMoveToExistingObjectRequest) *pb.RpcBlockListMoveToExistingObjectResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockListMoveToExistingObjectResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockListMoveToExistingObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockListMoveToExistingObject'
+type MockClientCommands_BlockListMoveToExistingObject_Call struct {
+	*mock.Call
+}
+
+// BlockListMoveToExistingObject is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockListMoveToExistingObjectRequest
+func (_e *MockClientCommands_Expecter) BlockListMoveToExistingObject(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockListMoveToExistingObject_Call {
+	return &MockClientCommands_BlockListMoveToExistingObject_Call{Call: _e.mock.On("BlockListMoveToExistingObject", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockListMoveToExistingObject_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockListMoveToExistingObjectRequest)) *MockClientCommands_BlockListMoveToExistingObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockListMoveToExistingObjectRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockListMoveToExistingObject_Call) Return(_a0 *pb.RpcBlockListMoveToExistingObjectResponse) *MockClientCommands_BlockListMoveToExistingObject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockListMoveToExistingObject_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockListMoveToExistingObjectRequest) *pb.RpcBlockListMoveToExistingObjectResponse) *MockClientCommands_BlockListMoveToExistingObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockListSetAlign provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockListSetAlign(_a0 context.Context, _a1 *pb.RpcBlockListSetAlignRequest) *pb.RpcBlockListSetAlignResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockListSetAlign")
+	}
+
+	var r0 *pb.RpcBlockListSetAlignResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockListSetAlignRequest) *pb.RpcBlockListSetAlignResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockListSetAlignResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockListSetAlign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockListSetAlign'
+type MockClientCommands_BlockListSetAlign_Call struct {
+	*mock.Call
+}
+
+// BlockListSetAlign is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockListSetAlignRequest
+func (_e *MockClientCommands_Expecter) BlockListSetAlign(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockListSetAlign_Call {
+	return &MockClientCommands_BlockListSetAlign_Call{Call: _e.mock.On("BlockListSetAlign", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockListSetAlign_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockListSetAlignRequest)) *MockClientCommands_BlockListSetAlign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockListSetAlignRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockListSetAlign_Call) Return(_a0 *pb.RpcBlockListSetAlignResponse) *MockClientCommands_BlockListSetAlign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockListSetAlign_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockListSetAlignRequest) *pb.RpcBlockListSetAlignResponse) *MockClientCommands_BlockListSetAlign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockListSetBackgroundColor provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockListSetBackgroundColor(_a0 context.Context, _a1 *pb.RpcBlockListSetBackgroundColorRequest) *pb.RpcBlockListSetBackgroundColorResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockListSetBackgroundColor")
+	}
+
+	var r0 *pb.RpcBlockListSetBackgroundColorResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockListSetBackgroundColorRequest) *pb.RpcBlockListSetBackgroundColorResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockListSetBackgroundColorResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockListSetBackgroundColor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockListSetBackgroundColor'
+type MockClientCommands_BlockListSetBackgroundColor_Call struct {
+	*mock.Call
+}
+
+// BlockListSetBackgroundColor is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockListSetBackgroundColorRequest
+func (_e *MockClientCommands_Expecter) BlockListSetBackgroundColor(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockListSetBackgroundColor_Call {
+	return &MockClientCommands_BlockListSetBackgroundColor_Call{Call: _e.mock.On("BlockListSetBackgroundColor", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockListSetBackgroundColor_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockListSetBackgroundColorRequest)) *MockClientCommands_BlockListSetBackgroundColor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockListSetBackgroundColorRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockListSetBackgroundColor_Call) Return(_a0 *pb.RpcBlockListSetBackgroundColorResponse) *MockClientCommands_BlockListSetBackgroundColor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockListSetBackgroundColor_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockListSetBackgroundColorRequest) *pb.RpcBlockListSetBackgroundColorResponse) *MockClientCommands_BlockListSetBackgroundColor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BlockPaste provides a mock function with given fields: _a0, _a1
 func (_m *MockClientCommands) BlockPaste(_a0 context.Context, _a1 *pb.RpcBlockPasteRequest) *pb.RpcBlockPasteResponse {
 	ret := _m.Called(_a0, _a1)
@@ -218,6 +463,202 @@ func (_c *MockClientCommands_BlockPaste_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// BlockTextSetChecked provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockTextSetChecked(_a0 context.Context, _a1 *pb.RpcBlockTextSetCheckedRequest) *pb.RpcBlockTextSetCheckedResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockTextSetChecked")
+	}
+
+	var r0 *pb.RpcBlockTextSetCheckedResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockTextSetCheckedRequest) *pb.RpcBlockTextSetCheckedResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockTextSetCheckedResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockTextSetChecked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockTextSetChecked'
+type MockClientCommands_BlockTextSetChecked_Call struct {
+	*mock.Call
+}
+
+// BlockTextSetChecked is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockTextSetCheckedRequest
+func (_e *MockClientCommands_Expecter) BlockTextSetChecked(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockTextSetChecked_Call {
+	return &MockClientCommands_BlockTextSetChecked_Call{Call: _e.mock.On("BlockTextSetChecked", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockTextSetChecked_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockTextSetCheckedRequest)) *MockClientCommands_BlockTextSetChecked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockTextSetCheckedRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetChecked_Call) Return(_a0 *pb.RpcBlockTextSetCheckedResponse) *MockClientCommands_BlockTextSetChecked_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetChecked_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockTextSetCheckedRequest) *pb.RpcBlockTextSetCheckedResponse) *MockClientCommands_BlockTextSetChecked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockTextSetColor provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockTextSetColor(_a0 context.Context, _a1 *pb.RpcBlockTextSetColorRequest) *pb.RpcBlockTextSetColorResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockTextSetColor")
+	}
+
+	var r0 *pb.RpcBlockTextSetColorResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockTextSetColorRequest) *pb.RpcBlockTextSetColorResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockTextSetColorResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockTextSetColor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockTextSetColor'
+type MockClientCommands_BlockTextSetColor_Call struct {
+	*mock.Call
+}
+
+// BlockTextSetColor is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockTextSetColorRequest
+func (_e *MockClientCommands_Expecter) BlockTextSetColor(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockTextSetColor_Call {
+	return &MockClientCommands_BlockTextSetColor_Call{Call: _e.mock.On("BlockTextSetColor", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockTextSetColor_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockTextSetColorRequest)) *MockClientCommands_BlockTextSetColor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockTextSetColorRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetColor_Call) Return(_a0 *pb.RpcBlockTextSetColorResponse) *MockClientCommands_BlockTextSetColor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetColor_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockTextSetColorRequest) *pb.RpcBlockTextSetColorResponse) *MockClientCommands_BlockTextSetColor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockTextSetStyle provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockTextSetStyle(_a0 context.Context, _a1 *pb.RpcBlockTextSetStyleRequest) *pb.RpcBlockTextSetStyleResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockTextSetStyle")
+	}
+
+	var r0 *pb.RpcBlockTextSetStyleResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockTextSetStyleRequest) *pb.RpcBlockTextSetStyleResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockTextSetStyleResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockTextSetStyle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockTextSetStyle'
+type MockClientCommands_BlockTextSetStyle_Call struct {
+	*mock.Call
+}
+
+// BlockTextSetStyle is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockTextSetStyleRequest
+func (_e *MockClientCommands_Expecter) BlockTextSetStyle(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockTextSetStyle_Call {
+	return &MockClientCommands_BlockTextSetStyle_Call{Call: _e.mock.On("BlockTextSetStyle", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockTextSetStyle_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockTextSetStyleRequest)) *MockClientCommands_BlockTextSetStyle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockTextSetStyleRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetStyle_Call) Return(_a0 *pb.RpcBlockTextSetStyleResponse) *MockClientCommands_BlockTextSetStyle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetStyle_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockTextSetStyleRequest) *pb.RpcBlockTextSetStyleResponse) *MockClientCommands_BlockTextSetStyle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockTextSetText provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockTextSetText(_a0 context.Context, _a1 *pb.RpcBlockTextSetTextRequest) *pb.RpcBlockTextSetTextResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockTextSetText")
+	}
+
+	var r0 *pb.RpcBlockTextSetTextResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockTextSetTextRequest) *pb.RpcBlockTextSetTextResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockTextSetTextResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockTextSetText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockTextSetText'
+type MockClientCommands_BlockTextSetText_Call struct {
+	*mock.Call
+}
+
+// BlockTextSetText is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockTextSetTextRequest
+func (_e *MockClientCommands_Expecter) BlockTextSetText(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockTextSetText_Call {
+	return &MockClientCommands_BlockTextSetText_Call{Call: _e.mock.On("BlockTextSetText", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockTextSetText_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockTextSetTextRequest)) *MockClientCommands_BlockTextSetText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockTextSetTextRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetText_Call) Return(_a0 *pb.RpcBlockTextSetTextResponse) *MockClientCommands_BlockTextSetText_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetText_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockTextSetTextRequest) *pb.RpcBlockTextSetTextResponse) *MockClientCommands_BlockTextSetText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ObjectCollectionAdd provides a mock function with given fields: _a0, _a1
 func (_m *MockClientCommands) ObjectCollectionAdd(_a0 context.Context, _a1 *pb.RpcObjectCollectionAddRequest) *pb.RpcObjectCollectionAddResponse {
 	ret := _m.Called(_a0, _a1)
@@ -0,0 +1,304 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/core/api/service"
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+// ListBlocksHandler retrieves a list of blocks of an object
+//
+//	@Summary		List blocks
+//	@Description	Retrieves a paginated list of the blocks of an object in the order they appear in the document. Nested blocks follow their parent, and each block carries the ids of its parent and children, so the tree can be rebuilt on the client. The title and description are part of the object properties and are not returned as blocks.
+//	@Id				list_blocks
+//	@Tags			Blocks
+//	@Produce		json
+//	@Param			Anytype-Version	header		string											true	"The version of the API to use"	default(2025-05-20)
+//	@Param			space_id		path		string											true	"The ID of the space in which the object exists; must be retrieved from ListSpaces endpoint"
+//	@Param			object_id		path		string											true	"The ID of the object to list blocks of; must be retrieved from ListObjects, SearchSpace or GlobalSearch endpoints or obtained from response context"
+//	@Param			offset			query		int												false	"The number of items to skip before starting to collect the result set"	default(0)
+//	@Param			limit			query		int												false	"The number of items to return"											default(100)	maximum(1000)
+//	@Success		200				{object}	pagination.PaginatedResponse[apimodel.Block]	"The list of blocks of the object"
+//	@Failure		401				{object}	util.UnauthorizedError							"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError								"Resource not found"
+//	@Failure		410				{object}	util.GoneError									"Resource deleted"
+//	@Failure		500				{object}	util.ServerError								"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/objects/{object_id}/blocks [get]
+func ListBlocksHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+		offset := c.GetInt("offset")
+		limit := c.GetInt("limit")
+
+		blocks, total, hasMore, err := s.ListBlocks(c.Request.Context(), spaceId, objectId, offset, limit)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedRetrieveBlocks, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		pagination.RespondWithPagination(c, http.StatusOK, blocks, total, offset, limit, hasMore)
+	}
+}
+
+// GetBlockHandler retrieves a block of an object
+//
+//	@Summary		Get block
+//	@Description	Fetches a single block of an object by its ID. The response includes the content of the block (text with its style and marks, file or link), its appearance and the ids of its parent and children.
+//	@Id				get_block
+//	@Tags			Blocks
+//	@Produce		json
+//	@Param			Anytype-Version	header		string					true	"The version of the API to use"	default(2025-05-20)
+//	@Param			space_id		path		string					true	"The ID of the space in which the object exists; must be retrieved from ListSpaces endpoint"
+//	@Param			object_id		path		string					true	"The ID of the object the block belongs to; must be retrieved from ListObjects, SearchSpace or GlobalSearch endpoints or obtained from response context"
+//	@Param			block_id		path		string					true	"The ID of the block to retrieve; must be retrieved from ListBlocks endpoint or obtained from response context"
+//	@Success		200				{object}	apimodel.BlockResponse	"The retrieved block"
+//	@Failure		401				{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError		"Resource not found"
+//	@Failure		410				{object}	util.GoneError			"Resource deleted"
+//	@Failure		500				{object}	util.ServerError		"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/objects/{object_id}/blocks/{block_id} [get]
+func GetBlockHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+		blockId := c.Param("block_id")
+
+		block, err := s.GetBlock(c.Request.Context(), spaceId, objectId, blockId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrBlockNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedRetrieveBlocks, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, apimodel.BlockResponse{Block: block})
+	}
+}
+
+// CreateBlockHandler creates a new block in an object
+//
+//	@Summary		Create block
+//	@Description	Creates a new block in the object using a JSON payload. Exactly one of text, file or link content must be given. When no target block is given, the block is appended to the end of the object, which allows adding content to an object without rewriting its body. Otherwise the block is placed on top of, below or inside the target block. The creation process is subject to rate limiting.
+//	@Id				create_block
+//	@Tags			Blocks
+//	@Accept			json
+//	@Produce		json
+//	@Param			Anytype-Version	header		string						true	"The version of the API to use"	default(2025-05-20)
+//	@Param			space_id		path		string						true	"The ID of the space in which the object exists; must be retrieved from ListSpaces endpoint"
+//	@Param			object_id		path		string						true	"The ID of the object to create the block in; must be retrieved from ListObjects, SearchSpace or GlobalSearch endpoints or obtained from response context"
+//	@Param			block			body		apimodel.CreateBlockRequest	true	"The block to create"
+//	@Success		201				{object}	apimodel.BlockResponse		"The created block"
+//	@Failure		400				{object}	util.ValidationError		"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError		"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError			"Resource not found"
+//	@Failure		410				{object}	util.GoneError				"Resource deleted"
+//	@Failure		429				{object}	util.RateLimitError			"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError			"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/objects/{object_id}/blocks [post]
+func CreateBlockHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+
+		request := apimodel.CreateBlockRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		block, err := s.CreateBlock(c.Request.Context(), spaceId, objectId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(util.ErrBad, http.StatusBadRequest),
+			util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrBlockNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedCreateBlock, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedRetrieveBlocks, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusCreated, apimodel.BlockResponse{Block: block})
+	}
+}
+
+// UpdateBlockHandler updates a block of an object
+//
+//	@Summary		Update block
+//	@Description	Updates an existing block using a JSON payload. Only the given fields are changed: the text, marks, style, checkbox state and color of text blocks, the file shown by file blocks, and the background color and alignment of any block. Setting the text without marks clears the marks of the block. The update process is subject to rate limiting.
+//	@Id				update_block
+//	@Tags			Blocks
+//	@Accept			json
+//	@Produce		json
+//	@Param			Anytype-Version	header		string						true	"The version of the API to use"	default(2025-05-20)
+//	@Param			space_id		path		string						true	"The ID of the space in which the object exists; must be retrieved from ListSpaces endpoint"
+//	@Param			object_id		path		string						true	"The ID of the object the block belongs to; must be retrieved from ListObjects, SearchSpace or GlobalSearch endpoints or obtained from response context"
+//	@Param			block_id		path		string						true	"The ID of the block to update; must be retrieved from ListBlocks endpoint or obtained from response context"
+//	@Param			block			body		apimodel.UpdateBlockRequest	true	"The fields of the block to update"
+//	@Success		200				{object}	apimodel.BlockResponse		"The updated block"
+//	@Failure		400				{object}	util.ValidationError		"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError		"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError			"Resource not found"
+//	@Failure		410				{object}	util.GoneError				"Resource deleted"
+//	@Failure		429				{object}	util.RateLimitError			"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError			"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/objects/{object_id}/blocks/{block_id} [patch]
+func UpdateBlockHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+		blockId := c.Param("block_id")
+
+		request := apimodel.UpdateBlockRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		block, err := s.UpdateBlock(c.Request.Context(), spaceId, objectId, blockId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(util.ErrBad, http.StatusBadRequest),
+			util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrBlockNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedUpdateBlock, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedRetrieveBlocks, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, apimodel.BlockResponse{Block: block})
+	}
+}
+
+// MoveBlockHandler moves a block within an object
+//
+//	@Summary		Move block
+//	@Description	Moves a block with its children to another position within the same object. The block is placed on top of, below or inside the target block, or at the end of the object when no target block is given. The move is subject to rate limiting.
+//	@Id				move_block
+//	@Tags			Blocks
+//	@Accept			json
+//	@Produce		json
+//	@Param			Anytype-Version	header		string						true	"The version of the API to use"	default(2025-05-20)
+//	@Param			space_id		path		string						true	"The ID of the space in which the object exists; must be retrieved from ListSpaces endpoint"
+//	@Param			object_id		path		string						true	"The ID of the object the block belongs to; must be retrieved from ListObjects, SearchSpace or GlobalSearch endpoints or obtained from response context"
+//	@Param			block_id		path		string						true	"The ID of the block to move; must be retrieved from ListBlocks endpoint or obtained from response context"
+//	@Param			move			body		apimodel.MoveBlockRequest	true	"The new position of the block"
+//	@Success		200				{object}	apimodel.BlockResponse		"The moved block"
+//	@Failure		400				{object}	util.ValidationError		"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError		"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError			"Resource not found"
+//	@Failure		410				{object}	util.GoneError				"Resource deleted"
+//	@Failure		429				{object}	util.RateLimitError			"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError			"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/objects/{object_id}/blocks/{block_id}/move [post]
+func MoveBlockHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+		blockId := c.Param("block_id")
+
+		request := apimodel.MoveBlockRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		block, err := s.MoveBlock(c.Request.Context(), spaceId, objectId, blockId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(util.ErrBad, http.StatusBadRequest),
+			util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrBlockNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedMoveBlock, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedRetrieveBlocks, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, apimodel.BlockResponse{Block: block})
+	}
+}
+
+// DeleteBlockHandler deletes a block of an object
+//
+//	@Summary		Delete block
+//	@Description	Deletes a block together with its children from the object. The deletion is subject to rate limiting. It returns the block as it was before the deletion.
+//	@Id				delete_block
+//	@Tags			Blocks
+//	@Produce		json
+//	@Param			Anytype-Version	header		string					true	"The version of the API to use"	default(2025-05-20)
+//	@Param			space_id		path		string					true	"The ID of the space in which the object exists; must be retrieved from ListSpaces endpoint"
+//	@Param			object_id		path		string					true	"The ID of the object the block belongs to; must be retrieved from ListObjects, SearchSpace or GlobalSearch endpoints or obtained from response context"
+//	@Param			block_id		path		string					true	"The ID of the block to delete; must be retrieved from ListBlocks endpoint or obtained from response context"
+//	@Success		200				{object}	apimodel.BlockResponse	"The deleted block"
+//	@Failure		401				{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError		"Resource not found"
+//	@Failure		410				{object}	util.GoneError			"Resource deleted"
+//	@Failure		429				{object}	util.RateLimitError		"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError		"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/objects/{object_id}/blocks/{block_id} [delete]
+func DeleteBlockHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+		blockId := c.Param("block_id")
+
+		block, err := s.DeleteBlock(c.Request.Context(), spaceId, objectId, blockId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrBlockNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedDeleteBlock, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedRetrieveBlocks, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, apimodel.BlockResponse{Block: block})
+	}
+}
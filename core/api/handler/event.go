@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/service"
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+const eventStreamHeartbeat = 30 * time.Second
+
+// StreamEventsHandler streams the object events of a space
+//
+//	@Summary		Stream events
+//	@Description	Opens a server-sent events stream of the changes to the objects of a space: creation, update, archival and deletion. Each event carries the id and type of the object and, for updates, the keys of the changed properties. The stream can be narrowed down to event types, object types, changed properties or the objects of a set or collection. Every event id is a cursor; pass the last received one as cursor or Last-Event-ID header to resume after a reconnect without missing events. Only the latest events are kept, so a cursor that is too old is rejected with 410 and the client has to reload the objects it tracks.
+//	@Id				stream_events
+//	@Tags			Events
+//	@Produce		text/event-stream
+//	@Param			Anytype-Version	header		string					true	"The version of the API to use"	default(2025-05-20)
+//	@Param			Last-Event-ID	header		string					false	"The id of the last received event to resume after; the cursor query parameter takes precedence"
+//	@Param			space_id		path		string					true	"The ID of the space to stream events of; must be retrieved from ListSpaces endpoint"
+//	@Param			events			query		string					false	"Comma-separated event types to stream: object.created, object.updated, object.archived, object.deleted; all types when omitted"
+//	@Param			types			query		string					false	"Comma-separated keys of the object types to stream events for; all types when omitted"
+//	@Param			properties		query		string					false	"Comma-separated property keys; update events are streamed only when one of them changed"
+//	@Param			list_id			query		string					false	"The ID of a set or collection; only events of its objects are streamed"
+//	@Param			cursor			query		string					false	"The id of the event to resume after; new events only when omitted"
+//	@Success		200				{object}	apimodel.ObjectEvent	"The stream of object events"
+//	@Failure		400				{object}	util.ValidationError	"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError		"Resource not found"
+//	@Failure		410				{object}	util.GoneError			"Cursor expired"
+//	@Failure		500				{object}	util.ServerError		"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/events [get]
+func StreamEventsHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+
+		filter, err := parseEventFilter(c)
+		if err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		cursor := c.Query("cursor")
+		if cursor == "" {
+			cursor = c.GetHeader("Last-Event-ID")
+		}
+
+		stream, err := s.SubscribeObjectEvents(c.Request.Context(), spaceId, filter, cursor)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(service.ErrInvalidEventCursor, http.StatusBadRequest),
+			util.ErrToCode(service.ErrUnsupportedListType, http.StatusBadRequest),
+			util.ErrToCode(service.ErrFailedGetList, http.StatusNotFound),
+			util.ErrToCode(service.ErrEventCursorExpired, http.StatusGone),
+			util.ErrToCode(service.ErrFailedSubscribeEvents, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+		defer stream.Close()
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		c.Status(http.StatusOK)
+		c.Writer.Flush()
+
+		for {
+			ctx, cancel := context.WithTimeout(c.Request.Context(), eventStreamHeartbeat)
+			events, err := stream.Next(ctx)
+			cancel()
+
+			switch {
+			case err == nil:
+				for _, event := range events {
+					c.Render(-1, sse.Event{Id: event.Id, Event: string(event.Type), Data: event})
+				}
+			case c.Request.Context().Err() != nil:
+				return
+			case errors.Is(err, context.DeadlineExceeded):
+				// keep idle connections open through proxies
+				_, _ = c.Writer.WriteString(": ping\n\n")
+			default:
+				code := util.MapErrorCode(err,
+					util.ErrToCode(service.ErrEventCursorExpired, http.StatusGone),
+					util.ErrToCode(service.ErrFailedSubscribeEvents, http.StatusInternalServerError),
+				)
+				c.Render(-1, sse.Event{Event: "error", Data: util.CodeToAPIError(code, err.Error())})
+				c.Writer.Flush()
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+// parseEventFilter reads the comma-separated filter query parameters of an event stream
+func parseEventFilter(c *gin.Context) (service.ObjectEventFilter, error) {
+	filter := service.ObjectEventFilter{
+		Types:      splitQuery(c, "types"),
+		Properties: splitQuery(c, "properties"),
+		ListId:     c.Query("list_id"),
+	}
+	for _, raw := range splitQuery(c, "events") {
+		eventType, err := apimodel.ParseObjectEventType(raw)
+		if err != nil {
+			return service.ObjectEventFilter{}, err
+		}
+		filter.Events = append(filter.Events, eventType)
+	}
+	return filter, nil
+}
+
+func splitQuery(c *gin.Context, key string) []string {
+	var values []string
+	for _, value := range c.QueryArray(key) {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
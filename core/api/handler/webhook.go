@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/core/api/service"
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+// ListWebhooksHandler retrieves a list of webhooks of a space
+//
+//	@Summary		List webhooks
+//	@Description	Retrieves a paginated list of the webhooks registered for a space, along with the cursor of the last delivered event and the error of the last failed delivery. Webhooks live as long as the API server and have to be registered again after a restart.
+//	@Id				list_webhooks
+//	@Tags			Events
+//	@Produce		json
+//	@Param			Anytype-Version	header		string											true	"The version of the API to use"	default(2025-05-20)
+//	@Param			space_id		path		string											true	"The ID of the space to list webhooks of; must be retrieved from ListSpaces endpoint"
+//	@Param			offset			query		int												false	"The number of items to skip before starting to collect the result set"	default(0)
+//	@Param			limit			query		int												false	"The number of items to return"											default(100)	maximum(1000)
+//	@Success		200				{object}	pagination.PaginatedResponse[apimodel.Webhook]	"The list of webhooks of the space"
+//	@Failure		401				{object}	util.UnauthorizedError							"Unauthorized"
+//	@Failure		500				{object}	util.ServerError								"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/webhooks [get]
+func ListWebhooksHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		offset := c.GetInt("offset")
+		limit := c.GetInt("limit")
+
+		webhooks, total, hasMore, err := s.ListWebhooks(c.Request.Context(), spaceId, offset, limit)
+		code := util.MapErrorCode(err)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		pagination.RespondWithPagination(c, http.StatusOK, webhooks, total, offset, limit, hasMore)
+	}
+}
+
+// CreateWebhookHandler registers a webhook for the object events of a space
+//
+//	@Summary		Create webhook
+//	@Description	Registers a local url to receive the object events of a space. Every event matching the filters is posted to the url as JSON, in the same format as in the event stream. Deliveries happen one at a time and in order; failed ones are retried with increasing delays. Only http(s) urls on localhost are accepted, so events never leave the machine.
+//	@Id				create_webhook
+//	@Tags			Events
+//	@Accept			json
+//	@Produce		json
+//	@Param			Anytype-Version	header		string							true	"The version of the API to use"	default(2025-05-20)
+//	@Param			space_id		path		string							true	"The ID of the space to receive events of; must be retrieved from ListSpaces endpoint"
+//	@Param			webhook			body		apimodel.CreateWebhookRequest	true	"The webhook to create"
+//	@Success		201				{object}	apimodel.WebhookResponse		"The created webhook"
+//	@Failure		400				{object}	util.ValidationError			"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError			"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError				"Resource not found"
+//	@Failure		410				{object}	util.GoneError					"Cursor expired"
+//	@Failure		429				{object}	util.RateLimitError				"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError				"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/webhooks [post]
+func CreateWebhookHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+
+		request := apimodel.CreateWebhookRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		webhook, err := s.CreateWebhook(c.Request.Context(), spaceId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(service.ErrInvalidWebhookUrl, http.StatusBadRequest),
+			util.ErrToCode(service.ErrInvalidEventCursor, http.StatusBadRequest),
+			util.ErrToCode(service.ErrUnsupportedListType, http.StatusBadRequest),
+			util.ErrToCode(service.ErrFailedGetList, http.StatusNotFound),
+			util.ErrToCode(service.ErrEventCursorExpired, http.StatusGone),
+			util.ErrToCode(service.ErrFailedSubscribeEvents, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusCreated, apimodel.WebhookResponse{Webhook: webhook})
+	}
+}
+
+// DeleteWebhookHandler deletes a webhook of a space
+//
+//	@Summary		Delete webhook
+//	@Description	Stops the delivery of events to a webhook and removes it. Events that were not delivered yet are dropped.
+//	@Id				delete_webhook
+//	@Tags			Events
+//	@Produce		json
+//	@Param			Anytype-Version	header		string						true	"The version of the API to use"	default(2025-05-20)
+//	@Param			space_id		path		string						true	"The ID of the space the webhook belongs to; must be retrieved from ListSpaces endpoint"
+//	@Param			webhook_id		path		string						true	"The ID of the webhook to delete; must be retrieved from ListWebhooks endpoint or obtained from response context"
+//	@Success		200				{object}	apimodel.WebhookResponse	"The deleted webhook"
+//	@Failure		401				{object}	util.UnauthorizedError		"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError			"Resource not found"
+//	@Failure		429				{object}	util.RateLimitError			"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError			"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/webhooks/{webhook_id} [delete]
+func DeleteWebhookHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		webhookId := c.Param("webhook_id")
+
+		webhook, err := s.DeleteWebhook(c.Request.Context(), spaceId, webhookId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(service.ErrWebhookNotFound, http.StatusNotFound),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, apimodel.WebhookResponse{Webhook: webhook})
+	}
+}
@@ -0,0 +1,234 @@
+package apimodel
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+type TextStyle string
+
+const (
+	TextStyleParagraph   TextStyle = "paragraph"
+	TextStyleHeader1     TextStyle = "header1"
+	TextStyleHeader2     TextStyle = "header2"
+	TextStyleHeader3     TextStyle = "header3"
+	TextStyleHeader4     TextStyle = "header4"
+	TextStyleQuote       TextStyle = "quote"
+	TextStyleCode        TextStyle = "code"
+	TextStyleTitle       TextStyle = "title"
+	TextStyleCheckbox    TextStyle = "checkbox"
+	TextStyleBulleted    TextStyle = "bulleted"
+	TextStyleNumbered    TextStyle = "numbered"
+	TextStyleToggle      TextStyle = "toggle"
+	TextStyleDescription TextStyle = "description"
+	TextStyleCallout     TextStyle = "callout"
+)
+
+func (ts *TextStyle) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch TextStyle(s) {
+	case TextStyleParagraph, TextStyleHeader1, TextStyleHeader2, TextStyleHeader3, TextStyleHeader4, TextStyleQuote, TextStyleCode, TextStyleCheckbox, TextStyleBulleted, TextStyleNumbered, TextStyleToggle, TextStyleCallout:
+		*ts = TextStyle(s)
+		return nil
+	default:
+		return util.ErrBadInput(fmt.Sprintf("invalid text style: %q", s))
+	}
+}
+
+type MarkType string
+
+const (
+	MarkTypeBold            MarkType = "bold"
+	MarkTypeItalic          MarkType = "italic"
+	MarkTypeStrikethrough   MarkType = "strikethrough"
+	MarkTypeUnderscored     MarkType = "underscored"
+	MarkTypeKeyboard        MarkType = "keyboard"
+	MarkTypeLink            MarkType = "link"
+	MarkTypeTextColor       MarkType = "text_color"
+	MarkTypeBackgroundColor MarkType = "background_color"
+	MarkTypeMention         MarkType = "mention"
+	MarkTypeEmoji           MarkType = "emoji"
+	MarkTypeObject          MarkType = "object"
+)
+
+func (mt *MarkType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch MarkType(s) {
+	case MarkTypeBold, MarkTypeItalic, MarkTypeStrikethrough, MarkTypeUnderscored, MarkTypeKeyboard, MarkTypeLink, MarkTypeTextColor, MarkTypeBackgroundColor, MarkTypeMention, MarkTypeEmoji, MarkTypeObject:
+		*mt = MarkType(s)
+		return nil
+	default:
+		return util.ErrBadInput(fmt.Sprintf("invalid mark type: %q", s))
+	}
+}
+
+type FileType string
+
+const (
+	FileTypeFile  FileType = "file"
+	FileTypeImage FileType = "image"
+	FileTypeVideo FileType = "video"
+	FileTypeAudio FileType = "audio"
+	FileTypePdf   FileType = "pdf"
+)
+
+func (ft *FileType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch FileType(s) {
+	case FileTypeFile, FileTypeImage, FileTypeVideo, FileTypeAudio, FileTypePdf:
+		*ft = FileType(s)
+		return nil
+	default:
+		return util.ErrBadInput(fmt.Sprintf("invalid file type: %q", s))
+	}
+}
+
+type BlockAlign string
+
+const (
+	BlockAlignLeft    BlockAlign = "left"
+	BlockAlignCenter  BlockAlign = "center"
+	BlockAlignRight   BlockAlign = "right"
+	BlockAlignJustify BlockAlign = "justify"
+)
+
+func (ba *BlockAlign) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch BlockAlign(s) {
+	case BlockAlignLeft, BlockAlignCenter, BlockAlignRight, BlockAlignJustify:
+		*ba = BlockAlign(s)
+		return nil
+	default:
+		return util.ErrBadInput(fmt.Sprintf("invalid block align: %q", s))
+	}
+}
+
+type BlockPosition string
+
+const (
+	BlockPositionTop    BlockPosition = "top"
+	BlockPositionBottom BlockPosition = "bottom"
+	BlockPositionInner  BlockPosition = "inner"
+)
+
+func (bp *BlockPosition) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch BlockPosition(s) {
+	case BlockPositionTop, BlockPositionBottom, BlockPositionInner:
+		*bp = BlockPosition(s)
+		return nil
+	default:
+		return util.ErrBadInput(fmt.Sprintf("invalid block position: %q", s))
+	}
+}
+
+type BlockResponse struct {
+	Block Block `json:"block"` // The block
+}
+
+type Block struct {
+	Object          string     `json:"object" example:"block"`                                                          // The data model of the object
+	Id              string     `json:"id" example:"64394517de52ad5acb89c66c"`                                           // The id of the block
+	ParentId        string     `json:"parent_id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"` // The id of the parent block, equals to the object id for top-level blocks
+	ChildrenIds     []string   `json:"children_ids" example:"[\"6797ce8ecda913cde14b02dc\"]"`                           // The ids of the block's children
+	BackgroundColor string     `json:"background_color,omitempty" example:"red"`                                        // The background color of the block
+	Align           BlockAlign `json:"align" example:"left" enums:"left,center,right,justify"`                          // The alignment of the block
+	Text            *Text      `json:"text,omitempty"`                                                                  // The text content of the block, if applicable
+	File            *File      `json:"file,omitempty"`                                                                  // The file content of the block, if applicable
+	Link            *Link      `json:"link,omitempty"`                                                                  // The link content of the block, if applicable
+}
+
+type Text struct {
+	Text    string    `json:"text" example:"Some text..."`                                                                                                                        // The text
+	Style   TextStyle `json:"style" example:"paragraph" enums:"paragraph,header1,header2,header3,header4,quote,code,title,checkbox,bulleted,numbered,toggle,description,callout"` // The style of the text
+	Checked bool      `json:"checked" example:"false"`                                                                                                                            // Whether the checkbox is checked
+	Color   string    `json:"color,omitempty" example:"red"`                                                                                                                      // The color of the text
+	Marks   []Mark    `json:"marks"`                                                                                                                                              // The marks of the text
+}
+
+type Mark struct {
+	From  int      `json:"from" example:"0"`                                                                                                                                    // The start of the marked range, in UTF-16 code units
+	To    int      `json:"to" example:"4"`                                                                                                                                      // The end of the marked range, in UTF-16 code units
+	Type  MarkType `json:"type" binding:"required" example:"bold" enums:"bold,italic,strikethrough,underscored,keyboard,link,text_color,background_color,mention,emoji,object"` // The type of the mark
+	Param string   `json:"param,omitempty" example:"https://anytype.io"`                                                                                                        // The url for links, the object id for mentions and objects, the color name for colors or the emoji
+}
+
+type File struct {
+	TargetObjectId string   `json:"target_object_id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"` // The id of the file object
+	Name           string   `json:"name" example:"document.pdf"`                                                            // The name of the file
+	Type           FileType `json:"type" example:"pdf" enums:"file,image,video,audio,pdf"`                                  // The type of the file
+	Mime           string   `json:"mime" example:"application/pdf"`                                                         // The mime type of the file
+	Size           int64    `json:"size" example:"1024"`                                                                    // The size of the file in bytes
+}
+
+type Link struct {
+	TargetObjectId string `json:"target_object_id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"` // The id of the linked object
+}
+
+type CreateBlockRequest struct {
+	TargetId        string        `json:"target_id,omitempty" example:"64394517de52ad5acb89c66c"`           // The id of the block relative to which the new block is placed; the block is appended to the end of the object when omitted
+	Position        BlockPosition `json:"position,omitempty" example:"bottom" enums:"top,bottom,inner"`     // The position relative to the target block, bottom by default
+	BackgroundColor *Color        `json:"background_color,omitempty" example:"red"`                         // The background color of the block
+	Align           *BlockAlign   `json:"align,omitempty" example:"left" enums:"left,center,right,justify"` // The alignment of the block
+	Text            *TextRequest  `json:"text,omitempty"`                                                   // The text content; exactly one of text, file or link must be set
+	File            *FileRequest  `json:"file,omitempty"`                                                   // The file content; exactly one of text, file or link must be set
+	Link            *LinkRequest  `json:"link,omitempty"`                                                   // The link content; exactly one of text, file or link must be set
+}
+
+type TextRequest struct {
+	Text    string    `json:"text" example:"Some text..."`                                                                                                                // The text
+	Style   TextStyle `json:"style,omitempty" example:"paragraph" enums:"paragraph,header1,header2,header3,header4,quote,code,checkbox,bulleted,numbered,toggle,callout"` // The style of the text, paragraph by default
+	Checked bool      `json:"checked,omitempty" example:"false"`                                                                                                          // Whether the checkbox is checked
+	Color   *Color    `json:"color,omitempty" example:"red"`                                                                                                              // The color of the text
+	Marks   []Mark    `json:"marks,omitempty"`                                                                                                                            // The marks of the text
+}
+
+type FileRequest struct {
+	TargetObjectId string   `json:"target_object_id" binding:"required" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"` // The id of the file object; must be retrieved from SearchSpace endpoint with types: ['file', 'image', 'video', 'audio', 'pdf']
+	Type           FileType `json:"type,omitempty" example:"file" enums:"file,image,video,audio,pdf"`                                          // The type of the file, file by default
+}
+
+type LinkRequest struct {
+	TargetObjectId string `json:"target_object_id" binding:"required" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"` // The id of the object to link to
+}
+
+type UpdateBlockRequest struct {
+	BackgroundColor *Color             `json:"background_color,omitempty" example:"red"`                         // The background color to set for the block
+	Align           *BlockAlign        `json:"align,omitempty" example:"left" enums:"left,center,right,justify"` // The alignment to set for the block
+	Text            *UpdateTextRequest `json:"text,omitempty"`                                                   // The text fields to update; only for text blocks
+	File            *UpdateFileRequest `json:"file,omitempty"`                                                   // The file fields to update; only for file blocks
+}
+
+type UpdateTextRequest struct {
+	Text    *string    `json:"text,omitempty" example:"Some text..."`                                                                                                     // The text to set; the marks are replaced as well
+	Marks   *[]Mark    `json:"marks,omitempty"`                                                                                                                           // The marks to set; the current text is kept when text is omitted
+	Style   *TextStyle `json:"style,omitempty" example:"checkbox" enums:"paragraph,header1,header2,header3,header4,quote,code,checkbox,bulleted,numbered,toggle,callout"` // The style to set
+	Checked *bool      `json:"checked,omitempty" example:"true"`                                                                                                          // Whether the checkbox is checked
+	Color   *Color     `json:"color,omitempty" example:"red"`                                                                                                             // The color of the text
+}
+
+type UpdateFileRequest struct {
+	TargetObjectId string `json:"target_object_id" binding:"required" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"` // The id of the file object to show in the block
+}
+
+type MoveBlockRequest struct {
+	TargetId string        `json:"target_id,omitempty" example:"64394517de52ad5acb89c66c"`       // The id of the block relative to which the block is placed; the block is moved to the end of the object when omitted
+	Position BlockPosition `json:"position,omitempty" example:"bottom" enums:"top,bottom,inner"` // The position relative to the target block, bottom by default
+}
@@ -0,0 +1,77 @@
+package apimodel
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+type ObjectEventType string
+
+const (
+	ObjectEventTypeCreated  ObjectEventType = "object.created"
+	ObjectEventTypeUpdated  ObjectEventType = "object.updated"
+	ObjectEventTypeArchived ObjectEventType = "object.archived"
+	ObjectEventTypeDeleted  ObjectEventType = "object.deleted"
+)
+
+func (et *ObjectEventType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseObjectEventType(s)
+	if err != nil {
+		return err
+	}
+	*et = parsed
+	return nil
+}
+
+// ParseObjectEventType validates the event type given in a request
+func ParseObjectEventType(s string) (ObjectEventType, error) {
+	switch ObjectEventType(s) {
+	case ObjectEventTypeCreated, ObjectEventTypeUpdated, ObjectEventTypeArchived, ObjectEventTypeDeleted:
+		return ObjectEventType(s), nil
+	default:
+		return "", util.ErrBadInput(fmt.Sprintf("invalid event type: %q", s))
+	}
+}
+
+type ObjectEvent struct {
+	Object     string          `json:"object" example:"event"`                                                                             // The data model of the object
+	Id         string          `json:"id" example:"m1x2k3-42"`                                                                             // The cursor of the event; pass it as cursor or Last-Event-ID to resume the stream right after this event
+	Type       ObjectEventType `json:"type" example:"object.updated" enums:"object.created,object.updated,object.archived,object.deleted"` // The type of the event
+	SpaceId    string          `json:"space_id" example:"bafyreigyfkt6rbv24sbv5aq2hko3bhmv5xxlf22b4bypdu6j7hnphm3psq.23me69r569oi1"`       // The id of the space the object belongs to
+	ObjectId   string          `json:"object_id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"`                    // The id of the object
+	TypeKey    string          `json:"type_key" example:"page"`                                                                            // The key of the type of the object
+	Properties []string        `json:"properties,omitempty" example:"[\"done\", \"status\"]"`                                              // The keys of the properties changed by the update
+	Timestamp  string          `json:"timestamp" example:"2025-05-20T12:00:00Z"`                                                           // The time the change was observed, in RFC 3339 format
+}
+
+type WebhookResponse struct {
+	Webhook Webhook `json:"webhook"` // The webhook
+}
+
+type Webhook struct {
+	Object     string            `json:"object" example:"webhook"`                                                                     // The data model of the object
+	Id         string            `json:"id" example:"67b0d3e3cda913b84c1299b1"`                                                        // The id of the webhook
+	Url        string            `json:"url" example:"http://localhost:8080/anytype"`                                                  // The local url the events are posted to
+	SpaceId    string            `json:"space_id" example:"bafyreigyfkt6rbv24sbv5aq2hko3bhmv5xxlf22b4bypdu6j7hnphm3psq.23me69r569oi1"` // The id of the space the events come from
+	Events     []ObjectEventType `json:"events" example:"[\"object.created\"]"`                                                        // The types of events delivered, all types when empty
+	Types      []string          `json:"types" example:"[\"page\", \"task\"]"`                                                         // The keys of the object types to deliver events for, all types when empty
+	Properties []string          `json:"properties" example:"[\"done\"]"`                                                              // The keys of the properties an update must change to be delivered, any update when empty
+	ListId     string            `json:"list_id,omitempty" example:"bafyreigyfkt6rbv24sbv5aq2hko3bhmv5xxlf22b4bypdu6j7hnphm3psq"`      // The id of the set or collection the objects must belong to
+	Cursor     string            `json:"cursor" example:"m1x2k3-42"`                                                                   // The cursor of the last delivered event
+	LastError  string            `json:"last_error,omitempty" example:"connection refused"`                                            // The error of the last failed delivery, empty after a successful one
+}
+
+type CreateWebhookRequest struct {
+	Url        string            `json:"url" binding:"required" example:"http://localhost:8080/anytype"`                          // The url to post the events to; only localhost urls are allowed
+	Events     []ObjectEventType `json:"events,omitempty" example:"[\"object.created\"]"`                                         // The types of events to deliver, all types when empty
+	Types      []string          `json:"types,omitempty" example:"[\"page\", \"task\"]"`                                          // The keys of the object types to deliver events for, all types when empty
+	Properties []string          `json:"properties,omitempty" example:"[\"done\"]"`                                               // The keys of the properties an update must change to be delivered, any update when empty
+	ListId     string            `json:"list_id,omitempty" example:"bafyreigyfkt6rbv24sbv5aq2hko3bhmv5xxlf22b4bypdu6j7hnphm3psq"` // The id of the set or collection the objects must belong to
+	Cursor     string            `json:"cursor,omitempty" example:"m1x2k3-42"`                                                    // The cursor of the event to start after, new events only when empty
+}
@@ -13,11 +13,11 @@ type View struct {
 }
 
 type Filter struct {
-	Id          string         `json:"id" example:"67bf3f21cda9134102e2422c"`                                                                                                                                                   // The id of the filter
-	PropertyKey string         `json:"property_key" example:"name"`                                                                                                                                                             // The property key used for filtering
-	Format      PropertyFormat `json:"format" example:"text" enums:"text,number,select,multi_select,date,files,checkbox,url,email,phone,objects"`                                                                               // The format of the property used for filtering
-	Condition   string         `json:"condition" example:"contains" enums:"equal,not_equal,greater,less,greater_or_equal,less_or_equal,like,not_like,in,not_in,empty,not_empty,all_in,not_all_in,exact_in,not_exact_in,exists"` // The filter condition
-	Value       string         `json:"value" example:"Some value..."`                                                                                                                                                           // The value used for filtering
+	Id          string         `json:"id" example:"67bf3f21cda9134102e2422c"`                                                                                                                                                                                     // The id of the filter
+	PropertyKey string         `json:"property_key" example:"name"`                                                                                                                                                                                               // The property key used for filtering
+	Format      PropertyFormat `json:"format" example:"text" enums:"text,number,select,multi_select,date,files,checkbox,url,email,phone,objects"`                                                                                                                 // The format of the property used for filtering
+	Condition   string         `json:"condition" example:"contains" enums:"equal,not_equal,greater,less,greater_or_equal,less_or_equal,like,not_like,in,not_in,empty,not_empty,all_in,not_all_in,exact_in,not_exact_in,exists,regex,not_regex,matches_full_text"` // The filter condition
+	Value       string         `json:"value" example:"Some value..."`                                                                                                                                                                                             // The value used for filtering
 }
 
 type Sort struct {
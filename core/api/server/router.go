@@ -80,10 +80,18 @@ func (s *Server) NewRouter(mw apicore.ClientCommands, eventService apicore.Event
 	v1.Use(s.ensureAuthenticated(mw))
 	{
 		// Block
-		// TODO: implement create, update and delete block endpoints
-		// v1.POST("/spaces/:space_id/objects/:object_id/blocks", writeRateLimitMW, object.CreateBlockHandler(s.service))
-		// v1.PATCH("/spaces/:space_id/objects/:object_id/blocks/:block_id", writeRateLimitMW, object.UpdateBlockHandler(s.service))
-		// v1.DELETE("/spaces/:space_id/objects/:object_id/blocks/:block_id", writeRateLimitMW, object.DeleteBlockHandler(s.service))
+		v1.GET("/spaces/:space_id/objects/:object_id/blocks", s.ensureAnalyticsEvent("BlockList", eventService), handler.ListBlocksHandler(s.service))
+		v1.GET("/spaces/:space_id/objects/:object_id/blocks/:block_id", s.ensureAnalyticsEvent("BlockOpen", eventService), handler.GetBlockHandler(s.service))
+		v1.POST("/spaces/:space_id/objects/:object_id/blocks", writeRateLimitMW, s.ensureAnalyticsEvent("BlockCreate", eventService), handler.CreateBlockHandler(s.service))
+		v1.PATCH("/spaces/:space_id/objects/:object_id/blocks/:block_id", writeRateLimitMW, s.ensureAnalyticsEvent("BlockUpdate", eventService), handler.UpdateBlockHandler(s.service))
+		v1.POST("/spaces/:space_id/objects/:object_id/blocks/:block_id/move", writeRateLimitMW, s.ensureAnalyticsEvent("BlockMove", eventService), handler.MoveBlockHandler(s.service))
+		v1.DELETE("/spaces/:space_id/objects/:object_id/blocks/:block_id", writeRateLimitMW, s.ensureAnalyticsEvent("BlockDelete", eventService), handler.DeleteBlockHandler(s.service))
+
+		// Event
+		v1.GET("/spaces/:space_id/events", s.ensureAnalyticsEvent("EventStream", eventService), handler.StreamEventsHandler(s.service))
+		v1.GET("/spaces/:space_id/webhooks", s.ensureAnalyticsEvent("WebhookList", eventService), handler.ListWebhooksHandler(s.service))
+		v1.POST("/spaces/:space_id/webhooks", writeRateLimitMW, s.ensureAnalyticsEvent("WebhookCreate", eventService), handler.CreateWebhookHandler(s.service))
+		v1.DELETE("/spaces/:space_id/webhooks/:webhook_id", writeRateLimitMW, s.ensureAnalyticsEvent("WebhookDelete", eventService), handler.DeleteWebhookHandler(s.service))
 
 		// List
 		v1.GET("/spaces/:space_id/lists/:list_id/views", s.ensureAnalyticsEvent("ListGetViews", eventService), handler.GetListViewsHandler(s.service))
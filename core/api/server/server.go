@@ -25,13 +25,13 @@ type Server struct {
 }
 
 // NewServer constructs a new Server with the default config and sets up the routes.
-func NewServer(mw apicore.ClientCommands, accountService apicore.AccountService, eventService apicore.EventService, openapiYAML []byte, openapiJSON []byte) *Server {
+func NewServer(mw apicore.ClientCommands, accountService apicore.AccountService, eventService apicore.EventService, subscriptionService apicore.SubscriptionService, openapiYAML []byte, openapiJSON []byte) *Server {
 	gatewayUrl, techSpaceId, err := getAccountInfo(accountService)
 	if err != nil {
 		panic(err)
 	}
 
-	s := &Server{service: service.NewService(mw, gatewayUrl, techSpaceId)}
+	s := &Server{service: service.NewService(mw, subscriptionService, gatewayUrl, techSpaceId)}
 	s.engine = s.NewRouter(mw, eventService, openapiYAML, openapiJSON)
 	s.KeyToToken = make(map[string]ApiSessionEntry)
 
@@ -53,3 +53,8 @@ func getAccountInfo(accountService apicore.AccountService) (gatewayUrl string, t
 func (s *Server) Engine() *gin.Engine {
 	return s.engine
 }
+
+// Close stops the event streams and webhooks of the server.
+func (s *Server) Close() {
+	s.service.Close()
+}
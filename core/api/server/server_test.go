@@ -26,11 +26,12 @@ func newFixture(t *testing.T) *fixture {
 	mwMock := mock_apicore.NewMockClientCommands(t)
 	accountService := mock_apicore.NewMockAccountService(t)
 	eventService := mock_apicore.NewMockEventService(t)
+	subscriptionService := mock_apicore.NewMockSubscriptionService(t)
 	accountService.On("GetInfo", mock.Anything).Return(&model.AccountInfo{
 		GatewayUrl:  mockedGatewayUrl,
 		TechSpaceId: mockedTechSpaceId,
 	}, nil).Once()
-	server := NewServer(mwMock, accountService, eventService, []byte{}, []byte{})
+	server := NewServer(mwMock, accountService, eventService, subscriptionService, []byte{}, []byte{})
 
 	return &fixture{
 		Server: server,
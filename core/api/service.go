@@ -16,6 +16,7 @@ import (
 	apicore "github.com/anyproto/anytype-heart/core/api/core"
 	"github.com/anyproto/anytype-heart/core/api/server"
 	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/core/subscription"
 )
 
 const (
@@ -39,13 +40,14 @@ type Service interface {
 }
 
 type apiService struct {
-	srv            *server.Server
-	httpSrv        *http.Server
-	mw             apicore.ClientCommands
-	accountService apicore.AccountService
-	eventService   apicore.EventService
-	listenAddr     string
-	lock           sync.Mutex
+	srv                 *server.Server
+	httpSrv             *http.Server
+	mw                  apicore.ClientCommands
+	accountService      apicore.AccountService
+	eventService        apicore.EventService
+	subscriptionService apicore.SubscriptionService
+	listenAddr          string
+	lock                sync.Mutex
 }
 
 func New() Service {
@@ -75,6 +77,7 @@ func (s *apiService) Init(a *app.App) (err error) {
 	s.listenAddr = a.MustComponent(config.CName).(*config.Config).JsonApiListenAddr
 	s.accountService = a.MustComponent(account.CName).(account.Service)
 	s.eventService = a.MustComponent(event.CName).(apicore.EventService)
+	s.subscriptionService = a.MustComponent(subscription.CName).(subscription.Service)
 	return nil
 }
 
@@ -95,7 +98,7 @@ func (s *apiService) runServer() {
 		return
 	}
 
-	s.srv = server.NewServer(s.mw, s.accountService, s.eventService, openapiYAML, openapiJSON)
+	s.srv = server.NewServer(s.mw, s.accountService, s.eventService, s.subscriptionService, openapiYAML, openapiJSON)
 
 	s.httpSrv = &http.Server{
 		Addr:              s.listenAddr,
@@ -125,6 +128,7 @@ func (s *apiService) shutdown(ctx context.Context) (err error) {
 	if err := s.httpSrv.Shutdown(shutdownCtx); err != nil {
 		return err
 	}
+	s.srv.Close()
 
 	return nil
 }
@@ -0,0 +1,562 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf16"
+
+	"github.com/anyproto/anytype-heart/pb"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// headerBlockId is the id of the layout block holding the title, description and featured properties,
+// which are managed through object properties instead of blocks.
+const headerBlockId = "header"
+
+var (
+	ErrBlockNotFound        = errors.New("block not found")
+	ErrFailedRetrieveBlocks = errors.New("failed to retrieve blocks")
+	ErrFailedUpdateBlock    = errors.New("failed to update block")
+	ErrFailedMoveBlock      = errors.New("failed to move block")
+	ErrFailedDeleteBlock    = errors.New("failed to delete block")
+)
+
+var (
+	textStyleToModel = map[apimodel.TextStyle]model.BlockContentTextStyle{
+		apimodel.TextStyleParagraph:   model.BlockContentText_Paragraph,
+		apimodel.TextStyleHeader1:     model.BlockContentText_Header1,
+		apimodel.TextStyleHeader2:     model.BlockContentText_Header2,
+		apimodel.TextStyleHeader3:     model.BlockContentText_Header3,
+		apimodel.TextStyleHeader4:     model.BlockContentText_Header4,
+		apimodel.TextStyleQuote:       model.BlockContentText_Quote,
+		apimodel.TextStyleCode:        model.BlockContentText_Code,
+		apimodel.TextStyleTitle:       model.BlockContentText_Title,
+		apimodel.TextStyleCheckbox:    model.BlockContentText_Checkbox,
+		apimodel.TextStyleBulleted:    model.BlockContentText_Marked,
+		apimodel.TextStyleNumbered:    model.BlockContentText_Numbered,
+		apimodel.TextStyleToggle:      model.BlockContentText_Toggle,
+		apimodel.TextStyleDescription: model.BlockContentText_Description,
+		apimodel.TextStyleCallout:     model.BlockContentText_Callout,
+	}
+
+	markTypeToModel = map[apimodel.MarkType]model.BlockContentTextMarkType{
+		apimodel.MarkTypeBold:            model.BlockContentTextMark_Bold,
+		apimodel.MarkTypeItalic:          model.BlockContentTextMark_Italic,
+		apimodel.MarkTypeStrikethrough:   model.BlockContentTextMark_Strikethrough,
+		apimodel.MarkTypeUnderscored:     model.BlockContentTextMark_Underscored,
+		apimodel.MarkTypeKeyboard:        model.BlockContentTextMark_Keyboard,
+		apimodel.MarkTypeLink:            model.BlockContentTextMark_Link,
+		apimodel.MarkTypeTextColor:       model.BlockContentTextMark_TextColor,
+		apimodel.MarkTypeBackgroundColor: model.BlockContentTextMark_BackgroundColor,
+		apimodel.MarkTypeMention:         model.BlockContentTextMark_Mention,
+		apimodel.MarkTypeEmoji:           model.BlockContentTextMark_Emoji,
+		apimodel.MarkTypeObject:          model.BlockContentTextMark_Object,
+	}
+
+	fileTypeToModel = map[apimodel.FileType]model.BlockContentFileType{
+		apimodel.FileTypeFile:  model.BlockContentFile_File,
+		apimodel.FileTypeImage: model.BlockContentFile_Image,
+		apimodel.FileTypeVideo: model.BlockContentFile_Video,
+		apimodel.FileTypeAudio: model.BlockContentFile_Audio,
+		apimodel.FileTypePdf:   model.BlockContentFile_PDF,
+	}
+
+	blockAlignToModel = map[apimodel.BlockAlign]model.BlockAlign{
+		apimodel.BlockAlignLeft:    model.Block_AlignLeft,
+		apimodel.BlockAlignCenter:  model.Block_AlignCenter,
+		apimodel.BlockAlignRight:   model.Block_AlignRight,
+		apimodel.BlockAlignJustify: model.Block_AlignJustify,
+	}
+
+	blockPositionToModel = map[apimodel.BlockPosition]model.BlockPosition{
+		apimodel.BlockPositionTop:    model.Block_Top,
+		apimodel.BlockPositionBottom: model.Block_Bottom,
+		apimodel.BlockPositionInner:  model.Block_Inner,
+	}
+)
+
+// ListBlocks retrieves a paginated list of blocks of an object in document order.
+func (s *Service) ListBlocks(ctx context.Context, spaceId string, objectId string, offset int, limit int) (blocks []apimodel.Block, total int, hasMore bool, err error) {
+	tree, err := s.getBlockTree(ctx, spaceId, objectId)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	total = len(tree.order)
+	paginatedBlocks, hasMore := pagination.Paginate(tree.order, offset, limit)
+	blocks = make([]apimodel.Block, 0, len(paginatedBlocks))
+	for _, block := range paginatedBlocks {
+		blocks = append(blocks, tree.toApiBlock(block))
+	}
+	return blocks, total, hasMore, nil
+}
+
+// GetBlock retrieves a single block of an object.
+func (s *Service) GetBlock(ctx context.Context, spaceId string, objectId string, blockId string) (apimodel.Block, error) {
+	tree, err := s.getBlockTree(ctx, spaceId, objectId)
+	if err != nil {
+		return apimodel.Block{}, err
+	}
+
+	block, err := tree.get(blockId)
+	if err != nil {
+		return apimodel.Block{}, err
+	}
+	return tree.toApiBlock(block), nil
+}
+
+// CreateBlock creates a new block in an object, by default at the end of the object.
+func (s *Service) CreateBlock(ctx context.Context, spaceId string, objectId string, request apimodel.CreateBlockRequest) (apimodel.Block, error) {
+	block, err := s.buildBlock(spaceId, request)
+	if err != nil {
+		return apimodel.Block{}, err
+	}
+
+	tree, err := s.getBlockTree(ctx, spaceId, objectId)
+	if err != nil {
+		return apimodel.Block{}, err
+	}
+	if request.TargetId != "" {
+		if _, err = tree.get(request.TargetId); err != nil {
+			return apimodel.Block{}, err
+		}
+	}
+
+	resp := s.mw.BlockCreate(ctx, &pb.RpcBlockCreateRequest{
+		ContextId: objectId,
+		TargetId:  request.TargetId,
+		Block:     block,
+		Position:  positionToModel(request.Position),
+	})
+
+	if resp.Error != nil && resp.Error.Code != pb.RpcBlockCreateResponseError_NULL {
+		return apimodel.Block{}, ErrFailedCreateBlock
+	}
+
+	return s.GetBlock(ctx, spaceId, objectId, resp.BlockId)
+}
+
+// UpdateBlock updates the content and the appearance of an existing block.
+func (s *Service) UpdateBlock(ctx context.Context, spaceId string, objectId string, blockId string, request apimodel.UpdateBlockRequest) (apimodel.Block, error) {
+	tree, err := s.getBlockTree(ctx, spaceId, objectId)
+	if err != nil {
+		return apimodel.Block{}, err
+	}
+	block, err := tree.get(blockId)
+	if err != nil {
+		return apimodel.Block{}, err
+	}
+
+	if request.Text != nil {
+		if block.GetText() == nil {
+			return apimodel.Block{}, util.ErrBadInput("text can only be updated for text blocks")
+		}
+		if err := s.updateText(ctx, objectId, block, *request.Text); err != nil {
+			return apimodel.Block{}, err
+		}
+	}
+
+	if request.File != nil {
+		if block.GetFile() == nil {
+			return apimodel.Block{}, util.ErrBadInput("file can only be updated for file blocks")
+		}
+		fileId := s.sanitizedString(request.File.TargetObjectId)
+		if !s.isValidFileReference(spaceId, fileId) {
+			return apimodel.Block{}, util.ErrBadInput("file is not valid")
+		}
+		resp := s.mw.BlockFileSetTargetObjectId(ctx, &pb.RpcBlockFileSetTargetObjectIdRequest{
+			ContextId: objectId,
+			BlockId:   blockId,
+			ObjectId:  fileId,
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockFileSetTargetObjectIdResponseError_NULL {
+			return apimodel.Block{}, ErrFailedUpdateBlock
+		}
+	}
+
+	if request.BackgroundColor != nil {
+		resp := s.mw.BlockListSetBackgroundColor(ctx, &pb.RpcBlockListSetBackgroundColorRequest{
+			ContextId: objectId,
+			BlockIds:  []string{blockId},
+			Color:     string(*request.BackgroundColor),
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockListSetBackgroundColorResponseError_NULL {
+			return apimodel.Block{}, ErrFailedUpdateBlock
+		}
+	}
+
+	if request.Align != nil {
+		resp := s.mw.BlockListSetAlign(ctx, &pb.RpcBlockListSetAlignRequest{
+			ContextId: objectId,
+			BlockIds:  []string{blockId},
+			Align:     blockAlignToModel[*request.Align],
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockListSetAlignResponseError_NULL {
+			return apimodel.Block{}, ErrFailedUpdateBlock
+		}
+	}
+
+	return s.GetBlock(ctx, spaceId, objectId, blockId)
+}
+
+// MoveBlock moves a block to another position within the same object.
+func (s *Service) MoveBlock(ctx context.Context, spaceId string, objectId string, blockId string, request apimodel.MoveBlockRequest) (apimodel.Block, error) {
+	if request.TargetId == blockId {
+		return apimodel.Block{}, util.ErrBadInput("block cannot be moved relative to itself")
+	}
+
+	tree, err := s.getBlockTree(ctx, spaceId, objectId)
+	if err != nil {
+		return apimodel.Block{}, err
+	}
+	if _, err = tree.get(blockId); err != nil {
+		return apimodel.Block{}, err
+	}
+	if request.TargetId != "" {
+		if _, err = tree.get(request.TargetId); err != nil {
+			return apimodel.Block{}, err
+		}
+	}
+
+	resp := s.mw.BlockListMoveToExistingObject(ctx, &pb.RpcBlockListMoveToExistingObjectRequest{
+		ContextId:       objectId,
+		BlockIds:        []string{blockId},
+		TargetContextId: objectId,
+		DropTargetId:    request.TargetId,
+		Position:        positionToModel(request.Position),
+	})
+
+	if resp.Error != nil && resp.Error.Code != pb.RpcBlockListMoveToExistingObjectResponseError_NULL {
+		return apimodel.Block{}, ErrFailedMoveBlock
+	}
+
+	return s.GetBlock(ctx, spaceId, objectId, blockId)
+}
+
+// DeleteBlock deletes a block with all its children from an object.
+func (s *Service) DeleteBlock(ctx context.Context, spaceId string, objectId string, blockId string) (apimodel.Block, error) {
+	block, err := s.GetBlock(ctx, spaceId, objectId, blockId)
+	if err != nil {
+		return apimodel.Block{}, err
+	}
+
+	resp := s.mw.BlockListDelete(ctx, &pb.RpcBlockListDeleteRequest{
+		ContextId: objectId,
+		BlockIds:  []string{blockId},
+	})
+
+	if resp.Error != nil && resp.Error.Code != pb.RpcBlockListDeleteResponseError_NULL {
+		return apimodel.Block{}, ErrFailedDeleteBlock
+	}
+
+	return block, nil
+}
+
+// updateText applies the text related changes of UpdateTextRequest to a text block.
+func (s *Service) updateText(ctx context.Context, objectId string, block *model.Block, request apimodel.UpdateTextRequest) error {
+	if request.Text != nil || request.Marks != nil {
+		text := block.GetText().Text
+		if request.Text != nil {
+			text = *request.Text
+		}
+		var marks []apimodel.Mark
+		if request.Marks != nil {
+			marks = *request.Marks
+		}
+		modelMarks, err := marksToModel(text, marks)
+		if err != nil {
+			return err
+		}
+
+		resp := s.mw.BlockTextSetText(ctx, &pb.RpcBlockTextSetTextRequest{
+			ContextId: objectId,
+			BlockId:   block.Id,
+			Text:      text,
+			Marks:     &model.BlockContentTextMarks{Marks: modelMarks},
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockTextSetTextResponseError_NULL {
+			return ErrFailedUpdateBlock
+		}
+	}
+
+	if request.Style != nil {
+		resp := s.mw.BlockTextSetStyle(ctx, &pb.RpcBlockTextSetStyleRequest{
+			ContextId: objectId,
+			BlockId:   block.Id,
+			Style:     textStyleToModel[*request.Style],
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockTextSetStyleResponseError_NULL {
+			return ErrFailedUpdateBlock
+		}
+	}
+
+	if request.Checked != nil {
+		resp := s.mw.BlockTextSetChecked(ctx, &pb.RpcBlockTextSetCheckedRequest{
+			ContextId: objectId,
+			BlockId:   block.Id,
+			Checked:   *request.Checked,
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockTextSetCheckedResponseError_NULL {
+			return ErrFailedUpdateBlock
+		}
+	}
+
+	if request.Color != nil {
+		resp := s.mw.BlockTextSetColor(ctx, &pb.RpcBlockTextSetColorRequest{
+			ContextId: objectId,
+			BlockId:   block.Id,
+			Color:     string(*request.Color),
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockTextSetColorResponseError_NULL {
+			return ErrFailedUpdateBlock
+		}
+	}
+
+	return nil
+}
+
+// buildBlock creates the block model from CreateBlockRequest.
+func (s *Service) buildBlock(spaceId string, request apimodel.CreateBlockRequest) (*model.Block, error) {
+	contents := 0
+	for _, set := range []bool{request.Text != nil, request.File != nil, request.Link != nil} {
+		if set {
+			contents++
+		}
+	}
+	if contents != 1 {
+		return nil, util.ErrBadInput("exactly one of text, file or link must be set")
+	}
+
+	block := &model.Block{}
+	if request.BackgroundColor != nil {
+		block.BackgroundColor = string(*request.BackgroundColor)
+	}
+	if request.Align != nil {
+		block.Align = blockAlignToModel[*request.Align]
+	}
+
+	switch {
+	case request.Text != nil:
+		marks, err := marksToModel(request.Text.Text, request.Text.Marks)
+		if err != nil {
+			return nil, err
+		}
+		style := model.BlockContentText_Paragraph
+		if request.Text.Style != "" {
+			style = textStyleToModel[request.Text.Style]
+		}
+		text := &model.BlockContentText{
+			Text:    request.Text.Text,
+			Style:   style,
+			Checked: request.Text.Checked,
+			Marks:   &model.BlockContentTextMarks{Marks: marks},
+		}
+		if request.Text.Color != nil {
+			text.Color = string(*request.Text.Color)
+		}
+		block.Content = &model.BlockContentOfText{Text: text}
+	case request.File != nil:
+		fileId := s.sanitizedString(request.File.TargetObjectId)
+		if !s.isValidFileReference(spaceId, fileId) {
+			return nil, util.ErrBadInput("file is not valid")
+		}
+		fileType := model.BlockContentFile_File
+		if request.File.Type != "" {
+			fileType = fileTypeToModel[request.File.Type]
+		}
+		block.Content = &model.BlockContentOfFile{File: &model.BlockContentFile{
+			TargetObjectId: fileId,
+			Type:           fileType,
+			State:          model.BlockContentFile_Done,
+		}}
+	case request.Link != nil:
+		block.Content = &model.BlockContentOfLink{Link: &model.BlockContentLink{
+			TargetBlockId: s.sanitizedString(request.Link.TargetObjectId),
+		}}
+	}
+	return block, nil
+}
+
+// marksToModel converts the marks and validates their ranges against the text.
+func marksToModel(text string, marks []apimodel.Mark) ([]*model.BlockContentTextMark, error) {
+	textLen := len(utf16.Encode([]rune(text)))
+	modelMarks := make([]*model.BlockContentTextMark, 0, len(marks))
+	for _, mark := range marks {
+		if mark.From < 0 || mark.From > mark.To || mark.To > textLen {
+			return nil, util.ErrBadInput(fmt.Sprintf("mark range %d-%d is out of text bounds", mark.From, mark.To))
+		}
+		modelMarks = append(modelMarks, &model.BlockContentTextMark{
+			Range: &model.Range{From: int32(mark.From), To: int32(mark.To)},
+			Type:  markTypeToModel[mark.Type],
+			Param: mark.Param,
+		})
+	}
+	return modelMarks, nil
+}
+
+// positionToModel converts the position, defaulting to bottom.
+func positionToModel(position apimodel.BlockPosition) model.BlockPosition {
+	if p, ok := blockPositionToModel[position]; ok {
+		return p
+	}
+	return model.Block_Bottom
+}
+
+// blockTree is the content of an object with header blocks left out.
+type blockTree struct {
+	objectId string
+	blocks   map[string]*model.Block
+	parents  map[string]string
+	order    []*model.Block
+}
+
+// getBlockTree retrieves the blocks of an object and orders them as they appear in the document.
+func (s *Service) getBlockTree(ctx context.Context, spaceId string, objectId string) (*blockTree, error) {
+	resp := s.mw.ObjectShow(ctx, &pb.RpcObjectShowRequest{
+		SpaceId:  spaceId,
+		ObjectId: objectId,
+	})
+
+	if resp.Error != nil {
+		if resp.Error.Code == pb.RpcObjectShowResponseError_NOT_FOUND {
+			return nil, ErrObjectNotFound
+		}
+
+		if resp.Error.Code == pb.RpcObjectShowResponseError_OBJECT_DELETED {
+			return nil, ErrObjectDeleted
+		}
+
+		if resp.Error.Code != pb.RpcObjectShowResponseError_NULL {
+			return nil, ErrFailedRetrieveBlocks
+		}
+	}
+
+	allBlocks := make(map[string]*model.Block, len(resp.ObjectView.Blocks))
+	for _, block := range resp.ObjectView.Blocks {
+		allBlocks[block.Id] = block
+	}
+
+	root := allBlocks[resp.ObjectView.RootId]
+	if root == nil {
+		return nil, ErrFailedRetrieveBlocks
+	}
+
+	tree := &blockTree{
+		objectId: objectId,
+		blocks:   make(map[string]*model.Block, len(allBlocks)),
+		parents:  make(map[string]string, len(allBlocks)),
+	}
+	var walk func(parentId string, childrenIds []string)
+	walk = func(parentId string, childrenIds []string) {
+		for _, id := range childrenIds {
+			block := allBlocks[id]
+			if block == nil || id == headerBlockId {
+				continue
+			}
+			tree.blocks[id] = block
+			tree.parents[id] = parentId
+			tree.order = append(tree.order, block)
+			walk(id, block.ChildrenIds)
+		}
+	}
+	walk(objectId, root.ChildrenIds)
+	return tree, nil
+}
+
+func (t *blockTree) get(blockId string) (*model.Block, error) {
+	block, ok := t.blocks[blockId]
+	if !ok {
+		return nil, ErrBlockNotFound
+	}
+	return block, nil
+}
+
+// toApiBlock converts a block model to the API block.
+func (t *blockTree) toApiBlock(block *model.Block) apimodel.Block {
+	childrenIds := block.ChildrenIds
+	if childrenIds == nil {
+		childrenIds = []string{}
+	}
+	apiBlock := apimodel.Block{
+		Object:          "block",
+		Id:              block.Id,
+		ParentId:        t.parents[block.Id],
+		ChildrenIds:     childrenIds,
+		BackgroundColor: block.BackgroundColor,
+		Align:           blockAlignFromModel(block.Align),
+	}
+
+	switch content := block.Content.(type) {
+	case *model.BlockContentOfText:
+		marks := make([]apimodel.Mark, 0, len(content.Text.GetMarks().GetMarks()))
+		for _, mark := range content.Text.GetMarks().GetMarks() {
+			marks = append(marks, apimodel.Mark{
+				From:  int(mark.GetRange().GetFrom()),
+				To:    int(mark.GetRange().GetTo()),
+				Type:  markTypeFromModel(mark.Type),
+				Param: mark.Param,
+			})
+		}
+		apiBlock.Text = &apimodel.Text{
+			Text:    content.Text.Text,
+			Style:   textStyleFromModel(content.Text.Style),
+			Checked: content.Text.Checked,
+			Color:   content.Text.Color,
+			Marks:   marks,
+		}
+	case *model.BlockContentOfFile:
+		apiBlock.File = &apimodel.File{
+			TargetObjectId: content.File.TargetObjectId,
+			Name:           content.File.Name,
+			Type:           fileTypeFromModel(content.File.Type),
+			Mime:           content.File.Mime,
+			Size:           content.File.Size_,
+		}
+	case *model.BlockContentOfLink:
+		apiBlock.Link = &apimodel.Link{
+			TargetObjectId: content.Link.TargetBlockId,
+		}
+	}
+	return apiBlock
+}
+
+func textStyleFromModel(style model.BlockContentTextStyle) apimodel.TextStyle {
+	for k, v := range textStyleToModel {
+		if v == style {
+			return k
+		}
+	}
+	return apimodel.TextStyleParagraph
+}
+
+func markTypeFromModel(markType model.BlockContentTextMarkType) apimodel.MarkType {
+	for k, v := range markTypeToModel {
+		if v == markType {
+			return k
+		}
+	}
+	return apimodel.MarkTypeBold
+}
+
+func fileTypeFromModel(fileType model.BlockContentFileType) apimodel.FileType {
+	for k, v := range fileTypeToModel {
+		if v == fileType {
+			return k
+		}
+	}
+	return apimodel.FileTypeFile
+}
+
+func blockAlignFromModel(align model.BlockAlign) apimodel.BlockAlign {
+	for k, v := range blockAlignToModel {
+		if v == align {
+			return k
+		}
+	}
+	return apimodel.BlockAlignLeft
+}
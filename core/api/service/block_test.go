@@ -0,0 +1,344 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	mockedTextBlockId  = "mocked-text-block-id"
+	mockedChildBlockId = "mocked-child-block-id"
+	mockedFileBlockId  = "mocked-file-block-id"
+	mockedNewBlockId   = "mocked-new-block-id"
+	mockedFileObjectId = "mocked-file-object-id"
+)
+
+// mockObjectBlocks mocks ObjectShow with a root, a header and the given blocks, blocks which aren't children of others are placed into the root
+func mockObjectBlocks(fx *fixture, blocks ...*model.Block) *mock.Call {
+	nested := map[string]bool{}
+	for _, b := range blocks {
+		for _, id := range b.ChildrenIds {
+			nested[id] = true
+		}
+	}
+	root := &model.Block{Id: mockedObjectId, ChildrenIds: []string{headerBlockId}}
+	for _, b := range blocks {
+		if !nested[b.Id] {
+			root.ChildrenIds = append(root.ChildrenIds, b.Id)
+		}
+	}
+	header := &model.Block{Id: headerBlockId, ChildrenIds: []string{"title"}}
+	title := &model.Block{Id: "title", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Style: model.BlockContentText_Title}}}
+
+	return fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{
+		SpaceId:  mockedSpaceId,
+		ObjectId: mockedObjectId,
+	}).Return(&pb.RpcObjectShowResponse{
+		ObjectView: &model.ObjectView{
+			RootId: mockedObjectId,
+			Blocks: append([]*model.Block{root, header, title}, blocks...),
+		},
+		Error: &pb.RpcObjectShowResponseError{Code: pb.RpcObjectShowResponseError_NULL},
+	})
+}
+
+func textBlock(id string, text string, childrenIds ...string) *model.Block {
+	return &model.Block{
+		Id:          id,
+		ChildrenIds: childrenIds,
+		Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text:  text,
+			Style: model.BlockContentText_Checkbox,
+			Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+				{Range: &model.Range{From: 0, To: 4}, Type: model.BlockContentTextMark_Bold},
+			}},
+		}},
+	}
+}
+
+func fileBlock(id string) *model.Block {
+	return &model.Block{
+		Id: id,
+		Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+			TargetObjectId: mockedFileObjectId,
+			Name:           "document.pdf",
+			Type:           model.BlockContentFile_PDF,
+			Mime:           "application/pdf",
+			Size_:          1024,
+		}},
+	}
+}
+
+func TestBlockService_ListBlocks(t *testing.T) {
+	t.Run("blocks are listed in document order without header", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		mockObjectBlocks(fx,
+			textBlock(mockedTextBlockId, "Todo item", mockedChildBlockId),
+			textBlock(mockedChildBlockId, "Nested"),
+			fileBlock(mockedFileBlockId),
+		)
+
+		// when
+		blocks, total, hasMore, err := fx.service.ListBlocks(ctx, mockedSpaceId, mockedObjectId, offset, limit)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, 3, total)
+		require.False(t, hasMore)
+		require.Len(t, blocks, 3)
+
+		require.Equal(t, mockedTextBlockId, blocks[0].Id)
+		require.Equal(t, mockedObjectId, blocks[0].ParentId)
+		require.Equal(t, []string{mockedChildBlockId}, blocks[0].ChildrenIds)
+		require.Equal(t, &apimodel.Text{
+			Text:  "Todo item",
+			Style: apimodel.TextStyleCheckbox,
+			Marks: []apimodel.Mark{{From: 0, To: 4, Type: apimodel.MarkTypeBold}},
+		}, blocks[0].Text)
+
+		require.Equal(t, mockedChildBlockId, blocks[1].Id)
+		require.Equal(t, mockedTextBlockId, blocks[1].ParentId)
+
+		require.Equal(t, mockedFileBlockId, blocks[2].Id)
+		require.Equal(t, &apimodel.File{
+			TargetObjectId: mockedFileObjectId,
+			Name:           "document.pdf",
+			Type:           apimodel.FileTypePdf,
+			Mime:           "application/pdf",
+			Size:           1024,
+		}, blocks[2].File)
+	})
+
+	t.Run("object not found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(&pb.RpcObjectShowResponse{
+			Error: &pb.RpcObjectShowResponseError{Code: pb.RpcObjectShowResponseError_NOT_FOUND},
+		}).Once()
+
+		// when
+		_, _, _, err := fx.service.ListBlocks(ctx, mockedSpaceId, mockedObjectId, offset, limit)
+
+		// then
+		require.ErrorIs(t, err, ErrObjectNotFound)
+	})
+}
+
+func TestBlockService_GetBlock(t *testing.T) {
+	t.Run("header blocks are not accessible", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		mockObjectBlocks(fx, textBlock(mockedTextBlockId, "Some text"))
+
+		// when
+		_, err := fx.service.GetBlock(ctx, mockedSpaceId, mockedObjectId, "title")
+
+		// then
+		require.ErrorIs(t, err, ErrBlockNotFound)
+	})
+}
+
+func TestBlockService_CreateBlock(t *testing.T) {
+	t.Run("text block is appended to the end of the object", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		mockObjectBlocks(fx, textBlock(mockedTextBlockId, "Some text")).Once()
+		fx.mwMock.On("BlockCreate", mock.Anything, &pb.RpcBlockCreateRequest{
+			ContextId: mockedObjectId,
+			Block: &model.Block{
+				Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+					Text:  "New line",
+					Style: model.BlockContentText_Paragraph,
+					Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+						{Range: &model.Range{From: 0, To: 3}, Type: model.BlockContentTextMark_Italic},
+					}},
+				}},
+			},
+			Position: model.Block_Bottom,
+		}).Return(&pb.RpcBlockCreateResponse{
+			BlockId: mockedNewBlockId,
+			Error:   &pb.RpcBlockCreateResponseError{Code: pb.RpcBlockCreateResponseError_NULL},
+		}).Once()
+		mockObjectBlocks(fx, textBlock(mockedTextBlockId, "Some text"), &model.Block{
+			Id: mockedNewBlockId,
+			Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+				Text: "New line",
+			}},
+		}).Once()
+
+		// when
+		block, err := fx.service.CreateBlock(ctx, mockedSpaceId, mockedObjectId, apimodel.CreateBlockRequest{
+			Text: &apimodel.TextRequest{
+				Text:  "New line",
+				Marks: []apimodel.Mark{{From: 0, To: 3, Type: apimodel.MarkTypeItalic}},
+			},
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedNewBlockId, block.Id)
+		require.Equal(t, mockedObjectId, block.ParentId)
+		require.Equal(t, "New line", block.Text.Text)
+	})
+
+	t.Run("exactly one content is required", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		_, err := fx.service.CreateBlock(ctx, mockedSpaceId, mockedObjectId, apimodel.CreateBlockRequest{
+			Text: &apimodel.TextRequest{Text: "text"},
+			Link: &apimodel.LinkRequest{TargetObjectId: mockedObjectId},
+		})
+
+		// then
+		require.ErrorIs(t, err, util.ErrBad)
+	})
+
+	t.Run("mark out of text bounds", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		_, err := fx.service.CreateBlock(ctx, mockedSpaceId, mockedObjectId, apimodel.CreateBlockRequest{
+			Text: &apimodel.TextRequest{
+				Text:  "abc",
+				Marks: []apimodel.Mark{{From: 0, To: 4, Type: apimodel.MarkTypeBold}},
+			},
+		})
+
+		// then
+		require.ErrorIs(t, err, util.ErrBad)
+	})
+}
+
+func TestBlockService_UpdateBlock(t *testing.T) {
+	t.Run("text and checkbox are updated", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		mockObjectBlocks(fx, textBlock(mockedTextBlockId, "Todo item"))
+		text := "Done item"
+		checked := true
+		fx.mwMock.On("BlockTextSetText", mock.Anything, &pb.RpcBlockTextSetTextRequest{
+			ContextId: mockedObjectId,
+			BlockId:   mockedTextBlockId,
+			Text:      text,
+			Marks:     &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{}},
+		}).Return(&pb.RpcBlockTextSetTextResponse{
+			Error: &pb.RpcBlockTextSetTextResponseError{Code: pb.RpcBlockTextSetTextResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("BlockTextSetChecked", mock.Anything, &pb.RpcBlockTextSetCheckedRequest{
+			ContextId: mockedObjectId,
+			BlockId:   mockedTextBlockId,
+			Checked:   true,
+		}).Return(&pb.RpcBlockTextSetCheckedResponse{
+			Error: &pb.RpcBlockTextSetCheckedResponseError{Code: pb.RpcBlockTextSetCheckedResponseError_NULL},
+		}).Once()
+
+		// when
+		block, err := fx.service.UpdateBlock(ctx, mockedSpaceId, mockedObjectId, mockedTextBlockId, apimodel.UpdateBlockRequest{
+			Text: &apimodel.UpdateTextRequest{Text: &text, Checked: &checked},
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedTextBlockId, block.Id)
+	})
+
+	t.Run("text cannot be set for a file block", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		mockObjectBlocks(fx, fileBlock(mockedFileBlockId))
+		text := "text"
+
+		// when
+		_, err := fx.service.UpdateBlock(ctx, mockedSpaceId, mockedObjectId, mockedFileBlockId, apimodel.UpdateBlockRequest{
+			Text: &apimodel.UpdateTextRequest{Text: &text},
+		})
+
+		// then
+		require.ErrorIs(t, err, util.ErrBad)
+	})
+}
+
+func TestBlockService_MoveBlock(t *testing.T) {
+	t.Run("block is moved on top of the target", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		mockObjectBlocks(fx, textBlock(mockedTextBlockId, "First"), fileBlock(mockedFileBlockId))
+		fx.mwMock.On("BlockListMoveToExistingObject", mock.Anything, &pb.RpcBlockListMoveToExistingObjectRequest{
+			ContextId:       mockedObjectId,
+			BlockIds:        []string{mockedFileBlockId},
+			TargetContextId: mockedObjectId,
+			DropTargetId:    mockedTextBlockId,
+			Position:        model.Block_Top,
+		}).Return(&pb.RpcBlockListMoveToExistingObjectResponse{
+			Error: &pb.RpcBlockListMoveToExistingObjectResponseError{Code: pb.RpcBlockListMoveToExistingObjectResponseError_NULL},
+		}).Once()
+
+		// when
+		block, err := fx.service.MoveBlock(ctx, mockedSpaceId, mockedObjectId, mockedFileBlockId, apimodel.MoveBlockRequest{
+			TargetId: mockedTextBlockId,
+			Position: apimodel.BlockPositionTop,
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedFileBlockId, block.Id)
+	})
+
+	t.Run("target block not found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		mockObjectBlocks(fx, textBlock(mockedTextBlockId, "First"))
+
+		// when
+		_, err := fx.service.MoveBlock(ctx, mockedSpaceId, mockedObjectId, mockedTextBlockId, apimodel.MoveBlockRequest{
+			TargetId: "unknown",
+		})
+
+		// then
+		require.ErrorIs(t, err, ErrBlockNotFound)
+	})
+}
+
+func TestBlockService_DeleteBlock(t *testing.T) {
+	t.Run("successful deletion", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		mockObjectBlocks(fx, textBlock(mockedTextBlockId, "Some text"))
+		fx.mwMock.On("BlockListDelete", mock.Anything, &pb.RpcBlockListDeleteRequest{
+			ContextId: mockedObjectId,
+			BlockIds:  []string{mockedTextBlockId},
+		}).Return(&pb.RpcBlockListDeleteResponse{
+			Error: &pb.RpcBlockListDeleteResponseError{Code: pb.RpcBlockListDeleteResponseError_NULL},
+		}).Once()
+
+		// when
+		block, err := fx.service.DeleteBlock(ctx, mockedSpaceId, mockedObjectId, mockedTextBlockId)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, "Some text", block.Text.Text)
+	})
+}
@@ -0,0 +1,627 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cheggaaa/mb/v3"
+	"github.com/globalsign/mgo/bson"
+
+	apicore "github.com/anyproto/anytype-heart/core/api/core"
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	objectEventLogSize     = 1000
+	objectEventSubIdPrefix = "api-object-events-"
+	listMembersSubIdPrefix = "api-list-members-"
+)
+
+var (
+	ErrFailedSubscribeEvents = errors.New("failed to subscribe to object events")
+	ErrInvalidEventCursor    = errors.New("invalid event cursor")
+	ErrEventCursorExpired    = errors.New("event cursor expired")
+)
+
+// eventIgnoredProperties are maintained by the middleware itself and don't produce update events on their own
+var eventIgnoredProperties = map[string]bool{
+	bundle.RelationKeyLastModifiedDate.String(): true,
+	bundle.RelationKeyLastModifiedBy.String():   true,
+	bundle.RelationKeyLastOpenedDate.String():   true,
+	bundle.RelationKeyLastUsedDate.String():     true,
+	bundle.RelationKeySyncStatus.String():       true,
+	bundle.RelationKeySyncDate.String():         true,
+	bundle.RelationKeySyncError.String():        true,
+}
+
+// ObjectEventFilter narrows down the events of a stream; empty fields match everything
+type ObjectEventFilter struct {
+	Events     []apimodel.ObjectEventType
+	Types      []string
+	Properties []string
+	ListId     string
+}
+
+// objectEventHub keeps the event logs of all spaces with active streams, so clients can resume from their cursor after reconnecting
+type objectEventHub struct {
+	mu       sync.Mutex
+	closed   bool
+	logs     map[string]*objectEventLog
+	webhooks map[string]*webhook
+}
+
+func newObjectEventHub() *objectEventHub {
+	return &objectEventHub{
+		logs:     make(map[string]*objectEventLog),
+		webhooks: make(map[string]*webhook),
+	}
+}
+
+func (h *objectEventHub) close(subscriptionService apicore.SubscriptionService) {
+	h.mu.Lock()
+	h.closed = true
+	webhooks := h.webhooks
+	logs := h.logs
+	h.webhooks = make(map[string]*webhook)
+	h.logs = make(map[string]*objectEventLog)
+	h.mu.Unlock()
+
+	for _, w := range webhooks {
+		w.stop()
+	}
+	for _, l := range logs {
+		l.stop()
+		if err := subscriptionService.Unsubscribe(l.subId); err != nil {
+			log.Errorf("failed to unsubscribe from object events of space %s: %v", l.spaceId, err)
+		}
+	}
+}
+
+// SubscribeObjectEvents opens a stream of the object events of a space. The stream starts right after the event of the given cursor, or with new events when the cursor is empty.
+func (s *Service) SubscribeObjectEvents(ctx context.Context, spaceId string, filter ObjectEventFilter, cursor string) (*ObjectEventStream, error) {
+	eventLog, err := s.getObjectEventLog(spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	seq, err := eventLog.parseCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := &ObjectEventStream{
+		log:        eventLog,
+		seq:        seq,
+		notify:     make(chan struct{}, 1),
+		events:     toSet(filter.Events),
+		types:      toSet(filter.Types),
+		properties: toSet(filter.Properties),
+	}
+
+	if filter.ListId != "" {
+		stream.members, err = s.subscribeListMembers(ctx, spaceId, filter.ListId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	eventLog.addWatcher(stream.notify)
+	return stream, nil
+}
+
+// getObjectEventLog returns the event log of the space, starting it on first use
+func (s *Service) getObjectEventLog(spaceId string) (*objectEventLog, error) {
+	s.events.mu.Lock()
+	defer s.events.mu.Unlock()
+
+	if s.events.closed {
+		return nil, ErrFailedSubscribeEvents
+	}
+	if eventLog, ok := s.events.logs[spaceId]; ok {
+		return eventLog, nil
+	}
+
+	eventLog, err := s.startObjectEventLog(spaceId)
+	if err != nil {
+		return nil, err
+	}
+	s.events.logs[spaceId] = eventLog
+	return eventLog, nil
+}
+
+// objectEventLog turns the changes of a space-wide subscription into object events and keeps the latest of them for resuming
+type objectEventLog struct {
+	s       *Service
+	spaceId string
+	subId   string
+	epoch   string
+	output  *mb.MB[*pb.EventMessage]
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	mu           sync.Mutex
+	objects      map[string]*domain.Details
+	ring         []apimodel.ObjectEvent
+	lastSeq      uint64
+	watchers     map[chan struct{}]struct{}
+	typeKeys     map[string]string
+	propertyKeys map[string]string
+}
+
+func (s *Service) startObjectEventLog(spaceId string) (*objectEventLog, error) {
+	allRelationKeys, err := util.GetAllRelationKeys(s.mw, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	subId := objectEventSubIdPrefix + spaceId
+	resp, err := s.subscription.Search(subscription.SubscribeRequest{
+		SpaceId: spaceId,
+		SubId:   subId,
+		Filters: database.FiltersFromProto(s.combineFilters(
+			model.BlockContentDataviewFilter_And,
+			s.prepareBaseFilters(),
+			s.prepareTemplateFilter(),
+			[]*model.BlockContentDataviewFilter{
+				// keep archived and deleted objects to report their transitions
+				{RelationKey: bundle.RelationKeyIsArchived.String()},
+				{RelationKey: bundle.RelationKeyIsDeleted.String()},
+			},
+		)),
+		Keys:              allRelationKeys,
+		NoDepSubscription: true,
+		Internal:          true,
+	})
+	if err != nil {
+		return nil, ErrFailedSubscribeEvents
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &objectEventLog{
+		s:            s,
+		spaceId:      spaceId,
+		subId:        subId,
+		epoch:        strconv.FormatInt(time.Now().UnixNano(), 36),
+		output:       resp.Output,
+		ctx:          ctx,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+		objects:      make(map[string]*domain.Details, len(resp.Records)),
+		ring:         make([]apimodel.ObjectEvent, objectEventLogSize),
+		watchers:     make(map[chan struct{}]struct{}),
+		typeKeys:     make(map[string]string),
+		propertyKeys: make(map[string]string),
+	}
+	for _, record := range resp.Records {
+		l.objects[record.GetString(bundle.RelationKeyId)] = record
+	}
+	l.refreshTypeKeys()
+	l.refreshPropertyKeys()
+
+	go l.read()
+	return l, nil
+}
+
+func (l *objectEventLog) stop() {
+	l.cancel()
+	<-l.done
+}
+
+func (l *objectEventLog) read() {
+	defer close(l.done)
+	for {
+		msgs, err := l.output.Wait(l.ctx)
+		if err != nil {
+			return
+		}
+		l.mu.Lock()
+		for _, msg := range msgs {
+			l.handleMessage(msg)
+		}
+		l.mu.Unlock()
+	}
+}
+
+func (l *objectEventLog) handleMessage(msg *pb.EventMessage) {
+	switch v := msg.Value.(type) {
+	case *pb.EventMessageValueOfObjectDetailsSet:
+		id := v.ObjectDetailsSet.Id
+		details := domain.NewDetailsFromProto(v.ObjectDetailsSet.Details)
+		prev, ok := l.objects[id]
+		l.objects[id] = details
+		if !ok {
+			l.publish(apimodel.ObjectEventTypeCreated, id, details, nil)
+			return
+		}
+		l.publishChange(id, prev, details)
+	case *pb.EventMessageValueOfObjectDetailsAmend:
+		id := v.ObjectDetailsAmend.Id
+		prev, ok := l.objects[id]
+		if !ok {
+			return
+		}
+		details := prev.Copy()
+		for _, kv := range v.ObjectDetailsAmend.Details {
+			details.Set(domain.RelationKey(kv.Key), domain.ValueFromProto(kv.Value))
+		}
+		l.objects[id] = details
+		l.publishChange(id, prev, details)
+	case *pb.EventMessageValueOfObjectDetailsUnset:
+		id := v.ObjectDetailsUnset.Id
+		prev, ok := l.objects[id]
+		if !ok {
+			return
+		}
+		details := prev.Copy()
+		for _, key := range v.ObjectDetailsUnset.Keys {
+			details.Delete(domain.RelationKey(key))
+		}
+		l.objects[id] = details
+		l.publishChange(id, prev, details)
+	case *pb.EventMessageValueOfSubscriptionRemove:
+		id := v.SubscriptionRemove.Id
+		prev, ok := l.objects[id]
+		if !ok {
+			return
+		}
+		delete(l.objects, id)
+		if !prev.GetBool(bundle.RelationKeyIsDeleted) {
+			l.publish(apimodel.ObjectEventTypeDeleted, id, prev, nil)
+		}
+	}
+}
+
+// publishChange classifies the change of an object as deletion, archival or update of its properties
+func (l *objectEventLog) publishChange(id string, prev, details *domain.Details) {
+	switch {
+	case !prev.GetBool(bundle.RelationKeyIsDeleted) && details.GetBool(bundle.RelationKeyIsDeleted):
+		l.publish(apimodel.ObjectEventTypeDeleted, id, details, nil)
+	case !prev.GetBool(bundle.RelationKeyIsArchived) && details.GetBool(bundle.RelationKeyIsArchived):
+		l.publish(apimodel.ObjectEventTypeArchived, id, details, nil)
+	default:
+		diff, absentKeys := domain.StructDiff(prev, details)
+		changed := absentKeys
+		if diff != nil {
+			changed = append(changed, diff.Keys()...)
+		}
+
+		var properties []string
+		for _, key := range changed {
+			if eventIgnoredProperties[key.String()] {
+				continue
+			}
+			apiKey := l.propertyKey(key.String())
+			if !slices.Contains(properties, apiKey) {
+				properties = append(properties, apiKey)
+			}
+		}
+		if len(properties) == 0 {
+			return
+		}
+		slices.Sort(properties)
+		l.publish(apimodel.ObjectEventTypeUpdated, id, details, properties)
+	}
+}
+
+func (l *objectEventLog) publish(eventType apimodel.ObjectEventType, id string, details *domain.Details, properties []string) {
+	l.lastSeq++
+	l.ring[l.lastSeq%objectEventLogSize] = apimodel.ObjectEvent{
+		Object:     "event",
+		Id:         l.formatCursor(l.lastSeq),
+		Type:       eventType,
+		SpaceId:    l.spaceId,
+		ObjectId:   id,
+		TypeKey:    l.typeKey(details.GetString(bundle.RelationKeyType)),
+		Properties: properties,
+		Timestamp:  time.Now().UTC().Format(time.RFC3339),
+	}
+	for notify := range l.watchers {
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// since returns the events after the given sequence number along with the number of the last event
+func (l *objectEventLog) since(seq uint64) ([]apimodel.ObjectEvent, uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if seq > l.lastSeq {
+		return nil, 0, ErrInvalidEventCursor
+	}
+	if l.lastSeq-seq > objectEventLogSize {
+		return nil, 0, ErrEventCursorExpired
+	}
+
+	events := make([]apimodel.ObjectEvent, 0, l.lastSeq-seq)
+	for i := seq + 1; i <= l.lastSeq; i++ {
+		events = append(events, l.ring[i%objectEventLogSize])
+	}
+	return events, l.lastSeq, nil
+}
+
+func (l *objectEventLog) addWatcher(notify chan struct{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.watchers[notify] = struct{}{}
+}
+
+func (l *objectEventLog) removeWatcher(notify chan struct{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.watchers, notify)
+}
+
+// formatCursor builds a cursor out of the log epoch and the sequence number of an event, so cursors of a previous run are rejected
+func (l *objectEventLog) formatCursor(seq uint64) string {
+	return fmt.Sprintf("%s-%d", l.epoch, seq)
+}
+
+// parseCursor returns the sequence number of the cursor, or the number of the last event for an empty cursor
+func (l *objectEventLog) parseCursor(cursor string) (uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if cursor == "" {
+		return l.lastSeq, nil
+	}
+
+	epoch, rawSeq, ok := strings.Cut(cursor, "-")
+	if !ok {
+		return 0, ErrInvalidEventCursor
+	}
+	seq, err := strconv.ParseUint(rawSeq, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidEventCursor
+	}
+	if epoch != l.epoch {
+		return 0, ErrEventCursorExpired
+	}
+	if seq > l.lastSeq {
+		return 0, ErrInvalidEventCursor
+	}
+	if l.lastSeq-seq > objectEventLogSize {
+		return 0, ErrEventCursorExpired
+	}
+	return seq, nil
+}
+
+// typeKey resolves the api key of a type id, reloading the types once for unknown ids
+func (l *objectEventLog) typeKey(typeId string) string {
+	if key, ok := l.typeKeys[typeId]; ok {
+		return key
+	}
+	l.refreshTypeKeys()
+	if _, ok := l.typeKeys[typeId]; !ok {
+		l.typeKeys[typeId] = ""
+	}
+	return l.typeKeys[typeId]
+}
+
+// propertyKey resolves the api key of a relation key, reloading the properties once for unknown keys
+func (l *objectEventLog) propertyKey(rk string) string {
+	if key, ok := l.propertyKeys[rk]; ok {
+		return key
+	}
+	l.refreshPropertyKeys()
+	if _, ok := l.propertyKeys[rk]; !ok {
+		l.propertyKeys[rk] = util.ToPropertyApiKey(rk)
+	}
+	return l.propertyKeys[rk]
+}
+
+func (l *objectEventLog) refreshTypeKeys() {
+	typeMap, err := l.s.getTypeMapFromStore(l.ctx, l.spaceId, nil, false)
+	if err != nil {
+		log.Warnf("failed to load types of space %s for object events: %v", l.spaceId, err)
+		return
+	}
+	for id, t := range typeMap {
+		l.typeKeys[id] = t.Key
+	}
+}
+
+func (l *objectEventLog) refreshPropertyKeys() {
+	propertyMap, err := l.s.getPropertyMapFromStore(l.ctx, l.spaceId, false)
+	if err != nil {
+		log.Warnf("failed to load properties of space %s for object events: %v", l.spaceId, err)
+		return
+	}
+	for _, p := range propertyMap {
+		l.propertyKeys[p.RelationKey] = p.Key
+	}
+}
+
+// ObjectEventStream delivers the events of a space log that match its filter
+type ObjectEventStream struct {
+	log        *objectEventLog
+	seq        uint64
+	notify     chan struct{}
+	events     map[apimodel.ObjectEventType]bool
+	types      map[string]bool
+	properties map[string]bool
+	members    *listMembers
+}
+
+// Next blocks until there are events matching the filter of the stream and returns them
+func (st *ObjectEventStream) Next(ctx context.Context) ([]apimodel.ObjectEvent, error) {
+	for {
+		events, lastSeq, err := st.log.since(st.seq)
+		if err != nil {
+			return nil, err
+		}
+		st.seq = lastSeq
+
+		matched := make([]apimodel.ObjectEvent, 0, len(events))
+		for _, event := range events {
+			if st.match(event) {
+				matched = append(matched, event)
+			}
+		}
+		if len(matched) > 0 {
+			return matched, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-st.log.done:
+			return nil, ErrFailedSubscribeEvents
+		case <-st.notify:
+		}
+	}
+}
+
+// Cursor returns the cursor of the last event seen by the stream, including the filtered out ones
+func (st *ObjectEventStream) Cursor() string {
+	return st.log.formatCursor(st.seq)
+}
+
+// Close detaches the stream from the space log
+func (st *ObjectEventStream) Close() {
+	st.log.removeWatcher(st.notify)
+	if st.members != nil {
+		st.members.close()
+	}
+}
+
+func (st *ObjectEventStream) match(event apimodel.ObjectEvent) bool {
+	if len(st.events) > 0 && !st.events[event.Type] {
+		return false
+	}
+	if len(st.types) > 0 && !st.types[event.TypeKey] {
+		return false
+	}
+	if len(st.properties) > 0 && event.Type == apimodel.ObjectEventTypeUpdated {
+		if !slices.ContainsFunc(event.Properties, func(key string) bool { return st.properties[key] }) {
+			return false
+		}
+	}
+	if st.members != nil && !st.members.contains(event.ObjectId, event.Type) {
+		return false
+	}
+	return true
+}
+
+// listMembers tracks the objects of a set or collection. Objects that left the list are kept as former members,
+// so their archival and deletion are still reported.
+type listMembers struct {
+	subscription apicore.SubscriptionService
+	subId        string
+	output       *mb.MB[*pb.EventMessage]
+	cancel       context.CancelFunc
+	done         chan struct{}
+
+	mu  sync.Mutex
+	ids map[string]bool
+}
+
+func (s *Service) subscribeListMembers(ctx context.Context, spaceId string, listId string) (*listMembers, error) {
+	resp := s.mw.ObjectShow(ctx, &pb.RpcObjectShowRequest{
+		SpaceId:  spaceId,
+		ObjectId: listId,
+	})
+	if resp.Error != nil && resp.Error.Code != pb.RpcObjectShowResponseError_NULL {
+		return nil, ErrFailedGetList
+	}
+
+	source, collectionId, err := s.getListSource(spaceId, listId, resp.ObjectView)
+	if err != nil {
+		return nil, err
+	}
+
+	subId := listMembersSubIdPrefix + bson.NewObjectId().Hex()
+	subResp, err := s.subscription.Search(subscription.SubscribeRequest{
+		SpaceId:           spaceId,
+		SubId:             subId,
+		Keys:              []string{bundle.RelationKeyId.String()},
+		Source:            source,
+		CollectionId:      collectionId,
+		NoDepSubscription: true,
+		Internal:          true,
+	})
+	if err != nil {
+		return nil, ErrFailedSubscribeEvents
+	}
+
+	readCtx, cancel := context.WithCancel(context.Background())
+	m := &listMembers{
+		subscription: s.subscription,
+		subId:        subId,
+		output:       subResp.Output,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+		ids:          make(map[string]bool, len(subResp.Records)),
+	}
+	for _, record := range subResp.Records {
+		m.ids[record.GetString(bundle.RelationKeyId)] = true
+	}
+	go m.read(readCtx)
+	return m, nil
+}
+
+func (m *listMembers) read(ctx context.Context) {
+	defer close(m.done)
+	for {
+		msgs, err := m.output.Wait(ctx)
+		if err != nil {
+			return
+		}
+		m.mu.Lock()
+		for _, msg := range msgs {
+			switch v := msg.Value.(type) {
+			case *pb.EventMessageValueOfSubscriptionAdd:
+				m.ids[v.SubscriptionAdd.Id] = true
+			case *pb.EventMessageValueOfObjectDetailsSet:
+				m.ids[v.ObjectDetailsSet.Id] = true
+			case *pb.EventMessageValueOfSubscriptionRemove:
+				if _, ok := m.ids[v.SubscriptionRemove.Id]; ok {
+					m.ids[v.SubscriptionRemove.Id] = false
+				}
+			}
+		}
+		m.mu.Unlock()
+	}
+}
+
+func (m *listMembers) contains(id string, eventType apimodel.ObjectEventType) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	isMember, known := m.ids[id]
+	if !known {
+		return false
+	}
+	return isMember || eventType == apimodel.ObjectEventTypeArchived || eventType == apimodel.ObjectEventTypeDeleted
+}
+
+func (m *listMembers) close() {
+	m.cancel()
+	<-m.done
+	if err := m.subscription.Unsubscribe(m.subId); err != nil {
+		log.Errorf("failed to unsubscribe from list members: %v", err)
+	}
+}
+
+func toSet[T comparable](values []T) map[T]bool {
+	set := make(map[T]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
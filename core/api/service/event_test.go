@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cheggaaa/mb/v3"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	mockedPropertyId  = "mocked-property-id"
+	mockedPropertyKey = "done"
+)
+
+// mockObjectEventLog mocks the space subscription of the event log and returns its event queue
+func mockObjectEventLog(fx *fixture, records ...*domain.Details) *mb.MB[*pb.EventMessage] {
+	fx.mwMock.
+		On("ObjectSearch", mock.Anything, mock.MatchedBy(func(req *pb.RpcObjectSearchRequest) bool {
+			return len(req.Keys) == 1 && req.Keys[0] == bundle.RelationKeyRelationKey.String()
+		})).
+		Return(&pb.RpcObjectSearchResponse{
+			Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+			Records: []*types.Struct{
+				{Fields: map[string]*types.Value{bundle.RelationKeyRelationKey.String(): pbtypes.String(mockedPropertyKey)}},
+			},
+		}, nil).Once()
+	fx.mwMock.
+		On("ObjectSearch", mock.Anything, mock.MatchedBy(func(req *pb.RpcObjectSearchRequest) bool {
+			return len(req.Keys) > 1 && req.Filters[0].Value.GetNumberValue() == float64(model.ObjectType_objectType)
+		})).
+		Return(&pb.RpcObjectSearchResponse{
+			Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+			Records: []*types.Struct{
+				{
+					Fields: map[string]*types.Value{
+						bundle.RelationKeyId.String():        pbtypes.String(mockedTypeId),
+						bundle.RelationKeyUniqueKey.String(): pbtypes.String("ot-" + mockedTypeKey),
+					},
+				},
+			},
+		}, nil).Maybe()
+	fx.mwMock.
+		On("ObjectSearch", mock.Anything, mock.MatchedBy(func(req *pb.RpcObjectSearchRequest) bool {
+			return len(req.Keys) > 1 && req.Filters[0].Value.GetNumberValue() == float64(model.ObjectType_relation)
+		})).
+		Return(&pb.RpcObjectSearchResponse{
+			Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+			Records: []*types.Struct{
+				{
+					Fields: map[string]*types.Value{
+						bundle.RelationKeyId.String():          pbtypes.String(mockedPropertyId),
+						bundle.RelationKeyRelationKey.String(): pbtypes.String(mockedPropertyKey),
+					},
+				},
+			},
+		}, nil).Maybe()
+
+	queue := mb.New[*pb.EventMessage](0)
+	fx.subMock.
+		On("Search", mock.MatchedBy(func(req subscription.SubscribeRequest) bool {
+			return req.SubId == objectEventSubIdPrefix+mockedSpaceId
+		})).
+		Return(&subscription.SubscribeResponse{Records: records, Output: queue}, nil).Once()
+	fx.subMock.On("Unsubscribe", objectEventSubIdPrefix+mockedSpaceId).Return(nil).Maybe()
+	return queue
+}
+
+func mockedObjectDetails(id string) *domain.Details {
+	return domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+		bundle.RelationKeyId:   domain.String(id),
+		bundle.RelationKeyType: domain.String(mockedTypeId),
+		bundle.RelationKeyName: domain.String(mockedObjectName),
+	})
+}
+
+func amendMessage(id string, key domain.RelationKey, value *types.Value) *pb.EventMessage {
+	return &pb.EventMessage{Value: &pb.EventMessageValueOfObjectDetailsAmend{
+		ObjectDetailsAmend: &pb.EventObjectDetailsAmend{
+			Id:      id,
+			Details: []*pb.EventObjectDetailsAmendKeyValue{{Key: key.String(), Value: value}},
+		},
+	}}
+}
+
+// nextEvents reads from the stream until the given number of events arrived
+func nextEvents(t *testing.T, stream *ObjectEventStream, count int) []apimodel.ObjectEvent {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var events []apimodel.ObjectEvent
+	for len(events) < count {
+		batch, err := stream.Next(ctx)
+		require.NoError(t, err)
+		events = append(events, batch...)
+	}
+	return events
+}
+
+func TestEventService_SubscribeObjectEvents(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("streams created, updated, archived and deleted events", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		queue := mockObjectEventLog(fx, mockedObjectDetails(mockedObjectId))
+		defer fx.service.Close()
+
+		stream, err := fx.service.SubscribeObjectEvents(ctx, mockedSpaceId, ObjectEventFilter{}, "")
+		require.NoError(t, err)
+		defer stream.Close()
+
+		// when
+		require.NoError(t, queue.Add(ctx,
+			&pb.EventMessage{Value: &pb.EventMessageValueOfObjectDetailsSet{
+				ObjectDetailsSet: &pb.EventObjectDetailsSet{Id: mockedNewObjectId, Details: mockedObjectDetails(mockedNewObjectId).ToProto()},
+			}},
+			amendMessage(mockedObjectId, bundle.RelationKeySyncStatus, pbtypes.Int64(1)),
+			amendMessage(mockedObjectId, domain.RelationKey(mockedPropertyKey), pbtypes.Bool(true)),
+			amendMessage(mockedObjectId, bundle.RelationKeyIsArchived, pbtypes.Bool(true)),
+			&pb.EventMessage{Value: &pb.EventMessageValueOfSubscriptionRemove{
+				SubscriptionRemove: &pb.EventObjectSubscriptionRemove{Id: mockedNewObjectId},
+			}},
+		))
+		events := nextEvents(t, stream, 4)
+
+		// then
+		require.Len(t, events, 4)
+		require.Equal(t, apimodel.ObjectEventTypeCreated, events[0].Type)
+		require.Equal(t, mockedNewObjectId, events[0].ObjectId)
+		require.Equal(t, mockedTypeKey, events[0].TypeKey)
+		require.Equal(t, apimodel.ObjectEventTypeUpdated, events[1].Type)
+		require.Equal(t, []string{mockedPropertyKey}, events[1].Properties)
+		require.Equal(t, apimodel.ObjectEventTypeArchived, events[2].Type)
+		require.Equal(t, mockedObjectId, events[2].ObjectId)
+		require.Equal(t, apimodel.ObjectEventTypeDeleted, events[3].Type)
+		require.Equal(t, mockedNewObjectId, events[3].ObjectId)
+		require.Equal(t, events[3].Id, stream.Cursor())
+	})
+
+	t.Run("filters by event type and property", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		queue := mockObjectEventLog(fx, mockedObjectDetails(mockedObjectId))
+		defer fx.service.Close()
+
+		filter := ObjectEventFilter{
+			Events:     []apimodel.ObjectEventType{apimodel.ObjectEventTypeUpdated},
+			Properties: []string{mockedPropertyKey},
+		}
+		stream, err := fx.service.SubscribeObjectEvents(ctx, mockedSpaceId, filter, "")
+		require.NoError(t, err)
+		defer stream.Close()
+
+		// when
+		require.NoError(t, queue.Add(ctx,
+			amendMessage(mockedObjectId, bundle.RelationKeyName, pbtypes.String("renamed")),
+			amendMessage(mockedObjectId, bundle.RelationKeyIsArchived, pbtypes.Bool(true)),
+			amendMessage(mockedObjectId, domain.RelationKey(mockedPropertyKey), pbtypes.Bool(true)),
+		))
+		events := nextEvents(t, stream, 1)
+
+		// then
+		require.Len(t, events, 1)
+		require.Equal(t, apimodel.ObjectEventTypeUpdated, events[0].Type)
+		require.Equal(t, []string{mockedPropertyKey}, events[0].Properties)
+	})
+
+	t.Run("resumes after cursor", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		queue := mockObjectEventLog(fx, mockedObjectDetails(mockedObjectId))
+		defer fx.service.Close()
+
+		stream, err := fx.service.SubscribeObjectEvents(ctx, mockedSpaceId, ObjectEventFilter{}, "")
+		require.NoError(t, err)
+		require.NoError(t, queue.Add(ctx,
+			amendMessage(mockedObjectId, bundle.RelationKeyName, pbtypes.String("first")),
+			amendMessage(mockedObjectId, bundle.RelationKeyName, pbtypes.String("second")),
+		))
+		first := nextEvents(t, stream, 2)
+		stream.Close()
+
+		// when
+		resumed, err := fx.service.SubscribeObjectEvents(ctx, mockedSpaceId, ObjectEventFilter{}, first[0].Id)
+		require.NoError(t, err)
+		defer resumed.Close()
+		events := nextEvents(t, resumed, 1)
+
+		// then
+		require.Equal(t, first[1], events[0])
+	})
+
+	t.Run("rejects invalid and expired cursors", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		mockObjectEventLog(fx)
+		defer fx.service.Close()
+
+		// when
+		_, invalidErr := fx.service.SubscribeObjectEvents(ctx, mockedSpaceId, ObjectEventFilter{}, "not-a-cursor")
+		_, expiredErr := fx.service.SubscribeObjectEvents(ctx, mockedSpaceId, ObjectEventFilter{}, "previousrun-1")
+
+		// then
+		require.ErrorIs(t, invalidErr, ErrInvalidEventCursor)
+		require.ErrorIs(t, expiredErr, ErrEventCursorExpired)
+	})
+
+	t.Run("failed subscription", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.mwMock.
+			On("ObjectSearch", mock.Anything, mock.Anything).
+			Return(&pb.RpcObjectSearchResponse{
+				Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+				Records: []*types.Struct{
+					{Fields: map[string]*types.Value{bundle.RelationKeyRelationKey.String(): pbtypes.String(mockedPropertyKey)}},
+				},
+			}, nil).Once()
+		fx.subMock.On("Search", mock.Anything).Return(nil, errors.New("subscription failed")).Once()
+
+		// when
+		_, err := fx.service.SubscribeObjectEvents(ctx, mockedSpaceId, ObjectEventFilter{}, "")
+
+		// then
+		require.ErrorIs(t, err, ErrFailedSubscribeEvents)
+	})
+}
@@ -141,32 +141,9 @@ func (s *Service) GetObjectsInList(ctx context.Context, spaceId string, listId s
 		return nil, 0, false, ErrFailedGetListDataview
 	}
 
-	var typeDetail *types.Struct
-	for _, detail := range resp.ObjectView.Details {
-		if detail.Id == resp.ObjectView.Details[0].Details.Fields[bundle.RelationKeyType.String()].GetStringValue() {
-			typeDetail = detail.GetDetails()
-			break
-		}
-	}
-
-	var collectionId string
-	var source []string
-	switch model.ObjectTypeLayout(typeDetail.Fields[bundle.RelationKeyRecommendedLayout.String()].GetNumberValue()) {
-	case model.ObjectType_set, model.ObjectType_objectType:
-		// for queries, we search within the space for objects of the setOf type
-		setOfValues := resp.ObjectView.Details[0].Details.Fields[bundle.RelationKeySetOf.String()].GetListValue().Values
-		for _, value := range setOfValues {
-			uk, _, err := util.ResolveIdtoUniqueKeyAndRelationKey(s.mw, spaceId, value.GetStringValue())
-			if err != nil {
-				return nil, 0, false, err
-			}
-			source = append(source, uk)
-		}
-	case model.ObjectType_collection:
-		// for collections, we need to search within that collection
-		collectionId = listId
-	default:
-		return nil, 0, false, ErrUnsupportedListType
+	source, collectionId, err := s.getListSource(spaceId, listId, resp.ObjectView)
+	if err != nil {
+		return nil, 0, false, err
 	}
 
 	allRelationKeys, err := util.GetAllRelationKeys(s.mw, spaceId)
@@ -254,3 +231,33 @@ func (s *Service) mapDataviewTypeName(dataviewType model.BlockContentDataviewVie
 		return strcase.ToSnake(model.BlockContentDataviewViewType_name[int32(dataviewType)])
 	}
 }
+
+// getListSource returns the source types for sets and the collection id for collections, which define the objects of a list.
+func (s *Service) getListSource(spaceId string, listId string, objectView *model.ObjectView) (source []string, collectionId string, err error) {
+	var typeDetail *types.Struct
+	for _, detail := range objectView.Details {
+		if detail.Id == objectView.Details[0].Details.Fields[bundle.RelationKeyType.String()].GetStringValue() {
+			typeDetail = detail.GetDetails()
+			break
+		}
+	}
+
+	switch model.ObjectTypeLayout(typeDetail.Fields[bundle.RelationKeyRecommendedLayout.String()].GetNumberValue()) {
+	case model.ObjectType_set, model.ObjectType_objectType:
+		// for queries, we search within the space for objects of the setOf type
+		setOfValues := objectView.Details[0].Details.Fields[bundle.RelationKeySetOf.String()].GetListValue().Values
+		for _, value := range setOfValues {
+			uk, _, err := util.ResolveIdtoUniqueKeyAndRelationKey(s.mw, spaceId, value.GetStringValue())
+			if err != nil {
+				return nil, "", err
+			}
+			source = append(source, uk)
+		}
+	case model.ObjectType_collection:
+		// for collections, we need to search within that collection
+		collectionId = listId
+	default:
+		return nil, "", ErrUnsupportedListType
+	}
+	return source, collectionId, nil
+}
@@ -357,64 +357,6 @@ func (s *Service) processIconFields(spaceId string, icon apimodel.Icon, isType b
 	return iconFields, nil
 }
 
-// ! Deprecated method, until json blocks properly implemented
-// getBlocksFromDetails returns the list of blocks from the ObjectShowResponse.
-// func (s *Service) getBlocksFromDetails(blocks []*model.Block) []apimodel.Block {
-// 	b := make([]apimodel.Block, 0, len(blocks))
-//
-// 	for _, block := range blocks {
-// 		var text *apimodel.Text
-// 		var file *apimodel.File
-// 		var property *apimodel.Property
-//
-// 		switch content := block.Content.(type) {
-// 		case *model.BlockContentOfText:
-// 			text = &apimodel.Text{
-// 				Object:  "text",
-// 				Text:    content.Text.Text,
-// 				Style:   model.BlockContentTextStyle_name[int32(content.Text.Style)],
-// 				Checked: content.Text.Checked,
-// 				Color:   content.Text.Color,
-// 				Icon:    GetIcon(s.gatewayUrl, content.Text.IconEmoji, content.Text.IconImage, "", 0),
-// 			}
-// 		case *model.BlockContentOfFile:
-// 			file = &apimodel.File{
-// 				Object:         "file",
-// 				Hash:           content.File.Hash,
-// 				Name:           content.File.Name,
-// 				Type:           model.BlockContentFileType_name[int32(content.File.Type)],
-// 				Mime:           content.File.Mime,
-// 				Size:           content.File.Size(),
-// 				AddedAt:        int(content.File.AddedAt),
-// 				TargetObjectId: content.File.TargetObjectId,
-// 				State:          model.BlockContentFileState_name[int32(content.File.State)],
-// 				Style:          model.BlockContentFileStyle_name[int32(content.File.Style)],
-// 			}
-// 		case *model.BlockContentOfRelation:
-// 			property = &apimodel.Property{
-// 				// TODO: is it sufficient to return the key only?
-// 				Object: "property",
-// 				Key:    content.Relation.Key,
-// 			}
-// 		}
-// 		// TODO: other content types?
-//
-// 		b = append(b, apimodel.Block{
-// 			Object:          "block",
-// 			Id:              block.Id,
-// 			ChildrenIds:     block.ChildrenIds,
-// 			BackgroundColor: block.BackgroundColor,
-// 			Align:           model.BlockAlign_name[int32(block.Align)],
-// 			VerticalAlign:   model.BlockVerticalAlign_name[int32(block.VerticalAlign)],
-// 			Text:            text,
-// 			File:            file,
-// 			Property:        property,
-// 		})
-// 	}
-//
-// 	return b
-// }
-
 // getObjectFromStruct creates an Object without blocks from the details.
 func (s *Service) getObjectFromStruct(details *types.Struct, propertyMap map[string]*apimodel.Property, typeMap map[string]*apimodel.Type, tagMap map[string]apimodel.Tag) apimodel.Object {
 	return apimodel.Object{
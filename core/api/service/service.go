@@ -2,14 +2,24 @@ package service
 
 import (
 	apicore "github.com/anyproto/anytype-heart/core/api/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 )
 
+var log = logging.Logger("api-service")
+
 type Service struct {
-	mw          apicore.ClientCommands
-	gatewayUrl  string
-	techSpaceId string
+	mw           apicore.ClientCommands
+	subscription apicore.SubscriptionService
+	gatewayUrl   string
+	techSpaceId  string
+	events       *objectEventHub
+}
+
+func NewService(mw apicore.ClientCommands, subscription apicore.SubscriptionService, gatewayUrl string, techspaceId string) *Service {
+	return &Service{mw: mw, subscription: subscription, gatewayUrl: gatewayUrl, techSpaceId: techspaceId, events: newObjectEventHub()}
 }
 
-func NewService(mw apicore.ClientCommands, gatewayUrl string, techspaceId string) *Service {
-	return &Service{mw: mw, gatewayUrl: gatewayUrl, techSpaceId: techspaceId}
+// Close stops the object event logs and webhooks of the service.
+func (s *Service) Close() {
+	s.events.close(s.subscription)
 }
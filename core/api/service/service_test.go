@@ -30,14 +30,17 @@ const (
 type fixture struct {
 	service *Service
 	mwMock  *mock_apicore.MockClientCommands
+	subMock *mock_apicore.MockSubscriptionService
 }
 
 func newFixture(t *testing.T) *fixture {
 	mwMock := mock_apicore.NewMockClientCommands(t)
-	service := NewService(mwMock, gatewayUrl, techSpaceId)
+	subMock := mock_apicore.NewMockSubscriptionService(t)
+	service := NewService(mwMock, subMock, gatewayUrl, techSpaceId)
 
 	return &fixture{
 		service: service,
 		mwMock:  mwMock,
+		subMock: subMock,
 	}
 }
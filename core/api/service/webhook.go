@@ -0,0 +1,249 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+)
+
+const (
+	webhookTimeout       = 10 * time.Second
+	webhookRetryInterval = time.Second
+	webhookMaxRetryDelay = time.Minute
+)
+
+var (
+	ErrInvalidWebhookUrl = errors.New("invalid webhook url, only http(s) urls on localhost are allowed")
+	ErrWebhookNotFound   = errors.New("webhook not found")
+)
+
+// webhookClient doesn't follow redirects, so local webhooks can't forward events to other hosts
+var webhookClient = &http.Client{
+	Timeout: webhookTimeout,
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// ListWebhooks returns a paginated list of the webhooks registered for a space
+func (s *Service) ListWebhooks(ctx context.Context, spaceId string, offset int, limit int) (webhooks []apimodel.Webhook, total int, hasMore bool, err error) {
+	webhooks = make([]apimodel.Webhook, 0)
+	s.events.mu.Lock()
+	for _, w := range s.events.webhooks {
+		if w.spaceId == spaceId {
+			webhooks = append(webhooks, w.toApiWebhook())
+		}
+	}
+	s.events.mu.Unlock()
+
+	// webhook ids are ordered by creation time
+	slices.SortFunc(webhooks, func(a, b apimodel.Webhook) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+
+	total = len(webhooks)
+	paginated, hasMore := pagination.Paginate(webhooks, offset, limit)
+	return paginated, total, hasMore, nil
+}
+
+// CreateWebhook registers a local url to receive the object events of a space
+func (s *Service) CreateWebhook(ctx context.Context, spaceId string, request apimodel.CreateWebhookRequest) (apimodel.Webhook, error) {
+	if !isLocalUrl(request.Url) {
+		return apimodel.Webhook{}, ErrInvalidWebhookUrl
+	}
+
+	filter := ObjectEventFilter{
+		Events:     request.Events,
+		Types:      request.Types,
+		Properties: request.Properties,
+		ListId:     request.ListId,
+	}
+	stream, err := s.SubscribeObjectEvents(ctx, spaceId, filter, request.Cursor)
+	if err != nil {
+		return apimodel.Webhook{}, err
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	w := &webhook{
+		id:      bson.NewObjectId().Hex(),
+		spaceId: spaceId,
+		url:     request.Url,
+		filter:  filter,
+		stream:  stream,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		cursor:  stream.Cursor(),
+	}
+
+	s.events.mu.Lock()
+	if s.events.closed {
+		s.events.mu.Unlock()
+		cancel()
+		stream.Close()
+		return apimodel.Webhook{}, ErrFailedSubscribeEvents
+	}
+	s.events.webhooks[w.id] = w
+	s.events.mu.Unlock()
+
+	go w.run(runCtx)
+	return w.toApiWebhook(), nil
+}
+
+// DeleteWebhook stops the delivery of a webhook and removes it
+func (s *Service) DeleteWebhook(ctx context.Context, spaceId string, webhookId string) (apimodel.Webhook, error) {
+	s.events.mu.Lock()
+	w, ok := s.events.webhooks[webhookId]
+	if !ok || w.spaceId != spaceId {
+		s.events.mu.Unlock()
+		return apimodel.Webhook{}, ErrWebhookNotFound
+	}
+	delete(s.events.webhooks, webhookId)
+	s.events.mu.Unlock()
+
+	w.stop()
+	return w.toApiWebhook(), nil
+}
+
+// webhook posts the events of its stream one by one to a local url. Failed deliveries are retried with backoff,
+// so events are delivered in order; when the consumer stays down longer than the event log covers, delivery stops.
+type webhook struct {
+	id      string
+	spaceId string
+	url     string
+	filter  ObjectEventFilter
+	stream  *ObjectEventStream
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	mu        sync.Mutex
+	cursor    string
+	lastError string
+}
+
+func (w *webhook) run(ctx context.Context) {
+	defer close(w.done)
+	for {
+		events, err := w.stream.Next(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				w.setResult("", err)
+			}
+			return
+		}
+		for _, event := range events {
+			if !w.deliver(ctx, event) {
+				return
+			}
+		}
+	}
+}
+
+func (w *webhook) deliver(ctx context.Context, event apimodel.ObjectEvent) bool {
+	body, err := json.Marshal(event)
+	if err != nil {
+		w.setResult("", err)
+		return false
+	}
+
+	delay := webhookRetryInterval
+	for {
+		err = w.post(ctx, body)
+		if err == nil {
+			w.setResult(event.Id, nil)
+			return true
+		}
+		w.setResult("", err)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, webhookMaxRetryDelay)
+	}
+}
+
+func (w *webhook) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (w *webhook) setResult(cursor string, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if cursor != "" {
+		w.cursor = cursor
+	}
+	if err != nil {
+		w.lastError = err.Error()
+	} else {
+		w.lastError = ""
+	}
+}
+
+func (w *webhook) stop() {
+	w.cancel()
+	<-w.done
+	w.stream.Close()
+}
+
+func (w *webhook) toApiWebhook() apimodel.Webhook {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return apimodel.Webhook{
+		Object:     "webhook",
+		Id:         w.id,
+		Url:        w.url,
+		SpaceId:    w.spaceId,
+		Events:     w.filter.Events,
+		Types:      w.filter.Types,
+		Properties: w.filter.Properties,
+		ListId:     w.filter.ListId,
+		Cursor:     w.cursor,
+		LastError:  w.lastError,
+	}
+}
+
+// isLocalUrl reports whether the url points to an http(s) server on the local machine
+func isLocalUrl(rawUrl string) bool {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return false
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return false
+	}
+	switch u.Hostname() {
+	case "localhost", "127.0.0.1", "::1":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestWebhookService_CreateWebhook(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("delivers events to local url", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		queue := mockObjectEventLog(fx, mockedObjectDetails(mockedObjectId))
+		defer fx.service.Close()
+
+		received := make(chan apimodel.ObjectEvent, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var event apimodel.ObjectEvent
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+			received <- event
+		}))
+		defer srv.Close()
+
+		webhook, err := fx.service.CreateWebhook(ctx, mockedSpaceId, apimodel.CreateWebhookRequest{
+			Url:    srv.URL,
+			Events: []apimodel.ObjectEventType{apimodel.ObjectEventTypeArchived},
+		})
+		require.NoError(t, err)
+
+		// when
+		require.NoError(t, queue.Add(ctx,
+			amendMessage(mockedObjectId, bundle.RelationKeyName, pbtypes.String("renamed")),
+			amendMessage(mockedObjectId, bundle.RelationKeyIsArchived, pbtypes.Bool(true)),
+		))
+
+		// then
+		select {
+		case event := <-received:
+			require.Equal(t, apimodel.ObjectEventTypeArchived, event.Type)
+			require.Equal(t, mockedObjectId, event.ObjectId)
+			require.Eventually(t, func() bool {
+				webhooks, _, _, err := fx.service.ListWebhooks(ctx, mockedSpaceId, offset, limit)
+				return err == nil && len(webhooks) == 1 && webhooks[0].Id == webhook.Id && webhooks[0].Cursor == event.Id
+			}, time.Second, 10*time.Millisecond)
+		case <-time.After(time.Second):
+			t.Fatal("event was not delivered")
+		}
+	})
+
+	t.Run("rejects non-local url", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+
+		// when
+		_, err := fx.service.CreateWebhook(ctx, mockedSpaceId, apimodel.CreateWebhookRequest{Url: "https://example.com/hook"})
+
+		// then
+		require.ErrorIs(t, err, ErrInvalidWebhookUrl)
+	})
+}
+
+func TestWebhookClient(t *testing.T) {
+	t.Run("does not follow redirects", func(t *testing.T) {
+		// given
+		var redirected bool
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			redirected = true
+		}))
+		defer target.Close()
+		srv := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+		defer srv.Close()
+
+		// when
+		resp, err := webhookClient.Post(srv.URL, "application/json", nil)
+
+		// then
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+		require.False(t, redirected)
+	})
+}
+
+func TestWebhookService_DeleteWebhook(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		mockObjectEventLog(fx)
+		defer fx.service.Close()
+
+		webhook, err := fx.service.CreateWebhook(ctx, mockedSpaceId, apimodel.CreateWebhookRequest{Url: "http://localhost:8080/anytype"})
+		require.NoError(t, err)
+
+		// when
+		deleted, err := fx.service.DeleteWebhook(ctx, mockedSpaceId, webhook.Id)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, webhook.Id, deleted.Id)
+		webhooks, total, _, err := fx.service.ListWebhooks(ctx, mockedSpaceId, offset, limit)
+		require.NoError(t, err)
+		require.Empty(t, webhooks)
+		require.Zero(t, total)
+	})
+
+	t.Run("webhook not found", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+
+		// when
+		_, err := fx.service.DeleteWebhook(ctx, mockedSpaceId, "unknown-webhook-id")
+
+		// then
+		require.ErrorIs(t, err, ErrWebhookNotFound)
+	})
+}
@@ -20,8 +20,25 @@ func (s *Service) AccountConfigUpdate(req *pb.RpcAccountConfigUpdateRequest) err
 	}
 
 	conf := s.app.MustComponent(config.CName).(*config.Config)
-	cfg := config.ConfigRequired{}
-	cfg.CustomFileStorePath = req.IPFSStorageAddr
+	var cfg interface{} = config.ConfigRequired{CustomFileStorePath: req.IPFSStorageAddr}
+	switch {
+	case req.ResetTimeZone:
+		if err := conf.SetTimeZone(""); err != nil {
+			return errors.Join(ErrBadInput, err)
+		}
+		// empty time zone is written explicitly, otherwise the stored one is kept
+		cfg = struct {
+			config.ConfigRequired
+			TimeZone string
+		}{
+			ConfigRequired: config.ConfigRequired{CustomFileStorePath: req.IPFSStorageAddr},
+		}
+	case req.TimeZone != "":
+		if err := conf.SetTimeZone(req.TimeZone); err != nil {
+			return errors.Join(ErrBadInput, err)
+		}
+		cfg = config.ConfigRequired{CustomFileStorePath: req.IPFSStorageAddr, TimeZone: req.TimeZone}
+	}
 	err := config.WriteJsonConfig(conf.GetConfigPath(), cfg)
 	if err != nil {
 		return errors.Join(ErrFailedToWriteConfig, err)
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/backup"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) BackupRun(ctx context.Context, req *pb.RpcBackupRunRequest) *pb.RpcBackupRunResponse {
+	res, err := func() (*backup.Result, error) {
+		target, err := backupTarget(req.Target)
+		if err != nil {
+			return nil, err
+		}
+		return mustService[backup.Service](mw).Backup(ctx, req.SpaceId, target)
+	}()
+	code := mapErrorCode(err,
+		errToCode(backup.ErrInvalidTarget, pb.RpcBackupRunResponseError_BAD_INPUT),
+	)
+	return &pb.RpcBackupRunResponse{
+		Error: &pb.RpcBackupRunResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+		Result: backupResultToProto(res),
+	}
+}
+
+func (mw *Middleware) BackupRestore(ctx context.Context, req *pb.RpcBackupRestoreRequest) *pb.RpcBackupRestoreResponse {
+	res, err := func() (*backup.Result, error) {
+		target, err := backupTarget(req.Target)
+		if err != nil {
+			return nil, err
+		}
+		return mustService[backup.Service](mw).Restore(ctx, req.SpaceId, req.SourceSpaceId, target)
+	}()
+	code := mapErrorCode(err,
+		errToCode(backup.ErrInvalidTarget, pb.RpcBackupRestoreResponseError_BAD_INPUT),
+		errToCode(backup.ErrNotFound, pb.RpcBackupRestoreResponseError_NOT_FOUND),
+	)
+	return &pb.RpcBackupRestoreResponse{
+		Error: &pb.RpcBackupRestoreResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+		Result: backupResultToProto(res),
+	}
+}
+
+func backupTarget(target *pb.RpcBackupTarget) (backup.Target, error) {
+	switch t := target.GetTarget().(type) {
+	case *pb.RpcBackupTargetTargetOfDir:
+		return backup.NewDirTarget(t.Dir.GetPath())
+	case *pb.RpcBackupTargetTargetOfS3:
+		return backup.NewS3Target(backup.S3Config{
+			Endpoint:  t.S3.GetEndpoint(),
+			Region:    t.S3.GetRegion(),
+			Bucket:    t.S3.GetBucket(),
+			AccessKey: t.S3.GetAccessKey(),
+			SecretKey: t.S3.GetSecretKey(),
+			Prefix:    t.S3.GetPrefix(),
+		})
+	default:
+		return nil, fmt.Errorf("%w: target is not set", backup.ErrInvalidTarget)
+	}
+}
+
+func backupResultToProto(res *backup.Result) *pb.RpcBackupResult {
+	if res == nil {
+		return nil
+	}
+	return &pb.RpcBackupResult{
+		BlocksAdded:    uint64(res.BlocksAdded),
+		BlocksTotal:    uint64(res.BlocksTotal),
+		SnapshotsAdded: uint64(res.SnapshotsAdded),
+		SnapshotsTotal: uint64(res.SnapshotsTotal),
+		BytesCopied:    uint64(res.BytesCopied),
+	}
+}
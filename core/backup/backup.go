@@ -0,0 +1,431 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonfile/fileblockstore"
+	"github.com/anyproto/any-sync/commonfile/fileservice"
+	"github.com/gogo/protobuf/jsonpb"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/block/export"
+	importer "github.com/anyproto/anytype-heart/core/block/import"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/domain/objectorigin"
+	"github.com/anyproto/anytype-heart/core/files/filestorage"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const CName = "core.backup"
+
+// ErrFilesNotAvailable is returned when files of the space can't be loaded neither from the local storage nor from the file node
+var ErrFilesNotAvailable = errors.New("files are not available")
+
+var errBlockNotAvailable = errors.New("block is not available")
+
+var log = logging.Logger(CName).Desugar()
+
+const (
+	blocksPrefix = "blocks/"
+	// filesDir is the directory of the export with decrypted file content, it is not backed up,
+	// as files are restored from encrypted blocks
+	filesDir = "files"
+)
+
+// Service copies encrypted file blocks and object snapshots of a space to a target outside the sync network.
+// Data is content-addressed, so every run uploads only blocks and snapshots missing in the target
+type Service interface {
+	app.Component
+
+	Backup(ctx context.Context, spaceId string, target Target) (*Result, error)
+	// Restore imports objects backed up from sourceSpaceId into the space spaceId. File blocks are added to the local storage
+	Restore(ctx context.Context, spaceId string, sourceSpaceId string, target Target) (*Result, error)
+}
+
+type Result struct {
+	BlocksAdded    int
+	BlocksTotal    int
+	SnapshotsAdded int
+	SnapshotsTotal int
+	// BytesCopied is the size of data uploaded on backup or downloaded on restore
+	BytesCopied int64
+}
+
+// manifest describes the latest backup of a space. It is written last, so an interrupted run doesn't break the previous backup
+type manifest struct {
+	SpaceId   string   `json:"spaceId"`
+	CreatedAt int64    `json:"createdAt"`
+	Blocks    []string `json:"blocks"`
+	// Snapshots maps paths of exported files to sha256 of their content
+	Snapshots map[string]string `json:"snapshots"`
+}
+
+type exporter interface {
+	Export(ctx context.Context, req pb.RpcObjectListExportRequest) (path string, succeed int, err error)
+}
+
+type objectImporter interface {
+	Import(ctx context.Context, importRequest *importer.ImportRequest) *importer.ImportResponse
+}
+
+type service struct {
+	objectStore     objectstore.ObjectStore
+	dagService      ipld.DAGService
+	fileStorage     filestorage.FileStorage
+	exporter        exporter
+	importer        objectImporter
+	tempDirProvider core.TempDirProvider
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.dagService = app.MustComponent[fileservice.FileService](a).DAGService()
+	s.fileStorage = app.MustComponent[filestorage.FileStorage](a)
+	s.exporter = app.MustComponent[export.Export](a)
+	s.importer = app.MustComponent[importer.Importer](a)
+	s.tempDirProvider = app.MustComponent[core.TempDirProvider](a)
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Backup(ctx context.Context, spaceId string, target Target) (*Result, error) {
+	res := &Result{}
+	existingBlocks, err := s.listExisting(ctx, target, blocksPrefix)
+	if err != nil {
+		return nil, err
+	}
+	snapshotsPrefix := spacePrefix(spaceId) + "snapshots/"
+	existingSnapshots, err := s.listExisting(ctx, target, snapshotsPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	mf := &manifest{
+		SpaceId:   spaceId,
+		CreatedAt: time.Now().Unix(),
+		Snapshots: map[string]string{},
+	}
+	if mf.Blocks, err = s.backupBlocks(ctx, spaceId, target, existingBlocks, res); err != nil {
+		return nil, fmt.Errorf("backup file blocks: %w", err)
+	}
+	if err = s.backupSnapshots(ctx, spaceId, target, snapshotsPrefix, existingSnapshots, mf, res); err != nil {
+		return nil, fmt.Errorf("backup snapshots: %w", err)
+	}
+
+	data, err := json.Marshal(mf)
+	if err != nil {
+		return nil, err
+	}
+	if err = target.Put(ctx, spacePrefix(spaceId)+"manifest.json", data); err != nil {
+		return nil, fmt.Errorf("put manifest: %w", err)
+	}
+	return res, nil
+}
+
+func (s *service) listExisting(ctx context.Context, target Target, prefix string) (map[string]struct{}, error) {
+	keys, err := target.List(ctx, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("list %s: %w", prefix, err)
+	}
+	existing := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		existing[key] = struct{}{}
+	}
+	return existing, nil
+}
+
+// backupBlocks walks DAGs of all files of the space. Blocks missing in the local storage are loaded through the file service,
+// if some files can't be loaded, the backup fails with ErrFilesNotAvailable listing their ids
+func (s *service) backupBlocks(ctx context.Context, spaceId string, target Target, existing map[string]struct{}, res *Result) ([]string, error) {
+	records, err := s.objectStore.SpaceIndex(spaceId).QueryRaw(&database.Filters{FilterObj: database.FilterIn{
+		Key:   bundle.RelationKeyResolvedLayout,
+		Value: layoutValues(domain.FileLayouts),
+	}}, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("query file objects: %w", err)
+	}
+
+	ctx = fileblockstore.CtxWithSpaceId(ctx, spaceId)
+	var (
+		cids    []string
+		visited = map[string]struct{}{}
+	)
+	var walk func(c cid.Cid) error
+	walk = func(c cid.Cid) error {
+		if _, ok := visited[c.String()]; ok {
+			return nil
+		}
+		visited[c.String()] = struct{}{}
+		node, err := s.dagService.Get(ctx, c)
+		if err != nil {
+			return fmt.Errorf("%w: get block %s: %w", errBlockNotAvailable, c, err)
+		}
+		cids = append(cids, c.String())
+		key := blocksPrefix + c.String()
+		if _, ok := existing[key]; !ok {
+			if err = target.Put(ctx, key, node.RawData()); err != nil {
+				return fmt.Errorf("put block %s: %w", c, err)
+			}
+			existing[key] = struct{}{}
+			res.BlocksAdded++
+			res.BytesCopied += int64(len(node.RawData()))
+		}
+		for _, link := range node.Links() {
+			if err = walk(link.Cid); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var missingFiles []string
+	for _, rec := range records {
+		fileId := rec.Details.GetString(bundle.RelationKeyFileId)
+		if fileId == "" {
+			continue
+		}
+		c, err := cid.Parse(fileId)
+		if err != nil {
+			log.Warn("parse file id", zap.String("fileId", fileId), zap.Error(err))
+			continue
+		}
+		err = walk(c)
+		if errors.Is(err, errBlockNotAvailable) {
+			log.Warn("file is not available", zap.String("fileId", fileId), zap.Error(err))
+			missingFiles = append(missingFiles, fileId)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(missingFiles) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrFilesNotAvailable, strings.Join(missingFiles, ", "))
+	}
+	res.BlocksTotal = len(cids)
+	return cids, nil
+}
+
+func layoutValues(layouts []model.ObjectTypeLayout) []domain.Value {
+	values := make([]domain.Value, 0, len(layouts))
+	for _, layout := range layouts {
+		values = append(values, domain.Int64(layout))
+	}
+	return values
+}
+
+func (s *service) backupSnapshots(ctx context.Context, spaceId string, target Target, prefix string, existing map[string]struct{}, mf *manifest, res *Result) error {
+	exportPath, err := os.MkdirTemp(s.tempDirProvider.TempDir(), "backup-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(exportPath)
+
+	dir, _, err := s.exporter.Export(ctx, pb.RpcObjectListExportRequest{
+		SpaceId:         spaceId,
+		Path:            exportPath,
+		Format:          model.Export_Protobuf,
+		IncludeNested:   true,
+		IncludeFiles:    true,
+		IncludeArchived: true,
+		IncludeSpace:    true,
+		NoProgress:      true,
+	})
+	if err != nil {
+		return fmt.Errorf("export space: %w", err)
+	}
+
+	return filepath.WalkDir(dir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, filePath)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if d.IsDir() {
+			if rel == filesDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		data, sum, err := prepareSnapshot(data)
+		if err != nil {
+			return fmt.Errorf("prepare snapshot %s: %w", rel, err)
+		}
+		mf.Snapshots[rel] = sum
+		res.SnapshotsTotal++
+		key := prefix + sum
+		if _, ok := existing[key]; ok {
+			return nil
+		}
+		if err = target.Put(ctx, key, data); err != nil {
+			return fmt.Errorf("put snapshot %s: %w", rel, err)
+		}
+		existing[key] = struct{}{}
+		res.SnapshotsAdded++
+		res.BytesCopied += int64(len(data))
+		return nil
+	})
+}
+
+// prepareSnapshot removes the path to decrypted file content from snapshots of file objects,
+// so on restore file objects are created from their snapshots and read content from restored blocks.
+// Binary encoding of maps is not stable, so the hash of a snapshot is calculated from its json representation with sorted keys
+func prepareSnapshot(data []byte) ([]byte, string, error) {
+	snapshot := &pb.SnapshotWithType{}
+	if err := snapshot.Unmarshal(data); err != nil {
+		// not a snapshot, e.g. the profile
+		return data, sha256Hex(data), nil
+	}
+	details := snapshot.GetSnapshot().GetData().GetDetails()
+	if details != nil && details.Fields[bundle.RelationKeySource.String()] != nil && details.Fields[bundle.RelationKeyFileId.String()] != nil {
+		delete(details.Fields, bundle.RelationKeySource.String())
+		var err error
+		if data, err = snapshot.Marshal(); err != nil {
+			return nil, "", err
+		}
+	}
+	canonical, err := (&jsonpb.Marshaler{}).MarshalToString(snapshot)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, sha256Hex([]byte(canonical)), nil
+}
+
+func (s *service) Restore(ctx context.Context, spaceId string, sourceSpaceId string, target Target) (*Result, error) {
+	if sourceSpaceId == "" {
+		sourceSpaceId = spaceId
+	}
+	data, err := target.Get(ctx, spacePrefix(sourceSpaceId)+"manifest.json")
+	if err != nil {
+		return nil, fmt.Errorf("get manifest: %w", err)
+	}
+	mf := &manifest{}
+	if err = json.Unmarshal(data, mf); err != nil {
+		return nil, fmt.Errorf("decode manifest: %w", err)
+	}
+
+	res := &Result{}
+	if err = s.restoreBlocks(ctx, spaceId, target, mf.Blocks, res); err != nil {
+		return nil, fmt.Errorf("restore file blocks: %w", err)
+	}
+
+	importPath, err := os.MkdirTemp(s.tempDirProvider.TempDir(), "restore-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(importPath)
+	prefix := spacePrefix(sourceSpaceId) + "snapshots/"
+	for rel, sum := range mf.Snapshots {
+		if err = validateKey(rel); err != nil {
+			return nil, err
+		}
+		data, err := target.Get(ctx, prefix+sum)
+		if err != nil {
+			return nil, fmt.Errorf("get snapshot %s: %w", rel, err)
+		}
+		filePath := filepath.Join(importPath, filepath.FromSlash(rel))
+		if err = os.MkdirAll(filepath.Dir(filePath), 0700); err != nil {
+			return nil, err
+		}
+		if err = os.WriteFile(filePath, data, 0600); err != nil {
+			return nil, err
+		}
+		res.SnapshotsTotal++
+		res.SnapshotsAdded++
+		res.BytesCopied += int64(len(data))
+	}
+	if res.SnapshotsTotal == 0 {
+		return res, nil
+	}
+
+	importRes := s.importer.Import(ctx, &importer.ImportRequest{
+		RpcObjectImportRequest: &pb.RpcObjectImportRequest{
+			SpaceId: spaceId,
+			Params: &pb.RpcObjectImportRequestParamsOfPbParams{PbParams: &pb.RpcObjectImportRequestPbParams{
+				Path:         []string{importPath},
+				NoCollection: true,
+			}},
+			Type:                  model.Import_Pb,
+			Mode:                  pb.RpcObjectImportRequest_IGNORE_ERRORS,
+			UpdateExistingObjects: true,
+			NoProgress:            true,
+		},
+		Origin: objectorigin.Import(model.Import_Pb),
+		IsSync: true,
+	})
+	if importRes.Err != nil {
+		return nil, fmt.Errorf("import snapshots: %w", importRes.Err)
+	}
+	return res, nil
+}
+
+func (s *service) restoreBlocks(ctx context.Context, spaceId string, target Target, cids []string, res *Result) error {
+	ctx = fileblockstore.CtxWithSpaceId(ctx, spaceId)
+	for _, id := range cids {
+		c, err := cid.Parse(id)
+		if err != nil {
+			return fmt.Errorf("parse cid %s: %w", id, err)
+		}
+		res.BlocksTotal++
+		if exists, err := s.fileStorage.ExistsCids(ctx, []cid.Cid{c}); err != nil {
+			return err
+		} else if len(exists) > 0 {
+			continue
+		}
+		data, err := target.Get(ctx, blocksPrefix+id)
+		if err != nil {
+			return fmt.Errorf("get block %s: %w", id, err)
+		}
+		// the cid is checked against the content, so corrupted data is not added to the storage
+		if actual, err := c.Prefix().Sum(data); err != nil || !actual.Equals(c) {
+			return fmt.Errorf("verify block %s: content doesn't match the cid", id)
+		}
+		block, err := blocks.NewBlockWithCid(data, c)
+		if err != nil {
+			return fmt.Errorf("create block %s: %w", id, err)
+		}
+		if err = s.fileStorage.Add(ctx, []blocks.Block{block}); err != nil {
+			return fmt.Errorf("add block %s: %w", id, err)
+		}
+		res.BlocksAdded++
+		res.BytesCopied += int64(len(data))
+	}
+	return nil
+}
+
+func spacePrefix(spaceId string) string {
+	return path.Join("spaces", strings.ReplaceAll(spaceId, "/", "_")) + "/"
+}
@@ -0,0 +1,268 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonfile/fileservice"
+	"github.com/gogo/protobuf/types"
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	importer "github.com/anyproto/anytype-heart/core/block/import"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files/filestorage"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/mock_core"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type exporterStub struct {
+	files map[string][]byte
+}
+
+func (e *exporterStub) Export(_ context.Context, req pb.RpcObjectListExportRequest) (string, int, error) {
+	dir := filepath.Join(req.Path, "Anytype.export")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", 0, err
+	}
+	for name, data := range e.files {
+		filePath := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0700); err != nil {
+			return "", 0, err
+		}
+		if err := os.WriteFile(filePath, data, 0600); err != nil {
+			return "", 0, err
+		}
+	}
+	return dir, len(e.files), nil
+}
+
+type importerStub struct {
+	req   *importer.ImportRequest
+	files map[string][]byte
+}
+
+func (i *importerStub) Import(_ context.Context, req *importer.ImportRequest) *importer.ImportResponse {
+	i.req = req
+	i.files = map[string][]byte{}
+	root := req.GetPbParams().Path[0]
+	err := filepath.Walk(root, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, _ := filepath.Rel(root, filePath)
+		i.files[filepath.ToSlash(rel)], err = os.ReadFile(filePath)
+		return err
+	})
+	return &importer.ImportResponse{Err: err}
+}
+
+type fixture struct {
+	*service
+	commonFile  fileservice.FileService
+	objectStore *objectstore.StoreFixture
+	exporter    *exporterStub
+	importer    *importerStub
+}
+
+func newFixture(t *testing.T) *fixture {
+	ctx := context.Background()
+	dataStoreProvider, err := datastore.NewInMemory()
+	require.NoError(t, err)
+	blockStorage := filestorage.NewInMemory()
+	commonFile := fileservice.New()
+	a := new(app.App)
+	a.Register(dataStoreProvider)
+	a.Register(blockStorage)
+	a.Register(commonFile)
+	require.NoError(t, a.Start(ctx))
+	t.Cleanup(func() {
+		a.Close(ctx)
+	})
+
+	tempDirProvider := mock_core.NewMockTempDirProvider(t)
+	tempDirProvider.EXPECT().TempDir().Return(t.TempDir()).Maybe()
+	fx := &fixture{
+		commonFile:  commonFile,
+		objectStore: objectstore.NewStoreFixture(t),
+		exporter:    &exporterStub{},
+		importer:    &importerStub{},
+	}
+	fx.service = &service{
+		objectStore:     fx.objectStore,
+		dagService:      commonFile.DAGService(),
+		fileStorage:     blockStorage,
+		exporter:        fx.exporter,
+		importer:        fx.importer,
+		tempDirProvider: tempDirProvider,
+	}
+	return fx
+}
+
+func givenFileSnapshot(t *testing.T, id string, fileId string) []byte {
+	snapshot := &pb.SnapshotWithType{
+		SbType: model.SmartBlockType_FileObject,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{Details: &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyId.String():     pbtypes.String(id),
+			bundle.RelationKeyFileId.String(): pbtypes.String(fileId),
+			bundle.RelationKeySource.String(): pbtypes.String("files/photo.jpg"),
+		}}}},
+	}
+	data, err := snapshot.Marshal()
+	require.NoError(t, err)
+	return data
+}
+
+func givenFile(t *testing.T, fx *fixture, objectId string) cid.Cid {
+	data := make([]byte, 2*1024*1024)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+	node, err := fx.commonFile.AddFile(context.Background(), bytes.NewReader(data))
+	require.NoError(t, err)
+	fx.objectStore.AddObjects(t, "space1", []objectstore.TestObject{{
+		bundle.RelationKeyId:             domain.String(objectId),
+		bundle.RelationKeyFileId:         domain.String(node.Cid().String()),
+		bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_image),
+		bundle.RelationKeyIsArchived:     domain.Bool(true),
+	}})
+	return node.Cid()
+}
+
+func TestService_Backup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("backup is incremental", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fileCid := givenFile(t, fx, "file1")
+		fx.exporter.files = map[string][]byte{
+			"objects/file1.pb": givenFileSnapshot(t, "file1", fileCid.String()),
+			"objects/page1.pb": []byte("page"),
+			"files/photo.jpg":  []byte("decrypted content"),
+		}
+		target, err := NewDirTarget(t.TempDir())
+		require.NoError(t, err)
+
+		// when
+		res, err := fx.Backup(ctx, "space1", target)
+
+		// then
+		require.NoError(t, err)
+		assert.Greater(t, res.BlocksTotal, 1)
+		assert.Equal(t, res.BlocksTotal, res.BlocksAdded)
+		assert.Equal(t, 2, res.SnapshotsTotal)
+		assert.Equal(t, 2, res.SnapshotsAdded)
+
+		snapshots, err := target.List(ctx, "spaces/space1/snapshots/")
+		require.NoError(t, err)
+		assert.Len(t, snapshots, 2)
+		for _, key := range snapshots {
+			data, err := target.Get(ctx, key)
+			require.NoError(t, err)
+			assert.NotContains(t, string(data), "photo.jpg")
+		}
+
+		// when
+		fx.exporter.files["objects/page2.pb"] = []byte("page2")
+		res, err = fx.Backup(ctx, "space1", target)
+
+		// then
+		require.NoError(t, err)
+		assert.Zero(t, res.BlocksAdded)
+		assert.Equal(t, 3, res.SnapshotsTotal)
+		assert.Equal(t, 1, res.SnapshotsAdded)
+		assert.Equal(t, int64(len("page2")), res.BytesCopied)
+	})
+
+	t.Run("files that are not available are reported", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		givenFile(t, fx, "file1")
+		missingCid, err := cid.V1Builder{Codec: cid.DagProtobuf, MhType: multihash.SHA2_256}.Sum([]byte("missing"))
+		require.NoError(t, err)
+		fx.objectStore.AddObjects(t, "space1", []objectstore.TestObject{{
+			bundle.RelationKeyId:             domain.String("file2"),
+			bundle.RelationKeyFileId:         domain.String(missingCid.String()),
+			bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_file),
+		}})
+		target, err := NewDirTarget(t.TempDir())
+		require.NoError(t, err)
+
+		// when
+		_, err = fx.Backup(ctx, "space1", target)
+
+		// then
+		require.ErrorIs(t, err, ErrFilesNotAvailable)
+		assert.Contains(t, err.Error(), missingCid.String())
+		_, err = target.Get(ctx, "spaces/space1/manifest.json")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestService_Restore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("restore blocks and objects", func(t *testing.T) {
+		// given
+		source := newFixture(t)
+		fileCid := givenFile(t, source, "file1")
+		source.exporter.files = map[string][]byte{
+			"objects/page1.pb": []byte("page"),
+		}
+		target, err := NewDirTarget(t.TempDir())
+		require.NoError(t, err)
+		backupRes, err := source.Backup(ctx, "space1", target)
+		require.NoError(t, err)
+		fx := newFixture(t)
+
+		// when
+		res, err := fx.Restore(ctx, "space2", "space1", target)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, backupRes.BlocksTotal, res.BlocksAdded)
+		exists, err := fx.fileStorage.ExistsCids(ctx, []cid.Cid{fileCid})
+		require.NoError(t, err)
+		assert.Len(t, exists, 1)
+		assert.Equal(t, "space2", fx.importer.req.SpaceId)
+		assert.Equal(t, map[string][]byte{"objects/page1.pb": []byte("page")}, fx.importer.files)
+	})
+
+	t.Run("corrupted block", func(t *testing.T) {
+		// given
+		source := newFixture(t)
+		fileCid := givenFile(t, source, "file1")
+		target, err := NewDirTarget(t.TempDir())
+		require.NoError(t, err)
+		_, err = source.Backup(ctx, "space1", target)
+		require.NoError(t, err)
+		require.NoError(t, target.Put(ctx, blocksPrefix+fileCid.String(), []byte("corrupted")))
+
+		// when
+		_, err = newFixture(t).Restore(ctx, "space1", "", target)
+
+		// then
+		assert.Error(t, err)
+	})
+
+	t.Run("no backup of the space", func(t *testing.T) {
+		target, err := NewDirTarget(t.TempDir())
+		require.NoError(t, err)
+
+		_, err = newFixture(t).Restore(ctx, "space1", "", target)
+
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,124 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	ErrNotFound   = errors.New("not found in backup")
+	ErrInvalidKey = errors.New("invalid backup key")
+	// ErrInvalidTarget is returned when a target can't be created from the given parameters
+	ErrInvalidTarget = errors.New("invalid backup target")
+)
+
+// Target keeps backup data by slash-separated keys. Implementations should be safe for concurrent use
+type Target interface {
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns ErrNotFound if there is no data for the key
+	Get(ctx context.Context, key string) ([]byte, error)
+	// List returns all keys with the given prefix
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+// validateKey allows only relative slash-separated keys without empty, "." and ".." segments,
+// so a key can't point outside the root of the target on any platform
+func validateKey(key string) error {
+	if key == "" || strings.Contains(key, "\\") {
+		return fmt.Errorf("%w: %s", ErrInvalidKey, key)
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("%w: %s", ErrInvalidKey, key)
+		}
+	}
+	return nil
+}
+
+type dirTarget struct {
+	root string
+}
+
+// NewDirTarget creates a target that stores every key as a file in the root directory
+func NewDirTarget(root string) (Target, error) {
+	if root == "" {
+		return nil, fmt.Errorf("%w: empty path", ErrInvalidTarget)
+	}
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, fmt.Errorf("create backup dir: %w", err)
+	}
+	return &dirTarget{root: root}, nil
+}
+
+func (t *dirTarget) Put(_ context.Context, key string, data []byte) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	filePath := filepath.Join(t.root, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(filePath), 0700); err != nil {
+		return err
+	}
+	// write to a temporary file first, so an interrupted backup doesn't leave partially written data
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), filePath)
+}
+
+func (t *dirTarget) Get(_ context.Context, key string) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(t.root, filepath.FromSlash(key)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+func (t *dirTarget) List(_ context.Context, prefix string) ([]string, error) {
+	var keys []string
+	start := t.root
+	if dir := path.Dir(prefix); strings.Contains(prefix, "/") && dir != "." {
+		start = filepath.Join(t.root, filepath.FromSlash(dir))
+	}
+	if _, err := os.Stat(start); errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	err := filepath.WalkDir(start, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".tmp-") {
+			return nil
+		}
+		rel, err := filepath.Rel(t.root, filePath)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk backup dir: %w", err)
+	}
+	return keys, nil
+}
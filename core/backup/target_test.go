@@ -0,0 +1,55 @@
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirTarget(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	target, err := NewDirTarget(root)
+	require.NoError(t, err)
+
+	t.Run("put and get", func(t *testing.T) {
+		require.NoError(t, target.Put(ctx, "spaces/space1/manifest.json", []byte("v1")))
+		require.NoError(t, target.Put(ctx, "spaces/space1/manifest.json", []byte("v2")))
+
+		data, err := target.Get(ctx, "spaces/space1/manifest.json")
+
+		require.NoError(t, err)
+		assert.Equal(t, []byte("v2"), data)
+	})
+
+	t.Run("get missing key", func(t *testing.T) {
+		_, err := target.Get(ctx, "spaces/space1/missing")
+
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("list by prefix", func(t *testing.T) {
+		require.NoError(t, target.Put(ctx, "blocks/cid1", []byte("1")))
+		require.NoError(t, target.Put(ctx, "blocks/cid2", []byte("2")))
+		// leftover of an interrupted write
+		require.NoError(t, os.WriteFile(filepath.Join(root, "blocks", ".tmp-123"), []byte("3"), 0600))
+
+		keys, err := target.List(ctx, "blocks/")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"blocks/cid1", "blocks/cid2"}, keys)
+
+		keys, err = target.List(ctx, "spaces/space2/")
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("keys outside of root are rejected", func(t *testing.T) {
+		for _, key := range []string{"", "/etc/passwd", "../secret", "blocks/../../secret", "..", ".", "blocks/./cid", "blocks//cid", "blocks\\..\\secret"} {
+			assert.ErrorIs(t, target.Put(ctx, key, nil), ErrInvalidKey, key)
+		}
+	})
+}
@@ -46,6 +46,8 @@ const (
 	StateIdKey     = "stateId"
 	OrderKey       = "_o"
 	SyncedKey      = "synced"
+	ThreadIdKey    = "threadId"
+	ThreadKey      = "thread"
 )
 
 type Message struct {
@@ -229,6 +231,32 @@ func (m *Message) MarshalAnyenc(marshalTo *anyenc.Value, arena *anyenc.Arena) {
 	marshalTo.Set(StateIdKey, arena.NewString(m.StateId))
 	marshalTo.Set(ReactionsKey, reactions)
 	marshalTo.Set(SyncedKey, arenaNewBool(arena, m.Synced))
+	// threadId is set only for thread replies, so messages of the main channel could be found by its absence
+	if m.ThreadId != "" {
+		marshalTo.Set(ThreadIdKey, arena.NewString(m.ThreadId))
+	} else {
+		marshalTo.Del(ThreadIdKey)
+	}
+	if m.Thread != nil {
+		marshalTo.Set(ThreadKey, marshalThread(m.Thread, arena))
+	} else {
+		marshalTo.Del(ThreadKey)
+	}
+}
+
+func marshalThread(thread *model.ChatMessageThread, arena *anyenc.Arena) *anyenc.Value {
+	res := arena.NewObject()
+	res.Set("replyCount", arena.NewNumberInt(int(thread.ReplyCount)))
+	if lastReply := thread.LastReply; lastReply != nil {
+		reply := arena.NewObject()
+		reply.Set("id", arena.NewString(lastReply.Id))
+		reply.Set("orderId", arena.NewString(lastReply.OrderId))
+		reply.Set("creator", arena.NewString(lastReply.Creator))
+		reply.Set("createdAt", arena.NewNumberInt(int(lastReply.CreatedAt)))
+		reply.Set("text", arena.NewString(lastReply.Text))
+		res.Set("lastReply", reply)
+	}
+	return res
 }
 
 func arenaNewBool(a *anyenc.Arena, value bool) *anyenc.Value {
@@ -255,6 +283,8 @@ func (m *messageUnmarshaller) toModel() (*Message, error) {
 			Attachments:      m.attachmentsToModel(),
 			Reactions:        m.reactionsToModel(),
 			Synced:           m.val.GetBool(SyncedKey),
+			ThreadId:         string(m.val.GetStringBytes(ThreadIdKey)),
+			Thread:           m.threadToModel(),
 		},
 		CurrentUserMentioned: m.val.GetBool(HasMentionKey),
 	}, nil
@@ -281,6 +311,26 @@ func (m *messageUnmarshaller) contentToModel() *model.ChatMessageMessageContent
 	}
 }
 
+func (m *messageUnmarshaller) threadToModel() *model.ChatMessageThread {
+	inThread := m.val.Get(ThreadKey)
+	if inThread == nil {
+		return nil
+	}
+	thread := &model.ChatMessageThread{
+		ReplyCount: int32(inThread.GetInt("replyCount")),
+	}
+	if inReply := inThread.Get("lastReply"); inReply != nil {
+		thread.LastReply = &model.ChatMessageThreadLastReply{
+			Id:        string(inReply.GetStringBytes("id")),
+			OrderId:   string(inReply.GetStringBytes("orderId")),
+			Creator:   string(inReply.GetStringBytes("creator")),
+			CreatedAt: int64(inReply.GetInt("createdAt")),
+			Text:      string(inReply.GetStringBytes("text")),
+		}
+	}
+	return thread
+}
+
 func (m *messageUnmarshaller) attachmentsToModel() []*model.ChatMessageAttachment {
 	inAttachments := m.val.GetObject(ContentKey, "attachments")
 	var attachments []*model.ChatMessageAttachment
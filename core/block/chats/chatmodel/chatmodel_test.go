@@ -3,7 +3,9 @@ package chatmodel
 import (
 	"testing"
 
+	"github.com/anyproto/any-store/anyenc"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
@@ -75,3 +77,49 @@ func TestValidate(t *testing.T) {
 		assert.Error(t, msg.Validate())
 	})
 }
+
+func TestMarshalThread(t *testing.T) {
+	arena := &anyenc.Arena{}
+
+	t.Run("thread info is preserved", func(t *testing.T) {
+		msg := &Message{
+			ChatMessage: &model.ChatMessage{
+				Id:       "reply",
+				ThreadId: "root",
+				Message:  &model.ChatMessageMessageContent{Text: "text"},
+				Thread: &model.ChatMessageThread{
+					ReplyCount: 2,
+					LastReply: &model.ChatMessageThreadLastReply{
+						Id:        "lastReply",
+						OrderId:   "order",
+						Creator:   "creator",
+						CreatedAt: 123,
+						Text:      "last reply",
+					},
+				},
+			},
+		}
+		val := arena.NewObject()
+		msg.MarshalAnyenc(val, arena)
+
+		got, err := UnmarshalMessage(val)
+		require.NoError(t, err)
+		assert.Equal(t, msg.ThreadId, got.ThreadId)
+		assert.Equal(t, msg.Thread, got.Thread)
+	})
+
+	t.Run("main channel message has no thread keys", func(t *testing.T) {
+		msg := &Message{
+			ChatMessage: &model.ChatMessage{
+				Id:      "main",
+				Message: &model.ChatMessageMessageContent{Text: "text"},
+			},
+		}
+		val := arena.NewObject()
+		val.Set(ThreadIdKey, arena.NewString("stale"))
+		msg.MarshalAnyenc(val, arena)
+
+		assert.Nil(t, val.Get(ThreadIdKey))
+		assert.Nil(t, val.Get(ThreadKey))
+	})
+}
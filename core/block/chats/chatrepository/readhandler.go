@@ -9,6 +9,8 @@ import (
 
 type readHandler interface {
 	getUnreadFilter() query.Filter
+	// getCounterFilter returns the filter of unread messages counted in the chat state
+	getCounterFilter() query.Filter
 	getMessagesFilter() query.Filter
 	getReadKey() string
 	readModifier(value bool) query.Modifier
@@ -22,6 +24,11 @@ func (h readMessagesHandler) getUnreadFilter() query.Filter {
 	}
 }
 
+func (h readMessagesHandler) getCounterFilter() query.Filter {
+	// replies of threads are counted separately
+	return query.And{h.getUnreadFilter(), threadFilter("")}
+}
+
 func (h readMessagesHandler) getMessagesFilter() query.Filter {
 	return nil
 }
@@ -51,6 +58,10 @@ func (h readMentionsHandler) getUnreadFilter() query.Filter {
 	}
 }
 
+func (h readMentionsHandler) getCounterFilter() query.Filter {
+	return h.getUnreadFilter()
+}
+
 func (h readMentionsHandler) getMessagesFilter() query.Filter {
 	return query.Key{Path: []string{chatmodel.HasMentionKey}, Filter: query.NewComp(query.CompOpEq, true)}
 }
@@ -83,6 +94,14 @@ func newReadHandler(counterType chatmodel.CounterType) readHandler {
 	}
 }
 
+// threadFilter selects replies of the thread or messages of the main channel if threadId is empty
+func threadFilter(threadId string) query.Filter {
+	if threadId == "" {
+		return query.Not{Filter: query.Key{Path: []string{chatmodel.ThreadIdKey}, Filter: query.Exists{}}}
+	}
+	return query.Key{Path: []string{chatmodel.ThreadIdKey}, Filter: query.NewComp(query.CompOpEq, threadId)}
+}
+
 func arenaNewBool(a *anyenc.Arena, value bool) *anyenc.Value {
 	if value {
 		return a.NewTrue()
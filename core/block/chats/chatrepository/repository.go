@@ -113,13 +113,14 @@ type Repository interface {
 	LoadChatState(ctx context.Context) (*model.ChatState, error)
 	GetOldestOrderId(ctx context.Context, counterType chatmodel.CounterType) (string, error)
 	GetReadMessagesAfter(ctx context.Context, afterOrderId string, counterType chatmodel.CounterType) ([]string, error)
-	GetUnreadMessageIdsInRange(ctx context.Context, afterOrderId, beforeOrderId string, lastStateId string, counterType chatmodel.CounterType) ([]string, error)
+	GetUnreadMessageIdsInRange(ctx context.Context, threadId string, afterOrderId, beforeOrderId string, lastStateId string, counterType chatmodel.CounterType) ([]string, error)
+	LoadThreadsState(ctx context.Context) (map[string]*model.ChatStateUnreadState, error)
 	GetAllUnreadMessages(ctx context.Context, counterType chatmodel.CounterType) ([]string, error)
 	SetReadFlag(ctx context.Context, chatObjectId string, msgIds []string, counterType chatmodel.CounterType, value bool) []string
 	GetMessages(ctx context.Context, req GetMessagesRequest) ([]*chatmodel.Message, error)
 	HasMyReaction(ctx context.Context, myIdentity string, messageId string, emoji string) (bool, error)
 	GetMessagesByIds(ctx context.Context, messageIds []string) ([]*chatmodel.Message, error)
-	GetLastMessages(ctx context.Context, limit uint) ([]*chatmodel.Message, error)
+	GetLastMessages(ctx context.Context, threadId string, limit uint) ([]*chatmodel.Message, error)
 	SetSyncedFlag(ctx context.Context, chatObjectId string, msgIds []string, value bool) []string
 }
 
@@ -197,6 +198,11 @@ func (s *repository) LoadChatState(ctx context.Context) (*model.ChatState, error
 		return nil, fmt.Errorf("get mentions state: %w", err)
 	}
 
+	threadsState, err := s.LoadThreadsState(txn.Context())
+	if err != nil {
+		return nil, fmt.Errorf("get threads state: %w", err)
+	}
+
 	lastStateId, err := s.GetLastStateId(txn.Context())
 	if err != nil {
 		return nil, fmt.Errorf("get last added date: %w", err)
@@ -205,10 +211,45 @@ func (s *repository) LoadChatState(ctx context.Context) (*model.ChatState, error
 	return &model.ChatState{
 		Messages:    messagesState,
 		Mentions:    mentionsState,
+		Threads:     threadsState,
 		LastStateId: lastStateId,
 	}, nil
 }
 
+// LoadThreadsState returns unread state of replies per thread root message id
+func (s *repository) LoadThreadsState(ctx context.Context) (map[string]*model.ChatStateUnreadState, error) {
+	qry := query.And{
+		readMessagesHandler{}.getUnreadFilter(),
+		query.Key{Path: []string{chatmodel.ThreadIdKey}, Filter: query.Exists{}},
+	}
+	iter, err := s.collection.Find(qry).Sort(ascOrder).Iter(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("init iter: %w", err)
+	}
+	defer iter.Close()
+
+	var threads map[string]*model.ChatStateUnreadState
+	for iter.Next() {
+		doc, err := iter.Doc()
+		if err != nil {
+			return nil, fmt.Errorf("get doc: %w", err)
+		}
+		if threads == nil {
+			threads = map[string]*model.ChatStateUnreadState{}
+		}
+		threadId := doc.Value().GetString(chatmodel.ThreadIdKey)
+		state, ok := threads[threadId]
+		if !ok {
+			state = &model.ChatStateUnreadState{
+				OldestOrderId: doc.Value().GetString(chatmodel.OrderKey, "id"),
+			}
+			threads[threadId] = state
+		}
+		state.Counter++
+	}
+	return threads, iter.Err()
+}
+
 func (s *repository) loadChatStateByType(ctx context.Context, counterType chatmodel.CounterType) (*model.ChatStateUnreadState, error) {
 	handler := newReadHandler(counterType)
 
@@ -230,7 +271,7 @@ func (s *repository) loadChatStateByType(ctx context.Context, counterType chatmo
 
 func (s *repository) GetOldestOrderId(ctx context.Context, counterType chatmodel.CounterType) (string, error) {
 	handler := newReadHandler(counterType)
-	unreadQuery := s.collection.Find(handler.getUnreadFilter()).Sort(ascOrder)
+	unreadQuery := s.collection.Find(handler.getCounterFilter()).Sort(ascOrder)
 
 	iter, err := unreadQuery.Limit(1).Iter(ctx)
 	if err != nil {
@@ -252,7 +293,7 @@ func (s *repository) GetOldestOrderId(ctx context.Context, counterType chatmodel
 }
 
 func (s *repository) countUnreadMessages(ctx context.Context, handler readHandler) (int, error) {
-	unreadQuery := s.collection.Find(handler.getUnreadFilter())
+	unreadQuery := s.collection.Find(handler.getCounterFilter())
 
 	return unreadQuery.Count(ctx)
 }
@@ -260,9 +301,11 @@ func (s *repository) countUnreadMessages(ctx context.Context, handler readHandle
 func (s *repository) GetReadMessagesAfter(ctx context.Context, afterOrderId string, counterType chatmodel.CounterType) ([]string, error) {
 	handler := newReadHandler(counterType)
 
+	// only messages of the main channel are marked as unread
 	filter := query.And{
 		query.Key{Path: []string{chatmodel.OrderKey, "id"}, Filter: query.NewComp(query.CompOpGte, afterOrderId)},
 		query.Key{Path: []string{handler.getReadKey()}, Filter: query.NewComp(query.CompOpEq, true)},
+		threadFilter(""),
 	}
 	if handler.getMessagesFilter() != nil {
 		filter = append(filter, handler.getMessagesFilter())
@@ -285,10 +328,11 @@ func (s *repository) GetReadMessagesAfter(ctx context.Context, afterOrderId stri
 	return msgIds, iter.Err()
 }
 
-func (s *repository) GetUnreadMessageIdsInRange(ctx context.Context, afterOrderId, beforeOrderId string, lastStateId string, counterType chatmodel.CounterType) ([]string, error) {
+func (s *repository) GetUnreadMessageIdsInRange(ctx context.Context, threadId string, afterOrderId, beforeOrderId string, lastStateId string, counterType chatmodel.CounterType) ([]string, error) {
 	handler := newReadHandler(counterType)
 
 	qry := query.And{
+		threadFilter(threadId),
 		query.Key{Path: []string{chatmodel.OrderKey, "id"}, Filter: query.NewComp(query.CompOpGte, afterOrderId)},
 		query.Key{Path: []string{chatmodel.OrderKey, "id"}, Filter: query.NewComp(query.CompOpLte, beforeOrderId)},
 		query.Or{
@@ -397,24 +441,34 @@ type GetMessagesRequest struct {
 	BeforeOrderId   string
 	Limit           int
 	IncludeBoundary bool
+	// ThreadId scopes messages to replies of the thread, messages of the main channel are returned if it's empty
+	ThreadId string
+	// AllThreads returns messages of the main channel and replies of all threads, ThreadId is ignored
+	AllThreads bool
 }
 
 func (s *repository) GetMessages(ctx context.Context, req GetMessagesRequest) ([]*chatmodel.Message, error) {
+	filter := query.And{}
+	if !req.AllThreads {
+		filter = append(filter, threadFilter(req.ThreadId))
+	}
 	var qry anystore.Query
 	if req.AfterOrderId != "" {
 		operator := query.CompOpGt
 		if req.IncludeBoundary {
 			operator = query.CompOpGte
 		}
-		qry = s.collection.Find(query.Key{Path: []string{chatmodel.OrderKey, "id"}, Filter: query.NewComp(operator, req.AfterOrderId)}).Sort(ascOrder).Limit(uint(req.Limit))
+		filter = append(filter, query.Key{Path: []string{chatmodel.OrderKey, "id"}, Filter: query.NewComp(operator, req.AfterOrderId)})
+		qry = s.collection.Find(filter).Sort(ascOrder).Limit(uint(req.Limit))
 	} else if req.BeforeOrderId != "" {
 		operator := query.CompOpLt
 		if req.IncludeBoundary {
 			operator = query.CompOpLte
 		}
-		qry = s.collection.Find(query.Key{Path: []string{chatmodel.OrderKey, "id"}, Filter: query.NewComp(operator, req.BeforeOrderId)}).Sort(descOrder).Limit(uint(req.Limit))
+		filter = append(filter, query.Key{Path: []string{chatmodel.OrderKey, "id"}, Filter: query.NewComp(operator, req.BeforeOrderId)})
+		qry = s.collection.Find(filter).Sort(descOrder).Limit(uint(req.Limit))
 	} else {
-		qry = s.collection.Find(nil).Sort(descOrder).Limit(uint(req.Limit))
+		qry = s.collection.Find(filter).Sort(descOrder).Limit(uint(req.Limit))
 	}
 
 	msgs, err := s.queryMessages(ctx, qry)
@@ -500,7 +554,7 @@ func (s *repository) GetMessagesByIds(ctx context.Context, messageIds []string)
 	return messages, nil
 }
 
-func (s *repository) GetLastMessages(ctx context.Context, limit uint) ([]*chatmodel.Message, error) {
-	qry := s.collection.Find(nil).Sort(descOrder).Limit(limit)
+func (s *repository) GetLastMessages(ctx context.Context, threadId string, limit uint) ([]*chatmodel.Message, error) {
+	qry := s.collection.Find(threadFilter(threadId)).Sort(descOrder).Limit(limit)
 	return s.queryMessages(ctx, qry)
 }
@@ -52,6 +52,8 @@ type subscription struct {
 	withDependencies bool
 
 	onlyLastMessage bool
+	// threadId is the id of the thread root message, the subscription receives messages of the main channel if it's empty
+	threadId string
 	// couldUseSessionContext determines if client could receive events synchronously in API responses
 	couldUseSessionContext bool
 }
@@ -71,6 +73,7 @@ func (s *subscriptionManager) subscribe(req SubscribeLastMessagesRequest) bool {
 			id:                     req.SubId,
 			withDependencies:       req.WithDependencies,
 			onlyLastMessage:        req.OnlyLastMessage,
+			threadId:               req.ThreadId,
 			couldUseSessionContext: req.CouldUseSessionContext,
 		}
 		s.chatStateUpdated = false
@@ -204,6 +207,7 @@ func (s *subscriptionManager) Flush() {
 	if len(syncSubIds) > 0 {
 		syncEvents := cloneEvents(events)
 		eventsSetSubIds(syncSubIds, syncEvents)
+		syncEvents = s.filterEventsByThread(syncEvents)
 		s.sessionContext.SetMessages(s.chatId, append(s.sessionContext.GetMessages(), syncEvents...))
 
 		ev := &pb.Event{
@@ -215,6 +219,7 @@ func (s *subscriptionManager) Flush() {
 
 	if len(asyncSubIds) > 0 {
 		eventsSetSubIds(asyncSubIds, events)
+		events = s.filterEventsByThread(events)
 		ev := &pb.Event{
 			ContextId: s.chatId,
 			Messages:  events,
@@ -224,9 +229,34 @@ func (s *subscriptionManager) Flush() {
 
 }
 
+// filterEventsByThread leaves only subscriptions of the message thread in added or updated message events
+func (s *subscriptionManager) filterEventsByThread(events []*pb.EventMessage) []*pb.EventMessage {
+	filterSubIds := func(subIds []string, threadId string) []string {
+		// subIds slice is shared between events, so filter a copy
+		return slices.DeleteFunc(slices.Clone(subIds), func(subId string) bool {
+			sub, ok := s.subscriptions[subId]
+			return ok && sub.threadId != threadId
+		})
+	}
+	return slices.DeleteFunc(events, func(ev *pb.EventMessage) bool {
+		if v := ev.GetChatAdd(); v != nil {
+			v.SubIds = filterSubIds(v.SubIds, v.Message.GetThreadId())
+			return len(v.SubIds) == 0
+		} else if v := ev.GetChatUpdate(); v != nil {
+			v.SubIds = filterSubIds(v.SubIds, v.Message.GetThreadId())
+			return len(v.SubIds) == 0
+		}
+		return false
+	})
+}
+
 func (s *subscriptionManager) getEventsOnlyForLastMessage(events []*pb.EventMessage, subIdsOnlyLastMessage []string) []*pb.EventMessage {
 	state := newMessagesState()
 	for _, ev := range events {
+		// Thread replies are not shown in the preview of the chat
+		if v := ev.GetChatAdd(); v != nil && v.Message.GetThreadId() != "" {
+			continue
+		}
 		state.applyEvent(ev)
 	}
 	lastMessage, ok := state.getLastAddedMessage()
@@ -362,11 +392,12 @@ func (s *subscriptionManager) UpdateSyncStatus(messageIds []string, isSynced boo
 // updateMessageRead updates the read status of the messages with the given ids
 // read ids should ONLY contain ids if they were actually modified in the DB
 func (s *subscriptionManager) updateMessageRead(ids []string, read bool) {
+	mainChannelIds := s.updateThreadsReadState(ids)
 	s.UpdateChatState(func(state *model.ChatState) *model.ChatState {
 		if read {
-			state.Messages.Counter -= int32(len(ids))
+			state.Messages.Counter -= int32(len(mainChannelIds))
 		} else {
-			state.Messages.Counter += int32(len(ids))
+			state.Messages.Counter += int32(len(mainChannelIds))
 		}
 		return state
 	})
@@ -383,6 +414,37 @@ func (s *subscriptionManager) updateMessageRead(ids []string, read bool) {
 	}))
 }
 
+// updateThreadsReadState reloads unread state of threads if some of the given messages are thread replies.
+// It returns ids of messages from the main channel
+func (s *subscriptionManager) updateThreadsReadState(ids []string) []string {
+	messages, err := s.repository.GetMessagesByIds(s.componentCtx, ids)
+	if err != nil {
+		log.Error("get messages by ids", zap.Error(err))
+		return ids
+	}
+	mainChannelIds := make([]string, 0, len(ids))
+	var hasReplies bool
+	for _, msg := range messages {
+		if msg.ThreadId == "" {
+			mainChannelIds = append(mainChannelIds, msg.Id)
+		} else {
+			hasReplies = true
+		}
+	}
+	if hasReplies {
+		threads, err := s.repository.LoadThreadsState(s.componentCtx)
+		if err != nil {
+			log.Error("load threads state", zap.Error(err))
+		} else {
+			s.UpdateChatState(func(state *model.ChatState) *model.ChatState {
+				state.Threads = threads
+				return state
+			})
+		}
+	}
+	return mainChannelIds
+}
+
 func (s *subscriptionManager) updateMentionRead(ids []string, read bool) {
 	s.UpdateChatState(func(state *model.ChatState) *model.ChatState {
 		if read {
@@ -456,6 +518,7 @@ func copyChatState(state *model.ChatState) *model.ChatState {
 	return &model.ChatState{
 		Messages:    copyReadState(state.Messages),
 		Mentions:    copyReadState(state.Mentions),
+		Threads:     copyThreadsState(state.Threads),
 		LastStateId: state.LastStateId,
 		Order:       state.Order,
 	}
@@ -471,6 +534,17 @@ func copyReadState(state *model.ChatStateUnreadState) *model.ChatStateUnreadStat
 	}
 }
 
+func copyThreadsState(threads map[string]*model.ChatStateUnreadState) map[string]*model.ChatStateUnreadState {
+	if threads == nil {
+		return nil
+	}
+	res := make(map[string]*model.ChatStateUnreadState, len(threads))
+	for threadId, state := range threads {
+		res[threadId] = copyReadState(state)
+	}
+	return res
+}
+
 func cloneEvents(events []*pb.EventMessage) []*pb.EventMessage {
 	res := make([]*pb.EventMessage, 0, len(events))
 	for _, ev := range events {
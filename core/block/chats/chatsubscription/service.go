@@ -170,6 +170,8 @@ type SubscribeLastMessagesRequest struct {
 	WithDependencies       bool
 	OnlyLastMessage        bool
 	CouldUseSessionContext bool
+	// ThreadId subscribes to replies of the thread instead of messages of the main channel
+	ThreadId string
 }
 
 type SubscribeLastMessagesResponse struct {
@@ -207,7 +209,7 @@ func (s *service) SubscribeLastMessages(ctx context.Context, req SubscribeLastMe
 	}
 	defer txn.Commit()
 
-	messages, err := mngr.repository.GetLastMessages(txn.Context(), uint(req.Limit))
+	messages, err := mngr.repository.GetLastMessages(txn.Context(), req.ThreadId, uint(req.Limit))
 	if err != nil {
 		return nil, fmt.Errorf("query messages: %w", err)
 	}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -48,7 +49,7 @@ type Service interface {
 	DeleteMessage(ctx context.Context, chatObjectId string, messageId string) error
 	GetMessages(ctx context.Context, chatObjectId string, req chatrepository.GetMessagesRequest) (*chatobject.GetMessagesResponse, error)
 	GetMessagesByIds(ctx context.Context, chatObjectId string, messageIds []string) ([]*chatmodel.Message, error)
-	SubscribeLastMessages(ctx context.Context, chatObjectId string, limit int, subId string) (*chatsubscription.SubscribeLastMessagesResponse, error)
+	SubscribeLastMessages(ctx context.Context, chatObjectId string, threadId string, limit int, subId string) (*chatsubscription.SubscribeLastMessagesResponse, error)
 	ReadMessages(ctx context.Context, req ReadMessagesRequest) error
 	UnreadMessages(ctx context.Context, chatObjectId string, afterOrderId string, counterType chatmodel.CounterType) error
 	Unsubscribe(chatObjectId string, subId string) error
@@ -57,6 +58,7 @@ type Service interface {
 	UnsubscribeFromMessagePreviews(subId string) error
 
 	ReadAll(ctx context.Context) error
+	SearchMessages(ctx context.Context, req SearchMessagesRequest) ([]*SearchMessagesResult, error)
 
 	app.ComponentRunnable
 }
@@ -526,9 +528,10 @@ func (s *service) GetMessagesByIds(ctx context.Context, chatObjectId string, mes
 	return res, err
 }
 
-func (s *service) SubscribeLastMessages(ctx context.Context, chatObjectId string, limit int, subId string) (*chatsubscription.SubscribeLastMessagesResponse, error) {
+func (s *service) SubscribeLastMessages(ctx context.Context, chatObjectId string, threadId string, limit int, subId string) (*chatsubscription.SubscribeLastMessagesResponse, error) {
 	return s.chatSubscriptionService.SubscribeLastMessages(s.componentCtx, chatsubscription.SubscribeLastMessagesRequest{
 		ChatObjectId:           chatObjectId,
+		ThreadId:               threadId,
 		SubId:                  subId,
 		Limit:                  limit,
 		WithDependencies:       false,
@@ -545,6 +548,7 @@ type ReadMessagesRequest struct {
 	AfterOrderId  string
 	BeforeOrderId string
 	LastStateId   string
+	ThreadId      string
 	CounterType   chatmodel.CounterType
 }
 
@@ -554,6 +558,7 @@ func (s *service) ReadMessages(ctx context.Context, req ReadMessagesRequest) err
 			AfterOrderId:  req.AfterOrderId,
 			BeforeOrderId: req.BeforeOrderId,
 			LastStateId:   req.LastStateId,
+			ThreadId:      req.ThreadId,
 			CounterType:   req.CounterType,
 		})
 		if err != nil {
@@ -619,6 +624,70 @@ func (s *service) ReadAll(ctx context.Context) error {
 	return nil
 }
 
+var ErrEmptySearchText = errors.New("search text is empty")
+
+type SearchMessagesRequest struct {
+	SpaceId string
+	// ChatObjectId is optional, all chats of the space are searched if it's empty
+	ChatObjectId string
+	Text         string
+	Limit        int
+}
+
+type SearchMessagesResult struct {
+	ChatObjectId    string
+	MessageId       string
+	OrderId         string
+	Highlight       string
+	HighlightRanges []*model.Range
+}
+
+// SearchMessages searches chat messages in the full-text index. Results are ordered by relevance
+func (s *service) SearchMessages(ctx context.Context, req SearchMessagesRequest) ([]*SearchMessagesResult, error) {
+	if strings.TrimSpace(req.Text) == "" {
+		return nil, ErrEmptySearchText
+	}
+	matches, err := s.objectStore.SpaceIndex(req.SpaceId).SearchChatMessages(req.ChatObjectId, req.Text)
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+
+	messageIdsByChat := map[string][]string{}
+	for _, match := range matches {
+		messageIdsByChat[match.Path.ObjectId] = append(messageIdsByChat[match.Path.ObjectId], match.Path.MessageId)
+	}
+	// messages could be already deleted from the chat while the index isn't updated yet
+	orderIds := make(map[domain.ObjectPath]string, len(matches))
+	for chatObjectId, messageIds := range messageIdsByChat {
+		messages, err := s.GetMessagesByIds(ctx, chatObjectId, messageIds)
+		if err != nil {
+			return nil, fmt.Errorf("get messages of chat %s: %w", chatObjectId, err)
+		}
+		for _, msg := range messages {
+			orderIds[domain.NewObjectPathWithMessage(chatObjectId, msg.Id)] = msg.OrderId
+		}
+	}
+
+	results := make([]*SearchMessagesResult, 0, len(orderIds))
+	for _, match := range matches {
+		orderId, ok := orderIds[match.Path]
+		if !ok {
+			continue
+		}
+		results = append(results, &SearchMessagesResult{
+			ChatObjectId:    match.Path.ObjectId,
+			MessageId:       match.Path.MessageId,
+			OrderId:         orderId,
+			Highlight:       match.Highlight,
+			HighlightRanges: match.HighlightRanges,
+		})
+		if req.Limit > 0 && len(results) == req.Limit {
+			break
+		}
+	}
+	return results, nil
+}
+
 func pushGroupId(objectId string) string {
 	hash := sha256.Sum256([]byte(objectId))
 	return hex.EncodeToString(hash[:])
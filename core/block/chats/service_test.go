@@ -526,3 +526,32 @@ func TestApplyEmojiMarks(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchMessages(t *testing.T) {
+	t.Run("empty text", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.crossSpaceSubService.EXPECT().Subscribe(mock.Anything, mock.Anything).Return(&subscription.SubscribeResponse{}, nil).Maybe()
+		fx.start(t)
+
+		// when
+		_, err := fx.SearchMessages(context.Background(), SearchMessagesRequest{SpaceId: "space1", Text: "  "})
+
+		// then
+		require.ErrorIs(t, err, ErrEmptySearchText)
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.crossSpaceSubService.EXPECT().Subscribe(mock.Anything, mock.Anything).Return(&subscription.SubscribeResponse{}, nil).Maybe()
+		fx.start(t)
+
+		// when
+		results, err := fx.SearchMessages(context.Background(), SearchMessagesRequest{SpaceId: "space1", Text: "hello"})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
@@ -386,6 +386,9 @@ func (s *Service) UploadFile(ctx context.Context, spaceId string, req FileUpload
 	if req.ImageKind != model.ImageKind_Basic {
 		upl.SetImageKind(req.ImageKind)
 	}
+	if req.ReuseDuplicate {
+		upl.ReuseDuplicates()
+	}
 	res := upl.Upload(ctx)
 	if res.Err != nil {
 		return "", 0, nil, res.Err
@@ -39,7 +39,7 @@ func (a accountHandler) BeforeDelete(ctx context.Context, ch storestate.ChangeOp
 	return storestate.DeleteModeDelete, nil
 }
 
-func (a accountHandler) UpgradeKeyModifier(ch storestate.ChangeOp, key *pb.KeyModify, mod query.Modifier) query.Modifier {
+func (a accountHandler) UpgradeKeyModifier(ctx context.Context, ch storestate.ChangeOp, key *pb.KeyModify, mod query.Modifier) query.Modifier {
 	return query.ModifyFunc(func(a *anyenc.Arena, v *anyenc.Value) (result *anyenc.Value, modified bool, err error) {
 		return mod.Modify(a, v)
 	})
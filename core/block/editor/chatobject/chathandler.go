@@ -24,6 +24,7 @@ type ChatHandler struct {
 	subscription    chatsubscription.Manager
 	currentIdentity string
 	myParticipantId string
+	changedMessages *changedMessages
 	// forceNotRead forces handler to mark all messages as not read. It's useful for unit testing
 	forceNotRead bool
 }
@@ -73,6 +74,8 @@ func (d *ChatHandler) BeforeCreate(ctx context.Context, ch storestate.ChangeOp)
 	}
 
 	msg.StateId = bson.NewObjectId().Hex()
+	// Thread info is maintained locally from replies
+	msg.Thread = nil
 
 	isMentioned, err := msg.IsCurrentUserMentioned(ctx, d.myParticipantId, d.currentIdentity, d.repository)
 	if err != nil {
@@ -88,20 +91,39 @@ func (d *ChatHandler) BeforeCreate(ctx context.Context, ch storestate.ChangeOp)
 
 	d.subscription.Lock()
 	defer d.subscription.Unlock()
+
+	if msg.ThreadId != "" {
+		err = d.updateThreadRoot(ctx, ch, msg.ThreadId, msg, "")
+		if err != nil {
+			return fmt.Errorf("update thread root: %w", err)
+		}
+	}
+
 	d.subscription.UpdateChatState(func(state *model.ChatState) *model.ChatState {
-		if !msg.Read {
+		if !msg.Read && msg.ThreadId != "" {
+			if state.Threads == nil {
+				state.Threads = map[string]*model.ChatStateUnreadState{}
+			}
+			threadState, ok := state.Threads[msg.ThreadId]
+			if !ok {
+				threadState = &model.ChatStateUnreadState{}
+				state.Threads[msg.ThreadId] = threadState
+			}
+			if msg.OrderId < threadState.OldestOrderId || threadState.OldestOrderId == "" {
+				threadState.OldestOrderId = msg.OrderId
+			}
+			threadState.Counter++
+		} else if !msg.Read {
 			if msg.OrderId < state.Messages.OldestOrderId || state.Messages.OldestOrderId == "" {
 				state.Messages.OldestOrderId = msg.OrderId
 			}
 			state.Messages.Counter++
-
-			if isMentioned {
-				state.Mentions.Counter++
-				if msg.OrderId < state.Mentions.OldestOrderId || state.Mentions.OldestOrderId == "" {
-					state.Mentions.OldestOrderId = msg.OrderId
-				}
+		}
+		if !msg.Read && isMentioned {
+			state.Mentions.Counter++
+			if msg.OrderId < state.Mentions.OldestOrderId || state.Mentions.OldestOrderId == "" {
+				state.Mentions.OldestOrderId = msg.OrderId
 			}
-
 		}
 		if msg.StateId > state.LastStateId {
 			state.LastStateId = msg.StateId
@@ -110,6 +132,7 @@ func (d *ChatHandler) BeforeCreate(ctx context.Context, ch storestate.ChangeOp)
 	})
 
 	d.subscription.Add(prevOrderId, msg)
+	d.changedMessages.add(msg.Id)
 
 	msg.MarshalAnyenc(ch.Value, ch.Arena)
 
@@ -146,12 +169,96 @@ func (d *ChatHandler) BeforeDelete(ctx context.Context, ch storestate.ChangeOp)
 
 	d.subscription.Lock()
 	defer d.subscription.Unlock()
+	if message.ThreadId != "" {
+		err = d.updateThreadRoot(ctx, ch, message.ThreadId, nil, messageId)
+		if err != nil {
+			return storestate.DeleteModeDelete, fmt.Errorf("update thread root: %w", err)
+		}
+	}
 	d.subscription.Delete(messageId)
+	d.changedMessages.add(messageId)
 
 	return storestate.DeleteModeDelete, nil
 }
 
-func (d *ChatHandler) UpgradeKeyModifier(ch storestate.ChangeOp, key *pb.KeyModify, mod query.Modifier) query.Modifier {
+// updateThreadRoot recalculates reply counter and the last reply of the thread root message.
+// addedReply is a reply that is being inserted or edited, deletedReplyId is an id of a reply that is being deleted
+func (d *ChatHandler) updateThreadRoot(ctx context.Context, ch storestate.ChangeOp, threadId string, addedReply *chatmodel.Message, deletedReplyId string) error {
+	coll, err := ch.State.Collection(ctx, CollectionName)
+	if err != nil {
+		return fmt.Errorf("get collection: %w", err)
+	}
+
+	iter, err := coll.Find(query.Key{Path: []string{chatmodel.ThreadIdKey}, Filter: query.NewComp(query.CompOpEq, threadId)}).Sort(descOrder).Iter(ctx)
+	if err != nil {
+		return fmt.Errorf("find replies: %w", err)
+	}
+	defer iter.Close()
+
+	thread := &model.ChatMessageThread{}
+	var lastReply *chatmodel.Message
+	for iter.Next() {
+		doc, err := iter.Doc()
+		if err != nil {
+			return fmt.Errorf("get doc: %w", err)
+		}
+		reply, err := chatmodel.UnmarshalMessage(doc.Value())
+		if err != nil {
+			return fmt.Errorf("unmarshal reply: %w", err)
+		}
+		if reply.Id == deletedReplyId || (addedReply != nil && reply.Id == addedReply.Id) {
+			continue
+		}
+		thread.ReplyCount++
+		if lastReply == nil {
+			lastReply = reply
+		}
+	}
+	if err = iter.Err(); err != nil {
+		return fmt.Errorf("iterate replies: %w", err)
+	}
+	if addedReply != nil {
+		thread.ReplyCount++
+		if lastReply == nil || addedReply.OrderId > lastReply.OrderId {
+			lastReply = addedReply
+		}
+	}
+	if lastReply != nil {
+		thread.LastReply = &model.ChatMessageThreadLastReply{
+			Id:        lastReply.Id,
+			OrderId:   lastReply.OrderId,
+			Creator:   lastReply.Creator,
+			CreatedAt: lastReply.CreatedAt,
+			Text:      lastReply.GetMessage().GetText(),
+		}
+	}
+
+	var root *chatmodel.Message
+	_, err = coll.UpdateId(ctx, threadId, query.ModifyFunc(func(a *anyenc.Arena, v *anyenc.Value) (*anyenc.Value, bool, error) {
+		root, err = chatmodel.UnmarshalMessage(v)
+		if err != nil {
+			return nil, false, fmt.Errorf("unmarshal root message: %w", err)
+		}
+		if thread.ReplyCount == 0 {
+			root.Thread = nil
+		} else {
+			root.Thread = thread
+		}
+		root.MarshalAnyenc(v, a)
+		return v, true, nil
+	}))
+	if errors.Is(err, anystore.ErrDocNotFound) {
+		// Root message could be already deleted
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	d.subscription.UpdateFull(root)
+	return nil
+}
+
+func (d *ChatHandler) UpgradeKeyModifier(ctx context.Context, ch storestate.ChangeOp, key *pb.KeyModify, mod query.Modifier) query.Modifier {
 	return query.ModifyFunc(func(a *anyenc.Arena, v *anyenc.Value) (result *anyenc.Value, modified bool, err error) {
 		if len(key.KeyPath) == 0 {
 			return nil, false, fmt.Errorf("no key path")
@@ -190,7 +297,15 @@ func (d *ChatHandler) UpgradeKeyModifier(ch storestate.ChangeOp, key *pb.KeyModi
 				}
 				msg.ModifiedAt = ch.Change.Timestamp
 				msg.MarshalAnyenc(result, a)
+				if msg.ThreadId != "" {
+					// keep the text of the last reply on the thread root up to date
+					err = d.updateThreadRoot(ctx, ch, msg.ThreadId, msg, "")
+					if err != nil {
+						return nil, false, fmt.Errorf("update thread root: %w", err)
+					}
+				}
 				d.subscription.UpdateFull(msg)
+				d.changedMessages.add(msg.Id)
 			default:
 				return nil, false, fmt.Errorf("invalid key path %s", key.KeyPath)
 			}
@@ -2,7 +2,9 @@ package chatobject
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	anystore "github.com/anyproto/any-store"
@@ -11,6 +13,7 @@ import (
 	"github.com/anyproto/any-sync/commonspace/object/accountdata"
 	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
 	"github.com/anyproto/any-sync/util/slice"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
 
@@ -41,6 +44,11 @@ const (
 
 var log = logging.Logger("core.block.editor.chatobject").Desugar()
 
+var (
+	ErrThreadRootNotFound = errors.New("thread root message not found")
+	ErrNestedThread       = errors.New("can't reply in thread to a thread reply")
+)
+
 type StoreObject interface {
 	smartblock.SmartBlock
 	anystoredebug.AnystoreDebug
@@ -61,6 +69,40 @@ type AccountService interface {
 	Keys() *accountdata.AccountKeys
 }
 
+// FulltextQueue is used to schedule indexing of chat messages
+type FulltextQueue interface {
+	AddToIndexQueue(ctx context.Context, ids ...domain.FullID) error
+}
+
+// changedMessages collects ids of messages added, edited or deleted since the last full-text indexing
+type changedMessages struct {
+	lock sync.Mutex
+	ids  map[string]struct{}
+}
+
+func (c *changedMessages) add(id string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.ids == nil {
+		c.ids = map[string]struct{}{}
+	}
+	c.ids[id] = struct{}{}
+}
+
+func (c *changedMessages) len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return len(c.ids)
+}
+
+func (c *changedMessages) take() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	ids := lo.Keys(c.ids)
+	c.ids = nil
+	return ids
+}
+
 type seenHeadsCollector interface {
 	collectSeenHeads(ctx context.Context, afterOrderId string) ([]string, error)
 }
@@ -81,6 +123,8 @@ type storeObject struct {
 	chatHandler             *ChatHandler
 	repository              chatrepository.Repository
 	statService             debugstat.StatService
+	fulltextQueue           FulltextQueue
+	changedMessages         *changedMessages
 
 	arenaPool          *anyenc.ArenaPool
 	componentCtx       context.Context
@@ -133,13 +177,15 @@ func (s *storeObject) StatType() string {
 	return "store.object"
 }
 
-func New(sb smartblock.SmartBlock, accountService AccountService, crdtDb anystore.DB, repositoryService chatrepository.Service, chatSubscriptionService chatsubscription.Service, statService debugstat.StatService) StoreObject {
+func New(sb smartblock.SmartBlock, accountService AccountService, crdtDb anystore.DB, repositoryService chatrepository.Service, chatSubscriptionService chatsubscription.Service, statService debugstat.StatService, fulltextQueue FulltextQueue) StoreObject {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &storeObject{
 		SmartBlock:              sb,
 		locker:                  sb.(smartblock.Locker),
 		accountService:          accountService,
 		statService:             statService,
+		fulltextQueue:           fulltextQueue,
+		changedMessages:         &changedMessages{},
 		arenaPool:               &anyenc.ArenaPool{},
 		crdtDb:                  crdtDb,
 		repositoryService:       repositoryService,
@@ -199,6 +245,7 @@ func (s *storeObject) Init(ctx *smartblock.InitContext) error {
 		subscription:    s.subscription,
 		currentIdentity: s.accountService.AccountID(),
 		myParticipantId: myParticipantId,
+		changedMessages: s.changedMessages,
 	}
 
 	stateStore, err := storestate.New(ctx.Ctx, s.Id(), s.crdtDb, s.chatHandler)
@@ -228,8 +275,44 @@ func (s *storeObject) Init(ctx *smartblock.InitContext) error {
 
 func (s *storeObject) onUpdate() {
 	s.subscription.Lock()
-	defer s.subscription.Unlock()
 	s.subscription.Flush()
+	s.subscription.Unlock()
+
+	if s.changedMessages.len() == 0 {
+		return
+	}
+	err := s.fulltextQueue.AddToIndexQueue(s.componentCtx, domain.FullID{ObjectID: s.Id(), SpaceID: s.SpaceID()})
+	if err != nil {
+		log.Error("add chat to fulltext index queue", zap.Error(err))
+	}
+}
+
+// GetAllMessages returns all messages of the chat in ascending order, it's used for full-text indexing
+func (s *storeObject) GetAllMessages(ctx context.Context) ([]*chatmodel.Message, error) {
+	return s.repository.GetMessages(ctx, chatrepository.GetMessagesRequest{IncludeBoundary: true, AllThreads: true})
+}
+
+// TakeChangedMessages returns messages changed since the previous call and ids of deleted messages.
+// ok is false when changes are unknown, e.g. after the object is reloaded, so all messages have to be indexed
+func (s *storeObject) TakeChangedMessages(ctx context.Context) (changed []*chatmodel.Message, deletedIds []string, ok bool, err error) {
+	ids := s.changedMessages.take()
+	if len(ids) == 0 {
+		return nil, nil, false, nil
+	}
+	changed, err = s.repository.GetMessagesByIds(ctx, ids)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("get changed messages: %w", err)
+	}
+	found := make(map[string]struct{}, len(changed))
+	for _, msg := range changed {
+		found[msg.Id] = struct{}{}
+	}
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			deletedIds = append(deletedIds, id)
+		}
+	}
+	return changed, deletedIds, true, nil
 }
 
 func (s *storeObject) GetMessageById(ctx context.Context, id string) (*chatmodel.Message, error) {
@@ -274,6 +357,13 @@ func (s *storeObject) AddMessage(ctx context.Context, sessionCtx session.Context
 		s.arenaPool.Put(arena)
 	}()
 
+	if message.ThreadId != "" {
+		err = s.validateThreadRoot(ctx, message.ThreadId)
+		if err != nil {
+			return "", fmt.Errorf("validate thread: %w", err)
+		}
+	}
+
 	// Normalize message
 	message.Read = false
 	message.MentionRead = false
@@ -283,6 +373,7 @@ func (s *storeObject) AddMessage(ctx context.Context, sessionCtx session.Context
 	obj.Del(chatmodel.ReadKey)
 	obj.Del(chatmodel.MentionReadKey)
 	obj.Del(chatmodel.SyncedKey)
+	obj.Del(chatmodel.ThreadKey)
 
 	builder := storestate.Builder{}
 	err = builder.Create(CollectionName, storestate.IdFromChange, obj)
@@ -319,6 +410,21 @@ func (s *storeObject) AddMessage(ctx context.Context, sessionCtx session.Context
 	return messageId, nil
 }
 
+// validateThreadRoot checks that thread root exists and is not a reply itself, because nested threads are not supported
+func (s *storeObject) validateThreadRoot(ctx context.Context, threadId string) error {
+	roots, err := s.repository.GetMessagesByIds(ctx, []string{threadId})
+	if err != nil {
+		return fmt.Errorf("get thread root: %w", err)
+	}
+	if len(roots) == 0 {
+		return ErrThreadRootNotFound
+	}
+	if roots[0].ThreadId != "" {
+		return ErrNestedThread
+	}
+	return nil
+}
+
 func (s *storeObject) DeleteMessage(ctx context.Context, messageId string) error {
 	builder := storestate.Builder{}
 	builder.Delete(CollectionName, messageId)
@@ -78,6 +78,7 @@ type fixture struct {
 	eventSender        *mock_event.MockSender
 	events             []*pb.EventMessage
 	spaceIndex         spaceindex.Store
+	objectStore        *objectstore.StoreFixture
 
 	generateOrderIdFunc func(tx *storestate.StoreStateTx) string
 }
@@ -98,6 +99,7 @@ func newFixture(t *testing.T) *fixture {
 	eventSender := mock_event.NewMockSender(t)
 
 	sb := smarttest.New("chatId1")
+	sb.SetSpaceId(testSpaceId)
 
 	objectStore := objectstore.NewStoreFixture(t)
 	spaceIndex := objectStore.SpaceIndex(testSpaceId)
@@ -125,7 +127,7 @@ func newFixture(t *testing.T) *fixture {
 	db, err := provider.GetCrdtDb(testSpaceId).Wait()
 	require.NoError(t, err)
 
-	object := New(sb, accountService, db, repo, subscriptions, debugstat.NewNoOp())
+	object := New(sb, accountService, db, repo, subscriptions, debugstat.NewNoOp(), objectStore)
 	rawObject := object.(*storeObject)
 
 	fx := &fixture{
@@ -134,6 +136,7 @@ func newFixture(t *testing.T) *fixture {
 		sourceCreator:      testCreator,
 		eventSender:        eventSender,
 		spaceIndex:         spaceIndex,
+		objectStore:        objectStore,
 	}
 	eventSender.EXPECT().Broadcast(mock.Anything).Run(func(event *pb.Event) {
 		for _, msg := range event.Messages {
@@ -330,6 +333,182 @@ func TestGetMessagesByIds(t *testing.T) {
 	assertMessagesEqual(t, want, got)
 }
 
+func TestGetAllMessages(t *testing.T) {
+	ctx := context.Background()
+	fx := newFixture(t)
+
+	for i := 0; i < 3; i++ {
+		inputMessage := givenComplexMessage()
+		inputMessage.Message.Text = fmt.Sprintf("text %d", i+1)
+		_, err := fx.AddMessage(ctx, nil, inputMessage)
+		require.NoError(t, err)
+	}
+
+	messages, err := fx.GetAllMessages(ctx)
+	require.NoError(t, err)
+
+	wantTexts := []string{"text 1", "text 2", "text 3"}
+	require.Len(t, messages, len(wantTexts))
+	for i, msg := range messages {
+		assert.Equal(t, wantTexts[i], msg.Message.Text)
+	}
+
+	t.Run("chat is added to fulltext queue", func(t *testing.T) {
+		ids, err := fx.objectStore.ListIdsFromFullTextQueue([]string{testSpaceId}, 0)
+		require.NoError(t, err)
+		assert.Contains(t, ids, domain.FullID{ObjectID: fx.Id(), SpaceID: testSpaceId})
+	})
+}
+
+func TestTakeChangedMessages(t *testing.T) {
+	// given
+	ctx := context.Background()
+	fx := newFixture(t)
+	firstId, err := fx.AddMessage(ctx, nil, givenSimpleMessage("first"))
+	require.NoError(t, err)
+	secondId, err := fx.AddMessage(ctx, nil, givenSimpleMessage("second"))
+	require.NoError(t, err)
+
+	changed, deletedIds, ok, err := fx.TakeChangedMessages(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Len(t, changed, 2)
+	assert.Empty(t, deletedIds)
+
+	// when
+	err = fx.EditMessage(ctx, firstId, givenSimpleMessage("edited"))
+	require.NoError(t, err)
+	err = fx.DeleteMessage(ctx, secondId)
+	require.NoError(t, err)
+
+	// then
+	changed, deletedIds, ok, err = fx.TakeChangedMessages(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, changed, 1)
+	assert.Equal(t, "edited", changed[0].Message.Text)
+	assert.Equal(t, []string{secondId}, deletedIds)
+
+	t.Run("changes are unknown when they are taken", func(t *testing.T) {
+		_, _, ok, err := fx.TakeChangedMessages(ctx)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestThreads(t *testing.T) {
+	ctx := context.Background()
+
+	givenThread := func(t *testing.T, fx *fixture) (rootId string, replyIds []string) {
+		rootId, err := fx.AddMessage(ctx, nil, givenSimpleMessage("root"))
+		require.NoError(t, err)
+		for i := 0; i < 3; i++ {
+			reply := givenSimpleMessage(fmt.Sprintf("reply %d", i+1))
+			reply.ThreadId = rootId
+			replyId, err := fx.AddMessage(ctx, nil, reply)
+			require.NoError(t, err)
+			replyIds = append(replyIds, replyId)
+		}
+		_, err = fx.AddMessage(ctx, nil, givenSimpleMessage("main"))
+		require.NoError(t, err)
+		return rootId, replyIds
+	}
+
+	t.Run("root message has reply count and last reply", func(t *testing.T) {
+		fx := newFixture(t)
+		rootId, replyIds := givenThread(t, fx)
+
+		root, err := fx.GetMessageById(ctx, rootId)
+		require.NoError(t, err)
+		require.NotNil(t, root.Thread)
+		assert.Equal(t, int32(3), root.Thread.ReplyCount)
+		assert.Equal(t, replyIds[2], root.Thread.LastReply.Id)
+		assert.Equal(t, "reply 3", root.Thread.LastReply.Text)
+		assert.Equal(t, testCreator, root.Thread.LastReply.Creator)
+	})
+
+	t.Run("thread replies are separated from the main channel", func(t *testing.T) {
+		fx := newFixture(t)
+		rootId, _ := givenThread(t, fx)
+
+		mainResp, err := fx.GetMessages(ctx, chatrepository.GetMessagesRequest{})
+		require.NoError(t, err)
+		var mainTexts []string
+		for _, msg := range mainResp.Messages {
+			mainTexts = append(mainTexts, msg.Message.Text)
+		}
+		assert.Equal(t, []string{"root", "main"}, mainTexts)
+
+		threadResp, err := fx.GetMessages(ctx, chatrepository.GetMessagesRequest{ThreadId: rootId, Limit: 2})
+		require.NoError(t, err)
+		var threadTexts []string
+		for _, msg := range threadResp.Messages {
+			threadTexts = append(threadTexts, msg.Message.Text)
+		}
+		assert.Equal(t, []string{"reply 2", "reply 3"}, threadTexts)
+	})
+
+	t.Run("deleting reply updates thread root", func(t *testing.T) {
+		fx := newFixture(t)
+		rootId, replyIds := givenThread(t, fx)
+
+		err := fx.DeleteMessage(ctx, replyIds[2])
+		require.NoError(t, err)
+
+		root, err := fx.GetMessageById(ctx, rootId)
+		require.NoError(t, err)
+		require.NotNil(t, root.Thread)
+		assert.Equal(t, int32(2), root.Thread.ReplyCount)
+		assert.Equal(t, replyIds[1], root.Thread.LastReply.Id)
+	})
+
+	t.Run("editing last reply updates thread root", func(t *testing.T) {
+		fx := newFixture(t)
+		rootId, replyIds := givenThread(t, fx)
+
+		err := fx.EditMessage(ctx, replyIds[2], givenSimpleMessage("edited reply"))
+		require.NoError(t, err)
+
+		root, err := fx.GetMessageById(ctx, rootId)
+		require.NoError(t, err)
+		require.NotNil(t, root.Thread)
+		assert.Equal(t, int32(3), root.Thread.ReplyCount)
+		assert.Equal(t, replyIds[2], root.Thread.LastReply.Id)
+		assert.Equal(t, "edited reply", root.Thread.LastReply.Text)
+	})
+
+	t.Run("unread replies are counted per thread", func(t *testing.T) {
+		fx := newFixture(t)
+		fx.chatHandler.forceNotRead = true
+		rootId, _ := givenThread(t, fx)
+
+		resp, err := fx.GetMessages(ctx, chatrepository.GetMessagesRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), resp.ChatState.Messages.Counter)
+		require.Contains(t, resp.ChatState.Threads, rootId)
+		assert.Equal(t, int32(3), resp.ChatState.Threads[rootId].Counter)
+	})
+
+	t.Run("can't reply to missing message", func(t *testing.T) {
+		fx := newFixture(t)
+
+		reply := givenSimpleMessage("reply")
+		reply.ThreadId = "missing"
+		_, err := fx.AddMessage(ctx, nil, reply)
+		require.ErrorIs(t, err, ErrThreadRootNotFound)
+	})
+
+	t.Run("can't reply to thread reply", func(t *testing.T) {
+		fx := newFixture(t)
+		_, replyIds := givenThread(t, fx)
+
+		reply := givenSimpleMessage("nested reply")
+		reply.ThreadId = replyIds[0]
+		_, err := fx.AddMessage(ctx, nil, reply)
+		require.ErrorIs(t, err, ErrNestedThread)
+	})
+}
+
 func TestEditMessage(t *testing.T) {
 	t.Run("edit own message", func(t *testing.T) {
 		ctx := context.Background()
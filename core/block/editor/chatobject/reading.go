@@ -17,6 +17,8 @@ type ReadMessagesRequest struct {
 	AfterOrderId  string
 	BeforeOrderId string
 	LastStateId   string
+	// ThreadId scopes the range to replies of the thread, messages of the main channel are read if it's empty
+	ThreadId string
 
 	CounterType chatmodel.CounterType
 
@@ -40,7 +42,7 @@ func (s *storeObject) MarkReadMessages(ctx context.Context, req ReadMessagesRequ
 		}
 	} else {
 		var err error
-		msgs, err = s.repository.GetUnreadMessageIdsInRange(ctx, req.AfterOrderId, req.BeforeOrderId, req.LastStateId, req.CounterType)
+		msgs, err = s.repository.GetUnreadMessageIdsInRange(ctx, req.ThreadId, req.AfterOrderId, req.BeforeOrderId, req.LastStateId, req.CounterType)
 		if err != nil {
 			return 0, fmt.Errorf("get messages: %w", err)
 		}
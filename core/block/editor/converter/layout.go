@@ -387,7 +387,7 @@ func insertGroupRelationKey(block *model.BlockContentOfDataview, viewType model.
 			model.RelationFormat_tag:      {},
 			model.RelationFormat_checkbox: {},
 		}
-	case model.BlockContentDataviewView_Calendar:
+	case model.BlockContentDataviewView_Calendar, model.BlockContentDataviewView_Timeline:
 		formats = map[model.RelationFormat]struct{}{model.RelationFormat_date: {}}
 	default:
 		return
@@ -400,7 +400,12 @@ func insertGroupRelationKey(block *model.BlockContentOfDataview, viewType model.
 		}
 		relation, err := bundle.GetRelation(domain.RelationKey(relLink.Key))
 		if errors.Is(err, bundle.ErrNotFound) || (relation != nil && !relation.Hidden) {
-			block.Dataview.Views[0].GroupRelationKey = relLink.Key
+			if viewType == model.BlockContentDataviewView_Timeline {
+				// timeline lanes are optional, so the date relation only defines where items start
+				block.Dataview.Views[0].StartRelationKey = relLink.Key
+			} else {
+				block.Dataview.Views[0].GroupRelationKey = relLink.Key
+			}
 			return
 		}
 	}
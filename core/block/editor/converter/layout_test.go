@@ -217,6 +217,27 @@ func TestInsertGroupRelationKey(t *testing.T) {
 			assert.Equal(t, tc.expectedKey, domain.RelationKey(block.Dataview.Views[0].GroupRelationKey))
 		})
 	}
+
+	t.Run("timeline starts with first unhidden date relation", func(t *testing.T) {
+		// given
+		block := &model.BlockContentOfDataview{Dataview: &model.BlockContentDataview{
+			Views: []*model.BlockContentDataviewView{{
+				Type: model.BlockContentDataviewView_Timeline,
+			}},
+			RelationLinks: []*model.RelationLink{
+				{Key: bundle.RelationKeyStatus.String(), Format: model.RelationFormat_status},
+				{Key: bundle.RelationKeyLastUsedDate.String(), Format: model.RelationFormat_date},
+				{Key: bundle.RelationKeyDueDate.String(), Format: model.RelationFormat_date},
+			},
+		}}
+
+		// when
+		insertGroupRelationKey(block, model.BlockContentDataviewView_Timeline)
+
+		// then
+		assert.Equal(t, bundle.RelationKeyDueDate.String(), block.Dataview.Views[0].StartRelationKey)
+		assert.Empty(t, block.Dataview.Views[0].GroupRelationKey)
+	})
 }
 
 func TestLayout_isConversionAllowed(t *testing.T) {
@@ -36,7 +36,7 @@ type Handler interface {
 	BeforeCreate(ctx context.Context, ch ChangeOp) (err error)
 	BeforeModify(ctx context.Context, ch ChangeOp) (mode ModifyMode, err error)
 	BeforeDelete(ctx context.Context, ch ChangeOp) (mode DeleteMode, err error)
-	UpgradeKeyModifier(ch ChangeOp, key *pb.KeyModify, mod query.Modifier) query.Modifier
+	UpgradeKeyModifier(ctx context.Context, ch ChangeOp, key *pb.KeyModify, mod query.Modifier) query.Modifier
 }
 
 type DefaultHandler struct {
@@ -66,6 +66,6 @@ func (d DefaultHandler) BeforeDelete(ctx context.Context, ch ChangeOp) (mode Del
 	return d.DeleteMode, nil
 }
 
-func (d DefaultHandler) UpgradeKeyModifier(ch ChangeOp, key *pb.KeyModify, mod query.Modifier) query.Modifier {
+func (d DefaultHandler) UpgradeKeyModifier(ctx context.Context, ch ChangeOp, key *pb.KeyModify, mod query.Modifier) query.Modifier {
 	return mod
 }
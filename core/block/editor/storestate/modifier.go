@@ -1,6 +1,7 @@
 package storestate
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -13,7 +14,7 @@ import (
 
 const ordersKey = "_o"
 
-func makeModifier(ch ChangeOp, h Handler) (modifier query.Modifier, err error) {
+func makeModifier(ctx context.Context, ch ChangeOp, h Handler) (modifier query.Modifier, err error) {
 	m := ch.Change.Change.GetModify()
 	chain := make(query.ModifierChain, 0, len(m.Keys))
 	newModifier := func(mKey *pb.KeyModify, modOp string, val *anyenc.Value) (query.Modifier, error) {
@@ -36,7 +37,7 @@ func makeModifier(ch ChangeOp, h Handler) (modifier query.Modifier, err error) {
 			return
 		})
 		ch.Value = val
-		return h.UpgradeKeyModifier(ch, mKey, mod), nil
+		return h.UpgradeKeyModifier(ctx, ch, mKey, mod), nil
 	}
 
 	for _, mKey := range m.Keys {
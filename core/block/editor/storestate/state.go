@@ -226,7 +226,7 @@ func (ss *StoreState) applyModify(ctx context.Context, ch Change) (err error) {
 		return
 	}
 
-	mod, err := makeModifier(ss.changeOp(ch, nil), handler)
+	mod, err := makeModifier(ctx, ss.changeOp(ch, nil), handler)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,112 @@
+package export
+
+import (
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/converter/ics"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const locationRelationName = "location"
+
+// addCalendarItems replaces requested sets and collections with their items. Relations of events are taken from
+// the first calendar or timeline view of them, the due date is used otherwise
+func (e *exportContext) addCalendarItems() error {
+	var sources []*dataviewSource
+	for _, id := range e.reqIds {
+		doc, ok := e.docs[id]
+		if !ok || !isObjectWithDataview(doc.Details) {
+			continue
+		}
+		source, err := e.readDataviewSource(id)
+		if err != nil {
+			return err
+		}
+		sources = append(sources, source)
+	}
+	var (
+		itemIds, setOf []string
+		settings       ics.Settings
+	)
+	for _, source := range sources {
+		itemIds = append(itemIds, source.itemIds...)
+		setOf = append(setOf, source.setOf...)
+		if view := calendarView(source); settings.StartKey == "" && view != nil {
+			var err error
+			if settings, err = e.calendarSettings(source.name, view); err != nil {
+				return err
+			}
+		}
+	}
+	if settings.CalendarName == "" && len(sources) == 1 {
+		settings.CalendarName = sources[0].name
+	}
+	settings.TimeZone = e.objectStore.SpaceIndex(e.spaceId).AccountLocation()
+	e.icsSettings = settings
+
+	items, err := e.queryAndFilterObjectsByRelation(e.spaceId, lo.Uniq(itemIds), bundle.RelationKeyId)
+	if err != nil {
+		return err
+	}
+	setItems, err := e.queryAndFilterObjectsByRelation(e.spaceId, lo.Uniq(setOf), bundle.RelationKeyType)
+	if err != nil {
+		return err
+	}
+	for _, item := range append(items, setItems...) {
+		id := item.Details.GetString(bundle.RelationKeyId)
+		e.docs[id] = &Doc{Details: item.Details}
+	}
+	// tags become categories of events
+	return e.addRelationOptions(bundle.RelationKeyTag.String())
+}
+
+// calendarView returns the first view, which shows items by dates
+func calendarView(source *dataviewSource) *model.BlockContentDataviewView {
+	for _, view := range source.views {
+		if startKey, _ := viewDateKeys(view); startKey != "" {
+			return view
+		}
+	}
+	return nil
+}
+
+// viewDateKeys returns relations with the start and the end of events shown in the view
+func viewDateKeys(view *model.BlockContentDataviewView) (startKey, endKey string) {
+	switch view.Type {
+	case model.BlockContentDataviewView_Calendar:
+		return view.GroupRelationKey, ""
+	case model.BlockContentDataviewView_Timeline:
+		return view.StartRelationKey, view.EndRelationKey
+	}
+	return "", ""
+}
+
+func (e *exportContext) calendarSettings(name string, view *model.BlockContentDataviewView) (ics.Settings, error) {
+	startKey, endKey := viewDateKeys(view)
+	settings := ics.Settings{
+		CalendarName: name,
+		StartKey:     domain.RelationKey(startKey),
+		EndKey:       domain.RelationKey(endKey),
+	}
+	relationKeys := lo.Map(view.Relations, func(relation *model.BlockContentDataviewRelation, _ int) string { return relation.Key })
+	relations, err := e.getRelationsFromStore(lo.Uniq(append(relationKeys, startKey)))
+	if err != nil {
+		return settings, err
+	}
+	for _, relation := range relations {
+		details := relation.Details
+		key := domain.RelationKey(details.GetString(bundle.RelationKeyRelationKey))
+		if key == settings.StartKey {
+			settings.IncludeTime = details.GetBool(bundle.RelationKeyRelationFormatIncludeTime)
+		}
+		if strings.EqualFold(details.GetString(bundle.RelationKeyName), locationRelationName) &&
+			details.GetInt64(bundle.RelationKeyRelationFormat) != int64(model.RelationFormat_object) {
+			settings.LocationKey = key
+		}
+	}
+	return settings, nil
+}
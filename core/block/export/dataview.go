@@ -0,0 +1,48 @@
+package export
+
+import (
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	sb "github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// dataviewSource is the set or collection, which items are exported instead of the object itself
+type dataviewSource struct {
+	name         string
+	isCollection bool
+	itemIds      []string
+	setOf        []string
+	views        []*model.BlockContentDataviewView
+}
+
+func (e *exportContext) readDataviewSource(id string) (*dataviewSource, error) {
+	source := &dataviewSource{}
+	err := cache.Do(e.picker, id, func(b sb.SmartBlock) error {
+		st := b.NewState()
+		details := st.CombinedDetails()
+		source.name = details.GetString(bundle.RelationKeyName)
+		source.isCollection = details.GetInt64(bundle.RelationKeyResolvedLayout) == int64(model.ObjectType_collection)
+		source.itemIds = st.GetStoreSlice(template.CollectionStoreKey)
+		source.setOf = details.GetStringList(bundle.RelationKeySetOf)
+		return st.Iterate(func(b simple.Block) (isContinue bool) {
+			if dataview := b.Model().GetDataview(); dataview != nil {
+				source.views = append(source.views, dataview.Views...)
+			}
+			return true
+		})
+	})
+	return source, err
+}
+
+// view returns the view by id or the first view, when id is empty
+func (s *dataviewSource) view(id string) *model.BlockContentDataviewView {
+	for _, view := range s.views {
+		if id == "" || view.Id == id {
+			return view
+		}
+	}
+	return nil
+}
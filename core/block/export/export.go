@@ -33,6 +33,8 @@ import (
 	"github.com/anyproto/anytype-heart/core/converter"
 	"github.com/anyproto/anytype-heart/core/converter/dot"
 	"github.com/anyproto/anytype-heart/core/converter/graphjson"
+	"github.com/anyproto/anytype-heart/core/converter/html"
+	"github.com/anyproto/anytype-heart/core/converter/ics"
 	"github.com/anyproto/anytype-heart/core/converter/md"
 	"github.com/anyproto/anytype-heart/core/converter/pbc"
 	"github.com/anyproto/anytype-heart/core/converter/pbjson"
@@ -196,6 +198,9 @@ type exportContext struct {
 	setOfList                    map[string]struct{}
 	objectTypes                  map[string]struct{}
 	gatewayUrl                   string
+	htmlIndex                    *htmlIndex
+	icsSettings                  ics.Settings
+	viewId                       string
 	*export
 }
 
@@ -215,6 +220,7 @@ func newExportContext(e *export, req pb.RpcObjectListExportRequest) *exportConte
 		includeBackLinks:             req.IncludeBacklinks,
 		includeSpace:                 req.IncludeSpace,
 		mdIncludePropertiesAndSchema: req.MdIncludePropertiesAndSchema,
+		viewId:                       req.ViewId,
 		setOfList:                    make(map[string]struct{}),
 		objectTypes:                  make(map[string]struct{}),
 		relations:                    make(map[string]struct{}),
@@ -244,6 +250,9 @@ func (e *exportContext) copy() *exportContext {
 		setOfList:        e.setOfList,
 		objectTypes:      e.objectTypes,
 		includeSpace:     e.includeSpace,
+		htmlIndex:        e.htmlIndex,
+		icsSettings:      e.icsSettings,
+		viewId:           e.viewId,
 	}
 }
 
@@ -362,7 +371,14 @@ func (e *exportContext) exportByFormat(ctx context.Context, wr writer, queue pro
 		succeed = e.exportDotAndSVG(ctx, succeed, wr, queue)
 	} else if e.format == model.Export_GRAPH_JSON {
 		succeed = e.exportGraphJson(ctx, succeed, wr, queue)
+	} else if e.format == model.Export_ICS {
+		succeed = e.exportIcs(ctx, succeed, wr, queue)
+	} else if isTableExport(e.format) {
+		succeed = e.exportTables(wr)
 	} else {
+		if e.format == model.Export_HTML {
+			e.htmlIndex = newHTMLIndex(wr)
+		}
 		tasks := make([]process.Task, 0, len(e.docs))
 		var succeedAsync int64
 		tasks = e.exportDocs(ctx, wr, &succeedAsync, tasks)
@@ -374,7 +390,7 @@ func (e *exportContext) exportByFormat(ctx context.Context, wr writer, queue pro
 		succeed += int(succeedAsync)
 
 		if err := e.postProcess(ctx, wr); err != nil {
-			log.Warnf("failed to generate all schemas: %v", err)
+			log.Warnf("failed to post process export: %v", err)
 		}
 	}
 	return succeed, nil
@@ -410,6 +426,16 @@ func (e *exportContext) exportGraphJson(ctx context.Context, succeed int, wr wri
 	return succeed
 }
 
+func (e *exportContext) exportIcs(ctx context.Context, succeed int, wr writer, queue process.Queue) int {
+	mc := ics.NewMultiConverter(e.icsSettings)
+	mc.SetKnownDocs(e.docs.transformToDetailsMap())
+	var werr error
+	if succeed, werr = e.writeMultiDoc(ctx, mc, wr, queue); werr != nil {
+		log.Warnf("can't export docs: %v", werr)
+	}
+	return succeed
+}
+
 func (e *exportContext) exportDotAndSVG(ctx context.Context, succeed int, wr writer, queue process.Queue) int {
 	var format = dot.ExportFormatDOT
 	if e.format == model.Export_SVG {
@@ -465,6 +491,13 @@ func (e *exportContext) getObjectsByIDs(ctx context.Context, isProtobuf bool) er
 			return err
 		}
 	}
+	if e.format == model.Export_ICS {
+		return e.addCalendarItems()
+	}
+	if isTableExport(e.format) {
+		// items of sets and collections are read from the store, when tables are written
+		return nil
+	}
 	if isProtobuf {
 		return e.processProtobuf()
 	}
@@ -1156,8 +1189,8 @@ func (e *exportContext) writeDoc(ctx context.Context, wr writer, docId string, d
 				return fmt.Errorf("save file: %w", err)
 			}
 			st.SetDetailAndBundledRelation(bundle.RelationKeySource, domain.String(fileName))
-			// Don't save file objects in markdown
-			if e.format == model.Export_Markdown {
+			// Don't save file objects in markdown and html
+			if e.format == model.Export_Markdown || e.format == model.Export_HTML {
 				return nil
 			}
 		}
@@ -1177,6 +1210,8 @@ func (e *exportContext) writeDoc(ctx context.Context, wr writer, docId string, d
 			conv = pbc.NewConverter(st, e.isJson)
 		case model.Export_JSON:
 			conv = pbjson.NewConverter(st)
+		case model.Export_HTML:
+			conv = html.NewExportConverter(st, wr.Namer(), makeMarkdownName(st, wr, docId, html.Ext, e.spaceId))
 		}
 		conv.SetKnownDocs(details)
 		result := conv.Convert(b.Type().ToProto())
@@ -1184,7 +1219,7 @@ func (e *exportContext) writeDoc(ctx context.Context, wr writer, docId string, d
 			return nil
 		}
 		var filename string
-		if e.format == model.Export_Markdown {
+		if e.format == model.Export_Markdown || e.format == model.Export_HTML {
 			filename = makeMarkdownName(st, wr, docId, conv.Ext(), e.spaceId)
 		} else if docId == b.Space().DerivedIDs().Home {
 			filename = "index" + conv.Ext()
@@ -1195,6 +1230,9 @@ func (e *exportContext) writeDoc(ctx context.Context, wr writer, docId string, d
 		if err = wr.WriteFile(filename, bytes.NewReader(result), lastModifiedDate); err != nil {
 			return err
 		}
+		if e.htmlIndex != nil {
+			e.htmlIndex.add(html.PageTitle(st.Details(), st.Snippet()), filename)
+		}
 
 		return nil
 	})
@@ -1434,8 +1472,11 @@ func pbFiltersToState(filters *pb.RpcObjectListExportStateFilters) *state.Filter
 	}
 }
 
-// generateAllSchemas generates JSON schemas for all object types found in the export
+// postProcess writes files built from all exported objects: JSON schemas for markdown and the index page for html
 func (e *exportContext) postProcess(ctx context.Context, wr writer) error {
+	if e.format == model.Export_HTML {
+		return e.htmlIndex.write(wr)
+	}
 	if e.format != model.Export_Markdown || !e.mdIncludePropertiesAndSchema {
 		// for now only needed for MD
 		return nil
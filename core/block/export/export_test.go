@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/anyproto/any-sync/app"
 	"github.com/gogo/protobuf/jsonpb"
@@ -38,6 +39,7 @@ import (
 	"github.com/anyproto/anytype-heart/space/mock_space"
 	"github.com/anyproto/anytype-heart/space/spacecore/typeprovider/mock_typeprovider"
 	"github.com/anyproto/anytype-heart/tests/testutil"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
 func TestFileNamer_Get(t *testing.T) {
@@ -3168,3 +3170,379 @@ func TestExport_ExportCollectionWithNonExistingObjects(t *testing.T) {
 		os.Remove(path)
 	})
 }
+
+func TestExport_ExportHTML(t *testing.T) {
+	// given
+	storeFixture := objectstore.NewStoreFixture(t)
+	storeFixture.AddObjects(t, spaceId, []spaceindex.TestObject{
+		{
+			bundle.RelationKeyId:      domain.String("object1"),
+			bundle.RelationKeyName:    domain.String("First"),
+			bundle.RelationKeySpaceId: domain.String(spaceId),
+		},
+		{
+			bundle.RelationKeyId:      domain.String("object2"),
+			bundle.RelationKeyName:    domain.String("Second"),
+			bundle.RelationKeySpaceId: domain.String(spaceId),
+		},
+	})
+
+	object1 := smarttest.New("object1")
+	object1.Doc.(*state.State).SetDetail(bundle.RelationKeyName, domain.String("First"))
+	object1.AddBlock(simple.New(&model.Block{Id: "object1", ChildrenIds: []string{"link"}}))
+	object1.AddBlock(simple.New(&model.Block{Id: "link", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "object2"}}}))
+	object2 := smarttest.New("object2")
+	object2.Doc.(*state.State).SetDetail(bundle.RelationKeyName, domain.String("Second"))
+	object2.AddBlock(simple.New(&model.Block{Id: "object2"}))
+
+	objectGetter := mock_cache.NewMockObjectGetter(t)
+	objectGetter.EXPECT().GetObject(mock.Anything, "object1").Return(object1, nil)
+	objectGetter.EXPECT().GetObject(mock.Anything, "object2").Return(object2, nil)
+
+	a := &app.App{}
+	mockSender := mock_event.NewMockSender(t)
+	mockSender.EXPECT().Broadcast(mock.Anything).Return()
+	a.Register(testutil.PrepareMock(context.Background(), a, mockSender))
+	service := process.New()
+	err := service.Init(a)
+	require.NoError(t, err)
+
+	notifications := mock_notifications.NewMockNotifications(t)
+	notificationSend := make(chan struct{})
+	notifications.EXPECT().CreateAndSend(mock.Anything).RunAndReturn(func(notification *model.Notification) error {
+		close(notificationSend)
+		return nil
+	})
+
+	e := &export{
+		objectStore:         storeFixture,
+		picker:              objectGetter,
+		processService:      service,
+		notificationService: notifications,
+	}
+
+	// when
+	path, succeed, err := e.Export(context.Background(), pb.RpcObjectListExportRequest{
+		SpaceId:   spaceId,
+		Path:      t.TempDir(),
+		ObjectIds: []string{"object1", "object2"},
+		Format:    model.Export_HTML,
+		Zip:       true,
+	})
+
+	// then
+	<-notificationSend
+	require.NoError(t, err)
+	assert.Equal(t, 2, succeed)
+
+	reader, err := zip.OpenReader(path)
+	require.NoError(t, err)
+	defer reader.Close()
+	files := make(map[string]string, len(reader.File))
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[file.Name] = string(data)
+	}
+	require.Len(t, files, 3)
+	assert.Contains(t, files["first.html"], `<a href="second.html">Second</a>`)
+	assert.Contains(t, files["second.html"], `<title>Second</title>`)
+	assert.Contains(t, files["index.html"], `<li><a href="first.html">First</a></li><li><a href="second.html">Second</a></li>`)
+}
+
+func TestExport_ExportICS(t *testing.T) {
+	// given
+	start := time.Date(2024, 3, 11, 9, 30, 0, 0, time.UTC)
+	storeFixture := objectstore.NewStoreFixture(t)
+	storeFixture.AddObjects(t, spaceId, []spaceindex.TestObject{
+		{
+			bundle.RelationKeyId:             domain.String("collection"),
+			bundle.RelationKeyName:           domain.String("Meetings"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(int64(model.ObjectType_collection)),
+			bundle.RelationKeySpaceId:        domain.String(spaceId),
+		},
+		{
+			bundle.RelationKeyId:      domain.String("object1"),
+			bundle.RelationKeyName:    domain.String("Standup"),
+			bundle.RelationKeySpaceId: domain.String(spaceId),
+			bundle.RelationKeyTag:     domain.StringList([]string{"option1"}),
+		},
+		{
+			bundle.RelationKeyId:      domain.String("object2"),
+			bundle.RelationKeyName:    domain.String("Note"),
+			bundle.RelationKeySpaceId: domain.String(spaceId),
+		},
+		{
+			bundle.RelationKeyId:                        domain.String("startRelation"),
+			bundle.RelationKeyUniqueKey:                 domain.String("rel-start"),
+			bundle.RelationKeyRelationKey:               domain.String("start"),
+			bundle.RelationKeyRelationFormat:            domain.Int64(int64(model.RelationFormat_date)),
+			bundle.RelationKeyRelationFormatIncludeTime: domain.Bool(true),
+			bundle.RelationKeyResolvedLayout:            domain.Int64(int64(model.ObjectType_relation)),
+			bundle.RelationKeySpaceId:                   domain.String(spaceId),
+		},
+		{
+			bundle.RelationKeyId:             domain.String("locationRelation"),
+			bundle.RelationKeyUniqueKey:      domain.String("rel-place"),
+			bundle.RelationKeyRelationKey:    domain.String("place"),
+			bundle.RelationKeyName:           domain.String("Location"),
+			bundle.RelationKeyRelationFormat: domain.Int64(int64(model.RelationFormat_shorttext)),
+			bundle.RelationKeyResolvedLayout: domain.Int64(int64(model.ObjectType_relation)),
+			bundle.RelationKeySpaceId:        domain.String(spaceId),
+		},
+		{
+			bundle.RelationKeyId:             domain.String("option1"),
+			bundle.RelationKeyName:           domain.String("Work"),
+			bundle.RelationKeyRelationKey:    domain.String(bundle.RelationKeyTag),
+			bundle.RelationKeyResolvedLayout: domain.Int64(int64(model.ObjectType_relationOption)),
+			bundle.RelationKeySpaceId:        domain.String(spaceId),
+		},
+	})
+
+	collection := smarttest.New("collection")
+	collectionState := collection.Doc.(*state.State)
+	collectionState.SetDetail(bundle.RelationKeyName, domain.String("Meetings"))
+	collectionState.SetDetail(bundle.RelationKeyResolvedLayout, domain.Int64(int64(model.ObjectType_collection)))
+	collectionState.UpdateStoreSlice(template.CollectionStoreKey, []string{"object1", "object2"})
+	collection.AddBlock(simple.New(&model.Block{Id: "collection", ChildrenIds: []string{"dataview"}}))
+	collection.AddBlock(simple.New(&model.Block{Id: "dataview", Content: &model.BlockContentOfDataview{Dataview: &model.BlockContentDataview{
+		Views: []*model.BlockContentDataviewView{
+			{Id: "table", Type: model.BlockContentDataviewView_Table},
+			{
+				Id:               "timeline",
+				Type:             model.BlockContentDataviewView_Timeline,
+				StartRelationKey: "start",
+				EndRelationKey:   "end",
+				Relations:        []*model.BlockContentDataviewRelation{{Key: "place", IsVisible: true}},
+			},
+		},
+	}}}))
+	object1 := smarttest.New("object1")
+	object1State := object1.Doc.(*state.State)
+	object1State.SetDetail(bundle.RelationKeyName, domain.String("Standup"))
+	object1State.SetDetail("start", domain.Int64(start.Unix()))
+	object1State.SetDetail("end", domain.Int64(start.Add(15*time.Minute).Unix()))
+	object1State.SetDetail("place", domain.String("Room 1"))
+	object1State.SetDetail(bundle.RelationKeyTag, domain.StringList([]string{"option1"}))
+	object1.AddBlock(simple.New(&model.Block{Id: "object1"}))
+	object2 := smarttest.New("object2")
+	object2.Doc.(*state.State).SetDetail(bundle.RelationKeyName, domain.String("Note"))
+	object2.AddBlock(simple.New(&model.Block{Id: "object2"}))
+	option := smarttest.New("option1")
+	option.AddBlock(simple.New(&model.Block{Id: "option1"}))
+
+	objectGetter := mock_cache.NewMockObjectGetter(t)
+	objectGetter.EXPECT().GetObject(mock.Anything, "collection").Return(collection, nil)
+	objectGetter.EXPECT().GetObject(mock.Anything, "object1").Return(object1, nil)
+	objectGetter.EXPECT().GetObject(mock.Anything, "object2").Return(object2, nil)
+	objectGetter.EXPECT().GetObject(mock.Anything, "option1").Return(option, nil)
+
+	a := &app.App{}
+	mockSender := mock_event.NewMockSender(t)
+	mockSender.EXPECT().Broadcast(mock.Anything).Return()
+	a.Register(testutil.PrepareMock(context.Background(), a, mockSender))
+	service := process.New()
+	err := service.Init(a)
+	require.NoError(t, err)
+
+	notifications := mock_notifications.NewMockNotifications(t)
+	notificationSend := make(chan struct{})
+	notifications.EXPECT().CreateAndSend(mock.Anything).RunAndReturn(func(notification *model.Notification) error {
+		close(notificationSend)
+		return nil
+	})
+
+	e := &export{
+		objectStore:         storeFixture,
+		picker:              objectGetter,
+		processService:      service,
+		notificationService: notifications,
+	}
+
+	// when
+	path, _, err := e.Export(context.Background(), pb.RpcObjectListExportRequest{
+		SpaceId:   spaceId,
+		Path:      t.TempDir(),
+		ObjectIds: []string{"collection"},
+		Format:    model.Export_ICS,
+		Zip:       true,
+	})
+
+	// then
+	<-notificationSend
+	require.NoError(t, err)
+
+	reader, err := zip.OpenReader(path)
+	require.NoError(t, err)
+	defer reader.Close()
+	require.Len(t, reader.File, 1)
+	assert.Equal(t, "export.ics", reader.File[0].Name)
+	rc, err := reader.File[0].Open()
+	require.NoError(t, err)
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	rc.Close()
+	calendar := string(data)
+	assert.Contains(t, calendar, "X-WR-CALNAME:Meetings\r\n")
+	assert.Equal(t, 1, strings.Count(calendar, "BEGIN:VEVENT"))
+	assert.Contains(t, calendar, "SUMMARY:Standup\r\n")
+	assert.Contains(t, calendar, "DTSTART:20240311T093000Z\r\n")
+	assert.Contains(t, calendar, "DTEND:20240311T094500Z\r\n")
+	assert.Contains(t, calendar, "LOCATION:Room 1\r\n")
+	assert.Contains(t, calendar, "CATEGORIES:Work\r\n")
+}
+
+func TestExport_ExportCSV(t *testing.T) {
+	// given
+	due := time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)
+	storeFixture := objectstore.NewStoreFixture(t)
+	storeFixture.SetLocation(time.UTC)
+	storeFixture.AddObjects(t, spaceId, []spaceindex.TestObject{
+		{
+			bundle.RelationKeyId:             domain.String("set"),
+			bundle.RelationKeyName:           domain.String("Tasks"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(int64(model.ObjectType_set)),
+			bundle.RelationKeySetOf:          domain.StringList([]string{"taskType"}),
+			bundle.RelationKeySpaceId:        domain.String(spaceId),
+		},
+		{
+			bundle.RelationKeyId:      domain.String("task1"),
+			bundle.RelationKeyName:    domain.String("Report"),
+			bundle.RelationKeyType:    domain.String("taskType"),
+			bundle.RelationKeyTag:     domain.StringList([]string{"option1", "option2"}),
+			bundle.RelationKeySpaceId: domain.String(spaceId),
+			"estimate":                domain.Float64(2.5),
+			"due":                     domain.Int64(due.Unix()),
+			"secret":                  domain.String("hidden"),
+		},
+		{
+			bundle.RelationKeyId:      domain.String("task2"),
+			bundle.RelationKeyName:    domain.String("Plan, next steps"),
+			bundle.RelationKeyType:    domain.String("taskType"),
+			bundle.RelationKeySpaceId: domain.String(spaceId),
+			"estimate":                domain.Int64(5),
+		},
+		{
+			bundle.RelationKeyId:      domain.String("task3"),
+			bundle.RelationKeyName:    domain.String("Small"),
+			bundle.RelationKeyType:    domain.String("taskType"),
+			bundle.RelationKeySpaceId: domain.String(spaceId),
+			"estimate":                domain.Int64(1),
+		},
+		{
+			bundle.RelationKeyId:             domain.String("estimateRelation"),
+			bundle.RelationKeyUniqueKey:      domain.String("rel-estimate"),
+			bundle.RelationKeyRelationKey:    domain.String("estimate"),
+			bundle.RelationKeyName:           domain.String("Estimate"),
+			bundle.RelationKeyRelationFormat: domain.Int64(int64(model.RelationFormat_number)),
+			bundle.RelationKeyResolvedLayout: domain.Int64(int64(model.ObjectType_relation)),
+			bundle.RelationKeySpaceId:        domain.String(spaceId),
+		},
+		{
+			bundle.RelationKeyId:             domain.String("dueRelation"),
+			bundle.RelationKeyUniqueKey:      domain.String("rel-due"),
+			bundle.RelationKeyRelationKey:    domain.String("due"),
+			bundle.RelationKeyName:           domain.String("Due"),
+			bundle.RelationKeyRelationFormat: domain.Int64(int64(model.RelationFormat_date)),
+			bundle.RelationKeyResolvedLayout: domain.Int64(int64(model.ObjectType_relation)),
+			bundle.RelationKeySpaceId:        domain.String(spaceId),
+		},
+		{
+			bundle.RelationKeyId:             domain.String("option1"),
+			bundle.RelationKeyName:           domain.String("work"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(int64(model.ObjectType_relationOption)),
+			bundle.RelationKeySpaceId:        domain.String(spaceId),
+		},
+		{
+			bundle.RelationKeyId:             domain.String("option2"),
+			bundle.RelationKeyName:           domain.String("urgent"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(int64(model.ObjectType_relationOption)),
+			bundle.RelationKeySpaceId:        domain.String(spaceId),
+		},
+	})
+
+	set := smarttest.New("set")
+	setState := set.Doc.(*state.State)
+	setState.SetDetail(bundle.RelationKeyName, domain.String("Tasks"))
+	setState.SetDetail(bundle.RelationKeySetOf, domain.StringList([]string{"taskType"}))
+	set.AddBlock(simple.New(&model.Block{Id: "set", ChildrenIds: []string{"dataview"}}))
+	set.AddBlock(simple.New(&model.Block{Id: "dataview", Content: &model.BlockContentOfDataview{Dataview: &model.BlockContentDataview{
+		Views: []*model.BlockContentDataviewView{
+			{Id: "all", Type: model.BlockContentDataviewView_Table},
+			{
+				Id:   "big",
+				Type: model.BlockContentDataviewView_Table,
+				Relations: []*model.BlockContentDataviewRelation{
+					{Key: "estimate", IsVisible: true},
+					{Key: bundle.RelationKeyName.String(), IsVisible: true},
+					{Key: "secret", IsVisible: false},
+					{Key: bundle.RelationKeyTag.String(), IsVisible: true},
+					{Key: "due", IsVisible: true},
+				},
+				Filters: []*model.BlockContentDataviewFilter{
+					{RelationKey: "estimate", Condition: model.BlockContentDataviewFilter_Greater, Value: pbtypes.Int64(2)},
+				},
+				Sorts: []*model.BlockContentDataviewSort{
+					{RelationKey: "estimate", Type: model.BlockContentDataviewSort_Desc},
+				},
+			},
+		},
+	}}}))
+
+	objectGetter := mock_cache.NewMockObjectGetter(t)
+	objectGetter.EXPECT().GetObject(mock.Anything, "set").Return(set, nil)
+
+	a := &app.App{}
+	mockSender := mock_event.NewMockSender(t)
+	mockSender.EXPECT().Broadcast(mock.Anything).Return()
+	a.Register(testutil.PrepareMock(context.Background(), a, mockSender))
+	service := process.New()
+	err := service.Init(a)
+	require.NoError(t, err)
+
+	notifications := mock_notifications.NewMockNotifications(t)
+	notificationSend := make(chan struct{})
+	notifications.EXPECT().CreateAndSend(mock.Anything).RunAndReturn(func(notification *model.Notification) error {
+		close(notificationSend)
+		return nil
+	})
+
+	e := &export{
+		objectStore:         storeFixture,
+		picker:              objectGetter,
+		processService:      service,
+		notificationService: notifications,
+	}
+
+	// when
+	path, succeed, err := e.Export(context.Background(), pb.RpcObjectListExportRequest{
+		SpaceId:   spaceId,
+		Path:      t.TempDir(),
+		ObjectIds: []string{"set"},
+		Format:    model.Export_CSV,
+		ViewId:    "big",
+		Zip:       true,
+	})
+
+	// then
+	<-notificationSend
+	require.NoError(t, err)
+	assert.Equal(t, 1, succeed)
+
+	reader, err := zip.OpenReader(path)
+	require.NoError(t, err)
+	defer reader.Close()
+	require.Len(t, reader.File, 1)
+	assert.Equal(t, "tasks.csv", reader.File[0].Name)
+	rc, err := reader.File[0].Open()
+	require.NoError(t, err)
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	rc.Close()
+	assert.Equal(t, "Name,Estimate,Tag,Due\n"+
+		"\"Plan, next steps\",5,,\n"+
+		"Report,2.5,\"work, urgent\",2024-03-11\n", string(data))
+}
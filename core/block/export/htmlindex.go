@@ -0,0 +1,58 @@
+package export
+
+import (
+	"bytes"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/anyproto/anytype-heart/core/converter/html"
+)
+
+const (
+	htmlIndexId    = "_index"
+	htmlIndexTitle = "Anytype export"
+)
+
+// htmlIndex collects exported pages to list them in the index page
+type htmlIndex struct {
+	fileName string
+	entries  []html.IndexEntry
+	mu       sync.Mutex
+}
+
+func newHTMLIndex(wr writer) *htmlIndex {
+	return &htmlIndex{
+		// reserve the name, so none of the objects will take it
+		fileName: wr.Namer().Get("", htmlIndexId, "index", html.Ext),
+	}
+}
+
+func (i *htmlIndex) add(title, fileName string) {
+	if title == "" {
+		title = defaultFileName
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.entries = append(i.entries, html.IndexEntry{Title: title, Path: fileName})
+}
+
+func (i *htmlIndex) write(wr writer) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	sort.Slice(i.entries, func(a, b int) bool {
+		if i.entries[a].Title == i.entries[b].Title {
+			return i.entries[a].Path < i.entries[b].Path
+		}
+		return i.entries[a].Title < i.entries[b].Title
+	})
+	entries := make([]html.IndexEntry, 0, len(i.entries))
+	for _, entry := range i.entries {
+		path, err := filepath.Rel(filepath.Dir(i.fileName), entry.Path)
+		if err != nil {
+			path = entry.Path
+		}
+		entries = append(entries, html.IndexEntry{Title: entry.Title, Path: path})
+	}
+	return wr.WriteFile(i.fileName, bytes.NewReader(html.RenderIndex(htmlIndexTitle, entries)), 0)
+}
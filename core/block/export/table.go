@@ -0,0 +1,248 @@
+package export
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/converter/table"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	tableDateLayout     = "2006-01-02"
+	tableDateTimeLayout = "2006-01-02 15:04"
+	tableValuesSep      = ", "
+)
+
+var errViewNotFound = errors.New("view is not found")
+
+func isTableExport(format model.ExportFormat) bool {
+	return format == model.Export_CSV || format == model.Export_XLSX
+}
+
+// column is the visible relation of the view
+type column struct {
+	key         domain.RelationKey
+	name        string
+	format      model.RelationFormat
+	includeTime bool
+}
+
+// exportTables writes the view of each requested set and collection to its own file
+func (e *exportContext) exportTables(wr writer) int {
+	var succeed int
+	for _, id := range e.reqIds {
+		doc, ok := e.docs[id]
+		if !ok || !isObjectWithDataview(doc.Details) {
+			continue
+		}
+		if err := e.exportTable(wr, id); err != nil {
+			log.With("objectID", id).Warnf("can't export table: %v", err)
+			continue
+		}
+		succeed++
+	}
+	return succeed
+}
+
+func (e *exportContext) exportTable(wr writer, id string) error {
+	source, err := e.readDataviewSource(id)
+	if err != nil {
+		return err
+	}
+	view := source.view(e.viewId)
+	if view == nil {
+		return fmt.Errorf("%w: %s", errViewNotFound, e.viewId)
+	}
+	records, err := e.queryViewItems(source, view)
+	if err != nil {
+		return fmt.Errorf("query items: %w", err)
+	}
+	columns, err := e.viewColumns(view)
+	if err != nil {
+		return fmt.Errorf("get columns: %w", err)
+	}
+	t, err := e.buildTable(source.name, columns, records)
+	if err != nil {
+		return err
+	}
+	var (
+		buf   bytes.Buffer
+		ext   = table.CSVExt
+		write = table.WriteCSV
+	)
+	if e.format == model.Export_XLSX {
+		ext, write = table.XLSXExt, table.WriteXLSX
+	}
+	if err = write(&buf, t); err != nil {
+		return err
+	}
+	return wr.WriteFile(wr.Namer().Get("", id, source.name, ext), &buf, 0)
+}
+
+// queryViewItems returns items of the set or collection, which match filters of the view, in the order of the view.
+// Items of collections keep their order in the collection, when the view has no sorts
+func (e *exportContext) queryViewItems(source *dataviewSource, view *model.BlockContentDataviewView) ([]database.Record, error) {
+	sourceFilter := database.FilterRequest{
+		RelationKey: bundle.RelationKeyType,
+		Condition:   model.BlockContentDataviewFilter_In,
+		Value:       domain.StringList(source.setOf),
+	}
+	if source.isCollection {
+		sourceFilter.RelationKey = bundle.RelationKeyId
+		sourceFilter.Value = domain.StringList(source.itemIds)
+	}
+	if len(sourceFilter.Value.StringList()) == 0 {
+		return nil, nil
+	}
+	// the filter of the source goes first, so filters of the view are joined with it
+	records, err := e.objectStore.SpaceIndex(e.spaceId).Query(database.Query{
+		Filters: append([]database.FilterRequest{sourceFilter}, database.FiltersFromProto(view.Filters)...),
+		Sorts:   database.SortsFromProto(view.Sorts),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if source.isCollection && len(view.Sorts) == 0 {
+		slices.SortStableFunc(records, func(a, b database.Record) int {
+			return slices.Index(source.itemIds, a.Details.GetString(bundle.RelationKeyId)) -
+				slices.Index(source.itemIds, b.Details.GetString(bundle.RelationKeyId))
+		})
+	}
+	return records, nil
+}
+
+// viewColumns returns the name and visible relations of the view in their order. The name is always the first
+// column, because CSV import reads names of objects from it
+func (e *exportContext) viewColumns(view *model.BlockContentDataviewView) ([]column, error) {
+	keys := []string{bundle.RelationKeyName.String()}
+	for _, relation := range view.Relations {
+		if relation.IsVisible && relation.Key != bundle.RelationKeyName.String() {
+			keys = append(keys, relation.Key)
+		}
+	}
+	records, err := e.getRelationsFromStore(keys)
+	if err != nil {
+		return nil, err
+	}
+	relations := make(map[string]*domain.Details, len(records))
+	for _, record := range records {
+		relations[record.Details.GetString(bundle.RelationKeyRelationKey)] = record.Details
+	}
+	columns := make([]column, 0, len(keys))
+	for _, key := range keys {
+		if details, ok := relations[key]; ok {
+			columns = append(columns, column{
+				key:         domain.RelationKey(key),
+				name:        details.GetString(bundle.RelationKeyName),
+				format:      model.RelationFormat(details.GetInt64(bundle.RelationKeyRelationFormat)),
+				includeTime: details.GetBool(bundle.RelationKeyRelationFormatIncludeTime),
+			})
+			continue
+		}
+		// bundled relations may be not installed in the space
+		if relation, err := bundle.GetRelation(domain.RelationKey(key)); err == nil {
+			columns = append(columns, column{key: domain.RelationKey(key), name: relation.Name, format: relation.Format, includeTime: relation.IncludeTime})
+		}
+	}
+	return columns, nil
+}
+
+func (e *exportContext) buildTable(name string, columns []column, records []database.Record) (*table.Table, error) {
+	names, err := e.linkedObjectNames(columns, records)
+	if err != nil {
+		return nil, fmt.Errorf("get names of linked objects: %w", err)
+	}
+	loc := e.objectStore.SpaceIndex(e.spaceId).AccountLocation()
+	t := &table.Table{Name: name, Header: make([]string, 0, len(columns))}
+	for _, col := range columns {
+		t.Header = append(t.Header, col.name)
+	}
+	for _, record := range records {
+		row := make([]table.Cell, 0, len(columns))
+		for _, col := range columns {
+			row = append(row, cellValue(record.Details.Get(col.key), col, names, loc))
+		}
+		t.Rows = append(t.Rows, row)
+	}
+	return t, nil
+}
+
+func isLinkFormat(format model.RelationFormat) bool {
+	return format == model.RelationFormat_tag || format == model.RelationFormat_status ||
+		format == model.RelationFormat_object || format == model.RelationFormat_file
+}
+
+// linkedObjectNames returns names of options and objects, which are values of relations in the table
+func (e *exportContext) linkedObjectNames(columns []column, records []database.Record) (map[string]string, error) {
+	var ids []string
+	for _, col := range columns {
+		if !isLinkFormat(col.format) {
+			continue
+		}
+		for _, record := range records {
+			ids = append(ids, record.Details.GetStringList(col.key)...)
+		}
+	}
+	names := make(map[string]string, len(ids))
+	if len(ids) == 0 {
+		return names, nil
+	}
+	linked, err := e.objectStore.SpaceIndex(e.spaceId).QueryByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range linked {
+		names[record.Details.GetString(bundle.RelationKeyId)] = record.Details.GetString(bundle.RelationKeyName)
+	}
+	return names, nil
+}
+
+// cellValue renders the value as it is shown in the view: options and objects by their names, dates
+// in the time zone of the account
+func cellValue(value domain.Value, col column, names map[string]string, loc *time.Location) table.Cell {
+	if !value.Ok() || value.IsNull() {
+		return table.Text("")
+	}
+	switch {
+	case isLinkFormat(col.format):
+		var values []string
+		for _, id := range value.WrapToStringList() {
+			if name, ok := names[id]; ok && name != "" {
+				values = append(values, name)
+			}
+		}
+		return table.Text(strings.Join(values, tableValuesSep))
+	case col.format == model.RelationFormat_date:
+		unix, ok := value.TryInt64()
+		if !ok || unix == 0 {
+			return table.Text("")
+		}
+		layout := tableDateLayout
+		if col.includeTime {
+			layout = tableDateTimeLayout
+		}
+		return table.Text(time.Unix(unix, 0).In(loc).Format(layout))
+	case col.format == model.RelationFormat_checkbox:
+		return table.Text(strconv.FormatBool(value.Bool()))
+	case col.format == model.RelationFormat_number:
+		if number, ok := value.TryFloat64(); ok {
+			return table.Number(number)
+		}
+	}
+	if list, ok := value.TryStringList(); ok {
+		return table.Text(strings.Join(list, tableValuesSep))
+	}
+	if number, ok := value.TryFloat64(); ok {
+		return table.Text(strconv.FormatFloat(number, 'f', -1, 64))
+	}
+	return table.Text(value.String())
+}
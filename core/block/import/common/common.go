@@ -242,7 +242,7 @@ func handleTextBlock(oldIDtoNew map[string]string, block simple.Block, st *state
 			continue
 		}
 		if isBundledObjects(mark.Param) {
-			return
+			continue
 		}
 		newTarget := oldIDtoNew[mark.Param]
 		if newTarget == "" {
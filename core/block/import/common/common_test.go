@@ -387,4 +387,33 @@ func TestUpdateLinksToObjects(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, newTypeId, st.Get(dataviewBlockId).Model().GetDataview().GetViews()[0].GetDefaultObjectTypeId())
 	})
+	t.Run("mentions after mention of date object are updated", func(t *testing.T) {
+		// given
+		dateId := "_date_2024-01-15"
+		block := &model.Block{
+			Id: "test",
+			Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+				Text: "date and page",
+				Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+					{Range: &model.Range{From: 0, To: 4}, Type: model.BlockContentTextMark_Mention, Param: dateId},
+					{Range: &model.Range{From: 9, To: 13}, Type: model.BlockContentTextMark_Mention, Param: "page"},
+				}},
+			}},
+		}
+		rootBlock := &model.Block{
+			Id:          "root",
+			ChildrenIds: []string{"test"},
+			Content:     &model.BlockContentOfSmartblock{Smartblock: &model.BlockContentSmartblock{}},
+		}
+		st := state.NewDoc("root", map[string]simple.Block{"test": simple.New(block), "root": simple.New(rootBlock)}).(*state.State)
+
+		// when
+		err := UpdateLinksToObjects(st, map[string]string{"page": "newPage"})
+
+		// then
+		assert.Nil(t, err)
+		marks := st.Get("test").Model().GetText().GetMarks().GetMarks()
+		assert.Equal(t, dateId, marks[0].Param)
+		assert.Equal(t, "newPage", marks[1].Param)
+	})
 }
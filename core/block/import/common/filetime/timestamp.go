@@ -0,0 +1,24 @@
+package filetime
+
+import (
+	"strings"
+	"time"
+)
+
+// basicLayouts are ISO 8601 basic formats used by Evernote and iCalendar exports
+var basicLayouts = []string{
+	"20060102T150405Z",
+	"20060102T150405",
+}
+
+// ParseBasicTimestamp returns unix time of timestamps like 20240131T235959Z. Timestamps without zone are treated as UTC.
+// 0 is returned if the value can't be parsed, the same as ExtractFileTimes does for unknown times
+func ParseBasicTimestamp(value string) int64 {
+	value = strings.TrimSpace(value)
+	for _, layout := range basicLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Unix()
+		}
+	}
+	return 0
+}
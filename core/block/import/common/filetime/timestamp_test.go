@@ -0,0 +1,21 @@
+package filetime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBasicTimestamp(t *testing.T) {
+	for _, tc := range []struct {
+		value    string
+		expected int64
+	}{
+		{"20240131T235959Z", 1706745599},
+		{" 20240131T235959 ", 1706745599},
+		{"2024-01-31", 0},
+		{"", 0},
+	} {
+		assert.Equal(t, tc.expected, ParseBasicTimestamp(tc.value), tc.value)
+	}
+}
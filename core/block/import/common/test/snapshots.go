@@ -0,0 +1,70 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// NewImportRequest makes a request to import files from the given paths with the converter of the import type
+func NewImportRequest(importType model.ImportType, mode pb.RpcObjectImportRequestMode, paths ...string) *pb.RpcObjectImportRequest {
+	req := &pb.RpcObjectImportRequest{
+		Type: importType,
+		Mode: mode,
+	}
+	switch importType {
+	case model.Import_Enex:
+		req.Params = &pb.RpcObjectImportRequestParamsOfEnexParams{EnexParams: &pb.RpcObjectImportRequestEnexParams{Path: paths}}
+	case model.Import_Logseq:
+		req.Params = &pb.RpcObjectImportRequestParamsOfLogseqParams{LogseqParams: &pb.RpcObjectImportRequestLogseqParams{Path: paths}}
+	case model.Import_Roam:
+		req.Params = &pb.RpcObjectImportRequestParamsOfRoamParams{RoamParams: &pb.RpcObjectImportRequestRoamParams{Path: paths}}
+	case model.Import_Obsidian:
+		req.Params = &pb.RpcObjectImportRequestParamsOfObsidianParams{ObsidianParams: &pb.RpcObjectImportRequestObsidianParams{Path: paths}}
+	case model.Import_Ics:
+		req.Params = &pb.RpcObjectImportRequestParamsOfIcsParams{IcsParams: &pb.RpcObjectImportRequestIcsParams{Path: paths}}
+	}
+	return req
+}
+
+// FindSnapshot returns the snapshot with the given smartblock type and name, the test fails if there is no such snapshot
+func FindSnapshot(t *testing.T, snapshots []*common.Snapshot, sbType smartblock.SmartBlockType, name string) *common.Snapshot {
+	sn := LookupSnapshot(snapshots, sbType, name)
+	if sn == nil {
+		require.Failf(t, "snapshot is not found", "%s %s", sbType, name)
+	}
+	return sn
+}
+
+// LookupSnapshot returns the snapshot with the given smartblock type and name or nil
+func LookupSnapshot(snapshots []*common.Snapshot, sbType smartblock.SmartBlockType, name string) *common.Snapshot {
+	for _, sn := range snapshots {
+		if sn.Snapshot.SbType == sbType && sn.Snapshot.Data.Details.GetString(bundle.RelationKeyName) == name {
+			return sn
+		}
+	}
+	return nil
+}
+
+// FindBlock returns the text block with the given text, the test fails if there is no such block
+func FindBlock(t *testing.T, sn *common.Snapshot, text string) *model.Block {
+	for _, b := range sn.Snapshot.Data.Blocks {
+		if b.GetText() != nil && b.GetText().Text == text {
+			return b
+		}
+	}
+	require.Failf(t, "block is not found", text)
+	return nil
+}
+
+// RelationKey returns the key of the imported relation with the given name
+func RelationKey(t *testing.T, snapshots []*common.Snapshot, name string) domain.RelationKey {
+	return domain.RelationKey(FindSnapshot(t, snapshots, smartblock.SmartBlockTypeRelation, name).Snapshot.Data.Key)
+}
@@ -0,0 +1,357 @@
+package enex
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/filetime"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/constant"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const (
+	Name               = "Enex"
+	rootCollectionName = "Evernote Import"
+	enexExtension      = ".enex"
+)
+
+var (
+	log            = logging.Logger("import-enex")
+	errWrongFormat = errors.New("wrong enex format")
+)
+
+// Enex imports Evernote exports. Every .enex file contains notes of a single notebook,
+// so notebooks become collections of their notes
+type Enex struct {
+	collectionService *collection.Service
+	tempDirProvider   core.TempDirProvider
+}
+
+func New(collectionService *collection.Service, tempDirProvider core.TempDirProvider) common.Converter {
+	return &Enex{
+		collectionService: collectionService,
+		tempDirProvider:   tempDirProvider,
+	}
+}
+
+func (e *Enex) Name() string {
+	return Name
+}
+
+func (e *Enex) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetEnexParams(); p != nil {
+		return p.Path
+	}
+	return nil
+}
+
+// resourceFile is a resource of the note extracted to the temporary directory
+type resourceFile struct {
+	path    string
+	isImage bool
+}
+
+// enexImport keeps the state of a single import request
+type enexImport struct {
+	mode        pb.RpcObjectImportRequestMode
+	filesDir    string
+	snapshots   []*common.Snapshot
+	notebookIds []string
+	tagOptions  map[string]*common.Snapshot
+	tagNames    []string
+}
+
+func (e *Enex) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*common.Response, *common.ConvertError) {
+	paths := e.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from files")
+	allErrors := common.NewError(req.Mode)
+	imp := &enexImport{
+		mode:       req.Mode,
+		filesDir:   filepath.Join(e.tempDirProvider.TempDir(), "enex-"+uuid.New().String()),
+		tagOptions: map[string]*common.Snapshot{},
+	}
+	for _, path := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(common.ErrCancel)
+			return nil, allErrors
+		}
+		e.handleImportPath(imp, path, len(paths), allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	snapshots := imp.snapshots
+	for _, name := range imp.tagNames {
+		snapshots = append(snapshots, imp.tagOptions[name])
+	}
+
+	rootCollection := common.NewImportCollection(e.collectionService)
+	settings := common.NewImportCollectionSetting(
+		common.WithCollectionName(rootCollectionName),
+		common.WithTargetObjects(imp.notebookIds),
+		common.WithAddDate(),
+	)
+	rootCollectionSnapshot, err := rootCollection.MakeImportCollection(settings)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCollectionSnapshot != nil {
+		snapshots = append(snapshots, rootCollectionSnapshot)
+		rootCollectionID = rootCollectionSnapshot.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	response := &common.Response{
+		Snapshots:            snapshots,
+		RootObjectID:         rootCollectionID,
+		RootObjectWidgetType: model.BlockContentWidget_CompactList,
+	}
+	if allErrors.IsEmpty() {
+		return response, nil
+	}
+	return response, allErrors
+}
+
+func (e *Enex) handleImportPath(imp *enexImport, path string, pathsCount int, allErrors *common.ConvertError) {
+	importSource := source.GetSource(path)
+	defer importSource.Close()
+	err := importSource.Initialize(path)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, model.Import_Enex) {
+			return
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{enexExtension}) == 0 {
+		allErrors.Add(common.ErrorBySourceType(importSource))
+		return
+	}
+	if iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if !strings.EqualFold(filepath.Ext(fileName), enexExtension) {
+			return true
+		}
+		if err := e.convertNotebook(imp, fileName, fileReader, allErrors); err != nil {
+			allErrors.Add(fmt.Errorf("notebook %s: %w", filepath.Base(fileName), err))
+		}
+		return !allErrors.ShouldAbortImport(pathsCount, model.Import_Enex)
+	}); iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+}
+
+func (e *Enex) convertNotebook(imp *enexImport, fileName string, r io.Reader, allErrors *common.ConvertError) error {
+	var noteIds []string
+	err := readNotes(r, func(n *note) error {
+		snapshot, err := e.convertNote(imp, fileName, n)
+		if err != nil {
+			err = fmt.Errorf("note %q: %w", n.Title, err)
+			if imp.mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+				return err
+			}
+			allErrors.Add(err)
+			return nil
+		}
+		imp.snapshots = append(imp.snapshots, snapshot)
+		noteIds = append(noteIds, snapshot.Id)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	notebookName := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	notebook := common.NewImportCollection(e.collectionService)
+	settings := common.NewImportCollectionSetting(
+		common.WithCollectionName(notebookName),
+		common.WithTargetObjects(noteIds),
+		common.WithRelations(),
+	)
+	notebookSnapshot, err := notebook.MakeImportCollection(settings)
+	if err != nil {
+		return fmt.Errorf("make notebook collection: %w", err)
+	}
+	imp.snapshots = append(imp.snapshots, notebookSnapshot)
+	imp.notebookIds = append(imp.notebookIds, notebookSnapshot.Id)
+	return nil
+}
+
+func (e *Enex) convertNote(imp *enexImport, fileName string, n *note) (*common.Snapshot, error) {
+	files, err := imp.extractResources(n.Resources)
+	if err != nil {
+		return nil, err
+	}
+	html, err := enmlToHTML(n.Content, files)
+	if err != nil {
+		return nil, err
+	}
+	blocks, _, err := anymark.HTMLToBlocks([]byte(html), "")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", common.ErrWrongHTMLFormat, err)
+	}
+	blocks = addFileBlocks(blocks, files)
+
+	details := common.GetCommonDetails(fileName, n.Title, "", model.ObjectType_basic)
+	// notes of a notebook share the same file, so the note is identified by its creation time and title
+	h := sha256.Sum256([]byte(fileName + "/" + n.Created + "/" + n.Title))
+	details.SetString(bundle.RelationKeySourceFilePath, hex.EncodeToString(h[:]))
+	if created := filetime.ParseBasicTimestamp(n.Created); created != 0 {
+		details.SetInt64(bundle.RelationKeyCreatedDate, created)
+	}
+	if updated := filetime.ParseBasicTimestamp(n.Updated); updated != 0 {
+		details.SetInt64(bundle.RelationKeyLastModifiedDate, updated)
+	}
+	relationLinks := []*model.RelationLink{}
+	if len(n.Tags) > 0 {
+		tagIds := make([]string, 0, len(n.Tags))
+		for _, tag := range n.Tags {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tagIds = append(tagIds, imp.tagOptionId(tag))
+			}
+		}
+		details.SetStringList(bundle.RelationKeyTag, tagIds)
+		relationLinks = append(relationLinks, &model.RelationLink{Key: bundle.RelationKeyTag.String(), Format: model.RelationFormat_tag})
+	}
+	if sourceUrl := strings.TrimSpace(n.Attributes.SourceUrl); sourceUrl != "" {
+		details.SetString(bundle.RelationKeySource, sourceUrl)
+		relationLinks = append(relationLinks, &model.RelationLink{Key: bundle.RelationKeySource.String(), Format: model.RelationFormat_url})
+	}
+
+	return &common.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: fileName,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypePage,
+			Data: &common.StateSnapshot{
+				Blocks:        blocks,
+				Details:       details,
+				RelationLinks: relationLinks,
+				ObjectTypes:   []string{bundle.TypeKeyPage.String()},
+			},
+		},
+	}, nil
+}
+
+// extractResources writes resources of the note to the temporary directory, so they are uploaded as files during import.
+// Broken resources are skipped, so the note is imported without them
+func (imp *enexImport) extractResources(resources []resource) (map[string]resourceFile, error) {
+	files := make(map[string]resourceFile, len(resources))
+	for _, res := range resources {
+		data, hash, err := res.decode()
+		if err != nil {
+			log.Warnf("skip resource %s: %v", res.Attributes.FileName, err)
+			continue
+		}
+		if _, ok := files[hash]; ok {
+			continue
+		}
+		dir := filepath.Join(imp.filesDir, hash)
+		if err = os.MkdirAll(dir, 0700); err != nil {
+			return nil, fmt.Errorf("create resource directory: %w", err)
+		}
+		path := filepath.Join(dir, sanitizeFileName(res.fileName(hash), hash))
+		if err = os.WriteFile(path, data, 0600); err != nil {
+			return nil, fmt.Errorf("write resource: %w", err)
+		}
+		files[hash] = resourceFile{path: path, isImage: strings.HasPrefix(res.Mime, "image/")}
+	}
+	return files, nil
+}
+
+func sanitizeFileName(name, fallback string) string {
+	name = filepath.Base(filepath.Clean("/" + name))
+	if name == "/" || name == "." {
+		return fallback
+	}
+	return name
+}
+
+// addFileBlocks turns links to extracted files into file blocks and adds blocks for resources,
+// which are attached to the note, but not referenced in its content
+func addFileBlocks(blocks []*model.Block, files map[string]resourceFile) []*model.Block {
+	filePaths := make(map[string]bool, len(files))
+	for _, file := range files {
+		filePaths[file.path] = true
+	}
+	used := make(map[string]bool, len(files))
+	for _, block := range blocks {
+		if file := block.GetFile(); file != nil {
+			used[file.Name] = true
+			continue
+		}
+		for _, mark := range block.GetText().GetMarks().GetMarks() {
+			if mark.Type == model.BlockContentTextMark_Link && filePaths[mark.Param] {
+				used[mark.Param] = true
+				block.Content = anymark.ConvertTextToFile(mark.Param)
+				break
+			}
+		}
+	}
+	for _, file := range files {
+		if used[file.path] {
+			continue
+		}
+		blocks = append(blocks, &model.Block{
+			Id:      bson.NewObjectId().Hex(),
+			Content: anymark.ConvertTextToFile(file.path),
+		})
+	}
+	return blocks
+}
+
+func (imp *enexImport) tagOptionId(name string) string {
+	if option, ok := imp.tagOptions[name]; ok {
+		return option.Id
+	}
+	id := bson.NewObjectId().Hex()
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, name)
+	details.SetString(bundle.RelationKeyRelationKey, bundle.RelationKeyTag.String())
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relationOption))
+	details.SetString(bundle.RelationKeyRelationOptionColor, constant.RandomOptionColor().String())
+	if uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelationOption, id); err == nil {
+		details.SetString(bundle.RelationKeyUniqueKey, uniqueKey.Marshal())
+	} else {
+		log.Warnf("failed to create unique key for tag: %v", err)
+	}
+	imp.tagOptions[name] = &common.Snapshot{
+		Id: id,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypeRelationOption,
+			Data: &common.StateSnapshot{
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyRelationOption.String()},
+				Key:         id,
+			},
+		},
+	}
+	imp.tagNames = append(imp.tagNames, name)
+	return id
+}
@@ -0,0 +1,146 @@
+package enex
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/test"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/mock_core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func newConverter(t *testing.T) *Enex {
+	tempDirProvider := mock_core.NewMockTempDirProvider(t)
+	tempDirProvider.EXPECT().TempDir().Return(t.TempDir()).Maybe()
+	return &Enex{tempDirProvider: tempDirProvider}
+}
+
+func TestEnex_GetSnapshots(t *testing.T) {
+	t.Run("notes, notebook and tags", func(t *testing.T) {
+		// given
+		e := newConverter(t)
+
+		// when
+		resp, ce := e.GetSnapshots(context.Background(), test.NewImportRequest(model.Import_Enex, pb.RpcObjectImportRequest_ALL_OR_NOTHING, filepath.Join("testdata", "Notebook.enex")), process.NewNoOp())
+
+		// then
+		require.Nil(t, ce)
+		// 2 notes, notebook, 2 tags and root collection
+		require.Len(t, resp.Snapshots, 6)
+
+		note := test.FindSnapshot(t, resp.Snapshots, smartblock.SmartBlockTypePage, "Groceries & more")
+		details := note.Snapshot.Data.Details
+		assert.Equal(t, int64(1579077000), details.GetInt64(bundle.RelationKeyCreatedDate))
+		assert.Equal(t, int64(1613812500), details.GetInt64(bundle.RelationKeyLastModifiedDate))
+		assert.Equal(t, "https://example.com/list", details.GetString(bundle.RelationKeySource))
+		tagIds := details.GetStringList(bundle.RelationKeyTag)
+		require.Len(t, tagIds, 2)
+
+		home := test.FindSnapshot(t, resp.Snapshots, smartblock.SmartBlockTypeRelationOption, "home")
+		assert.Equal(t, smartblock.SmartBlockTypeRelationOption, home.Snapshot.SbType)
+		assert.Equal(t, home.Id, tagIds[0])
+		assert.Equal(t, bundle.RelationKeyTag.String(), home.Snapshot.Data.Details.GetString(bundle.RelationKeyRelationKey))
+		second := test.FindSnapshot(t, resp.Snapshots, smartblock.SmartBlockTypePage, "Second note")
+		assert.Equal(t, []string{home.Id}, second.Snapshot.Data.Details.GetStringList(bundle.RelationKeyTag))
+		assert.NotEqual(t, details.GetString(bundle.RelationKeySourceFilePath), second.Snapshot.Data.Details.GetString(bundle.RelationKeySourceFilePath))
+
+		notebook := test.FindSnapshot(t, resp.Snapshots, smartblock.SmartBlockTypePage, "Notebook")
+		assert.Equal(t, []string{bundle.TypeKeyCollection.String()}, notebook.Snapshot.Data.ObjectTypes)
+		assert.Equal(t, []string{note.Id, second.Id}, pbtypes.GetStringList(notebook.Snapshot.Data.Collections, template.CollectionStoreKey))
+
+		root := resp.Snapshots[len(resp.Snapshots)-1]
+		assert.Equal(t, root.Id, resp.RootObjectID)
+		assert.Equal(t, []string{notebook.Id}, pbtypes.GetStringList(root.Snapshot.Data.Collections, template.CollectionStoreKey))
+	})
+
+	t.Run("formatting and resources", func(t *testing.T) {
+		// given
+		e := newConverter(t)
+
+		// when
+		resp, ce := e.GetSnapshots(context.Background(), test.NewImportRequest(model.Import_Enex, pb.RpcObjectImportRequest_ALL_OR_NOTHING, filepath.Join("testdata", "Notebook.enex")), process.NewNoOp())
+
+		// then
+		require.Nil(t, ce)
+		note := test.FindSnapshot(t, resp.Snapshots, smartblock.SmartBlockTypePage, "Groceries & more")
+		var (
+			checkboxes []*model.BlockContentText
+			listItems  int
+			hasTable   bool
+			files      = map[string]model.BlockContentFileType{}
+			texts      []string
+		)
+		for _, block := range note.Snapshot.Data.Blocks {
+			switch {
+			case block.GetText() != nil:
+				text := block.GetText()
+				texts = append(texts, text.Text)
+				switch text.Style {
+				case model.BlockContentText_Checkbox:
+					checkboxes = append(checkboxes, text)
+				case model.BlockContentText_Marked:
+					listItems++
+				}
+			case block.GetTable() != nil:
+				hasTable = true
+			case block.GetFile() != nil:
+				files[filepath.Base(block.GetFile().Name)] = block.GetFile().Type
+				_, err := os.Stat(block.GetFile().Name)
+				assert.NoError(t, err)
+			}
+		}
+		require.Len(t, checkboxes, 2)
+		assert.Equal(t, "Buy milk", checkboxes[0].Text)
+		assert.True(t, checkboxes[0].Checked)
+		assert.Equal(t, "Buy bread", checkboxes[1].Text)
+		assert.False(t, checkboxes[1].Checked)
+		assert.Equal(t, 2, listItems)
+		assert.True(t, hasTable)
+		assert.Contains(t, texts, encryptedContentText)
+		assert.Equal(t, map[string]model.BlockContentFileType{
+			"pixel.png":                            model.BlockContentFile_Image,
+			"report.pdf":                           model.BlockContentFile_PDF,
+			"5ce6519e70d96778cc8eac8205e59977.txt": model.BlockContentFile_File,
+		}, files)
+	})
+
+	t.Run("broken notebook is skipped", func(t *testing.T) {
+		// given
+		e := newConverter(t)
+
+		// when
+		resp, ce := e.GetSnapshots(context.Background(), test.NewImportRequest(model.Import_Enex, pb.RpcObjectImportRequest_IGNORE_ERRORS,
+			filepath.Join("testdata", "broken.enex"), filepath.Join("testdata", "Notebook.enex")), process.NewNoOp())
+
+		// then
+		require.NotNil(t, ce)
+		assert.ErrorContains(t, ce.Error(), errWrongFormat.Error())
+		test.FindSnapshot(t, resp.Snapshots, smartblock.SmartBlockTypePage, "Second note")
+		assert.Nil(t, test.LookupSnapshot(resp.Snapshots, smartblock.SmartBlockTypePage, "broken"))
+	})
+
+	t.Run("no enex files in directory", func(t *testing.T) {
+		// given
+		e := newConverter(t)
+
+		// when
+		_, ce := e.GetSnapshots(context.Background(), test.NewImportRequest(model.Import_Enex, pb.RpcObjectImportRequest_IGNORE_ERRORS, t.TempDir()), process.NewNoOp())
+
+		// then
+		require.NotNil(t, ce)
+		assert.True(t, errors.Is(ce.GetResultError(model.Import_Enex), common.ErrFileImportNoObjectsInDirectory))
+	})
+}
@@ -0,0 +1,110 @@
+package enex
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+	"unicode"
+)
+
+// note is a single note of Evernote export, see http://xml.evernote.com/pub/evernote-export4.dtd
+type note struct {
+	Title      string         `xml:"title"`
+	Content    string         `xml:"content"`
+	Created    string         `xml:"created"`
+	Updated    string         `xml:"updated"`
+	Tags       []string       `xml:"tag"`
+	Attributes noteAttributes `xml:"note-attributes"`
+	Resources  []resource     `xml:"resource"`
+}
+
+type noteAttributes struct {
+	SourceUrl string `xml:"source-url"`
+}
+
+type resource struct {
+	Data       string             `xml:"data"`
+	Mime       string             `xml:"mime"`
+	Attributes resourceAttributes `xml:"resource-attributes"`
+}
+
+// preferredExtensions are used instead of the first extension known for the mime type, which is not the common one
+var preferredExtensions = map[string]string{
+	"text/plain": ".txt",
+	"image/jpeg": ".jpg",
+	"audio/mpeg": ".mp3",
+}
+
+type resourceAttributes struct {
+	FileName string `xml:"file-name"`
+}
+
+// readNotes decodes notes one by one, as exports with attachments can be large
+func readNotes(r io.Reader, onNote func(n *note) error) error {
+	decoder := xml.NewDecoder(r)
+	decoder.Entity = xml.HTMLEntity
+	var hasExport bool
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %w", errWrongFormat, err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "en-export":
+			hasExport = true
+		case "note":
+			n := &note{}
+			if err = decoder.DecodeElement(n, &start); err != nil {
+				return fmt.Errorf("%w: %w", errWrongFormat, err)
+			}
+			if err = onNote(n); err != nil {
+				return err
+			}
+		}
+	}
+	if !hasExport {
+		return fmt.Errorf("%w: no en-export element", errWrongFormat)
+	}
+	return nil
+}
+
+// decode returns content of the resource and its hash, which is used by en-media elements to reference the resource
+func (r *resource) decode() (data []byte, hash string, err error) {
+	data, err = base64.StdEncoding.DecodeString(strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, r.Data))
+	if err != nil {
+		return nil, "", fmt.Errorf("decode resource: %w", err)
+	}
+	sum := md5.Sum(data)
+	return data, hex.EncodeToString(sum[:]), nil
+}
+
+func (r *resource) fileName(hash string) string {
+	if name := strings.TrimSpace(r.Attributes.FileName); name != "" {
+		return name
+	}
+	if extension, ok := preferredExtensions[r.Mime]; ok {
+		return hash + extension
+	}
+	if extensions, err := mime.ExtensionsByType(r.Mime); err == nil && len(extensions) > 0 {
+		return hash + extensions[0]
+	}
+	return hash
+}
@@ -0,0 +1,56 @@
+package enex
+
+import (
+	"fmt"
+	"html"
+	"path/filepath"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const encryptedContentText = "Encrypted content is not imported"
+
+// enmlToHTML converts note content to HTML supported by anymark: to-dos become markdown checkboxes and media is
+// replaced by images or links to files extracted from resources
+func enmlToHTML(content string, files map[string]resourceFile) (string, error) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", errWrongFormat, err)
+	}
+	// html parser doesn't support self-closing custom elements, so the content following them becomes their children
+	doc.Find("en-todo").Each(func(_ int, s *goquery.Selection) {
+		marker := "[ ] "
+		if checked, _ := s.Attr("checked"); checked == "true" {
+			marker = "[x] "
+		}
+		replaceKeepingChildren(s, marker)
+	})
+	doc.Find("en-media").Each(func(_ int, s *goquery.Selection) {
+		hash, _ := s.Attr("hash")
+		file, ok := files[hash]
+		if !ok {
+			replaceKeepingChildren(s, "")
+			return
+		}
+		escapedPath := html.EscapeString(file.path)
+		if file.isImage {
+			replaceKeepingChildren(s, fmt.Sprintf(`<img src="%s"/>`, escapedPath))
+			return
+		}
+		replaceKeepingChildren(s, fmt.Sprintf(`<div><a href="%s">%s</a></div>`, escapedPath, html.EscapeString(filepath.Base(file.path))))
+	})
+	doc.Find("en-crypt").Each(func(_ int, s *goquery.Selection) {
+		s.ReplaceWithHtml("<div>" + encryptedContentText + "</div>")
+	})
+	root := doc.Find("en-note")
+	if root.Length() == 0 {
+		root = doc.Find("body")
+	}
+	return root.Html()
+}
+
+func replaceKeepingChildren(s *goquery.Selection, prefix string) {
+	inner, _ := s.Html()
+	s.ReplaceWithHtml(prefix + inner)
+}
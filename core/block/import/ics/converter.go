@@ -0,0 +1,131 @@
+package ics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	Name               = "Ics"
+	rootCollectionName = "iCalendar Import"
+	icsExt             = ".ics"
+	numberOfStages     = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+)
+
+var log = logging.Logger("import-ics")
+
+// ICS imports events and to-dos of iCalendar files. Events become objects of Event type, to-dos become tasks
+type ICS struct {
+	collectionService *collection.Service
+}
+
+func New(collectionService *collection.Service) common.Converter {
+	return &ICS{collectionService: collectionService}
+}
+
+func (i *ICS) Name() string {
+	return Name
+}
+
+func (i *ICS) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*common.Response, *common.ConvertError) {
+	params := req.GetIcsParams()
+	if params == nil || len(params.Path) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from files")
+	allErrors := common.NewError(req.Mode)
+	objects := newObjectBuilder()
+	var (
+		snapshots []*common.Snapshot
+		targetIds []string
+	)
+	for _, path := range params.Path {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(common.ErrCancel)
+			return nil, allErrors
+		}
+		pathSnapshots := i.readPath(path, objects, len(params.Path), allErrors)
+		if allErrors.ShouldAbortImport(len(params.Path), req.Type) {
+			return nil, allErrors
+		}
+		for _, snapshot := range pathSnapshots {
+			snapshots = append(snapshots, snapshot)
+			targetIds = append(targetIds, snapshot.Id)
+		}
+	}
+	snapshots = append(snapshots, objects.schemaSnapshots()...)
+
+	rootCollection := common.NewImportCollection(i.collectionService)
+	settings := common.NewImportCollectionSetting(
+		common.WithCollectionName(rootCollectionName),
+		common.WithTargetObjects(targetIds),
+		common.WithAddDate(),
+		common.WithRelations(),
+	)
+	rootCollectionSnapshot, err := rootCollection.MakeImportCollection(settings)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(params.Path), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCollectionSnapshot != nil {
+		snapshots = append(snapshots, rootCollectionSnapshot)
+		rootCollectionID = rootCollectionSnapshot.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	response := &common.Response{
+		Snapshots:            snapshots,
+		RootObjectID:         rootCollectionID,
+		RootObjectWidgetType: model.BlockContentWidget_CompactList,
+	}
+	if allErrors.IsEmpty() {
+		return response, nil
+	}
+	return response, allErrors
+}
+
+func (i *ICS) readPath(path string, objects *objectBuilder, pathsCount int, allErrors *common.ConvertError) []*common.Snapshot {
+	importSource := source.GetSource(path)
+	defer importSource.Close()
+	if err := importSource.Initialize(path); err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, model.Import_Ics) {
+			return nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{icsExt}) == 0 {
+		allErrors.Add(common.ErrorBySourceType(importSource))
+		return nil
+	}
+	var snapshots []*common.Snapshot
+	if iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if !strings.EqualFold(filepath.Ext(fileName), icsExt) {
+			return true
+		}
+		cal, err := parseCalendar(fileReader)
+		if err != nil {
+			allErrors.Add(fmt.Errorf("file %s: %w", filepath.Base(fileName), err))
+			return !allErrors.ShouldAbortImport(pathsCount, model.Import_Ics)
+		}
+		for _, c := range cal.components {
+			snapshots = append(snapshots, objects.objectSnapshot(fileName, c))
+		}
+		return true
+	}); iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+	return snapshots
+}
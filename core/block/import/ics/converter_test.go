@@ -0,0 +1,147 @@
+package ics
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/test"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestICS_GetSnapshots(t *testing.T) {
+	t.Run("events and to-dos", func(t *testing.T) {
+		// given
+		i := New(nil)
+
+		// when
+		resp, ce := i.GetSnapshots(context.Background(), test.NewImportRequest(model.Import_Ics, pb.RpcObjectImportRequest_ALL_OR_NOTHING, filepath.Join("testdata", "calendar")), process.NewNoOp())
+
+		// then
+		require.Nil(t, ce)
+		// 3 events, 2 to-dos, 4 relations, 4 options, type of events and root collection
+		require.Len(t, resp.Snapshots, 15)
+		snapshots := resp.Snapshots
+		startKey := test.RelationKey(t, snapshots, relationNameStart)
+		endKey := test.RelationKey(t, snapshots, relationNameEnd)
+		eventType := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypeObjectType, eventTypeName)
+
+		standup := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "Standup")
+		details := standup.Snapshot.Data.Details
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 3, 11, 9, 30, 0, 0, berlin).Unix(), details.GetInt64(startKey))
+		assert.Equal(t, time.Date(2024, 3, 11, 9, 45, 0, 0, berlin).Unix(), details.GetInt64(endKey))
+		assert.Equal(t, "Room 1, 2nd floor", details.GetString(test.RelationKey(t, snapshots, relationNameLocation)))
+		attendees := details.GetStringList(test.RelationKey(t, snapshots, relationNameAttendees))
+		require.Len(t, attendees, 2)
+		assert.Equal(t, test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypeRelationOption, "Alice, Lead").Id, attendees[0])
+		assert.Equal(t, test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypeRelationOption, "bob@example.com").Id, attendees[1])
+		work := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypeRelationOption, "Work")
+		daily := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypeRelationOption, "Daily")
+		assert.Equal(t, []string{work.Id, daily.Id}, details.GetStringList(bundle.RelationKeyTag))
+		assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO,WE,FR", details.GetString(bundle.RelationKeyRecurrenceRule))
+		assert.Equal(t, time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC).Unix(), details.GetInt64(bundle.RelationKeyCreatedDate))
+		assert.Equal(t, []string{eventType.Snapshot.Data.Key}, standup.Snapshot.Data.ObjectTypes)
+		blocks := standup.Snapshot.Data.Blocks
+		require.Len(t, blocks, 2)
+		assert.Equal(t, "Daily sync.", blocks[0].GetText().Text)
+		assert.Equal(t, "Bring updates.", blocks[1].GetText().Text)
+		assert.True(t, strings.HasSuffix(details.GetString(bundle.RelationKeySourceFilePath), "#standup@example.com"))
+
+		offsite := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "Offsite").Snapshot.Data.Details
+		assert.Equal(t, time.Date(2024, 3, 20, 0, 0, 0, 0, time.Local).Unix(), offsite.GetInt64(startKey))
+		assert.Equal(t, time.Date(2024, 3, 22, 0, 0, 0, 0, time.Local).Unix(), offsite.GetInt64(endKey))
+		assert.Equal(t, []string{work.Id}, offsite.GetStringList(bundle.RelationKeyTag))
+
+		review := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "Review").Snapshot.Data.Details
+		assert.Equal(t, int64(90*60), review.GetInt64(endKey)-review.GetInt64(startKey))
+
+		report := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "Send report")
+		assert.Equal(t, []string{bundle.TypeKeyTask.String()}, report.Snapshot.Data.ObjectTypes)
+		assert.Equal(t, time.Date(2024, 3, 15, 17, 0, 0, 0, time.UTC).Unix(), report.Snapshot.Data.Details.GetInt64(bundle.RelationKeyDueDate))
+		assert.True(t, report.Snapshot.Data.Details.GetBool(bundle.RelationKeyDone))
+		slides := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "Prepare slides").Snapshot.Data.Details
+		assert.False(t, slides.GetBool(bundle.RelationKeyDone))
+
+		start := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypeRelation, relationNameStart).Snapshot.Data.Details
+		assert.True(t, start.GetBool(bundle.RelationKeyRelationFormatIncludeTime))
+		assert.Len(t, eventType.Snapshot.Data.Details.GetStringList(bundle.RelationKeyRecommendedFeaturedRelations), 2)
+
+		root := snapshots[len(snapshots)-1]
+		assert.Equal(t, root.Id, resp.RootObjectID)
+		assert.Len(t, pbtypes.GetStringList(root.Snapshot.Data.Collections, template.CollectionStoreKey), 5)
+	})
+
+	t.Run("broken calendar is skipped", func(t *testing.T) {
+		// given
+		i := New(nil)
+
+		// when
+		resp, ce := i.GetSnapshots(context.Background(), test.NewImportRequest(model.Import_Ics, pb.RpcObjectImportRequest_IGNORE_ERRORS, filepath.Join("testdata", "broken")), process.NewNoOp())
+
+		// then
+		require.NotNil(t, ce)
+		assert.ErrorContains(t, ce.Error(), errWrongFormat.Error())
+		party := test.FindSnapshot(t, resp.Snapshots, smartblock.SmartBlockTypePage, "Party").Snapshot.Data.Details
+		assert.NotZero(t, party.GetInt64(test.RelationKey(t, resp.Snapshots, relationNameStart)))
+		// single day event has no end
+		for _, sn := range resp.Snapshots {
+			assert.NotEqual(t, relationNameEnd, sn.Snapshot.Data.Details.GetString(bundle.RelationKeyName))
+		}
+	})
+
+	t.Run("no calendars", func(t *testing.T) {
+		// given
+		i := New(nil)
+
+		// when
+		_, ce := i.GetSnapshots(context.Background(), test.NewImportRequest(model.Import_Ics, pb.RpcObjectImportRequest_IGNORE_ERRORS, t.TempDir()), process.NewNoOp())
+
+		// then
+		require.NotNil(t, ce)
+		assert.ErrorIs(t, ce.GetResultError(model.Import_Ics), common.ErrFileImportNoObjectsInDirectory)
+	})
+}
+
+func TestParseDuration(t *testing.T) {
+	start := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		value string
+		end   time.Time
+		ok    bool
+	}{
+		{value: "PT30M", end: start.Add(30 * time.Minute), ok: true},
+		{value: "P1DT2H", end: start.Add(26 * time.Hour), ok: true},
+		{value: "P1W", end: start.AddDate(0, 0, 7), ok: true},
+		{value: "-PT15M", end: start.Add(-15 * time.Minute), ok: true},
+		{value: "1 hour"},
+	} {
+		t.Run(tc.value, func(t *testing.T) {
+			// when
+			end, ok := parseDuration(start, tc.value)
+
+			// then
+			assert.Equal(t, tc.ok, ok)
+			if tc.ok {
+				assert.Equal(t, tc.end, end)
+			}
+		})
+	}
+}
+
+func TestSplitText(t *testing.T) {
+	assert.Equal(t, []string{"Work", "a, b", "c"}, splitText(`Work,a\, b, c,`))
+}
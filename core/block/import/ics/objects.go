@@ -0,0 +1,356 @@
+package ics
+
+import (
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/filetime"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/constant"
+	"github.com/anyproto/anytype-heart/util/rrule"
+)
+
+const (
+	eventTypeName  = "Event"
+	eventTypeEmoji = "📅"
+
+	relationNameStart     = "Start"
+	relationNameEnd       = "End"
+	relationNameLocation  = "Location"
+	relationNameAttendees = "Attendees"
+)
+
+// relation is a relation created for properties of events, which have no bundled counterparts
+type relation struct {
+	name        string
+	key         string
+	format      model.RelationFormat
+	includeTime bool
+	id          string
+}
+
+// objectBuilder converts events and to-dos to objects. Relations, options and the type of events are shared
+// by all imported calendars and created only if they are used
+type objectBuilder struct {
+	relations   map[string]*relation
+	used        []string
+	options     map[string]*common.Snapshot
+	optionNames []string
+
+	eventTypeKey string
+	hasEvents    bool
+}
+
+func newObjectBuilder() *objectBuilder {
+	b := &objectBuilder{
+		relations:    map[string]*relation{},
+		options:      map[string]*common.Snapshot{},
+		eventTypeKey: bson.NewObjectId().Hex(),
+	}
+	for _, rel := range []*relation{
+		{name: relationNameStart, format: model.RelationFormat_date, includeTime: true},
+		{name: relationNameEnd, format: model.RelationFormat_date, includeTime: true},
+		{name: relationNameLocation, format: model.RelationFormat_shorttext},
+		{name: relationNameAttendees, format: model.RelationFormat_tag},
+	} {
+		rel.key = bson.NewObjectId().Hex()
+		rel.id = rel.key
+		if uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelation, rel.key); err == nil {
+			rel.id = uniqueKey.Marshal()
+		} else {
+			log.Warnf("failed to create unique key for relation: %v", err)
+		}
+		b.relations[rel.name] = rel
+	}
+	return b
+}
+
+// use returns the relation and marks it to be created
+func (b *objectBuilder) use(name string) *relation {
+	rel := b.relations[name]
+	for _, used := range b.used {
+		if used == name {
+			return rel
+		}
+	}
+	b.used = append(b.used, name)
+	return rel
+}
+
+func (b *objectBuilder) objectSnapshot(fileName string, c *component) *common.Snapshot {
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, c.text("SUMMARY"))
+	uid := c.text("UID")
+	if uid == "" {
+		uid = uuid.New().String()
+	}
+	details.SetString(bundle.RelationKeySourceFilePath, fileName+"#"+uid)
+	var (
+		relationLinks []*model.RelationLink
+		objectType    string
+	)
+	setDate := func(rel *relation, t time.Time) {
+		details.SetInt64(domain.RelationKey(rel.key), t.Unix())
+		relationLinks = append(relationLinks, &model.RelationLink{Key: rel.key, Format: rel.format})
+	}
+
+	start, startAllDay, hasStart := dateProperty(c, "DTSTART")
+	if hasStart {
+		setDate(b.use(relationNameStart), start)
+	}
+	if c.name == componentTodo {
+		objectType = bundle.TypeKeyTask.String()
+		details.SetInt64(bundle.RelationKeyResolvedLayout, int64(model.ObjectType_todo))
+		if due, _, ok := dateProperty(c, "DUE"); ok {
+			details.SetInt64(bundle.RelationKeyDueDate, due.Unix())
+			relationLinks = append(relationLinks, &model.RelationLink{Key: bundle.RelationKeyDueDate.String(), Format: model.RelationFormat_date})
+		}
+		_, completed := c.get("COMPLETED")
+		details.SetBool(bundle.RelationKeyDone, completed || strings.EqualFold(c.text("STATUS"), "COMPLETED"))
+		relationLinks = append(relationLinks, &model.RelationLink{Key: bundle.RelationKeyDone.String(), Format: model.RelationFormat_checkbox})
+	} else {
+		b.hasEvents = true
+		objectType = b.eventTypeKey
+		details.SetInt64(bundle.RelationKeyResolvedLayout, int64(model.ObjectType_basic))
+		if end, ok := eventEnd(c, start, startAllDay, hasStart); ok {
+			setDate(b.use(relationNameEnd), end)
+		}
+	}
+
+	if location := c.text("LOCATION"); location != "" {
+		rel := b.use(relationNameLocation)
+		details.SetString(domain.RelationKey(rel.key), location)
+		relationLinks = append(relationLinks, &model.RelationLink{Key: rel.key, Format: rel.format})
+	}
+	if attendees := attendeeNames(c); len(attendees) > 0 {
+		rel := b.use(relationNameAttendees)
+		ids := make([]string, 0, len(attendees))
+		for _, attendee := range attendees {
+			ids = append(ids, b.optionId(rel.key, attendee))
+		}
+		details.SetStringList(domain.RelationKey(rel.key), ids)
+		relationLinks = append(relationLinks, &model.RelationLink{Key: rel.key, Format: rel.format})
+	}
+	var tagIds []string
+	for _, prop := range c.all("CATEGORIES") {
+		for _, category := range splitText(prop.value) {
+			tagIds = append(tagIds, b.optionId(bundle.RelationKeyTag.String(), category))
+		}
+	}
+	if len(tagIds) > 0 {
+		details.SetStringList(bundle.RelationKeyTag, tagIds)
+		relationLinks = append(relationLinks, &model.RelationLink{Key: bundle.RelationKeyTag.String(), Format: model.RelationFormat_tag})
+	}
+	if rule, ok := c.get("RRULE"); ok {
+		// rules are kept as is, even if recurrence of objects supports only a part of them
+		if _, err := rrule.Parse(rule.value); err != nil {
+			log.Warnf("recurrence rule of %s is not supported: %v", uid, err)
+		}
+		details.SetString(bundle.RelationKeyRecurrenceRule, rule.value)
+		relationLinks = append(relationLinks, &model.RelationLink{Key: bundle.RelationKeyRecurrenceRule.String(), Format: model.RelationFormat_longtext})
+	}
+	if created := filetime.ParseBasicTimestamp(c.text("CREATED")); created != 0 {
+		details.SetInt64(bundle.RelationKeyCreatedDate, created)
+	}
+	if modified := filetime.ParseBasicTimestamp(c.text("LAST-MODIFIED")); modified != 0 {
+		details.SetInt64(bundle.RelationKeyLastModifiedDate, modified)
+	}
+
+	return &common.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: fileName,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypePage,
+			Data: &common.StateSnapshot{
+				Blocks:        descriptionBlocks(c.text("DESCRIPTION")),
+				Details:       details,
+				RelationLinks: relationLinks,
+				ObjectTypes:   []string{objectType},
+			},
+		},
+	}
+}
+
+func dateProperty(c *component, name string) (time.Time, bool, bool) {
+	prop, ok := c.get(name)
+	if !ok {
+		return time.Time{}, false, false
+	}
+	return parseDateTime(prop)
+}
+
+// eventEnd returns the end of the event from DTEND or DURATION. The end of all-day events is exclusive in iCalendar,
+// so it is moved to the last day of the event
+func eventEnd(c *component, start time.Time, startAllDay, hasStart bool) (time.Time, bool) {
+	end, allDay, ok := dateProperty(c, "DTEND")
+	if !ok && hasStart {
+		if duration, hasDuration := c.get("DURATION"); hasDuration {
+			end, ok = parseDuration(start, duration.value)
+			allDay = startAllDay
+		}
+	}
+	if !ok {
+		return time.Time{}, false
+	}
+	if allDay && end.After(start) {
+		end = end.AddDate(0, 0, -1)
+	}
+	if allDay && hasStart && !end.After(start) {
+		// single day events have no end
+		return time.Time{}, false
+	}
+	return end, true
+}
+
+// attendeeNames returns common names of attendees or their addresses
+func attendeeNames(c *component) []string {
+	var names []string
+	for _, prop := range c.all("ATTENDEE") {
+		name := prop.params["CN"]
+		if name == "" {
+			name = prop.value
+			if len(name) > len("mailto:") && strings.EqualFold(name[:len("mailto:")], "mailto:") {
+				name = name[len("mailto:"):]
+			}
+		}
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func descriptionBlocks(description string) []*model.Block {
+	var blocks []*model.Block
+	for _, line := range strings.Split(description, "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		blocks = append(blocks, &model.Block{
+			Id:      bson.NewObjectId().Hex(),
+			Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: line}},
+		})
+	}
+	return blocks
+}
+
+// optionId returns the id of the option of tag relation, the option is created on the first use
+func (b *objectBuilder) optionId(relationKey, name string) string {
+	optionKey := relationKey + "/" + strings.ToLower(name)
+	if option, ok := b.options[optionKey]; ok {
+		return option.Id
+	}
+	id := bson.NewObjectId().Hex()
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, name)
+	details.SetString(bundle.RelationKeyRelationKey, relationKey)
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relationOption))
+	details.SetString(bundle.RelationKeyRelationOptionColor, constant.RandomOptionColor().String())
+	if uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelationOption, id); err == nil {
+		details.SetString(bundle.RelationKeyUniqueKey, uniqueKey.Marshal())
+	} else {
+		log.Warnf("failed to create unique key for option: %v", err)
+	}
+	b.options[optionKey] = &common.Snapshot{
+		Id: id,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypeRelationOption,
+			Data: &common.StateSnapshot{
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyRelationOption.String()},
+				Key:         id,
+			},
+		},
+	}
+	b.optionNames = append(b.optionNames, optionKey)
+	return id
+}
+
+// schemaSnapshots returns snapshots of used relations, their options and the type of events
+func (b *objectBuilder) schemaSnapshots() []*common.Snapshot {
+	var snapshots []*common.Snapshot
+	relationIds := make([]string, 0, len(b.used))
+	for _, name := range b.used {
+		rel := b.relations[name]
+		snapshots = append(snapshots, relationSnapshot(rel))
+		relationIds = append(relationIds, rel.id)
+	}
+	for _, name := range b.optionNames {
+		snapshots = append(snapshots, b.options[name])
+	}
+	if b.hasEvents {
+		snapshots = append(snapshots, b.eventTypeSnapshot(relationIds))
+	}
+	return snapshots
+}
+
+func relationSnapshot(rel *relation) *common.Snapshot {
+	details := domain.NewDetails()
+	details.SetInt64(bundle.RelationKeyRelationFormat, int64(rel.format))
+	details.SetString(bundle.RelationKeyName, rel.name)
+	details.SetString(bundle.RelationKeyRelationKey, rel.key)
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relation))
+	details.SetString(bundle.RelationKeyId, rel.id)
+	if rel.format == model.RelationFormat_date {
+		details.SetBool(bundle.RelationKeyRelationFormatIncludeTime, rel.includeTime)
+	}
+	return &common.Snapshot{
+		Id: rel.id,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypeRelation,
+			Data: &common.StateSnapshot{
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyRelation.String()},
+				Key:         rel.key,
+			},
+		},
+	}
+}
+
+// eventTypeSnapshot creates the type of events. Start and end dates are featured relations of the type
+func (b *objectBuilder) eventTypeSnapshot(relationIds []string) *common.Snapshot {
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, eventTypeName)
+	details.SetString(bundle.RelationKeyIconEmoji, eventTypeEmoji)
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_objectType))
+	details.SetInt64(bundle.RelationKeyResolvedLayout, int64(model.ObjectType_objectType))
+	details.SetInt64(bundle.RelationKeyRecommendedLayout, int64(model.ObjectType_basic))
+	details.SetString(bundle.RelationKeyType, bundle.TypeKeyObjectType.String())
+	var featured, recommended []string
+	for _, name := range b.used {
+		rel := b.relations[name]
+		if rel.format == model.RelationFormat_date {
+			featured = append(featured, rel.id)
+		} else {
+			recommended = append(recommended, rel.id)
+		}
+	}
+	details.SetStringList(bundle.RelationKeyRecommendedFeaturedRelations, featured)
+	details.SetStringList(bundle.RelationKeyRecommendedRelations, recommended)
+	id := b.eventTypeKey
+	if uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeObjectType, b.eventTypeKey); err == nil {
+		id = uniqueKey.Marshal()
+		details.SetString(bundle.RelationKeyId, id)
+		details.SetString(bundle.RelationKeyUniqueKey, id)
+	} else {
+		log.Warnf("failed to create unique key for type: %v", err)
+	}
+	return &common.Snapshot{
+		Id: id,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypeObjectType,
+			Data: &common.StateSnapshot{
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyObjectType.String()},
+				Key:         b.eventTypeKey,
+			},
+		},
+	}
+}
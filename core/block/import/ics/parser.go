@@ -0,0 +1,273 @@
+package ics
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common/filetime"
+)
+
+const (
+	componentCalendar = "VCALENDAR"
+	componentEvent    = "VEVENT"
+	componentTodo     = "VTODO"
+
+	dateLayout = "20060102"
+)
+
+var (
+	errWrongFormat = errors.New("wrong iCalendar format")
+
+	// durationRegexp matches durations of RFC 5545 like "P1W", "P1DT2H" or "PT30M"
+	durationRegexp = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+)
+
+// property is a content line of the calendar: "NAME;PARAM=value:value"
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// component is a VEVENT or VTODO with its properties. Nested components, like alarms, are skipped
+type component struct {
+	name       string
+	properties []property
+}
+
+func (c *component) get(name string) (property, bool) {
+	for _, prop := range c.properties {
+		if prop.name == name {
+			return prop, true
+		}
+	}
+	return property{}, false
+}
+
+func (c *component) text(name string) string {
+	prop, _ := c.get(name)
+	return unescapeText(prop.value)
+}
+
+func (c *component) all(name string) []property {
+	var props []property
+	for _, prop := range c.properties {
+		if prop.name == name {
+			props = append(props, prop)
+		}
+	}
+	return props
+}
+
+// calendar is the parsed VCALENDAR object
+type calendar struct {
+	name       string
+	components []*component
+}
+
+// parseCalendar reads events and to-dos of the calendar. Content lines are unfolded before parsing
+func parseCalendar(r io.Reader) (*calendar, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		cal       *calendar
+		current   *component
+		nestLevel int
+	)
+	for _, line := range lines {
+		prop, ok := parseContentLine(line)
+		if !ok {
+			continue
+		}
+		switch prop.name {
+		case "BEGIN":
+			value := strings.ToUpper(prop.value)
+			switch {
+			case cal == nil && value == componentCalendar:
+				cal = &calendar{}
+			case cal == nil:
+				return nil, fmt.Errorf("%w: calendar is not started", errWrongFormat)
+			case current != nil:
+				nestLevel++
+			case value == componentEvent || value == componentTodo:
+				current = &component{name: value}
+			default:
+				nestLevel++
+			}
+			continue
+		case "END":
+			switch {
+			case nestLevel > 0:
+				nestLevel--
+			case current != nil:
+				cal.components = append(cal.components, current)
+				current = nil
+			}
+			continue
+		}
+		switch {
+		case cal == nil || nestLevel > 0:
+		case current != nil:
+			current.properties = append(current.properties, prop)
+		case prop.name == "X-WR-CALNAME":
+			cal.name = unescapeText(prop.value)
+		}
+	}
+	if cal == nil {
+		return nil, fmt.Errorf("%w: calendar is not found", errWrongFormat)
+	}
+	return cal, nil
+}
+
+// unfoldLines joins lines, which are folded by a line break followed by a space or a tab
+func unfoldLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// parseContentLine splits the line to the name, parameters and the value. Colons and semicolons in quoted
+// parameter values are kept
+func parseContentLine(line string) (property, bool) {
+	var (
+		inQuotes bool
+		parts    []string
+		start    int
+		valueIdx = -1
+	)
+	for i, r := range line {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+		case r == ';':
+			parts = append(parts, line[start:i])
+			start = i + 1
+		case r == ':':
+			parts = append(parts, line[start:i])
+			valueIdx = i + 1
+		}
+		if valueIdx >= 0 {
+			break
+		}
+	}
+	if valueIdx < 0 || len(parts) == 0 || parts[0] == "" {
+		return property{}, false
+	}
+	prop := property{name: strings.ToUpper(parts[0]), value: line[valueIdx:]}
+	for _, param := range parts[1:] {
+		key, value, ok := strings.Cut(param, "=")
+		if !ok {
+			continue
+		}
+		if prop.params == nil {
+			prop.params = map[string]string{}
+		}
+		prop.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+	return prop, true
+}
+
+func unescapeText(value string) string {
+	var sb strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' || i == len(value)-1 {
+			sb.WriteByte(value[i])
+			continue
+		}
+		i++
+		switch value[i] {
+		case 'n', 'N':
+			sb.WriteByte('\n')
+		default:
+			sb.WriteByte(value[i])
+		}
+	}
+	return sb.String()
+}
+
+// splitText splits the list value by commas, which are not escaped
+func splitText(value string) []string {
+	var (
+		values []string
+		start  int
+	)
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case ',':
+			values = append(values, value[start:i])
+			start = i + 1
+		}
+	}
+	values = append(values, value[start:])
+	result := values[:0]
+	for _, v := range values {
+		if v = strings.TrimSpace(unescapeText(v)); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// parseDateTime parses values of DTSTART, DTEND and DUE. Dates without time are all-day dates in the local time,
+// times without zone are in the zone of TZID parameter or floating, i.e. in the local time
+func parseDateTime(prop property) (t time.Time, allDay bool, ok bool) {
+	value := strings.TrimSpace(prop.value)
+	if prop.params["VALUE"] == "DATE" || len(value) == len(dateLayout) {
+		t, err := time.ParseInLocation(dateLayout, value, time.Local)
+		return t, true, err == nil
+	}
+	if strings.HasSuffix(value, "Z") {
+		unix := filetime.ParseBasicTimestamp(value)
+		return time.Unix(unix, 0), false, unix != 0
+	}
+	location := time.Local
+	if tzid := prop.params["TZID"]; tzid != "" {
+		if tz, err := time.LoadLocation(tzid); err == nil {
+			location = tz
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, location)
+	return t, false, err == nil
+}
+
+// parseDuration parses durations like "PT1H30M". Weeks and days are nominal durations, so they are added as days
+func parseDuration(start time.Time, value string) (time.Time, bool) {
+	m := durationRegexp.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return time.Time{}, false
+	}
+	number := func(s string) int {
+		n, _ := strconv.Atoi(s)
+		return n
+	}
+	sign := 1
+	if m[1] == "-" {
+		sign = -1
+	}
+	end := start.AddDate(0, 0, sign*(number(m[2])*7+number(m[3])))
+	clock := time.Duration(number(m[4]))*time.Hour + time.Duration(number(m[5]))*time.Minute + time.Duration(number(m[6]))*time.Second
+	return end.Add(time.Duration(sign) * clock), true
+}
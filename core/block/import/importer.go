@@ -15,9 +15,13 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/import/common/objectid"
 	"github.com/anyproto/anytype-heart/core/block/import/common/syncer"
 	"github.com/anyproto/anytype-heart/core/block/import/csv"
+	"github.com/anyproto/anytype-heart/core/block/import/enex"
 	"github.com/anyproto/anytype-heart/core/block/import/html"
+	"github.com/anyproto/anytype-heart/core/block/import/ics"
 	"github.com/anyproto/anytype-heart/core/block/import/markdown"
 	"github.com/anyproto/anytype-heart/core/block/import/notion"
+	"github.com/anyproto/anytype-heart/core/block/import/obsidian"
+	"github.com/anyproto/anytype-heart/core/block/import/outliner"
 	pbc "github.com/anyproto/anytype-heart/core/block/import/pb"
 	"github.com/anyproto/anytype-heart/core/block/import/txt"
 	"github.com/anyproto/anytype-heart/core/block/import/web"
@@ -114,12 +118,15 @@ func (i *Import) setupConverters(a *app.App) {
 		html.New(collectionService, tempDirProvider),
 		txt.New(collectionService),
 		csv.New(collectionService),
+		enex.New(collectionService, tempDirProvider),
+		outliner.NewLogseq(collectionService),
+		outliner.NewRoam(collectionService),
+		obsidian.New(collectionService, tempDirProvider),
+		ics.New(collectionService),
 	}
 	for _, c := range converters {
 		i.deps.converters[c.Name()] = c
 	}
-	// temporary, until we don't have specific logic for obsidian import
-	i.deps.converters[model.Import_Obsidian.String()] = i.deps.converters[model.Import_Markdown.String()]
 }
 
 func (i *Import) Name() string {
@@ -0,0 +1,331 @@
+package obsidian
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	// wikilinks are turned into markdown links with these schemes before markdown is parsed,
+	// and links are turned into marks with object ids afterward
+	mentionScheme = "anytypemention:"
+	objectScheme  = "anytypeobject:"
+
+	defaultCalloutEmoji = "💡"
+)
+
+var (
+	// wikilinkRegexp matches wikilinks "[[Note]]", "[[Note|alias]]", "[[Note#Heading]]" and embeds "![[Note]]"
+	wikilinkRegexp = regexp.MustCompile(`(!?)\[\[([^\[\]]+)\]\]`)
+	// embedLineRegexp matches lines, which consist of the embed only
+	embedLineRegexp = regexp.MustCompile(`^\s*!\[\[([^\[\]]+)\]\]\s*$`)
+	// calloutRegexp matches the first line of the callout "> [!type]- Title"
+	calloutRegexp = regexp.MustCompile(`^\s*>\s*\[!([\w-]+)\][+-]?\s*(.*)$`)
+	// tagRegexp matches tags "#tag" and nested tags "#project/alpha". Tags consisting of digits only are not tags in Obsidian
+	tagRegexp = regexp.MustCompile(`(?:^|\s)#([\p{L}\p{N}_/-]*[\p{L}_/-][\p{L}\p{N}_/-]*)`)
+	// commentRegexp matches comments "%%text%%", which are not shown in Obsidian
+	commentRegexp = regexp.MustCompile(`(?s)%%.*?%%`)
+
+	calloutEmojis = map[string]string{
+		"note":      "📝",
+		"abstract":  "📋",
+		"summary":   "📋",
+		"tldr":      "📋",
+		"info":      "ℹ️",
+		"todo":      "☑️",
+		"tip":       "🔥",
+		"hint":      "🔥",
+		"important": "🔥",
+		"success":   "✅",
+		"check":     "✅",
+		"done":      "✅",
+		"question":  "❓",
+		"help":      "❓",
+		"faq":       "❓",
+		"warning":   "⚠️",
+		"caution":   "⚠️",
+		"attention": "⚠️",
+		"failure":   "❌",
+		"fail":      "❌",
+		"missing":   "❌",
+		"danger":    "⚡",
+		"error":     "⚡",
+		"bug":       "🐞",
+		"example":   "📑",
+		"quote":     "💬",
+		"cite":      "💬",
+	}
+)
+
+// bodyConverter converts the markdown of the note. Callouts and embeds are converted separately from the rest of the text,
+// because they have no counterparts in markdown. Tags found in the text are collected
+type bodyConverter struct {
+	*noteConverter
+	baseDir string
+	tags    []string
+}
+
+func newBodyConverter(c *noteConverter, filePath string) *bodyConverter {
+	return &bodyConverter{noteConverter: c, baseDir: filepath.Dir(filePath)}
+}
+
+func (b *bodyConverter) convert(text string) ([]*model.Block, []string) {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return b.convertText(commentRegexp.ReplaceAllString(text, ""))
+}
+
+func (b *bodyConverter) convertText(text string) (blocks []*model.Block, rootIds []string) {
+	var (
+		buffer  []string
+		inFence bool
+	)
+	add := func(converted []*model.Block, ids []string) {
+		blocks = append(blocks, converted...)
+		rootIds = append(rootIds, ids...)
+	}
+	flush := func() {
+		if len(buffer) > 0 {
+			add(b.convertMarkdown(strings.Join(buffer, "\n")))
+			buffer = nil
+		}
+	}
+	lines := strings.Split(text, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		if isFence(line) {
+			inFence = !inFence
+			buffer = append(buffer, line)
+			continue
+		}
+		if inFence {
+			buffer = append(buffer, line)
+			continue
+		}
+		if m := calloutRegexp.FindStringSubmatch(line); m != nil {
+			flush()
+			var content []string
+			for i+1 < len(lines) && strings.HasPrefix(strings.TrimLeft(lines[i+1], " \t"), ">") {
+				i++
+				content = append(content, stripQuote(lines[i]))
+			}
+			add(b.callout(m[1], m[2], content))
+			continue
+		}
+		if m := embedLineRegexp.FindStringSubmatch(line); m != nil {
+			flush()
+			embed := b.embed(m[1])
+			add([]*model.Block{embed}, []string{embed.Id})
+			continue
+		}
+		for _, m := range tagRegexp.FindAllStringSubmatch(line, -1) {
+			addTag(&b.tags, m[1])
+		}
+		buffer = append(buffer, b.renderLinks(line))
+	}
+	flush()
+	return blocks, rootIds
+}
+
+func isFence(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+}
+
+func stripQuote(line string) string {
+	line = strings.TrimPrefix(strings.TrimLeft(line, " \t"), ">")
+	return strings.TrimPrefix(line, " ")
+}
+
+// convertMarkdown converts markdown, in which wikilinks are already replaced with markdown links
+func (b *bodyConverter) convertMarkdown(text string) ([]*model.Block, []string) {
+	blocks, _, err := anymark.MarkdownToBlocks([]byte(text), b.baseDir, nil)
+	if err != nil {
+		log.Warnf("failed to convert markdown: %v", err)
+		paragraph := textBlock(text)
+		return []*model.Block{paragraph}, []string{paragraph.Id}
+	}
+	for _, block := range blocks {
+		b.convertLinkMarks(block)
+		b.convertFileBlock(block)
+	}
+	return blocks, topLevelIds(blocks)
+}
+
+// callout converts the callout to the text block with the callout style. The content of the callout
+// becomes children of the block
+func (b *bodyConverter) callout(calloutType, title string, content []string) ([]*model.Block, []string) {
+	calloutType = strings.ToLower(calloutType)
+	emoji, ok := calloutEmojis[calloutType]
+	if !ok {
+		emoji = defaultCalloutEmoji
+	}
+	block := textBlock("")
+	text := block.GetText()
+	text.Style = model.BlockContentText_Callout
+	text.IconEmoji = emoji
+	if title = strings.TrimSpace(title); title != "" {
+		titleBlocks, titleIds := b.convertMarkdown(b.renderLinks(title))
+		if first := firstTextBlock(titleBlocks, titleIds); first != nil {
+			text.Text = first.GetText().Text
+			text.Marks = first.GetText().Marks
+		}
+	} else {
+		text.Text = strings.ToUpper(calloutType[:1]) + calloutType[1:]
+	}
+	children, childIds := b.convertText(strings.Join(content, "\n"))
+	block.ChildrenIds = childIds
+	return append([]*model.Block{block}, children...), []string{block.Id}
+}
+
+// embed converts "![[target]]" to the link block for notes and canvases and to the file block for attachments
+func (b *bodyConverter) embed(link string) *model.Block {
+	target, _, display := parseWikilink(link)
+	if id, ok := b.vault.findObject(target); ok {
+		return &model.Block{
+			Id: bson.NewObjectId().Hex(),
+			Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{
+				TargetBlockId: id,
+				Style:         model.BlockContentLink_Page,
+			}},
+		}
+	}
+	if sourcePath, ok := b.vault.findAttachment(target); ok {
+		filePath, err := b.vault.provideFile(sourcePath)
+		if err == nil {
+			return &model.Block{
+				Id:      bson.NewObjectId().Hex(),
+				Content: anymark.ConvertTextToFile(filePath),
+			}
+		}
+		log.Warnf("failed to provide attachment %s: %v", filepath.Base(sourcePath), err)
+	}
+	return textBlock(display)
+}
+
+// renderLinks replaces wikilinks with markdown links. Links to missing notes are replaced with their text
+func (b *bodyConverter) renderLinks(line string) string {
+	return wikilinkRegexp.ReplaceAllStringFunc(line, func(raw string) string {
+		link := wikilinkRegexp.FindStringSubmatch(raw)[2]
+		target, hasAlias, display := parseWikilink(link)
+		id, ok := b.vault.findObject(target)
+		if !ok {
+			return anymark.Escape(display)
+		}
+		scheme := mentionScheme
+		if hasAlias || display != target {
+			// mentions show names of objects, so links with custom text are kept as links to objects
+			scheme = objectScheme
+		}
+		return "[" + anymark.Escape(display) + "](" + scheme + id + ")"
+	})
+}
+
+// parseWikilink splits the link to the target note and the text shown in Obsidian: the alias of the link,
+// "Note > Heading" for links to headings and the name of the note otherwise
+func parseWikilink(link string) (target string, hasAlias bool, display string) {
+	link = strings.ReplaceAll(link, `\|`, "|")
+	target, alias, hasAlias := strings.Cut(link, "|")
+	target, anchor, _ := strings.Cut(target, "#")
+	target, anchor = strings.TrimSpace(target), strings.TrimSpace(anchor)
+	switch {
+	case hasAlias && strings.TrimSpace(alias) != "":
+		return target, true, strings.TrimSpace(alias)
+	case anchor != "" && target != "":
+		return target, false, target + " > " + anchor
+	case anchor != "":
+		return target, false, anchor
+	default:
+		return target, false, target
+	}
+}
+
+// wikilinkTarget returns the target of the value, if the value is a single wikilink
+func wikilinkTarget(value string) (string, bool) {
+	m := wikilinkRegexp.FindStringSubmatchIndex(strings.TrimSpace(value))
+	if m == nil || m[0] != 0 || m[1] != len(strings.TrimSpace(value)) || m[2] != m[3] {
+		return "", false
+	}
+	target, _, _ := parseWikilink(strings.TrimSpace(value)[m[4]:m[5]])
+	return target, true
+}
+
+// convertLinkMarks turns links made of wikilinks and markdown links to notes into marks with object ids
+func (b *bodyConverter) convertLinkMarks(block *model.Block) {
+	for _, mark := range block.GetText().GetMarks().GetMarks() {
+		if mark.Type != model.BlockContentTextMark_Link {
+			continue
+		}
+		if id, ok := strings.CutPrefix(mark.Param, mentionScheme); ok {
+			mark.Type = model.BlockContentTextMark_Mention
+			mark.Param = id
+		} else if id, ok = strings.CutPrefix(mark.Param, objectScheme); ok {
+			mark.Type = model.BlockContentTextMark_Object
+			mark.Param = id
+		} else if !anymark.IsUrl(mark.Param) {
+			if id, ok = b.vault.findObject(b.vault.sourceRelPath(mark.Param)); ok {
+				mark.Type = model.BlockContentTextMark_Object
+				mark.Param = id
+			}
+		}
+	}
+}
+
+// convertFileBlock replaces paths of images of the note with local paths of attachments
+func (b *bodyConverter) convertFileBlock(block *model.Block) {
+	file := block.GetFile()
+	if file == nil || file.Name == "" || anymark.IsUrl(file.Name) {
+		return
+	}
+	sourcePath, ok := b.vault.findAttachment(b.vault.sourceRelPath(file.Name))
+	if !ok {
+		return
+	}
+	filePath, err := b.vault.provideFile(sourcePath)
+	if err != nil {
+		log.Warnf("failed to provide attachment %s: %v", filepath.Base(sourcePath), err)
+		return
+	}
+	file.Name = filePath
+}
+
+func textBlock(text string) *model.Block {
+	return &model.Block{
+		Id:      bson.NewObjectId().Hex(),
+		Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: text}},
+	}
+}
+
+// topLevelIds returns ids of blocks, which are not children of other blocks
+func topLevelIds(blocks []*model.Block) []string {
+	children := make(map[string]bool, len(blocks))
+	for _, b := range blocks {
+		for _, id := range b.ChildrenIds {
+			children[id] = true
+		}
+	}
+	ids := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		if !children[b.Id] {
+			ids = append(ids, b.Id)
+		}
+	}
+	return ids
+}
+
+func firstTextBlock(blocks []*model.Block, rootIds []string) *model.Block {
+	if len(rootIds) == 0 {
+		return nil
+	}
+	for _, b := range blocks {
+		if b.Id == rootIds[0] && b.GetText() != nil {
+			return b
+		}
+	}
+	return nil
+}
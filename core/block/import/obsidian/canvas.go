@@ -0,0 +1,135 @@
+package obsidian
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	canvasNodeText  = "text"
+	canvasNodeFile  = "file"
+	canvasNodeLink  = "link"
+	canvasNodeGroup = "group"
+)
+
+// canvas is the JSON Canvas document, see https://jsoncanvas.org. Edges are not imported
+type canvas struct {
+	Nodes []canvasNode `json:"nodes"`
+}
+
+type canvasNode struct {
+	Id    string  `json:"id"`
+	Type  string  `json:"type"`
+	X     float64 `json:"x"`
+	Y     float64 `json:"y"`
+	Text  string  `json:"text"`
+	File  string  `json:"file"`
+	Url   string  `json:"url"`
+	Label string  `json:"label"`
+}
+
+// canvasSnapshot converts the canvas, which consists of notes only, to the collection of these notes.
+// Other canvases become pages, where nodes go from top to bottom and from left to right
+func (o *Obsidian) canvasSnapshot(c *noteConverter, cf *canvasFile) (*common.Snapshot, error) {
+	var doc canvas
+	if err := json.Unmarshal(cf.content, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", errWrongCanvasFormat, err)
+	}
+	if noteIds, ok := canvasNotes(c.vault, doc.Nodes); ok {
+		collection := common.NewImportCollection(o.collectionService)
+		settings := common.NewImportCollectionSetting(
+			common.WithCollectionName(cf.title),
+			common.WithTargetObjects(noteIds),
+		)
+		snapshot, err := collection.MakeImportCollection(settings)
+		if err != nil {
+			return nil, err
+		}
+		// links to the canvas are resolved before the collection is made
+		snapshot.Id = cf.id
+		snapshot.FileName = cf.path
+		snapshot.Snapshot.Data.Details.SetString(bundle.RelationKeySourceFilePath, cf.path)
+		return snapshot, nil
+	}
+
+	nodes := doc.Nodes
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].Y != nodes[j].Y {
+			return nodes[i].Y < nodes[j].Y
+		}
+		return nodes[i].X < nodes[j].X
+	})
+	body := newBodyConverter(c, cf.path)
+	var blocks []*model.Block
+	for _, node := range nodes {
+		blocks = append(blocks, canvasNodeBlocks(body, node)...)
+	}
+
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, cf.title)
+	details.SetString(bundle.RelationKeySourceFilePath, cf.path)
+	details.SetInt64(bundle.RelationKeyResolvedLayout, int64(model.ObjectType_basic))
+	return &common.Snapshot{
+		Id:       cf.id,
+		FileName: cf.path,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypePage,
+			Data: &common.StateSnapshot{
+				Blocks:      blocks,
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyPage.String()},
+			},
+		},
+	}, nil
+}
+
+// canvasNotes returns ids of notes, if all nodes of the canvas are notes of the vault
+func canvasNotes(v *vault, nodes []canvasNode) ([]string, bool) {
+	if len(nodes) == 0 {
+		return nil, false
+	}
+	ids := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		if node.Type != canvasNodeFile {
+			return nil, false
+		}
+		n := v.findNote(node.File)
+		if n == nil {
+			return nil, false
+		}
+		ids = append(ids, n.id)
+	}
+	return ids, true
+}
+
+func canvasNodeBlocks(body *bodyConverter, node canvasNode) []*model.Block {
+	switch node.Type {
+	case canvasNodeText:
+		blocks, _ := body.convertText(node.Text)
+		return blocks
+	case canvasNodeFile:
+		return []*model.Block{body.embed(node.File)}
+	case canvasNodeLink:
+		return []*model.Block{{
+			Id:      bson.NewObjectId().Hex(),
+			Content: &model.BlockContentOfBookmark{Bookmark: &model.BlockContentBookmark{Url: node.Url}},
+		}}
+	case canvasNodeGroup:
+		if node.Label == "" {
+			return nil
+		}
+		header := textBlock(node.Label)
+		header.GetText().Style = model.BlockContentText_Header3
+		return []*model.Block{header}
+	}
+	return nil
+}
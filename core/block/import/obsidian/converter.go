@@ -0,0 +1,154 @@
+package obsidian
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	Name               = "Obsidian"
+	rootCollectionName = "Obsidian Import"
+	numberOfStages     = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+)
+
+var (
+	log                  = logging.Logger("import-obsidian")
+	errWrongCanvasFormat = errors.New("wrong canvas format")
+)
+
+// Obsidian imports vaults of Obsidian: notes with their properties, wikilinks, embeds and callouts, attachments and canvases
+type Obsidian struct {
+	collectionService *collection.Service
+	tempDirProvider   core.TempDirProvider
+}
+
+func New(collectionService *collection.Service, tempDirProvider core.TempDirProvider) common.Converter {
+	return &Obsidian{collectionService: collectionService, tempDirProvider: tempDirProvider}
+}
+
+func (o *Obsidian) Name() string {
+	return Name
+}
+
+func (o *Obsidian) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*common.Response, *common.ConvertError) {
+	paths := o.getParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from files")
+	allErrors := common.NewError(req.Mode)
+	objects := newObjectStore()
+	var (
+		snapshots []*common.Snapshot
+		targetIds []string
+	)
+	for _, path := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(common.ErrCancel)
+			return nil, allErrors
+		}
+		pathSnapshots, pathTargetIds := o.readVault(path, objects, len(paths), allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+		snapshots = append(snapshots, pathSnapshots...)
+		targetIds = append(targetIds, pathTargetIds...)
+	}
+	snapshots = append(snapshots, objects.snapshots()...)
+
+	rootCollection := common.NewImportCollection(o.collectionService)
+	settings := common.NewImportCollectionSetting(
+		common.WithCollectionName(rootCollectionName),
+		common.WithTargetObjects(targetIds),
+		common.WithAddDate(),
+		common.WithRelations(),
+	)
+	rootCollectionSnapshot, err := rootCollection.MakeImportCollection(settings)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCollectionSnapshot != nil {
+		snapshots = append(snapshots, rootCollectionSnapshot)
+		rootCollectionID = rootCollectionSnapshot.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	response := &common.Response{
+		Snapshots:            snapshots,
+		RootObjectID:         rootCollectionID,
+		RootObjectWidgetType: model.BlockContentWidget_CompactList,
+	}
+	if allErrors.IsEmpty() {
+		return response, nil
+	}
+	return response, allErrors
+}
+
+// getParams returns paths of vaults. Clients, which used the markdown import for Obsidian, pass paths in markdown params
+func (o *Obsidian) getParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetObsidianParams(); p != nil {
+		return p.Path
+	}
+	if p := req.GetMarkdownParams(); p != nil {
+		return p.Path
+	}
+	return nil
+}
+
+// readVault converts notes and canvases of the vault. Files are converted before the source is closed,
+// because attachments are extracted from archives on demand
+func (o *Obsidian) readVault(path string, objects *objectStore, pathsCount int, allErrors *common.ConvertError) ([]*common.Snapshot, []string) {
+	importSource := source.GetSource(path)
+	defer importSource.Close()
+	if err := importSource.Initialize(path); err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, model.Import_Obsidian) {
+			return nil, nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{noteExt, canvasExt}) == 0 {
+		allErrors.Add(common.ErrorBySourceType(importSource))
+		return nil, nil
+	}
+	v := newVault(path, importSource, o.tempDirProvider)
+	if err := v.read(); err != nil {
+		allErrors.Add(err)
+		return nil, nil
+	}
+	c := newNoteConverter(v, objects)
+	var (
+		snapshots []*common.Snapshot
+		targetIds []string
+	)
+	for _, n := range v.notes {
+		snapshots = append(snapshots, c.noteSnapshot(n))
+		targetIds = append(targetIds, n.id)
+	}
+	for _, cf := range v.canvases {
+		snapshot, err := o.canvasSnapshot(c, cf)
+		if err != nil {
+			allErrors.Add(fmt.Errorf("file %s: %w", filepath.Base(cf.path), err))
+			if allErrors.ShouldAbortImport(pathsCount, model.Import_Obsidian) {
+				return nil, nil
+			}
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+		targetIds = append(targetIds, cf.id)
+	}
+	return snapshots, targetIds
+}
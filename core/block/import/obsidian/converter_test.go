@@ -0,0 +1,158 @@
+package obsidian
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/test"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func markParams(b *model.Block, markType model.BlockContentTextMarkType) []string {
+	var params []string
+	for _, mark := range b.GetText().GetMarks().GetMarks() {
+		if mark.Type == markType {
+			params = append(params, mark.Param)
+		}
+	}
+	return params
+}
+
+func TestObsidian_GetSnapshots(t *testing.T) {
+	t.Run("vault directory", func(t *testing.T) {
+		// given
+		o := New(nil, nil)
+
+		// when
+		resp, ce := o.GetSnapshots(context.Background(), test.NewImportRequest(model.Import_Obsidian, pb.RpcObjectImportRequest_ALL_OR_NOTHING, filepath.Join("testdata", "vault")), process.NewNoOp())
+
+		// then
+		require.Nil(t, ce)
+		// 3 notes, 2 canvases, 3 relations, 4 options and root collection. Notes of trash and ignored folders are skipped
+		require.Len(t, resp.Snapshots, 13)
+		snapshots := resp.Snapshots
+		alpha := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "Alpha")
+		alice := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "Alice")
+		roadmap := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "Roadmap")
+
+		details := alpha.Snapshot.Data.Details
+		tags := details.GetStringList(bundle.RelationKeyTag)
+		require.Len(t, tags, 3)
+		for i, name := range []string{"review", "project", "project/alpha"} {
+			assert.Equal(t, test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypeRelationOption, name).Id, tags[i])
+		}
+		active := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypeRelationOption, "active")
+		assert.Equal(t, []string{active.Id}, details.GetStringList(bundle.RelationKeyStatus))
+		assert.Equal(t, float64(4), details.GetFloat64(test.RelationKey(t, snapshots, "rating")))
+		assert.Equal(t, []string{alice.Id}, details.GetStringList(test.RelationKey(t, snapshots, "owner")))
+		assert.NotZero(t, details.GetInt64(test.RelationKey(t, snapshots, "due")))
+		assert.Equal(t, int64(model.RelationFormat_number),
+			test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypeRelation, "rating").Snapshot.Data.Details.GetInt64(bundle.RelationKeyRelationFormat))
+
+		led := test.FindBlock(t, alpha, "Alpha is led by our lead and described in Roadmap > Goals.")
+		assert.Equal(t, []string{alice.Id, roadmap.Id}, markParams(led, model.BlockContentTextMark_Object))
+		callout := test.FindBlock(t, alpha, "Check Alice")
+		assert.Equal(t, model.BlockContentText_Callout, callout.GetText().Style)
+		assert.Equal(t, "⚠️", callout.GetText().IconEmoji)
+		assert.Equal(t, []string{alice.Id}, markParams(callout, model.BlockContentTextMark_Mention))
+		assert.Equal(t, []string{test.FindBlock(t, alpha, "Deadline is close").Id, test.FindBlock(t, alpha, "first item").Id}, callout.ChildrenIds)
+		see := test.FindBlock(t, alpha, "See roadmap and #review tag.")
+		assert.Equal(t, []string{roadmap.Id}, markParams(see, model.BlockContentTextMark_Object))
+		assert.Empty(t, test.FindBlock(t, alpha, "Missing note").GetText().GetMarks().GetMarks())
+
+		var embeddedFile, embeddedNote bool
+		for _, b := range alpha.Snapshot.Data.Blocks {
+			if file := b.GetFile(); file != nil {
+				embeddedFile = true
+				assert.Equal(t, model.BlockContentFile_Image, file.Type)
+				assert.True(t, filepath.IsAbs(file.Name))
+				assert.Equal(t, filepath.Join("attachments", "diagram.png"), filepath.Join(filepath.Base(filepath.Dir(file.Name)), filepath.Base(file.Name)))
+			}
+			if link := b.GetLink(); link != nil {
+				embeddedNote = true
+				assert.Equal(t, roadmap.Id, link.TargetBlockId)
+			}
+			assert.NotContains(t, b.GetText().GetText(), "hidden note")
+		}
+		assert.True(t, embeddedFile)
+		assert.True(t, embeddedNote)
+		// link by the alias
+		assert.Equal(t, []string{alpha.Id}, markParams(test.FindBlock(t, alice, "Alice works on Project A."), model.BlockContentTextMark_Mention))
+
+		board := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "Board")
+		blocks := board.Snapshot.Data.Blocks
+		require.Len(t, blocks, 5)
+		assert.Equal(t, "Plan", blocks[0].GetText().Text)
+		assert.Equal(t, model.BlockContentText_Header3, blocks[0].GetText().Style)
+		assert.Equal(t, []string{alice.Id}, markParams(blocks[1], model.BlockContentTextMark_Mention))
+		assert.Equal(t, roadmap.Id, blocks[2].GetLink().TargetBlockId)
+		assert.NotNil(t, blocks[3].GetFile())
+		assert.Equal(t, "https://obsidian.md", blocks[4].GetBookmark().Url)
+
+		notes := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "Notes")
+		assert.Equal(t, []string{alpha.Id, alice.Id}, pbtypes.GetStringList(notes.Snapshot.Data.Collections, template.CollectionStoreKey))
+
+		root := snapshots[len(snapshots)-1]
+		assert.Equal(t, root.Id, resp.RootObjectID)
+		assert.Len(t, pbtypes.GetStringList(root.Snapshot.Data.Collections, template.CollectionStoreKey), 5)
+	})
+
+	t.Run("broken canvas is skipped", func(t *testing.T) {
+		// given
+		o := New(nil, nil)
+
+		// when
+		resp, ce := o.GetSnapshots(context.Background(), test.NewImportRequest(model.Import_Obsidian, pb.RpcObjectImportRequest_IGNORE_ERRORS, filepath.Join("testdata", "broken")), process.NewNoOp())
+
+		// then
+		require.NotNil(t, ce)
+		assert.ErrorContains(t, ce.Error(), errWrongCanvasFormat.Error())
+		test.FindSnapshot(t, resp.Snapshots, smartblock.SmartBlockTypePage, "Note")
+	})
+
+	t.Run("no notes", func(t *testing.T) {
+		// given
+		o := New(nil, nil)
+
+		// when
+		_, ce := o.GetSnapshots(context.Background(), test.NewImportRequest(model.Import_Obsidian, pb.RpcObjectImportRequest_IGNORE_ERRORS, t.TempDir()), process.NewNoOp())
+
+		// then
+		require.NotNil(t, ce)
+		assert.ErrorIs(t, ce.GetResultError(model.Import_Obsidian), common.ErrFileImportNoObjectsInDirectory)
+	})
+}
+
+func TestParseWikilink(t *testing.T) {
+	for _, tc := range []struct {
+		link, target, display string
+		hasAlias              bool
+	}{
+		{link: "Note", target: "Note", display: "Note"},
+		{link: "folder/Note|alias", target: "folder/Note", display: "alias", hasAlias: true},
+		{link: `Note\|alias`, target: "Note", display: "alias", hasAlias: true},
+		{link: "Note#Heading", target: "Note", display: "Note > Heading"},
+		{link: "#Heading", target: "", display: "Heading"},
+	} {
+		t.Run(tc.link, func(t *testing.T) {
+			// when
+			target, hasAlias, display := parseWikilink(tc.link)
+
+			// then
+			assert.Equal(t, tc.target, target)
+			assert.Equal(t, tc.hasAlias, hasAlias)
+			assert.Equal(t, tc.display, display)
+		})
+	}
+}
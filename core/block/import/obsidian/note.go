@@ -0,0 +1,257 @@
+package obsidian
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/filetime"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/schema/yaml"
+)
+
+// propertyFormats maps types of properties from .obsidian/types.json to relation formats
+var propertyFormats = map[string]model.RelationFormat{
+	"text":      model.RelationFormat_shorttext,
+	"multitext": model.RelationFormat_tag,
+	"number":    model.RelationFormat_number,
+	"checkbox":  model.RelationFormat_checkbox,
+	"date":      model.RelationFormat_date,
+	"datetime":  model.RelationFormat_date,
+}
+
+// ignoredProperties are properties of Obsidian, which only affect the rendering of notes in Obsidian
+var ignoredProperties = map[string]bool{
+	"cssclasses": true,
+	"cssclass":   true,
+}
+
+func isAliasProperty(name string) bool {
+	name = strings.ToLower(name)
+	return name == "aliases" || name == "alias"
+}
+
+func isTagProperty(prop yaml.Property) bool {
+	name := strings.ToLower(prop.Name)
+	return name == propertyTags || name == propertyTag || prop.Key == bundle.RelationKeyTag.String()
+}
+
+// propertyValues returns values of the text or list property
+func propertyValues(prop yaml.Property) []string {
+	if prop.Value.IsStringList() {
+		return prop.Value.StringList()
+	}
+	if s, ok := prop.Value.TryString(); ok && strings.TrimSpace(s) != "" {
+		return []string{s}
+	}
+	if number, ok := prop.Value.TryFloat64(); ok {
+		return []string{strconv.FormatFloat(number, 'f', -1, 64)}
+	}
+	return nil
+}
+
+// noteConverter converts notes and canvases of the vault to snapshots
+type noteConverter struct {
+	vault   *vault
+	objects *objectStore
+}
+
+func newNoteConverter(v *vault, objects *objectStore) *noteConverter {
+	return &noteConverter{vault: v, objects: objects}
+}
+
+func (c *noteConverter) noteSnapshot(n *note) *common.Snapshot {
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, n.title)
+	details.SetString(bundle.RelationKeySourceFilePath, n.path)
+	details.SetInt64(bundle.RelationKeyResolvedLayout, int64(model.ObjectType_basic))
+	createdDate, modifiedDate := filetime.ExtractFileTimes(n.path)
+	if createdDate != 0 {
+		details.SetInt64(bundle.RelationKeyCreatedDate, createdDate)
+	}
+	if modifiedDate != 0 {
+		details.SetInt64(bundle.RelationKeyLastModifiedDate, modifiedDate)
+	}
+
+	body := newBodyConverter(c, n.path)
+	blocks, _ := body.convert(string(n.body))
+	tags := body.tags
+	relationLinks := c.setProperties(n, details, &tags)
+	if len(tags) > 0 {
+		tagIds := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			tagIds = append(tagIds, c.objects.optionId(bundle.RelationKeyTag.String(), tag))
+		}
+		details.SetStringList(bundle.RelationKeyTag, tagIds)
+		relationLinks = append(relationLinks, &model.RelationLink{Key: bundle.RelationKeyTag.String(), Format: model.RelationFormat_tag})
+	}
+
+	return &common.Snapshot{
+		Id:       n.id,
+		FileName: n.path,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypePage,
+			Data: &common.StateSnapshot{
+				Blocks:        blocks,
+				Details:       details,
+				RelationLinks: relationLinks,
+				ObjectTypes:   []string{bundle.TypeKeyPage.String()},
+			},
+		},
+	}
+}
+
+// setProperties sets values of properties of the note. Tags of properties are added to tags found in the text
+func (c *noteConverter) setProperties(n *note, details *domain.Details, tags *[]string) []*model.RelationLink {
+	if n.frontMatter == nil {
+		return nil
+	}
+	properties := n.frontMatter.Properties
+	// properties are parsed from the map, so they are sorted to create relations in the same order on every import
+	sort.Slice(properties, func(i, j int) bool {
+		return properties[i].Name < properties[j].Name
+	})
+	var relationLinks []*model.RelationLink
+	for _, prop := range properties {
+		name := strings.ToLower(prop.Name)
+		switch {
+		case isAliasProperty(name), ignoredProperties[name]:
+			continue
+		case isTagProperty(prop):
+			for _, tag := range propertyValues(prop) {
+				addTag(tags, tag)
+			}
+			continue
+		case bundle.HasRelation(domain.RelationKey(prop.Key)):
+			if link := c.setBundledProperty(prop, details); link != nil {
+				relationLinks = append(relationLinks, link)
+			}
+			continue
+		}
+		rel := c.objects.relation(prop.Name, c.propertyFormat(prop), c.includeTime(prop))
+		if c.setValue(rel.key, rel.format, prop, details) {
+			relationLinks = append(relationLinks, &model.RelationLink{Key: rel.key, Format: rel.format})
+		}
+	}
+	return relationLinks
+}
+
+// setBundledProperty sets properties, which are mapped to bundled relations by the parser, e.g. status or created date
+func (c *noteConverter) setBundledProperty(prop yaml.Property, details *domain.Details) *model.RelationLink {
+	rel, err := bundle.GetRelation(domain.RelationKey(prop.Key))
+	if err != nil {
+		return nil
+	}
+	if !c.setValue(prop.Key, rel.Format, prop, details) {
+		return nil
+	}
+	return &model.RelationLink{Key: prop.Key, Format: rel.Format}
+}
+
+// propertyFormat returns the format set in types of the vault. Properties with links to notes are object relations
+func (c *noteConverter) propertyFormat(prop yaml.Property) model.RelationFormat {
+	values := propertyValues(prop)
+	if len(values) > 0 && (prop.Format == model.RelationFormat_shorttext || prop.Format == model.RelationFormat_longtext ||
+		prop.Format == model.RelationFormat_tag || prop.Format == model.RelationFormat_object) {
+		isObject := true
+		for _, value := range values {
+			if _, ok := wikilinkTarget(value); !ok {
+				isObject = false
+			}
+		}
+		if isObject {
+			return model.RelationFormat_object
+		}
+	}
+	if format, ok := propertyFormats[c.vault.propertyTypes[strings.ToLower(prop.Name)]]; ok {
+		return format
+	}
+	return prop.Format
+}
+
+func (c *noteConverter) includeTime(prop yaml.Property) bool {
+	return prop.IncludeTime || c.vault.propertyTypes[strings.ToLower(prop.Name)] == "datetime"
+}
+
+// setValue converts the value of the property to the format of the relation. Values, which can't be converted, are skipped
+func (c *noteConverter) setValue(key string, format model.RelationFormat, prop yaml.Property, details *domain.Details) bool {
+	relationKey := domain.RelationKey(key)
+	values := propertyValues(prop)
+	switch format {
+	case model.RelationFormat_tag:
+		ids := make([]string, 0, len(values))
+		for _, value := range values {
+			ids = append(ids, c.objects.optionId(key, strings.TrimSpace(value)))
+		}
+		details.SetStringList(relationKey, ids)
+		return len(ids) > 0
+	case model.RelationFormat_status:
+		if len(values) == 0 {
+			return false
+		}
+		details.SetStringList(relationKey, []string{c.objects.optionId(key, strings.TrimSpace(values[0]))})
+	case model.RelationFormat_object:
+		ids := make([]string, 0, len(values))
+		for _, value := range values {
+			target := value
+			if linkTarget, ok := wikilinkTarget(value); ok {
+				target = linkTarget
+			}
+			if id, ok := c.vault.findObject(target); ok {
+				ids = append(ids, id)
+			}
+		}
+		details.SetStringList(relationKey, ids)
+		return len(ids) > 0
+	case model.RelationFormat_date:
+		date, ok := prop.Value.TryInt64()
+		if !ok || prop.Format != model.RelationFormat_date {
+			return false
+		}
+		details.SetInt64(relationKey, date)
+	case model.RelationFormat_number:
+		number, ok := prop.Value.TryFloat64()
+		if !ok {
+			return false
+		}
+		details.SetFloat64(relationKey, number)
+	case model.RelationFormat_checkbox:
+		checked, ok := prop.Value.TryBool()
+		if !ok {
+			return false
+		}
+		details.SetBool(relationKey, checked)
+	default:
+		if len(values) == 0 {
+			return false
+		}
+		details.SetString(relationKey, strings.Join(values, ", "))
+	}
+	return true
+}
+
+// addTag adds the tag and its parents. Nested tag "project/alpha" is added as "project" and "project/alpha"
+func addTag(tags *[]string, tag string) {
+	tag = strings.Trim(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(tag), "#")), "/")
+	if tag == "" {
+		return
+	}
+	parts := strings.Split(tag, "/")
+	for i := range parts {
+		name := strings.Join(parts[:i+1], "/")
+		var exists bool
+		for _, existing := range *tags {
+			if strings.EqualFold(existing, name) {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			*tags = append(*tags, name)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package obsidian
+
+import (
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/constant"
+)
+
+// relation is a relation made of the property of notes. Its format is taken from the first note with the property,
+// unless the type of the property is set in the vault
+type relation struct {
+	name        string
+	key         string
+	format      model.RelationFormat
+	includeTime bool
+}
+
+// objectStore keeps relations and options shared by notes of all imported vaults
+type objectStore struct {
+	relations     map[string]*relation
+	relationNames []string
+	options       map[string]*common.Snapshot
+	optionNames   []string
+}
+
+func newObjectStore() *objectStore {
+	return &objectStore{
+		relations: map[string]*relation{},
+		options:   map[string]*common.Snapshot{},
+	}
+}
+
+func (s *objectStore) relation(name string, format model.RelationFormat, includeTime bool) *relation {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	if rel, ok := s.relations[normalized]; ok {
+		return rel
+	}
+	rel := &relation{name: strings.TrimSpace(name), key: bson.NewObjectId().Hex(), format: format, includeTime: includeTime}
+	s.relations[normalized] = rel
+	s.relationNames = append(s.relationNames, normalized)
+	return rel
+}
+
+// optionId returns the id of the option of tag or status relation, the option is created on the first use
+func (s *objectStore) optionId(relationKey, name string) string {
+	optionKey := relationKey + "/" + name
+	if option, ok := s.options[optionKey]; ok {
+		return option.Id
+	}
+	id := bson.NewObjectId().Hex()
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, name)
+	details.SetString(bundle.RelationKeyRelationKey, relationKey)
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relationOption))
+	details.SetString(bundle.RelationKeyRelationOptionColor, constant.RandomOptionColor().String())
+	if uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelationOption, id); err == nil {
+		details.SetString(bundle.RelationKeyUniqueKey, uniqueKey.Marshal())
+	} else {
+		log.Warnf("failed to create unique key for option: %v", err)
+	}
+	s.options[optionKey] = &common.Snapshot{
+		Id: id,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypeRelationOption,
+			Data: &common.StateSnapshot{
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyRelationOption.String()},
+				Key:         id,
+			},
+		},
+	}
+	s.optionNames = append(s.optionNames, optionKey)
+	return id
+}
+
+// snapshots returns snapshots of relations and their options
+func (s *objectStore) snapshots() []*common.Snapshot {
+	snapshots := make([]*common.Snapshot, 0, len(s.relationNames)+len(s.optionNames))
+	for _, name := range s.relationNames {
+		snapshots = append(snapshots, relationSnapshot(s.relations[name]))
+	}
+	for _, name := range s.optionNames {
+		snapshots = append(snapshots, s.options[name])
+	}
+	return snapshots
+}
+
+func relationSnapshot(rel *relation) *common.Snapshot {
+	details := domain.NewDetails()
+	details.SetInt64(bundle.RelationKeyRelationFormat, int64(rel.format))
+	details.SetString(bundle.RelationKeyName, rel.name)
+	details.SetString(bundle.RelationKeyRelationKey, rel.key)
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relation))
+	if rel.format == model.RelationFormat_date {
+		details.SetBool(bundle.RelationKeyRelationFormatIncludeTime, rel.includeTime)
+	}
+	id := rel.key
+	if uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelation, rel.key); err == nil {
+		id = uniqueKey.Marshal()
+		details.SetString(bundle.RelationKeyId, id)
+	} else {
+		log.Warnf("failed to create unique key for relation: %v", err)
+	}
+	return &common.Snapshot{
+		Id: id,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypeRelation,
+			Data: &common.StateSnapshot{
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyRelation.String()},
+				Key:         rel.key,
+			},
+		},
+	}
+}
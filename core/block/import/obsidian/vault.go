@@ -0,0 +1,356 @@
+package obsidian
+
+import (
+	"encoding/json"
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/schema/yaml"
+)
+
+const (
+	configDir    = ".obsidian"
+	trashDir     = ".trash"
+	appConfig    = "app.json"
+	typesConfig  = "types.json"
+	noteExt      = ".md"
+	canvasExt    = ".canvas"
+	propertyTags = "tags"
+	propertyTag  = "tag"
+)
+
+// appSettings are the settings of the vault from .obsidian/app.json, which affect the import
+type appSettings struct {
+	AttachmentFolderPath string   `json:"attachmentFolderPath"`
+	UserIgnoreFilters    []string `json:"userIgnoreFilters"`
+}
+
+// typesSettings are the types of properties from .obsidian/types.json
+type typesSettings struct {
+	Types map[string]string `json:"types"`
+}
+
+// note is a markdown file of the vault
+type note struct {
+	path        string // path of the file in the import source
+	relPath     string // slash separated path relative to the root of the vault
+	title       string
+	content     []byte
+	body        []byte
+	frontMatter *yaml.ParseResult
+	aliases     []string
+	id          string
+}
+
+// canvasFile is a JSON Canvas file of the vault
+type canvasFile struct {
+	path    string
+	relPath string
+	title   string
+	content []byte
+	id      string
+}
+
+// vault indexes notes and attachments of the Obsidian vault to resolve links the way Obsidian does:
+// by the path relative to the vault root, by the path suffix and by the name of the file
+type vault struct {
+	importPath      string
+	source          source.Source
+	tempDirProvider core.TempDirProvider
+	root            string
+
+	settings      appSettings
+	propertyTypes map[string]string
+
+	notes         []*note
+	canvases      []*canvasFile
+	notesByPath   map[string]*note
+	notesByName   map[string]*note
+	attachments   map[string]string // lowercased relative path -> path in the source
+	attachByName  map[string]string // lowercased file name -> path in the source
+	providedFiles map[string]string
+}
+
+func newVault(importPath string, importSource source.Source, tempDirProvider core.TempDirProvider) *vault {
+	return &vault{
+		importPath:      importPath,
+		source:          importSource,
+		tempDirProvider: tempDirProvider,
+		propertyTypes:   map[string]string{},
+		notesByPath:     map[string]*note{},
+		notesByName:     map[string]*note{},
+		attachments:     map[string]string{},
+		attachByName:    map[string]string{},
+		providedFiles:   map[string]string{},
+	}
+}
+
+// read reads all files of the vault. Notes and canvases are read to memory, attachments are only indexed
+func (v *vault) read() error {
+	var fileNames []string
+	contents := map[string][]byte{}
+	err := v.source.Iterate(func(fileName string, fileReader io.ReadCloser) bool {
+		slashPath := filepath.ToSlash(fileName)
+		fileNames = append(fileNames, slashPath)
+		ext := strings.ToLower(path.Ext(slashPath))
+		if ext == noteExt || ext == canvasExt || isConfigFile(slashPath) {
+			content, err := io.ReadAll(fileReader)
+			if err != nil {
+				log.Warnf("failed to read file %s: %v", filepath.Base(fileName), err)
+				return true
+			}
+			contents[slashPath] = content
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	// files are iterated in random order, so they are sorted to keep the result of the import stable
+	sort.Strings(fileNames)
+	v.root = vaultRoot(fileNames)
+	v.readSettings(contents)
+	for _, fileName := range fileNames {
+		relPath := v.relPath(fileName)
+		if v.isIgnored(relPath) {
+			continue
+		}
+		sourcePath := filepath.FromSlash(fileName)
+		switch strings.ToLower(path.Ext(relPath)) {
+		case noteExt:
+			v.addNote(&note{path: sourcePath, relPath: relPath, title: fileTitle(relPath), content: contents[fileName]})
+		case canvasExt:
+			v.canvases = append(v.canvases, &canvasFile{
+				path:    sourcePath,
+				relPath: relPath,
+				title:   fileTitle(relPath),
+				content: contents[fileName],
+				id:      uuid.New().String(),
+			})
+		default:
+			v.attachments[strings.ToLower(relPath)] = sourcePath
+			if _, ok := v.attachByName[strings.ToLower(path.Base(relPath))]; !ok {
+				v.attachByName[strings.ToLower(path.Base(relPath))] = sourcePath
+			}
+		}
+	}
+	return nil
+}
+
+func isConfigFile(slashPath string) bool {
+	return strings.HasSuffix(slashPath, configDir+"/"+appConfig) || strings.HasSuffix(slashPath, configDir+"/"+typesConfig)
+}
+
+// vaultRoot returns the directory with .obsidian folder or the common directory of all files
+func vaultRoot(fileNames []string) string {
+	for _, fileName := range fileNames {
+		if fileName == configDir || strings.HasPrefix(fileName, configDir+"/") {
+			return ""
+		}
+		if i := strings.Index(fileName, "/"+configDir+"/"); i >= 0 {
+			return fileName[:i]
+		}
+	}
+	if len(fileNames) == 0 {
+		return ""
+	}
+	root := path.Dir(fileNames[0])
+	for _, fileName := range fileNames[1:] {
+		for root != "." && root != "/" && !strings.HasPrefix(fileName, root+"/") {
+			root = path.Dir(root)
+		}
+	}
+	if root == "." {
+		return ""
+	}
+	return root
+}
+
+func (v *vault) relPath(fileName string) string {
+	if v.root == "" {
+		return strings.TrimPrefix(fileName, "./")
+	}
+	return strings.TrimPrefix(fileName, v.root+"/")
+}
+
+func (v *vault) readSettings(contents map[string][]byte) {
+	if content, ok := contents[v.configPath(appConfig)]; ok {
+		if err := json.Unmarshal(content, &v.settings); err != nil {
+			log.Warnf("failed to parse %s: %v", appConfig, err)
+		}
+	}
+	if content, ok := contents[v.configPath(typesConfig)]; ok {
+		var types typesSettings
+		if err := json.Unmarshal(content, &types); err != nil {
+			log.Warnf("failed to parse %s: %v", typesConfig, err)
+		}
+		for name, propertyType := range types.Types {
+			v.propertyTypes[strings.ToLower(name)] = propertyType
+		}
+	}
+}
+
+func (v *vault) configPath(name string) string {
+	if v.root == "" {
+		return configDir + "/" + name
+	}
+	return v.root + "/" + configDir + "/" + name
+}
+
+// isIgnored reports whether the file is in service folders of Obsidian or excluded by the user in settings of the vault
+func (v *vault) isIgnored(relPath string) bool {
+	for _, dir := range []string{configDir, trashDir} {
+		if relPath == dir || strings.HasPrefix(relPath, dir+"/") {
+			return true
+		}
+	}
+	for _, filter := range v.settings.UserIgnoreFilters {
+		if filter = strings.TrimPrefix(filter, "/"); filter != "" && strings.HasPrefix(relPath, filter) {
+			return true
+		}
+	}
+	return false
+}
+
+func fileTitle(relPath string) string {
+	name := path.Base(relPath)
+	return strings.TrimSuffix(name, path.Ext(name))
+}
+
+func (v *vault) addNote(n *note) {
+	n.id = uuid.New().String()
+	n.body = n.content
+	frontMatter, body, err := yaml.ExtractYAMLFrontMatter(n.content)
+	if err == nil && len(frontMatter) > 0 {
+		n.body = body
+		if n.frontMatter, err = yaml.ParseYAMLFrontMatter(frontMatter); err != nil {
+			log.Warnf("failed to parse properties of note %s: %v", n.title, err)
+		}
+	}
+	if n.frontMatter != nil {
+		for _, prop := range n.frontMatter.Properties {
+			if isAliasProperty(prop.Name) {
+				n.aliases = append(n.aliases, propertyValues(prop)...)
+			}
+		}
+	}
+	v.notes = append(v.notes, n)
+	v.notesByPath[strings.ToLower(strings.TrimSuffix(n.relPath, path.Ext(n.relPath)))] = n
+	v.registerName(n.title, n)
+	for _, alias := range n.aliases {
+		v.registerName(alias, n)
+	}
+}
+
+func (v *vault) registerName(name string, n *note) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if _, ok := v.notesByName[name]; name != "" && !ok {
+		v.notesByName[name] = n
+	}
+}
+
+// findNote resolves the target of the link to the note. The target is the path relative to the vault root,
+// the end of the path or the name of the note or its alias
+func (v *vault) findNote(target string) *note {
+	target = strings.ToLower(strings.TrimPrefix(filepath.ToSlash(strings.TrimSpace(target)), "/"))
+	target = strings.TrimSuffix(target, noteExt)
+	if target == "" {
+		return nil
+	}
+	if n, ok := v.notesByPath[target]; ok {
+		return n
+	}
+	if !strings.Contains(target, "/") {
+		return v.notesByName[target]
+	}
+	for _, n := range v.notes {
+		if strings.HasSuffix(strings.ToLower(strings.TrimSuffix(n.relPath, path.Ext(n.relPath))), "/"+target) {
+			return n
+		}
+	}
+	return nil
+}
+
+// findObject returns the id of the note or the canvas, which is the target of the link
+func (v *vault) findObject(target string) (string, bool) {
+	if n := v.findNote(target); n != nil {
+		return n.id, true
+	}
+	if cf := v.findCanvas(target); cf != nil {
+		return cf.id, true
+	}
+	return "", false
+}
+
+func (v *vault) findCanvas(target string) *canvasFile {
+	target = strings.ToLower(strings.TrimPrefix(filepath.ToSlash(strings.TrimSpace(target)), "/"))
+	if !strings.HasSuffix(target, canvasExt) {
+		return nil
+	}
+	for _, cf := range v.canvases {
+		relPath := strings.ToLower(cf.relPath)
+		if relPath == target || strings.HasSuffix(relPath, "/"+target) {
+			return cf
+		}
+	}
+	return nil
+}
+
+// findAttachment returns the path of the attachment in the import source. Attachments are looked up by the path
+// relative to the vault root, in the attachment folder of the vault and by the name of the file
+func (v *vault) findAttachment(target string) (string, bool) {
+	target = strings.ToLower(strings.TrimPrefix(filepath.ToSlash(strings.TrimSpace(target)), "/"))
+	if target == "" {
+		return "", false
+	}
+	if p, ok := v.attachments[target]; ok {
+		return p, true
+	}
+	if folder := strings.Trim(v.settings.AttachmentFolderPath, "/."); folder != "" {
+		if p, ok := v.attachments[strings.ToLower(folder)+"/"+target]; ok {
+			return p, true
+		}
+	}
+	if strings.Contains(target, "/") {
+		for relPath, p := range v.attachments {
+			if strings.HasSuffix(relPath, "/"+target) {
+				return p, true
+			}
+		}
+	}
+	p, ok := v.attachByName[path.Base(target)]
+	return p, ok
+}
+
+// sourceRelPath returns the path relative to the vault root for the path of the file, which is made by markdown parser
+func (v *vault) sourceRelPath(fileName string) string {
+	return v.relPath(path.Clean(filepath.ToSlash(fileName)))
+}
+
+// provideFile returns the local path of the attachment. Files of archives are extracted to the temporary directory
+func (v *vault) provideFile(sourcePath string) (string, error) {
+	if provided, ok := v.providedFiles[sourcePath]; ok {
+		return provided, nil
+	}
+	fileName := sourcePath
+	if _, isDirectory := v.source.(*source.Directory); isDirectory {
+		// paths of directories are relative to the working directory, if the import path is relative
+		if absPath, err := filepath.Abs(sourcePath); err == nil {
+			fileName = absPath
+		}
+	}
+	provided, _, err := common.ProvideFileName(fileName, v.source, v.importPath, v.tempDirProvider)
+	if err != nil {
+		return "", err
+	}
+	v.providedFiles[sourcePath] = provided
+	return provided, nil
+}
@@ -0,0 +1,128 @@
+package outliner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+
+var (
+	log            = logging.Logger("import-outliner")
+	errWrongFormat = errors.New("wrong export format")
+)
+
+// pageReader reads pages from a file of the export. Files, which are not part of the graph, are skipped
+type pageReader interface {
+	readPages(fileName string, r io.Reader) ([]*page, error)
+}
+
+// converter imports graphs of outliners, where pages consist of nested bullets referencing each other
+type converter struct {
+	name               string
+	importType         model.ImportType
+	extension          string
+	rootCollectionName string
+	getPaths           func(req *pb.RpcObjectImportRequest) []string
+	reader             pageReader
+	collectionService  *collection.Service
+}
+
+func (c *converter) Name() string {
+	return c.name
+}
+
+func (c *converter) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*common.Response, *common.ConvertError) {
+	paths := c.getPaths(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from files")
+	allErrors := common.NewError(req.Mode)
+	g := newGraph(c.name)
+	for _, path := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(common.ErrCancel)
+			return nil, allErrors
+		}
+		c.readPath(g, path, len(paths), allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	snapshots, pageIds := g.snapshots()
+
+	rootCollection := common.NewImportCollection(c.collectionService)
+	settings := common.NewImportCollectionSetting(
+		common.WithCollectionName(c.rootCollectionName),
+		common.WithTargetObjects(pageIds),
+		common.WithAddDate(),
+		common.WithRelations(),
+	)
+	rootCollectionSnapshot, err := rootCollection.MakeImportCollection(settings)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCollectionSnapshot != nil {
+		snapshots = append(snapshots, rootCollectionSnapshot)
+		rootCollectionID = rootCollectionSnapshot.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	response := &common.Response{
+		Snapshots:            snapshots,
+		RootObjectID:         rootCollectionID,
+		RootObjectWidgetType: model.BlockContentWidget_CompactList,
+	}
+	if allErrors.IsEmpty() {
+		return response, nil
+	}
+	return response, allErrors
+}
+
+func (c *converter) readPath(g *graph, path string, pathsCount int, allErrors *common.ConvertError) {
+	importSource := source.GetSource(path)
+	defer importSource.Close()
+	err := importSource.Initialize(path)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, c.importType) {
+			return
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{c.extension}) == 0 {
+		allErrors.Add(common.ErrorBySourceType(importSource))
+		return
+	}
+	if iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if !strings.EqualFold(filepath.Ext(fileName), c.extension) {
+			return true
+		}
+		pages, err := c.reader.readPages(fileName, fileReader)
+		if err != nil {
+			allErrors.Add(fmt.Errorf("file %s: %w", filepath.Base(fileName), err))
+			return !allErrors.ShouldAbortImport(pathsCount, c.importType)
+		}
+		for _, p := range pages {
+			g.addPage(p)
+		}
+		return true
+	}); iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+}
@@ -0,0 +1,182 @@
+package outliner
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/test"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/dateutil"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func findMark(t *testing.T, b *model.Block, markType model.BlockContentTextMarkType) *model.BlockContentTextMark {
+	for _, mark := range b.GetText().GetMarks().GetMarks() {
+		if mark.Type == markType {
+			return mark
+		}
+	}
+	require.Failf(t, "mark is not found", "%s in %s", markType, b.GetText().Text)
+	return nil
+}
+
+func TestLogseq_GetSnapshots(t *testing.T) {
+	t.Run("graph directory", func(t *testing.T) {
+		// given
+		c := NewLogseq(nil)
+		req := test.NewImportRequest(model.Import_Logseq, pb.RpcObjectImportRequest_ALL_OR_NOTHING, filepath.Join("testdata", "logseq"))
+
+		// when
+		resp, ce := c.GetSnapshots(context.Background(), req, process.NewNoOp())
+
+		// then
+		require.Nil(t, ce)
+		// 3 pages, referenced page, 4 relations, 2 tags and root collection
+		require.Len(t, resp.Snapshots, 11)
+		snapshots := resp.Snapshots
+		project := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "Project Alpha")
+		alice := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "Alice")
+		journal := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "Jan 15th, 2024")
+		planning := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "planning")
+
+		details := project.Snapshot.Data.Details
+		assert.Len(t, details.GetStringList(bundle.RelationKeyTag), 2)
+		assert.Equal(t, "active", details.GetString(test.RelationKey(t, snapshots, "status")))
+		assert.Equal(t, []string{alice.Id}, details.GetStringList(test.RelationKey(t, snapshots, "owner")))
+		assert.Equal(t, float64(2), details.GetFloat64(test.RelationKey(t, snapshots, "priority")))
+		assert.Equal(t, "Engineer", alice.Snapshot.Data.Details.GetString(test.RelationKey(t, snapshots, "role")))
+		assert.Equal(t, int64(model.RelationFormat_object),
+			test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypeRelation, "owner").Snapshot.Data.Details.GetInt64(bundle.RelationKeyRelationFormat))
+
+		goals := test.FindBlock(t, project, "Goals of the project")
+		ship := test.FindBlock(t, project, "Ship first version")
+		assert.Equal(t, []string{ship.Id}, goals.ChildrenIds)
+		findMark(t, ship, model.BlockContentTextMark_Bold)
+		docs := test.FindBlock(t, project, "Write docs with Alice")
+		assert.Equal(t, []string{docs.Id}, ship.ChildrenIds)
+		assert.Equal(t, alice.Id, findMark(t, docs, model.BlockContentTextMark_Mention).Param)
+
+		review := test.FindBlock(t, project, "Review with planning team")
+		assert.Equal(t, model.BlockContentText_Checkbox, review.GetText().Style)
+		assert.False(t, review.GetText().Checked)
+		assert.Equal(t, planning.Id, findMark(t, review, model.BlockContentTextMark_Mention).Param)
+		kickOff := test.FindBlock(t, project, "Kick-off meeting on Jan 15th, 2024")
+		assert.True(t, kickOff.GetText().Checked)
+		assert.Equal(t, journal.Id, findMark(t, kickOff, model.BlockContentTextMark_Mention).Param)
+		blockRef := test.FindBlock(t, project, "See Goals of the project")
+		assert.Equal(t, project.Id, findMark(t, blockRef, model.BlockContentTextMark_Object).Param)
+
+		day := time.Date(2024, time.January, 15, 0, 0, 0, 0, time.Local)
+		assert.Equal(t, day.Unix(), journal.Snapshot.Data.Details.GetInt64(bundle.RelationKeyCreatedDate))
+		// page is referenced by its alias
+		marks := test.FindBlock(t, journal, "Met with Alice about Alpha").GetText().GetMarks().GetMarks()
+		require.Len(t, marks, 2)
+		assert.Equal(t, []string{alice.Id, project.Id}, []string{marks[0].Param, marks[1].Param})
+		nextDay := test.FindBlock(t, journal, "Planning for Jan 16th, 2024")
+		assert.Equal(t, dateutil.NewDateObject(day.AddDate(0, 0, 1), false).Id(), findMark(t, nextDay, model.BlockContentTextMark_Mention).Param)
+
+		root := snapshots[len(snapshots)-1]
+		assert.Equal(t, root.Id, resp.RootObjectID)
+		assert.Len(t, pbtypes.GetStringList(root.Snapshot.Data.Collections, template.CollectionStoreKey), 4)
+	})
+
+	t.Run("no markdown files", func(t *testing.T) {
+		// given
+		c := NewLogseq(nil)
+		req := test.NewImportRequest(model.Import_Logseq, pb.RpcObjectImportRequest_IGNORE_ERRORS, t.TempDir())
+
+		// when
+		_, ce := c.GetSnapshots(context.Background(), req, process.NewNoOp())
+
+		// then
+		require.NotNil(t, ce)
+		assert.ErrorIs(t, ce.GetResultError(model.Import_Logseq), common.ErrFileImportNoObjectsInDirectory)
+	})
+}
+
+func TestRoam_GetSnapshots(t *testing.T) {
+	t.Run("json export", func(t *testing.T) {
+		// given
+		c := NewRoam(nil)
+
+		// when
+		resp, ce := c.GetSnapshots(context.Background(), test.NewImportRequest(model.Import_Roam, pb.RpcObjectImportRequest_ALL_OR_NOTHING, filepath.Join("testdata", "roam", "export.json")), process.NewNoOp())
+
+		// then
+		require.Nil(t, ce)
+		// 3 pages, referenced page, relation, tag and root collection
+		require.Len(t, resp.Snapshots, 7)
+		snapshots := resp.Snapshots
+		project := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "Project Alpha")
+		journal := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "January 15th, 2024")
+		test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypePage, "planning")
+		work := test.FindSnapshot(t, snapshots, smartblock.SmartBlockTypeRelationOption, "work")
+
+		details := project.Snapshot.Data.Details
+		assert.Equal(t, int64(1705305600), details.GetInt64(bundle.RelationKeyCreatedDate))
+		assert.Equal(t, int64(1705392000), details.GetInt64(bundle.RelationKeyLastModifiedDate))
+		assert.Equal(t, "active", details.GetString(test.RelationKey(t, snapshots, "Status")))
+		assert.Equal(t, []string{work.Id}, details.GetStringList(bundle.RelationKeyTag))
+
+		goals := test.FindBlock(t, project, "Goals")
+		assert.Equal(t, model.BlockContentText_Header2, goals.GetText().Style)
+		ship := test.FindBlock(t, project, "Ship first version")
+		assert.Equal(t, []string{ship.Id}, goals.ChildrenIds)
+		findMark(t, ship, model.BlockContentTextMark_Italic)
+		kickOff := test.FindBlock(t, project, "Kick-off meeting on January 15th, 2024")
+		assert.Equal(t, model.BlockContentText_Checkbox, kickOff.GetText().Style)
+		assert.True(t, kickOff.GetText().Checked)
+		assert.Equal(t, journal.Id, findMark(t, kickOff, model.BlockContentTextMark_Mention).Param)
+		assert.Equal(t, project.Id, findMark(t, test.FindBlock(t, project, "See Goals"), model.BlockContentTextMark_Object).Param)
+		assert.Equal(t, time.Date(2024, time.January, 15, 0, 0, 0, 0, time.Local).Unix(), journal.Snapshot.Data.Details.GetInt64(bundle.RelationKeyCreatedDate))
+	})
+
+	t.Run("broken export is skipped", func(t *testing.T) {
+		// given
+		c := NewRoam(nil)
+
+		// when
+		resp, ce := c.GetSnapshots(context.Background(), test.NewImportRequest(model.Import_Roam, pb.RpcObjectImportRequest_IGNORE_ERRORS,
+			filepath.Join("testdata", "roam", "broken.json"), filepath.Join("testdata", "roam", "export.json")), process.NewNoOp())
+
+		// then
+		require.NotNil(t, ce)
+		assert.ErrorContains(t, ce.Error(), errWrongFormat.Error())
+		test.FindSnapshot(t, resp.Snapshots, smartblock.SmartBlockTypePage, "Project Alpha")
+	})
+}
+
+func TestParseOutline(t *testing.T) {
+	t.Run("properties in the first block", func(t *testing.T) {
+		// when
+		properties, blocks := parseOutline("- type:: book\n  author:: [[Someone]]\n- first\n  collapsed:: true\n  second line\n\t- nested\n")
+
+		// then
+		assert.Equal(t, []property{{name: "type", value: "book"}, {name: "author", value: "[[Someone]]"}}, properties)
+		require.Len(t, blocks, 1)
+		assert.Equal(t, "first\nsecond line", blocks[0].text)
+		require.Len(t, blocks[0].children, 1)
+		assert.Equal(t, "nested", blocks[0].children[0].text)
+	})
+
+	t.Run("code block is kept", func(t *testing.T) {
+		// when
+		properties, blocks := parseOutline("- ```\n  - not a bullet\n  key:: value\n  ```\n")
+
+		// then
+		assert.Empty(t, properties)
+		require.Len(t, blocks, 1)
+		assert.Equal(t, "```\n- not a bullet\nkey:: value\n```", blocks[0].text)
+	})
+}
@@ -0,0 +1,477 @@
+package outliner
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/constant"
+	"github.com/anyproto/anytype-heart/util/dateutil"
+)
+
+const (
+	propertyTitle = "title"
+	propertyAlias = "alias"
+	propertyTags  = "tags"
+	propertyIcon  = "icon"
+	propertyId    = "id"
+)
+
+// ignoredProperties are internal properties of Logseq, which are not imported as relations
+var ignoredProperties = map[string]bool{
+	propertyId:  true,
+	"collapsed": true,
+	"filters":   true,
+	"public":    true,
+}
+
+// block is a bullet of the outline with its nested bullets
+type block struct {
+	uid      string
+	text     string
+	heading  int
+	children []*block
+}
+
+type property struct {
+	name  string
+	value string
+}
+
+// page is a page of the graph read from a Logseq markdown file or a Roam JSON export
+type page struct {
+	title        string
+	sourcePath   string
+	baseDir      string
+	journalDate  time.Time
+	properties   []property
+	blocks       []*block
+	createdDate  int64
+	modifiedDate int64
+
+	id string
+}
+
+func (p *page) isJournal() bool {
+	return !p.journalDate.IsZero()
+}
+
+// relation is a relation made of the page property. Its format is chosen by values of the property on all pages
+type relation struct {
+	name   string
+	key    string
+	format model.RelationFormat
+	values []string
+}
+
+// graph resolves references between pages and blocks and converts pages to snapshots.
+// Pages referenced, but not existing in the graph, are created empty, as outliners do
+type graph struct {
+	stubPrefix  string
+	pages       []*page
+	pagesByName map[string]*page
+	journals    map[string]*page
+	blocks      map[string]*block
+	blockPages  map[string]*page
+
+	relations     map[string]*relation
+	relationNames []string
+	tagOptions    map[string]*common.Snapshot
+	tagNames      []string
+}
+
+func newGraph(stubPrefix string) *graph {
+	return &graph{
+		stubPrefix:  stubPrefix,
+		pagesByName: map[string]*page{},
+		journals:    map[string]*page{},
+		blocks:      map[string]*block{},
+		blockPages:  map[string]*page{},
+		relations:   map[string]*relation{},
+		tagOptions:  map[string]*common.Snapshot{},
+	}
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func (g *graph) addPage(p *page) *page {
+	p.id = uuid.New().String()
+	g.pages = append(g.pages, p)
+	g.registerName(p.title, p)
+	for _, prop := range p.properties {
+		if normalizeName(prop.name) == propertyAlias {
+			for _, alias := range splitValues(prop.value) {
+				g.registerName(valueName(alias), p)
+			}
+		}
+	}
+	if p.isJournal() {
+		if _, ok := g.journals[dateKey(p.journalDate)]; !ok {
+			g.journals[dateKey(p.journalDate)] = p
+		}
+	}
+	g.addBlocks(p, p.blocks)
+	return p
+}
+
+func (g *graph) registerName(name string, p *page) {
+	name = normalizeName(name)
+	if _, ok := g.pagesByName[name]; name != "" && !ok {
+		g.pagesByName[name] = p
+	}
+}
+
+func (g *graph) addBlocks(p *page, blocks []*block) {
+	for _, b := range blocks {
+		if b.uid != "" {
+			g.blocks[b.uid] = b
+			g.blockPages[b.uid] = p
+		}
+		g.addBlocks(p, b.children)
+	}
+}
+
+func (g *graph) findPage(name string) *page {
+	if p, ok := g.pagesByName[normalizeName(name)]; ok {
+		return p
+	}
+	if date, ok := parseJournalDate(name); ok {
+		return g.journals[dateKey(date)]
+	}
+	return nil
+}
+
+// pageId returns the id of the snapshot of the page. References to dates without journal pages are linked to date objects
+func (g *graph) pageId(name string) string {
+	if p := g.findPage(name); p != nil {
+		return p.id
+	}
+	if date, ok := parseJournalDate(name); ok {
+		return dateutil.NewDateObject(date, false).Id()
+	}
+	name = strings.TrimSpace(name)
+	return g.addPage(&page{title: name, sourcePath: g.stubPrefix + "/" + normalizeName(name)}).id
+}
+
+// snapshots converts all pages of the graph. Relations and tags are added after pages
+func (g *graph) snapshots() (snapshots []*common.Snapshot, pageIds []string) {
+	g.collectRelations()
+	// pages are appended, when references to missing pages are resolved
+	for i := 0; i < len(g.pages); i++ {
+		p := g.pages[i]
+		snapshots = append(snapshots, g.pageSnapshot(p))
+		pageIds = append(pageIds, p.id)
+	}
+	for _, name := range g.relationNames {
+		snapshots = append(snapshots, relationSnapshot(g.relations[name]))
+	}
+	for _, name := range g.tagNames {
+		snapshots = append(snapshots, g.tagOptions[name])
+	}
+	return snapshots, pageIds
+}
+
+func (g *graph) collectRelations() {
+	for _, p := range g.pages {
+		for _, prop := range p.properties {
+			name := normalizeName(prop.name)
+			if isSpecialProperty(name) {
+				continue
+			}
+			rel, ok := g.relations[name]
+			if !ok {
+				rel = &relation{name: strings.TrimSpace(prop.name), key: bson.NewObjectId().Hex()}
+				g.relations[name] = rel
+				g.relationNames = append(g.relationNames, name)
+			}
+			rel.values = append(rel.values, prop.value)
+		}
+	}
+	for _, rel := range g.relations {
+		rel.format = inferFormat(rel.values)
+	}
+}
+
+func isSpecialProperty(name string) bool {
+	switch name {
+	case propertyTitle, propertyAlias, propertyTags, propertyIcon:
+		return true
+	}
+	return ignoredProperties[name]
+}
+
+// inferFormat returns object format for references to pages, checkbox and number formats for values of these types
+// and long text otherwise
+func inferFormat(values []string) model.RelationFormat {
+	isObject, isCheckbox, isNumber := true, true, true
+	var hasValues bool
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		hasValues = true
+		for _, v := range splitValues(value) {
+			if _, ok := refName(v); !ok {
+				isObject = false
+			}
+		}
+		if value != "true" && value != "false" {
+			isCheckbox = false
+		}
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			isNumber = false
+		}
+	}
+	switch {
+	case !hasValues:
+		return model.RelationFormat_longtext
+	case isObject:
+		return model.RelationFormat_object
+	case isCheckbox:
+		return model.RelationFormat_checkbox
+	case isNumber:
+		return model.RelationFormat_number
+	default:
+		return model.RelationFormat_longtext
+	}
+}
+
+func (g *graph) pageSnapshot(p *page) *common.Snapshot {
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, p.title)
+	h := sha256.Sum256([]byte(p.sourcePath))
+	details.SetString(bundle.RelationKeySourceFilePath, hex.EncodeToString(h[:]))
+	details.SetInt64(bundle.RelationKeyResolvedLayout, int64(model.ObjectType_basic))
+	if p.createdDate != 0 {
+		details.SetInt64(bundle.RelationKeyCreatedDate, p.createdDate)
+	}
+	if p.modifiedDate != 0 {
+		details.SetInt64(bundle.RelationKeyLastModifiedDate, p.modifiedDate)
+	}
+	if p.isJournal() {
+		// the journal page is shown on the page of its date
+		details.SetInt64(bundle.RelationKeyCreatedDate, p.journalDate.Unix())
+	}
+	relationLinks := g.setProperties(p, details)
+	_, blocks := g.convertBlocks(p, p.blocks)
+
+	return &common.Snapshot{
+		Id:       p.id,
+		FileName: p.sourcePath,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypePage,
+			Data: &common.StateSnapshot{
+				Blocks:        blocks,
+				Details:       details,
+				RelationLinks: relationLinks,
+				ObjectTypes:   []string{bundle.TypeKeyPage.String()},
+			},
+		},
+	}
+}
+
+func (g *graph) setProperties(p *page, details *domain.Details) []*model.RelationLink {
+	var relationLinks []*model.RelationLink
+	for _, prop := range p.properties {
+		name := normalizeName(prop.name)
+		values := splitValues(prop.value)
+		switch name {
+		case propertyTags:
+			tagIds := make([]string, 0, len(values))
+			for _, value := range values {
+				tagIds = append(tagIds, g.tagOptionId(valueName(value)))
+			}
+			if len(tagIds) > 0 {
+				details.SetStringList(bundle.RelationKeyTag, tagIds)
+				relationLinks = append(relationLinks, &model.RelationLink{Key: bundle.RelationKeyTag.String(), Format: model.RelationFormat_tag})
+			}
+			continue
+		case propertyIcon:
+			details.SetString(bundle.RelationKeyIconEmoji, strings.TrimSpace(prop.value))
+			continue
+		}
+		rel, ok := g.relations[name]
+		if !ok {
+			continue
+		}
+		key := domain.RelationKey(rel.key)
+		value := strings.TrimSpace(prop.value)
+		switch rel.format {
+		case model.RelationFormat_object:
+			ids := make([]string, 0, len(values))
+			for _, v := range values {
+				ids = append(ids, g.pageId(valueName(v)))
+			}
+			details.SetStringList(key, ids)
+		case model.RelationFormat_checkbox:
+			details.SetBool(key, value == "true")
+		case model.RelationFormat_number:
+			number, _ := strconv.ParseFloat(value, 64)
+			details.SetFloat64(key, number)
+		default:
+			details.SetString(key, g.renderRefs(value, false, 0))
+		}
+		relationLinks = append(relationLinks, &model.RelationLink{Key: rel.key, Format: rel.format})
+	}
+	return relationLinks
+}
+
+// convertBlocks converts the outline to blocks. Nested bullets become children of the first text block of their parent
+func (g *graph) convertBlocks(p *page, outline []*block) (ids []string, blocks []*model.Block) {
+	for _, b := range outline {
+		converted, rootIds := g.convertBlock(p, b)
+		childIds, childBlocks := g.convertBlocks(p, b.children)
+		if len(childIds) > 0 {
+			if parent := firstTextBlock(converted, rootIds); parent != nil {
+				parent.ChildrenIds = append(parent.ChildrenIds, childIds...)
+			} else {
+				rootIds = append(rootIds, childIds...)
+			}
+		}
+		ids = append(ids, rootIds...)
+		blocks = append(blocks, converted...)
+		blocks = append(blocks, childBlocks...)
+	}
+	return ids, blocks
+}
+
+func (g *graph) convertBlock(p *page, b *block) ([]*model.Block, []string) {
+	text, isTask, checked := parseTaskMarker(b.text)
+	blocks, _, err := anymark.MarkdownToBlocks([]byte(g.renderRefs(text, true, 0)), p.baseDir, nil)
+	rootIds := topLevelIds(blocks)
+	if err != nil || len(rootIds) == 0 {
+		if err != nil {
+			log.Warnf("failed to convert block of page %s: %v", p.title, err)
+		}
+		paragraph := &model.Block{
+			Id: bson.NewObjectId().Hex(),
+			Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+				Text: g.renderRefs(text, false, 0),
+			}},
+		}
+		blocks, rootIds = []*model.Block{paragraph}, []string{paragraph.Id}
+	}
+	for _, bl := range blocks {
+		convertRefMarks(bl)
+	}
+	if first := firstTextBlock(blocks, rootIds); first != nil {
+		switch {
+		case isTask:
+			first.GetText().Style = model.BlockContentText_Checkbox
+			first.GetText().Checked = checked
+		case b.heading > 0:
+			first.GetText().Style = headingStyle(b.heading)
+		}
+	}
+	return blocks, rootIds
+}
+
+// topLevelIds returns ids of blocks, which are not children of other blocks
+func topLevelIds(blocks []*model.Block) []string {
+	children := make(map[string]bool, len(blocks))
+	for _, b := range blocks {
+		for _, id := range b.ChildrenIds {
+			children[id] = true
+		}
+	}
+	ids := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		if !children[b.Id] {
+			ids = append(ids, b.Id)
+		}
+	}
+	return ids
+}
+
+func headingStyle(heading int) model.BlockContentTextStyle {
+	switch heading {
+	case 1:
+		return model.BlockContentText_Header1
+	case 2:
+		return model.BlockContentText_Header2
+	default:
+		return model.BlockContentText_Header3
+	}
+}
+
+func firstTextBlock(blocks []*model.Block, rootIds []string) *model.Block {
+	if len(rootIds) == 0 {
+		return nil
+	}
+	for _, b := range blocks {
+		if b.Id == rootIds[0] && b.GetText() != nil {
+			return b
+		}
+	}
+	return nil
+}
+
+func relationSnapshot(rel *relation) *common.Snapshot {
+	details := domain.NewDetails()
+	details.SetInt64(bundle.RelationKeyRelationFormat, int64(rel.format))
+	details.SetString(bundle.RelationKeyName, rel.name)
+	details.SetString(bundle.RelationKeyRelationKey, rel.key)
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relation))
+	id := rel.key
+	if uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelation, rel.key); err == nil {
+		id = uniqueKey.Marshal()
+		details.SetString(bundle.RelationKeyId, id)
+	} else {
+		log.Warnf("failed to create unique key for relation: %v", err)
+	}
+	return &common.Snapshot{
+		Id: id,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypeRelation,
+			Data: &common.StateSnapshot{
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyRelation.String()},
+				Key:         rel.key,
+			},
+		},
+	}
+}
+
+func (g *graph) tagOptionId(name string) string {
+	if option, ok := g.tagOptions[name]; ok {
+		return option.Id
+	}
+	id := bson.NewObjectId().Hex()
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, name)
+	details.SetString(bundle.RelationKeyRelationKey, bundle.RelationKeyTag.String())
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relationOption))
+	details.SetString(bundle.RelationKeyRelationOptionColor, constant.RandomOptionColor().String())
+	if uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelationOption, id); err == nil {
+		details.SetString(bundle.RelationKeyUniqueKey, uniqueKey.Marshal())
+	} else {
+		log.Warnf("failed to create unique key for tag: %v", err)
+	}
+	g.tagOptions[name] = &common.Snapshot{
+		Id: id,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypeRelationOption,
+			Data: &common.StateSnapshot{
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyRelationOption.String()},
+				Key:         id,
+			},
+		},
+	}
+	g.tagNames = append(g.tagNames, name)
+	return id
+}
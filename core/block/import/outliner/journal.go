@@ -0,0 +1,62 @@
+package outliner
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	dateKeyLayout     = "2006-01-02"
+	logseqFileLayout  = "2006_01_02"
+	logseqTitleLayout = "Jan 2"
+)
+
+// ordinalDateRegexp matches default titles of journal pages, e.g. "Jan 15th, 2024" in Logseq or "January 15th, 2024" in Roam
+var ordinalDateRegexp = regexp.MustCompile(`^([A-Za-z]+)\s+(\d{1,2})(?:st|nd|rd|th)?,\s*(\d{4})$`)
+
+// parseJournalDate parses titles of journal pages and references to them. Dates are in local time,
+// as date objects are
+func parseJournalDate(title string) (time.Time, bool) {
+	title = strings.TrimSpace(title)
+	if m := ordinalDateRegexp.FindStringSubmatch(title); m != nil {
+		for _, layout := range []string{"January 2 2006", "Jan 2 2006"} {
+			if t, err := time.ParseInLocation(layout, m[1]+" "+m[2]+" "+m[3], time.Local); err == nil {
+				return t, true
+			}
+		}
+		return time.Time{}, false
+	}
+	for _, layout := range []string{dateKeyLayout, logseqFileLayout} {
+		if t, err := time.ParseInLocation(layout, title, time.Local); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// logseqJournalTitle returns the title of the journal page in the default Logseq format
+func logseqJournalTitle(t time.Time) string {
+	return t.Format(logseqTitleLayout) + ordinalSuffix(t.Day()) + ", " + strconv.Itoa(t.Year())
+}
+
+func ordinalSuffix(day int) string {
+	if day >= 11 && day <= 13 {
+		return "th"
+	}
+	switch day % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	default:
+		return "th"
+	}
+}
+
+func dateKey(t time.Time) string {
+	return t.Format(dateKeyLayout)
+}
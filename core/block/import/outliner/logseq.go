@@ -0,0 +1,206 @@
+package outliner
+
+import (
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/filetime"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	LogseqName               = "Logseq"
+	logseqRootCollectionName = "Logseq Import"
+	logseqJournalsDir        = "journals"
+	codeFence                = "```"
+	tabWidth                 = 4
+)
+
+// logseqServiceDirs contain backups and old versions of pages, which are not part of the graph
+var logseqServiceDirs = []string{"logseq/bak/", "logseq/version-files/", "logseq/.recycle/"}
+
+// NewLogseq creates the converter of Logseq graph directories. Pages are read from markdown files of
+// pages and journals directories
+func NewLogseq(collectionService *collection.Service) common.Converter {
+	return &converter{
+		name:               LogseqName,
+		importType:         model.Import_Logseq,
+		extension:          ".md",
+		rootCollectionName: logseqRootCollectionName,
+		getPaths: func(req *pb.RpcObjectImportRequest) []string {
+			if p := req.GetLogseqParams(); p != nil {
+				return p.Path
+			}
+			return nil
+		},
+		reader:            logseqReader{},
+		collectionService: collectionService,
+	}
+}
+
+type logseqReader struct{}
+
+func (logseqReader) readPages(fileName string, r io.Reader) ([]*page, error) {
+	slashPath := filepath.ToSlash(fileName)
+	for _, dir := range logseqServiceDirs {
+		if strings.Contains(slashPath, dir) {
+			return nil, nil
+		}
+	}
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	properties, blocks := parseOutline(string(content))
+	p := &page{
+		title:      logseqPageTitle(fileName),
+		sourcePath: fileName,
+		baseDir:    filepath.Dir(fileName),
+		properties: properties,
+		blocks:     blocks,
+	}
+	p.createdDate, p.modifiedDate = filetime.ExtractFileTimes(fileName)
+	if filepath.Base(filepath.Dir(fileName)) == logseqJournalsDir {
+		name := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+		if date, ok := parseJournalDate(name); ok {
+			p.journalDate = date
+			p.title = logseqJournalTitle(date)
+		}
+	}
+	for _, prop := range properties {
+		if normalizeName(prop.name) == propertyTitle && strings.TrimSpace(prop.value) != "" {
+			p.title = strings.TrimSpace(prop.value)
+		}
+	}
+	return []*page{p}, nil
+}
+
+// logseqPageTitle decodes the title of the page from the file name. Logseq replaces "/" of namespaces with "___"
+// and escapes other characters, which are not allowed in file names
+func logseqPageTitle(fileName string) string {
+	name := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	name = strings.ReplaceAll(name, "___", "/")
+	if unescaped, err := url.PathUnescape(name); err == nil {
+		name = unescaped
+	}
+	return name
+}
+
+type outlineLevel struct {
+	indent int
+	block  *block
+}
+
+// parseOutline parses the markdown file of Logseq. Lines before the first bullet or the first bullet consisting of
+// properties only are properties of the page. Properties of blocks are removed from their text, id property is kept
+// as the uid of the block for block references
+func parseOutline(content string) ([]property, []*block) {
+	var (
+		roots       []*block
+		stack       []outlineLevel
+		current     *block
+		contentSkip int
+		header      []string
+		inFence     bool
+	)
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimLeft(line, " \t")
+		leading := line[:len(line)-len(trimmed)]
+		if !inFence && (trimmed == "-" || strings.HasPrefix(trimmed, "- ")) {
+			b := &block{text: strings.TrimPrefix(strings.TrimPrefix(trimmed, "-"), " ")}
+			indent := indentWidth(leading)
+			for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+				stack = stack[:len(stack)-1]
+			}
+			if len(stack) == 0 {
+				roots = append(roots, b)
+			} else {
+				parent := stack[len(stack)-1].block
+				parent.children = append(parent.children, b)
+			}
+			stack = append(stack, outlineLevel{indent: indent, block: b})
+			current, contentSkip = b, len(leading)+2
+			if strings.HasPrefix(b.text, codeFence) {
+				inFence = !inFence
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, codeFence) {
+			inFence = !inFence
+		}
+		if current == nil {
+			header = append(header, line)
+			continue
+		}
+		current.text += "\n" + line[min(len(leading), contentSkip):]
+	}
+
+	properties, text := extractProperties(strings.Join(header, "\n"))
+	if text = strings.TrimSpace(text); text != "" {
+		roots = append([]*block{{text: text}}, roots...)
+	}
+	if len(properties) == 0 && len(roots) > 0 && len(roots[0].children) == 0 {
+		if props, text := extractProperties(roots[0].text); len(props) > 0 && strings.TrimSpace(text) == "" {
+			properties, roots = props, roots[1:]
+		}
+	}
+	for _, b := range roots {
+		extractBlockProperties(b)
+	}
+	return properties, roots
+}
+
+func indentWidth(leading string) int {
+	var width int
+	for _, r := range leading {
+		if r == '\t' {
+			width += tabWidth
+		} else {
+			width++
+		}
+	}
+	return width
+}
+
+// extractBlockProperties removes properties from the text of the block and its children
+func extractBlockProperties(b *block) {
+	var props []property
+	props, b.text = extractProperties(b.text)
+	b.text = strings.TrimRight(b.text, " \t\n")
+	for _, prop := range props {
+		if normalizeName(prop.name) == propertyId {
+			b.uid = strings.TrimSpace(prop.value)
+		}
+	}
+	for _, child := range b.children {
+		extractBlockProperties(child)
+	}
+}
+
+// extractProperties splits the text to "key:: value" lines and the rest of the text. Code blocks are kept as is
+func extractProperties(text string) ([]property, string) {
+	var (
+		props   []property
+		lines   []string
+		inFence bool
+	)
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, codeFence) {
+			inFence = !inFence
+		}
+		if !inFence {
+			if m := propertyRegexp.FindStringSubmatch(trimmed); m != nil {
+				props = append(props, property{name: m[1], value: m[2]})
+				continue
+			}
+		}
+		lines = append(lines, line)
+	}
+	return props, strings.Join(lines, "\n")
+}
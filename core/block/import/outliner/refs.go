@@ -0,0 +1,172 @@
+package outliner
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	// references are turned into markdown links with these schemes before markdown is parsed,
+	// and links are turned into marks with object ids afterward
+	pageRefScheme  = "anytypepage:"
+	blockRefScheme = "anytypeblock:"
+
+	maxBlockRefDepth = 3
+)
+
+var (
+	// refRegexp matches aliased page references "[alias]([[Page]])", page references "[[Page]]" and "#[[Page]]",
+	// block references "((uid))" and tags "#page"
+	refRegexp = regexp.MustCompile(`\[([^\[\]]+)\]\(\[\[([^\[\]]+)\]\]\)|#?\[\[([^\[\]]+)\]\]|\(\(([\w-]+)\)\)|(^|\s)#([^\s#\[\]()",;:!?]+)`)
+
+	// propertyRegexp matches "key:: value" properties of Logseq and attributes of Roam
+	propertyRegexp = regexp.MustCompile(`^([\p{L}\p{N}][\p{L}\p{N}_\- ]*)::\s*(.*)$`)
+
+	logseqTaskMarkers = map[string]bool{"TODO": false, "LATER": false, "NOW": false, "DOING": false, "WAITING": false, "DONE": true}
+	roamTaskMarkers   = map[string]bool{"{{[[TODO]]}}": false, "{{TODO}}": false, "{{[[DONE]]}}": true, "{{DONE}}": true}
+)
+
+// renderRefs replaces references in the text. If asLinks is set, references become markdown links,
+// otherwise they are replaced by the names of pages and the text of referenced blocks
+func (g *graph) renderRefs(text string, asLinks bool, depth int) string {
+	matches := refRegexp.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 {
+		return text
+	}
+	var sb strings.Builder
+	last := 0
+	for _, m := range matches {
+		sb.WriteString(text[last:m[0]])
+		last = m[1]
+		switch {
+		case m[2] >= 0:
+			sb.WriteString(g.pageRef(text[m[2]:m[3]], text[m[4]:m[5]], asLinks))
+		case m[6] >= 0:
+			name := text[m[6]:m[7]]
+			sb.WriteString(g.pageRef(name, name, asLinks))
+		case m[8] >= 0:
+			sb.WriteString(g.blockRef(text[m[0]:m[1]], text[m[8]:m[9]], asLinks, depth))
+		default:
+			sb.WriteString(text[m[10]:m[11]])
+			name := text[m[12]:m[13]]
+			sb.WriteString(g.pageRef(name, name, asLinks))
+		}
+	}
+	sb.WriteString(text[last:])
+	return sb.String()
+}
+
+func (g *graph) pageRef(text, name string, asLinks bool) string {
+	if !asLinks {
+		return text
+	}
+	return markdownLink(text, pageRefScheme+g.pageId(name))
+}
+
+func (g *graph) blockRef(raw, uid string, asLinks bool, depth int) string {
+	b, ok := g.blocks[uid]
+	if !ok || depth >= maxBlockRefDepth {
+		return raw
+	}
+	text := g.renderRefs(stripTaskMarker(b.text), false, depth+1)
+	if !asLinks {
+		return text
+	}
+	return markdownLink(text, blockRefScheme+g.blockPages[uid].id)
+}
+
+func markdownLink(text, target string) string {
+	return "[" + anymark.Escape(text) + "](" + target + ")"
+}
+
+// convertRefMarks turns links made of references into mentions of pages and links to pages with referenced blocks
+func convertRefMarks(block *model.Block) {
+	for _, mark := range block.GetText().GetMarks().GetMarks() {
+		if mark.Type != model.BlockContentTextMark_Link {
+			continue
+		}
+		if id, ok := strings.CutPrefix(mark.Param, pageRefScheme); ok {
+			mark.Type = model.BlockContentTextMark_Mention
+			mark.Param = id
+		} else if id, ok = strings.CutPrefix(mark.Param, blockRefScheme); ok {
+			mark.Type = model.BlockContentTextMark_Object
+			mark.Param = id
+		}
+	}
+}
+
+// parseTaskMarker strips the task marker of Logseq or Roam from the beginning of the text
+func parseTaskMarker(text string) (rest string, isTask, checked bool) {
+	marker, rest, found := strings.Cut(text, " ")
+	if !found {
+		marker = text
+	}
+	if checked, ok := logseqTaskMarkers[marker]; ok {
+		return rest, true, checked
+	}
+	if checked, ok := roamTaskMarkers[marker]; ok {
+		return rest, true, checked
+	}
+	return text, false, false
+}
+
+func stripTaskMarker(text string) string {
+	rest, _, _ := parseTaskMarker(text)
+	return rest
+}
+
+// splitValues splits comma separated values of the property, commas inside of references are kept
+func splitValues(value string) []string {
+	var (
+		values []string
+		depth  int
+		start  int
+	)
+	for i := 0; i < len(value); i++ {
+		switch {
+		case strings.HasPrefix(value[i:], "[["):
+			depth++
+			i++
+		case strings.HasPrefix(value[i:], "]]") && depth > 0:
+			depth--
+			i++
+		case value[i] == ',' && depth == 0:
+			values = append(values, value[start:i])
+			start = i + 1
+		}
+	}
+	values = append(values, value[start:])
+	result := values[:0]
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// refName returns the name of the page, if the value is a single reference to it
+func refName(value string) (string, bool) {
+	m := refRegexp.FindStringSubmatchIndex(value)
+	if m == nil || m[0] != 0 || m[1] != len(value) {
+		return "", false
+	}
+	switch {
+	case m[6] >= 0:
+		return value[m[6]:m[7]], true
+	case m[12] >= 0 && m[10] == m[11]:
+		return value[m[12]:m[13]], true
+	}
+	return "", false
+}
+
+// valueName returns the name of the page for references and the trimmed value otherwise
+func valueName(value string) string {
+	if name, ok := refName(value); ok {
+		return name
+	}
+	return value
+}
@@ -0,0 +1,119 @@
+package outliner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	RoamName               = "Roam"
+	roamRootCollectionName = "Roam Import"
+)
+
+// NewRoam creates the converter of Roam Research JSON exports
+func NewRoam(collectionService *collection.Service) common.Converter {
+	return &converter{
+		name:               RoamName,
+		importType:         model.Import_Roam,
+		extension:          ".json",
+		rootCollectionName: roamRootCollectionName,
+		getPaths: func(req *pb.RpcObjectImportRequest) []string {
+			if p := req.GetRoamParams(); p != nil {
+				return p.Path
+			}
+			return nil
+		},
+		reader:            roamReader{},
+		collectionService: collectionService,
+	}
+}
+
+// roamItalicRegexp matches italic text of Roam, which is bold in markdown
+var roamItalicRegexp = regexp.MustCompile(`__([^_]+)__`)
+
+type roamPage struct {
+	Title      string      `json:"title"`
+	Uid        string      `json:"uid"`
+	Children   []roamBlock `json:"children"`
+	CreateTime int64       `json:"create-time"`
+	EditTime   int64       `json:"edit-time"`
+}
+
+type roamBlock struct {
+	String   string      `json:"string"`
+	Uid      string      `json:"uid"`
+	Heading  int         `json:"heading"`
+	Children []roamBlock `json:"children"`
+}
+
+type roamReader struct{}
+
+// readPages reads the export, which is a JSON array of pages. Attributes of top-level blocks are properties of the page
+func (roamReader) readPages(fileName string, r io.Reader) ([]*page, error) {
+	var roamPages []roamPage
+	if err := json.NewDecoder(r).Decode(&roamPages); err != nil {
+		return nil, fmt.Errorf("%w: %w", errWrongFormat, err)
+	}
+	pages := make([]*page, 0, len(roamPages))
+	for _, rp := range roamPages {
+		if strings.TrimSpace(rp.Title) == "" {
+			continue
+		}
+		p := &page{
+			title:        rp.Title,
+			sourcePath:   fileName + "/" + rp.Title,
+			createdDate:  rp.CreateTime / 1000,
+			modifiedDate: rp.EditTime / 1000,
+		}
+		if date, ok := parseJournalDate(rp.Title); ok {
+			p.journalDate = date
+		}
+		for _, rb := range rp.Children {
+			if prop, ok := roamAttribute(rb); ok {
+				p.properties = append(p.properties, prop)
+				continue
+			}
+			p.blocks = append(p.blocks, convertRoamBlock(rb))
+		}
+		pages = append(pages, p)
+	}
+	return pages, nil
+}
+
+// roamAttribute returns the attribute of the block. If the attribute has no value in the block,
+// values are taken from its children
+func roamAttribute(rb roamBlock) (property, bool) {
+	m := propertyRegexp.FindStringSubmatch(strings.TrimSpace(rb.String))
+	if m == nil {
+		return property{}, false
+	}
+	prop := property{name: m[1], value: m[2]}
+	if strings.TrimSpace(prop.value) == "" {
+		values := make([]string, 0, len(rb.Children))
+		for _, child := range rb.Children {
+			values = append(values, strings.TrimSpace(child.String))
+		}
+		prop.value = strings.Join(values, ", ")
+	}
+	return prop, true
+}
+
+func convertRoamBlock(rb roamBlock) *block {
+	b := &block{
+		uid:     rb.Uid,
+		text:    roamItalicRegexp.ReplaceAllString(rb.String, "*$1*"),
+		heading: rb.Heading,
+	}
+	for _, child := range rb.Children {
+		b.children = append(b.children, convertRoamBlock(child))
+	}
+	return b
+}
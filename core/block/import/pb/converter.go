@@ -369,6 +369,10 @@ func (p *Pb) normalizeSnapshot(
 
 func (p *Pb) normalizeFilePath(snapshot *common.SnapshotModel, pbFiles source.Source, path string) error {
 	filePath := snapshot.Data.Details.GetString(bundle.RelationKeySource)
+	if filePath == "" {
+		// file objects without content, e.g. from backups, are created from snapshots and get content from the file storage
+		return nil
+	}
 	fileName, _, err := common.ProvideFileName(filePath, pbFiles, path, p.tempDirProvider)
 	if err != nil {
 		return err
@@ -190,6 +190,9 @@ func (d *Dataview) SetView(viewID string, view model.BlockContentDataviewView) e
 	v.DefaultTemplateId = view.DefaultTemplateId
 	v.DefaultObjectTypeId = view.DefaultObjectTypeId
 	v.EndRelationKey = view.EndRelationKey
+	v.StartRelationKey = view.StartRelationKey
+	v.GroupDateBucket = view.GroupDateBucket
+	v.GroupNumberRanges = view.GroupNumberRanges
 
 	return nil
 }
@@ -213,6 +216,9 @@ func (d *Dataview) SetViewFields(viewID string, view *model.BlockContentDataview
 	v.DefaultTemplateId = view.DefaultTemplateId
 	v.DefaultObjectTypeId = view.DefaultObjectTypeId
 	v.EndRelationKey = view.EndRelationKey
+	v.StartRelationKey = view.StartRelationKey
+	v.GroupDateBucket = view.GroupDateBucket
+	v.GroupNumberRanges = view.GroupNumberRanges
 
 	return nil
 }
@@ -1,6 +1,8 @@
 package dataview
 
 import (
+	"slices"
+
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/anyproto/anytype-heart/pb"
@@ -17,6 +19,9 @@ func diffViewFields(a, b *model.BlockContentDataviewView) *pb.EventBlockDataview
 		a.CoverFit == b.CoverFit &&
 		a.GroupRelationKey == b.GroupRelationKey &&
 		a.EndRelationKey == b.EndRelationKey &&
+		a.StartRelationKey == b.StartRelationKey &&
+		a.GroupDateBucket == b.GroupDateBucket &&
+		slices.Equal(a.GroupNumberRanges, b.GroupNumberRanges) &&
 		a.GroupBackgroundColors == b.GroupBackgroundColors &&
 		a.PageLimit == b.PageLimit &&
 		a.DefaultTemplateId == b.DefaultTemplateId &&
@@ -38,6 +43,9 @@ func diffViewFields(a, b *model.BlockContentDataviewView) *pb.EventBlockDataview
 		DefaultTemplateId:     b.DefaultTemplateId,
 		DefaultObjectTypeId:   b.DefaultObjectTypeId,
 		EndRelationKey:        b.EndRelationKey,
+		StartRelationKey:      b.StartRelationKey,
+		GroupDateBucket:       b.GroupDateBucket,
+		GroupNumberRanges:     b.GroupNumberRanges,
 	}
 }
 
@@ -240,6 +248,9 @@ func (d *Dataview) ApplyViewUpdate(upd *pb.EventBlockDataviewViewUpdate) {
 		view.DefaultTemplateId = f.DefaultTemplateId
 		view.DefaultObjectTypeId = f.DefaultObjectTypeId
 		view.EndRelationKey = f.EndRelationKey
+		view.StartRelationKey = f.StartRelationKey
+		view.GroupDateBucket = f.GroupDateBucket
+		view.GroupNumberRanges = f.GroupNumberRanges
 	}
 
 	{
@@ -51,14 +51,19 @@ var (
 	}
 )
 
+type accountService interface {
+	AccountID() string
+}
+
 type service struct {
-	picker       cache.ObjectGetter
-	store        objectstore.ObjectStore
-	spaceService space.Service
-	creator      objectcreator.Service
-	resolver     idresolver.Resolver
-	exporter     export.Export
-	converter    converter.LayoutConverter
+	picker         cache.ObjectGetter
+	store          objectstore.ObjectStore
+	spaceService   space.Service
+	creator        objectcreator.Service
+	resolver       idresolver.Resolver
+	exporter       export.Export
+	converter      converter.LayoutConverter
+	accountService accountService
 }
 
 func New() templateSvc.Service {
@@ -77,6 +82,7 @@ func (s *service) Init(a *app.App) error {
 	s.resolver = a.MustComponent(idresolver.CName).(idresolver.Resolver)
 	s.exporter = a.MustComponent(export.CName).(export.Export)
 	s.converter = app.MustComponent[converter.LayoutConverter](a)
+	s.accountService = app.MustComponent[accountService](a)
 	return nil
 }
 
@@ -97,7 +103,7 @@ func (s *service) CreateTemplateStateWithDetails(req templateSvc.CreateTemplateR
 	case "", blankTemplateId:
 		targetState = s.createBlankTemplateState(domain.FullID{SpaceID: req.SpaceId, ObjectID: req.TypeId}, req.Layout)
 	default:
-		targetState, err = s.createCustomTemplateState(req.TemplateId)
+		targetState, err = s.createCustomTemplateState(req.SpaceId, req.TemplateId)
 		if err != nil {
 			return
 		}
@@ -172,11 +178,29 @@ func (s *service) CreateTemplateStateFromSmartBlock(sb smartblock.SmartBlock, re
 	st, err := s.buildState(sb)
 	if err != nil {
 		st = s.createBlankTemplateState(domain.FullID{SpaceID: req.SpaceId, ObjectID: req.TypeId}, req.Layout)
+	} else {
+		s.expandTemplatePlaceholders(st, sb, sb.SpaceID())
 	}
 	addDetailsToState(st, req.Details)
 	return st
 }
 
+// expandTemplatePlaceholders substitutes placeholders like {{date}} in the state built from the template.
+// The template must be locked by the caller, as the counter placeholder updates it
+func (s *service) expandTemplatePlaceholders(st *state.State, template smartblock.SmartBlock, spaceId string) {
+	if !hasPlaceholders(st) {
+		return
+	}
+	if spaceId == "" {
+		var err error
+		spaceId, err = s.resolver.ResolveSpaceID(template.Id())
+		if err != nil {
+			log.Warnf("failed to resolve space of template '%s': %v", template.Id(), err)
+		}
+	}
+	expandPlaceholders(st, s.newPlaceholderResolver(spaceId, template, st.ObjectTypeKey()))
+}
+
 func extractTargetDetails(originDetails *domain.Details, templateDetails *domain.Details) *domain.Details {
 	targetDetails := originDetails.Copy()
 	if templateDetails == nil {
@@ -201,7 +225,7 @@ func extractTargetDetails(originDetails *domain.Details, templateDetails *domain
 	return targetDetails
 }
 
-func (s *service) createCustomTemplateState(templateId string) (targetState *state.State, err error) {
+func (s *service) createCustomTemplateState(spaceId, templateId string) (targetState *state.State, err error) {
 	err = cache.Do(s.picker, templateId, func(sb smartblock.SmartBlock) (innerErr error) {
 		targetState, innerErr = s.buildState(sb)
 		if innerErr != nil {
@@ -211,6 +235,7 @@ func (s *service) createCustomTemplateState(templateId string) (targetState *sta
 		if details.GetBool(bundle.RelationKeyIsDeleted) || details.GetBool(bundle.RelationKeyIsUninstalled) {
 			return spacestorage.ErrTreeStorageAlreadyDeleted
 		}
+		s.expandTemplatePlaceholders(targetState, sb, spaceId)
 		return nil
 	})
 	if errors.Is(err, spacestorage.ErrTreeStorageAlreadyDeleted) {
@@ -243,6 +268,7 @@ func (s *service) buildState(sb smartblock.SmartBlock) (st *state.State, err err
 		bundle.RelationKeyOrigin,
 		bundle.RelationKeyAddedDate,
 		bundle.RelationKeyFeaturedRelations,
+		bundle.RelationKeyTemplateCounter,
 	)
 	st.SetDetailAndBundledRelation(bundle.RelationKeySourceObject, domain.String(sb.Id()))
 	// original created timestamp is used to set creationDate for imported objects, not for template-based objects
@@ -0,0 +1,374 @@
+package templateimpl
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+// Placeholders have form of {{name}} or {{name:argument}}, e.g. {{date:DD.MM.YYYY}} or {{counter:3}}
+var placeholderRegexp = regexp.MustCompile(`\{\{\s*([a-zA-Z]+)\s*(?::([^{}]*))?\}\}`)
+
+const (
+	placeholderDate     = "date"
+	placeholderTime     = "time"
+	placeholderDateTime = "datetime"
+	placeholderCreator  = "creator"
+	placeholderSpace    = "space"
+	placeholderType     = "type"
+	placeholderCounter  = "counter"
+
+	// maxCounterWidth limits the number of digits of the counter, e.g. {{counter:3}}
+	maxCounterWidth = 20
+
+	defaultDateFormat     = "YYYY-MM-DD"
+	defaultTimeFormat     = "HH:mm"
+	defaultDateTimeFormat = "YYYY-MM-DD HH:mm"
+)
+
+// placeholderResolver returns the value of placeholder and false if placeholder is unknown or could not be resolved
+type placeholderResolver func(name, arg string) (string, bool)
+
+func hasPlaceholders(st *state.State) bool {
+	for _, value := range st.Details().Iterate() {
+		if str, ok := value.TryString(); ok && placeholderRegexp.MatchString(str) {
+			return true
+		}
+	}
+	var found bool
+	_ = st.Iterate(func(b simple.Block) (isContinue bool) {
+		if placeholderRegexp.MatchString(b.Model().GetText().GetText()) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// expandPlaceholders replaces placeholders in string details and text blocks of the state.
+// Marks of text blocks are shifted according to the length of substituted values
+func expandPlaceholders(st *state.State, resolve placeholderResolver) {
+	for key, value := range st.Details().Iterate() {
+		str, ok := value.TryString()
+		if !ok {
+			continue
+		}
+		if expanded, _ := expandText(str, resolve); expanded != str {
+			st.SetDetail(key, domain.String(expanded))
+		}
+	}
+
+	var blockIds []string
+	_ = st.Iterate(func(b simple.Block) (isContinue bool) {
+		// text of details blocks, e.g. title, is taken from details
+		if _, isDetailsBlock := b.Model().GetFields().GetFields()[text.DetailsKeyFieldName]; isDetailsBlock {
+			return true
+		}
+		if placeholderRegexp.MatchString(b.Model().GetText().GetText()) {
+			blockIds = append(blockIds, b.Model().Id)
+		}
+		return true
+	})
+	for _, id := range blockIds {
+		tb, ok := st.Get(id).(text.Block)
+		if !ok {
+			continue
+		}
+		expanded, replacements := expandText(tb.GetText(), resolve)
+		if len(replacements) == 0 {
+			continue
+		}
+		marks := tb.Model().GetText().GetMarks()
+		if marks != nil {
+			for _, mark := range marks.Marks {
+				if mark.Range == nil {
+					continue
+				}
+				mark.Range.From = shiftPosition(mark.Range.From, replacements)
+				mark.Range.To = shiftPosition(mark.Range.To, replacements)
+			}
+		}
+		tb.SetText(expanded, marks)
+	}
+}
+
+// replacement describes substitution of UTF-16 range [from, to) of the original text with the value of newLen length
+type replacement struct {
+	from, to, newLen int32
+}
+
+func expandText(str string, resolve placeholderResolver) (string, []replacement) {
+	matches := placeholderRegexp.FindAllStringSubmatchIndex(str, -1)
+	if len(matches) == 0 {
+		return str, nil
+	}
+	var (
+		result       strings.Builder
+		replacements []replacement
+		last         int
+	)
+	for _, m := range matches {
+		name := str[m[2]:m[3]]
+		var arg string
+		if m[4] >= 0 {
+			arg = strings.TrimSpace(str[m[4]:m[5]])
+		}
+		value, ok := resolve(strings.ToLower(name), arg)
+		if !ok {
+			continue
+		}
+		result.WriteString(str[last:m[0]])
+		from := int32(textutil.UTF16RuneCountString(str[:m[0]]))
+		replacements = append(replacements, replacement{
+			from:   from,
+			to:     from + int32(textutil.UTF16RuneCountString(str[m[0]:m[1]])),
+			newLen: int32(textutil.UTF16RuneCountString(value)),
+		})
+		result.WriteString(value)
+		last = m[1]
+	}
+	result.WriteString(str[last:])
+	return result.String(), replacements
+}
+
+// shiftPosition maps position in the original text to the position in the expanded text
+func shiftPosition(pos int32, replacements []replacement) int32 {
+	var offset int32
+	for _, r := range replacements {
+		if pos >= r.to {
+			offset += r.newLen - (r.to - r.from)
+			continue
+		}
+		if pos > r.from {
+			// position inside of placeholder is moved to the end of substituted value
+			return r.from + offset + r.newLen
+		}
+		break
+	}
+	return pos + offset
+}
+
+var dateFormatTokens = []string{"YYYY", "YY", "MMMM", "MMM", "MM", "M", "DD", "D", "dddd", "ddd", "HH", "H", "hh", "h", "mm", "ss", "A"}
+
+// formatDate formats time using tokens familiar to users, e.g. "DD.MM.YYYY HH:mm".
+// Characters that are not tokens are copied as is
+func formatDate(t time.Time, format string) string {
+	var result strings.Builder
+	for len(format) > 0 {
+		var matched bool
+		for _, token := range dateFormatTokens {
+			if !strings.HasPrefix(format, token) {
+				continue
+			}
+			result.WriteString(formatDateToken(t, token))
+			format = format[len(token):]
+			matched = true
+			break
+		}
+		if !matched {
+			result.WriteByte(format[0])
+			format = format[1:]
+		}
+	}
+	return result.String()
+}
+
+func formatDateToken(t time.Time, token string) string {
+	switch token {
+	case "YYYY":
+		return t.Format("2006")
+	case "YY":
+		return t.Format("06")
+	case "MMMM":
+		return t.Format("January")
+	case "MMM":
+		return t.Format("Jan")
+	case "MM":
+		return t.Format("01")
+	case "M":
+		return t.Format("1")
+	case "DD":
+		return t.Format("02")
+	case "D":
+		return t.Format("2")
+	case "dddd":
+		return t.Format("Monday")
+	case "ddd":
+		return t.Format("Mon")
+	case "HH":
+		return t.Format("15")
+	case "H":
+		return strconv.Itoa(t.Hour())
+	case "hh":
+		return t.Format("03")
+	case "h":
+		return t.Format("3")
+	case "mm":
+		return t.Format("04")
+	case "ss":
+		return t.Format("05")
+	case "A":
+		return t.Format("PM")
+	}
+	return token
+}
+
+// newPlaceholderResolver creates resolver of placeholders for objects created from the template.
+// Values that require object store are resolved lazily, only if they are used in the template
+func (s *service) newPlaceholderResolver(spaceId string, template smartblock.SmartBlock, typeKey domain.TypeKey) placeholderResolver {
+	now := time.Now().In(s.store.SpaceIndex(spaceId).AccountLocation())
+	// counter is increased once per created object, even if the template has several counter placeholders
+	var counter int64
+	return func(name, arg string) (string, bool) {
+		switch name {
+		case placeholderDate:
+			return formatDate(now, withDefault(arg, defaultDateFormat)), true
+		case placeholderTime:
+			return formatDate(now, withDefault(arg, defaultTimeFormat)), true
+		case placeholderDateTime:
+			return formatDate(now, withDefault(arg, defaultDateTimeFormat)), true
+		}
+
+		var (
+			value string
+			err   error
+		)
+		switch name {
+		case placeholderCreator:
+			value, err = s.creatorName(spaceId)
+		case placeholderSpace:
+			value, err = s.spaceName(spaceId)
+		case placeholderType:
+			value, err = s.typeName(spaceId, typeKey)
+		case placeholderCounter:
+			// the width is checked first, so the counter is not increased for placeholders that can't be resolved
+			var width int
+			width, err = counterWidth(arg)
+			if err == nil && counter == 0 {
+				counter, err = s.nextCounter(spaceId, template)
+			}
+			if err == nil {
+				value = formatCounter(counter, width)
+			}
+		default:
+			return "", false
+		}
+		if err != nil {
+			log.Warnf("failed to resolve template placeholder '%s': %v", name, err)
+			return "", false
+		}
+		return value, true
+	}
+}
+
+func withDefault(arg, defaultValue string) string {
+	if arg == "" {
+		return defaultValue
+	}
+	return arg
+}
+
+func (s *service) creatorName(spaceId string) (string, error) {
+	if s.accountService == nil {
+		return "", fmt.Errorf("account service is not initialized")
+	}
+	details, err := s.store.SpaceIndex(spaceId).GetDetails(domain.NewParticipantId(spaceId, s.accountService.AccountID()))
+	if err != nil {
+		return "", fmt.Errorf("get participant details: %w", err)
+	}
+	return details.GetString(bundle.RelationKeyName), nil
+}
+
+func (s *service) spaceName(spaceId string) (string, error) {
+	spc, err := s.spaceService.Get(context.Background(), spaceId)
+	if err != nil {
+		return "", fmt.Errorf("get space: %w", err)
+	}
+	details, err := s.store.SpaceIndex(spaceId).GetDetails(spc.DerivedIDs().Workspace)
+	if err != nil {
+		return "", fmt.Errorf("get workspace details: %w", err)
+	}
+	return details.GetString(bundle.RelationKeyName), nil
+}
+
+func (s *service) typeName(spaceId string, typeKey domain.TypeKey) (string, error) {
+	spc, err := s.spaceService.Get(context.Background(), spaceId)
+	if err != nil {
+		return "", fmt.Errorf("get space: %w", err)
+	}
+	typeId, err := spc.GetTypeIdByKey(context.Background(), typeKey)
+	if err != nil {
+		return "", fmt.Errorf("get type id: %w", err)
+	}
+	details, err := s.store.SpaceIndex(spaceId).GetDetails(typeId)
+	if err != nil {
+		return "", fmt.Errorf("get type details: %w", err)
+	}
+	return details.GetString(bundle.RelationKeyName), nil
+}
+
+// nextCounter increases the counter stored in the template and returns its new value. Templates created before
+// the counter was stored start with the number of objects already created from them, so numbers are not repeated
+// after objects are deleted. The counter is a synced detail of the template, so numbers are unique only for objects
+// created on the same device: objects created on other devices before their changes are received get the same numbers
+func (s *service) nextCounter(spaceId string, template smartblock.SmartBlock) (int64, error) {
+	counter := template.Details().GetInt64(bundle.RelationKeyTemplateCounter)
+	if counter == 0 {
+		records, err := s.store.SpaceIndex(spaceId).Query(database.Query{
+			Filters: []database.FilterRequest{
+				{
+					RelationKey: bundle.RelationKeySourceObject,
+					Condition:   model.BlockContentDataviewFilter_Equal,
+					Value:       domain.String(template.Id()),
+				},
+			},
+		})
+		if err != nil {
+			return 0, fmt.Errorf("query objects created from template: %w", err)
+		}
+		counter = int64(len(records))
+	}
+	counter++
+
+	st := template.NewState()
+	st.SetDetailAndBundledRelation(bundle.RelationKeyTemplateCounter, domain.Int64(counter))
+	if err := template.Apply(st, smartblock.NoHistory, smartblock.NoRestrictions); err != nil {
+		return 0, fmt.Errorf("store counter in template: %w", err)
+	}
+	return counter, nil
+}
+
+// counterWidth returns the minimal number of digits of the counter set by the argument, e.g. 3 for {{counter:3}}.
+// Arguments that are not numbers are ignored
+func counterWidth(arg string) (int, error) {
+	width, err := strconv.Atoi(arg)
+	if err != nil {
+		return 0, nil
+	}
+	if width > maxCounterWidth {
+		return 0, fmt.Errorf("counter width %d exceeds %d", width, maxCounterWidth)
+	}
+	return width, nil
+}
+
+// formatCounter pads the counter with zeros up to the width, e.g. {{counter:3}} is expanded to 007
+func formatCounter(counter int64, width int) string {
+	value := strconv.FormatInt(counter, 10)
+	if width > len(value) {
+		value = strings.Repeat("0", width-len(value)) + value
+	}
+	return value
+}
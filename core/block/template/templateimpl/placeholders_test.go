@@ -0,0 +1,192 @@
+package templateimpl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	templateSvc "github.com/anyproto/anytype-heart/core/block/template"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func stubResolver(values map[string]string) placeholderResolver {
+	return func(name, arg string) (string, bool) {
+		if arg != "" {
+			name += ":" + arg
+		}
+		value, ok := values[name]
+		return value, ok
+	}
+}
+
+func TestFormatDate(t *testing.T) {
+	moment := time.Date(2024, time.March, 5, 14, 7, 9, 0, time.UTC)
+
+	for format, expected := range map[string]string{
+		defaultDateFormat:     "2024-03-05",
+		defaultTimeFormat:     "14:07",
+		defaultDateTimeFormat: "2024-03-05 14:07",
+		"DD.MM.YY":            "05.03.24",
+		"dddd, MMMM D":        "Tuesday, March 5",
+		"ddd MMM":             "Tue Mar",
+		"h:mm:ss A":           "2:07:09 PM",
+		"week 1 of YYYY":      "week 1 of 2024",
+	} {
+		t.Run(format, func(t *testing.T) {
+			assert.Equal(t, expected, formatDate(moment, format))
+		})
+	}
+}
+
+func TestExpandText(t *testing.T) {
+	resolve := stubResolver(map[string]string{
+		"date":      "2024-03-05",
+		"counter:3": "007",
+	})
+
+	t.Run("known placeholders are replaced", func(t *testing.T) {
+		// when
+		expanded, replacements := expandText("Note {{date}} #{{ counter:3 }}", resolve)
+
+		// then
+		assert.Equal(t, "Note 2024-03-05 #007", expanded)
+		assert.Equal(t, []replacement{{from: 5, to: 13, newLen: 10}, {from: 15, to: 30, newLen: 3}}, replacements)
+	})
+
+	t.Run("unknown placeholders are left as is", func(t *testing.T) {
+		// when
+		expanded, replacements := expandText("{{unknown}} {{date}}", resolve)
+
+		// then
+		assert.Equal(t, "{{unknown}} 2024-03-05", expanded)
+		assert.Len(t, replacements, 1)
+	})
+}
+
+func TestShiftPosition(t *testing.T) {
+	// "ab{{date}}cd" -> "ab2024-03-05cd"
+	replacements := []replacement{{from: 2, to: 10, newLen: 10}}
+
+	assert.Equal(t, int32(1), shiftPosition(1, replacements))
+	assert.Equal(t, int32(2), shiftPosition(2, replacements))
+	assert.Equal(t, int32(12), shiftPosition(5, replacements))
+	assert.Equal(t, int32(12), shiftPosition(10, replacements))
+	assert.Equal(t, int32(14), shiftPosition(12, replacements))
+}
+
+func TestExpandPlaceholders(t *testing.T) {
+	// given
+	st := state.NewDoc("root", map[string]simple.Block{
+		"root": simple.New(&model.Block{Id: "root", ChildrenIds: []string{"text"}}),
+		"text": simple.New(&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text: "Meeting {{date}} by {{creator}}",
+			Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+				{Range: &model.Range{From: 20, To: 31}, Type: model.BlockContentTextMark_Bold},
+			}},
+		}}}),
+	}).NewState()
+	st.SetDetail(bundle.RelationKeyName, domain.String("Daily {{date}}"))
+	st.SetDetail(bundle.RelationKeyTag, domain.StringList([]string{"{{date}}"}))
+
+	resolve := stubResolver(map[string]string{
+		"date":    "2024-03-05",
+		"creator": "Alice",
+	})
+
+	// when
+	require.True(t, hasPlaceholders(st))
+	expandPlaceholders(st, resolve)
+
+	// then
+	assert.Equal(t, "Daily 2024-03-05", st.Details().GetString(bundle.RelationKeyName))
+	assert.Equal(t, []string{"{{date}}"}, st.Details().GetStringList(bundle.RelationKeyTag))
+
+	textBlock := st.Get("text").Model().GetText()
+	assert.Equal(t, "Meeting 2024-03-05 by Alice", textBlock.Text)
+	require.Len(t, textBlock.Marks.Marks, 1)
+	assert.Equal(t, &model.Range{From: 22, To: 27}, textBlock.Marks.Marks[0].Range)
+}
+
+func TestService_CreateTemplateStateWithPlaceholders(t *testing.T) {
+	// given
+	tmpl := newTemplateTest("template", "")
+	err := tmpl.(*smarttest.SmartTest).SetDetails(nil, []domain.Detail{{Key: bundle.RelationKeyName, Value: domain.String("Daily {{date:YYYY}}")}}, false)
+	require.NoError(t, err)
+	s := service{picker: &testPicker{sb: tmpl}, store: objectstore.NewStoreFixture(t)}
+
+	// when
+	st, err := s.CreateTemplateStateWithDetails(templateSvc.CreateTemplateRequest{SpaceId: "space", TemplateId: "template"})
+
+	// then
+	require.NoError(t, err)
+	expected := "Daily " + time.Now().Format("2006")
+	assert.Equal(t, expected, st.Details().GetString(bundle.RelationKeyName))
+	assert.Equal(t, expected, st.Get(template.TitleBlockId).Model().GetText().Text)
+}
+
+func TestService_CreateTemplateStateWithCounter(t *testing.T) {
+	// given
+	tmpl := newTemplateTest("template", "")
+	err := tmpl.(*smarttest.SmartTest).SetDetails(nil, []domain.Detail{{Key: bundle.RelationKeyName, Value: domain.String("Task {{counter}} ({{counter:3}})")}}, false)
+	require.NoError(t, err)
+	s := service{picker: &testPicker{sb: tmpl}, store: objectstore.NewStoreFixture(t)}
+
+	// the counter is increased once per created object
+	for _, expected := range []string{"Task 1 (001)", "Task 2 (002)"} {
+		// when
+		st, err := s.CreateTemplateStateWithDetails(templateSvc.CreateTemplateRequest{SpaceId: "space", TemplateId: "template"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, expected, st.Details().GetString(bundle.RelationKeyName))
+		assert.False(t, st.Details().Has(bundle.RelationKeyTemplateCounter))
+	}
+	assert.Equal(t, int64(2), tmpl.Details().GetInt64(bundle.RelationKeyTemplateCounter))
+}
+
+func TestService_CreateTemplateStateWithInvalidCounter(t *testing.T) {
+	// given
+	tmpl := newTemplateTest("template", "")
+	err := tmpl.(*smarttest.SmartTest).SetDetails(nil, []domain.Detail{{Key: bundle.RelationKeyName, Value: domain.String("Task {{counter:21}}")}}, false)
+	require.NoError(t, err)
+	s := service{picker: &testPicker{sb: tmpl}, store: objectstore.NewStoreFixture(t)}
+
+	// when
+	st, err := s.CreateTemplateStateWithDetails(templateSvc.CreateTemplateRequest{SpaceId: "space", TemplateId: "template"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "Task {{counter:21}}", st.Details().GetString(bundle.RelationKeyName))
+	assert.False(t, tmpl.Details().Has(bundle.RelationKeyTemplateCounter))
+}
+
+func TestFormatCounter(t *testing.T) {
+	for _, tc := range []struct {
+		arg      string
+		expected string
+		err      bool
+	}{
+		{arg: "", expected: "7"},
+		{arg: "3", expected: "007"},
+		{arg: "20", expected: "00000000000000000007"},
+		{arg: "21", err: true},
+		{arg: "1000000000", err: true},
+	} {
+		width, err := counterWidth(tc.arg)
+		if tc.err {
+			assert.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, formatCounter(7, width))
+	}
+}
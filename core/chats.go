@@ -6,10 +6,12 @@ import (
 
 	anystore "github.com/anyproto/any-store"
 	"github.com/gogo/protobuf/types"
+	"github.com/samber/lo"
 
 	"github.com/anyproto/anytype-heart/core/block/chats"
 	"github.com/anyproto/anytype-heart/core/block/chats/chatmodel"
 	"github.com/anyproto/anytype-heart/core/block/chats/chatrepository"
+	"github.com/anyproto/anytype-heart/core/block/editor/chatobject"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
@@ -20,7 +22,10 @@ func (mw *Middleware) ChatAddMessage(cctx context.Context, req *pb.RpcChatAddMes
 
 	messageId, err := chatService.AddMessage(cctx, ctx, req.ChatObjectId, &chatmodel.Message{ChatMessage: req.Message})
 	if err != nil {
-		code := mapErrorCode[pb.RpcChatAddMessageResponseErrorCode](err)
+		code := mapErrorCode(err,
+			errToCode(chatobject.ErrThreadRootNotFound, pb.RpcChatAddMessageResponseError_BAD_INPUT),
+			errToCode(chatobject.ErrNestedThread, pb.RpcChatAddMessageResponseError_BAD_INPUT),
+		)
 		return &pb.RpcChatAddMessageResponse{
 			Error: &pb.RpcChatAddMessageResponseError{
 				Code:        code,
@@ -92,6 +97,7 @@ func (mw *Middleware) ChatGetMessages(cctx context.Context, req *pb.RpcChatGetMe
 		BeforeOrderId:   req.BeforeOrderId,
 		Limit:           int(req.Limit),
 		IncludeBoundary: req.IncludeBoundary,
+		ThreadId:        req.ThreadId,
 	})
 	if err != nil {
 		code := mapErrorCode[pb.RpcChatGetMessagesResponseErrorCode](err)
@@ -130,7 +136,7 @@ func (mw *Middleware) ChatGetMessagesByIds(cctx context.Context, req *pb.RpcChat
 func (mw *Middleware) ChatSubscribeLastMessages(cctx context.Context, req *pb.RpcChatSubscribeLastMessagesRequest) *pb.RpcChatSubscribeLastMessagesResponse {
 	chatService := mustService[chats.Service](mw)
 
-	resp, err := chatService.SubscribeLastMessages(cctx, req.ChatObjectId, int(req.Limit), req.SubId)
+	resp, err := chatService.SubscribeLastMessages(cctx, req.ChatObjectId, req.ThreadId, int(req.Limit), req.SubId)
 	if err != nil {
 		code := mapErrorCode[pb.RpcChatSubscribeLastMessagesResponseErrorCode](err)
 		return &pb.RpcChatSubscribeLastMessagesResponse{
@@ -229,6 +235,7 @@ func (mw *Middleware) ChatReadMessages(cctx context.Context, request *pb.RpcChat
 		AfterOrderId:  request.AfterOrderId,
 		BeforeOrderId: request.BeforeOrderId,
 		LastStateId:   request.LastStateId,
+		ThreadId:      request.ThreadId,
 		CounterType:   chatmodel.CounterType(request.Type),
 	})
 	if err != nil {
@@ -283,3 +290,36 @@ func (mw *Middleware) ChatReadAll(cctx context.Context, req *pb.RpcChatReadAllRe
 	}
 	return &pb.RpcChatReadAllResponse{}
 }
+
+func (mw *Middleware) ChatSearchMessages(cctx context.Context, req *pb.RpcChatSearchMessagesRequest) *pb.RpcChatSearchMessagesResponse {
+	chatService := mustService[chats.Service](mw)
+
+	results, err := chatService.SearchMessages(cctx, chats.SearchMessagesRequest{
+		SpaceId:      req.SpaceId,
+		ChatObjectId: req.ChatObjectId,
+		Text:         req.Text,
+		Limit:        int(req.Limit),
+	})
+	code := mapErrorCode(err,
+		errToCode(chats.ErrEmptySearchText, pb.RpcChatSearchMessagesResponseError_BAD_INPUT),
+	)
+	if code != pb.RpcChatSearchMessagesResponseError_NULL {
+		return &pb.RpcChatSearchMessagesResponse{
+			Error: &pb.RpcChatSearchMessagesResponseError{
+				Code:        code,
+				Description: getErrorDescription(err),
+			},
+		}
+	}
+	return &pb.RpcChatSearchMessagesResponse{
+		Results: lo.Map(results, func(r *chats.SearchMessagesResult, _ int) *pb.RpcChatSearchMessagesResult {
+			return &pb.RpcChatSearchMessagesResult{
+				ChatObjectId:    r.ChatObjectId,
+				MessageId:       r.MessageId,
+				OrderId:         r.OrderId,
+				Highlight:       r.Highlight,
+				HighlightRanges: r.HighlightRanges,
+			}
+		}),
+	}
+}
@@ -43,6 +43,42 @@ const (
 	wrapExportEnd = `</div>
 			</body>
 		</html>`
+	// wrapPageStart is a format string with the page title, styles are inlined so exported pages are viewable offline
+	wrapPageStart = `<!DOCTYPE html>
+<html>
+	<head>
+		<meta http-equiv="content-type" content="text/html; charset=utf-8" />
+		<meta name="viewport" content="width=device-width, initial-scale=1" />
+		<title>%s</title>
+		<style type="text/css">
+			body { margin: 0px; font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; color: #252525; }
+			.anytype-container { max-width: 704px; margin: 0px auto; padding: 48px 16px; }
+			.row > * { display: flex; }
+			.header1 {` + styleHeader1 + `}
+			.header2 {` + styleHeader2 + `}
+			.header3 {` + styleHeader3 + `}
+			.quote {` + styleQuote + `}
+			.paragraph {` + styleParagraph + `}
+			.callout-image { width: 20px; height: 20px; font-size: 16px; line-height: 20px; margin-right: 6px; display: inline-block; }
+			.message { padding: 8px 0px; color: #aca996; }
+			.message .header { font-weight: 600; }
+			.file, .link, .bookmark { padding: 4px 0px; }
+			img { max-width: 100%%; }
+			a { color: inherit; }
+			kbd {` + styleKbd + `}
+			ul { margin: 0px; }
+		</style>
+	</head>
+	<body>
+		<div class="anytype-container">`
+	wrapPageEnd = `</div>
+	</body>
+</html>`
+
+	goToAnytypeMsg = `<div class="message">
+		<div class="header">This content is available in Anytype.</div>
+		Follow <a href="https://anytype.io">link</a> to ask a permission to get the content
+	</div>`
 
 	styleParagraph = "font-size: 15px; line-height: 24px; letter-spacing: -0.08px; font-weight: 400; word-wrap: break-word;"
 	styleHeader1   = "padding: 23px 0px 1px 0px; font-size: 28px; line-height: 32px; letter-spacing: -0.36px; font-weight: 600;"
@@ -0,0 +1,174 @@
+package html
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	Ext = ".html"
+
+	filesDirectory = "files"
+)
+
+type FileNamer interface {
+	Get(path, hash, title, ext string) (name string)
+}
+
+// NewExportConverter creates converter which renders the object as a standalone page placed by pagePath.
+// Links to known docs are rewritten to relative paths of their pages, and files are referenced from the files directory
+func NewExportConverter(s *state.State, fn FileNamer, pagePath string) converter.Converter {
+	return &exportConverter{
+		h: &HTML{
+			s:         s,
+			fn:        fn,
+			pagePath:  pagePath,
+			knownDocs: make(map[string]*domain.Details),
+		},
+	}
+}
+
+type exportConverter struct {
+	h *HTML
+}
+
+func (c *exportConverter) Convert(sbType model.SmartBlockType) []byte {
+	root := c.h.s.Pick(c.h.s.RootId())
+	if root == nil {
+		return nil
+	}
+	switch sbType {
+	case model.SmartBlockType_STType,
+		model.SmartBlockType_STRelation,
+		model.SmartBlockType_STRelationOption,
+		model.SmartBlockType_Participant,
+		model.SmartBlockType_SpaceView,
+		model.SmartBlockType_ChatObject,
+		model.SmartBlockType_ChatDerivedObject:
+		return nil
+	}
+	c.h.buf = bytes.NewBuffer(nil)
+	fmt.Fprintf(c.h.buf, wrapPageStart, html.EscapeString(PageTitle(c.h.s.Details(), c.h.s.Snippet())))
+	c.h.renderChildren(root.Model())
+	c.h.buf.WriteString(wrapPageEnd)
+	return c.h.buf.Bytes()
+}
+
+func (c *exportConverter) SetKnownDocs(docs map[string]*domain.Details) converter.Converter {
+	c.h.knownDocs = docs
+	return c
+}
+
+func (c *exportConverter) FileHashes() []string {
+	return c.h.fileHashes
+}
+
+func (c *exportConverter) ImageHashes() []string {
+	return c.h.imageHashes
+}
+
+func (c *exportConverter) Ext() string {
+	return Ext
+}
+
+// PageTitle returns the title of exported page
+func PageTitle(details *domain.Details, snippet string) string {
+	title := details.GetString(bundle.RelationKeyName)
+	if title == "" {
+		title = snippet
+	}
+	return title
+}
+
+type IndexEntry struct {
+	Title string
+	// Path is relative to the index page
+	Path string
+}
+
+// RenderIndex renders the page with links to all exported pages
+func RenderIndex(title string, entries []IndexEntry) []byte {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, wrapPageStart, html.EscapeString(title))
+	fmt.Fprintf(buf, `<h1 style="%s">%s</h1><ul>`, styleHeader1, html.EscapeString(title))
+	for _, entry := range entries {
+		fmt.Fprintf(buf, `<li><a href="%s">%s</a></li>`, linkPath(entry.Path), html.EscapeString(entry.Title))
+	}
+	buf.WriteString(`</ul>`)
+	buf.WriteString(wrapPageEnd)
+	return buf.Bytes()
+}
+
+func (h *HTML) renderExportedFile(b *model.Block, file *model.BlockContentFile) {
+	_, href, ok := h.objectLink(file.TargetObjectId)
+	switch {
+	case !ok:
+		h.buf.WriteString(`<div class="file"><div class="name">`)
+		h.buf.WriteString(html.EscapeString(file.Name))
+		h.buf.WriteString(`</div>`)
+		h.buf.WriteString(goToAnytypeMsg)
+	case file.Type == model.BlockContentFile_Image:
+		fmt.Fprintf(h.buf, `<div><img alt="%s" src="%s" />`, html.EscapeString(file.Name), href)
+		h.imageHashes = append(h.imageHashes, file.TargetObjectId)
+	default:
+		fmt.Fprintf(h.buf, `<div class="file"><a href="%s">%s</a>`, href, html.EscapeString(file.Name))
+		h.fileHashes = append(h.fileHashes, file.TargetObjectId)
+	}
+	h.renderChildren(b)
+	h.buf.WriteString("</div>")
+}
+
+// objectLink returns title and path of the object relative to the current page. Only objects included in export could be linked
+func (h *HTML) objectLink(objectId string) (title, href string, ok bool) {
+	info := h.knownDocs[objectId]
+	if info == nil {
+		return "", "", false
+	}
+	title = info.GetString(bundle.RelationKeyName)
+	var filename string
+	if isFileLayout(info.GetInt64(bundle.RelationKeyLayout)) {
+		ext := info.GetString(bundle.RelationKeyFileExt)
+		if ext != "" {
+			ext = "." + ext
+		}
+		name := strings.TrimSuffix(title, ext)
+		if name == "" {
+			name = objectId
+		}
+		filename = h.fn.Get(filesDirectory, objectId, name, ext)
+	} else {
+		if title == "" {
+			title = info.GetString(bundle.RelationKeySnippet)
+		}
+		filename = h.fn.Get("", objectId, title, Ext)
+	}
+	if title == "" {
+		title = objectId
+	}
+	rel, err := filepath.Rel(filepath.Dir(h.pagePath), filename)
+	if err != nil {
+		rel = filename
+	}
+	return title, linkPath(rel), true
+}
+
+func linkPath(path string) string {
+	return html.EscapeString(filepath.ToSlash(path))
+}
+
+func isFileLayout(layout int64) bool {
+	switch model.ObjectTypeLayout(layout) {
+	case model.ObjectType_file, model.ObjectType_image, model.ObjectType_audio, model.ObjectType_video, model.ObjectType_pdf:
+		return true
+	}
+	return false
+}
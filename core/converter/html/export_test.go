@@ -0,0 +1,107 @@
+package html
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+type testNamer struct{}
+
+func (testNamer) Get(path, hash, title, ext string) string {
+	return filepath.Join(path, hash+ext)
+}
+
+func givenLinks() *state.State {
+	s := state.NewDoc("root", map[string]simple.Block{
+		"root": simple.New(&model.Block{ChildrenIds: []string{"text", "link", "unknownLink", "image"}}),
+	}).(*state.State)
+	s.Add(simple.New(&model.Block{
+		Id: "text",
+		Content: &model.BlockContentOfText{
+			Text: &model.BlockContentText{
+				Text: "see page",
+				Marks: &model.BlockContentTextMarks{
+					Marks: []*model.BlockContentTextMark{
+						{Range: &model.Range{From: 4, To: 8}, Type: model.BlockContentTextMark_Mention, Param: "page"},
+					},
+				},
+			},
+		},
+	}))
+	s.Add(simple.New(&model.Block{Id: "link", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "page"}}}))
+	s.Add(simple.New(&model.Block{Id: "unknownLink", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "unknown"}}}))
+	s.Add(simple.New(&model.Block{
+		Id: "image",
+		Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+			Name:           "cat.png",
+			Type:           model.BlockContentFile_Image,
+			State:          model.BlockContentFile_Done,
+			TargetObjectId: "imageObject",
+		}},
+	}))
+	s.SetDetail(bundle.RelationKeyName, domain.String("Links & files"))
+	return s
+}
+
+func TestExportConverter_Convert(t *testing.T) {
+	knownDocs := map[string]*domain.Details{
+		"page": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName: domain.String("Page"),
+		}),
+		"imageObject": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName:    domain.String("cat.png"),
+			bundle.RelationKeyFileExt: domain.String("png"),
+			bundle.RelationKeyLayout:  domain.Int64(int64(model.ObjectType_image)),
+		}),
+	}
+
+	t.Run("links and files are relative", func(t *testing.T) {
+		// given
+		conv := NewExportConverter(givenLinks(), testNamer{}, "root.html").SetKnownDocs(knownDocs)
+
+		// when
+		result := string(conv.Convert(model.SmartBlockType_Page))
+
+		// then
+		assert.Contains(t, result, `<title>Links &amp; files</title>`)
+		assert.Contains(t, result, `see <a href="page.html">page</a>`)
+		assert.Contains(t, result, `<div class="link"><a href="page.html">Page</a></div>`)
+		assert.Contains(t, result, `<img alt="cat.png" src="files/imageObject.png" />`)
+		assert.Contains(t, result, goToAnytypeMsg)
+		assert.NotContains(t, result, "https://cdnjs")
+		assert.Equal(t, []string{"imageObject"}, conv.ImageHashes())
+		assert.Empty(t, conv.FileHashes())
+	})
+
+	t.Run("page in nested directory", func(t *testing.T) {
+		conv := NewExportConverter(givenLinks(), testNamer{}, "spaces/space1/root.html").SetKnownDocs(knownDocs)
+
+		result := string(conv.Convert(model.SmartBlockType_Page))
+
+		assert.Contains(t, result, `<a href="../../page.html">Page</a>`)
+	})
+
+	t.Run("object types are not exported", func(t *testing.T) {
+		conv := NewExportConverter(givenLinks(), testNamer{}, "root.html")
+
+		assert.Nil(t, conv.Convert(model.SmartBlockType_STType))
+	})
+}
+
+func TestRenderIndex(t *testing.T) {
+	result := string(RenderIndex("Export", []IndexEntry{
+		{Title: "First <page>", Path: "first.html"},
+		{Title: "Second", Path: "spaces/space1/second.html"},
+	}))
+
+	assert.Contains(t, result, `<li><a href="first.html">First &lt;page&gt;</a></li>`)
+	assert.Contains(t, result, `<li><a href="spaces/space1/second.html">Second</a></li>`)
+}
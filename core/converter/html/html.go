@@ -14,6 +14,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/editor/table"
 	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/files/fileobject"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -34,6 +35,13 @@ type HTML struct {
 	s                 *state.State
 	buf               *bytes.Buffer
 	fileObjectService fileobject.Service
+
+	// export mode fields, fn is nil when html is rendered for clipboard
+	fn          FileNamer
+	pagePath    string
+	knownDocs   map[string]*domain.Details
+	fileHashes  []string
+	imageHashes []string
 }
 
 func (h *HTML) Convert() (result string) {
@@ -162,10 +170,10 @@ func (h *HTML) renderFile(b *model.Block) {
 	if file.State != model.BlockContentFile_Done {
 		return
 	}
-	goToAnytypeMsg := `<div class="message">
-		<div class="header">This content is available in Anytype.</div>
-		Follow <a href="https://anytype.io">link</a> to ask a permission to get the content
-	</div>`
+	if h.fn != nil {
+		h.renderExportedFile(b, file)
+		return
+	}
 
 	switch file.Type {
 	case model.BlockContentFile_File:
@@ -263,13 +271,18 @@ func (h *HTML) renderLayout(b *model.Block) {
 }
 
 func (h *HTML) renderLink(b *model.Block) {
+	if h.fn != nil {
+		if title, href, ok := h.objectLink(b.GetLink().GetTargetBlockId()); ok {
+			fmt.Fprintf(h.buf, `<div class="link"><a href="%s">%s</a>`, href, html.EscapeString(title))
+			h.renderChildren(b)
+			h.buf.WriteString("</div>")
+			return
+		}
+	}
 	if len(b.ChildrenIds) > 0 {
 		h.buf.WriteString("<div>")
 	}
-	h.buf.WriteString(`<div class="message">
-		<div class="header">This content is available in Anytype.</div>
-		Follow <a href="https://anytype.io">link</a> to ask a permission to get the content
-	</div>`)
+	h.buf.WriteString(goToAnytypeMsg)
 	if len(b.ChildrenIds) > 0 {
 		h.renderChildren(b)
 		h.buf.WriteString("</div>")
@@ -381,6 +394,17 @@ func (h *HTML) writeTag(m *model.BlockContentTextMark, start bool) {
 		} else {
 			h.buf.WriteString("</a>")
 		}
+	case model.BlockContentTextMark_Mention, model.BlockContentTextMark_Object:
+		if h.fn == nil {
+			return
+		}
+		if _, href, ok := h.objectLink(m.Param); ok {
+			if start {
+				fmt.Fprintf(h.buf, `<a href="%s">`, href)
+			} else {
+				h.buf.WriteString("</a>")
+			}
+		}
 	case model.BlockContentTextMark_TextColor:
 		if start {
 			fmt.Fprintf(h.buf, `<span style="color:%s">`, textColor(m.Param))
@@ -0,0 +1,248 @@
+package ics
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	ext     = ".ics"
+	prodId  = "-//Anytype//Anytype Heart//EN"
+	uidHost = "anytype"
+
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405Z"
+	lineLength     = 75
+)
+
+// Settings describes which relations of objects make the calendar entry
+type Settings struct {
+	// CalendarName is the name of the calendar, usually the name of the exported set or collection
+	CalendarName string
+	// StartKey is the relation with the start of the event. Objects without it are skipped
+	StartKey domain.RelationKey
+	// EndKey is the relation with the end of the event, it is optional
+	EndKey domain.RelationKey
+	// IncludeTime is false for start relations with dates only, such dates make all-day events. The end is written
+	// in the same way, because the start and the end of the event must have the same value type
+	IncludeTime bool
+	// LocationKey is the relation with the location of the event, it is optional
+	LocationKey domain.RelationKey
+	// TimeZone is the time zone of all-day events, usually the time zone of the account. The time zone of the device
+	// is used when it is nil
+	TimeZone *time.Location
+}
+
+type entry struct {
+	id      string
+	start   int64
+	details *domain.Details
+	todo    bool
+	text    string
+}
+
+type ics struct {
+	settings  Settings
+	knownDocs map[string]*domain.Details
+	entries   []*entry
+	now       func() time.Time
+}
+
+// NewMultiConverter writes objects as events of iCalendar feed. Objects with todo layout become to-dos
+func NewMultiConverter(settings Settings) converter.MultiConverter {
+	if settings.StartKey == "" {
+		settings.StartKey = bundle.RelationKeyDueDate
+	}
+	if settings.TimeZone == nil {
+		settings.TimeZone = time.Local
+	}
+	return &ics{settings: settings, now: time.Now}
+}
+
+func (i *ics) SetKnownDocs(docs map[string]*domain.Details) converter.Converter {
+	i.knownDocs = docs
+	return i
+}
+
+func (i *ics) FileHashes() []string {
+	return nil
+}
+
+func (i *ics) ImageHashes() []string {
+	return nil
+}
+
+func (i *ics) Ext() string {
+	return ext
+}
+
+func (i *ics) Add(_ smartblock.Space, st *state.State) error {
+	details := st.CombinedDetails()
+	if !details.Has(i.settings.StartKey) {
+		return nil
+	}
+	i.entries = append(i.entries, &entry{
+		id:      st.RootId(),
+		start:   details.GetInt64(i.settings.StartKey),
+		details: details,
+		todo:    details.GetInt64(bundle.RelationKeyResolvedLayout) == int64(model.ObjectType_todo),
+		text:    plainText(st),
+	})
+	return nil
+}
+
+func (i *ics) Convert(_ model.SmartBlockType) []byte {
+	sort.SliceStable(i.entries, func(a, b int) bool {
+		if i.entries[a].start == i.entries[b].start {
+			return i.entries[a].id < i.entries[b].id
+		}
+		return i.entries[a].start < i.entries[b].start
+	})
+	w := &writer{}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + prodId)
+	w.line("CALSCALE:GREGORIAN")
+	if i.settings.CalendarName != "" {
+		w.line("X-WR-CALNAME:" + escapeText(i.settings.CalendarName))
+	}
+	stamp := i.now().UTC().Format(dateTimeLayout)
+	for _, e := range i.entries {
+		i.writeEntry(w, e, stamp)
+	}
+	w.line("END:VCALENDAR")
+	return w.buf.Bytes()
+}
+
+func (i *ics) writeEntry(w *writer, e *entry, stamp string) {
+	component := "VEVENT"
+	if e.todo {
+		component = "VTODO"
+	}
+	details := e.details
+	w.line("BEGIN:" + component)
+	w.line(fmt.Sprintf("UID:%s@%s", e.id, uidHost))
+	w.line("DTSTAMP:" + stamp)
+	if e.todo {
+		// due date of the task is its start in the exported view, so it is written as the due date of the to-do
+		w.line(i.dateProperty("DUE", e.start, false))
+		if details.GetBool(bundle.RelationKeyDone) {
+			w.line("STATUS:COMPLETED")
+		} else {
+			w.line("STATUS:NEEDS-ACTION")
+		}
+	} else {
+		w.line(i.dateProperty("DTSTART", e.start, false))
+		if i.settings.EndKey != "" && details.Has(i.settings.EndKey) {
+			if end := details.GetInt64(i.settings.EndKey); end >= e.start {
+				// the end of all-day events is exclusive
+				w.line(i.dateProperty("DTEND", end, !i.settings.IncludeTime))
+			}
+		}
+	}
+	w.line("SUMMARY:" + escapeText(details.GetString(bundle.RelationKeyName)))
+	description := e.text
+	if description == "" {
+		description = details.GetString(bundle.RelationKeyDescription)
+	}
+	if description != "" {
+		w.line("DESCRIPTION:" + escapeText(description))
+	}
+	if i.settings.LocationKey != "" {
+		if location := details.GetString(i.settings.LocationKey); location != "" {
+			w.line("LOCATION:" + escapeText(location))
+		}
+	}
+	if categories := i.tagNames(details.GetStringList(bundle.RelationKeyTag)); len(categories) > 0 {
+		w.line("CATEGORIES:" + strings.Join(categories, ","))
+	}
+	if rule := details.GetString(bundle.RelationKeyRecurrenceRule); rule != "" {
+		w.line("RRULE:" + strings.TrimPrefix(rule, "RRULE:"))
+	}
+	if created := details.GetInt64(bundle.RelationKeyCreatedDate); created > 0 {
+		w.line("CREATED:" + time.Unix(created, 0).UTC().Format(dateTimeLayout))
+	}
+	if modified := details.GetInt64(bundle.RelationKeyLastModifiedDate); modified > 0 {
+		w.line("LAST-MODIFIED:" + time.Unix(modified, 0).UTC().Format(dateTimeLayout))
+	}
+	w.line("END:" + component)
+}
+
+func (i *ics) tagNames(ids []string) []string {
+	var names []string
+	for _, id := range ids {
+		if details, ok := i.knownDocs[id]; ok {
+			if name := details.GetString(bundle.RelationKeyName); name != "" {
+				names = append(names, escapeText(name))
+			}
+		}
+	}
+	return names
+}
+
+// dateProperty writes times in UTC and dates in the time zone of the settings. nextDay is used for the exclusive
+// end of all-day events
+func (i *ics) dateProperty(name string, unix int64, nextDay bool) string {
+	if i.settings.IncludeTime {
+		return name + ":" + time.Unix(unix, 0).UTC().Format(dateTimeLayout)
+	}
+	t := time.Unix(unix, 0).In(i.settings.TimeZone)
+	if nextDay {
+		t = t.AddDate(0, 0, 1)
+	}
+	return name + ";VALUE=DATE:" + t.Format(dateLayout)
+}
+
+// plainText returns text of the object, which becomes the description of the event
+func plainText(st *state.State) string {
+	var lines []string
+	_ = st.Iterate(func(b simple.Block) (isContinue bool) {
+		text := b.Model().GetText()
+		if text == nil || text.Style == model.BlockContentText_Title || text.Style == model.BlockContentText_Description {
+			return true
+		}
+		if line := strings.TrimSpace(text.Text); line != "" {
+			lines = append(lines, line)
+		}
+		return true
+	})
+	return strings.Join(lines, "\n")
+}
+
+func escapeText(value string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(value)
+}
+
+// writer writes content lines, which are folded to lines of 75 octets
+type writer struct {
+	buf bytes.Buffer
+}
+
+func (w *writer) line(line string) {
+	limit := lineLength
+	for len(line) > limit {
+		cut := limit
+		// utf-8 sequences are not split between lines
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.buf.WriteString(line[:cut])
+		w.buf.WriteString("\r\n ")
+		line = line[cut:]
+		// continuation lines start with a space
+		limit = lineLength - 1
+	}
+	w.buf.WriteString(line)
+	w.buf.WriteString("\r\n")
+}
@@ -0,0 +1,153 @@
+package ics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func newState(id string, details map[domain.RelationKey]domain.Value, texts ...string) *state.State {
+	blocks := map[string]simple.Block{}
+	var childrenIds []string
+	for i, text := range texts {
+		blockId := id + "-text" + string(rune('0'+i))
+		blocks[blockId] = simple.New(&model.Block{
+			Id:      blockId,
+			Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: text}},
+		})
+		childrenIds = append(childrenIds, blockId)
+	}
+	blocks[id] = simple.New(&model.Block{Id: id, ChildrenIds: childrenIds})
+	st := state.NewDoc(id, blocks).(*state.State)
+	for key, value := range details {
+		st.SetDetail(key, value)
+	}
+	return st
+}
+
+func TestIcs_Convert(t *testing.T) {
+	start := time.Date(2024, 3, 11, 9, 30, 0, 0, time.UTC)
+
+	t.Run("events and to-dos", func(t *testing.T) {
+		// given
+		c := NewMultiConverter(Settings{
+			CalendarName: "Team, meetings",
+			StartKey:     "start",
+			EndKey:       "end",
+			IncludeTime:  true,
+			LocationKey:  "location",
+			TimeZone:     time.UTC,
+		})
+		c.(*ics).now = func() time.Time { return start }
+		c.SetKnownDocs(map[string]*domain.Details{
+			"tag1": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyName: domain.String("Work")}),
+		})
+		require.NoError(t, c.Add(nil, newState("standup", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName:           domain.String("Standup"),
+			"start":                          domain.Int64(start.Unix()),
+			"end":                            domain.Int64(start.Add(15 * time.Minute).Unix()),
+			"location":                       domain.String("Room 1; 2nd floor"),
+			bundle.RelationKeyTag:            domain.StringList([]string{"tag1", "missing"}),
+			bundle.RelationKeyRecurrenceRule: domain.String("RRULE:FREQ=WEEKLY"),
+		}, "Daily sync", "Bring updates")))
+		require.NoError(t, c.Add(nil, newState("report", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName:           domain.String("Send report"),
+			"start":                          domain.Int64(start.Add(-time.Hour).Unix()),
+			bundle.RelationKeyResolvedLayout: domain.Int64(int64(model.ObjectType_todo)),
+			bundle.RelationKeyDone:           domain.Bool(true),
+		})))
+		require.NoError(t, c.Add(nil, newState("note", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName: domain.String("Note without date"),
+		})))
+
+		// when
+		result := string(c.Convert(0))
+
+		// then
+		assert.Equal(t, strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"VERSION:2.0",
+			"PRODID:-//Anytype//Anytype Heart//EN",
+			"CALSCALE:GREGORIAN",
+			`X-WR-CALNAME:Team\, meetings`,
+			"BEGIN:VTODO",
+			"UID:report@anytype",
+			"DTSTAMP:20240311T093000Z",
+			"DUE:20240311T083000Z",
+			"STATUS:COMPLETED",
+			"SUMMARY:Send report",
+			"END:VTODO",
+			"BEGIN:VEVENT",
+			"UID:standup@anytype",
+			"DTSTAMP:20240311T093000Z",
+			"DTSTART:20240311T093000Z",
+			"DTEND:20240311T094500Z",
+			"SUMMARY:Standup",
+			`DESCRIPTION:Daily sync\nBring updates`,
+			`LOCATION:Room 1\; 2nd floor`,
+			"CATEGORIES:Work",
+			"RRULE:FREQ=WEEKLY",
+			"END:VEVENT",
+			"END:VCALENDAR",
+			"",
+		}, "\r\n"), result)
+	})
+
+	t.Run("all-day events", func(t *testing.T) {
+		// given
+		c := NewMultiConverter(Settings{StartKey: "start", EndKey: "end", TimeZone: time.UTC})
+		day := time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)
+		require.NoError(t, c.Add(nil, newState("offsite", map[domain.RelationKey]domain.Value{
+			"start": domain.Int64(day.Unix()),
+			"end":   domain.Int64(day.AddDate(0, 0, 2).Unix()),
+		})))
+
+		// when
+		result := string(c.Convert(0))
+
+		// then
+		assert.Contains(t, result, "DTSTART;VALUE=DATE:20240320\r\n")
+		// the end is exclusive
+		assert.Contains(t, result, "DTEND;VALUE=DATE:20240323\r\n")
+	})
+
+	t.Run("due date by default", func(t *testing.T) {
+		// given
+		c := NewMultiConverter(Settings{TimeZone: time.UTC})
+		require.NoError(t, c.Add(nil, newState("task", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyDueDate: domain.Int64(start.Unix()),
+		})))
+
+		// when
+		result := string(c.Convert(0))
+
+		// then
+		assert.Contains(t, result, "DTSTART;VALUE=DATE:20240311\r\n")
+	})
+}
+
+func TestWriter_Line(t *testing.T) {
+	// given
+	w := &writer{}
+	line := "DESCRIPTION:" + strings.Repeat("é", 100)
+
+	// when
+	w.line(line)
+
+	// then
+	lines := strings.Split(strings.TrimSuffix(w.buf.String(), "\r\n"), "\r\n ")
+	require.Len(t, lines, 3)
+	for _, l := range lines {
+		assert.LessOrEqual(t, len(l), lineLength)
+	}
+	assert.Equal(t, line, strings.Join(lines, ""))
+}
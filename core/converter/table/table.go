@@ -0,0 +1,68 @@
+package table
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	CSVExt  = ".csv"
+	XLSXExt = ".xlsx"
+)
+
+// Cell is a value of the table. Numbers keep their type in spreadsheets, all other values are written as text
+type Cell struct {
+	Text     string
+	Number   float64
+	IsNumber bool
+}
+
+func Text(text string) Cell {
+	return Cell{Text: text}
+}
+
+func Number(number float64) Cell {
+	return Cell{Text: strconv.FormatFloat(number, 'f', -1, 64), Number: number, IsNumber: true}
+}
+
+// Table is the view of set or collection: the header with names of relations and rows with values of objects
+type Table struct {
+	Name   string
+	Header []string
+	Rows   [][]Cell
+}
+
+// WriteCSV writes the table in the format read by CSV import: the first row is the header, the first column is the name.
+// Text that spreadsheets would evaluate as a formula is prefixed with an apostrophe, so it is imported with the prefix
+func WriteCSV(w io.Writer, t *Table) error {
+	cw := csv.NewWriter(w)
+	header := make([]string, 0, len(t.Header))
+	for _, name := range t.Header {
+		header = append(header, csvValue(Text(name)))
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	record := make([]string, len(t.Header))
+	for _, row := range t.Rows {
+		record = record[:0]
+		for _, cell := range row {
+			record = append(record, csvValue(cell))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvValue escapes text that spreadsheets would evaluate as a formula when the file is opened
+func csvValue(cell Cell) string {
+	if !cell.IsNumber && cell.Text != "" && strings.ContainsRune("=+-@\t\r", rune(cell.Text[0])) {
+		return "'" + cell.Text
+	}
+	return cell.Text
+}
@@ -0,0 +1,110 @@
+package table
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTable() *Table {
+	return &Table{
+		Name:   "Tasks: Q1 / Q2",
+		Header: []string{"Name", "Tag", "Estimate"},
+		Rows: [][]Cell{
+			{Text("Report"), Text("work, urgent"), Number(2.5)},
+			{Text(`Plan "next" <steps>`), Text(""), Number(10)},
+		},
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	// given
+	var buf bytes.Buffer
+
+	// when
+	err := WriteCSV(&buf, testTable())
+
+	// then
+	require.NoError(t, err)
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"Name", "Tag", "Estimate"},
+		{"Report", "work, urgent", "2.5"},
+		{`Plan "next" <steps>`, "", "10"},
+	}, records)
+}
+
+func TestWriteCSVFormulas(t *testing.T) {
+	// given
+	var buf bytes.Buffer
+	table := &Table{
+		Header: []string{"Name", "=Value"},
+		Rows: [][]Cell{
+			{Text("=HYPERLINK(\"http://example.com\")"), Number(-3)},
+			{Text("+1"), Text("-1")},
+			{Text("@SUM(A1)"), Text("a=b")},
+			{Text("\t=1"), Text("\r=2")},
+		},
+	}
+
+	// when
+	err := WriteCSV(&buf, table)
+
+	// then
+	require.NoError(t, err)
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"Name", "'=Value"},
+		{`'=HYPERLINK("http://example.com")`, "-3"},
+		{"'+1", "'-1"},
+		{"'@SUM(A1)", "a=b"},
+		{"'\t=1", "'\r=2"},
+	}, records)
+}
+
+func TestWriteXLSX(t *testing.T) {
+	// given
+	var buf bytes.Buffer
+
+	// when
+	err := WriteXLSX(&buf, testTable())
+
+	// then
+	require.NoError(t, err)
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	files := make(map[string]string, len(reader.File))
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[file.Name] = string(data)
+	}
+	assert.Contains(t, files, "[Content_Types].xml")
+	assert.Contains(t, files, "xl/styles.xml")
+	assert.Contains(t, files["xl/workbook.xml"], `<sheet name="Tasks  Q1   Q2" sheetId="1" r:id="rId1"/>`)
+	sheet := files["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, `<row r="1"><c r="A1" s="1" t="inlineStr"><is><t xml:space="preserve">Name</t></is></c>`)
+	assert.Contains(t, sheet, `<c r="C2"><v>2.5</v></c>`)
+	assert.Contains(t, sheet, `<c r="A3" t="inlineStr"><is><t xml:space="preserve">Plan &#34;next&#34; &lt;steps&gt;</t></is></c><c r="B3"/>`)
+}
+
+func TestColumnName(t *testing.T) {
+	for index, name := range map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"} {
+		assert.Equal(t, name, columnName(index))
+	}
+}
+
+func TestSheetName(t *testing.T) {
+	assert.Equal(t, "Sheet1", sheetName("[]"))
+	assert.Equal(t, "A very long name of the collect", sheetName("A very long name of the collection"))
+}
@@ -0,0 +1,163 @@
+package table
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	sheetNameLength  = 31
+	defaultSheetName = "Sheet1"
+
+	headerStyle = 1
+)
+
+var sheetNameReplacer = strings.NewReplacer("[", " ", "]", " ", ":", " ", "*", " ", "?", " ", "/", " ", `\`, " ")
+
+// xlsxParts are the parts of the workbook, which do not depend on the table
+var xlsxParts = []struct {
+	name    string
+	content string
+}{
+	{
+		name: "[Content_Types].xml",
+		content: xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+			`</Types>`,
+	},
+	{
+		name: "_rels/.rels",
+		content: xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`,
+	},
+	{
+		name: "xl/_rels/workbook.xml.rels",
+		content: xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+			`</Relationships>`,
+	},
+	{
+		// the second cell format is the bold font of the header
+		name: "xl/styles.xml",
+		content: xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+			`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+			`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+			`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+			`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+			`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+			`</styleSheet>`,
+	},
+}
+
+// WriteXLSX writes the table as the workbook with a single sheet. Strings are written inline, so the workbook
+// has no shared strings table
+func WriteXLSX(w io.Writer, t *Table) error {
+	zw := zip.NewWriter(w)
+	for _, part := range xlsxParts {
+		if err := writeZipFile(zw, part.name, []byte(part.content)); err != nil {
+			return err
+		}
+	}
+	if err := writeZipFile(zw, "xl/workbook.xml", workbook(t.Name)); err != nil {
+		return err
+	}
+	if err := writeZipFile(zw, "xl/worksheets/sheet1.xml", worksheet(t)); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func writeZipFile(zw *zip.Writer, name string, content []byte) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(content)
+	return err
+}
+
+func workbook(name string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="`)
+	escape(&buf, sheetName(name))
+	buf.WriteString(`" sheetId="1" r:id="rId1"/></sheets></workbook>`)
+	return buf.Bytes()
+}
+
+// sheetName removes characters, which are not allowed in names of sheets, and cuts the name to 31 characters
+func sheetName(name string) string {
+	name = strings.Trim(strings.TrimSpace(sheetNameReplacer.Replace(name)), "'")
+	if utf8.RuneCountInString(name) > sheetNameLength {
+		name = strings.TrimSpace(string([]rune(name)[:sheetNameLength]))
+	}
+	if name == "" {
+		return defaultSheetName
+	}
+	return name
+}
+
+func worksheet(t *Table) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	header := make([]Cell, 0, len(t.Header))
+	for _, name := range t.Header {
+		header = append(header, Text(name))
+	}
+	writeRow(&buf, 1, header, headerStyle)
+	for i, row := range t.Rows {
+		writeRow(&buf, i+2, row, 0)
+	}
+	buf.WriteString(`</sheetData></worksheet>`)
+	return buf.Bytes()
+}
+
+func writeRow(buf *bytes.Buffer, rowNumber int, cells []Cell, style int) {
+	row := strconv.Itoa(rowNumber)
+	buf.WriteString(`<row r="` + row + `">`)
+	for i, cell := range cells {
+		buf.WriteString(`<c r="` + columnName(i) + row + `"`)
+		if style != 0 {
+			buf.WriteString(` s="` + strconv.Itoa(style) + `"`)
+		}
+		switch {
+		case cell.IsNumber:
+			buf.WriteString(`><v>` + strconv.FormatFloat(cell.Number, 'f', -1, 64) + `</v></c>`)
+		case cell.Text == "":
+			buf.WriteString(`/>`)
+		default:
+			buf.WriteString(` t="inlineStr"><is><t xml:space="preserve">`)
+			escape(buf, cell.Text)
+			buf.WriteString(`</t></is></c>`)
+		}
+	}
+	buf.WriteString(`</row>`)
+}
+
+// columnName returns the name of the column by its index: A, B, ..., Z, AA, AB, ...
+func columnName(index int) string {
+	var name []byte
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = append([]byte{byte('A' + (index-1)%26)}, name...)
+	}
+	return string(name)
+}
+
+func escape(buf *bytes.Buffer, text string) {
+	// characters, which are not allowed in xml, are replaced
+	_ = xml.EscapeText(buf, []byte(text))
+}
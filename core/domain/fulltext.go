@@ -2,6 +2,7 @@ package domain
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -10,15 +11,20 @@ const (
 	ObjectPathSeparator = "/"
 	blockPrefix         = "b"
 	relationPrefix      = "r"
+	messagePrefix       = "m"
+	pagePrefix          = "p"
 )
 
 type ObjectPath struct {
 	ObjectId    string
 	BlockId     string
 	RelationKey string
+	MessageId   string
+	// Page is the number of the page of the file content, starting from 1
+	Page int
 }
 
-// String returns the full path, e.g. "objectId-b-blockId" or "objectId-r-relationKey"
+// String returns the full path, e.g. "objectId-b-blockId", "objectId-r-relationKey", "objectId-m-messageId" or "objectId-p-1"
 func (o ObjectPath) String() string {
 	if o.HasBlock() {
 		return strings.Join([]string{o.ObjectId, blockPrefix, o.BlockId}, ObjectPathSeparator)
@@ -26,6 +32,12 @@ func (o ObjectPath) String() string {
 	if o.HasRelation() {
 		return strings.Join([]string{o.ObjectId, relationPrefix, o.RelationKey}, ObjectPathSeparator)
 	}
+	if o.HasMessage() {
+		return strings.Join([]string{o.ObjectId, messagePrefix, o.MessageId}, ObjectPathSeparator)
+	}
+	if o.HasPage() {
+		return strings.Join([]string{o.ObjectId, pagePrefix, strconv.Itoa(o.Page)}, ObjectPathSeparator)
+	}
 	return o.ObjectId
 }
 
@@ -37,6 +49,12 @@ func (o ObjectPath) ObjectRelativePath() string {
 	if o.HasRelation() {
 		return strings.Join([]string{relationPrefix, o.RelationKey}, ObjectPathSeparator)
 	}
+	if o.HasMessage() {
+		return strings.Join([]string{messagePrefix, o.MessageId}, ObjectPathSeparator)
+	}
+	if o.HasPage() {
+		return strings.Join([]string{pagePrefix, strconv.Itoa(o.Page)}, ObjectPathSeparator)
+	}
 	return ""
 }
 
@@ -52,6 +70,14 @@ func (o ObjectPath) HasBlock() bool {
 	return o.BlockId != ""
 }
 
+func (o ObjectPath) HasMessage() bool {
+	return o.MessageId != ""
+}
+
+func (o ObjectPath) HasPage() bool {
+	return o.Page > 0
+}
+
 func NewObjectPathWithBlock(objectId, blockId string) ObjectPath {
 	return ObjectPath{
 		ObjectId: objectId,
@@ -66,6 +92,22 @@ func NewObjectPathWithRelation(objectId, relationKey string) ObjectPath {
 	}
 }
 
+// NewObjectPathWithMessage returns the path of the chat message
+func NewObjectPathWithMessage(objectId, messageId string) ObjectPath {
+	return ObjectPath{
+		ObjectId:  objectId,
+		MessageId: messageId,
+	}
+}
+
+// NewObjectPathWithPage returns the path of the page of the file content
+func NewObjectPathWithPage(objectId string, page int) ObjectPath {
+	return ObjectPath{
+		ObjectId: objectId,
+		Page:     page,
+	}
+}
+
 func NewFromPath(path string) (ObjectPath, error) {
 	parts := strings.Split(path, ObjectPathSeparator)
 	if len(parts) == 3 && parts[1] == blockPrefix {
@@ -74,5 +116,13 @@ func NewFromPath(path string) (ObjectPath, error) {
 	if len(parts) == 3 && parts[1] == relationPrefix {
 		return NewObjectPathWithRelation(parts[0], parts[2]), nil
 	}
+	if len(parts) == 3 && parts[1] == messagePrefix {
+		return NewObjectPathWithMessage(parts[0], parts[2]), nil
+	}
+	if len(parts) == 3 && parts[1] == pagePrefix {
+		if page, err := strconv.Atoi(parts[2]); err == nil && page > 0 {
+			return NewObjectPathWithPage(parts[0], page), nil
+		}
+	}
 	return ObjectPath{ObjectId: path}, fmt.Errorf("fts invalid path: %s", path)
 }
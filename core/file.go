@@ -9,6 +9,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/domain/objectorigin"
+	"github.com/anyproto/anytype-heart/core/files/fileobject"
 	"github.com/anyproto/anytype-heart/core/files/fileoffloader"
 	"github.com/anyproto/anytype-heart/core/files/filespaceusage"
 	"github.com/anyproto/anytype-heart/core/files/reconciler"
@@ -234,3 +235,32 @@ func (mw *Middleware) FileReconcile(ctx context.Context, req *pb.RpcFileReconcil
 	}
 	return &pb.RpcFileReconcileResponse{}
 }
+
+func (mw *Middleware) FileListDuplicates(ctx context.Context, req *pb.RpcFileListDuplicatesRequest) *pb.RpcFileListDuplicatesResponse {
+	groups, err := mustService[fileobject.Service](mw).ListDuplicates(req.SpaceId)
+	resp := &pb.RpcFileListDuplicatesResponse{
+		Error: &pb.RpcFileListDuplicatesResponseError{
+			Code:        mapErrorCode[pb.RpcFileListDuplicatesResponseErrorCode](err),
+			Description: getErrorDescription(err),
+		},
+	}
+	for _, group := range groups {
+		resp.Groups = append(resp.Groups, &pb.RpcFileListDuplicatesResponseGroup{
+			Checksum:   group.Checksum,
+			ObjectIds:  group.ObjectIds,
+			BytesUsage: uint64(group.Size),
+		})
+	}
+	return resp
+}
+
+func (mw *Middleware) FileMergeDuplicates(ctx context.Context, req *pb.RpcFileMergeDuplicatesRequest) *pb.RpcFileMergeDuplicatesResponse {
+	removedIds, err := mustService[fileobject.Service](mw).MergeDuplicates(ctx, req.SpaceId, req.Checksums)
+	return &pb.RpcFileMergeDuplicatesResponse{
+		Error: &pb.RpcFileMergeDuplicatesResponseError{
+			Code:        mapErrorCode[pb.RpcFileMergeDuplicatesResponseErrorCode](err),
+			Description: getErrorDescription(err),
+		},
+		RemovedObjectIds: removedIds,
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/mill/mediaprobe"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/storage"
 	"github.com/anyproto/anytype-heart/util/constant"
@@ -82,6 +83,44 @@ func (f *file) audioDetails(ctx context.Context) (*domain.Details, error) {
 	return d, nil
 }
 
+// mediaDetails returns duration, dimensions and codecs of audio and video files. Tags are filled only for video,
+// as audio tags are read by audioDetails
+func (f *file) mediaDetails(ctx context.Context) (*domain.Details, error) {
+	r, err := f.Reader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := mediaprobe.Probe(r)
+	if err != nil {
+		return nil, err
+	}
+
+	d := domain.NewDetails()
+	if info.Duration > 0 {
+		d.SetFloat64(bundle.RelationKeyDuration, info.Duration.Seconds())
+	}
+	if info.Width > 0 && info.Height > 0 {
+		d.SetInt64(bundle.RelationKeyWidthInPixels, int64(info.Width))
+		d.SetInt64(bundle.RelationKeyHeightInPixels, int64(info.Height))
+	}
+	if info.VideoCodec != "" {
+		d.SetString(bundle.RelationKeyVideoCodec, info.VideoCodec)
+	}
+	if info.AudioCodec != "" {
+		d.SetString(bundle.RelationKeyAudioCodec, info.AudioCodec)
+	}
+	if strings.HasPrefix(f.info.Media, "video") {
+		if info.Artist != "" {
+			d.SetString(bundle.RelationKeyArtist, info.Artist)
+		}
+		if info.Album != "" {
+			d.SetString(bundle.RelationKeyAudioAlbum, info.Album)
+		}
+	}
+	return d, nil
+}
+
 func (f *file) Details(ctx context.Context) (*domain.Details, domain.TypeKey, error) {
 	meta := f.Meta()
 
@@ -100,6 +139,9 @@ func (f *file) Details(ctx context.Context) (*domain.Details, domain.TypeKey, er
 	if strings.HasPrefix(meta.Media, "video") {
 		typeKey = bundle.TypeKeyVideo
 		details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_video))
+		if mediaDetails, err := f.mediaDetails(ctx); err == nil {
+			details = details.Merge(mediaDetails)
+		}
 	}
 
 	if strings.HasPrefix(meta.Media, "audio") {
@@ -107,6 +149,9 @@ func (f *file) Details(ctx context.Context) (*domain.Details, domain.TypeKey, er
 		if audioDetails, err := f.audioDetails(ctx); err == nil {
 			details = details.Merge(audioDetails)
 		}
+		if mediaDetails, err := f.mediaDetails(ctx); err == nil {
+			details = details.Merge(mediaDetails)
+		}
 		typeKey = bundle.TypeKeyAudio
 	}
 	if filepath.Ext(meta.Name) == constant.SvgExt {
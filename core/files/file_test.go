@@ -1,10 +1,13 @@
 package files
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/anyproto/anytype-heart/core/domain"
@@ -99,6 +102,26 @@ func TestFile_Details(t *testing.T) {
 		assert.Equal(t, "file", details.GetString(bundle.RelationKeyName))
 		assert.Equal(t, "id", details.GetString(bundle.RelationKeyFileId))
 	})
+	t.Run("video file with media metadata", func(t *testing.T) {
+		// given
+		fx.eventSender.EXPECT().Broadcast(mock.Anything).Return().Maybe()
+		added, err := fx.FileAdd(context.Background(), spaceId, WithName("clip.mp4"), WithReader(bytes.NewReader(buildMP4(12000, 1280, 720))))
+		require.NoError(t, err)
+		added.Commit()
+		f, err := NewFile(fx, domain.FullFileId{SpaceId: spaceId, FileId: added.FileId}, added.Variants)
+		require.NoError(t, err)
+
+		// when
+		details, typeKey, err := f.Details(context.Background())
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, bundle.TypeKeyVideo, typeKey)
+		assert.Equal(t, float64(12), details.GetFloat64(bundle.RelationKeyDuration))
+		assert.Equal(t, int64(1280), details.GetInt64(bundle.RelationKeyWidthInPixels))
+		assert.Equal(t, int64(720), details.GetInt64(bundle.RelationKeyHeightInPixels))
+		assert.Equal(t, "h264", details.GetString(bundle.RelationKeyVideoCodec))
+	})
 	t.Run("pdf file", func(t *testing.T) {
 		// given
 		f, err := NewFile(fx, domain.FullFileId{SpaceId: spaceId, FileId: "id"}, []*storage.FileInfo{
@@ -121,3 +144,31 @@ func TestFile_Details(t *testing.T) {
 		assert.Equal(t, "id", details.GetString(bundle.RelationKeyFileId))
 	})
 }
+
+func mp4Box(boxType string, data ...[]byte) []byte {
+	payload := bytes.Join(data, nil)
+	box := binary.BigEndian.AppendUint32(nil, uint32(8+len(payload)))
+	return append(append(box, boxType...), payload...)
+}
+
+// buildMP4 creates a file with a single video track without media data
+func buildMP4(durationMs, width, height uint32) []byte {
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:], 1000)
+	binary.BigEndian.PutUint32(mvhd[16:], durationMs)
+	tkhd := make([]byte, 84)
+	binary.BigEndian.PutUint32(tkhd[76:], width<<16)
+	binary.BigEndian.PutUint32(tkhd[80:], height<<16)
+	hdlr := append(make([]byte, 8), "vide"...)
+	stsd := append(binary.BigEndian.AppendUint32(make([]byte, 4), 1), mp4Box("avc1", make([]byte, 16))...)
+	return bytes.Join([][]byte{
+		mp4Box("ftyp", []byte("isom\x00\x00\x02\x00isommp41")),
+		mp4Box("moov",
+			mp4Box("mvhd", mvhd),
+			mp4Box("trak",
+				mp4Box("tkhd", tkhd),
+				mp4Box("mdia", mp4Box("hdlr", hdlr, make([]byte, 12)), mp4Box("minf", mp4Box("stbl", mp4Box("stsd", stsd)))),
+			),
+		),
+	}, nil)
+}
@@ -0,0 +1,325 @@
+package fileobject
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files/fileobject/filemodels"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// GetDuplicateObject returns the oldest file object of the space with the given checksum of the original content and
+// the mill of its first variant. It is used to reuse the file object before the file is added to the storage
+func (s *service) GetDuplicateObject(spaceId string, checksum string, millId string) (string, *domain.Details, error) {
+	if checksum == "" {
+		return "", nil, filemodels.ErrObjectNotFound
+	}
+	records, err := s.objectStore.SpaceIndex(spaceId).Query(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyFileSourceChecksum,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.String(checksum),
+			},
+			{
+				RelationKey: bundle.RelationKeyFileVariantMills,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.String(millId),
+			},
+		},
+		Sorts: []database.SortRequest{
+			{
+				RelationKey: bundle.RelationKeyAddedDate,
+				Type:        model.BlockContentDataviewSort_Asc,
+			},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("query objects by checksum: %w", err)
+	}
+	if len(records) == 0 {
+		return "", nil, filemodels.ErrObjectNotFound
+	}
+	details := records[0].Details
+	return details.GetString(bundle.RelationKeyId), details, nil
+}
+
+// ListDuplicates groups file objects of the space by content checksum and layout, only groups with more than one object are returned
+func (s *service) ListDuplicates(spaceId string) ([]filemodels.DuplicateGroup, error) {
+	records, err := s.objectStore.SpaceIndex(spaceId).Query(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyResolvedLayout,
+				Condition:   model.BlockContentDataviewFilter_In,
+				Value:       domain.Int64List(domain.FileLayouts),
+			},
+			{
+				RelationKey: bundle.RelationKeyFileSourceChecksum,
+				Condition:   model.BlockContentDataviewFilter_NotEmpty,
+			},
+			{
+				RelationKey: bundle.RelationKeyFileId,
+				Condition:   model.BlockContentDataviewFilter_NotEmpty,
+			},
+		},
+		Sorts: []database.SortRequest{
+			{
+				RelationKey: bundle.RelationKeyAddedDate,
+				Type:        model.BlockContentDataviewSort_Asc,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query file objects: %w", err)
+	}
+
+	// images and files with the same content are not duplicates, as they are shown differently
+	type groupKey struct {
+		checksum string
+		layout   int64
+	}
+	var (
+		keys   []groupKey
+		groups = map[groupKey]*filemodels.DuplicateGroup{}
+	)
+	for _, rec := range records {
+		key := groupKey{
+			checksum: rec.Details.GetString(bundle.RelationKeyFileSourceChecksum),
+			layout:   rec.Details.GetInt64(bundle.RelationKeyResolvedLayout),
+		}
+		group, ok := groups[key]
+		if !ok {
+			group = &filemodels.DuplicateGroup{
+				Checksum: key.checksum,
+				Size:     rec.Details.GetInt64(bundle.RelationKeySizeInBytes),
+			}
+			groups[key] = group
+			keys = append(keys, key)
+		}
+		group.ObjectIds = append(group.ObjectIds, rec.Details.GetString(bundle.RelationKeyId))
+	}
+
+	var result []filemodels.DuplicateGroup
+	for _, key := range keys {
+		if group := groups[key]; len(group.ObjectIds) > 1 {
+			result = append(result, *group)
+		}
+	}
+	return result, nil
+}
+
+// MergeDuplicates replaces links to duplicated files with the oldest file object of each group in objects that link them.
+// Replaced file objects are moved to the bin and their data is removed from the sync and the local storage.
+// Empty checksums mean all groups. Returns ids of replaced file objects
+func (s *service) MergeDuplicates(ctx context.Context, spaceId string, checksums []string) ([]string, error) {
+	groups, err := s.ListDuplicates(spaceId)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		removedIds   []string
+		replacements = map[string]string{}
+	)
+	for _, group := range groups {
+		if len(checksums) > 0 && !slices.Contains(checksums, group.Checksum) {
+			continue
+		}
+		for _, id := range group.ObjectIds[1:] {
+			replacements[id] = group.ObjectIds[0]
+			removedIds = append(removedIds, id)
+		}
+	}
+	if len(removedIds) == 0 {
+		return nil, nil
+	}
+
+	spaceIndex := s.objectStore.SpaceIndex(spaceId)
+	var (
+		removedDetails = make(map[string]*domain.Details, len(removedIds))
+		hasImages      bool
+	)
+	for _, id := range removedIds {
+		details, err := spaceIndex.GetDetails(id)
+		if err != nil {
+			return nil, fmt.Errorf("get details of duplicate: %w", err)
+		}
+		removedDetails[id] = details
+		if details.GetInt64(bundle.RelationKeyResolvedLayout) == int64(model.ObjectType_image) {
+			hasImages = true
+		}
+	}
+
+	spc, err := s.spaceService.Get(ctx, spaceId)
+	if err != nil {
+		return nil, fmt.Errorf("get space: %w", err)
+	}
+	ids, err := s.listObjectsLinkingFiles(spaceId, removedIds, hasImages)
+	if err != nil {
+		return nil, fmt.Errorf("list objects linking duplicates: %w", err)
+	}
+	for _, id := range ids {
+		if _, ok := replacements[id]; ok {
+			continue
+		}
+		err = spc.Do(id, func(sb smartblock.SmartBlock) error {
+			st := sb.NewState()
+			if !replaceFileLinks(st, replacements) {
+				return nil
+			}
+			return sb.Apply(st)
+		})
+		if err != nil {
+			log.With("objectId", id).Warnf("replace links to duplicated files: %v", err)
+		}
+	}
+
+	if err = s.objectArchiver.SetListIsArchived(removedIds, true); err != nil {
+		return nil, fmt.Errorf("archive duplicates: %w", err)
+	}
+	deletedFileIds := map[domain.FileId]struct{}{}
+	for _, id := range removedIds {
+		fileId := domain.FileId(removedDetails[id].GetString(bundle.RelationKeyFileId))
+		if _, ok := deletedFileIds[fileId]; ok {
+			continue
+		}
+		deletedFileIds[fileId] = struct{}{}
+		fullId := domain.FullFileId{SpaceId: spaceId, FileId: fileId}
+		if err = s.deleteFileData(spaceId, id, fullId, removedIds); err != nil {
+			log.With("objectId", id).Warnf("delete data of duplicate: %v", err)
+		}
+	}
+	return removedIds, nil
+}
+
+// imageBlockLayouts are layouts of objects that can contain image blocks
+var imageBlockLayouts = []model.ObjectTypeLayout{
+	model.ObjectType_basic,
+	model.ObjectType_profile,
+	model.ObjectType_todo,
+	model.ObjectType_note,
+	model.ObjectType_bookmark,
+	model.ObjectType_set,
+	model.ObjectType_collection,
+}
+
+// listObjectsLinkingFiles returns ids of objects that link the given file objects in blocks or file relations.
+// Image blocks are not indexed as links, so all objects that can contain them are returned when withImageBlocks is set
+func (s *service) listObjectsLinkingFiles(spaceId string, fileObjectIds []string, withImageBlocks bool) ([]string, error) {
+	spaceIndex := s.objectStore.SpaceIndex(spaceId)
+	relations, err := spaceIndex.Query(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyResolvedLayout,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(model.ObjectType_relation),
+			},
+			{
+				RelationKey: bundle.RelationKeyRelationFormat,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(model.RelationFormat_file),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query file relations: %w", err)
+	}
+
+	value := domain.StringList(fileObjectIds)
+	filters := []database.FilterRequest{
+		{
+			RelationKey: bundle.RelationKeyLinks,
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       value,
+		},
+		{
+			RelationKey: bundle.RelationKeyCoverId,
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       value,
+		},
+	}
+	for _, rel := range relations {
+		filters = append(filters, database.FilterRequest{
+			RelationKey: domain.RelationKey(rel.Details.GetString(bundle.RelationKeyRelationKey)),
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       value,
+		})
+	}
+	if withImageBlocks {
+		filters = append(filters, database.FilterRequest{
+			RelationKey: bundle.RelationKeyResolvedLayout,
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       domain.Int64List(imageBlockLayouts),
+		})
+	}
+	ids, _, err := spaceIndex.QueryObjectIds(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				Operator:      model.BlockContentDataviewFilter_Or,
+				NestedFilters: filters,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query objects: %w", err)
+	}
+	return ids, nil
+}
+
+// replaceFileLinks replaces ids of file objects in blocks and details, returns true if anything is replaced
+func replaceFileLinks(st *state.State, replacements map[string]string) (changed bool) {
+	replace := func(id string) string {
+		if newId, ok := replacements[id]; ok {
+			changed = true
+			return newId
+		}
+		return id
+	}
+
+	var blockIds []string
+	st.Iterate(func(b simple.Block) (isContinue bool) {
+		if slices.ContainsFunc(blockLinks(b), func(id string) bool {
+			_, ok := replacements[id]
+			return ok
+		}) {
+			blockIds = append(blockIds, b.Model().Id)
+		}
+		return true
+	})
+	for _, id := range blockIds {
+		b := st.Get(id)
+		if f := b.Model().GetFile(); f != nil {
+			f.TargetObjectId = replace(f.TargetObjectId)
+			continue
+		}
+		if migrator, ok := b.(simple.FileMigrator); ok {
+			migrator.MigrateFile(replace)
+		}
+		if replacer, ok := b.(simple.ObjectLinkReplacer); ok {
+			replacer.ReplaceLinkIds(replace)
+		}
+	}
+
+	st.ModifyLinkedFilesInDetails(replace)
+	st.ModifyLinkedObjectsInDetails(replace)
+	return changed
+}
+
+func blockLinks(b simple.Block) (ids []string) {
+	if source, ok := b.(interface{ FillSmartIds(ids []string) []string }); ok {
+		ids = source.FillSmartIds(ids)
+	}
+	if iter, ok := b.(simple.LinkedFilesIterator); ok {
+		iter.IterateLinkedFiles(func(id string) {
+			ids = append(ids, id)
+		})
+	}
+	return ids
+}
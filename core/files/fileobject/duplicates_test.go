@@ -0,0 +1,217 @@
+package fileobject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files/fileobject/filemodels"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/mill"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space/clientspace/mock_clientspace"
+	"github.com/anyproto/anytype-heart/space/mock_space"
+)
+
+type archiverStub struct {
+	archivedIds []string
+}
+
+func (a *archiverStub) SetListIsArchived(objectIds []string, isArchived bool) error {
+	if isArchived {
+		a.archivedIds = append(a.archivedIds, objectIds...)
+	}
+	return nil
+}
+
+func givenFileObject(id string, fileId domain.FileId, checksum string, layout model.ObjectTypeLayout, addedDate int64) objectstore.TestObject {
+	return objectstore.TestObject{
+		bundle.RelationKeyId:                 domain.String(id),
+		bundle.RelationKeyFileId:             domain.String(fileId.String()),
+		bundle.RelationKeyFileSourceChecksum: domain.String(checksum),
+		bundle.RelationKeyFileVariantMills:   domain.StringList([]string{mill.BlobId}),
+		bundle.RelationKeyResolvedLayout:     domain.Int64(layout),
+		bundle.RelationKeyAddedDate:          domain.Int64(addedDate),
+		bundle.RelationKeySizeInBytes:        domain.Int64(1024),
+	}
+}
+
+func TestService_ListDuplicates(t *testing.T) {
+	store := objectstore.NewStoreFixture(t)
+	store.AddObjects(t, "space1", []objectstore.TestObject{
+		givenFileObject("file2", "fileId2", "checksum1", model.ObjectType_file, 2),
+		givenFileObject("file1", "fileId1", "checksum1", model.ObjectType_file, 1),
+		givenFileObject("image1", "fileId3", "checksum1", model.ObjectType_image, 3),
+		givenFileObject("file3", "fileId4", "checksum2", model.ObjectType_file, 4),
+	})
+	s := &service{objectStore: store}
+
+	groups, err := s.ListDuplicates("space1")
+
+	require.NoError(t, err)
+	assert.Equal(t, []filemodels.DuplicateGroup{
+		{Checksum: "checksum1", ObjectIds: []string{"file1", "file2"}, Size: 1024},
+	}, groups)
+}
+
+func TestService_GetDuplicateObject(t *testing.T) {
+	store := objectstore.NewStoreFixture(t)
+	store.AddObjects(t, "space1", []objectstore.TestObject{
+		givenFileObject("file2", "fileId2", "checksum1", model.ObjectType_file, 2),
+		givenFileObject("file1", "fileId1", "checksum1", model.ObjectType_file, 1),
+	})
+	s := &service{objectStore: store}
+
+	t.Run("oldest object with the same content", func(t *testing.T) {
+		id, _, err := s.GetDuplicateObject("space1", "checksum1", mill.BlobId)
+
+		require.NoError(t, err)
+		assert.Equal(t, "file1", id)
+	})
+
+	t.Run("other content", func(t *testing.T) {
+		_, _, err := s.GetDuplicateObject("space1", "checksum2", mill.BlobId)
+
+		assert.ErrorIs(t, err, filemodels.ErrObjectNotFound)
+	})
+
+	t.Run("other kind of variants", func(t *testing.T) {
+		_, _, err := s.GetDuplicateObject("space1", "checksum1", mill.ImageResizeId)
+
+		assert.ErrorIs(t, err, filemodels.ErrObjectNotFound)
+	})
+}
+
+func TestService_MergeDuplicates(t *testing.T) {
+	// given
+	store := objectstore.NewStoreFixture(t)
+	store.AddObjects(t, "space1", []objectstore.TestObject{
+		// the duplicate shares the file id with the survivor, so its data is kept
+		givenFileObject("file1", "fileId1", "checksum1", model.ObjectType_file, 1),
+		givenFileObject("file2", "fileId1", "checksum1", model.ObjectType_file, 2),
+		{
+			bundle.RelationKeyId:             domain.String("page1"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_basic),
+			bundle.RelationKeyLinks:          domain.StringList([]string{"file2"}),
+		},
+		{
+			bundle.RelationKeyId:             domain.String("page2"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_basic),
+			bundle.RelationKeyIconImage:      domain.String("file2"),
+		},
+		{
+			bundle.RelationKeyId:             domain.String("page3"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_basic),
+			bundle.RelationKeyLinks:          domain.StringList([]string{"file1"}),
+		},
+		{
+			bundle.RelationKeyId:             domain.String("iconImageRelation"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_relation),
+			bundle.RelationKeyRelationKey:    domain.String(bundle.RelationKeyIconImage),
+			bundle.RelationKeyRelationFormat: domain.Int64(model.RelationFormat_file),
+		},
+	})
+
+	page := smarttest.New("page1")
+	page.AddBlock(simple.New(&model.Block{Id: "page1", ChildrenIds: []string{"file", "text"}})).
+		AddBlock(simple.New(&model.Block{Id: "file", Content: &model.BlockContentOfFile{File: &model.BlockContentFile{TargetObjectId: "file2"}}})).
+		AddBlock(simple.New(&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text:  "mention",
+			Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{{Type: model.BlockContentTextMark_Mention, Param: "file2"}}},
+		}}}))
+	page.Doc.(*state.State).SetDetailAndBundledRelation(bundle.RelationKeyIconImage, domain.String("file2"))
+	pageWithIcon := smarttest.New("page2")
+	pageWithIcon.Doc.(*state.State).SetDetailAndBundledRelation(bundle.RelationKeyIconImage, domain.String("file2"))
+	// only objects that link duplicates are opened
+	objects := map[string]smartblock.SmartBlock{
+		"page1": page,
+		"page2": pageWithIcon,
+	}
+
+	space := mock_clientspace.NewMockSpace(t)
+	space.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(id string, apply func(smartblock.SmartBlock) error) error {
+		sb, ok := objects[id]
+		require.True(t, ok, "unexpected object %s", id)
+		return apply(sb)
+	})
+	spaceService := mock_space.NewMockService(t)
+	spaceService.EXPECT().Get(mock.Anything, "space1").Return(space, nil)
+	archiver := &archiverStub{}
+	s := &service{objectStore: store, spaceService: spaceService, objectArchiver: archiver}
+
+	// when
+	removedIds, err := s.MergeDuplicates(context.Background(), "space1", nil)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"file2"}, removedIds)
+	assert.Equal(t, []string{"file2"}, archiver.archivedIds)
+	assert.Equal(t, "file1", page.Pick("file").Model().GetFile().TargetObjectId)
+	assert.Equal(t, "file1", page.Pick("text").Model().GetText().Marks.Marks[0].Param)
+	assert.Equal(t, "file1", page.Details().GetString(bundle.RelationKeyIconImage))
+	assert.Equal(t, "file1", pageWithIcon.Details().GetString(bundle.RelationKeyIconImage))
+}
+
+func TestService_MergeDuplicateImages(t *testing.T) {
+	// given
+	store := objectstore.NewStoreFixture(t)
+	store.AddObjects(t, "space1", []objectstore.TestObject{
+		givenFileObject("image1", "fileId1", "checksum1", model.ObjectType_image, 1),
+		givenFileObject("image2", "fileId1", "checksum1", model.ObjectType_image, 2),
+		// image blocks are not indexed as links
+		{
+			bundle.RelationKeyId:             domain.String("page1"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_basic),
+		},
+		{
+			bundle.RelationKeyId:             domain.String("note1"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_note),
+		},
+		{
+			bundle.RelationKeyId:             domain.String("type1"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_objectType),
+		},
+	})
+
+	page := smarttest.New("page1")
+	page.AddBlock(simple.New(&model.Block{Id: "page1", ChildrenIds: []string{"image"}})).
+		AddBlock(simple.New(&model.Block{Id: "image", Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+			TargetObjectId: "image2",
+			Type:           model.BlockContentFile_Image,
+		}}}))
+	note := smarttest.New("note1")
+	objects := map[string]*smarttest.SmartTest{
+		"page1": page,
+		"note1": note,
+	}
+
+	space := mock_clientspace.NewMockSpace(t)
+	space.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(id string, apply func(smartblock.SmartBlock) error) error {
+		sb, ok := objects[id]
+		require.True(t, ok, "unexpected object %s", id)
+		return apply(sb)
+	})
+	spaceService := mock_space.NewMockService(t)
+	spaceService.EXPECT().Get(mock.Anything, "space1").Return(space, nil)
+	archiver := &archiverStub{}
+	s := &service{objectStore: store, spaceService: spaceService, objectArchiver: archiver}
+
+	// when
+	removedIds, err := s.MergeDuplicates(context.Background(), "space1", nil)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"image2"}, removedIds)
+	assert.Equal(t, []string{"image2"}, archiver.archivedIds)
+	assert.Equal(t, "image1", page.Pick("image").Model().GetFile().TargetObjectId)
+	assert.Len(t, note.Results.Applies, 0)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/files/fileobject/fileblocks"
 	"github.com/anyproto/anytype-heart/core/files/fileobject/filemodels"
 	"github.com/anyproto/anytype-heart/core/files/filestorage/rpcstore"
+	"github.com/anyproto/anytype-heart/core/files/textextractor"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
@@ -36,11 +37,13 @@ type indexer struct {
 	spaceService   space.Service
 	objectStore    objectstore.ObjectStore
 	accountService accountService
+	createPoster   posterCreator
 
 	query        database.Query
 	indexCtx     context.Context
 	indexCancel  func()
 	indexQueue   *mb.MB[indexRequest]
+	textQueue    *mb.MB[indexTextRequest]
 	isQueuedLock sync.RWMutex
 	isQueued     map[domain.FullID]struct{}
 
@@ -53,8 +56,10 @@ func (s *service) newIndexer() *indexer {
 		spaceService:   s.spaceService,
 		objectStore:    s.objectStore,
 		accountService: s.accountService,
+		createPoster:   s.createPoster,
 
 		indexQueue: mb.New[indexRequest](0),
+		textQueue:  mb.New[indexTextRequest](0),
 		isQueued:   make(map[domain.FullID]struct{}),
 
 		closeWg: &sync.WaitGroup{},
@@ -71,20 +76,25 @@ func (ind *indexer) run() {
 
 	ind.closeWg.Add(1)
 	go ind.runIndexingWorker()
+
+	ind.closeWg.Add(1)
+	go ind.runTextExtractionWorker()
 }
 
 func (ind *indexer) close() error {
 	ind.indexCancel()
 	ind.closeWg.Wait()
-	return ind.indexQueue.Close()
+	return errors.Join(ind.indexQueue.Close(), ind.textQueue.Close())
 }
 
 type indexRequest struct {
 	id     domain.FullID
 	fileId domain.FullFileId
+	// addPoster is set only on the device that uploaded the file, so other devices don't create their own posters
+	addPoster bool
 }
 
-func (ind *indexer) addToQueue(ctx context.Context, id domain.FullID, fileId domain.FullFileId) error {
+func (ind *indexer) addToQueue(ctx context.Context, id domain.FullID, fileId domain.FullFileId, addPoster bool) error {
 	ind.isQueuedLock.Lock()
 	defer ind.isQueuedLock.Unlock()
 	_, ok := ind.isQueued[id]
@@ -93,7 +103,19 @@ func (ind *indexer) addToQueue(ctx context.Context, id domain.FullID, fileId dom
 	}
 	ind.isQueued[id] = struct{}{}
 
-	return ind.indexQueue.Add(ctx, indexRequest{id: id, fileId: fileId})
+	return ind.indexQueue.Add(ctx, indexRequest{id: id, fileId: fileId, addPoster: addPoster})
+}
+
+// indexTextRequest is a request to extract the text of the file. Text is extracted in the separate worker,
+// as it can take a long time for big documents, and the file object shouldn't be locked meanwhile
+type indexTextRequest struct {
+	id     domain.FullID
+	fileId domain.FullFileId
+	infos  []*storage.FileInfo
+}
+
+func (ind *indexer) addToTextQueue(ctx context.Context, id domain.FullID, fileId domain.FullFileId, infos []*storage.FileInfo) error {
+	return ind.textQueue.Add(ctx, indexTextRequest{id: id, fileId: fileId, infos: infos})
 }
 
 func (ind *indexer) markIndexingDone(id domain.FullID) {
@@ -155,7 +177,7 @@ func (ind *indexer) addToQueueFromObjectStore(ctx context.Context) error {
 		if !fileId.Valid() {
 			continue
 		}
-		err = ind.addToQueue(ctx, id, fileId)
+		err = ind.addToQueue(ctx, id, fileId, false)
 		if err != nil {
 			return fmt.Errorf("add to index queue: %w", err)
 		}
@@ -202,6 +224,21 @@ func (ind *indexer) runIndexingWorker() {
 	}
 }
 
+func (ind *indexer) runTextExtractionWorker() {
+	defer ind.closeWg.Done()
+
+	for {
+		req, err := ind.textQueue.NewCond().WaitOne(ind.indexCtx)
+		if err != nil {
+			return
+		}
+		err = ind.extractText(ind.indexCtx, req.id, req.fileId, req.infos)
+		if err != nil {
+			log.Warnf("extract text of file %s: %v", req.id.ObjectID, err)
+		}
+	}
+}
+
 func logIndexLoop(err error) {
 	if errors.Is(err, treestorage.ErrUnknownTreeId) {
 		return
@@ -223,11 +260,11 @@ func (ind *indexer) indexNext(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("wait for index request: %w", err)
 	}
-	return ind.indexFile(ctx, req.id, req.fileId)
+	return ind.indexFile(ctx, req.id, req.fileId, req.addPoster)
 }
 
 // indexFile updates file details from metadata and adds file to local cache
-func (ind *indexer) indexFile(ctx context.Context, id domain.FullID, fileId domain.FullFileId) error {
+func (ind *indexer) indexFile(ctx context.Context, id domain.FullID, fileId domain.FullFileId, addPoster bool) error {
 	defer ind.markIndexingDone(id)
 
 	space, err := ind.spaceService.Get(ctx, id.SpaceID)
@@ -244,6 +281,16 @@ func (ind *indexer) indexFile(ctx context.Context, id domain.FullID, fileId doma
 		if err != nil {
 			return fmt.Errorf("inject metadata to state: %w", err)
 		}
+		err = ind.addToTextQueue(ctx, id, fileId, infos)
+		if err != nil {
+			log.Warnf("add file %s to text extraction queue: %v", id.ObjectID, err)
+		}
+		if addPoster {
+			err = ind.addPoster(ctx, st, fileId, infos)
+			if err != nil {
+				log.Warnf("add poster of file %s: %v", id.ObjectID, err)
+			}
+		}
 		return sb.Apply(st)
 	})
 	if err != nil {
@@ -282,6 +329,34 @@ func (ind *indexer) injectMetadataToState(ctx context.Context, st *state.State,
 	return nil
 }
 
+// extractText stores the text of document files and schedules the fulltext indexing of the object,
+// so the text can be found by the fulltext search
+func (ind *indexer) extractText(ctx context.Context, id domain.FullID, fileId domain.FullFileId, infos []*storage.FileInfo) error {
+	file, err := files.NewFile(ind.fileService, fileId, infos)
+	if err != nil {
+		return fmt.Errorf("new file: %w", err)
+	}
+	if file.Mill() != mill.BlobId || !textextractor.IsSupported(file.MimeType(), file.Name()) {
+		return nil
+	}
+	if file.Meta().Size > textextractor.MaxFileSize {
+		return nil
+	}
+	reader, err := file.Reader(ctx)
+	if err != nil {
+		return fmt.Errorf("get reader: %w", err)
+	}
+	pages, err := textextractor.Extract(reader, file.MimeType(), file.Name())
+	if err != nil {
+		return fmt.Errorf("extract: %w", err)
+	}
+	err = ind.objectStore.SpaceIndex(id.SpaceID).SetFileText(id.ObjectID, pages)
+	if err != nil {
+		return fmt.Errorf("store text: %w", err)
+	}
+	return ind.objectStore.AddToIndexQueue(ctx, id)
+}
+
 func (ind *indexer) buildDetails(ctx context.Context, id domain.FullFileId, infos []*storage.FileInfo) (details *domain.Details, typeKey domain.TypeKey, err error) {
 	file, err := files.NewFile(ind.fileService, id, infos)
 	if err != nil {
@@ -25,6 +25,14 @@ type CreateRequest struct {
 	AsyncMetadataIndexing bool
 }
 
+// DuplicateGroup is a set of file objects in a space with the same content
+type DuplicateGroup struct {
+	Checksum string
+	// ObjectIds are sorted by added date, so the first object is the one that survives the merge
+	ObjectIds []string
+	Size      int64
+}
+
 var (
 	ErrObjectNotFound = fmt.Errorf("file object not found")
 	ErrEmptyFileId    = fmt.Errorf("empty file id")
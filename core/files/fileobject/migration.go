@@ -185,7 +185,7 @@ func (s *service) migrateDeriveObject(ctx context.Context, space clientspace.Spa
 		return fmt.Errorf("create object: %w", err)
 	}
 	fullFileId := domain.FullFileId{SpaceId: space.Id(), FileId: req.FileId}
-	err = s.indexer.addToQueue(ctx, domain.FullID{SpaceID: space.Id(), ObjectID: id}, fullFileId)
+	err = s.indexer.addToQueue(ctx, domain.FullID{SpaceID: space.Id(), ObjectID: id}, fullFileId, false)
 	if err != nil {
 		// Will be retried in background, so don't return error
 		log.Errorf("add to index queue: %v", err)
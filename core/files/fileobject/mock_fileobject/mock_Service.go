@@ -351,6 +351,73 @@ func (_c *MockService_EnsureFileAddedToSyncQueue_Call) RunAndReturn(run func(dom
 	return _c
 }
 
+// GetDuplicateObject provides a mock function with given fields: spaceId, checksum, millId
+func (_m *MockService) GetDuplicateObject(spaceId string, checksum string, millId string) (string, *domain.Details, error) {
+	ret := _m.Called(spaceId, checksum, millId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDuplicateObject")
+	}
+
+	var r0 string
+	var r1 *domain.Details
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (string, *domain.Details, error)); ok {
+		return rf(spaceId, checksum, millId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = rf(spaceId, checksum, millId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) *domain.Details); ok {
+		r1 = rf(spaceId, checksum, millId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.Details)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, string) error); ok {
+		r2 = rf(spaceId, checksum, millId)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockService_GetDuplicateObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDuplicateObject'
+type MockService_GetDuplicateObject_Call struct {
+	*mock.Call
+}
+
+// GetDuplicateObject is a helper method to define mock.On call
+//   - spaceId string
+//   - checksum string
+//   - millId string
+func (_e *MockService_Expecter) GetDuplicateObject(spaceId interface{}, checksum interface{}, millId interface{}) *MockService_GetDuplicateObject_Call {
+	return &MockService_GetDuplicateObject_Call{Call: _e.mock.On("GetDuplicateObject", spaceId, checksum, millId)}
+}
+
+func (_c *MockService_GetDuplicateObject_Call) Run(run func(spaceId string, checksum string, millId string)) *MockService_GetDuplicateObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetDuplicateObject_Call) Return(_a0 string, _a1 *domain.Details, _a2 error) *MockService_GetDuplicateObject_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockService_GetDuplicateObject_Call) RunAndReturn(run func(string, string, string) (string, *domain.Details, error)) *MockService_GetDuplicateObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFileData provides a mock function with given fields: ctx, objectId
 func (_m *MockService) GetFileData(ctx context.Context, objectId string) (files.File, error) {
 	ret := _m.Called(ctx, objectId)
@@ -728,6 +795,124 @@ func (_c *MockService_InitEmptyFileState_Call) RunAndReturn(run func(*state.Stat
 	return _c
 }
 
+// ListDuplicates provides a mock function with given fields: spaceId
+func (_m *MockService) ListDuplicates(spaceId string) ([]filemodels.DuplicateGroup, error) {
+	ret := _m.Called(spaceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDuplicates")
+	}
+
+	var r0 []filemodels.DuplicateGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]filemodels.DuplicateGroup, error)); ok {
+		return rf(spaceId)
+	}
+	if rf, ok := ret.Get(0).(func(string) []filemodels.DuplicateGroup); ok {
+		r0 = rf(spaceId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]filemodels.DuplicateGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(spaceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDuplicates'
+type MockService_ListDuplicates_Call struct {
+	*mock.Call
+}
+
+// ListDuplicates is a helper method to define mock.On call
+//   - spaceId string
+func (_e *MockService_Expecter) ListDuplicates(spaceId interface{}) *MockService_ListDuplicates_Call {
+	return &MockService_ListDuplicates_Call{Call: _e.mock.On("ListDuplicates", spaceId)}
+}
+
+func (_c *MockService_ListDuplicates_Call) Run(run func(spaceId string)) *MockService_ListDuplicates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListDuplicates_Call) Return(_a0 []filemodels.DuplicateGroup, _a1 error) *MockService_ListDuplicates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListDuplicates_Call) RunAndReturn(run func(string) ([]filemodels.DuplicateGroup, error)) *MockService_ListDuplicates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeDuplicates provides a mock function with given fields: ctx, spaceId, checksums
+func (_m *MockService) MergeDuplicates(ctx context.Context, spaceId string, checksums []string) ([]string, error) {
+	ret := _m.Called(ctx, spaceId, checksums)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeDuplicates")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]string, error)); ok {
+		return rf(ctx, spaceId, checksums)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []string); ok {
+		r0 = rf(ctx, spaceId, checksums)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, spaceId, checksums)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_MergeDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeDuplicates'
+type MockService_MergeDuplicates_Call struct {
+	*mock.Call
+}
+
+// MergeDuplicates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spaceId string
+//   - checksums []string
+func (_e *MockService_Expecter) MergeDuplicates(ctx interface{}, spaceId interface{}, checksums interface{}) *MockService_MergeDuplicates_Call {
+	return &MockService_MergeDuplicates_Call{Call: _e.mock.On("MergeDuplicates", ctx, spaceId, checksums)}
+}
+
+func (_c *MockService_MergeDuplicates_Call) Run(run func(ctx context.Context, spaceId string, checksums []string)) *MockService_MergeDuplicates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockService_MergeDuplicates_Call) Return(_a0 []string, _a1 error) *MockService_MergeDuplicates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_MergeDuplicates_Call) RunAndReturn(run func(context.Context, string, []string) ([]string, error)) *MockService_MergeDuplicates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MigrateFileIdsInBlocks provides a mock function with given fields: st, spc
 func (_m *MockService) MigrateFileIdsInBlocks(st *state.State, spc source.Space) {
 	_m.Called(st, spc)
@@ -0,0 +1,98 @@
+package fileobject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/domain/objectorigin"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/files/fileobject/filemodels"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/mill"
+	"github.com/anyproto/anytype-heart/pkg/lib/mill/schema"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/storage"
+)
+
+type posterCreator func(ctx context.Context, spaceId string, origin objectorigin.ObjectOrigin, r io.ReadSeeker, name string) (string, error)
+
+// addPoster links the cover art embedded into audio and video files as a picture of the file object
+func (ind *indexer) addPoster(ctx context.Context, st *state.State, fileId domain.FullFileId, infos []*storage.FileInfo) error {
+	details := st.CombinedDetails()
+	if details.GetString(bundle.RelationKeyPicture) != "" {
+		return nil
+	}
+	layout := model.ObjectTypeLayout(details.GetInt64(bundle.RelationKeyLayout))
+	if layout != model.ObjectType_video && layout != model.ObjectType_audio {
+		return nil
+	}
+
+	file, err := files.NewFile(ind.fileService, fileId, infos)
+	if err != nil {
+		return fmt.Errorf("new file: %w", err)
+	}
+	thumbnailMill, err := schema.GetMill(mill.VideoThumbnailId, nil)
+	if err != nil {
+		return fmt.Errorf("get mill: %w", err)
+	}
+	if file.Mill() != mill.BlobId || thumbnailMill.AcceptMedia(file.MimeType()) != nil {
+		return nil
+	}
+	reader, err := file.Reader(ctx)
+	if err != nil {
+		return fmt.Errorf("get reader: %w", err)
+	}
+	res, err := thumbnailMill.Mill(reader, file.Name())
+	if errors.Is(err, mill.ErrNoPoster) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("make poster: %w", err)
+	}
+	defer res.File.Close()
+
+	name := strings.TrimSuffix(file.Name(), filepath.Ext(file.Name())) + "_poster.jpg"
+	posterId, err := ind.createPoster(ctx, fileId.SpaceId, objectorigin.FromDetails(details), res.File, name)
+	if err != nil {
+		return fmt.Errorf("create poster: %w", err)
+	}
+	st.SetDetailAndBundledRelation(bundle.RelationKeyPicture, domain.String(posterId))
+	return nil
+}
+
+// createPoster adds the poster as a hidden image object, the same poster is reused by files with the same cover art
+func (s *service) createPoster(ctx context.Context, spaceId string, origin objectorigin.ObjectOrigin, r io.ReadSeeker, name string) (string, error) {
+	addResult, err := s.fileService.ImageAdd(ctx, spaceId, files.WithReader(r), files.WithName(name))
+	if err != nil {
+		return "", fmt.Errorf("add image: %w", err)
+	}
+	defer addResult.Commit()
+
+	if addResult.IsExisting {
+		id, _, err := s.GetObjectDetailsByFileId(domain.FullFileId{SpaceId: spaceId, FileId: addResult.FileId})
+		if err == nil {
+			return id, nil
+		}
+		if !errors.Is(err, filemodels.ErrObjectNotFound) {
+			return "", fmt.Errorf("get object details by file id: %w", err)
+		}
+	}
+
+	id, _, err := s.Create(ctx, spaceId, filemodels.CreateRequest{
+		FileId:         addResult.FileId,
+		EncryptionKeys: addResult.EncryptionKeys.EncryptionKeys,
+		ObjectOrigin:   origin,
+		ImageKind:      model.ImageKind_AutomaticallyAdded,
+		FileVariants:   addResult.Variants,
+	})
+	if err != nil {
+		return "", fmt.Errorf("create file object: %w", err)
+	}
+	return id, nil
+}
@@ -0,0 +1,116 @@
+package fileobject
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/domain/objectorigin"
+	"github.com/anyproto/anytype-heart/core/files/mock_files"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func givenFlac(t *testing.T, withCover bool) []byte {
+	cover := bytes.NewBuffer(nil)
+	require.NoError(t, png.Encode(cover, image.NewRGBA(image.Rect(0, 0, 20, 10))))
+
+	streamInfo := make([]byte, 34)
+	binary.BigEndian.PutUint64(streamInfo[10:], uint64(44100)<<44|uint64(1)<<41|uint64(15)<<36|441000)
+
+	var picture []byte
+	picture = binary.BigEndian.AppendUint32(picture, 3)
+	picture = binary.BigEndian.AppendUint32(picture, uint32(len("image/png")))
+	picture = append(picture, "image/png"...)
+	picture = binary.BigEndian.AppendUint32(picture, 0)
+	picture = append(picture, make([]byte, 16)...)
+	picture = binary.BigEndian.AppendUint32(picture, uint32(cover.Len()))
+	picture = append(picture, cover.Bytes()...)
+
+	data := []byte("fLaC")
+	data = append(data, 0, 0, 0, byte(len(streamInfo)))
+	data = append(data, streamInfo...)
+	if !withCover {
+		data[4] |= 0x80
+		return data
+	}
+	data = append(data, 0x86, byte(len(picture)>>16), byte(len(picture)>>8), byte(len(picture)))
+	return append(data, picture...)
+}
+
+func newPosterIndexer(t *testing.T, content []byte) (*indexer, *[]string) {
+	fileService := mock_files.NewMockService(t)
+	fileService.EXPECT().GetContentReader(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(newNopCloserWrapper(bytes.NewReader(content)), nil).Maybe()
+
+	svc := &service{fileService: fileService, accountService: &dummyAccountService{}}
+	ind := svc.newIndexer()
+
+	var created []string
+	ind.createPoster = func(ctx context.Context, spaceId string, origin objectorigin.ObjectOrigin, r io.ReadSeeker, name string) (string, error) {
+		created = append(created, name)
+		return "posterId", nil
+	}
+	return ind, &created
+}
+
+func givenMediaState(layout model.ObjectTypeLayout, picture string) *state.State {
+	st := state.NewDoc("fileObjectId", nil).NewState()
+	st.SetDetail(bundle.RelationKeyLayout, domain.Int64(layout))
+	if picture != "" {
+		st.SetDetail(bundle.RelationKeyPicture, domain.String(picture))
+	}
+	return st
+}
+
+func TestIndexer_addPoster(t *testing.T) {
+	fileId := domain.FullFileId{SpaceId: "space1", FileId: testFileId}
+
+	t.Run("audio with cover art", func(t *testing.T) {
+		// given
+		ind, created := newPosterIndexer(t, givenFlac(t, true))
+		st := givenMediaState(model.ObjectType_audio, "")
+		infos := givenFileInfos("audio/flac")
+		infos[0].Name = "song.flac"
+
+		// when
+		err := ind.addPoster(context.Background(), st, fileId, infos)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"song_poster.jpg"}, *created)
+		assert.Equal(t, "posterId", st.Details().GetString(bundle.RelationKeyPicture))
+	})
+
+	t.Run("audio without cover art", func(t *testing.T) {
+		ind, created := newPosterIndexer(t, givenFlac(t, false))
+		st := givenMediaState(model.ObjectType_audio, "")
+
+		err := ind.addPoster(context.Background(), st, fileId, givenFileInfos("audio/flac"))
+
+		require.NoError(t, err)
+		assert.Empty(t, *created)
+		assert.Empty(t, st.Details().GetString(bundle.RelationKeyPicture))
+	})
+
+	t.Run("skip files with picture and non-media files", func(t *testing.T) {
+		ind, created := newPosterIndexer(t, givenFlac(t, true))
+
+		err := ind.addPoster(context.Background(), givenMediaState(model.ObjectType_audio, "picture"), fileId, givenFileInfos("audio/flac"))
+		require.NoError(t, err)
+		err = ind.addPoster(context.Background(), givenMediaState(model.ObjectType_file, ""), fileId, givenFileInfos("audio/flac"))
+		require.NoError(t, err)
+
+		assert.Empty(t, *created)
+	})
+}
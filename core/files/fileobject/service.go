@@ -63,6 +63,9 @@ type Service interface {
 	GetImageDataFromRawId(ctx context.Context, fileId domain.FileId) (files.Image, error)
 
 	GetObjectDetailsByFileId(fileId domain.FullFileId) (string, *domain.Details, error)
+	GetDuplicateObject(spaceId string, checksum string, millId string) (string, *domain.Details, error)
+	ListDuplicates(spaceId string) ([]filemodels.DuplicateGroup, error)
+	MergeDuplicates(ctx context.Context, spaceId string, checksums []string) ([]string, error)
 
 	MigrateFileIdsInDetails(st *state.State, spc source.Space)
 	MigrateFileIdsInBlocks(st *state.State, spc source.Space)
@@ -402,6 +405,10 @@ func (s *service) createInSpace(ctx context.Context, space clientspace.Space, re
 		if err != nil {
 			return "", nil, fmt.Errorf("inject metadata to state: %w", err)
 		}
+		err = s.indexer.addPoster(ctx, createState, fullFileId, req.FileVariants)
+		if err != nil {
+			log.Warnf("add poster of file %s: %v", fullObjectId.ObjectID, err)
+		}
 	}
 
 	if req.AdditionalDetails != nil {
@@ -417,11 +424,16 @@ func (s *service) createInSpace(ctx context.Context, space clientspace.Space, re
 	}
 
 	if req.AsyncMetadataIndexing {
-		err = s.indexer.addToQueue(ctx, domain.FullID{SpaceID: space.Id(), ObjectID: id}, domain.FullFileId{SpaceId: space.Id(), FileId: req.FileId})
+		err = s.indexer.addToQueue(ctx, domain.FullID{SpaceID: space.Id(), ObjectID: id}, domain.FullFileId{SpaceId: space.Id(), FileId: req.FileId}, true)
 		if err != nil {
 			// Will be retried in background, so don't return error
 			log.Errorf("add to index queue: %v", err)
 		}
+	} else {
+		err = s.indexer.addToTextQueue(ctx, domain.FullID{SpaceID: space.Id(), ObjectID: id}, domain.FullFileId{SpaceId: space.Id(), FileId: req.FileId}, req.FileVariants)
+		if err != nil {
+			log.Warnf("add file %s to text extraction queue: %v", id, err)
+		}
 	}
 
 	return id, object, nil
@@ -616,12 +628,17 @@ func (s *service) DeleteFileData(spaceId string, objectId string) error {
 	if err != nil {
 		return fmt.Errorf("get file id from object: %w", err)
 	}
+	return s.deleteFileData(spaceId, objectId, fullId, []string{objectId})
+}
+
+// deleteFileData removes the file from the sync and the local storage if it is not used by objects other than excludedIds
+func (s *service) deleteFileData(spaceId string, objectId string, fullId domain.FullFileId, excludedIds []string) error {
 	records, err := s.objectStore.QueryCrossSpace(database.Query{
 		Filters: []database.FilterRequest{
 			{
 				RelationKey: bundle.RelationKeyId,
-				Condition:   model.BlockContentDataviewFilter_NotEqual,
-				Value:       domain.String(objectId),
+				Condition:   model.BlockContentDataviewFilter_NotIn,
+				Value:       domain.StringList(excludedIds),
 			},
 			{
 				RelationKey: bundle.RelationKeyFileId,
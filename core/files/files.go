@@ -539,6 +539,20 @@ func looksLikeFileNode(node ipld.Node) bool {
 	return true
 }
 
+// SourceChecksum returns the checksum of the original content, the same that is stored as the source of file variants.
+// The reader is rewound after the calculation
+func SourceChecksum(r io.ReadSeeker) (string, error) {
+	sum, err := checksum(r, false)
+	if err != nil {
+		return "", err
+	}
+	_, err = r.Seek(0, io.SeekStart)
+	if err != nil {
+		return "", err
+	}
+	return sum, nil
+}
+
 func checksum(r io.Reader, wontEncrypt bool) (string, error) {
 	var add int
 	if wontEncrypt {
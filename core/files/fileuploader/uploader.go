@@ -25,6 +25,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/domain/objectorigin"
 	"github.com/anyproto/anytype-heart/core/files"
 	"github.com/anyproto/anytype-heart/core/files/fileobject/filemodels"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/core"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	"github.com/anyproto/anytype-heart/pkg/lib/mill"
@@ -103,6 +104,8 @@ type Uploader interface {
 	SetGroupId(groupId string) Uploader
 	SetCustomEncryptionKeys(keys map[string]string) Uploader
 	SetImageKind(imageKind model.ImageKind) Uploader
+	// ReuseDuplicates makes uploader return the file object with the same content from the space instead of creating a new one
+	ReuseDuplicates() Uploader
 	AddOptions(options ...files.AddOption) Uploader
 	AsyncUpdates(smartBlockId string) Uploader
 
@@ -144,6 +147,7 @@ func (ur UploadResult) ToBlock() file.Block {
 
 type FileObjectService interface {
 	GetObjectDetailsByFileId(fileId domain.FullFileId) (string, *domain.Details, error)
+	GetDuplicateObject(spaceId string, checksum string, millId string) (string, *domain.Details, error)
 	Create(ctx context.Context, spaceId string, req filemodels.CreateRequest) (id string, object *domain.Details, err error)
 }
 
@@ -157,6 +161,7 @@ type uploader struct {
 	lastModifiedDate     int64
 	forceType            bool
 	forceUploadingAsFile bool
+	reuseDuplicates      bool
 	smartBlockID         string
 	fileType             model.BlockContentFileType
 	fileStyle            model.BlockContentFileStyle
@@ -226,6 +231,11 @@ func (u *uploader) ForceUploadingAsFile() Uploader {
 	return u
 }
 
+func (u *uploader) ReuseDuplicates() Uploader {
+	u.reuseDuplicates = true
+	return u
+}
+
 func (u *uploader) SetStyle(tp model.BlockContentFileStyle) Uploader {
 	u.fileStyle = tp
 	return u
@@ -469,8 +479,23 @@ func (u *uploader) Upload(ctx context.Context) (result UploadResult) {
 		opts = append(opts, u.opts...)
 	}
 
+	isImage := !u.forceUploadingAsFile && u.fileType == model.BlockContentFile_Image && filepath.Ext(u.name) != constant.SvgExt
+	if u.reuseDuplicates {
+		// the duplicate is looked up before adding, so the same content is not stored under another file id
+		fileObjectId, fileObjectDetails, dupErr := u.getDuplicateObject(buf, isImage)
+		if dupErr == nil {
+			result.MIME = fileObjectDetails.GetString(bundle.RelationKeyFileMimeType)
+			result.Size = fileObjectDetails.GetInt64(bundle.RelationKeySizeInBytes)
+			return u.uploadDone(result, fileObjectId, fileObjectDetails)
+		}
+		if !errors.Is(dupErr, filemodels.ErrObjectNotFound) {
+			err = dupErr
+			return
+		}
+	}
+
 	var addResult *files.AddResult
-	if !u.forceUploadingAsFile && u.fileType == model.BlockContentFile_Image && filepath.Ext(u.name) != constant.SvgExt {
+	if isImage {
 		addResult, err = u.fileService.ImageAdd(ctx, u.spaceId, opts...)
 		if errors.Is(err, image.ErrFormat) ||
 			errors.Is(err, mill.ErrFormatSupportNotEnabled) ||
@@ -496,10 +521,13 @@ func (u *uploader) Upload(ctx context.Context) (result UploadResult) {
 	if err != nil {
 		return UploadResult{Err: err}
 	}
-	result.FileObjectId = fileObjectId
-	result.FileObjectDetails = fileObjectDetails
 	result.EncryptionKeys = addResult.EncryptionKeys.EncryptionKeys
+	return u.uploadDone(result, fileObjectId, fileObjectDetails)
+}
 
+func (u *uploader) uploadDone(result UploadResult, fileObjectId string, fileObjectDetails *domain.Details) UploadResult {
+	result.FileObjectId = fileObjectId
+	result.FileObjectDetails = fileObjectDetails
 	result.Type = u.fileType
 	result.Name = u.name
 	if u.block != nil {
@@ -512,7 +540,7 @@ func (u *uploader) Upload(ctx context.Context) (result UploadResult) {
 			SetMIME(result.MIME)
 		u.updateBlock()
 	}
-	return
+	return result
 }
 
 func (u *uploader) getOrCreateFileObject(ctx context.Context, addResult *files.AddResult) (string, *domain.Details, error) {
@@ -531,7 +559,6 @@ func (u *uploader) getOrCreateFileObject(ctx context.Context, addResult *files.A
 			return "", nil, fmt.Errorf("get object details by file id: %w", err)
 		}
 	}
-
 	fileObjectId, fileObjectDetails, err := u.fileObjectService.Create(ctx, u.spaceId, filemodels.CreateRequest{
 		FileId:            addResult.FileId,
 		EncryptionKeys:    addResult.EncryptionKeys.EncryptionKeys,
@@ -547,6 +574,26 @@ func (u *uploader) getOrCreateFileObject(ctx context.Context, addResult *files.A
 
 }
 
+// getDuplicateObject finds the file object with the same original content and kind of variants
+func (u *uploader) getDuplicateObject(buf *fileReader, isImage bool) (string, *domain.Details, error) {
+	checksum, err := files.SourceChecksum(buf)
+	if err != nil {
+		return "", nil, fmt.Errorf("calculate checksum: %w", err)
+	}
+	millId := mill.BlobId
+	if isImage {
+		millId = mill.ImageResizeId
+	}
+	id, details, err := u.fileObjectService.GetDuplicateObject(u.spaceId, checksum, millId)
+	if err != nil {
+		if errors.Is(err, filemodels.ErrObjectNotFound) {
+			return "", nil, err
+		}
+		return "", nil, fmt.Errorf("get duplicate object: %w", err)
+	}
+	return id, details, nil
+}
+
 func (u *uploader) detectType(buf *fileReader) (model.BlockContentFileType, error) {
 	mime, err := mimetype.DetectReader(buf)
 	_, seekErr := buf.Seek(0, io.SeekStart)
@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"testing"
 
 	"github.com/anyproto/any-sync/accountservice/mock_accountservice"
@@ -35,6 +36,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/core"
 	"github.com/anyproto/anytype-heart/pkg/lib/datastore/anystoreprovider"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/mill"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/tests/testutil"
 )
@@ -64,6 +66,28 @@ func TestUploader_Upload(t *testing.T) {
 		assert.Equal(t, b.Model().GetFile().Name, "unnamed.jpg")
 		assert.Equal(t, res.MIME, "image/jpeg")
 	})
+	t.Run("reuse duplicate", func(t *testing.T) {
+		fx := newFixture(t)
+		defer fx.tearDown()
+
+		f, err := os.Open("./testdata/unnamed.jpg")
+		require.NoError(t, err)
+		defer f.Close()
+		checksum, err := files.SourceChecksum(f)
+		require.NoError(t, err)
+		fx.fileObjectService.EXPECT().GetDuplicateObject("space1", checksum, mill.ImageResizeId).Return("duplicateId", domain.NewDetails(), nil).Once()
+
+		res := fx.Uploader.ReuseDuplicates().SetFile("./testdata/unnamed.jpg").Upload(ctx)
+		require.NoError(t, res.Err)
+		assert.Equal(t, res.FileObjectId, "duplicateId")
+
+		// the file is not added to the storage, so the next upload creates a new object instead of reusing the existing file
+		fx.fileObjectService.EXPECT().GetDuplicateObject("space1", checksum, mill.ImageResizeId).Return("", nil, filemodels.ErrObjectNotFound)
+		fileObjectId := fx.expectCreateObject()
+		res = fx.Uploader.SetFile("./testdata/unnamed.jpg").Upload(ctx)
+		require.NoError(t, res.Err)
+		assert.Equal(t, res.FileObjectId, fileObjectId)
+	})
 	t.Run("corrupted image: fall back to file", func(t *testing.T) {
 		fx := newFixture(t)
 		defer fx.tearDown()
@@ -0,0 +1,180 @@
+// Package textextractor extracts plain text from file contents for the fulltext search.
+// Supported formats are PDF, plain text and markdown, HTML, docx, odt and xlsx
+package textextractor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxFileSize is the maximum size of file contents the text is extracted from
+	MaxFileSize = 50 * 1024 * 1024
+	// maxTextSize limits the total size of the extracted text
+	maxTextSize = 2 * 1024 * 1024
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported format")
+
+type format int
+
+const (
+	formatUnknown format = iota
+	formatPlainText
+	formatHTML
+	formatPDF
+	formatDocx
+	formatOdt
+	formatXlsx
+)
+
+var formatsByMime = map[string]format{
+	"text/plain":            formatPlainText,
+	"text/markdown":         formatPlainText,
+	"text/x-markdown":       formatPlainText,
+	"text/csv":              formatPlainText,
+	"text/html":             formatHTML,
+	"application/xhtml+xml": formatHTML,
+	"application/pdf":       formatPDF,
+	"application/vnd.oasis.opendocument.text":                                 formatOdt,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": formatDocx,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":       formatXlsx,
+}
+
+var formatsByExt = map[string]format{
+	".txt":      formatPlainText,
+	".text":     formatPlainText,
+	".md":       formatPlainText,
+	".markdown": formatPlainText,
+	".csv":      formatPlainText,
+	".html":     formatHTML,
+	".htm":      formatHTML,
+	".xhtml":    formatHTML,
+	".pdf":      formatPDF,
+	".docx":     formatDocx,
+	".odt":      formatOdt,
+	".xlsx":     formatXlsx,
+}
+
+func detectFormat(mimeType, fileName string) format {
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+	if f, ok := formatsByMime[strings.TrimSpace(strings.ToLower(mimeType))]; ok {
+		return f
+	}
+	// mime type is often detected as generic application/zip or application/octet-stream
+	if f, ok := formatsByExt[strings.ToLower(filepath.Ext(fileName))]; ok {
+		return f
+	}
+	return formatUnknown
+}
+
+// IsSupported reports whether the text can be extracted from files of this type
+func IsSupported(mimeType, fileName string) bool {
+	return detectFormat(mimeType, fileName) != formatUnknown
+}
+
+// Extract returns the text of the file split by pages. Formats without pages, e.g. plain text, are returned
+// as a single page. Empty pages are kept, so the index of the page corresponds to its number in the document
+func Extract(r io.Reader, mimeType, fileName string) (pages []string, err error) {
+	// parsers of complex formats must not crash the application on broken files
+	defer func() {
+		if rec := recover(); rec != nil {
+			pages, err = nil, fmt.Errorf("extract text: panic: %v", rec)
+		}
+	}()
+
+	f := detectFormat(mimeType, fileName)
+	if f == formatUnknown {
+		return nil, ErrUnsupportedFormat
+	}
+	data, err := io.ReadAll(io.LimitReader(r, MaxFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxFileSize {
+		return nil, errors.New("file is too big")
+	}
+
+	switch f {
+	case formatPlainText:
+		pages = extractPlainText(data)
+	case formatHTML:
+		pages, err = extractHTML(data)
+	case formatPDF:
+		pages, err = extractPDF(data)
+	case formatDocx:
+		pages, err = extractDocx(data)
+	case formatOdt:
+		pages, err = extractOdt(data)
+	case formatXlsx:
+		pages, err = extractXlsx(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return normalizePages(pages), nil
+}
+
+// extractPlainText splits text by form feed characters that are used as page separators
+func extractPlainText(data []byte) []string {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(data) {
+		data = []byte(strings.ToValidUTF8(string(data), ""))
+	}
+	return strings.Split(string(data), "\f")
+}
+
+// normalizePages collapses repeated whitespace and limits the total size of the text
+func normalizePages(pages []string) []string {
+	var total int
+	res := make([]string, 0, len(pages))
+	for _, page := range pages {
+		page = normalizeWhitespace(page)
+		if total+len(page) > maxTextSize {
+			page = truncateUTF8(page, maxTextSize-total)
+		}
+		total += len(page)
+		res = append(res, page)
+		if total >= maxTextSize {
+			break
+		}
+	}
+	return res
+}
+
+func normalizeWhitespace(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	res := make([]string, 0, len(lines))
+	var emptyLines int
+	for _, line := range lines {
+		line = strings.Join(strings.Fields(line), " ")
+		if line == "" {
+			emptyLines++
+			if emptyLines > 1 {
+				continue
+			}
+		} else {
+			emptyLines = 0
+		}
+		res = append(res, line)
+	}
+	return strings.TrimSpace(strings.Join(res, "\n"))
+}
+
+func truncateUTF8(text string, size int) string {
+	if size <= 0 {
+		return ""
+	}
+	if len(text) <= size {
+		return text
+	}
+	for size > 0 && !utf8.RuneStart(text[size]) {
+		size--
+	}
+	return text[:size]
+}
@@ -0,0 +1,247 @@
+package textextractor
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildPDF creates a file with a page per content stream. Streams of odd pages are compressed
+func buildPDF(t *testing.T, fontObject string, pageContents ...string) []byte {
+	var (
+		buf     bytes.Buffer
+		objects []string
+		kids    []string
+	)
+	// 1: catalog, 2: pages, 3: font, 4...: pages and contents
+	objects = append(objects, "<< /Type /Catalog /Pages 2 0 R >>", "", fontObject)
+	for i, content := range pageContents {
+		pageNum := len(objects) + 1
+		contentNum := pageNum + 1
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageNum))
+		objects = append(objects, fmt.Sprintf("<< /Type /Page /Parent 2 0 R /Contents %d 0 R >>", contentNum))
+		if i%2 == 1 {
+			var compressed bytes.Buffer
+			w := zlib.NewWriter(&compressed)
+			_, err := w.Write([]byte(content))
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+			objects = append(objects, fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", compressed.Len(), compressed.String()))
+		} else {
+			objects = append(objects, fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+		}
+	}
+	// resources are inherited from the root of the page tree
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d /Resources << /Font << /F1 3 0 R >> >> >>", strings.Join(kids, " "), len(kids))
+
+	buf.WriteString("%PDF-1.7\n")
+	for i, obj := range objects {
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	buf.WriteString("trailer\n<< /Root 1 0 R >>\n%%EOF\n")
+	return buf.Bytes()
+}
+
+func buildZip(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestExtract_PDF(t *testing.T) {
+	t.Run("simple font", func(t *testing.T) {
+		// given
+		data := buildPDF(t, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+			"BT /F1 12 Tf 72 720 Td (Quarterly report) Tj 0 -14 Td [(Reve) -10 (nue) -300 (grew)] TJ ET",
+			"BT /F1 12 Tf 1 0 0 1 72 720 Tm (Second \\(page\\)) Tj 1 0 0 1 72 700 Tm <4f6b> Tj ET",
+		)
+
+		// when
+		pages, err := Extract(bytes.NewReader(data), "application/pdf", "report.pdf")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Quarterly report\nRevenue grew", "Second (page)\nOk"}, pages)
+	})
+
+	t.Run("composite font with ToUnicode map", func(t *testing.T) {
+		// given
+		cmap := "/CIDInit /ProcSet findresource begin 12 dict begin begincmap\n" +
+			"1 begincodespacerange <0000> <FFFF> endcodespacerange\n" +
+			"3 beginbfchar <0001> <041F> <0002> <0440> <0003> <0020> endbfchar\n" +
+			"1 beginbfrange <0010> <0012> <0061> endbfrange\n" +
+			"endcmap CMapName currentdict /CMap defineresource pop end end"
+		data := buildPDF(t, "<< /Type /Font /Subtype /Type0 /BaseFont /Arial /ToUnicode 99 0 R >>",
+			"BT /F1 12 Tf <000100020003> Tj <001000110012> Tj ET",
+		)
+		data = append(data, []byte(fmt.Sprintf("99 0 obj\n<< /Length %d >>\nstream\n%s\nendstream\nendobj\n", len(cmap), cmap))...)
+
+		// when
+		pages, err := Extract(bytes.NewReader(data), "application/pdf", "doc.pdf")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Пр abc"}, pages)
+	})
+
+	t.Run("encrypted", func(t *testing.T) {
+		_, err := Extract(strings.NewReader("%PDF-1.7\ntrailer\n<< /Root 1 0 R /Encrypt 5 0 R >>"), "application/pdf", "secret.pdf")
+		assert.ErrorIs(t, err, errEncryptedPDF)
+	})
+
+	t.Run("deeply nested objects", func(t *testing.T) {
+		// given
+		nested := strings.Repeat("[", 100000) + strings.Repeat("]", 100000)
+
+		// when
+		_, err := (&pdfLexer{data: []byte(nested)}).next()
+		pages, extractErr := Extract(bytes.NewReader(buildPDF(t, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+			"BT /F1 12 Tf (Text) Tj "+nested+" ET")), "application/pdf", "nested.pdf")
+
+		// then
+		assert.ErrorIs(t, err, errPdfNestingTooDeep)
+		require.NoError(t, extractErr)
+		assert.Equal(t, []string{"Text"}, pages)
+	})
+
+	t.Run("repeated streams and form XObjects", func(t *testing.T) {
+		// given
+		var buf bytes.Buffer
+		buf.WriteString("%PDF-1.7\n1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n")
+		buf.WriteString("2 0 obj\n<< /Type /Pages /Kids [3 0 R] /Count 1 >>\nendobj\n")
+		fmt.Fprintf(&buf, "3 0 obj\n<< /Type /Page /Parent 2 0 R /Resources << /XObject << /X 10 0 R >> >> /Contents [%s] >>\nendobj\n",
+			strings.Repeat("4 0 R ", 10000))
+		content := "/X Do"
+		fmt.Fprintf(&buf, "4 0 obj\n<< /Length %d >>\nstream\n%s\nendstream\nendobj\n", len(content), content)
+		// every form calls the next one a thousand times, the last one shows the text
+		calls := strings.Repeat("/X Do ", 1000)
+		for num := 10; num < 9+maxXObjectDepth; num++ {
+			fmt.Fprintf(&buf, "%d 0 obj\n<< /Subtype /Form /Resources << /XObject << /X %d 0 R >> >> /Length %d >>\nstream\n%s\nendstream\nendobj\n",
+				num, num+1, len(calls), calls)
+		}
+		text := "BT (" + strings.Repeat("a", 100) + ") Tj ET"
+		fmt.Fprintf(&buf, "%d 0 obj\n<< /Subtype /Form /Length %d >>\nstream\n%s\nendstream\nendobj\n", 9+maxXObjectDepth, len(text), text)
+		buf.WriteString("trailer\n<< /Root 1 0 R >>\n%%EOF\n")
+
+		// when
+		pages, err := Extract(bytes.NewReader(buf.Bytes()), "application/pdf", "bomb.pdf")
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, pages, 1)
+		assert.LessOrEqual(t, len(pages[0]), maxTextSize)
+		assert.True(t, strings.HasPrefix(pages[0], "aaaa"))
+	})
+}
+
+func TestExtract_Office(t *testing.T) {
+	t.Run("docx with page break", func(t *testing.T) {
+		// given
+		data := buildZip(t, map[string]string{
+			"word/document.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>
+<w:p><w:r><w:t>Project</w:t></w:r><w:r><w:t xml:space="preserve"> plan</w:t></w:r></w:p>
+<w:p><w:r><w:br w:type="page"/></w:r></w:p>
+<w:p><w:r><w:lastRenderedPageBreak/><w:t>Budget</w:t><w:tab/><w:t>100</w:t></w:r></w:p>
+</w:body></w:document>`,
+		})
+
+		// when
+		pages, err := Extract(bytes.NewReader(data), "application/zip", "plan.docx")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Project plan", "Budget 100"}, pages)
+	})
+
+	t.Run("odt", func(t *testing.T) {
+		// given
+		data := buildZip(t, map[string]string{
+			"content.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0">
+<office:body><office:text>
+<text:h>Title</text:h>
+<text:p>First<text:s text:c="2"/>line</text:p>
+<text:soft-page-break/>
+<text:p>Second page</text:p>
+</office:text></office:body></office:document-content>`,
+		})
+
+		// when
+		pages, err := Extract(bytes.NewReader(data), "application/vnd.oasis.opendocument.text", "notes.odt")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Title\nFirst line", "Second page"}, pages)
+	})
+
+	t.Run("xlsx", func(t *testing.T) {
+		// given
+		data := buildZip(t, map[string]string{
+			"xl/sharedStrings.xml": `<sst><si><t>Name</t></si><si><t>Total</t></si><si><r><t>Al</t></r><r><t>ice</t></r></si></sst>`,
+			"xl/worksheets/sheet1.xml": `<worksheet><sheetData>
+<row><c t="s"><v>0</v></c><c t="s"><v>1</v></c></row>
+<row><c t="s"><v>2</v></c><c><f>SUM(A1)</f><v>42</v></c></row>
+</sheetData></worksheet>`,
+			"xl/worksheets/sheet2.xml": `<worksheet><sheetData><row><c t="inlineStr"><is><t>Notes</t></is></c></row></sheetData></worksheet>`,
+		})
+
+		// when
+		pages, err := Extract(bytes.NewReader(data), "", "budget.xlsx")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Name Total\nAlice 42", "Notes"}, pages)
+	})
+}
+
+func TestExtract_Text(t *testing.T) {
+	t.Run("html", func(t *testing.T) {
+		// given
+		data := `<html><head><title>Guide</title><style>p {color: red}</style></head>
+<body><h1>Intro</h1><p>Read&nbsp;the <b>docs</b></p><script>alert("x")</script><ul><li>one</li><li>two</li></ul></body></html>`
+
+		// when
+		pages, err := Extract(strings.NewReader(data), "text/html; charset=utf-8", "guide.html")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Guide\n\nIntro\n\nRead the docs\n\none\n\ntwo"}, pages)
+	})
+
+	t.Run("plain text with form feeds", func(t *testing.T) {
+		// when
+		pages, err := Extract(strings.NewReader("\xef\xbb\xbf# Notes\n\n\n\nfirst   page\fsecond page"), "text/markdown", "notes.md")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"# Notes\n\nfirst page", "second page"}, pages)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		assert.False(t, IsSupported("image/png", "photo.png"))
+		_, err := Extract(strings.NewReader(""), "image/png", "photo.png")
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+}
+
+func TestNormalizePages(t *testing.T) {
+	pages := normalizePages([]string{strings.Repeat("я", maxTextSize/2-1) + "\n", strings.Repeat("b", 10), "c"})
+
+	require.Len(t, pages, 2)
+	assert.Equal(t, maxTextSize-2, len(pages[0]))
+	assert.Equal(t, "bb", pages[1])
+}
@@ -0,0 +1,66 @@
+package textextractor
+
+import (
+	"bytes"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// blockElements are separated by line breaks in the extracted text
+var blockElements = map[atom.Atom]struct{}{
+	atom.P: {}, atom.Div: {}, atom.Br: {}, atom.Li: {}, atom.Tr: {}, atom.Table: {},
+	atom.H1: {}, atom.H2: {}, atom.H3: {}, atom.H4: {}, atom.H5: {}, atom.H6: {},
+	atom.Blockquote: {}, atom.Pre: {}, atom.Section: {}, atom.Article: {}, atom.Header: {}, atom.Footer: {},
+	atom.Ul: {}, atom.Ol: {}, atom.Dt: {}, atom.Dd: {}, atom.Hr: {}, atom.Title: {},
+}
+
+// skippedElements contain no readable text
+var skippedElements = map[atom.Atom]struct{}{
+	atom.Script: {}, atom.Style: {}, atom.Noscript: {}, atom.Template: {}, atom.Svg: {},
+}
+
+func extractHTML(data []byte) ([]string, error) {
+	var (
+		res     strings.Builder
+		skipped int
+	)
+	tokenizer := html.NewTokenizer(bytes.NewReader(data))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			// io.EOF or broken markup, return what was extracted
+			return []string{res.String()}, nil
+		case html.TextToken:
+			if skipped == 0 {
+				res.Write(tokenizer.Text())
+			}
+		case html.StartTagToken:
+			a := tokenAtom(tokenizer)
+			if _, ok := skippedElements[a]; ok {
+				skipped++
+			}
+			if _, ok := blockElements[a]; ok {
+				res.WriteByte('\n')
+			}
+		case html.SelfClosingTagToken:
+			if _, ok := blockElements[tokenAtom(tokenizer)]; ok {
+				res.WriteByte('\n')
+			}
+		case html.EndTagToken:
+			a := tokenAtom(tokenizer)
+			if _, ok := skippedElements[a]; ok && skipped > 0 {
+				skipped--
+			}
+			if _, ok := blockElements[a]; ok {
+				res.WriteByte('\n')
+			}
+		}
+	}
+}
+
+func tokenAtom(tokenizer *html.Tokenizer) atom.Atom {
+	name, _ := tokenizer.TagName()
+	return atom.Lookup(name)
+}
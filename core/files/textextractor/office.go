@@ -0,0 +1,305 @@
+package textextractor
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const maxArchiveEntrySize = 100 * 1024 * 1024
+
+var errEntryNotFound = errors.New("entry not found in archive")
+
+func openZip(data []byte) (*zip.Reader, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("open archive: %w", err)
+	}
+	return zr, nil
+}
+
+func openZipEntry(zr *zip.Reader, name string) (io.ReadCloser, error) {
+	for _, f := range zr.File {
+		if f.Name == name {
+			rc, err := f.Open()
+			if err != nil {
+				return nil, fmt.Errorf("open %s: %w", name, err)
+			}
+			return struct {
+				io.Reader
+				io.Closer
+			}{io.LimitReader(rc, maxArchiveEntrySize), rc}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", errEntryNotFound, name)
+}
+
+// walkXML calls handlers for start and end elements and for character data of the entry
+func walkXML(zr *zip.Reader, name string, onStart func(el xml.StartElement), onEnd func(el xml.EndElement), onText func(text []byte)) error {
+	rc, err := openZipEntry(zr, name)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	decoder := xml.NewDecoder(rc)
+	decoder.Strict = false
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", name, err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if onStart != nil {
+				onStart(t)
+			}
+		case xml.EndElement:
+			if onEnd != nil {
+				onEnd(t)
+			}
+		case xml.CharData:
+			if onText != nil {
+				onText(t)
+			}
+		}
+	}
+}
+
+func attr(el xml.StartElement, name string) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// extractDocx reads word/document.xml. Pages are split by explicit and last rendered page breaks
+func extractDocx(data []byte) ([]string, error) {
+	zr, err := openZip(data)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		pages  []string
+		page   strings.Builder
+		inText bool
+	)
+	breakPage := func() {
+		// Word stores rendered page break right after the explicit one
+		if strings.TrimSpace(page.String()) == "" && len(pages) > 0 {
+			return
+		}
+		pages = append(pages, page.String())
+		page.Reset()
+	}
+	err = walkXML(zr, "word/document.xml", func(el xml.StartElement) {
+		switch el.Name.Local {
+		case "t":
+			inText = true
+		case "tab":
+			page.WriteByte('\t')
+		case "br", "cr":
+			if attr(el, "type") == "page" {
+				breakPage()
+			} else {
+				page.WriteByte('\n')
+			}
+		case "lastRenderedPageBreak":
+			breakPage()
+		}
+	}, func(el xml.EndElement) {
+		switch el.Name.Local {
+		case "t":
+			inText = false
+		case "p":
+			page.WriteByte('\n')
+		case "tc":
+			page.WriteByte('\t')
+		}
+	}, func(text []byte) {
+		if inText {
+			page.Write(text)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	breakPage()
+	return removeEmptyEdgePages(pages), nil
+}
+
+// extractOdt reads content.xml. Pages are split by soft page breaks stored by the editor
+func extractOdt(data []byte) ([]string, error) {
+	zr, err := openZip(data)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		pages []string
+		page  strings.Builder
+		// depth of text:p and text:h elements, text outside of them is formatting whitespace
+		depth int
+	)
+	err = walkXML(zr, "content.xml", func(el xml.StartElement) {
+		switch el.Name.Local {
+		case "p", "h":
+			depth++
+		case "s":
+			count, _ := strconv.Atoi(attr(el, "c"))
+			page.WriteString(strings.Repeat(" ", max(count, 1)))
+		case "tab":
+			page.WriteByte('\t')
+		case "line-break":
+			page.WriteByte('\n')
+		case "soft-page-break":
+			pages = append(pages, page.String())
+			page.Reset()
+		}
+	}, func(el xml.EndElement) {
+		switch el.Name.Local {
+		case "p", "h":
+			depth--
+			page.WriteByte('\n')
+		}
+	}, func(text []byte) {
+		if depth > 0 {
+			page.Write(text)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	pages = append(pages, page.String())
+	return removeEmptyEdgePages(pages), nil
+}
+
+// extractXlsx returns a page per worksheet. Cells are separated by tabs and rows by line breaks
+func extractXlsx(data []byte) ([]string, error) {
+	zr, err := openZip(data)
+	if err != nil {
+		return nil, err
+	}
+	sharedStrings, err := readSharedStrings(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	var sheets []string
+	for _, f := range zr.File {
+		if path.Dir(f.Name) == "xl/worksheets" && path.Ext(f.Name) == ".xml" {
+			sheets = append(sheets, f.Name)
+		}
+	}
+	sort.Slice(sheets, func(i, j int) bool {
+		return sheetNumber(sheets[i]) < sheetNumber(sheets[j])
+	})
+
+	pages := make([]string, 0, len(sheets))
+	for _, sheet := range sheets {
+		var (
+			page     strings.Builder
+			cellType string
+			inValue  bool
+			value    strings.Builder
+		)
+		err = walkXML(zr, sheet, func(el xml.StartElement) {
+			switch el.Name.Local {
+			case "c":
+				cellType = attr(el, "t")
+				value.Reset()
+			case "v", "t":
+				inValue = true
+			}
+		}, func(el xml.EndElement) {
+			switch el.Name.Local {
+			case "v", "t":
+				inValue = false
+			case "c":
+				text := value.String()
+				if cellType == "s" {
+					if idx, err := strconv.Atoi(text); err == nil && idx >= 0 && idx < len(sharedStrings) {
+						text = sharedStrings[idx]
+					}
+				}
+				if text != "" {
+					page.WriteString(text)
+					page.WriteByte('\t')
+				}
+			case "row":
+				page.WriteByte('\n')
+			}
+		}, func(text []byte) {
+			if inValue {
+				value.Write(text)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, page.String())
+	}
+	return pages, nil
+}
+
+func readSharedStrings(zr *zip.Reader) ([]string, error) {
+	var (
+		res    []string
+		item   strings.Builder
+		inText bool
+	)
+	err := walkXML(zr, "xl/sharedStrings.xml", func(el xml.StartElement) {
+		switch el.Name.Local {
+		case "si":
+			item.Reset()
+		case "t":
+			inText = true
+		}
+	}, func(el xml.EndElement) {
+		switch el.Name.Local {
+		case "si":
+			res = append(res, item.String())
+		case "t":
+			inText = false
+		}
+	}, func(text []byte) {
+		if inText {
+			item.Write(text)
+		}
+	})
+	if errors.Is(err, errEntryNotFound) {
+		// workbooks without strings have no shared strings part
+		return nil, nil
+	}
+	return res, err
+}
+
+// sheetNumber returns N of xl/worksheets/sheetN.xml
+func sheetNumber(name string) int {
+	n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(path.Base(name), "sheet"), ".xml"))
+	if err != nil {
+		return int(^uint(0) >> 1)
+	}
+	return n
+}
+
+// removeEmptyEdgePages removes empty pages produced by page breaks at the very beginning or end of the document
+func removeEmptyEdgePages(pages []string) []string {
+	for len(pages) > 1 && strings.TrimSpace(pages[0]) == "" {
+		pages = pages[1:]
+	}
+	for len(pages) > 1 && strings.TrimSpace(pages[len(pages)-1]) == "" {
+		pages = pages[:len(pages)-1]
+	}
+	return pages
+}
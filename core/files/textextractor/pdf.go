@@ -0,0 +1,567 @@
+package textextractor
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	// maxXObjectDepth limits nesting of form XObjects that can contain text
+	maxXObjectDepth = 5
+	// maxPageTreeDepth protects from cyclic page trees
+	maxPageTreeDepth = 32
+	// maxDocumentWork limits the total size of streams decoded and interpreted for the document, because the same
+	// stream can be used many times by contents of pages and form XObjects
+	maxDocumentWork = 64 * 1024 * 1024
+	// textSpaceThreshold is the negative adjustment in TJ arrays, in thousandths of em, that is treated as a space
+	textSpaceThreshold = -200
+)
+
+var (
+	errEncryptedPDF = errors.New("encrypted pdf is not supported")
+	errNoPDFPages   = errors.New("no pages found in pdf")
+
+	pdfObjectRegexp  = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+	pdfTrailerRegexp = regexp.MustCompile(`/Encrypt\s+(\d+\s+\d+\s+R|<<)`)
+)
+
+type pdfDocument struct {
+	objects map[int]any
+	// decoded caches data of streams by object number
+	decoded map[int][]byte
+	// work is the remaining size of data that can be decoded and interpreted
+	work int
+	// textSize is the size of the text extracted from all pages
+	textSize int
+}
+
+func extractPDF(data []byte) ([]string, error) {
+	if pdfTrailerRegexp.Match(data) {
+		return nil, errEncryptedPDF
+	}
+	doc := parsePDF(data)
+	pages := doc.pages()
+	if len(pages) == 0 {
+		return nil, errNoPDFPages
+	}
+	res := make([]string, 0, len(pages))
+	for _, page := range pages {
+		res = append(res, doc.pageText(page))
+		if doc.textSize >= maxTextSize {
+			break
+		}
+	}
+	return res, nil
+}
+
+// parsePDF reads all objects of the file, including objects of object streams.
+// Objects are found by scanning, so files with broken cross-reference tables are supported as well
+func parsePDF(data []byte) *pdfDocument {
+	doc := &pdfDocument{objects: map[int]any{}, decoded: map[int][]byte{}, work: maxDocumentWork}
+	var objectStreams []*pdfStream
+	for _, m := range pdfObjectRegexp.FindAllSubmatchIndex(data, -1) {
+		num, err := strconv.Atoi(string(data[m[2]:m[3]]))
+		if err != nil {
+			continue
+		}
+		l := &pdfLexer{data: data, pos: m[1]}
+		value, err := l.next()
+		if err != nil {
+			continue
+		}
+		if dict, ok := value.(pdfDict); ok {
+			if stream := readStreamData(l, dict); stream != nil {
+				value = stream
+				if dict["Type"] == pdfName("ObjStm") {
+					objectStreams = append(objectStreams, stream)
+				}
+			}
+		}
+		// objects of incremental updates are placed after the original ones
+		doc.objects[num] = value
+	}
+	for _, stream := range objectStreams {
+		doc.readObjectStream(stream)
+	}
+	return doc
+}
+
+func readStreamData(l *pdfLexer, dict pdfDict) *pdfStream {
+	l.skipSpace()
+	if !bytes.HasPrefix(l.data[l.pos:], []byte("stream")) {
+		return nil
+	}
+	l.pos += len("stream")
+	if bytes.HasPrefix(l.data[l.pos:], []byte("\r\n")) {
+		l.pos += 2
+	} else if !l.eof() && (l.data[l.pos] == '\n' || l.data[l.pos] == '\r') {
+		l.pos++
+	}
+	start := l.pos
+	if length, ok := pdfNumber(dict["Length"]); ok {
+		end := start + int(length)
+		if length >= 0 && end <= len(l.data) {
+			rest := bytes.TrimLeft(l.data[end:min(end+16, len(l.data))], "\r\n \t")
+			if bytes.HasPrefix(rest, []byte("endstream")) {
+				return &pdfStream{dict: dict, data: l.data[start:end]}
+			}
+		}
+	}
+	// length is indirect or wrong
+	end := bytes.Index(l.data[start:], []byte("endstream"))
+	if end < 0 {
+		return nil
+	}
+	data := l.data[start : start+end]
+	data = bytes.TrimSuffix(data, []byte("\n"))
+	data = bytes.TrimSuffix(data, []byte("\r"))
+	return &pdfStream{dict: dict, data: data}
+}
+
+func (d *pdfDocument) readObjectStream(stream *pdfStream) {
+	data := decodeStream(stream, d.resolve)
+	if data == nil || !d.spend(len(data)) {
+		return
+	}
+	n, _ := pdfNumber(d.resolve(stream.dict["N"]))
+	first, _ := pdfNumber(d.resolve(stream.dict["First"]))
+	header := &pdfLexer{data: data}
+	for i := 0; i < int(n); i++ {
+		numValue, err1 := header.next()
+		offsetValue, err2 := header.next()
+		if err1 != nil || err2 != nil {
+			return
+		}
+		num, _ := pdfNumber(numValue)
+		offset, _ := pdfNumber(offsetValue)
+		pos := int(first + offset)
+		if pos < 0 || pos >= len(data) {
+			continue
+		}
+		if _, exists := d.objects[int(num)]; exists {
+			continue
+		}
+		value, err := (&pdfLexer{data: data, pos: pos}).next()
+		if err == nil {
+			d.objects[int(num)] = value
+		}
+	}
+}
+
+func (d *pdfDocument) resolve(v any) any {
+	for i := 0; i < 8; i++ {
+		ref, ok := v.(pdfRef)
+		if !ok {
+			return v
+		}
+		v = d.objects[ref.num]
+	}
+	return nil
+}
+
+// spend takes n bytes from the work budget of the document, it returns false when the budget is exhausted
+func (d *pdfDocument) spend(n int) bool {
+	if n > d.work {
+		d.work = 0
+		return false
+	}
+	d.work -= n
+	return true
+}
+
+// streamData returns decoded data of the stream. Indirect streams are decoded only once, even if it fails
+func (d *pdfDocument) streamData(v any) []byte {
+	ref, isRef := v.(pdfRef)
+	if isRef {
+		if data, ok := d.decoded[ref.num]; ok {
+			return data
+		}
+	}
+	stream, ok := d.resolve(v).(*pdfStream)
+	if !ok || d.work == 0 {
+		return nil
+	}
+	data := decodeStream(stream, d.resolve)
+	if !d.spend(len(data)) {
+		data = nil
+	}
+	if isRef {
+		d.decoded[ref.num] = data
+	}
+	return data
+}
+
+func (d *pdfDocument) dict(v any) pdfDict {
+	switch res := d.resolve(v).(type) {
+	case pdfDict:
+		return res
+	case *pdfStream:
+		return res.dict
+	}
+	return nil
+}
+
+type pdfPage struct {
+	dict pdfDict
+	// resources can be inherited from the parent nodes of the page tree
+	resources pdfDict
+}
+
+// pages returns pages in the order of the page tree
+func (d *pdfDocument) pages() []pdfPage {
+	var root any
+	for _, obj := range d.objects {
+		if dict, ok := obj.(pdfDict); ok && dict["Type"] == pdfName("Catalog") {
+			root = dict["Pages"]
+			break
+		}
+	}
+	var pages []pdfPage
+	if root != nil {
+		d.collectPages(root, nil, 0, map[int]struct{}{}, &pages)
+	}
+	if len(pages) > 0 {
+		return pages
+	}
+
+	// fallback for files without catalog
+	nums := make([]int, 0, len(d.objects))
+	for num, obj := range d.objects {
+		if dict, ok := obj.(pdfDict); ok && dict["Type"] == pdfName("Page") {
+			nums = append(nums, num)
+		}
+	}
+	sort.Ints(nums)
+	for _, num := range nums {
+		dict := d.objects[num].(pdfDict)
+		pages = append(pages, pdfPage{dict: dict, resources: d.dict(dict["Resources"])})
+	}
+	return pages
+}
+
+func (d *pdfDocument) collectPages(node any, inheritedResources pdfDict, depth int, visited map[int]struct{}, pages *[]pdfPage) {
+	if depth > maxPageTreeDepth {
+		return
+	}
+	if ref, ok := node.(pdfRef); ok {
+		if _, ok := visited[ref.num]; ok {
+			return
+		}
+		visited[ref.num] = struct{}{}
+	}
+	dict := d.dict(node)
+	if dict == nil {
+		return
+	}
+	resources := inheritedResources
+	if res := d.dict(dict["Resources"]); res != nil {
+		resources = res
+	}
+	if dict["Type"] == pdfName("Page") {
+		*pages = append(*pages, pdfPage{dict: dict, resources: resources})
+		return
+	}
+	kids, _ := d.resolve(dict["Kids"]).(pdfArray)
+	for _, kid := range kids {
+		d.collectPages(kid, resources, depth+1, visited, pages)
+	}
+}
+
+// contents returns concatenated decoded content streams, they are limited by the work budget of the document
+func (d *pdfDocument) contents(v any) []byte {
+	array, ok := d.resolve(v).(pdfArray)
+	if !ok {
+		return d.streamData(v)
+	}
+	var res []byte
+	for _, item := range array {
+		data := d.streamData(item)
+		if data == nil {
+			continue
+		}
+		if len(res)+len(data) >= d.work {
+			break
+		}
+		res = append(res, data...)
+		res = append(res, '\n')
+	}
+	return res
+}
+
+func (d *pdfDocument) pageText(page pdfPage) string {
+	var res strings.Builder
+	d.extractText(&res, d.contents(page.dict["Contents"]), page.resources, 0)
+	d.textSize += res.Len()
+	return res.String()
+}
+
+// extractText interprets text operators of the content stream until the text of the document reaches the size limit
+func (d *pdfDocument) extractText(res *strings.Builder, content []byte, resources pdfDict, depth int) {
+	if len(content) == 0 || depth > maxXObjectDepth || !d.spend(len(content)) {
+		return
+	}
+	fonts := map[pdfName]*pdfFont{}
+	fontResources := d.dict(resources["Font"])
+	getFont := func(name pdfName) *pdfFont {
+		if font, ok := fonts[name]; ok {
+			return font
+		}
+		font := d.newFont(d.dict(fontResources[name]))
+		fonts[name] = font
+		return font
+	}
+
+	var (
+		operands []any
+		font     = &pdfFont{codeLength: 1}
+		lastY    float64
+		hasY     bool
+	)
+	newLine := func() {
+		res.WriteByte('\n')
+	}
+	space := func() {
+		res.WriteByte(' ')
+	}
+	l := &pdfLexer{data: content}
+	for d.textSize+res.Len() < maxTextSize {
+		token, err := l.next()
+		if err != nil {
+			// end of data or broken content
+			return
+		}
+		op, isOperator := token.(pdfOperator)
+		if !isOperator {
+			operands = append(operands, token)
+			continue
+		}
+		switch op {
+		case "Tf":
+			if len(operands) >= 2 {
+				if name, ok := operands[0].(pdfName); ok {
+					font = getFont(name)
+				}
+			}
+		case "Tj":
+			if len(operands) > 0 {
+				if s, ok := operands[len(operands)-1].(pdfString); ok {
+					res.WriteString(font.decode(s))
+				}
+			}
+		case "'", "\"":
+			newLine()
+			if len(operands) > 0 {
+				if s, ok := operands[len(operands)-1].(pdfString); ok {
+					res.WriteString(font.decode(s))
+				}
+			}
+		case "TJ":
+			if len(operands) > 0 {
+				items, _ := operands[len(operands)-1].(pdfArray)
+				for _, item := range items {
+					switch v := item.(type) {
+					case pdfString:
+						res.WriteString(font.decode(v))
+					case float64:
+						if v < textSpaceThreshold {
+							space()
+						}
+					}
+				}
+			}
+		case "T*":
+			newLine()
+		case "Td", "TD":
+			if len(operands) >= 2 {
+				ty, _ := pdfNumber(operands[1])
+				if ty != 0 {
+					newLine()
+				} else {
+					space()
+				}
+			}
+		case "Tm":
+			if len(operands) >= 6 {
+				y, _ := pdfNumber(operands[5])
+				if hasY && y != lastY {
+					newLine()
+				} else {
+					space()
+				}
+				lastY, hasY = y, true
+			}
+		case "ET":
+			space()
+		case "Do":
+			if len(operands) > 0 {
+				if name, ok := operands[0].(pdfName); ok {
+					d.extractXObjectText(res, resources, name, depth)
+				}
+			}
+		case "BI":
+			// inline image data is binary, skip it up to EI operator
+			if i := bytes.Index(content[l.pos:], []byte("ID")); i >= 0 {
+				l.pos += i + 2
+				if j := bytes.Index(content[l.pos:], []byte("EI")); j >= 0 {
+					l.pos += j + 2
+				} else {
+					return
+				}
+			}
+		}
+		operands = operands[:0]
+	}
+}
+
+func (d *pdfDocument) extractXObjectText(res *strings.Builder, resources pdfDict, name pdfName, depth int) {
+	xobjects := d.dict(resources["XObject"])
+	stream, ok := d.resolve(xobjects[name]).(*pdfStream)
+	if !ok || stream.dict["Subtype"] != pdfName("Form") {
+		return
+	}
+	formResources := d.dict(stream.dict["Resources"])
+	if formResources == nil {
+		formResources = resources
+	}
+	d.extractText(res, d.streamData(xobjects[name]), formResources, depth+1)
+}
+
+// pdfFont decodes character codes of the font to unicode text
+type pdfFont struct {
+	codeLength int
+	toUnicode  map[uint32]string
+	// simple fonts without ToUnicode map are decoded as latin1, codes of composite fonts can't be decoded
+	composite bool
+}
+
+func (d *pdfDocument) newFont(dict pdfDict) *pdfFont {
+	font := &pdfFont{codeLength: 1}
+	if dict == nil {
+		return font
+	}
+	if dict["Subtype"] == pdfName("Type0") {
+		font.composite = true
+		font.codeLength = 2
+	}
+	if cmap := d.streamData(dict["ToUnicode"]); cmap != nil {
+		font.toUnicode, font.codeLength = parseToUnicode(cmap, font.codeLength)
+	}
+	return font
+}
+
+func (f *pdfFont) decode(s pdfString) string {
+	if f.toUnicode == nil {
+		if f.composite {
+			return ""
+		}
+		runes := make([]rune, len(s))
+		for i, b := range s {
+			runes[i] = rune(b)
+		}
+		return string(runes)
+	}
+	var res strings.Builder
+	for i := 0; i+f.codeLength <= len(s); i += f.codeLength {
+		var code uint32
+		for _, b := range s[i : i+f.codeLength] {
+			code = code<<8 | uint32(b)
+		}
+		if text, ok := f.toUnicode[code]; ok {
+			res.WriteString(text)
+		} else if !f.composite {
+			res.WriteRune(rune(code))
+		}
+	}
+	return res.String()
+}
+
+// parseToUnicode reads bfchar and bfrange mappings of the ToUnicode CMap
+func parseToUnicode(cmap []byte, defaultCodeLength int) (map[uint32]string, int) {
+	mapping := map[uint32]string{}
+	codeLength := defaultCodeLength
+	var tokens []any
+	l := &pdfLexer{data: cmap}
+	for {
+		token, err := l.next()
+		if err != nil {
+			break
+		}
+		op, isOperator := token.(pdfOperator)
+		if !isOperator {
+			tokens = append(tokens, token)
+			continue
+		}
+		switch op {
+		case "endcodespacerange":
+			if len(tokens) > 0 {
+				if lo, ok := tokens[0].(pdfString); ok && len(lo) > 0 {
+					codeLength = len(lo)
+				}
+			}
+		case "endbfchar":
+			for i := 0; i+1 < len(tokens); i += 2 {
+				src, ok1 := tokens[i].(pdfString)
+				dst, ok2 := tokens[i+1].(pdfString)
+				if ok1 && ok2 {
+					mapping[codeValue(src)] = decodeUTF16(dst)
+				}
+			}
+		case "endbfrange":
+			for i := 0; i+2 < len(tokens); i += 3 {
+				lo, ok1 := tokens[i].(pdfString)
+				hi, ok2 := tokens[i+1].(pdfString)
+				if !ok1 || !ok2 {
+					continue
+				}
+				start, end := codeValue(lo), codeValue(hi)
+				if end < start || end-start > 0xFFFF {
+					continue
+				}
+				switch dst := tokens[i+2].(type) {
+				case pdfString:
+					base := []rune(decodeUTF16(dst))
+					if len(base) == 0 {
+						continue
+					}
+					for code := start; code <= end; code++ {
+						runes := append([]rune{}, base...)
+						runes[len(runes)-1] += rune(code - start)
+						mapping[code] = string(runes)
+					}
+				case pdfArray:
+					for j, item := range dst {
+						if s, ok := item.(pdfString); ok && start+uint32(j) <= end {
+							mapping[start+uint32(j)] = decodeUTF16(s)
+						}
+					}
+				}
+			}
+		}
+		tokens = tokens[:0]
+	}
+	return mapping, codeLength
+}
+
+func codeValue(s pdfString) uint32 {
+	var v uint32
+	for _, b := range s {
+		v = v<<8 | uint32(b)
+	}
+	return v
+}
+
+func decodeUTF16(s pdfString) string {
+	if len(s)%2 == 1 {
+		s = append(s, 0)
+	}
+	units := make([]uint16, len(s)/2)
+	for i := range units {
+		units[i] = uint16(s[2*i])<<8 | uint16(s[2*i+1])
+	}
+	return string(utf16.Decode(units))
+}
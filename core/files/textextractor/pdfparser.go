@@ -0,0 +1,378 @@
+package textextractor
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/ascii85"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strconv"
+)
+
+// Minimal parser of PDF objects, enough to walk the page tree and read content streams
+
+type (
+	pdfName     string
+	pdfString   []byte
+	pdfArray    []any
+	pdfDict     map[pdfName]any
+	pdfOperator string
+	pdfRef      struct{ num, gen int }
+	pdfStream   struct {
+		dict pdfDict
+		data []byte
+	}
+)
+
+const (
+	maxDecodedStreamSize = 64 * 1024 * 1024
+	// maxNestingDepth limits nesting of arrays and dictionaries, so crafted files can't exhaust the stack
+	maxNestingDepth = 64
+)
+
+var errPdfNestingTooDeep = errors.New("pdf objects are nested too deep")
+
+type pdfLexer struct {
+	data  []byte
+	pos   int
+	depth int
+}
+
+func (l *pdfLexer) enter() error {
+	if l.depth >= maxNestingDepth {
+		return errPdfNestingTooDeep
+	}
+	l.depth++
+	return nil
+}
+
+func (l *pdfLexer) leave() {
+	l.depth--
+}
+
+func isPdfWhitespace(c byte) bool {
+	switch c {
+	case 0, '\t', '\n', '\f', '\r', ' ':
+		return true
+	}
+	return false
+}
+
+func isPdfDelimiter(c byte) bool {
+	switch c {
+	case '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return true
+	}
+	return false
+}
+
+func (l *pdfLexer) eof() bool {
+	return l.pos >= len(l.data)
+}
+
+func (l *pdfLexer) skipSpace() {
+	for !l.eof() {
+		c := l.data[l.pos]
+		if isPdfWhitespace(c) {
+			l.pos++
+			continue
+		}
+		if c == '%' {
+			for !l.eof() && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+			continue
+		}
+		return
+	}
+}
+
+func (l *pdfLexer) readRegular() []byte {
+	start := l.pos
+	for !l.eof() && !isPdfWhitespace(l.data[l.pos]) && !isPdfDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	return l.data[start:l.pos]
+}
+
+// next returns the next object or operator. It returns io.EOF at the end of data
+func (l *pdfLexer) next() (any, error) {
+	l.skipSpace()
+	if l.eof() {
+		return nil, io.EOF
+	}
+	c := l.data[l.pos]
+	switch {
+	case c == '/':
+		l.pos++
+		return pdfName(decodeName(l.readRegular())), nil
+	case c == '(':
+		l.pos++
+		return l.readLiteralString(), nil
+	case c == '<' && l.pos+1 < len(l.data) && l.data[l.pos+1] == '<':
+		l.pos += 2
+		return l.readDict()
+	case c == '<':
+		l.pos++
+		return l.readHexString(), nil
+	case c == '[':
+		l.pos++
+		return l.readArray()
+	case c == ']' || c == '>' || c == ')' || c == '{' || c == '}':
+		l.pos++
+		if c == '>' && !l.eof() && l.data[l.pos] == '>' {
+			l.pos++
+			return pdfOperator(">>"), nil
+		}
+		return pdfOperator(rune(c)), nil
+	case c == '+' || c == '-' || c == '.' || (c >= '0' && c <= '9'):
+		return l.readNumberOrRef(), nil
+	}
+	word := l.readRegular()
+	if len(word) == 0 {
+		// unexpected delimiter
+		l.pos++
+		return pdfOperator(rune(c)), nil
+	}
+	switch string(word) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	return pdfOperator(word), nil
+}
+
+func decodeName(raw []byte) string {
+	if bytes.IndexByte(raw, '#') < 0 {
+		return string(raw)
+	}
+	res := make([]byte, 0, len(raw))
+	for i := 0; i < len(raw); i++ {
+		if raw[i] == '#' && i+2 < len(raw) {
+			if b, err := hex.DecodeString(string(raw[i+1 : i+3])); err == nil {
+				res = append(res, b[0])
+				i += 2
+				continue
+			}
+		}
+		res = append(res, raw[i])
+	}
+	return string(res)
+}
+
+func (l *pdfLexer) readLiteralString() pdfString {
+	var res []byte
+	depth := 1
+	for !l.eof() {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return res
+			}
+		case '\\':
+			if l.eof() {
+				return res
+			}
+			e := l.data[l.pos]
+			l.pos++
+			switch e {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case 'b':
+				c = '\b'
+			case 'f':
+				c = '\f'
+			case '\r':
+				// line continuation
+				if !l.eof() && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+				continue
+			case '\n':
+				continue
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for i := 0; i < 2 && !l.eof() && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						v = v*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					c = byte(v)
+				} else {
+					c = e
+				}
+			}
+		}
+		res = append(res, c)
+	}
+	return res
+}
+
+func (l *pdfLexer) readHexString() pdfString {
+	var digits []byte
+	for !l.eof() {
+		c := l.data[l.pos]
+		l.pos++
+		if c == '>' {
+			break
+		}
+		if isPdfWhitespace(c) {
+			continue
+		}
+		digits = append(digits, c)
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	res := make([]byte, len(digits)/2)
+	n, _ := hex.Decode(res, digits)
+	return res[:n]
+}
+
+func (l *pdfLexer) readDict() (pdfDict, error) {
+	if err := l.enter(); err != nil {
+		return nil, err
+	}
+	defer l.leave()
+	dict := pdfDict{}
+	for {
+		key, err := l.next()
+		if err != nil {
+			return dict, err
+		}
+		if key == pdfOperator(">>") {
+			return dict, nil
+		}
+		name, ok := key.(pdfName)
+		if !ok {
+			// broken dictionary, skip the token
+			continue
+		}
+		value, err := l.next()
+		if err != nil {
+			return dict, err
+		}
+		if value == pdfOperator(">>") {
+			return dict, nil
+		}
+		dict[name] = value
+	}
+}
+
+func (l *pdfLexer) readArray() (pdfArray, error) {
+	if err := l.enter(); err != nil {
+		return nil, err
+	}
+	defer l.leave()
+	var arr pdfArray
+	for {
+		value, err := l.next()
+		if err != nil {
+			return arr, err
+		}
+		if value == pdfOperator("]") {
+			return arr, nil
+		}
+		arr = append(arr, value)
+	}
+}
+
+func (l *pdfLexer) readNumberOrRef() any {
+	start := l.pos
+	word := l.readRegular()
+	if n, err := strconv.Atoi(string(word)); err == nil {
+		// check for "num gen R" reference
+		save := l.pos
+		l.skipSpace()
+		genStart := l.pos
+		genWord := l.readRegular()
+		if gen, err := strconv.Atoi(string(genWord)); err == nil && l.pos > genStart {
+			l.skipSpace()
+			if !l.eof() && l.data[l.pos] == 'R' && (l.pos+1 == len(l.data) || isPdfWhitespace(l.data[l.pos+1]) || isPdfDelimiter(l.data[l.pos+1])) {
+				l.pos++
+				return pdfRef{num: n, gen: gen}
+			}
+		}
+		l.pos = save
+		return float64(n)
+	}
+	f, err := strconv.ParseFloat(string(word), 64)
+	if err != nil {
+		if l.pos == start {
+			l.pos++
+		}
+		return float64(0)
+	}
+	return f
+}
+
+func pdfNumber(v any) (float64, bool) {
+	f, ok := v.(float64)
+	return f, ok
+}
+
+// decodeStream applies stream filters. Unsupported filters, e.g. image codecs, return nil
+func decodeStream(s *pdfStream, resolve func(any) any) []byte {
+	data := s.data
+	var filters []any
+	switch f := resolve(s.dict["Filter"]).(type) {
+	case pdfName:
+		filters = []any{f}
+	case pdfArray:
+		filters = f
+	}
+	for _, f := range filters {
+		switch resolve(f) {
+		case pdfName("FlateDecode"), pdfName("Fl"):
+			data = inflate(data)
+		case pdfName("ASCIIHexDecode"), pdfName("AHx"):
+			data = (&pdfLexer{data: append(bytes.Clone(bytes.TrimSpace(data)), '>')}).readHexString()
+		case pdfName("ASCII85Decode"), pdfName("A85"):
+			data = decodeASCII85(data)
+		default:
+			return nil
+		}
+		if data == nil {
+			return nil
+		}
+	}
+	return data
+}
+
+// inflate decompresses zlib data. Data of damaged streams is returned up to the first error
+func inflate(data []byte) []byte {
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil
+	}
+	defer r.Close()
+	res, _ := io.ReadAll(io.LimitReader(r, maxDecodedStreamSize))
+	return res
+}
+
+func decodeASCII85(data []byte) []byte {
+	data = bytes.TrimSpace(data)
+	data = bytes.TrimPrefix(data, []byte("<~"))
+	if i := bytes.Index(data, []byte("~>")); i >= 0 {
+		data = data[:i]
+	}
+	res := make([]byte, len(data))
+	n, _, err := ascii85.Decode(res, data, true)
+	if err != nil {
+		return nil
+	}
+	return res[:n]
+}
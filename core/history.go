@@ -114,10 +114,14 @@ func (mw *Middleware) HistorySetVersion(cctx context.Context, req *pb.RpcHistory
 		if err != nil {
 			return fmt.Errorf("resolve spaceID: %w", err)
 		}
-		return hs.SetVersion(domain.FullID{
+		id := domain.FullID{
 			SpaceID:  spaceID,
 			ObjectID: req.ObjectId,
-		}, req.VersionId)
+		}
+		if len(req.BlockIds) > 0 || len(req.DetailKeys) > 0 {
+			return hs.RestoreVersionParts(id, req.VersionId, req.BlockIds, req.DetailKeys)
+		}
+		return hs.SetVersion(id, req.VersionId)
 	}))
 }
 
@@ -144,3 +148,41 @@ func (mw *Middleware) HistoryDiffVersions(cctx context.Context, req *pb.RpcHisto
 	}
 	return response(versionDiff, objectView, nil)
 }
+
+func (mw *Middleware) HistoryMergeVersion(cctx context.Context, req *pb.RpcHistoryMergeVersionRequest) *pb.RpcHistoryMergeVersionResponse {
+	response := func(blocks []*pb.RpcHistoryMergedBlock, details []*pb.RpcHistoryMergedDetail, err error) (res *pb.RpcHistoryMergeVersionResponse) {
+		res = &pb.RpcHistoryMergeVersionResponse{
+			Error: &pb.RpcHistoryMergeVersionResponseError{
+				Code: pb.RpcHistoryMergeVersionResponseError_NULL,
+			},
+		}
+		if err != nil {
+			res.Error.Code = mapErrorCode(err,
+				errToCode(history.ErrBadInput, pb.RpcHistoryMergeVersionResponseError_BAD_INPUT),
+			)
+			res.Error.Description = getErrorDescription(err)
+			return
+		}
+		res.Blocks = blocks
+		res.Details = details
+		return
+	}
+	var (
+		blocks  []*pb.RpcHistoryMergedBlock
+		details []*pb.RpcHistoryMergedDetail
+	)
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		hs := mw.applicationService.GetApp().MustComponent(history.CName).(history.History)
+		res := mw.applicationService.GetApp().MustComponent(idresolver.CName).(idresolver.Resolver)
+		spaceID, err := res.ResolveSpaceID(req.ObjectId)
+		if err != nil {
+			return fmt.Errorf("resolve spaceID: %w", err)
+		}
+		blocks, details, err = hs.MergeVersion(domain.FullID{
+			SpaceID:  spaceID,
+			ObjectID: req.ObjectId,
+		}, req.VersionId, req.BaseVersionId)
+		return
+	})
+	return response(blocks, details, err)
+}
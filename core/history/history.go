@@ -58,6 +58,8 @@ type History interface {
 	SetVersion(id domain.FullID, versionId string) (err error)
 	DiffVersions(req *pb.RpcHistoryDiffVersionsRequest) ([]*pb.EventMessage, *model.ObjectView, error)
 	GetBlocksParticipants(id domain.FullID, versionId string, blocks []*model.Block) ([]*model.ObjectViewBlockParticipant, error)
+	MergeVersion(id domain.FullID, versionId, baseVersionId string) ([]*pb.RpcHistoryMergedBlock, []*pb.RpcHistoryMergedDetail, error)
+	RestoreVersionParts(id domain.FullID, versionId string, blockIds []string, detailKeys []string) error
 	app.Component
 }
 
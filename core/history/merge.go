@@ -0,0 +1,283 @@
+package history
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var ErrBadInput = errors.New("bad input")
+
+func (h *history) MergeVersion(id domain.FullID, versionId, baseVersionId string) ([]*pb.RpcHistoryMergedBlock, []*pb.RpcHistoryMergedDetail, error) {
+	if versionId == "" {
+		return nil, nil, fmt.Errorf("%w: version is not set", ErrBadInput)
+	}
+	// without a common ancestor every difference looks like a change of the current state,
+	// so nothing is ever reported as changed in the version or as a conflict
+	if baseVersionId == "" || baseVersionId == versionId {
+		return nil, nil, fmt.Errorf("%w: base version must be set and differ from the version", ErrBadInput)
+	}
+	version, _, _, err := h.buildState(id, versionId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get state of version %s: %w", versionId, err)
+	}
+	base, _, _, err := h.buildState(id, baseVersionId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get state of base version %s: %w", baseVersionId, err)
+	}
+
+	var (
+		blocks  []*pb.RpcHistoryMergedBlock
+		details []*pb.RpcHistoryMergedDetail
+	)
+	err = cache.Do(h.picker, id.ObjectID, func(sb smartblock.SmartBlock) error {
+		current := sb.NewState()
+		blocks = mergeBlocks(base, current, version)
+		details = mergeDetails(base, current, version)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return blocks, details, nil
+}
+
+// RestoreVersionParts restores the given blocks and details of the version, leaving the rest of the object as is.
+// Local and derived details are skipped
+func (h *history) RestoreVersionParts(id domain.FullID, versionId string, blockIds []string, detailKeys []string) error {
+	version, _, _, err := h.buildState(id, versionId)
+	if err != nil {
+		return err
+	}
+	return cache.Do(h.picker, id.ObjectID, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		if err := restoreBlocks(st, version, blockIds); err != nil {
+			return err
+		}
+		for _, key := range detailKeys {
+			if slices.Contains(bundle.LocalAndDerivedRelationKeys, domain.RelationKey(key)) {
+				continue
+			}
+			if value, ok := version.Details().TryGet(domain.RelationKey(key)); ok {
+				st.SetDetail(domain.RelationKey(key), value)
+			} else {
+				st.RemoveDetail(domain.RelationKey(key))
+			}
+		}
+		return sb.Apply(st)
+	})
+}
+
+// mergeBlocks merges blocks of the version into the current state. Blocks are compared as a whole, so
+// a block changed in both of them is a conflict even if different fields were changed
+func mergeBlocks(base, current, version *state.State) []*pb.RpcHistoryMergedBlock {
+	baseBlocks, currentBlocks, versionBlocks := blocksById(base), blocksById(current), blocksById(version)
+
+	var result []*pb.RpcHistoryMergedBlock
+	for _, id := range mergedIds(current.Blocks(), version.Blocks()) {
+		merged := mergeValues(baseBlocks[id], currentBlocks[id], versionBlocks[id], func(a, b *model.Block) bool {
+			if a == nil || b == nil {
+				return a == b
+			}
+			return proto.Equal(a, b)
+		})
+		result = append(result, &pb.RpcHistoryMergedBlock{
+			Id:      id,
+			Status:  merged.status,
+			Merged:  merged.value,
+			Version: versionBlocks[id],
+			Current: currentBlocks[id],
+		})
+	}
+
+	mergedBlockIds := make(map[string]struct{}, len(result))
+	for _, b := range result {
+		if b.Merged != nil {
+			mergedBlockIds[b.Id] = struct{}{}
+		}
+	}
+	for _, b := range result {
+		if b.Merged == nil || len(b.Merged.ChildrenIds) == 0 {
+			continue
+		}
+		b.Merged = proto.Clone(b.Merged).(*model.Block)
+		b.Merged.ChildrenIds = slices.DeleteFunc(b.Merged.ChildrenIds, func(childId string) bool {
+			_, ok := mergedBlockIds[childId]
+			return !ok
+		})
+	}
+	return result
+}
+
+// mergeDetails merges details of the version into the current state, local and derived details are skipped
+func mergeDetails(base, current, version *state.State) []*pb.RpcHistoryMergedDetail {
+	var keys []domain.RelationKey
+	for _, details := range []*domain.Details{current.Details(), version.Details()} {
+		for _, key := range details.Keys() {
+			if !slices.Contains(keys, key) && !slices.Contains(bundle.LocalAndDerivedRelationKeys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.Sort(keys)
+
+	var result []*pb.RpcHistoryMergedDetail
+	for _, key := range keys {
+		baseValue, currentValue, versionValue := base.Details().Get(key), current.Details().Get(key), version.Details().Get(key)
+		merged := mergeValues(baseValue, currentValue, versionValue, domain.Value.Equal)
+		result = append(result, &pb.RpcHistoryMergedDetail{
+			Key:     key.String(),
+			Status:  merged.status,
+			Merged:  detailToProto(merged.value),
+			Version: detailToProto(versionValue),
+			Current: detailToProto(currentValue),
+		})
+	}
+	return result
+}
+
+func detailToProto(v domain.Value) *types.Value {
+	if !v.Ok() {
+		return nil
+	}
+	return v.ToProto()
+}
+
+type mergeResult[T any] struct {
+	value  T
+	status pb.RpcHistoryMergeStatus
+}
+
+func mergeValues[T any](base, current, version T, equal func(a, b T) bool) mergeResult[T] {
+	switch {
+	case equal(current, version):
+		return mergeResult[T]{value: current, status: pb.RpcHistory_Unchanged}
+	case equal(base, version):
+		return mergeResult[T]{value: current, status: pb.RpcHistory_ChangedInCurrent}
+	case equal(base, current):
+		return mergeResult[T]{value: version, status: pb.RpcHistory_ChangedInVersion}
+	default:
+		return mergeResult[T]{value: current, status: pb.RpcHistory_Conflict}
+	}
+}
+
+func blocksById(s *state.State) map[string]*model.Block {
+	blocks := s.Blocks()
+	res := make(map[string]*model.Block, len(blocks))
+	for _, b := range blocks {
+		res[b.Id] = b
+	}
+	return res
+}
+
+// mergedIds returns ids of blocks in the order of the current state followed by blocks existing only in the version
+func mergedIds(current, version []*model.Block) []string {
+	ids := make([]string, 0, len(current))
+	seen := make(map[string]struct{}, len(current))
+	for _, blocks := range [][]*model.Block{current, version} {
+		for _, b := range blocks {
+			if _, ok := seen[b.Id]; !ok {
+				seen[b.Id] = struct{}{}
+				ids = append(ids, b.Id)
+			}
+		}
+	}
+	return ids
+}
+
+// restoreBlocks puts blocks of the version into the state. Blocks missing in the version are removed,
+// blocks missing in the state are inserted next to their siblings from the version. Children of a restored block
+// which were added after the version are kept, so they don't become orphans
+func restoreBlocks(st, version *state.State, blockIds []string) error {
+	var added []string
+	for _, id := range blockIds {
+		b := version.Pick(id)
+		switch {
+		case b == nil:
+			st.Unlink(id)
+		case st.Exists(id):
+			restored := b.Copy()
+			restored.Model().ChildrenIds = mergeChildrenIds(st.Pick(id).Model().ChildrenIds, restored.Model().ChildrenIds, func(childId string) bool {
+				parent := version.PickParentOf(childId)
+				return parent != nil && slices.Contains(blockIds, parent.Model().Id)
+			})
+			st.Set(restored)
+		default:
+			st.Add(b.Copy())
+			added = append(added, id)
+		}
+	}
+
+	// children of the version which don't exist anymore are dropped
+	for _, id := range blockIds {
+		if version.Exists(id) && st.Exists(id) {
+			b := st.Get(id).Model()
+			b.ChildrenIds = slices.DeleteFunc(slices.Clone(b.ChildrenIds), func(childId string) bool {
+				return !st.Exists(childId)
+			})
+		}
+	}
+
+	for _, id := range added {
+		if st.PickParentOf(id) != nil {
+			continue
+		}
+		if err := insertLikeInVersion(st, version, id); err != nil {
+			return fmt.Errorf("insert block %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// mergeChildrenIds returns children of the version with current children added after their current predecessors.
+// Current children placed by the restore somewhere else are skipped
+func mergeChildrenIds(current, version []string, placedByRestore func(id string) bool) []string {
+	result := slices.Clone(version)
+	for i, id := range current {
+		if slices.Contains(result, id) || placedByRestore(id) {
+			continue
+		}
+		pos := 0
+		for j := i - 1; j >= 0; j-- {
+			if prev := slices.Index(result, current[j]); prev >= 0 {
+				pos = prev + 1
+				break
+			}
+		}
+		result = slices.Insert(result, pos, id)
+	}
+	return result
+}
+
+func insertLikeInVersion(st, version *state.State, id string) error {
+	parent := version.PickParentOf(id)
+	if parent == nil || !st.Exists(parent.Model().Id) {
+		parent = st.Pick(st.RootId())
+	}
+	siblings := parent.Model().ChildrenIds
+	pos := slices.Index(siblings, id)
+	for i := pos - 1; i >= 0; i-- {
+		if st.Exists(siblings[i]) && st.PickParentOf(siblings[i]) != nil {
+			return st.InsertTo(siblings[i], model.Block_Bottom, id)
+		}
+	}
+	for i := pos + 1; pos >= 0 && i < len(siblings); i++ {
+		if st.Exists(siblings[i]) && st.PickParentOf(siblings[i]) != nil {
+			return st.InsertTo(siblings[i], model.Block_Top, id)
+		}
+	}
+	target := st.Get(parent.Model().Id)
+	target.Model().ChildrenIds = append(target.Model().ChildrenIds, id)
+	return nil
+}
@@ -0,0 +1,178 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func textBlock(id, text string) simple.Block {
+	return simple.New(&model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: text}}})
+}
+
+func newMergeState(texts map[string]string, order ...string) *state.State {
+	blocks := map[string]simple.Block{
+		"root": simple.New(&model.Block{Id: "root", ChildrenIds: order, Content: &model.BlockContentOfSmartblock{Smartblock: &model.BlockContentSmartblock{}}}),
+	}
+	for id, text := range texts {
+		blocks[id] = textBlock(id, text)
+	}
+	return state.NewDoc("root", blocks).(*state.State)
+}
+
+func mergedBlock(blocks []*pb.RpcHistoryMergedBlock, id string) *pb.RpcHistoryMergedBlock {
+	for _, b := range blocks {
+		if b.Id == id {
+			return b
+		}
+	}
+	return nil
+}
+
+func TestMergeBlocks(t *testing.T) {
+	t.Run("three-way merge of blocks", func(t *testing.T) {
+		// given
+		base := newMergeState(map[string]string{"same": "a", "current": "b", "version": "c", "conflict": "d", "removed": "e"},
+			"same", "current", "version", "conflict", "removed")
+		current := newMergeState(map[string]string{"same": "a", "current": "b2", "version": "c", "conflict": "d2", "added": "f"},
+			"same", "current", "version", "conflict", "added")
+		version := newMergeState(map[string]string{"same": "a", "current": "b", "version": "c3", "conflict": "d3", "removed": "e"},
+			"same", "current", "version", "conflict", "removed")
+
+		// when
+		blocks := mergeBlocks(base, current, version)
+
+		// then
+		assert.Equal(t, pb.RpcHistory_Unchanged, mergedBlock(blocks, "same").Status)
+
+		assert.Equal(t, pb.RpcHistory_ChangedInCurrent, mergedBlock(blocks, "current").Status)
+		assert.Equal(t, "b2", mergedBlock(blocks, "current").Merged.GetText().Text)
+
+		assert.Equal(t, pb.RpcHistory_ChangedInVersion, mergedBlock(blocks, "version").Status)
+		assert.Equal(t, "c3", mergedBlock(blocks, "version").Merged.GetText().Text)
+
+		conflict := mergedBlock(blocks, "conflict")
+		assert.Equal(t, pb.RpcHistory_Conflict, conflict.Status)
+		assert.Equal(t, "d2", conflict.Merged.GetText().Text)
+		assert.Equal(t, "d3", conflict.Version.GetText().Text)
+
+		added := mergedBlock(blocks, "added")
+		assert.Equal(t, pb.RpcHistory_ChangedInCurrent, added.Status)
+		assert.Nil(t, added.Version)
+		assert.NotNil(t, added.Merged)
+
+		removed := mergedBlock(blocks, "removed")
+		assert.Equal(t, pb.RpcHistory_ChangedInCurrent, removed.Status)
+		assert.Nil(t, removed.Merged)
+		assert.NotNil(t, removed.Version)
+
+		assert.Equal(t, []string{"same", "current", "version", "conflict", "added"}, mergedBlock(blocks, "root").Merged.ChildrenIds)
+	})
+
+	t.Run("version is the base", func(t *testing.T) {
+		// given
+		version := newMergeState(map[string]string{"one": "a", "two": "b"}, "one", "two")
+		current := newMergeState(map[string]string{"one": "a2"}, "one")
+
+		// when
+		blocks := mergeBlocks(version, current, version)
+
+		// then
+		assert.Equal(t, pb.RpcHistory_ChangedInCurrent, mergedBlock(blocks, "one").Status)
+		assert.Equal(t, pb.RpcHistory_ChangedInCurrent, mergedBlock(blocks, "two").Status)
+		assert.Nil(t, mergedBlock(blocks, "two").Merged)
+	})
+}
+
+func TestMergeDetails(t *testing.T) {
+	// given
+	base := newMergeState(nil)
+	base.SetDetail(bundle.RelationKeyName, domain.String("name"))
+	base.SetDetail(bundle.RelationKeyDescription, domain.String("description"))
+	current := base.Copy()
+	current.SetDetail(bundle.RelationKeyName, domain.String("current name"))
+	current.SetDetail(bundle.RelationKeyLastModifiedDate, domain.Int64(100))
+	version := base.Copy()
+	version.SetDetail(bundle.RelationKeyDescription, domain.String("version description"))
+
+	// when
+	details := mergeDetails(base, current, version)
+
+	// then
+	require.Len(t, details, 2)
+	assert.Equal(t, bundle.RelationKeyDescription.String(), details[0].Key)
+	assert.Equal(t, pb.RpcHistory_ChangedInVersion, details[0].Status)
+	assert.Equal(t, "version description", details[0].Merged.GetStringValue())
+	assert.Equal(t, bundle.RelationKeyName.String(), details[1].Key)
+	assert.Equal(t, pb.RpcHistory_ChangedInCurrent, details[1].Status)
+	assert.Equal(t, "current name", details[1].Merged.GetStringValue())
+}
+
+func TestRestoreBlocks(t *testing.T) {
+	t.Run("restore changed, removed and added blocks", func(t *testing.T) {
+		// given
+		version := newMergeState(map[string]string{"one": "a", "two": "b", "three": "c"}, "one", "two", "three")
+		st := newMergeState(map[string]string{"one": "a2", "three": "c2", "four": "d"}, "one", "three", "four")
+
+		// when
+		err := restoreBlocks(st, version, []string{"one", "two", "four"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"one", "two", "three"}, st.Pick("root").Model().ChildrenIds)
+		assert.Equal(t, "a", st.Pick("one").Model().GetText().Text)
+		assert.Equal(t, "b", st.Pick("two").Model().GetText().Text)
+		assert.Equal(t, "c2", st.Pick("three").Model().GetText().Text)
+	})
+
+	t.Run("restored block without siblings goes to its parent", func(t *testing.T) {
+		// given
+		version := newMergeState(map[string]string{"one": "a"}, "one")
+		st := newMergeState(nil)
+
+		// when
+		err := restoreBlocks(st, version, []string{"one"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"one"}, st.Pick("root").Model().ChildrenIds)
+	})
+
+	t.Run("children added after the version are kept", func(t *testing.T) {
+		// given
+		version := newMergeState(map[string]string{"one": "a", "two": "b"}, "one", "two")
+		st := newMergeState(map[string]string{"new": "n", "one": "a", "two": "b", "three": "c"}, "new", "one", "three", "two")
+		st.Get("one").Model().GetText().Text = "a2"
+
+		// when
+		err := restoreBlocks(st, version, []string{"root", "one"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"new", "one", "three", "two"}, st.Pick("root").Model().ChildrenIds)
+		assert.Equal(t, "a", st.Pick("one").Model().GetText().Text)
+	})
+}
+
+func TestMergeVersion(t *testing.T) {
+	t.Run("base version is required", func(t *testing.T) {
+		// given
+		h := &history{}
+
+		for _, baseVersionId := range []string{"", "version"} {
+			// when
+			_, _, err := h.MergeVersion(domain.FullID{SpaceID: "space", ObjectID: "obj"}, "version", baseVersionId)
+
+			// then
+			assert.ErrorIs(t, err, ErrBadInput)
+		}
+	})
+}
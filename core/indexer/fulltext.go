@@ -10,9 +10,9 @@ import (
 
 	"github.com/anyproto/any-sync/commonspace/spacestorage"
 	"github.com/samber/lo"
-	"golang.org/x/exp/slices"
 
 	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/chats/chatmodel"
 	smartblock2 "github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/block/simple/text"
@@ -22,7 +22,6 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
-	"github.com/anyproto/anytype-heart/util/slice"
 )
 
 var (
@@ -127,7 +126,7 @@ func (i *indexer) runFullTextIndexer(ctx context.Context) error {
 				return nil, 0, ctx.Err()
 			default:
 			}
-			objDocs, err := i.prepareSearchDocument(ctx, objectId)
+			objDocs, partial, err := i.prepareSearchDocument(ctx, objectId)
 			if err != nil {
 				if errors.Is(err, context.Canceled) {
 					return nil, 0, err
@@ -140,11 +139,16 @@ func (i *indexer) runFullTextIndexer(ctx context.Context) error {
 				}
 			}
 
-			objDocs, removedDocIds, err := i.filterOutNotChangedDocuments(objectId.ObjectID, objDocs)
-			if err != nil {
-				log.With("id", objectId).Errorf("filter not changed error:: %s", err)
-				// try to process the other returned values.
-				continue
+			var removedDocIds []string
+			if partial != nil {
+				objDocs, removedDocIds = partial.docs, partial.removedIds
+			} else {
+				objDocs, removedDocIds, err = i.filterOutNotChangedDocuments(objectId.ObjectID, objDocs)
+				if err != nil {
+					log.With("id", objectId).Errorf("filter not changed error:: %s", err)
+					// try to process the other returned values.
+					continue
+				}
 			}
 			for _, removeId := range removedDocIds {
 				err = batcher.DeleteDoc(removeId)
@@ -199,19 +203,21 @@ func (i *indexer) filterOutNotChangedDocuments(id string, newDocs []ftsearch.Sea
 		// no need to query fields if we have no documents to compare (means the whole object is deleted)
 		fields = []string{"Title", "Text"}
 	}
+	newDocsById := make(map[string]ftsearch.SearchDoc, len(newDocs))
+	for _, doc := range newDocs {
+		newDocsById[doc.Id] = doc
+	}
+	existingIds := make(map[string]struct{})
 	err = i.ftsearch.Iterate(id, fields, func(doc *ftsearch.SearchDoc) bool {
-		newDocIndex := slice.Find(newDocs, func(d ftsearch.SearchDoc) bool {
-			return d.Id == doc.Id
-		})
-
-		if newDocIndex == -1 {
+		existingIds[doc.Id] = struct{}{}
+		newDoc, ok := newDocsById[doc.Id]
+		if !ok {
 			// doc got removed
 			removeDocs = append(removeDocs, doc.Id)
 			return true
-		} else {
-			if newDocs[newDocIndex].Text != doc.Text || newDocs[newDocIndex].Title != doc.Title {
-				changedDocs = append(changedDocs, newDocs[newDocIndex])
-			}
+		}
+		if newDoc.Text != doc.Text || newDoc.Title != doc.Title {
+			changedDocs = append(changedDocs, newDoc)
 		}
 		return true
 	})
@@ -220,9 +226,7 @@ func (i *indexer) filterOutNotChangedDocuments(id string, newDocs []ftsearch.Sea
 	}
 
 	for _, doc := range newDocs {
-		if !slices.ContainsFunc(changedDocs, func(d ftsearch.SearchDoc) bool {
-			return d.Id == doc.Id
-		}) {
+		if _, ok := existingIds[doc.Id]; !ok {
 			// doc is new as it doesn't exist in the index
 			changedDocs = append(changedDocs, doc)
 		}
@@ -238,7 +242,14 @@ var filesLayouts = map[model.ObjectTypeLayout]struct{}{
 	model.ObjectType_pdf:   {},
 }
 
-func (i *indexer) prepareSearchDocument(ctx context.Context, id domain.FullID) (docs []ftsearch.SearchDoc, err error) {
+// partialUpdate contains only changed documents of the object, so they are applied to the index
+// without comparison with all documents of the object
+type partialUpdate struct {
+	docs       []ftsearch.SearchDoc
+	removedIds []string
+}
+
+func (i *indexer) prepareSearchDocument(ctx context.Context, id domain.FullID) (docs []ftsearch.SearchDoc, partial *partialUpdate, err error) {
 	// shortcut for deleted objects via objectstore
 	// otherwise we can have race condition when object is marked as deleted but the tree is not yet deleted
 	details, err := i.store.SpaceIndex(id.SpaceID).GetDetails(id.ObjectID)
@@ -253,6 +264,11 @@ func (i *indexer) prepareSearchDocument(ctx context.Context, id domain.FullID) (
 	var fulltextSkipped bool
 
 	err = cache.DoContext(i.picker, ctx, id.ObjectID, func(sb smartblock2.SmartBlock) error {
+		if chat, ok := sb.(chatMessagesGetter); ok {
+			docs, partial, err = i.prepareChatMessageDocuments(ctx, id, chat)
+			return err
+		}
+
 		fulltext, _, _ := sb.Type().Indexable()
 		if !fulltext {
 			fulltextSkipped = true
@@ -306,6 +322,16 @@ func (i *indexer) prepareSearchDocument(ctx context.Context, id domain.FullID) (
 			docs = append(docs, doc)
 		}
 
+		if layout, ok := sb.Layout(); ok {
+			if _, isFile := filesLayouts[layout]; isFile {
+				fileDocs, err := i.prepareFileTextDocuments(id)
+				if err != nil {
+					log.With("id", id).Errorf("prepareSearchDocument: file text: %v", err)
+				}
+				docs = append(docs, fileDocs...)
+			}
+		}
+
 		sb.Iterate(func(b simple.Block) (isContinue bool) {
 			if ctx.Err() != nil {
 				return false
@@ -341,10 +367,10 @@ func (i *indexer) prepareSearchDocument(ctx context.Context, id domain.FullID) (
 		// todo: this should be removed. objects which is not supposed to be added to fulltext index should not be added to the queue
 		// but now it happens in the ftInit that some objects still can be added to the queue
 		// we need to avoid TryRemoveFromCache in this case
-		return docs, nil
+		return docs, nil, nil
 	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	_, cacheErr := i.picker.TryRemoveFromCache(ctx, id.ObjectID)
 	if cacheErr != nil &&
@@ -352,9 +378,104 @@ func (i *indexer) prepareSearchDocument(ctx context.Context, id domain.FullID) (
 		log.With("objectId", id).Errorf("object cache remove: %v", err)
 	}
 
+	return docs, partial, nil
+}
+
+// prepareFileTextDocuments returns a document per page of the text extracted from the file content
+func (i *indexer) prepareFileTextDocuments(id domain.FullID) ([]ftsearch.SearchDoc, error) {
+	pages, err := i.store.SpaceIndex(id.SpaceID).GetFileText(id.ObjectID)
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]ftsearch.SearchDoc, 0, len(pages))
+	for n, page := range pages {
+		if strings.TrimSpace(page) == "" {
+			continue
+		}
+		doc := ftsearch.SearchDoc{
+			Id:      domain.NewObjectPathWithPage(id.ObjectID, n+1).String(),
+			SpaceId: id.SpaceID,
+			Text:    page,
+		}
+		if len(doc.Text) > ftBlockMaxSize {
+			doc.Text = doc.Text[:ftBlockMaxSize]
+		}
+		docs = append(docs, doc)
+	}
 	return docs, nil
 }
 
+type chatMessagesGetter interface {
+	GetAllMessages(ctx context.Context) ([]*chatmodel.Message, error)
+	TakeChangedMessages(ctx context.Context) (changed []*chatmodel.Message, deletedIds []string, ok bool, err error)
+}
+
+// prepareChatMessageDocuments returns a document per chat message containing its text and names of attachments.
+// Only changed messages are indexed when the chat knows them, otherwise documents of all messages are returned
+func (i *indexer) prepareChatMessageDocuments(ctx context.Context, id domain.FullID, chat chatMessagesGetter) ([]ftsearch.SearchDoc, *partialUpdate, error) {
+	changed, deletedIds, ok, err := chat.TakeChangedMessages(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get changed chat messages: %w", err)
+	}
+	if ok {
+		partial := &partialUpdate{}
+		for _, msg := range changed {
+			if doc, ok := i.chatMessageDocument(id, msg); ok {
+				partial.docs = append(partial.docs, doc)
+			} else {
+				partial.removedIds = append(partial.removedIds, doc.Id)
+			}
+		}
+		for _, msgId := range deletedIds {
+			partial.removedIds = append(partial.removedIds, domain.NewObjectPathWithMessage(id.ObjectID, msgId).String())
+		}
+		return nil, partial, nil
+	}
+
+	messages, err := chat.GetAllMessages(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get chat messages: %w", err)
+	}
+	docs := make([]ftsearch.SearchDoc, 0, len(messages))
+	for _, msg := range messages {
+		if doc, ok := i.chatMessageDocument(id, msg); ok {
+			docs = append(docs, doc)
+		}
+	}
+	return docs, nil, nil
+}
+
+// chatMessageDocument returns the document of the message, ok is false when the message has nothing to index
+func (i *indexer) chatMessageDocument(id domain.FullID, msg *chatmodel.Message) (doc ftsearch.SearchDoc, ok bool) {
+	doc = ftsearch.SearchDoc{
+		Id:      domain.NewObjectPathWithMessage(id.ObjectID, msg.Id).String(),
+		SpaceId: id.SpaceID,
+	}
+	parts := make([]string, 0, len(msg.Attachments)+1)
+	if msgText := strings.TrimSpace(msg.GetMessage().GetText()); msgText != "" {
+		parts = append(parts, msgText)
+	}
+	spaceIndex := i.store.SpaceIndex(id.SpaceID)
+	for _, attachment := range msg.Attachments {
+		details, err := spaceIndex.GetDetails(attachment.Target)
+		if err != nil {
+			continue
+		}
+		if name := details.GetString(bundle.RelationKeyName); name != "" {
+			parts = append(parts, name)
+		}
+	}
+	if len(parts) == 0 {
+		return doc, false
+	}
+
+	doc.Text = strings.Join(parts, "\n")
+	if len(doc.Text) > ftBlockMaxSize {
+		doc.Text = doc.Text[:ftBlockMaxSize]
+	}
+	return doc, true
+}
+
 func isName(rel *model.RelationLink) bool {
 	return rel.Key == bundle.RelationKeyName.String() || rel.Key == bundle.RelationKeyPluralName.String()
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/core/block/cache/mock_cache"
+	"github.com/anyproto/anytype-heart/core/block/chats/chatmodel"
 	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/source/mock_source"
@@ -114,7 +115,7 @@ func TestPrepareSearchDocument_Success(t *testing.T) {
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 	indexerFx.pickerFx.EXPECT().TryRemoveFromCache(mock.Anything, "objectId1").Return(true, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
 	assert.NoError(t, err)
 	require.Len(t, docs, 1)
 	assert.Equal(t, "objectId1/b/blockId1", docs[0].Id)
@@ -135,7 +136,7 @@ func TestPrepareSearchDocument_Empty_NotIndexing(t *testing.T) {
 		)))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
 	assert.NoError(t, err)
 	require.Len(t, docs, 0)
 }
@@ -155,7 +156,7 @@ func TestPrepareSearchDocument_NoIndexableType(t *testing.T) {
 	smartTest.SetType(coresb.SmartBlockTypeDate)
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
 	assert.Len(t, docs, 0)
 	assert.NoError(t, err)
 }
@@ -169,7 +170,7 @@ func TestPrepareSearchDocument_NoTextBlock(t *testing.T) {
 	))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
 	assert.Len(t, docs, 0)
 	assert.NoError(t, err)
 }
@@ -186,7 +187,7 @@ func TestPrepareSearchDocument_RelationShortText_Success(t *testing.T) {
 	}))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
 	assert.NoError(t, err)
 	assert.Len(t, docs, 1)
 	assert.Equal(t, "objectId1/r/name", docs[0].Id)
@@ -210,7 +211,7 @@ func TestPrepareSearchDocument_System_Plural_Success(t *testing.T) {
 	smartTest.Doc.Layout()
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
 	assert.NoError(t, err)
 	assert.Len(t, docs, 1)
 	assert.Equal(t, "objectId1/r/pluralName", docs[0].Id)
@@ -230,7 +231,7 @@ func TestPrepareSearchDocument_RelationLongText_Success(t *testing.T) {
 	}))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
 	assert.NoError(t, err)
 	assert.Len(t, docs, 1)
 	assert.Equal(t, "objectId1/r/name", docs[0].Id)
@@ -251,7 +252,7 @@ func TestPrepareSearchDocument_RelationText_EmptyValue(t *testing.T) {
 	}))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
 	require.NoError(t, err)
 	require.Len(t, docs, 0)
 }
@@ -269,7 +270,7 @@ func TestPrepareSearchDocument_RelationText_WrongFormat(t *testing.T) {
 	}))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
 	assert.NoError(t, err)
 	require.Len(t, docs, 0)
 }
@@ -287,7 +288,7 @@ func TestPrepareSearchDocument_BlockText_LessThanMaxSize(t *testing.T) {
 		)))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
 	assert.NoError(t, err)
 	require.Len(t, docs, 1)
 	assert.Equal(t, "objectId1/b/blockId1", docs[0].Id)
@@ -309,7 +310,7 @@ func TestPrepareSearchDocument_BlockText_EqualToMaxSize(t *testing.T) {
 		)))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
 	assert.NoError(t, err)
 	require.Len(t, docs, 1)
 	assert.Equal(t, "objectId1/b/blockId1", docs[0].Id)
@@ -331,13 +332,129 @@ func TestPrepareSearchDocument_BlockText_GreaterThanMaxSize(t *testing.T) {
 		)))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "objectId1", SpaceID: "spaceId1"})
 	assert.NoError(t, err)
 	require.Len(t, docs, 1)
 	assert.Equal(t, "objectId1/b/blockId1", docs[0].Id)
 	assert.Equal(t, maxSize, len(docs[0].Text))
 }
 
+type chatObjectStub struct {
+	*smarttest.SmartTest
+	messages   []*chatmodel.Message
+	changed    []*chatmodel.Message
+	deletedIds []string
+}
+
+func (c *chatObjectStub) GetAllMessages(ctx context.Context) ([]*chatmodel.Message, error) {
+	return c.messages, nil
+}
+
+func (c *chatObjectStub) TakeChangedMessages(ctx context.Context) ([]*chatmodel.Message, []string, bool, error) {
+	return c.changed, c.deletedIds, c.changed != nil || c.deletedIds != nil, nil
+}
+
+func givenChatMessage(id, text string, attachments ...string) *chatmodel.Message {
+	msg := &chatmodel.Message{ChatMessage: &model.ChatMessage{
+		Id:      id,
+		Message: &model.ChatMessageMessageContent{Text: text},
+	}}
+	for _, target := range attachments {
+		msg.Attachments = append(msg.Attachments, &model.ChatMessageAttachment{Target: target, Type: model.ChatMessageAttachment_FILE})
+	}
+	return msg
+}
+
+func TestPrepareSearchDocument_ChatMessages(t *testing.T) {
+	// given
+	indexerFx := NewIndexerFixture(t)
+	smartTest := smarttest.New("chatId1")
+	smartTest.SetSpaceId("spaceId1")
+	smartTest.SetType(coresb.SmartBlockTypeChatDerivedObject)
+	chat := &chatObjectStub{
+		SmartTest: smartTest,
+		messages: []*chatmodel.Message{
+			givenChatMessage("messageId1", "hello world"),
+			givenChatMessage("messageId2", "see attached", "fileId1"),
+			givenChatMessage("messageId3", "  ", "unknownFileId"),
+		},
+	}
+	indexerFx.objectStore.AddObjects(t, "spaceId1", []objectstore.TestObject{{
+		bundle.RelationKeyId:   domain.String("fileId1"),
+		bundle.RelationKeyName: domain.String("report.pdf"),
+	}})
+	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(chat, nil)
+
+	// when
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "chatId1", SpaceID: "spaceId1"})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, docs, 2)
+	assert.Equal(t, "chatId1/m/messageId1", docs[0].Id)
+	assert.Equal(t, "hello world", docs[0].Text)
+	assert.Equal(t, "chatId1/m/messageId2", docs[1].Id)
+	assert.Equal(t, "see attached\nreport.pdf", docs[1].Text)
+	assert.Equal(t, "spaceId1", docs[1].SpaceId)
+}
+
+func TestPrepareSearchDocument_ChangedChatMessages(t *testing.T) {
+	// given
+	indexerFx := NewIndexerFixture(t)
+	smartTest := smarttest.New("chatId1")
+	smartTest.SetSpaceId("spaceId1")
+	smartTest.SetType(coresb.SmartBlockTypeChatDerivedObject)
+	chat := &chatObjectStub{
+		SmartTest: smartTest,
+		messages: []*chatmodel.Message{
+			givenChatMessage("messageId1", "hello world"),
+			givenChatMessage("messageId2", "edited"),
+		},
+		changed:    []*chatmodel.Message{givenChatMessage("messageId2", "edited"), givenChatMessage("messageId3", "")},
+		deletedIds: []string{"messageId4"},
+	}
+	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(chat, nil)
+
+	// when
+	docs, partial, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "chatId1", SpaceID: "spaceId1"})
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, docs)
+	require.NotNil(t, partial)
+	require.Len(t, partial.docs, 1)
+	assert.Equal(t, "chatId1/m/messageId2", partial.docs[0].Id)
+	assert.Equal(t, "edited", partial.docs[0].Text)
+	assert.Equal(t, []string{"chatId1/m/messageId3", "chatId1/m/messageId4"}, partial.removedIds)
+}
+
+func TestPrepareSearchDocument_FileText(t *testing.T) {
+	// given
+	indexerFx := NewIndexerFixture(t)
+	smartTest := smarttest.New("fileObjectId1")
+	smartTest.SetSpaceId("spaceId1")
+	smartTest.SetType(coresb.SmartBlockTypeFileObject)
+	smartTest.Doc.(*state.State).SetLocalDetails(domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+		bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_pdf),
+	}))
+	err := indexerFx.store.SpaceIndex("spaceId1").SetFileText("fileObjectId1", []string{"first page", "", "third page"})
+	require.NoError(t, err)
+
+	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
+	indexerFx.pickerFx.EXPECT().TryRemoveFromCache(mock.Anything, "fileObjectId1").Return(true, nil)
+
+	// when
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: "fileObjectId1", SpaceID: "spaceId1"})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, docs, 2)
+	assert.Equal(t, "fileObjectId1/p/1", docs[0].Id)
+	assert.Equal(t, "first page", docs[0].Text)
+	assert.Equal(t, "fileObjectId1/p/3", docs[1].Id)
+	assert.Equal(t, "spaceId1", docs[1].SpaceId)
+}
+
 func TestRunFullTextIndexer(t *testing.T) {
 	indexerFx := NewIndexerFixture(t)
 	for i := range 10 {
@@ -519,7 +636,7 @@ func TestPrepareSearchDocumentWithDetails(t *testing.T) {
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 	indexerFx.pickerFx.EXPECT().TryRemoveFromCache(mock.Anything, objectId).Return(true, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: objectId, SpaceID: "spaceId1"})
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), domain.FullID{ObjectID: objectId, SpaceID: "spaceId1"})
 	require.NoError(t, err)
 	require.Len(t, docs, 1, "Should prepare 1 document")
 	assert.Equal(t, "testObject1/b/blockId1", docs[0].Id)
@@ -51,7 +51,7 @@ const (
 	ForceReindexDeletedObjectsCounter int32 = 1
 
 	ForceReindexParticipantsCounter int32 = 1
-	ForceReindexChatsCounter        int32 = 3
+	ForceReindexChatsCounter        int32 = 4
 )
 
 type allDeletedIdsProvider interface {
@@ -288,6 +288,16 @@ func (i *indexer) reindexChats(ctx context.Context, space clientspace.Space) err
 
 	i.reindexIdsIgnoreErr(ctx, space, ids...)
 
+	// index messages of existing chats for full-text search
+	fullIds := make([]domain.FullID, 0, len(ids))
+	for _, id := range ids {
+		fullIds = append(fullIds, domain.FullID{ObjectID: id, SpaceID: space.Id()})
+	}
+	err = i.store.AddToIndexQueue(ctx, fullIds...)
+	if err != nil {
+		return fmt.Errorf("add chats to fulltext queue: %w", err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,102 @@
+package kanban
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	timeutil "github.com/anyproto/anytype-heart/util/time"
+)
+
+// GroupDate groups objects by day, week or month of the date relation in the account time zone
+type GroupDate struct {
+	Key    domain.RelationKey
+	Bucket model.BlockContentDataviewDateBucket
+	// Location is the time zone of buckets, it is taken from the space index on init. The time zone of the device is used when it is nil
+	Location *time.Location
+	store    objectstore.ObjectStore
+	Records  []database.Record
+}
+
+func (d *GroupDate) InitGroups(spaceID string, f *database.Filters) error {
+	records, err := queryRecords(d.store, spaceID, d.Key, f)
+	if err != nil {
+		return fmt.Errorf("init kanban by date, objectStore query error: %w", err)
+	}
+	d.Records = records
+	d.Location = d.store.SpaceIndex(spaceID).AccountLocation()
+	return nil
+}
+
+func (d *GroupDate) MakeGroups() (GroupSlice, error) {
+	var groups GroupSlice
+	uniqMap := make(map[int64]bool)
+	for _, rec := range d.Records {
+		ts, ok := rec.Details.TryInt64(d.Key)
+		if !ok {
+			continue
+		}
+		from, _ := d.bucket(time.Unix(ts, 0))
+		if uniqMap[from] {
+			continue
+		}
+		uniqMap[from] = true
+		id := strconv.FormatInt(from, 10)
+		groups = append(groups, Group{Id: id, Data: GroupData{Ids: []string{id}}})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return dateGroupStart(groups[i]) < dateGroupStart(groups[j])
+	})
+	return groups, nil
+}
+
+func (d *GroupDate) MakeDataViewGroups() ([]*model.BlockContentDataviewGroup, error) {
+	groups, err := d.MakeGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.BlockContentDataviewGroup{{
+		Id:    "empty",
+		Value: &model.BlockContentDataviewGroupValueOfDate{Date: &model.BlockContentDataviewDate{}},
+	}}
+	for _, g := range groups {
+		from, to := d.bucket(time.Unix(dateGroupStart(g), 0))
+		result = append(result, &model.BlockContentDataviewGroup{
+			Id:    Hash(g.Id),
+			Value: &model.BlockContentDataviewGroupValueOfDate{Date: &model.BlockContentDataviewDate{From: from, To: to}},
+		})
+	}
+	return result, nil
+}
+
+func (d *GroupDate) GetRecords() []database.Record {
+	return d.Records
+}
+
+func (d *GroupDate) SetRecords(records []database.Record) {
+	d.Records = records
+}
+
+// bucket returns unix time of the start and the end of the bucket containing t
+func (d *GroupDate) bucket(t time.Time) (from, to int64) {
+	cal := timeutil.NewCalendar(t, d.Location)
+	switch d.Bucket {
+	case model.BlockContentDataviewDate_Week:
+		return cal.WeekNumStart(0).Unix(), cal.WeekNumStart(1).Unix()
+	case model.BlockContentDataviewDate_Month:
+		return cal.MonthNumStart(0).Unix(), cal.MonthNumStart(1).Unix()
+	default:
+		return cal.DayNumStart(0).Unix(), cal.DayNumStart(1).Unix()
+	}
+}
+
+func dateGroupStart(g Group) int64 {
+	from, _ := strconv.ParseInt(g.Id, 10, 64)
+	return from
+}
@@ -0,0 +1,116 @@
+package kanban
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// GroupNumber groups objects by ranges of the number relation. Ranges are split by ascending bounds,
+// so n bounds make n+1 groups; without bounds every distinct value makes its own group
+type GroupNumber struct {
+	Key     domain.RelationKey
+	Ranges  []float64
+	store   objectstore.ObjectStore
+	Records []database.Record
+}
+
+func (n *GroupNumber) InitGroups(spaceID string, f *database.Filters) error {
+	if !slices.IsSorted(n.Ranges) {
+		return fmt.Errorf("number ranges must be ascending")
+	}
+	records, err := queryRecords(n.store, spaceID, n.Key, f)
+	if err != nil {
+		return fmt.Errorf("init kanban by number, objectStore query error: %w", err)
+	}
+	n.Records = records
+	return nil
+}
+
+func (n *GroupNumber) MakeGroups() (GroupSlice, error) {
+	var groups GroupSlice
+	if len(n.Ranges) > 0 {
+		for i := 0; i <= len(n.Ranges); i++ {
+			id := strconv.Itoa(i)
+			groups = append(groups, Group{Id: id, Data: GroupData{Ids: []string{id}}})
+		}
+		return groups, nil
+	}
+
+	var values []float64
+	for _, rec := range n.Records {
+		if v, ok := rec.Details.TryFloat64(n.Key); ok && !slices.Contains(values, v) {
+			values = append(values, v)
+		}
+	}
+	slices.Sort(values)
+	for _, v := range values {
+		id := strconv.FormatFloat(v, 'f', -1, 64)
+		groups = append(groups, Group{Id: id, Data: GroupData{Ids: []string{id}}})
+	}
+	return groups, nil
+}
+
+func (n *GroupNumber) MakeDataViewGroups() ([]*model.BlockContentDataviewGroup, error) {
+	groups, err := n.MakeGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.BlockContentDataviewGroup{{
+		Id:    "empty",
+		Value: &model.BlockContentDataviewGroupValueOfNumber{Number: &model.BlockContentDataviewNumber{}},
+	}}
+	for _, g := range groups {
+		var number *model.BlockContentDataviewNumber
+		if len(n.Ranges) > 0 {
+			i, _ := strconv.Atoi(g.Id)
+			number = n.rangeAt(i)
+		} else {
+			v, _ := strconv.ParseFloat(g.Id, 64)
+			number = &model.BlockContentDataviewNumber{From: v, To: v, HasFrom: true, HasTo: true}
+		}
+		result = append(result, &model.BlockContentDataviewGroup{
+			Id:    Hash(numberGroupKey(number)),
+			Value: &model.BlockContentDataviewGroupValueOfNumber{Number: number},
+		})
+	}
+	return result, nil
+}
+
+func (n *GroupNumber) GetRecords() []database.Record {
+	return n.Records
+}
+
+func (n *GroupNumber) SetRecords(records []database.Record) {
+	n.Records = records
+}
+
+// rangeAt returns i-th range between the bounds, the first and the last ones are open
+func (n *GroupNumber) rangeAt(i int) *model.BlockContentDataviewNumber {
+	number := &model.BlockContentDataviewNumber{}
+	if i > 0 {
+		number.From, number.HasFrom = n.Ranges[i-1], true
+	}
+	if i < len(n.Ranges) {
+		number.To, number.HasTo = n.Ranges[i], true
+	}
+	return number
+}
+
+func numberGroupKey(number *model.BlockContentDataviewNumber) string {
+	key := "number:"
+	if number.HasFrom {
+		key += strconv.FormatFloat(number.From, 'f', -1, 64)
+	}
+	key += ":"
+	if number.HasTo {
+		key += strconv.FormatFloat(number.To, 'f', -1, 64)
+	}
+	return key
+}
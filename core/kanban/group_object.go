@@ -0,0 +1,85 @@
+package kanban
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// GroupObject groups objects by the objects they link to in the relation, e.g. by assignee, project or type.
+// Like with tags, objects linking to several objects make a group of their combination
+type GroupObject struct {
+	Key     domain.RelationKey
+	store   objectstore.ObjectStore
+	Records []database.Record
+}
+
+func (o *GroupObject) InitGroups(spaceID string, f *database.Filters) error {
+	records, err := queryRecords(o.store, spaceID, o.Key, f)
+	if err != nil {
+		return fmt.Errorf("init kanban by object, objectStore query error: %w", err)
+	}
+	o.Records = records
+	return nil
+}
+
+func (o *GroupObject) MakeGroups() (GroupSlice, error) {
+	var single, multiple GroupSlice
+	uniqMap := make(map[string]bool)
+
+	for _, rec := range o.Records {
+		ids := slices.Clone(rec.Details.WrapToStringList(o.Key))
+		sort.Strings(ids)
+		ids = slices.Compact(ids)
+		for _, id := range ids {
+			if !uniqMap[id] {
+				uniqMap[id] = true
+				single = append(single, Group{Id: id, Data: GroupData{Ids: []string{id}}})
+			}
+		}
+		if len(ids) > 1 {
+			hash := strings.Join(ids, "")
+			if !uniqMap[hash] {
+				uniqMap[hash] = true
+				multiple = append(multiple, Group{Id: hash, Data: GroupData{Ids: ids}})
+			}
+		}
+	}
+
+	sort.Slice(single, func(i, j int) bool { return single[i].Id < single[j].Id })
+	sort.Slice(multiple, func(i, j int) bool { return multiple[i].Id < multiple[j].Id })
+	return append(single, multiple...), nil
+}
+
+func (o *GroupObject) MakeDataViewGroups() ([]*model.BlockContentDataviewGroup, error) {
+	groups, err := o.MakeGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.BlockContentDataviewGroup{{
+		Id:    "empty",
+		Value: &model.BlockContentDataviewGroupValueOfObject{Object: &model.BlockContentDataviewObject{Ids: make([]string, 0)}},
+	}}
+	for _, g := range groups {
+		result = append(result, &model.BlockContentDataviewGroup{
+			Id:    Hash(g.Id),
+			Value: &model.BlockContentDataviewGroupValueOfObject{Object: &model.BlockContentDataviewObject{Ids: g.Data.Ids}},
+		})
+	}
+	return result, nil
+}
+
+func (o *GroupObject) GetRecords() []database.Record {
+	return o.Records
+}
+
+func (o *GroupObject) SetRecords(records []database.Record) {
+	o.Records = records
+}
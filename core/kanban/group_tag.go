@@ -138,3 +138,11 @@ func (t *GroupTag) MakeDataViewGroups() ([]*model.BlockContentDataviewGroup, err
 
 	return result, nil
 }
+
+func (t *GroupTag) GetRecords() []database.Record {
+	return t.Records
+}
+
+func (t *GroupTag) SetRecords(records []database.Record) {
+	t.Records = records
+}
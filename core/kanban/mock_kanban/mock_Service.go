@@ -21,9 +21,9 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
-// Grouper provides a mock function with given fields: spaceID, key
-func (_m *MockService) Grouper(spaceID string, key string) (kanban.Grouper, error) {
-	ret := _m.Called(spaceID, key)
+// Grouper provides a mock function with given fields: spaceID, key, opts
+func (_m *MockService) Grouper(spaceID string, key string, opts kanban.GroupOptions) (kanban.Grouper, error) {
+	ret := _m.Called(spaceID, key, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Grouper")
@@ -31,19 +31,19 @@ func (_m *MockService) Grouper(spaceID string, key string) (kanban.Grouper, erro
 
 	var r0 kanban.Grouper
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (kanban.Grouper, error)); ok {
-		return rf(spaceID, key)
+	if rf, ok := ret.Get(0).(func(string, string, kanban.GroupOptions) (kanban.Grouper, error)); ok {
+		return rf(spaceID, key, opts)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) kanban.Grouper); ok {
-		r0 = rf(spaceID, key)
+	if rf, ok := ret.Get(0).(func(string, string, kanban.GroupOptions) kanban.Grouper); ok {
+		r0 = rf(spaceID, key, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(kanban.Grouper)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(spaceID, key)
+	if rf, ok := ret.Get(1).(func(string, string, kanban.GroupOptions) error); ok {
+		r1 = rf(spaceID, key, opts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,13 +59,14 @@ type MockService_Grouper_Call struct {
 // Grouper is a helper method to define mock.On call
 //   - spaceID string
 //   - key string
-func (_e *MockService_Expecter) Grouper(spaceID interface{}, key interface{}) *MockService_Grouper_Call {
-	return &MockService_Grouper_Call{Call: _e.mock.On("Grouper", spaceID, key)}
+//   - opts kanban.GroupOptions
+func (_e *MockService_Expecter) Grouper(spaceID interface{}, key interface{}, opts interface{}) *MockService_Grouper_Call {
+	return &MockService_Grouper_Call{Call: _e.mock.On("Grouper", spaceID, key, opts)}
 }
 
-func (_c *MockService_Grouper_Call) Run(run func(spaceID string, key string)) *MockService_Grouper_Call {
+func (_c *MockService_Grouper_Call) Run(run func(spaceID string, key string, opts kanban.GroupOptions)) *MockService_Grouper_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(string), args[1].(string), args[2].(kanban.GroupOptions))
 	})
 	return _c
 }
@@ -75,7 +76,7 @@ func (_c *MockService_Grouper_Call) Return(_a0 kanban.Grouper, _a1 error) *MockS
 	return _c
 }
 
-func (_c *MockService_Grouper_Call) RunAndReturn(run func(string, string) (kanban.Grouper, error)) *MockService_Grouper_Call {
+func (_c *MockService_Grouper_Call) RunAndReturn(run func(string, string, kanban.GroupOptions) (kanban.Grouper, error)) *MockService_Grouper_Call {
 	_c.Call.Return(run)
 	return _c
 }
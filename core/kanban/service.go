@@ -19,7 +19,7 @@ const (
 )
 
 type Service interface {
-	Grouper(spaceID string, key string) (Grouper, error)
+	Grouper(spaceID string, key string, opts GroupOptions) (Grouper, error)
 
 	app.Component
 }
@@ -30,27 +30,52 @@ type Grouper interface {
 	MakeDataViewGroups() ([]*model.BlockContentDataviewGroup, error)
 }
 
+// RecordsGrouper makes groups out of the relation values of the matching records,
+// so its groups have to be rebuilt when these records change
+type RecordsGrouper interface {
+	Grouper
+	GetRecords() []database.Record
+	SetRecords(records []database.Record)
+}
+
+// GroupOptions configure groupers of the relation formats which don't have predefined groups
+type GroupOptions struct {
+	DateBucket model.BlockContentDataviewDateBucket
+	// NumberRanges are ascending bounds of number groups, every distinct value makes its own group when empty
+	NumberRanges []float64
+}
+
 type service struct {
 	objectStore  objectstore.ObjectStore
-	groupColumns map[model.RelationFormat]func(string) Grouper
+	groupColumns map[model.RelationFormat]func(key string, opts GroupOptions) Grouper
 }
 
 func New() Service {
-	return &service{groupColumns: make(map[model.RelationFormat]func(key string) Grouper)}
+	return &service{groupColumns: make(map[model.RelationFormat]func(key string, opts GroupOptions) Grouper)}
 }
 
 func (s *service) Init(a *app.App) (err error) {
 	s.objectStore = a.MustComponent(objectstore.CName).(objectstore.ObjectStore)
 
-	s.groupColumns[model.RelationFormat_status] = func(key string) Grouper {
+	s.groupColumns[model.RelationFormat_status] = func(key string, _ GroupOptions) Grouper {
 		return &GroupStatus{key: domain.RelationKey(key), store: s.objectStore}
 	}
-	s.groupColumns[model.RelationFormat_tag] = func(key string) Grouper {
+	s.groupColumns[model.RelationFormat_tag] = func(key string, _ GroupOptions) Grouper {
 		return &GroupTag{Key: domain.RelationKey(key), store: s.objectStore}
 	}
-	s.groupColumns[model.RelationFormat_checkbox] = func(key string) Grouper {
+	s.groupColumns[model.RelationFormat_checkbox] = func(key string, _ GroupOptions) Grouper {
 		return &GroupCheckBox{}
 	}
+	s.groupColumns[model.RelationFormat_date] = func(key string, opts GroupOptions) Grouper {
+		return &GroupDate{Key: domain.RelationKey(key), Bucket: opts.DateBucket, store: s.objectStore}
+	}
+	s.groupColumns[model.RelationFormat_number] = func(key string, opts GroupOptions) Grouper {
+		return &GroupNumber{Key: domain.RelationKey(key), Ranges: opts.NumberRanges, store: s.objectStore}
+	}
+	// also covers the type relation, so objects can be grouped by their type
+	s.groupColumns[model.RelationFormat_object] = func(key string, _ GroupOptions) Grouper {
+		return &GroupObject{Key: domain.RelationKey(key), store: s.objectStore}
+	}
 
 	return nil
 }
@@ -59,7 +84,7 @@ func (s *service) Name() (name string) {
 	return CName
 }
 
-func (s *service) Grouper(spaceID string, key string) (Grouper, error) {
+func (s *service) Grouper(spaceID string, key string, opts GroupOptions) (Grouper, error) {
 	if spaceID == "" {
 		return nil, fmt.Errorf("spaceId is required")
 	}
@@ -73,7 +98,24 @@ func (s *service) Grouper(spaceID string, key string) (Grouper, error) {
 		return nil, errors.New("unsupported relation format")
 	}
 
-	return grouperFn(key), nil
+	return grouperFn(key, opts), nil
+}
+
+// queryRecords returns records matching the filters which have a value of the relation.
+// The relation condition is added to the filters, so they can be used to track the records afterwards
+func queryRecords(store objectstore.ObjectStore, spaceID string, key domain.RelationKey, f *database.Filters) ([]database.Record, error) {
+	if spaceID == "" {
+		return nil, fmt.Errorf("spaceId is required")
+	}
+	// filters of the caller are copied, because they are used for the subscription itself
+	filters := &database.Filters{FilterObj: database.FilterNot{Filter: database.FilterEmpty{Key: key}}}
+	if f != nil {
+		filters.Order = f.Order
+		if f.FilterObj != nil {
+			filters.FilterObj = database.FiltersAnd{f.FilterObj, filters.FilterObj}
+		}
+	}
+	return store.SpaceIndex(spaceID).QueryRaw(filters, 0, 0)
 }
 
 func GroupsToStrSlice(groups []*model.BlockContentDataviewGroup) []string {
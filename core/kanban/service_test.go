@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/anyproto/any-sync/app"
 	"github.com/globalsign/mgo/bson"
@@ -14,6 +15,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/space/spacecore/typeprovider/mock_typeprovider"
 )
@@ -102,7 +104,7 @@ func Test_GrouperTags(t *testing.T) {
 		"tag":  domain.StringList([]string{idTag1, idTag3}),
 	})))
 
-	grouper, err := kanbanSrv.Grouper(spaceId, "tag")
+	grouper, err := kanbanSrv.Grouper(spaceId, "tag", GroupOptions{})
 	require.NoError(t, err)
 	err = grouper.InitGroups(spaceId, nil)
 	require.NoError(t, err)
@@ -117,3 +119,185 @@ func Test_GrouperTags(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, groups, 5)
 }
+
+func newGrouperFixture(t *testing.T, spaceId string, relationKey string, format model.RelationFormat) (Service, spaceindex.Store) {
+	a := new(app.App)
+	tp := mock_typeprovider.NewMockSmartBlockTypeProvider(t)
+	tp.EXPECT().Name().Return("typeprovider")
+	tp.EXPECT().Init(a).Return(nil)
+
+	objectStore := objectstore.NewStoreFixture(t)
+	objectStore.SetLocation(time.UTC)
+	kanbanSrv := New()
+	require.NoError(t, a.Register(objectStore).
+		Register(kanbanSrv).
+		Register(tp).
+		Start(context.Background()))
+
+	store := objectStore.SpaceIndex(spaceId)
+	require.NoError(t, store.UpdateObjectDetails(context.Background(), "rel-"+relationKey, domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+		bundle.RelationKeyId:             domain.String("rel-" + relationKey),
+		bundle.RelationKeyUniqueKey:      domain.String("rel-" + relationKey),
+		bundle.RelationKeyRelationKey:    domain.String(relationKey),
+		bundle.RelationKeyRelationFormat: domain.Int64(int64(format)),
+		bundle.RelationKeyType:           domain.String(bundle.TypeKeyRelation.URL()),
+		bundle.RelationKeyResolvedLayout: domain.Int64(int64(model.ObjectType_relation)),
+	})))
+	return kanbanSrv, store
+}
+
+func addObject(t *testing.T, store spaceindex.Store, key domain.RelationKey, value domain.Value) string {
+	id := bson.NewObjectId().Hex()
+	require.NoError(t, store.UpdateObjectDetails(context.Background(), id, domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+		bundle.RelationKeyId: domain.String(id),
+		key:                  value,
+	})))
+	return id
+}
+
+func Test_GrouperDate(t *testing.T) {
+	const spaceId = "space1"
+
+	t.Run("week buckets", func(t *testing.T) {
+		// given
+		kanbanSrv, store := newGrouperFixture(t, spaceId, "dueDate", model.RelationFormat_date)
+		monday := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+		addObject(t, store, "dueDate", domain.Int64(monday.Add(10*time.Hour).Unix()))
+		addObject(t, store, "dueDate", domain.Int64(monday.AddDate(0, 0, 6).Unix()))
+		addObject(t, store, "dueDate", domain.Int64(monday.AddDate(0, 0, 7).Unix()))
+		addObject(t, store, "name", domain.String("no date"))
+
+		// when
+		grouper, err := kanbanSrv.Grouper(spaceId, "dueDate", GroupOptions{DateBucket: model.BlockContentDataviewDate_Week})
+		require.NoError(t, err)
+		require.NoError(t, grouper.InitGroups(spaceId, nil))
+		groups, err := grouper.MakeDataViewGroups()
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, groups, 3)
+		require.Equal(t, "empty", groups[0].Id)
+		require.Equal(t, &model.BlockContentDataviewDate{From: monday.Unix(), To: monday.AddDate(0, 0, 7).Unix()}, groups[1].GetDate())
+		require.Equal(t, &model.BlockContentDataviewDate{From: monday.AddDate(0, 0, 7).Unix(), To: monday.AddDate(0, 0, 14).Unix()}, groups[2].GetDate())
+	})
+
+	t.Run("day and month buckets", func(t *testing.T) {
+		// given
+		grouper := &GroupDate{Key: "dueDate", Location: time.UTC, Records: []database.Record{
+			{Details: domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{"dueDate": domain.Int64(time.Date(2024, 5, 6, 23, 0, 0, 0, time.UTC).Unix())})},
+			{Details: domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{"dueDate": domain.Int64(time.Date(2024, 5, 7, 1, 0, 0, 0, time.UTC).Unix())})},
+		}}
+
+		// when
+		dayGroups, err := grouper.MakeDataViewGroups()
+		require.NoError(t, err)
+		grouper.Bucket = model.BlockContentDataviewDate_Month
+		monthGroups, err := grouper.MakeDataViewGroups()
+		require.NoError(t, err)
+
+		// then
+		require.Len(t, dayGroups, 3)
+		require.Len(t, monthGroups, 2)
+		require.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC).Unix(), monthGroups[1].GetDate().From)
+		require.Equal(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC).Unix(), monthGroups[1].GetDate().To)
+	})
+}
+
+func Test_GrouperNumber(t *testing.T) {
+	const spaceId = "space1"
+
+	t.Run("configured ranges", func(t *testing.T) {
+		// given
+		kanbanSrv, store := newGrouperFixture(t, spaceId, "estimate", model.RelationFormat_number)
+		addObject(t, store, "estimate", domain.Int64(3))
+
+		// when
+		grouper, err := kanbanSrv.Grouper(spaceId, "estimate", GroupOptions{NumberRanges: []float64{1, 5}})
+		require.NoError(t, err)
+		require.NoError(t, grouper.InitGroups(spaceId, nil))
+		groups, err := grouper.MakeDataViewGroups()
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, groups, 4)
+		require.Equal(t, &model.BlockContentDataviewNumber{To: 1, HasTo: true}, groups[1].GetNumber())
+		require.Equal(t, &model.BlockContentDataviewNumber{From: 1, To: 5, HasFrom: true, HasTo: true}, groups[2].GetNumber())
+		require.Equal(t, &model.BlockContentDataviewNumber{From: 5, HasFrom: true}, groups[3].GetNumber())
+	})
+
+	t.Run("distinct values", func(t *testing.T) {
+		// given
+		kanbanSrv, store := newGrouperFixture(t, spaceId, "estimate", model.RelationFormat_number)
+		addObject(t, store, "estimate", domain.Int64(8))
+		addObject(t, store, "estimate", domain.Int64(2))
+		addObject(t, store, "estimate", domain.Int64(8))
+
+		// when
+		grouper, err := kanbanSrv.Grouper(spaceId, "estimate", GroupOptions{})
+		require.NoError(t, err)
+		require.NoError(t, grouper.InitGroups(spaceId, nil))
+		groups, err := grouper.MakeDataViewGroups()
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, groups, 3)
+		require.Equal(t, float64(2), groups[1].GetNumber().From)
+		require.Equal(t, float64(8), groups[2].GetNumber().From)
+	})
+
+	t.Run("filters of the caller are not changed", func(t *testing.T) {
+		// given
+		kanbanSrv, store := newGrouperFixture(t, spaceId, "estimate", model.RelationFormat_number)
+		addObject(t, store, "estimate", domain.Int64(3))
+		filter := database.FilterEq{Key: "name", Cond: model.BlockContentDataviewFilter_NotEqual, Value: domain.String("other")}
+		f := &database.Filters{FilterObj: filter}
+		grouper, err := kanbanSrv.Grouper(spaceId, "estimate", GroupOptions{})
+		require.NoError(t, err)
+
+		// when
+		err = grouper.InitGroups(spaceId, f)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, filter, f.FilterObj)
+		require.Len(t, grouper.(*GroupNumber).Records, 1)
+	})
+
+	t.Run("descending ranges", func(t *testing.T) {
+		// given
+		kanbanSrv, _ := newGrouperFixture(t, spaceId, "estimate", model.RelationFormat_number)
+		grouper, err := kanbanSrv.Grouper(spaceId, "estimate", GroupOptions{NumberRanges: []float64{5, 1}})
+		require.NoError(t, err)
+
+		// when
+		err = grouper.InitGroups(spaceId, nil)
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func Test_GrouperObject(t *testing.T) {
+	const spaceId = "space1"
+
+	// given
+	kanbanSrv, store := newGrouperFixture(t, spaceId, "assignee", model.RelationFormat_object)
+	addObject(t, store, "assignee", domain.StringList([]string{"alice"}))
+	addObject(t, store, "assignee", domain.StringList([]string{"bob", "alice"}))
+	addObject(t, store, "assignee", domain.StringList([]string{"alice", "bob"}))
+	addObject(t, store, "name", domain.String("unassigned"))
+
+	// when
+	grouper, err := kanbanSrv.Grouper(spaceId, "assignee", GroupOptions{})
+	require.NoError(t, err)
+	require.NoError(t, grouper.InitGroups(spaceId, nil))
+	groups, err := grouper.MakeDataViewGroups()
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, groups, 4)
+	require.Empty(t, groups[0].GetObject().Ids)
+	require.Equal(t, []string{"alice"}, groups[1].GetObject().Ids)
+	require.Equal(t, []string{"bob"}, groups[2].GetObject().Ids)
+	require.Equal(t, []string{"alice", "bob"}, groups[3].GetObject().Ids)
+}
@@ -2,9 +2,12 @@ package core
 
 import (
 	"context"
+	"time"
 
+	anystore "github.com/anyproto/any-store"
 	"github.com/google/uuid"
 
+	"github.com/anyproto/anytype-heart/core/notificationrules"
 	"github.com/anyproto/anytype-heart/core/notifications"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -20,7 +23,17 @@ func (mw *Middleware) NotificationList(cctx context.Context, req *pb.RpcNotifica
 		}
 		return m
 	}
-	notificationsList, err := mustService[notifications.Notifications](mw).List(req.Limit, req.IncludeRead)
+	notificationService := mustService[notifications.Notifications](mw)
+	if req.Grouping != model.NotificationDigest_None {
+		digests, err := notificationService.ListDigests(req.Limit, req.IncludeRead, req.Grouping)
+		if err != nil {
+			return response(pb.RpcNotificationListResponseError_INTERNAL_ERROR, nil, err)
+		}
+		resp := response(pb.RpcNotificationListResponseError_NULL, nil, nil)
+		resp.Digests = digests
+		return resp
+	}
+	notificationsList, err := notificationService.List(req.Limit, req.IncludeRead)
 
 	if err != nil {
 		return response(pb.RpcNotificationListResponseError_INTERNAL_ERROR, notificationsList, err)
@@ -64,3 +77,80 @@ func (mw *Middleware) NotificationTest(cctx context.Context, req *pb.RpcNotifica
 	}
 	return response(pb.RpcNotificationTestResponseError_NULL, nil)
 }
+
+func (mw *Middleware) NotificationSnooze(cctx context.Context, req *pb.RpcNotificationSnoozeRequest) *pb.RpcNotificationSnoozeResponse {
+	var until time.Time
+	if req.Until != 0 {
+		until = time.Unix(req.Until, 0)
+	}
+	err := mustService[notifications.Notifications](mw).Snooze(req.Ids, until)
+	code := mapErrorCode(err,
+		errToCode(notifications.ErrInvalidSnoozeTime, pb.RpcNotificationSnoozeResponseError_BAD_INPUT),
+		errToCode(anystore.ErrDocNotFound, pb.RpcNotificationSnoozeResponseError_BAD_INPUT),
+	)
+	return &pb.RpcNotificationSnoozeResponse{
+		Error: &pb.RpcNotificationSnoozeResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) NotificationExpiryPolicyGet(cctx context.Context, req *pb.RpcNotificationExpiryPolicyGetRequest) *pb.RpcNotificationExpiryPolicyGetResponse {
+	policy, err := mustService[notifications.Notifications](mw).GetExpiryPolicy()
+	return &pb.RpcNotificationExpiryPolicyGetResponse{
+		Error: &pb.RpcNotificationExpiryPolicyGetResponseError{
+			Code:        mapErrorCode[pb.RpcNotificationExpiryPolicyGetResponseErrorCode](err),
+			Description: getErrorDescription(err),
+		},
+		Policy: policy,
+	}
+}
+
+func (mw *Middleware) NotificationExpiryPolicySet(cctx context.Context, req *pb.RpcNotificationExpiryPolicySetRequest) *pb.RpcNotificationExpiryPolicySetResponse {
+	err := mustService[notifications.Notifications](mw).SetExpiryPolicy(req.Policy)
+	code := mapErrorCode(err,
+		errToCode(notifications.ErrInvalidExpiryPolicy, pb.RpcNotificationExpiryPolicySetResponseError_BAD_INPUT),
+	)
+	return &pb.RpcNotificationExpiryPolicySetResponse{
+		Error: &pb.RpcNotificationExpiryPolicySetResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) NotificationRuleSet(cctx context.Context, req *pb.RpcNotificationRuleSetRequest) *pb.RpcNotificationRuleSetResponse {
+	rule, err := mustService[notificationrules.Service](mw).SetRule(req.Rule)
+	code := mapErrorCode(err,
+		errToCode(notificationrules.ErrInvalidRule, pb.RpcNotificationRuleSetResponseError_BAD_INPUT),
+	)
+	return &pb.RpcNotificationRuleSetResponse{
+		Error: &pb.RpcNotificationRuleSetResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+		Rule: rule,
+	}
+}
+
+func (mw *Middleware) NotificationRuleList(cctx context.Context, req *pb.RpcNotificationRuleListRequest) *pb.RpcNotificationRuleListResponse {
+	rules, err := mustService[notificationrules.Service](mw).ListRules()
+	return &pb.RpcNotificationRuleListResponse{
+		Error: &pb.RpcNotificationRuleListResponseError{
+			Code:        mapErrorCode[pb.RpcNotificationRuleListResponseErrorCode](err),
+			Description: getErrorDescription(err),
+		},
+		Rules: rules,
+	}
+}
+
+func (mw *Middleware) NotificationRuleRemove(cctx context.Context, req *pb.RpcNotificationRuleRemoveRequest) *pb.RpcNotificationRuleRemoveResponse {
+	err := mustService[notificationrules.Service](mw).RemoveRules(req.Ids)
+	return &pb.RpcNotificationRuleRemoveResponse{
+		Error: &pb.RpcNotificationRuleRemoveResponseError{
+			Code:        mapErrorCode[pb.RpcNotificationRuleRemoveResponseErrorCode](err),
+			Description: getErrorDescription(err),
+		},
+	}
+}
@@ -0,0 +1,321 @@
+package notificationrules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"sync"
+
+	anystore "github.com/anyproto/any-store"
+	"github.com/anyproto/any-sync/app"
+	"github.com/cheggaaa/mb/v3"
+	"github.com/gogo/protobuf/proto"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/notifications"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore/anystoreprovider"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/keyvaluestore"
+)
+
+const CName = "core.notificationrules"
+
+var log = logging.Logger(CName).Desugar()
+
+var ErrInvalidRule = errors.New("invalid notification rule")
+
+// Service keeps user-defined notification rules and evaluates them on every update of indexed details.
+// Only changes made by other participants are checked, as nobody wants to be notified about own changes
+type Service interface {
+	app.ComponentRunnable
+
+	// SetRule creates a rule if its id is empty or replaces the existing one
+	SetRule(rule *model.NotificationRule) (*model.NotificationRule, error)
+	ListRules() ([]*model.NotificationRule, error)
+	RemoveRules(ids []string) error
+}
+
+type detailsUpdater interface {
+	SubscribeDetailsUpdate(callback func(info spaceindex.DetailsUpdateInfo))
+}
+
+type accountService interface {
+	MyParticipantId(spaceId string) string
+}
+
+type service struct {
+	updater             detailsUpdater
+	objectStore         objectstore.ObjectStore
+	notificationService notifications.Notifications
+	accountService      accountService
+	ruleStore           keyvaluestore.Store[*model.NotificationRule]
+
+	updates            *mb.MB[spaceindex.DetailsUpdateInfo]
+	componentCtx       context.Context
+	componentCtxCancel context.CancelFunc
+
+	lock  sync.RWMutex
+	rules []*model.NotificationRule
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.updater = app.MustComponent[detailsUpdater](a)
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.notificationService = app.MustComponent[notifications.Notifications](a)
+	s.accountService = app.MustComponent[accountService](a)
+	provider := app.MustComponent[anystoreprovider.Provider](a)
+	s.ruleStore, err = newRuleStore(provider.GetCommonDb())
+	if err != nil {
+		return fmt.Errorf("init rule store: %w", err)
+	}
+	s.updates = mb.New[spaceindex.DetailsUpdateInfo](0)
+	s.componentCtx, s.componentCtxCancel = context.WithCancel(context.Background())
+	return nil
+}
+
+func newRuleStore(db anystore.DB) (keyvaluestore.Store[*model.NotificationRule], error) {
+	return keyvaluestore.New(db, "notificationRules", func(rule *model.NotificationRule) ([]byte, error) {
+		return proto.Marshal(rule)
+	}, func(raw []byte) (*model.NotificationRule, error) {
+		rule := &model.NotificationRule{}
+		err := proto.Unmarshal(raw, rule)
+		return rule, err
+	})
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(_ context.Context) error {
+	if err := s.loadRules(); err != nil {
+		return err
+	}
+	go s.processUpdates()
+	return nil
+}
+
+// updateSubscription subscribes to details updates only while there are rules,
+// otherwise the object store doesn't read previous details on every update
+func (s *service) updateSubscription(hasRules bool) {
+	if !hasRules {
+		s.updater.SubscribeDetailsUpdate(nil)
+		return
+	}
+	// the callback is called inside indexing, so updates are evaluated in the background
+	s.updater.SubscribeDetailsUpdate(func(info spaceindex.DetailsUpdateInfo) {
+		if err := s.updates.Add(s.componentCtx, info); err != nil {
+			log.Error("add details update to queue", zap.String("objectId", info.Id.ObjectID), zap.Error(err))
+		}
+	})
+}
+
+func (s *service) Close(_ context.Context) error {
+	if s.componentCtxCancel != nil {
+		s.componentCtxCancel()
+	}
+	if s.updates != nil {
+		if err := s.updates.Close(); err != nil {
+			log.Error("close details update queue", zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (s *service) loadRules() error {
+	rules, err := s.ruleStore.ListAllValues(s.componentCtx)
+	if err != nil {
+		return fmt.Errorf("load notification rules: %w", err)
+	}
+	s.lock.Lock()
+	s.rules = rules
+	s.lock.Unlock()
+	s.updateSubscription(len(rules) > 0)
+	return nil
+}
+
+func (s *service) SetRule(rule *model.NotificationRule) (*model.NotificationRule, error) {
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+	rule = proto.Clone(rule).(*model.NotificationRule)
+	if rule.Id == "" {
+		rule.Id = uuid.New().String()
+	}
+	if err := s.ruleStore.Set(s.componentCtx, rule.Id, rule); err != nil {
+		return nil, fmt.Errorf("save rule: %w", err)
+	}
+	return rule, s.loadRules()
+}
+
+func validateRule(rule *model.NotificationRule) error {
+	if rule == nil {
+		return fmt.Errorf("%w: empty rule", ErrInvalidRule)
+	}
+	if rule.Trigger != model.NotificationRule_Mentioned && rule.RelationKey == "" {
+		return fmt.Errorf("%w: relation key is required for trigger %s", ErrInvalidRule, rule.Trigger)
+	}
+	return nil
+}
+
+func (s *service) ListRules() ([]*model.NotificationRule, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return slices.Clone(s.rules), nil
+}
+
+func (s *service) RemoveRules(ids []string) error {
+	for _, id := range ids {
+		if err := s.ruleStore.Delete(s.componentCtx, id); err != nil && !errors.Is(err, anystore.ErrDocNotFound) {
+			return fmt.Errorf("delete rule %s: %w", id, err)
+		}
+	}
+	return s.loadRules()
+}
+
+func (s *service) processUpdates() {
+	for {
+		updates, err := s.updates.Wait(s.componentCtx)
+		if err != nil {
+			return
+		}
+		s.lock.RLock()
+		rules := s.rules
+		s.lock.RUnlock()
+		for _, update := range updates {
+			for _, notification := range s.evaluate(rules, update) {
+				if err = s.notificationService.CreateAndSend(notification); err != nil {
+					log.Error("send rule notification", zap.String("objectId", update.Id.ObjectID), zap.Error(err))
+				}
+			}
+		}
+	}
+}
+
+// evaluate returns notifications for all rules matched by the update
+func (s *service) evaluate(rules []*model.NotificationRule, update spaceindex.DetailsUpdateInfo) []*model.Notification {
+	// objects indexed for the first time are skipped, otherwise every object is reported after joining a space
+	if update.OldDetails == nil || update.NewDetails == nil {
+		return nil
+	}
+	newDetails := update.NewDetails
+	if newDetails.GetBool(bundle.RelationKeyIsArchived) || newDetails.GetBool(bundle.RelationKeyIsDeleted) {
+		return nil
+	}
+	spaceId := update.Id.SpaceID
+	me := s.accountService.MyParticipantId(spaceId)
+	actorId := newDetails.GetString(bundle.RelationKeyLastModifiedBy)
+	if actorId == "" || actorId == me {
+		return nil
+	}
+
+	var (
+		result  []*model.Notification
+		typeKey domain.TypeKey
+	)
+	for _, rule := range rules {
+		if rule.Disabled || (rule.SpaceId != "" && rule.SpaceId != spaceId) {
+			continue
+		}
+		if rule.OnlyCreatedByMe && newDetails.GetString(bundle.RelationKeyCreator) != me {
+			continue
+		}
+		if len(rule.ObjectTypeKeys) > 0 {
+			if typeKey == "" {
+				typeKey = s.getTypeKey(spaceId, newDetails.GetString(bundle.RelationKeyType))
+			}
+			if !slices.Contains(rule.ObjectTypeKeys, typeKey.String()) {
+				continue
+			}
+		}
+		relationKey := domain.RelationKey(rule.RelationKey)
+		switch rule.Trigger {
+		case model.NotificationRule_RelationChanged:
+			if update.OldDetails.Get(relationKey).Equal(newDetails.Get(relationKey)) {
+				continue
+			}
+		case model.NotificationRule_Assigned:
+			if !isAdded(me, update.OldDetails.GetStringList(relationKey), newDetails.GetStringList(relationKey)) {
+				continue
+			}
+		case model.NotificationRule_Mentioned:
+			relationKey = bundle.RelationKeyMentions
+			if !isAdded(me, update.OldDetails.GetStringList(relationKey), newDetails.GetStringList(relationKey)) {
+				continue
+			}
+		default:
+			continue
+		}
+		result = append(result, s.newNotification(rule, update.Id, relationKey, newDetails))
+	}
+	return result
+}
+
+func isAdded(id string, oldIds, newIds []string) bool {
+	return id != "" && slices.Contains(newIds, id) && !slices.Contains(oldIds, id)
+}
+
+func (s *service) getTypeKey(spaceId string, typeId string) domain.TypeKey {
+	if typeId == "" {
+		return ""
+	}
+	uk, err := s.objectStore.SpaceIndex(spaceId).GetUniqueKeyById(typeId)
+	if err != nil {
+		log.Warn("get unique key of type", zap.String("typeId", typeId), zap.Error(err))
+		return ""
+	}
+	return domain.TypeKey(uk.InternalKey())
+}
+
+func (s *service) newNotification(rule *model.NotificationRule, id domain.FullID, relationKey domain.RelationKey, details *domain.Details) *model.Notification {
+	spaceIndex := s.objectStore.SpaceIndex(id.SpaceID)
+	var relationName string
+	if rel, err := spaceIndex.GetRelationByKey(relationKey.String()); err == nil {
+		relationName = rel.Name
+	}
+	actorId := details.GetString(bundle.RelationKeyLastModifiedBy)
+	var actorName string
+	if actor, err := spaceIndex.GetDetails(actorId); err == nil {
+		actorName = actor.GetString(bundle.RelationKeyName)
+	}
+	return &model.Notification{
+		// the same change is reported once, even if the object is reindexed
+		Id:      fmt.Sprintf("rule_%s_%s_%d", rule.Id, id.ObjectID, details.GetInt64(bundle.RelationKeyLastModifiedDate)),
+		Status:  model.Notification_Created,
+		IsLocal: true,
+		Space:   id.SpaceID,
+		Payload: &model.NotificationPayloadOfRuleTriggered{RuleTriggered: &model.NotificationRuleTriggered{
+			RuleId:       rule.Id,
+			RuleName:     rule.Name,
+			Trigger:      rule.Trigger,
+			SpaceId:      id.SpaceID,
+			SpaceName:    s.objectStore.GetSpaceName(id.SpaceID),
+			ObjectId:     id.ObjectID,
+			ObjectName:   details.GetString(bundle.RelationKeyName),
+			RelationKey:  relationKey.String(),
+			RelationName: relationName,
+			ActorId:      actorId,
+			ActorName:    actorName,
+			DeepLink:     deepLink(id),
+		}},
+	}
+}
+
+func deepLink(id domain.FullID) string {
+	query := url.Values{}
+	query.Set("objectId", id.ObjectID)
+	query.Set("spaceId", id.SpaceID)
+	return "anytype://object?" + query.Encode()
+}
@@ -0,0 +1,257 @@
+package notificationrules
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	anystore "github.com/anyproto/any-store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	spaceId     = "space1"
+	me          = "_participant_space1_me"
+	colleague   = "_participant_space1_colleague"
+	assigneeKey = domain.RelationKey("assignee")
+)
+
+type accountServiceStub struct{}
+
+type updaterStub struct {
+	callback func(info spaceindex.DetailsUpdateInfo)
+}
+
+func (u *updaterStub) SubscribeDetailsUpdate(callback func(info spaceindex.DetailsUpdateInfo)) {
+	u.callback = callback
+}
+
+func (accountServiceStub) MyParticipantId(spaceId string) string {
+	return domain.NewParticipantId(spaceId, "me")
+}
+
+func newFixture(t *testing.T) *service {
+	store := objectstore.NewStoreFixture(t)
+	taskTypeKey, err := domain.NewUniqueKey(coresb.SmartBlockTypeObjectType, "task")
+	require.NoError(t, err)
+	store.AddObjects(t, spaceId, []objectstore.TestObject{
+		{
+			bundle.RelationKeyId:        domain.String("taskType"),
+			bundle.RelationKeyUniqueKey: domain.String(taskTypeKey.Marshal()),
+		},
+		{
+			bundle.RelationKeyId:   domain.String(colleague),
+			bundle.RelationKeyName: domain.String("Colleague"),
+		},
+		{
+			bundle.RelationKeyId:             domain.String("rel-assignee"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_relation),
+			bundle.RelationKeyRelationKey:    domain.String(assigneeKey.String()),
+			bundle.RelationKeyName:           domain.String("Assignee"),
+		},
+	})
+	db, err := anystore.Open(context.Background(), filepath.Join(t.TempDir(), "test.db"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	ruleStore, err := newRuleStore(db)
+	require.NoError(t, err)
+	return &service{
+		updater:        &updaterStub{},
+		objectStore:    store,
+		accountService: accountServiceStub{},
+		ruleStore:      ruleStore,
+		componentCtx:   context.Background(),
+	}
+}
+
+func givenTask(modify func(d *domain.Details)) *domain.Details {
+	d := domain.NewDetails()
+	d.SetString(bundle.RelationKeyId, "task1")
+	d.SetString(bundle.RelationKeyName, "Write report")
+	d.SetString(bundle.RelationKeyType, "taskType")
+	d.SetString(bundle.RelationKeyCreator, me)
+	d.SetString(bundle.RelationKeyLastModifiedBy, colleague)
+	d.SetInt64(bundle.RelationKeyLastModifiedDate, 100)
+	if modify != nil {
+		modify(d)
+	}
+	return d
+}
+
+func givenUpdate(oldDetails, newDetails *domain.Details) spaceindex.DetailsUpdateInfo {
+	return spaceindex.DetailsUpdateInfo{
+		Id:         domain.FullID{SpaceID: spaceId, ObjectID: "task1"},
+		OldDetails: oldDetails,
+		NewDetails: newDetails,
+	}
+}
+
+func TestService_evaluate(t *testing.T) {
+	assignedRule := &model.NotificationRule{
+		Id:             "assigned",
+		Name:           "Tasks assigned to me",
+		ObjectTypeKeys: []string{"task"},
+		Trigger:        model.NotificationRule_Assigned,
+		RelationKey:    assigneeKey.String(),
+	}
+	assigned := func(d *domain.Details) {
+		d.SetStringList(assigneeKey, []string{colleague, me})
+	}
+
+	t.Run("assigned by colleague", func(t *testing.T) {
+		s := newFixture(t)
+
+		notifications := s.evaluate([]*model.NotificationRule{assignedRule}, givenUpdate(givenTask(nil), givenTask(assigned)))
+
+		require.Len(t, notifications, 1)
+		assert.Equal(t, "rule_assigned_task1_100", notifications[0].Id)
+		assert.True(t, notifications[0].IsLocal)
+		assert.Equal(t, &model.NotificationRuleTriggered{
+			RuleId:       "assigned",
+			RuleName:     "Tasks assigned to me",
+			Trigger:      model.NotificationRule_Assigned,
+			SpaceId:      spaceId,
+			ObjectId:     "task1",
+			ObjectName:   "Write report",
+			RelationKey:  assigneeKey.String(),
+			RelationName: "Assignee",
+			ActorId:      colleague,
+			ActorName:    "Colleague",
+			DeepLink:     "anytype://object?objectId=task1&spaceId=space1",
+		}, notifications[0].GetRuleTriggered())
+	})
+
+	t.Run("already assigned", func(t *testing.T) {
+		s := newFixture(t)
+
+		notifications := s.evaluate([]*model.NotificationRule{assignedRule}, givenUpdate(givenTask(assigned), givenTask(func(d *domain.Details) {
+			assigned(d)
+			d.SetString(bundle.RelationKeyName, "Renamed")
+		})))
+
+		assert.Empty(t, notifications)
+	})
+
+	t.Run("own changes are skipped", func(t *testing.T) {
+		s := newFixture(t)
+
+		notifications := s.evaluate([]*model.NotificationRule{assignedRule}, givenUpdate(givenTask(nil), givenTask(func(d *domain.Details) {
+			assigned(d)
+			d.SetString(bundle.RelationKeyLastModifiedBy, me)
+		})))
+
+		assert.Empty(t, notifications)
+	})
+
+	t.Run("object of another type", func(t *testing.T) {
+		s := newFixture(t)
+
+		notifications := s.evaluate([]*model.NotificationRule{assignedRule}, givenUpdate(givenTask(nil), givenTask(func(d *domain.Details) {
+			assigned(d)
+			d.SetString(bundle.RelationKeyType, "pageType")
+		})))
+
+		assert.Empty(t, notifications)
+	})
+
+	t.Run("objects indexed for the first time are skipped", func(t *testing.T) {
+		s := newFixture(t)
+
+		notifications := s.evaluate([]*model.NotificationRule{assignedRule}, givenUpdate(nil, givenTask(assigned)))
+
+		assert.Empty(t, notifications)
+	})
+
+	t.Run("status of my object is changed", func(t *testing.T) {
+		s := newFixture(t)
+		rule := &model.NotificationRule{
+			Id:              "status",
+			Trigger:         model.NotificationRule_RelationChanged,
+			RelationKey:     "status",
+			OnlyCreatedByMe: true,
+		}
+		withStatus := func(status string) func(d *domain.Details) {
+			return func(d *domain.Details) {
+				d.SetString("status", status)
+			}
+		}
+
+		notifications := s.evaluate([]*model.NotificationRule{rule}, givenUpdate(givenTask(withStatus("open")), givenTask(withStatus("done"))))
+		assert.Len(t, notifications, 1)
+
+		notifications = s.evaluate([]*model.NotificationRule{rule}, givenUpdate(givenTask(withStatus("open")), givenTask(func(d *domain.Details) {
+			withStatus("done")(d)
+			d.SetString(bundle.RelationKeyCreator, colleague)
+		})))
+		assert.Empty(t, notifications)
+	})
+
+	t.Run("mentioned", func(t *testing.T) {
+		s := newFixture(t)
+		rule := &model.NotificationRule{Id: "mention", Trigger: model.NotificationRule_Mentioned, SpaceId: spaceId}
+
+		notifications := s.evaluate([]*model.NotificationRule{rule}, givenUpdate(givenTask(nil), givenTask(func(d *domain.Details) {
+			d.SetStringList(bundle.RelationKeyMentions, []string{me})
+		})))
+
+		require.Len(t, notifications, 1)
+		assert.Equal(t, bundle.RelationKeyMentions.String(), notifications[0].GetRuleTriggered().RelationKey)
+	})
+
+	t.Run("disabled rule and rule of another space", func(t *testing.T) {
+		s := newFixture(t)
+		rules := []*model.NotificationRule{
+			{Id: "disabled", Trigger: model.NotificationRule_Mentioned, Disabled: true},
+			{Id: "another space", Trigger: model.NotificationRule_Mentioned, SpaceId: "space2"},
+		}
+
+		notifications := s.evaluate(rules, givenUpdate(givenTask(nil), givenTask(func(d *domain.Details) {
+			d.SetStringList(bundle.RelationKeyMentions, []string{me})
+		})))
+
+		assert.Empty(t, notifications)
+	})
+}
+
+func TestService_Rules(t *testing.T) {
+	s := newFixture(t)
+
+	t.Run("set, list and remove", func(t *testing.T) {
+		rule, err := s.SetRule(&model.NotificationRule{Name: "Mentions", Trigger: model.NotificationRule_Mentioned})
+		require.NoError(t, err)
+		assert.NotEmpty(t, rule.Id)
+		assert.NotNil(t, s.updater.(*updaterStub).callback)
+
+		rule.Name = "My mentions"
+		_, err = s.SetRule(rule)
+		require.NoError(t, err)
+
+		rules, err := s.ListRules()
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		assert.Equal(t, "My mentions", rules[0].Name)
+
+		require.NoError(t, s.RemoveRules([]string{rule.Id, "unknown"}))
+		rules, err = s.ListRules()
+		require.NoError(t, err)
+		assert.Empty(t, rules)
+		// details updates are not tracked without rules
+		assert.Nil(t, s.updater.(*updaterStub).callback)
+	})
+
+	t.Run("relation key is required", func(t *testing.T) {
+		_, err := s.SetRule(&model.NotificationRule{Trigger: model.NotificationRule_Assigned})
+
+		assert.ErrorIs(t, err, ErrInvalidRule)
+	})
+}
@@ -0,0 +1,100 @@
+package notifications
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// groupNotifications joins related notifications into digests. Notifications without an object or a space
+// get their own digest. Digests are sorted by the time of the latest notification, newest first
+func groupNotifications(notifications []*model.Notification, grouping model.NotificationDigestGrouping) []*model.NotificationDigest {
+	notifications = slices.Clone(notifications)
+	slices.SortStableFunc(notifications, func(a, b *model.Notification) int {
+		return cmp.Compare(b.CreateTime, a.CreateTime)
+	})
+
+	var (
+		digests []*model.NotificationDigest
+		byKey   = map[string]*model.NotificationDigest{}
+	)
+	for _, notification := range notifications {
+		spaceId := notificationSpaceId(notification)
+		objectId := notificationObjectId(notification)
+		key := digestKey(notification, grouping, spaceId, objectId)
+		digest, ok := byKey[key]
+		if !ok {
+			digest = &model.NotificationDigest{
+				Id:       key,
+				Grouping: grouping,
+				SpaceId:  spaceId,
+				Latest:   notification,
+			}
+			if grouping != model.NotificationDigest_Space {
+				digest.ObjectId = objectId
+			}
+			byKey[key] = digest
+			digests = append(digests, digest)
+		}
+		digest.Count++
+		if !isRead(notification) {
+			digest.UnreadCount++
+		}
+		digest.NotificationIds = append(digest.NotificationIds, notification.Id)
+	}
+	return digests
+}
+
+func digestKey(notification *model.Notification, grouping model.NotificationDigestGrouping, spaceId, objectId string) string {
+	switch grouping {
+	case model.NotificationDigest_Object:
+		if objectId != "" {
+			return objectId
+		}
+	case model.NotificationDigest_Space:
+		if spaceId != "" {
+			return spaceId
+		}
+	}
+	return notification.Id
+}
+
+func notificationObjectId(notification *model.Notification) string {
+	switch payload := notification.Payload.(type) {
+	case *model.NotificationPayloadOfReminder:
+		return payload.Reminder.GetObjectId()
+	case *model.NotificationPayloadOfRuleTriggered:
+		return payload.RuleTriggered.GetObjectId()
+	}
+	return ""
+}
+
+func notificationSpaceId(notification *model.Notification) string {
+	if notification.Space != "" {
+		return notification.Space
+	}
+	switch payload := notification.Payload.(type) {
+	case *model.NotificationPayloadOfImport:
+		return payload.Import.GetSpaceId()
+	case *model.NotificationPayloadOfGalleryImport:
+		return payload.GalleryImport.GetSpaceId()
+	case *model.NotificationPayloadOfRequestToJoin:
+		return payload.RequestToJoin.GetSpaceId()
+	case *model.NotificationPayloadOfParticipantRequestApproved:
+		return payload.ParticipantRequestApproved.GetSpaceId()
+	case *model.NotificationPayloadOfRequestToLeave:
+		return payload.RequestToLeave.GetSpaceId()
+	case *model.NotificationPayloadOfParticipantRemove:
+		return payload.ParticipantRemove.GetSpaceId()
+	case *model.NotificationPayloadOfParticipantRequestDecline:
+		return payload.ParticipantRequestDecline.GetSpaceId()
+	case *model.NotificationPayloadOfParticipantPermissionsChange:
+		return payload.ParticipantPermissionsChange.GetSpaceId()
+	case *model.NotificationPayloadOfReminder:
+		return payload.Reminder.GetSpaceId()
+	case *model.NotificationPayloadOfRuleTriggered:
+		return payload.RuleTriggered.GetSpaceId()
+	}
+	return ""
+}
@@ -10,6 +10,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+
+	time "time"
 )
 
 // MockNotifications is an autogenerated mock type for the Notifications type
@@ -117,6 +119,63 @@ func (_c *MockNotifications_CreateAndSend_Call) RunAndReturn(run func(*model.Not
 	return _c
 }
 
+// GetExpiryPolicy provides a mock function with given fields:
+func (_m *MockNotifications) GetExpiryPolicy() (*model.NotificationExpiryPolicy, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiryPolicy")
+	}
+
+	var r0 *model.NotificationExpiryPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*model.NotificationExpiryPolicy, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *model.NotificationExpiryPolicy); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.NotificationExpiryPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNotifications_GetExpiryPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpiryPolicy'
+type MockNotifications_GetExpiryPolicy_Call struct {
+	*mock.Call
+}
+
+// GetExpiryPolicy is a helper method to define mock.On call
+func (_e *MockNotifications_Expecter) GetExpiryPolicy() *MockNotifications_GetExpiryPolicy_Call {
+	return &MockNotifications_GetExpiryPolicy_Call{Call: _e.mock.On("GetExpiryPolicy")}
+}
+
+func (_c *MockNotifications_GetExpiryPolicy_Call) Run(run func()) *MockNotifications_GetExpiryPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockNotifications_GetExpiryPolicy_Call) Return(_a0 *model.NotificationExpiryPolicy, _a1 error) *MockNotifications_GetExpiryPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNotifications_GetExpiryPolicy_Call) RunAndReturn(run func() (*model.NotificationExpiryPolicy, error)) *MockNotifications_GetExpiryPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Init provides a mock function with given fields: a
 func (_m *MockNotifications) Init(a *app.App) error {
 	ret := _m.Called(a)
@@ -222,6 +281,66 @@ func (_c *MockNotifications_List_Call) RunAndReturn(run func(int64, bool) ([]*mo
 	return _c
 }
 
+// ListDigests provides a mock function with given fields: limit, includeRead, grouping
+func (_m *MockNotifications) ListDigests(limit int64, includeRead bool, grouping model.NotificationDigestGrouping) ([]*model.NotificationDigest, error) {
+	ret := _m.Called(limit, includeRead, grouping)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDigests")
+	}
+
+	var r0 []*model.NotificationDigest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, bool, model.NotificationDigestGrouping) ([]*model.NotificationDigest, error)); ok {
+		return rf(limit, includeRead, grouping)
+	}
+	if rf, ok := ret.Get(0).(func(int64, bool, model.NotificationDigestGrouping) []*model.NotificationDigest); ok {
+		r0 = rf(limit, includeRead, grouping)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.NotificationDigest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, bool, model.NotificationDigestGrouping) error); ok {
+		r1 = rf(limit, includeRead, grouping)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNotifications_ListDigests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDigests'
+type MockNotifications_ListDigests_Call struct {
+	*mock.Call
+}
+
+// ListDigests is a helper method to define mock.On call
+//   - limit int64
+//   - includeRead bool
+//   - grouping model.NotificationDigestGrouping
+func (_e *MockNotifications_Expecter) ListDigests(limit interface{}, includeRead interface{}, grouping interface{}) *MockNotifications_ListDigests_Call {
+	return &MockNotifications_ListDigests_Call{Call: _e.mock.On("ListDigests", limit, includeRead, grouping)}
+}
+
+func (_c *MockNotifications_ListDigests_Call) Run(run func(limit int64, includeRead bool, grouping model.NotificationDigestGrouping)) *MockNotifications_ListDigests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(bool), args[2].(model.NotificationDigestGrouping))
+	})
+	return _c
+}
+
+func (_c *MockNotifications_ListDigests_Call) Return(_a0 []*model.NotificationDigest, _a1 error) *MockNotifications_ListDigests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNotifications_ListDigests_Call) RunAndReturn(run func(int64, bool, model.NotificationDigestGrouping) ([]*model.NotificationDigest, error)) *MockNotifications_ListDigests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *MockNotifications) Name() string {
 	ret := _m.Called()
@@ -360,6 +479,99 @@ func (_c *MockNotifications_Run_Call) RunAndReturn(run func(context.Context) err
 	return _c
 }
 
+// SetExpiryPolicy provides a mock function with given fields: policy
+func (_m *MockNotifications) SetExpiryPolicy(policy *model.NotificationExpiryPolicy) error {
+	ret := _m.Called(policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetExpiryPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.NotificationExpiryPolicy) error); ok {
+		r0 = rf(policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotifications_SetExpiryPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetExpiryPolicy'
+type MockNotifications_SetExpiryPolicy_Call struct {
+	*mock.Call
+}
+
+// SetExpiryPolicy is a helper method to define mock.On call
+//   - policy *model.NotificationExpiryPolicy
+func (_e *MockNotifications_Expecter) SetExpiryPolicy(policy interface{}) *MockNotifications_SetExpiryPolicy_Call {
+	return &MockNotifications_SetExpiryPolicy_Call{Call: _e.mock.On("SetExpiryPolicy", policy)}
+}
+
+func (_c *MockNotifications_SetExpiryPolicy_Call) Run(run func(policy *model.NotificationExpiryPolicy)) *MockNotifications_SetExpiryPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.NotificationExpiryPolicy))
+	})
+	return _c
+}
+
+func (_c *MockNotifications_SetExpiryPolicy_Call) Return(_a0 error) *MockNotifications_SetExpiryPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotifications_SetExpiryPolicy_Call) RunAndReturn(run func(*model.NotificationExpiryPolicy) error) *MockNotifications_SetExpiryPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Snooze provides a mock function with given fields: notificationIds, until
+func (_m *MockNotifications) Snooze(notificationIds []string, until time.Time) error {
+	ret := _m.Called(notificationIds, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snooze")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, time.Time) error); ok {
+		r0 = rf(notificationIds, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotifications_Snooze_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snooze'
+type MockNotifications_Snooze_Call struct {
+	*mock.Call
+}
+
+// Snooze is a helper method to define mock.On call
+//   - notificationIds []string
+//   - until time.Time
+func (_e *MockNotifications_Expecter) Snooze(notificationIds interface{}, until interface{}) *MockNotifications_Snooze_Call {
+	return &MockNotifications_Snooze_Call{Call: _e.mock.On("Snooze", notificationIds, until)}
+}
+
+func (_c *MockNotifications_Snooze_Call) Run(run func(notificationIds []string, until time.Time)) *MockNotifications_Snooze_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockNotifications_Snooze_Call) Return(_a0 error) *MockNotifications_Snooze_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotifications_Snooze_Call) RunAndReturn(run func([]string, time.Time) error) *MockNotifications_Snooze_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateAndSend provides a mock function with given fields: notification
 func (_m *MockNotifications) UpdateAndSend(notification *model.Notification) error {
 	ret := _m.Called(notification)
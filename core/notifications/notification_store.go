@@ -2,6 +2,7 @@ package notifications
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	anystore "github.com/anyproto/any-store"
@@ -11,14 +12,26 @@ import (
 	"github.com/anyproto/anytype-heart/util/keyvaluestore"
 )
 
+const expiryPolicyKey = "expiryPolicy"
+
+// DefaultExpiryPolicy removes read notifications in 30 days and keeps unread ones
+var DefaultExpiryPolicy = &model.NotificationExpiryPolicy{
+	ReadTtl: 30 * 24 * 60 * 60,
+}
+
 type NotificationStore interface {
 	SaveNotification(notification *model.Notification) error
 	ListNotifications() ([]*model.Notification, error)
 	GetNotificationById(notificationID string) (*model.Notification, error)
+	DeleteNotification(notificationId string) error
+	// GetExpiryPolicy returns DefaultExpiryPolicy if the policy was never saved
+	GetExpiryPolicy() (*model.NotificationExpiryPolicy, error)
+	SaveExpiryPolicy(policy *model.NotificationExpiryPolicy) error
 }
 
 type notificationStore struct {
-	db keyvaluestore.Store[*model.Notification]
+	db       keyvaluestore.Store[*model.Notification]
+	policies keyvaluestore.Store[*model.NotificationExpiryPolicy]
 }
 
 func NewNotificationStore(db anystore.DB) (NotificationStore, error) {
@@ -32,7 +45,17 @@ func NewNotificationStore(db anystore.DB) (NotificationStore, error) {
 	if err != nil {
 		return nil, fmt.Errorf("init store: %w", err)
 	}
-	return &notificationStore{db: kv}, nil
+	policies, err := keyvaluestore.New(db, "notificationSettings", func(policy *model.NotificationExpiryPolicy) ([]byte, error) {
+		return proto.Marshal(policy)
+	}, func(raw []byte) (*model.NotificationExpiryPolicy, error) {
+		p := &model.NotificationExpiryPolicy{}
+		err := proto.Unmarshal(raw, p)
+		return p, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("init settings store: %w", err)
+	}
+	return &notificationStore{db: kv, policies: policies}, nil
 }
 
 func (n *notificationStore) SaveNotification(notification *model.Notification) error {
@@ -46,3 +69,19 @@ func (n *notificationStore) ListNotifications() ([]*model.Notification, error) {
 func (n *notificationStore) GetNotificationById(notificationId string) (*model.Notification, error) {
 	return n.db.Get(context.Background(), notificationId)
 }
+
+func (n *notificationStore) DeleteNotification(notificationId string) error {
+	return n.db.Delete(context.Background(), notificationId)
+}
+
+func (n *notificationStore) GetExpiryPolicy() (*model.NotificationExpiryPolicy, error) {
+	policy, err := n.policies.Get(context.Background(), expiryPolicyKey)
+	if errors.Is(err, anystore.ErrDocNotFound) {
+		return proto.Clone(DefaultExpiryPolicy).(*model.NotificationExpiryPolicy), nil
+	}
+	return policy, err
+}
+
+func (n *notificationStore) SaveExpiryPolicy(policy *model.NotificationExpiryPolicy) error {
+	return n.policies.Set(context.Background(), expiryPolicyKey, policy)
+}
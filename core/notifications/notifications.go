@@ -31,12 +31,25 @@ var log = logging.Logger("notifications")
 
 const CName = "notificationService"
 
+const maintenanceInterval = time.Minute
+
+var (
+	ErrInvalidSnoozeTime   = errors.New("snooze time is in the past")
+	ErrInvalidExpiryPolicy = errors.New("invalid expiry policy")
+)
+
 type Notifications interface {
 	app.ComponentRunnable
 	CreateAndSend(notification *model.Notification) error
 	UpdateAndSend(notification *model.Notification) error
 	Reply(notificationIds []string, notificationAction model.NotificationActionType) error
 	List(limit int64, includeRead bool) ([]*model.Notification, error)
+	// ListDigests groups notifications by object or by space, the most recent digests go first
+	ListDigests(limit int64, includeRead bool, grouping model.NotificationDigestGrouping) ([]*model.NotificationDigest, error)
+	// Snooze hides notifications from the list until the given time, zero time cancels the snooze
+	Snooze(notificationIds []string, until time.Time) error
+	GetExpiryPolicy() (*model.NotificationExpiryPolicy, error)
+	SetExpiryPolicy(policy *model.NotificationExpiryPolicy) error
 }
 
 type notificationService struct {
@@ -82,6 +95,7 @@ func (n *notificationService) Run(_ context.Context) (err error) {
 	notificationContext, notificationCancel := context.WithCancel(context.Background())
 	n.notificationCancel = notificationCancel
 	go n.loadNotificationObject(notificationContext)
+	go n.runMaintenance(notificationContext)
 	return nil
 }
 
@@ -105,8 +119,18 @@ func (n *notificationService) updateNotificationsInLocalStore() {
 	if err != nil {
 		log.Errorf("failed to get notifications from object: %s", err)
 	}
+	policy, err := n.notificationStore.GetExpiryPolicy()
+	if err != nil {
+		log.Errorf("failed to get expiry policy: %s", err)
+		policy = DefaultExpiryPolicy
+	}
+	now := time.Now()
 	lastNotificationTimestamp := make(map[string]int64, 0)
 	for _, notification := range notifications {
+		if isExpired(policy, notification, now) {
+			continue
+		}
+		n.keepLocalState(notification)
 		err := n.notificationStore.SaveNotification(notification)
 		if err != nil {
 			log.Errorf("failed to save notification %s: %s", notification.Id, err)
@@ -130,16 +154,17 @@ func (n *notificationService) Close(_ context.Context) (err error) {
 
 func (n *notificationService) CreateAndSend(notification *model.Notification) error {
 	notification.CreateTime = time.Now().Unix()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	// local notifications are deduplicated by the store only, as they are not added to the notification object
+	storeNotification, err := n.notificationStore.GetNotificationById(notification.Id)
+	if err != nil && !errors.Is(err, anystore.ErrDocNotFound) {
+		return err
+	}
+	if storeNotification != nil {
+		return nil
+	}
 	if !notification.IsLocal {
-		n.mu.Lock()
-		defer n.mu.Unlock()
-		storeNotification, err := n.notificationStore.GetNotificationById(notification.Id)
-		if err != nil && !errors.Is(err, anystore.ErrDocNotFound) {
-			return err
-		}
-		if storeNotification != nil {
-			return nil
-		}
 		var exist bool
 		err = cache.DoState(n.picker, n.notificationId, func(s *state.State, sb smartblock.SmartBlock) error {
 			stateNotification := s.GetNotificationById(notification.Id)
@@ -165,7 +190,7 @@ func (n *notificationService) CreateAndSend(notification *model.Notification) er
 			Notification: notification,
 		},
 	}))
-	err := n.notificationStore.SaveNotification(notification)
+	err = n.notificationStore.SaveNotification(notification)
 	if err != nil {
 		return fmt.Errorf("failed to add notification %s to cache: %w", notification.Id, err)
 	}
@@ -173,6 +198,23 @@ func (n *notificationService) CreateAndSend(notification *model.Notification) er
 }
 
 func (n *notificationService) UpdateAndSend(notification *model.Notification) error {
+	n.keepLocalState(notification)
+	return n.updateAndSend(notification)
+}
+
+// keepLocalState copies the fields that are stored only on the current device from the stored notification
+func (n *notificationService) keepLocalState(notification *model.Notification) {
+	if notification.SnoozeUntil != 0 {
+		return
+	}
+	stored, err := n.notificationStore.GetNotificationById(notification.Id)
+	if err != nil {
+		return
+	}
+	notification.SnoozeUntil = stored.SnoozeUntil
+}
+
+func (n *notificationService) updateAndSend(notification *model.Notification) error {
 	n.eventSender.Broadcast(event.NewEventSingleMessage("", &pb.EventMessageValueOfNotificationUpdate{
 		NotificationUpdate: &pb.EventNotificationUpdate{
 			Notification: notification,
@@ -197,7 +239,8 @@ func (n *notificationService) Reply(notificationIds []string, notificationAction
 			return err
 		}
 		notification.Status = status
-		err = n.UpdateAndSend(notification)
+		notification.SnoozeUntil = 0
+		err = n.updateAndSend(notification)
 		if err != nil {
 			return fmt.Errorf("failed to update notification: %w", err)
 		}
@@ -216,6 +259,30 @@ func (n *notificationService) Reply(notificationIds []string, notificationAction
 }
 
 func (n *notificationService) List(limit int64, includeRead bool) ([]*model.Notification, error) {
+	notifications, err := n.listVisible(includeRead)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && int64(len(notifications)) > limit {
+		notifications = notifications[:limit]
+	}
+	return notifications, nil
+}
+
+func (n *notificationService) ListDigests(limit int64, includeRead bool, grouping model.NotificationDigestGrouping) ([]*model.NotificationDigest, error) {
+	notifications, err := n.listVisible(includeRead)
+	if err != nil {
+		return nil, err
+	}
+	digests := groupNotifications(notifications, grouping)
+	if limit >= 0 && int64(len(digests)) > limit {
+		digests = digests[:limit]
+	}
+	return digests, nil
+}
+
+// listVisible returns notifications that should be shown in the notification center right now
+func (n *notificationService) listVisible(includeRead bool) ([]*model.Notification, error) {
 	ticker := time.NewTicker(n.loadTimeout)
 	defer ticker.Stop()
 
@@ -228,26 +295,131 @@ func (n *notificationService) List(limit int64, includeRead bool) ([]*model.Noti
 		return nil, fmt.Errorf("failed to list notifications: %w", err)
 	}
 
-	var (
-		result   []*model.Notification
-		addCount int64
-	)
+	now := time.Now().Unix()
+	result := make([]*model.Notification, 0, len(notifications))
 	for _, notification := range notifications {
-		if addCount == limit {
-			break
-		}
 		if !includeRead && n.isNotificationRead(notification) {
 			continue
 		}
 		if notification.GetRequestToLeave() != nil {
 			continue
 		}
+		if notification.SnoozeUntil > now {
+			continue
+		}
 		result = append(result, notification)
-		addCount++
 	}
 	return result, nil
 }
 
+func (n *notificationService) Snooze(notificationIds []string, until time.Time) error {
+	var snoozeUntil int64
+	if !until.IsZero() {
+		if !until.After(time.Now()) {
+			return ErrInvalidSnoozeTime
+		}
+		snoozeUntil = until.Unix()
+	}
+	for _, id := range notificationIds {
+		notification, err := n.notificationStore.GetNotificationById(id)
+		if err != nil {
+			return fmt.Errorf("get notification %s: %w", id, err)
+		}
+		notification.SnoozeUntil = snoozeUntil
+		if err = n.updateAndSend(notification); err != nil {
+			return fmt.Errorf("failed to update notification: %w", err)
+		}
+	}
+	return nil
+}
+
+func (n *notificationService) GetExpiryPolicy() (*model.NotificationExpiryPolicy, error) {
+	return n.notificationStore.GetExpiryPolicy()
+}
+
+func (n *notificationService) SetExpiryPolicy(policy *model.NotificationExpiryPolicy) error {
+	if policy == nil || policy.ReadTtl < 0 || policy.UnreadTtl < 0 {
+		return ErrInvalidExpiryPolicy
+	}
+	if err := n.notificationStore.SaveExpiryPolicy(policy); err != nil {
+		return fmt.Errorf("save expiry policy: %w", err)
+	}
+	return n.expireNotifications(time.Now())
+}
+
+func (n *notificationService) runMaintenance(ctx context.Context) {
+	ticker := time.NewTicker(maintenanceInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			n.wakeSnoozed(now)
+			if err := n.expireNotifications(now); err != nil {
+				log.Errorf("failed to expire notifications: %v", err)
+			}
+		}
+	}
+}
+
+// wakeSnoozed sends notifications again when their snooze time comes
+func (n *notificationService) wakeSnoozed(now time.Time) {
+	notifications, err := n.notificationStore.ListNotifications()
+	if err != nil {
+		log.Errorf("failed to list notifications: %v", err)
+		return
+	}
+	for _, notification := range notifications {
+		if notification.SnoozeUntil == 0 || notification.SnoozeUntil > now.Unix() {
+			continue
+		}
+		notification.SnoozeUntil = 0
+		if err = n.notificationStore.SaveNotification(notification); err != nil {
+			log.Errorf("failed to save notification %s: %v", notification.Id, err)
+			continue
+		}
+		n.eventSender.Broadcast(event.NewEventSingleMessage("", &pb.EventMessageValueOfNotificationSend{
+			NotificationSend: &pb.EventNotificationSend{
+				Notification: notification,
+			},
+		}))
+	}
+}
+
+// expireNotifications removes notifications from the store according to the expiry policy.
+// Synced notifications stay in the notification object, but they are skipped on the next indexing
+func (n *notificationService) expireNotifications(now time.Time) error {
+	policy, err := n.notificationStore.GetExpiryPolicy()
+	if err != nil {
+		return fmt.Errorf("get expiry policy: %w", err)
+	}
+	notifications, err := n.notificationStore.ListNotifications()
+	if err != nil {
+		return fmt.Errorf("list notifications: %w", err)
+	}
+	for _, notification := range notifications {
+		if !isExpired(policy, notification, now) {
+			continue
+		}
+		if err = n.notificationStore.DeleteNotification(notification.Id); err != nil {
+			return fmt.Errorf("delete notification %s: %w", notification.Id, err)
+		}
+	}
+	return nil
+}
+
+func isExpired(policy *model.NotificationExpiryPolicy, notification *model.Notification, now time.Time) bool {
+	ttl := policy.UnreadTtl
+	if isRead(notification) {
+		ttl = policy.ReadTtl
+	}
+	if ttl == 0 || notification.CreateTime == 0 {
+		return false
+	}
+	return notification.CreateTime+ttl <= now.Unix()
+}
+
 func (n *notificationService) GetLastNotificationId(acl string) string {
 	n.RLock()
 	defer n.RUnlock()
@@ -259,6 +431,10 @@ func (n *notificationService) LoadFinish() chan struct{} {
 }
 
 func (n *notificationService) isNotificationRead(notification *model.Notification) bool {
+	return isRead(notification)
+}
+
+func isRead(notification *model.Notification) bool {
 	return notification.GetStatus() == model.Notification_Read || notification.GetStatus() == model.Notification_Replied
 }
 
@@ -282,6 +282,33 @@ func TestNotificationService_CreateAndSend(t *testing.T) {
 		// then
 		sender.AssertNotCalled(t, "Broadcast", testNotification)
 	})
+	t.Run("local notification exist in store - don't send it again", func(t *testing.T) {
+		// given
+		storeFixture := NewTestStore(t)
+		err := storeFixture.SaveNotification(&model.Notification{Id: "id", Status: model.Notification_Created, IsLocal: true})
+		assert.Nil(t, err)
+
+		testNotification := &model.Notification{
+			Id:      "id",
+			Status:  model.Notification_Created,
+			IsLocal: true,
+			Payload: &model.NotificationPayloadOfTest{Test: &model.NotificationTest{}},
+		}
+
+		sender := mock_event.NewMockSender(t)
+		notifications := notificationService{
+			eventSender:       sender,
+			notificationStore: storeFixture,
+			loadTimeout:       10 * time.Millisecond,
+		}
+
+		// when
+		err = notifications.CreateAndSend(testNotification)
+		assert.Nil(t, err)
+
+		// then
+		sender.AssertNotCalled(t, "Broadcast", mock.Anything)
+	})
 	t.Run("notification not exist in store, but exit in NotificationObject - don't send it again", func(t *testing.T) {
 		// given
 		storeFixture := NewTestStore(t)
@@ -367,6 +394,186 @@ func TestNotificationService_CreateAndSend(t *testing.T) {
 		sender.AssertCalled(t, "Broadcast", event)
 	})
 }
+
+func TestNotificationService_Snooze(t *testing.T) {
+	t.Run("snoozed notification is hidden until snooze time", func(t *testing.T) {
+		// given
+		storeFixture := NewTestStore(t)
+		err := storeFixture.SaveNotification(&model.Notification{Id: "id", Status: model.Notification_Created, IsLocal: true})
+		require.NoError(t, err)
+		err = storeFixture.SaveNotification(&model.Notification{Id: "id1", Status: model.Notification_Created, IsLocal: true})
+		require.NoError(t, err)
+
+		sender := mock_event.NewMockSender(t)
+		sender.EXPECT().Broadcast(mock.Anything).Return()
+		notifications := notificationService{
+			eventSender:       sender,
+			notificationStore: storeFixture,
+			loadTimeout:       10 * time.Millisecond,
+		}
+
+		// when
+		err = notifications.Snooze([]string{"id"}, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		list, err := notifications.List(10, false)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, "id1", list[0].Id)
+
+		// when
+		notifications.wakeSnoozed(time.Now().Add(2 * time.Hour))
+		list, err = notifications.List(10, false)
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, list, 2)
+		sender.AssertNumberOfCalls(t, "Broadcast", 2)
+	})
+	t.Run("snooze is kept when notification is updated", func(t *testing.T) {
+		// given
+		storeFixture := NewTestStore(t)
+		err := storeFixture.SaveNotification(&model.Notification{Id: "id", Status: model.Notification_Created})
+		require.NoError(t, err)
+
+		sender := mock_event.NewMockSender(t)
+		sender.EXPECT().Broadcast(mock.Anything).Return()
+		notifications := notificationService{
+			eventSender:       sender,
+			notificationStore: storeFixture,
+			loadTimeout:       10 * time.Millisecond,
+		}
+		until := time.Now().Add(time.Hour)
+		require.NoError(t, notifications.Snooze([]string{"id"}, until))
+
+		// when
+		err = notifications.UpdateAndSend(&model.Notification{Id: "id", Status: model.Notification_Shown})
+		require.NoError(t, err)
+
+		// then
+		notification, err := storeFixture.GetNotificationById("id")
+		require.NoError(t, err)
+		assert.Equal(t, until.Unix(), notification.SnoozeUntil)
+	})
+	t.Run("snooze time in the past", func(t *testing.T) {
+		notifications := notificationService{notificationStore: NewTestStore(t)}
+
+		err := notifications.Snooze([]string{"id"}, time.Now().Add(-time.Hour))
+
+		assert.ErrorIs(t, err, ErrInvalidSnoozeTime)
+	})
+}
+
+func TestNotificationService_ListDigests(t *testing.T) {
+	reminder := func(id, spaceId, objectId string, createTime int64, status model.NotificationStatus) *model.Notification {
+		return &model.Notification{
+			Id:         id,
+			CreateTime: createTime,
+			Status:     status,
+			Payload: &model.NotificationPayloadOfReminder{Reminder: &model.NotificationReminder{
+				SpaceId:  spaceId,
+				ObjectId: objectId,
+			}},
+		}
+	}
+	storeFixture := NewTestStore(t)
+	for _, notification := range []*model.Notification{
+		reminder("r1", "space1", "object1", 1, model.Notification_Created),
+		reminder("r2", "space1", "object1", 3, model.Notification_Read),
+		reminder("r3", "space1", "object2", 2, model.Notification_Created),
+		reminder("r4", "space2", "object3", 4, model.Notification_Created),
+		{Id: "test", CreateTime: 5, Payload: &model.NotificationPayloadOfTest{Test: &model.NotificationTest{}}},
+	} {
+		require.NoError(t, storeFixture.SaveNotification(notification))
+	}
+	notifications := notificationService{
+		notificationStore: storeFixture,
+		loadTimeout:       10 * time.Millisecond,
+	}
+
+	t.Run("group by object", func(t *testing.T) {
+		// when
+		digests, err := notifications.ListDigests(10, true, model.NotificationDigest_Object)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, digests, 4)
+		assert.Equal(t, "test", digests[0].Id)
+		assert.Equal(t, "object3", digests[1].Id)
+		assert.Equal(t, "object1", digests[2].Id)
+		assert.Equal(t, int64(2), digests[2].Count)
+		assert.Equal(t, int64(1), digests[2].UnreadCount)
+		assert.Equal(t, "r2", digests[2].Latest.Id)
+		assert.Equal(t, []string{"r2", "r1"}, digests[2].NotificationIds)
+		assert.Equal(t, "object2", digests[3].Id)
+	})
+	t.Run("group unread by space with limit", func(t *testing.T) {
+		// when
+		digests, err := notifications.ListDigests(2, false, model.NotificationDigest_Space)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, digests, 2)
+		assert.Equal(t, "test", digests[0].Id)
+		assert.Equal(t, "space2", digests[1].Id)
+		assert.Empty(t, digests[1].ObjectId)
+	})
+}
+
+func TestNotificationService_Expiry(t *testing.T) {
+	now := time.Now()
+	hour := int64(time.Hour.Seconds())
+
+	t.Run("default policy", func(t *testing.T) {
+		// given
+		storeFixture := NewTestStore(t)
+		old := now.Add(-40 * 24 * time.Hour).Unix()
+		require.NoError(t, storeFixture.SaveNotification(&model.Notification{Id: "oldRead", CreateTime: old, Status: model.Notification_Read}))
+		require.NoError(t, storeFixture.SaveNotification(&model.Notification{Id: "oldUnread", CreateTime: old, Status: model.Notification_Created}))
+		require.NoError(t, storeFixture.SaveNotification(&model.Notification{Id: "newRead", CreateTime: now.Unix(), Status: model.Notification_Read}))
+		notifications := notificationService{notificationStore: storeFixture}
+
+		// when
+		err := notifications.expireNotifications(now)
+
+		// then
+		require.NoError(t, err)
+		list, err := storeFixture.ListNotifications()
+		require.NoError(t, err)
+		var ids []string
+		for _, notification := range list {
+			ids = append(ids, notification.Id)
+		}
+		assert.ElementsMatch(t, []string{"oldUnread", "newRead"}, ids)
+	})
+	t.Run("set policy", func(t *testing.T) {
+		// given
+		storeFixture := NewTestStore(t)
+		require.NoError(t, storeFixture.SaveNotification(&model.Notification{Id: "unread", CreateTime: now.Unix() - 2*hour}))
+		notifications := notificationService{notificationStore: storeFixture}
+
+		// when
+		err := notifications.SetExpiryPolicy(&model.NotificationExpiryPolicy{UnreadTtl: hour})
+
+		// then
+		require.NoError(t, err)
+		policy, err := notifications.GetExpiryPolicy()
+		require.NoError(t, err)
+		assert.Equal(t, &model.NotificationExpiryPolicy{UnreadTtl: hour}, policy)
+		list, err := storeFixture.ListNotifications()
+		require.NoError(t, err)
+		assert.Empty(t, list)
+	})
+	t.Run("invalid policy", func(t *testing.T) {
+		notifications := notificationService{notificationStore: NewTestStore(t)}
+
+		err := notifications.SetExpiryPolicy(&model.NotificationExpiryPolicy{ReadTtl: -1})
+
+		assert.ErrorIs(t, err, ErrInvalidExpiryPolicy)
+	})
+}
+
 func NewTestStore(t *testing.T) NotificationStore {
 	db, err := anystore.Open(context.Background(), filepath.Join(t.TempDir(), "test.db"), nil)
 	require.NoError(t, err)
@@ -214,6 +214,8 @@ func (mw *Middleware) ObjectSearchSubscribe(cctx context.Context, req *pb.RpcObj
 		Source:            req.Source,
 		NoDepSubscription: req.NoDepSubscription,
 		CollectionId:      req.CollectionId,
+		Formulas:          subscription.FormulasFromProto(req.Formulas),
+		Timeline:          subscription.TimelineFromProto(req.Timeline),
 	})
 	if err != nil {
 		return errResponse(err)
@@ -224,6 +226,7 @@ func (mw *Middleware) ObjectSearchSubscribe(cctx context.Context, req *pb.RpcObj
 		Records:      domain.DetailsListToProtos(resp.Records),
 		Dependencies: domain.DetailsListToProtos(resp.Dependencies),
 		Counters:     resp.Counters,
+		Formulas:     resp.Formulas,
 	}
 }
 
@@ -295,6 +298,9 @@ func (mw *Middleware) ObjectGroupsSubscribe(_ context.Context, req *pb.RpcObject
 		Filters:      database.FiltersFromProto(req.Filters),
 		Source:       req.Source,
 		CollectionId: req.CollectionId,
+		Timeline:     subscription.TimelineFromProto(req.Timeline),
+		DateBucket:   req.DateBucket,
+		NumberRanges: req.NumberRanges,
 	})
 	if err != nil {
 		return errResponse(err)
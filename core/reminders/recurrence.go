@@ -0,0 +1,143 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/rrule"
+	timeutil "github.com/anyproto/anytype-heart/util/time"
+)
+
+// processRecurringObjects creates next instances of done objects with recurrence rule.
+// Rule is moved to the new instance. Id of the instance is derived from the source object and the occurrence date,
+// so devices processing the same object create the same instance
+func (s *service) processRecurringObjects() error {
+	records, err := s.objectStore.QueryCrossSpace(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyDone,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Bool(true),
+			},
+			{
+				RelationKey: bundle.RelationKeyRecurrenceRule,
+				Condition:   model.BlockContentDataviewFilter_NotEmpty,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("query recurring objects: %w", err)
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	relations, err := s.listReminderRelations()
+	if err != nil {
+		return fmt.Errorf("list reminder relations: %w", err)
+	}
+	for _, rec := range records {
+		id := rec.Details.GetString(bundle.RelationKeyId)
+		ruleStr := rec.Details.GetString(bundle.RelationKeyRecurrenceRule)
+		rule, err := rrule.Parse(ruleStr)
+		if err != nil {
+			if s.invalidRules[id] != ruleStr {
+				s.invalidRules[id] = ruleStr
+				log.Warn("invalid recurrence rule", zap.String("objectId", id), zap.Error(err))
+			}
+			continue
+		}
+		spaceId := rec.Details.GetString(bundle.RelationKeySpaceId)
+		dateKeys := []domain.RelationKey{bundle.RelationKeyDueDate}
+		for _, rel := range relations {
+			if rel.spaceId == spaceId && rel.key != bundle.RelationKeyDueDate {
+				dateKeys = append(dateKeys, rel.key)
+			}
+		}
+		if err = s.createNextInstance(spaceId, id, rec.Details, rule, dateKeys); err != nil {
+			log.Error("create next instance of recurring object", zap.String("objectId", id), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (s *service) createNextInstance(spaceId, id string, details *domain.Details, rule rrule.Rule, dateKeys []domain.RelationKey) error {
+	anchor := recurrenceAnchor(details)
+	next, ok := rule.Next(anchor.In(timeutil.AccountLocation(s.locationProvider)))
+	if ok {
+		uniqueKey, err := domain.NewUniqueKey(coresb.SmartBlockTypePage, recurrenceInstanceKey(id, next))
+		if err != nil {
+			return fmt.Errorf("create unique key: %w", err)
+		}
+		var (
+			newState *state.State
+			typeKeys []domain.TypeKey
+		)
+		err = cache.Do(s.objectGetter, id, func(sb smartblock.SmartBlock) error {
+			src := sb.NewState()
+			blocks := map[string]simple.Block{}
+			src.Iterate(func(b simple.Block) (isContinue bool) {
+				blocks[b.Model().Id] = b.Copy()
+				return true
+			})
+			newState = state.NewDocWithUniqueKey(src.RootId(), blocks, uniqueKey).(*state.State)
+			newState.AddRelationLinks(src.GetRelationLinks()...)
+			newState.SetDetails(src.Details().Copy())
+			typeKeys = src.ObjectTypeKeys()
+			newState.SetObjectTypeKeys(typeKeys)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("get object: %w", err)
+		}
+		delta := next.Sub(anchor)
+		for _, key := range dateKeys {
+			if value := newState.Details().GetInt64(key); value != 0 {
+				newState.SetDetail(key, domain.Int64(time.Unix(value, 0).Add(delta).Unix()))
+			}
+		}
+		if newState.Details().GetInt64(bundle.RelationKeyDueDate) == 0 {
+			newState.SetDetail(bundle.RelationKeyDueDate, domain.Int64(next.Unix()))
+		}
+		newState.SetDetail(bundle.RelationKeyDone, domain.Bool(false))
+		newState.SetDetail(bundle.RelationKeyRecurrenceRule, domain.String(rule.Advance().String()))
+		_, _, err = s.objectCreator.CreateSmartBlockFromState(context.Background(), spaceId, typeKeys, newState)
+		if err != nil && !errors.Is(err, treestorage.ErrTreeExists) {
+			return fmt.Errorf("create object: %w", err)
+		}
+	}
+	return cache.DoState(s.objectGetter, id, func(st *state.State, sb smartblock.SmartBlock) error {
+		st.SetDetail(bundle.RelationKeyRecurrenceRule, domain.String(""))
+		return nil
+	})
+}
+
+// recurrenceAnchor returns the time the next occurrence is counted from: the due date or, for objects without it,
+// the time of the last change, e.g. when the object is marked as done. Only synced details are used, so all devices
+// derive the same occurrence
+func recurrenceAnchor(details *domain.Details) time.Time {
+	for _, key := range []domain.RelationKey{bundle.RelationKeyDueDate, bundle.RelationKeyLastModifiedDate, bundle.RelationKeyCreatedDate} {
+		if value := details.GetInt64(key); value != 0 {
+			return time.Unix(value, 0)
+		}
+	}
+	return time.Now()
+}
+
+// recurrenceInstanceKey returns the internal unique key of the instance of the recurring object for the occurrence
+func recurrenceInstanceKey(sourceId string, occurrence time.Time) string {
+	return fmt.Sprintf("recurrence_%s_%d", sourceId, occurrence.Unix())
+}
@@ -0,0 +1,263 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	anystore "github.com/anyproto/any-store"
+	"github.com/anyproto/any-sync/app"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/notifications"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore/anystoreprovider"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/keyvaluestore"
+	timeutil "github.com/anyproto/anytype-heart/util/time"
+)
+
+const CName = "core.reminders"
+
+var log = logging.Logger(CName).Desugar()
+
+const (
+	checkInterval = time.Minute
+	// maxMissedPeriod limits reminders fired after the app was offline
+	maxMissedPeriod = 7 * 24 * time.Hour
+	// dateOnlyReminderHour is the local hour when reminders of relations without time are fired
+	dateOnlyReminderHour = 9
+)
+
+// Service fires notifications for dates of relations flagged with relationIsReminder
+// and creates next instances of recurring objects when they are done
+type Service interface {
+	app.ComponentRunnable
+}
+
+type objectCreator interface {
+	CreateSmartBlockFromState(ctx context.Context, spaceID string, objectTypeKeys []domain.TypeKey, createState *state.State) (id string, newDetails *domain.Details, err error)
+}
+
+type service struct {
+	objectStore         objectstore.ObjectStore
+	notificationService notifications.Notifications
+	objectGetter        cache.ObjectGetter
+	objectCreator       objectCreator
+	lastCheckStore      keyvaluestore.Store[int64]
+	locationProvider    timeutil.LocationProvider
+
+	componentCtx       context.Context
+	componentCtxCancel context.CancelFunc
+
+	lock sync.Mutex
+	// invalidRules contains recurrence rules that failed to parse per object id, so errors are not logged on every check
+	invalidRules map[string]string
+}
+
+func New() Service {
+	return &service{
+		invalidRules: map[string]string{},
+	}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.notificationService = app.MustComponent[notifications.Notifications](a)
+	s.objectGetter = app.MustComponent[cache.ObjectGetter](a)
+	s.objectCreator = app.MustComponent[objectCreator](a)
+	s.locationProvider, _ = app.GetComponent[timeutil.LocationProvider](a)
+	provider := app.MustComponent[anystoreprovider.Provider](a)
+	s.lastCheckStore = keyvaluestore.NewJsonFromCollection[int64](provider.GetSystemCollection())
+	s.componentCtx, s.componentCtxCancel = context.WithCancel(context.Background())
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(_ context.Context) error {
+	go s.runChecks()
+	return nil
+}
+
+func (s *service) Close(_ context.Context) error {
+	if s.componentCtxCancel != nil {
+		s.componentCtxCancel()
+	}
+	return nil
+}
+
+func (s *service) runChecks() {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		s.check(time.Now())
+		select {
+		case <-s.componentCtx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *service) check(now time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	lastCheck := s.getLastCheck(now)
+	if err := s.fireReminders(lastCheck, now); err != nil {
+		log.Error("fire reminders", zap.Error(err))
+		// keep last check time to retry missed reminders next time
+		return
+	}
+	if err := s.processRecurringObjects(); err != nil {
+		log.Error("process recurring objects", zap.Error(err))
+	}
+	if err := s.lastCheckStore.Set(s.componentCtx, anystoreprovider.SystemKeys.RemindersLastCheck(), now.Unix()); err != nil {
+		log.Error("save last check time", zap.Error(err))
+	}
+}
+
+// getLastCheck returns the time of the previous check, so reminders missed while the app was offline are fired
+func (s *service) getLastCheck(now time.Time) time.Time {
+	lastCheck, err := s.lastCheckStore.Get(s.componentCtx, anystoreprovider.SystemKeys.RemindersLastCheck())
+	if errors.Is(err, anystore.ErrDocNotFound) {
+		return now
+	}
+	if err != nil {
+		log.Error("get last check time", zap.Error(err))
+		return now
+	}
+	res := time.Unix(lastCheck, 0)
+	if now.Sub(res) > maxMissedPeriod {
+		return now.Add(-maxMissedPeriod)
+	}
+	return res
+}
+
+type reminderRelation struct {
+	spaceId     string
+	key         domain.RelationKey
+	name        string
+	includeTime bool
+}
+
+func (s *service) listReminderRelations() ([]reminderRelation, error) {
+	records, err := s.objectStore.QueryCrossSpace(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyResolvedLayout,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(model.ObjectType_relation),
+			},
+			{
+				RelationKey: bundle.RelationKeyRelationIsReminder,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Bool(true),
+			},
+			{
+				RelationKey: bundle.RelationKeyRelationFormat,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(model.RelationFormat_date),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	relations := make([]reminderRelation, 0, len(records))
+	for _, rec := range records {
+		relations = append(relations, reminderRelation{
+			spaceId:     rec.Details.GetString(bundle.RelationKeySpaceId),
+			key:         domain.RelationKey(rec.Details.GetString(bundle.RelationKeyRelationKey)),
+			name:        rec.Details.GetString(bundle.RelationKeyName),
+			includeTime: rec.Details.GetBool(bundle.RelationKeyRelationFormatIncludeTime),
+		})
+	}
+	return relations, nil
+}
+
+// fireReminders sends notifications for reminders with time in (from, to]
+func (s *service) fireReminders(from, to time.Time) error {
+	if !to.After(from) {
+		return nil
+	}
+	relations, err := s.listReminderRelations()
+	if err != nil {
+		return fmt.Errorf("list reminder relations: %w", err)
+	}
+	for _, rel := range relations {
+		queryFrom := from
+		if !rel.includeTime {
+			// values of relations without time point to the beginning of the day
+			queryFrom = from.Add(-48 * time.Hour)
+		}
+		records, err := s.objectStore.SpaceIndex(rel.spaceId).Query(database.Query{
+			Filters: []database.FilterRequest{
+				{
+					RelationKey: rel.key,
+					Condition:   model.BlockContentDataviewFilter_Greater,
+					Value:       domain.Int64(queryFrom.Unix()),
+				},
+				{
+					RelationKey: rel.key,
+					Condition:   model.BlockContentDataviewFilter_LessOrEqual,
+					Value:       domain.Int64(to.Unix()),
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("query objects with reminders: %w", err)
+		}
+		for _, rec := range records {
+			if rec.Details.GetBool(bundle.RelationKeyDone) {
+				continue
+			}
+			fireAt := reminderTime(rec.Details.GetInt64(rel.key), rel.includeTime, timeutil.AccountLocation(s.locationProvider))
+			if !fireAt.After(from) || fireAt.After(to) {
+				continue
+			}
+			if err = s.notificationService.CreateAndSend(s.newReminderNotification(rel, rec.Details, fireAt)); err != nil {
+				log.Error("send reminder notification", zap.Error(err))
+			}
+		}
+	}
+	return nil
+}
+
+// reminderTime returns the time when the reminder for the date value should be fired
+func reminderTime(value int64, includeTime bool, loc *time.Location) time.Time {
+	date := time.Unix(value, 0).In(loc)
+	if includeTime {
+		return date
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), dateOnlyReminderHour, 0, 0, 0, loc)
+}
+
+func (s *service) newReminderNotification(rel reminderRelation, details *domain.Details, fireAt time.Time) *model.Notification {
+	objectId := details.GetString(bundle.RelationKeyId)
+	return &model.Notification{
+		// id is stable, so the same reminder is not sent twice
+		Id:    fmt.Sprintf("reminder_%s_%s_%d", objectId, rel.key, fireAt.Unix()),
+		Space: rel.spaceId,
+		Payload: &model.NotificationPayloadOfReminder{Reminder: &model.NotificationReminder{
+			SpaceId:      rel.spaceId,
+			ObjectId:     objectId,
+			ObjectName:   details.GetString(bundle.RelationKeyName),
+			RelationKey:  rel.key.String(),
+			RelationName: rel.name,
+			Date:         fireAt.Unix(),
+			SpaceName:    s.objectStore.GetSpaceName(rel.spaceId),
+		}},
+	}
+}
@@ -0,0 +1,260 @@
+package reminders
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/notifications/mock_notifications"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	spaceId     = "space1"
+	deadlineKey = domain.RelationKey("deadline")
+)
+
+type testObjectGetter struct {
+	sb smartblock.SmartBlock
+}
+
+func (g *testObjectGetter) GetObject(_ context.Context, _ string) (smartblock.SmartBlock, error) {
+	return g.sb, nil
+}
+
+func (g *testObjectGetter) GetObjectByFullID(_ context.Context, _ domain.FullID) (smartblock.SmartBlock, error) {
+	return g.sb, nil
+}
+
+type testObjectCreator struct {
+	created []*state.State
+	err     error
+}
+
+func (c *testObjectCreator) CreateSmartBlockFromState(_ context.Context, _ string, _ []domain.TypeKey, createState *state.State) (string, *domain.Details, error) {
+	c.created = append(c.created, createState)
+	if c.err != nil {
+		return "", nil, c.err
+	}
+	return "newObject", createState.CombinedDetails(), nil
+}
+
+type fixture struct {
+	*service
+	store         *objectstore.StoreFixture
+	notifications *mock_notifications.MockNotifications
+	creator       *testObjectCreator
+}
+
+func newFixture(t *testing.T) *fixture {
+	store := objectstore.NewStoreFixture(t)
+	notificationService := mock_notifications.NewMockNotifications(t)
+	creator := &testObjectCreator{}
+	fx := &fixture{
+		service: &service{
+			objectStore:         store,
+			notificationService: notificationService,
+			objectGetter:        &testObjectGetter{},
+			objectCreator:       creator,
+			invalidRules:        map[string]string{},
+		},
+		store:         store,
+		notifications: notificationService,
+		creator:       creator,
+	}
+	fx.store.AddObjects(t, spaceId, []objectstore.TestObject{{
+		bundle.RelationKeyId:                        domain.String("rel-deadline"),
+		bundle.RelationKeySpaceId:                   domain.String(spaceId),
+		bundle.RelationKeyResolvedLayout:            domain.Int64(model.ObjectType_relation),
+		bundle.RelationKeyRelationKey:               domain.String(deadlineKey.String()),
+		bundle.RelationKeyRelationFormat:            domain.Int64(model.RelationFormat_date),
+		bundle.RelationKeyRelationFormatIncludeTime: domain.Bool(true),
+		bundle.RelationKeyRelationIsReminder:        domain.Bool(true),
+		bundle.RelationKeyName:                      domain.String("Deadline"),
+	}})
+	return fx
+}
+
+func TestService_FireReminders(t *testing.T) {
+	now := time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+
+	t.Run("reminders in checked period are fired, including missed ones", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.store.AddObjects(t, spaceId, []objectstore.TestObject{
+			{
+				bundle.RelationKeyId:   domain.String("missed"),
+				bundle.RelationKeyName: domain.String("Missed task"),
+				deadlineKey:            domain.Int64(now.Add(-2 * time.Hour).Unix()),
+			},
+			{
+				bundle.RelationKeyId:   domain.String("done"),
+				bundle.RelationKeyDone: domain.Bool(true),
+				deadlineKey:            domain.Int64(now.Add(-time.Hour).Unix()),
+			},
+			{
+				bundle.RelationKeyId: domain.String("future"),
+				deadlineKey:          domain.Int64(now.Add(time.Hour).Unix()),
+			},
+			{
+				bundle.RelationKeyId: domain.String("old"),
+				deadlineKey:          domain.Int64(now.Add(-5 * time.Hour).Unix()),
+			},
+		})
+		var sent []*model.Notification
+		fx.notifications.EXPECT().CreateAndSend(mock.Anything).RunAndReturn(func(n *model.Notification) error {
+			sent = append(sent, n)
+			return nil
+		})
+
+		// when
+		err := fx.fireReminders(now.Add(-3*time.Hour), now)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, sent, 1)
+		reminder := sent[0].GetReminder()
+		require.NotNil(t, reminder)
+		assert.Equal(t, "missed", reminder.ObjectId)
+		assert.Equal(t, "Missed task", reminder.ObjectName)
+		assert.Equal(t, "Deadline", reminder.RelationName)
+		assert.Equal(t, now.Add(-2*time.Hour).Unix(), reminder.Date)
+		assert.Equal(t, spaceId, sent[0].Space)
+	})
+
+	t.Run("empty period", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+
+		// when
+		err := fx.fireReminders(now, now)
+
+		// then
+		require.NoError(t, err)
+	})
+}
+
+func TestReminderTime(t *testing.T) {
+	value := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC).Unix()
+
+	withTime := reminderTime(value, true, time.UTC)
+	assert.Equal(t, value, withTime.Unix())
+
+	dateOnly := reminderTime(value, false, time.UTC)
+	assert.Equal(t, dateOnlyReminderHour, dateOnly.Hour())
+	assert.Equal(t, 0, dateOnly.Minute())
+}
+
+func TestService_ProcessRecurringObjects(t *testing.T) {
+	dueDate := time.Date(2024, time.March, 5, 10, 0, 0, 0, time.UTC)
+
+	givenRecurringTask := func(t *testing.T, fx *fixture) *smarttest.SmartTest {
+		details := objectstore.TestObject{
+			bundle.RelationKeyId:             domain.String("task"),
+			bundle.RelationKeySpaceId:        domain.String(spaceId),
+			bundle.RelationKeyName:           domain.String("Weekly report"),
+			bundle.RelationKeyDone:           domain.Bool(true),
+			bundle.RelationKeyDueDate:        domain.Int64(dueDate.Unix()),
+			deadlineKey:                      domain.Int64(dueDate.Add(-time.Hour).Unix()),
+			bundle.RelationKeyRecurrenceRule: domain.String("FREQ=WEEKLY;COUNT=3"),
+		}
+		fx.store.AddObjects(t, spaceId, []objectstore.TestObject{details})
+		sb := smarttest.New("task")
+		st := sb.NewState()
+		for key, value := range details {
+			st.SetDetail(key, value)
+		}
+		require.NoError(t, sb.Apply(st))
+		fx.objectGetter = &testObjectGetter{sb: sb}
+		return sb
+	}
+
+	t.Run("next instance is created and rule is moved to it", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		sb := givenRecurringTask(t, fx)
+
+		// when
+		err := fx.processRecurringObjects()
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, fx.creator.created, 1)
+		created := fx.creator.created[0].Details()
+		nextDueDate := dueDate.AddDate(0, 0, 7)
+		assert.False(t, created.GetBool(bundle.RelationKeyDone))
+		assert.Equal(t, nextDueDate.Unix(), created.GetInt64(bundle.RelationKeyDueDate))
+		assert.Equal(t, nextDueDate.Add(-time.Hour).Unix(), created.GetInt64(deadlineKey))
+		assert.Equal(t, "FREQ=WEEKLY;COUNT=2", created.GetString(bundle.RelationKeyRecurrenceRule))
+		assert.Equal(t, recurrenceInstanceKey("task", nextDueDate), fx.creator.created[0].UniqueKeyInternal())
+		assert.Empty(t, sb.Details().GetString(bundle.RelationKeyRecurrenceRule))
+	})
+
+	t.Run("instance created by another device is not duplicated", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		sb := givenRecurringTask(t, fx)
+		fx.creator.err = fmt.Errorf("derive tree: %w", treestorage.ErrTreeExists)
+
+		// when
+		err := fx.processRecurringObjects()
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, fx.creator.created, 1)
+		assert.Equal(t, recurrenceInstanceKey("task", dueDate.AddDate(0, 0, 7)), fx.creator.created[0].UniqueKeyInternal())
+		assert.Empty(t, sb.Details().GetString(bundle.RelationKeyRecurrenceRule))
+	})
+
+	t.Run("object without due date is anchored on the last change", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		modifiedDate := time.Date(2024, time.March, 6, 18, 30, 0, 0, time.UTC)
+		fx.store.AddObjects(t, spaceId, []objectstore.TestObject{{
+			bundle.RelationKeyId:               domain.String("task"),
+			bundle.RelationKeySpaceId:          domain.String(spaceId),
+			bundle.RelationKeyDone:             domain.Bool(true),
+			bundle.RelationKeyLastModifiedDate: domain.Int64(modifiedDate.Unix()),
+			bundle.RelationKeyRecurrenceRule:   domain.String("FREQ=DAILY"),
+		}})
+		fx.objectGetter = &testObjectGetter{sb: smarttest.New("task")}
+
+		// when
+		err := fx.processRecurringObjects()
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, fx.creator.created, 1)
+		assert.Equal(t, recurrenceInstanceKey("task", modifiedDate.AddDate(0, 0, 1)), fx.creator.created[0].UniqueKeyInternal())
+	})
+
+	t.Run("invalid rule is skipped", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.store.AddObjects(t, spaceId, []objectstore.TestObject{{
+			bundle.RelationKeyId:             domain.String("task"),
+			bundle.RelationKeyDone:           domain.Bool(true),
+			bundle.RelationKeyRecurrenceRule: domain.String("FREQ=SOMETIMES"),
+		}})
+
+		// when
+		err := fx.processRecurringObjects()
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, fx.creator.created)
+		assert.Equal(t, "FREQ=SOMETIMES", fx.invalidRules["task"])
+	})
+}
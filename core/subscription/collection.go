@@ -189,7 +189,7 @@ func (c *collectionSub) close() {
 	c.sortedSub.close()
 }
 
-func (s *spaceSubscriptions) newCollectionSub(id string, spaceId string, collectionID string, keys []domain.RelationKey, filterDepIds []string, flt database.Filter, order database.Order, limit, offset int, disableDepSub bool) (*collectionSub, error) {
+func (s *spaceSubscriptions) newCollectionSub(id string, spaceId string, collectionID string, keys []domain.RelationKey, filterDepIds []string, flt database.Filter, order database.Order, limit, offset int, disableDepSub bool, formulas []FormulaRequest) (*collectionSub, error) {
 	obs, err := s.newCollectionObserver(spaceId, collectionID, id)
 	if err != nil {
 		return nil, err
@@ -202,6 +202,7 @@ func (s *spaceSubscriptions) newCollectionSub(id string, spaceId string, collect
 
 	ssub := s.newSortedSub(id, spaceId, keys, flt, order, limit, offset)
 	ssub.disableDep = disableDepSub
+	ssub.formulas = newFormulaCalculator(formulas)
 	if !ssub.disableDep {
 		ssub.forceSubIds = filterDepIds
 	}
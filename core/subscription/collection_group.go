@@ -2,6 +2,7 @@ package subscription
 
 import (
 	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/kanban"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
@@ -12,9 +13,9 @@ type collectionGroupSub struct {
 	colObserver *collectionObserver
 }
 
-func (s *spaceSubscriptions) newCollectionGroupSub(id string, relKey domain.RelationKey, f *database.Filters, groups []*model.BlockContentDataviewGroup, colObserver *collectionObserver) *collectionGroupSub {
+func (s *spaceSubscriptions) newCollectionGroupSub(id string, relKey domain.RelationKey, f *database.Filters, groups []*model.BlockContentDataviewGroup, grouper kanban.RecordsGrouper, colObserver *collectionObserver) *collectionGroupSub {
 	sub := &collectionGroupSub{
-		groupSub:    s.newGroupSub(id, relKey, f, groups),
+		groupSub:    s.newGroupSub(id, relKey, f, groups, grouper),
 		colObserver: colObserver,
 	}
 	return sub
@@ -40,6 +40,11 @@ type opCounter struct {
 	nextCount int
 }
 
+type opFormulas struct {
+	subId    string
+	formulas []*pb.EventObjectSubscriptionFormulasFormula
+}
+
 type opGroup struct {
 	subId  string
 	group  *model.BlockContentDataviewGroup
@@ -55,6 +60,7 @@ type opCtx struct {
 	change   []opChange
 	position []opPosition
 	counters []opCounter
+	formulas []opFormulas
 	entries  []*entry
 	groups   []opGroup
 
@@ -135,6 +141,17 @@ func (ctx *opCtx) apply() {
 		))
 	}
 
+	// formulas
+	for _, f := range ctx.formulas {
+		addEvent(f.subId, event.NewMessage(ctx.spaceId, &pb.EventMessageValueOfSubscriptionFormulas{
+			SubscriptionFormulas: &pb.EventObjectSubscriptionFormulas{
+				SubId:    f.subId,
+				Formulas: f.formulas,
+			},
+		},
+		))
+	}
+
 	// apply to cache
 	for _, e := range ctx.entries {
 		if len(e.SubIds()) > 0 {
@@ -365,6 +382,7 @@ func (ctx *opCtx) reset() {
 	ctx.change = ctx.change[:0]
 	ctx.position = ctx.position[:0]
 	ctx.counters = ctx.counters[:0]
+	ctx.formulas = ctx.formulas[:0]
 	ctx.keysBuf = ctx.keysBuf[:0]
 	ctx.entries = ctx.entries[:0]
 	ctx.groups = ctx.groups[:0]
@@ -384,6 +402,7 @@ type EventMatcher struct {
 	OnAmend    func(spaceId string, msg *pb.EventObjectDetailsAmend)
 	OnCounters func(spaceId string, msg *pb.EventObjectSubscriptionCounters)
 	OnGroups   func(spaceId string, msg *pb.EventObjectSubscriptionGroups)
+	OnFormulas func(spaceId string, msg *pb.EventObjectSubscriptionFormulas)
 }
 
 func (m EventMatcher) Match(msg *pb.EventMessage) {
@@ -423,5 +442,9 @@ func (m EventMatcher) Match(msg *pb.EventMessage) {
 		if m.OnGroups != nil {
 			m.OnGroups(msg.SpaceId, v.SubscriptionGroups)
 		}
+	case *pb.EventMessageValueOfSubscriptionFormulas:
+		if m.OnFormulas != nil {
+			m.OnFormulas(msg.SpaceId, v.SubscriptionFormulas)
+		}
 	}
 }
@@ -0,0 +1,219 @@
+package subscription
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+type FormulaRequest struct {
+	RelationKey domain.RelationKey
+	Formula     model.BlockContentDataviewRelationFormulaType
+}
+
+// FormulasFromProto picks formulas from the view relations, relations without formula are skipped
+func FormulasFromProto(relations []*model.BlockContentDataviewRelation) []FormulaRequest {
+	var formulas []FormulaRequest
+	for _, rel := range relations {
+		if rel.Formula == model.BlockContentDataviewRelation_None || rel.Key == "" {
+			continue
+		}
+		formulas = append(formulas, FormulaRequest{
+			RelationKey: domain.RelationKey(rel.Key),
+			Formula:     rel.Formula,
+		})
+	}
+	return formulas
+}
+
+// columnAggregate keeps the state needed to calculate any formula of the column,
+// so records could be added and removed without passing over the whole set
+type columnAggregate struct {
+	empty      int
+	valueCount int
+	distinct   map[string]int
+	sum        float64
+	// numbers are kept sorted for median, min, max and range
+	numbers []float64
+}
+
+func (c *columnAggregate) update(value domain.Value, delta int) {
+	values := formulaValues(value)
+	if len(values) == 0 {
+		c.empty += delta
+		return
+	}
+	c.valueCount += delta * len(values)
+	for _, v := range values {
+		key := distinctKey(v)
+		c.distinct[key] += delta
+		if c.distinct[key] <= 0 {
+			delete(c.distinct, key)
+		}
+	}
+	number, ok := value.TryFloat64()
+	if !ok {
+		return
+	}
+	pos, _ := slices.BinarySearch(c.numbers, number)
+	if delta > 0 {
+		c.numbers = slices.Insert(c.numbers, pos, number)
+		c.sum += number
+	} else if pos < len(c.numbers) && c.numbers[pos] == number {
+		c.numbers = slices.Delete(c.numbers, pos, pos+1)
+		c.sum -= number
+	}
+	if len(c.numbers) == 0 {
+		// drop accumulated floating point error
+		c.sum = 0
+	}
+}
+
+func (c *columnAggregate) calculate(formula model.BlockContentDataviewRelationFormulaType, total int) domain.Value {
+	percent := func(count int) domain.Value {
+		if total == 0 {
+			return domain.Null()
+		}
+		return domain.Float64(float64(count) * 100 / float64(total))
+	}
+	switch formula {
+	case model.BlockContentDataviewRelation_Count:
+		return domain.Int64(total)
+	case model.BlockContentDataviewRelation_CountValue:
+		return domain.Int64(c.valueCount)
+	case model.BlockContentDataviewRelation_CountDistinct:
+		return domain.Int64(len(c.distinct))
+	case model.BlockContentDataviewRelation_CountEmpty:
+		return domain.Int64(c.empty)
+	case model.BlockContentDataviewRelation_CountNotEmpty:
+		return domain.Int64(total - c.empty)
+	case model.BlockContentDataviewRelation_PercentEmpty:
+		return percent(c.empty)
+	case model.BlockContentDataviewRelation_PercentNotEmpty:
+		return percent(total - c.empty)
+	case model.BlockContentDataviewRelation_MathSum:
+		return domain.Float64(c.sum)
+	}
+	if len(c.numbers) == 0 {
+		return domain.Null()
+	}
+	switch formula {
+	case model.BlockContentDataviewRelation_MathAverage:
+		return domain.Float64(c.sum / float64(len(c.numbers)))
+	case model.BlockContentDataviewRelation_MathMedian:
+		middle := len(c.numbers) / 2
+		if len(c.numbers)%2 == 0 {
+			return domain.Float64((c.numbers[middle-1] + c.numbers[middle]) / 2)
+		}
+		return domain.Float64(c.numbers[middle])
+	case model.BlockContentDataviewRelation_MathMin:
+		return domain.Float64(c.numbers[0])
+	case model.BlockContentDataviewRelation_MathMax:
+		return domain.Float64(c.numbers[len(c.numbers)-1])
+	case model.BlockContentDataviewRelation_Range:
+		return domain.Float64(c.numbers[len(c.numbers)-1] - c.numbers[0])
+	}
+	return domain.Null()
+}
+
+// formulaValues returns non-empty values of the relation. Unlike domain.Value.IsEmpty, zero number is a value
+func formulaValues(value domain.Value) []domain.Value {
+	if v, ok := value.TryBool(); ok {
+		if v {
+			return []domain.Value{value}
+		}
+		return nil
+	}
+	list := value.WrapToList()
+	values := list[:0]
+	for _, v := range list {
+		if s, ok := v.TryString(); ok && s == "" {
+			continue
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+func distinctKey(v domain.Value) string {
+	if f, ok := v.TryFloat64(); ok {
+		return "n" + strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	if b, ok := v.TryBool(); ok {
+		return "b" + strconv.FormatBool(b)
+	}
+	return "s" + v.String()
+}
+
+// formulaCalculator incrementally calculates the formulas over all records of the subscription
+type formulaCalculator struct {
+	formulas []FormulaRequest
+	columns  map[domain.RelationKey]*columnAggregate
+	total    int
+
+	lastResults []*pb.EventObjectSubscriptionFormulasFormula
+}
+
+func newFormulaCalculator(formulas []FormulaRequest) *formulaCalculator {
+	if len(formulas) == 0 {
+		return nil
+	}
+	calc := &formulaCalculator{
+		formulas: formulas,
+		columns:  make(map[domain.RelationKey]*columnAggregate, len(formulas)),
+	}
+	for _, f := range formulas {
+		calc.columns[f.RelationKey] = &columnAggregate{distinct: map[string]int{}}
+	}
+	return calc
+}
+
+func (c *formulaCalculator) add(details *domain.Details) {
+	c.update(details, 1)
+}
+
+func (c *formulaCalculator) remove(details *domain.Details) {
+	c.update(details, -1)
+}
+
+func (c *formulaCalculator) update(details *domain.Details, delta int) {
+	c.total += delta
+	for key, column := range c.columns {
+		column.update(details.Get(key), delta)
+	}
+}
+
+func (c *formulaCalculator) results() []*pb.EventObjectSubscriptionFormulasFormula {
+	results := make([]*pb.EventObjectSubscriptionFormulasFormula, 0, len(c.formulas))
+	for _, f := range c.formulas {
+		results = append(results, &pb.EventObjectSubscriptionFormulasFormula{
+			RelationKey: f.RelationKey.String(),
+			Formula:     f.Formula,
+			Value:       c.columns[f.RelationKey].calculate(f.Formula, c.total).ToProto(),
+		})
+	}
+	return results
+}
+
+// changedResults returns new results if they differ from the previously returned ones
+func (c *formulaCalculator) changedResults() (results []*pb.EventObjectSubscriptionFormulasFormula, changed bool) {
+	results = c.results()
+	if c.lastResults != nil && slices.EqualFunc(results, c.lastResults, func(a, b *pb.EventObjectSubscriptionFormulasFormula) bool {
+		return a.Value.Equal(b.Value)
+	}) {
+		return nil, false
+	}
+	c.lastResults = results
+	return results, true
+}
+
+func (c *formulaCalculator) event(subId string) *pb.EventObjectSubscriptionFormulas {
+	c.lastResults = c.results()
+	return &pb.EventObjectSubscriptionFormulas{
+		SubId:    subId,
+		Formulas: c.lastResults,
+	}
+}
@@ -0,0 +1,208 @@
+package subscription
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func formulaDetails(id string, values map[domain.RelationKey]domain.Value) *domain.Details {
+	details := domain.NewDetailsFromMap(values)
+	details.SetString(bundle.RelationKeyId, id)
+	return details
+}
+
+func formulaValue(t *testing.T, results []*pb.EventObjectSubscriptionFormulasFormula, key string, formula model.BlockContentDataviewRelationFormulaType) *types.Value {
+	for _, r := range results {
+		if r.RelationKey == key && r.Formula == formula {
+			return r.Value
+		}
+	}
+	t.Fatalf("formula %s for %s not found", formula, key)
+	return nil
+}
+
+func TestFormulaCalculator(t *testing.T) {
+	var formulas []FormulaRequest
+	for _, formula := range []model.BlockContentDataviewRelationFormulaType{
+		model.BlockContentDataviewRelation_Count,
+		model.BlockContentDataviewRelation_CountValue,
+		model.BlockContentDataviewRelation_CountDistinct,
+		model.BlockContentDataviewRelation_CountEmpty,
+		model.BlockContentDataviewRelation_CountNotEmpty,
+		model.BlockContentDataviewRelation_PercentEmpty,
+		model.BlockContentDataviewRelation_PercentNotEmpty,
+		model.BlockContentDataviewRelation_MathSum,
+		model.BlockContentDataviewRelation_MathAverage,
+		model.BlockContentDataviewRelation_MathMedian,
+		model.BlockContentDataviewRelation_MathMin,
+		model.BlockContentDataviewRelation_MathMax,
+		model.BlockContentDataviewRelation_Range,
+	} {
+		formulas = append(formulas, FormulaRequest{RelationKey: "price", Formula: formula})
+	}
+	formulas = append(formulas,
+		FormulaRequest{RelationKey: bundle.RelationKeyTag, Formula: model.BlockContentDataviewRelation_CountValue},
+		FormulaRequest{RelationKey: bundle.RelationKeyTag, Formula: model.BlockContentDataviewRelation_CountDistinct},
+		FormulaRequest{RelationKey: bundle.RelationKeyDone, Formula: model.BlockContentDataviewRelation_PercentNotEmpty},
+	)
+
+	t.Run("empty set", func(t *testing.T) {
+		calc := newFormulaCalculator(formulas)
+
+		results := calc.results()
+
+		assert.Equal(t, pbtypes.Int64(0), formulaValue(t, results, "price", model.BlockContentDataviewRelation_Count))
+		assert.Equal(t, pbtypes.Float64(0), formulaValue(t, results, "price", model.BlockContentDataviewRelation_MathSum))
+		assert.Equal(t, pbtypes.Null(), formulaValue(t, results, "price", model.BlockContentDataviewRelation_MathAverage))
+		assert.Equal(t, pbtypes.Null(), formulaValue(t, results, "price", model.BlockContentDataviewRelation_PercentEmpty))
+	})
+
+	t.Run("add and remove records", func(t *testing.T) {
+		// given
+		calc := newFormulaCalculator(formulas)
+		records := []*domain.Details{
+			formulaDetails("id1", map[domain.RelationKey]domain.Value{
+				"price":                domain.Int64(10),
+				bundle.RelationKeyTag:  domain.StringList([]string{"a", "b"}),
+				bundle.RelationKeyDone: domain.Bool(true),
+			}),
+			formulaDetails("id2", map[domain.RelationKey]domain.Value{
+				"price":               domain.Int64(0),
+				bundle.RelationKeyTag: domain.StringList([]string{"b"}),
+			}),
+			formulaDetails("id3", map[domain.RelationKey]domain.Value{
+				"price":                domain.Int64(30),
+				bundle.RelationKeyDone: domain.Bool(false),
+			}),
+			formulaDetails("id4", map[domain.RelationKey]domain.Value{
+				"price": domain.Int64(10),
+			}),
+			formulaDetails("id5", map[domain.RelationKey]domain.Value{}),
+		}
+
+		// when
+		for _, rec := range records {
+			calc.add(rec)
+		}
+
+		// then
+		results := calc.results()
+		for formula, expected := range map[model.BlockContentDataviewRelationFormulaType]*types.Value{
+			model.BlockContentDataviewRelation_Count:           pbtypes.Int64(5),
+			model.BlockContentDataviewRelation_CountValue:      pbtypes.Int64(4),
+			model.BlockContentDataviewRelation_CountDistinct:   pbtypes.Int64(3),
+			model.BlockContentDataviewRelation_CountEmpty:      pbtypes.Int64(1),
+			model.BlockContentDataviewRelation_CountNotEmpty:   pbtypes.Int64(4),
+			model.BlockContentDataviewRelation_PercentEmpty:    pbtypes.Float64(20),
+			model.BlockContentDataviewRelation_PercentNotEmpty: pbtypes.Float64(80),
+			model.BlockContentDataviewRelation_MathSum:         pbtypes.Float64(50),
+			model.BlockContentDataviewRelation_MathAverage:     pbtypes.Float64(12.5),
+			model.BlockContentDataviewRelation_MathMedian:      pbtypes.Float64(10),
+			model.BlockContentDataviewRelation_MathMin:         pbtypes.Float64(0),
+			model.BlockContentDataviewRelation_MathMax:         pbtypes.Float64(30),
+			model.BlockContentDataviewRelation_Range:           pbtypes.Float64(30),
+		} {
+			assert.Equal(t, expected, formulaValue(t, results, "price", formula), formula.String())
+		}
+		assert.Equal(t, pbtypes.Int64(3), formulaValue(t, results, bundle.RelationKeyTag.String(), model.BlockContentDataviewRelation_CountValue))
+		assert.Equal(t, pbtypes.Int64(2), formulaValue(t, results, bundle.RelationKeyTag.String(), model.BlockContentDataviewRelation_CountDistinct))
+		assert.Equal(t, pbtypes.Float64(20), formulaValue(t, results, bundle.RelationKeyDone.String(), model.BlockContentDataviewRelation_PercentNotEmpty))
+
+		// when
+		calc.remove(records[2])
+		calc.remove(records[0])
+
+		// then
+		results = calc.results()
+		assert.Equal(t, pbtypes.Int64(3), formulaValue(t, results, "price", model.BlockContentDataviewRelation_Count))
+		assert.Equal(t, pbtypes.Float64(10), formulaValue(t, results, "price", model.BlockContentDataviewRelation_MathSum))
+		assert.Equal(t, pbtypes.Float64(5), formulaValue(t, results, "price", model.BlockContentDataviewRelation_MathMedian))
+		assert.Equal(t, pbtypes.Int64(2), formulaValue(t, results, "price", model.BlockContentDataviewRelation_CountDistinct))
+		assert.Equal(t, pbtypes.Int64(1), formulaValue(t, results, bundle.RelationKeyTag.String(), model.BlockContentDataviewRelation_CountDistinct))
+	})
+
+	t.Run("results are reported only when changed", func(t *testing.T) {
+		calc := newFormulaCalculator(formulas)
+		calc.event("sub")
+
+		calc.add(formulaDetails("id1", map[domain.RelationKey]domain.Value{"price": domain.Int64(1)}))
+		_, changed := calc.changedResults()
+		assert.True(t, changed)
+
+		_, changed = calc.changedResults()
+		assert.False(t, changed)
+	})
+}
+
+func TestFormulasFromProto(t *testing.T) {
+	formulas := FormulasFromProto([]*model.BlockContentDataviewRelation{
+		{Key: bundle.RelationKeyName.String()},
+		{Key: "price", Formula: model.BlockContentDataviewRelation_MathSum},
+	})
+
+	assert.Equal(t, []FormulaRequest{{RelationKey: "price", Formula: model.BlockContentDataviewRelation_MathSum}}, formulas)
+}
+
+func TestService_SearchWithFormulas(t *testing.T) {
+	// given
+	fx := NewInternalTestService(t)
+	fx.AddObjects(t, testSpaceId, []objectstore.TestObject{
+		{bundle.RelationKeyId: domain.String("id1"), "price": domain.Int64(10)},
+		{bundle.RelationKeyId: domain.String("id2"), "price": domain.Int64(20)},
+		{bundle.RelationKeyId: domain.String("id3"), "price": domain.Int64(30)},
+	})
+
+	// when
+	resp, err := fx.Search(SubscribeRequest{
+		SpaceId: testSpaceId,
+		SubId:   "formulas",
+		Keys:    []string{bundle.RelationKeyId.String()},
+		Sorts:   []database.SortRequest{{RelationKey: "price", Type: model.BlockContentDataviewSort_Asc}},
+		Limit:   1,
+		Formulas: []FormulaRequest{
+			{RelationKey: "price", Formula: model.BlockContentDataviewRelation_MathSum},
+		},
+		Internal: true,
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 1)
+	require.NotNil(t, resp.Formulas)
+	assert.Equal(t, pbtypes.Float64(60), resp.Formulas.Formulas[0].Value)
+
+	t.Run("formulas are updated on changes outside the page", func(t *testing.T) {
+		// when
+		fx.AddObjects(t, testSpaceId, []objectstore.TestObject{
+			{bundle.RelationKeyId: domain.String("id3"), "price": domain.Int64(40)},
+		})
+
+		// then
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		msgs, err := resp.Output.NewCond().WithMin(1).Wait(ctx)
+		require.NoError(t, err)
+		var formulas *pb.EventObjectSubscriptionFormulas
+		for _, msg := range msgs {
+			if v := msg.GetSubscriptionFormulas(); v != nil {
+				formulas = v
+			}
+		}
+		require.NotNil(t, formulas)
+		assert.Equal(t, "formulas", formulas.SubId)
+		assert.Equal(t, pbtypes.Float64(70), formulas.Formulas[0].Value)
+	})
+}
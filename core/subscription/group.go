@@ -8,14 +8,15 @@ import (
 	"github.com/anyproto/anytype-heart/util/slice"
 )
 
-func (s *spaceSubscriptions) newGroupSub(id string, relKey domain.RelationKey, f *database.Filters, groups []*model.BlockContentDataviewGroup) *groupSub {
+func (s *spaceSubscriptions) newGroupSub(id string, relKey domain.RelationKey, f *database.Filters, groups []*model.BlockContentDataviewGroup, grouper kanban.RecordsGrouper) *groupSub {
 	sub := &groupSub{
-		id:     id,
-		relKey: relKey,
-		cache:  s.cache,
-		set:    make(map[string]struct{}),
-		filter: f,
-		groups: groups,
+		id:      id,
+		relKey:  relKey,
+		cache:   s.cache,
+		set:     make(map[string]struct{}),
+		filter:  f,
+		groups:  groups,
+		grouper: grouper,
 	}
 	return sub
 }
@@ -31,6 +32,8 @@ type groupSub struct {
 	filter *database.Filters
 
 	groups []*model.BlockContentDataviewGroup
+
+	grouper kanban.RecordsGrouper
 }
 
 func (gs *groupSub) init(entries []*entry) (err error) {
@@ -53,9 +56,7 @@ func (gs *groupSub) onChange(ctx *opCtx) {
 		if _, inSet := gs.set[ctxEntry.id]; inSet {
 			cacheEntry := gs.cache.Get(ctxEntry.id)
 			if !checkGroups && cacheEntry != nil {
-				oldList := cacheEntry.data.GetStringList(gs.relKey)
-				newList := ctxEntry.data.GetStringList(gs.relKey)
-				checkGroups = !slice.UnsortedEqual(oldList, newList)
+				checkGroups = !cacheEntry.data.Get(gs.relKey).Equal(ctxEntry.data.Get(gs.relKey))
 			}
 			if !inFilter {
 				gs.cache.RemoveSubId(ctxEntry.id, gs.id)
@@ -80,9 +81,8 @@ func (gs *groupSub) onChange(ctx *opCtx) {
 			}
 		}
 
-		tag := kanban.GroupTag{Key: gs.relKey, Records: records}
-
-		newGroups, err := tag.MakeDataViewGroups()
+		gs.grouper.SetRecords(records)
+		newGroups, err := gs.grouper.MakeDataViewGroups()
 		if err != nil {
 			log.Errorf("fail to make groups for kanban: %s", err)
 		}
@@ -2,6 +2,7 @@ package subscription
 
 import (
 	"testing"
+	"time"
 
 	"github.com/anyproto/any-store/anyenc"
 	"github.com/stretchr/testify/require"
@@ -82,7 +83,7 @@ func TestGroupTag(t *testing.T) {
 
 	t.Run("change_existing_groups", func(t *testing.T) {
 		entries := genTagEntries()
-		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache()}
+		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache(), grouper: &kanban.GroupTag{Key: kanbanKey}}
 
 		require.NoError(t, sub.init(entries))
 
@@ -98,7 +99,7 @@ func TestGroupTag(t *testing.T) {
 
 	t.Run("add_new_group_from_existing_tags", func(t *testing.T) {
 		entries := genTagEntries()
-		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache()}
+		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache(), grouper: &kanban.GroupTag{Key: kanbanKey}}
 
 		require.NoError(t, sub.init(entries))
 
@@ -114,7 +115,7 @@ func TestGroupTag(t *testing.T) {
 
 	t.Run("add_new_group_by_adding_new_tag", func(t *testing.T) {
 		entries := genTagEntries()
-		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache()}
+		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache(), grouper: &kanban.GroupTag{Key: kanbanKey}}
 
 		require.NoError(t, sub.init(entries))
 
@@ -127,7 +128,7 @@ func TestGroupTag(t *testing.T) {
 
 	t.Run("remove_existing_group_by_setting_tag_null", func(t *testing.T) {
 		entries := genTagEntries()
-		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache()}
+		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache(), grouper: &kanban.GroupTag{Key: kanbanKey}}
 
 		require.NoError(t, sub.init(entries))
 
@@ -143,7 +144,7 @@ func TestGroupTag(t *testing.T) {
 
 	t.Run("remove_existing_group_by_removing_record", func(t *testing.T) {
 		entries := genTagEntries()
-		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache()}
+		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache(), grouper: &kanban.GroupTag{Key: kanbanKey}}
 
 		require.NoError(t, sub.init(entries))
 
@@ -159,7 +160,7 @@ func TestGroupTag(t *testing.T) {
 
 	t.Run("remove_from_group_with_single_tag", func(t *testing.T) {
 		entries := genTagEntries()
-		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache()}
+		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache(), grouper: &kanban.GroupTag{Key: kanbanKey}}
 
 		require.NoError(t, sub.init(entries))
 
@@ -175,7 +176,7 @@ func TestGroupTag(t *testing.T) {
 
 	t.Run("remove_tag_which_exist_in_two_groups", func(t *testing.T) {
 		entries := genTagEntries()
-		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache()}
+		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache(), grouper: &kanban.GroupTag{Key: kanbanKey}}
 
 		require.NoError(t, sub.init(entries))
 
@@ -191,7 +192,7 @@ func TestGroupTag(t *testing.T) {
 
 	t.Run("add_new_tag", func(t *testing.T) {
 		entries := genTagEntries()
-		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache()}
+		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache(), grouper: &kanban.GroupTag{Key: kanbanKey}}
 
 		require.NoError(t, sub.init(entries))
 
@@ -204,7 +205,7 @@ func TestGroupTag(t *testing.T) {
 
 	t.Run("add_new_tag_and_set_to_record", func(t *testing.T) {
 		entries := genTagEntries()
-		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache()}
+		sub := groupSub{relKey: kanbanKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache(), grouper: &kanban.GroupTag{Key: kanbanKey}}
 
 		require.NoError(t, sub.init(entries))
 
@@ -220,3 +221,66 @@ func TestGroupTag(t *testing.T) {
 		assertCtxGroup(t, ctx, 2, 0)
 	})
 }
+
+func TestGroupDate(t *testing.T) {
+	dateKey := bundle.RelationKeyDueDate
+	firstWeek := time.Date(2024, 5, 6, 12, 0, 0, 0, time.UTC).Unix()
+	secondWeek := time.Date(2024, 5, 14, 12, 0, 0, 0, time.UTC).Unix()
+	thirdWeek := time.Date(2024, 5, 21, 12, 0, 0, 0, time.UTC).Unix()
+
+	genDateEntries := func() []*entry {
+		return []*entry{
+			newEntry("record_one", domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{dateKey: domain.Int64(firstWeek)})),
+			newEntry("record_two", domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{dateKey: domain.Int64(secondWeek)})),
+		}
+	}
+	newDateSub := func(t *testing.T) *groupSub {
+		entries := genDateEntries()
+		grouper := &kanban.GroupDate{Key: dateKey, Bucket: model.BlockContentDataviewDate_Week}
+		for _, e := range entries {
+			grouper.Records = append(grouper.Records, database.Record{Details: e.data})
+		}
+		groups, err := grouper.MakeDataViewGroups()
+		require.NoError(t, err)
+		f := &database.Filters{FilterObj: database.FilterNot{Filter: database.FilterEmpty{Key: dateKey}}}
+		sub := &groupSub{relKey: dateKey, filter: f, groups: groups, set: make(map[string]struct{}), cache: newCache(), grouper: grouper}
+		require.NoError(t, sub.init(entries))
+		return sub
+	}
+
+	t.Run("move_record_to_new_bucket", func(t *testing.T) {
+		sub := newDateSub(t)
+
+		ctx := &opCtx{c: sub.cache}
+		ctx.entries = append(ctx.entries, &entry{
+			id: "record_two", data: domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{dateKey: domain.Int64(thirdWeek)}),
+		})
+		sub.onChange(ctx)
+
+		assertCtxGroup(t, ctx, 1, 1)
+	})
+
+	t.Run("move_record_to_existing_bucket", func(t *testing.T) {
+		sub := newDateSub(t)
+
+		ctx := &opCtx{c: sub.cache}
+		ctx.entries = append(ctx.entries, &entry{
+			id: "record_two", data: domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{dateKey: domain.Int64(firstWeek)}),
+		})
+		sub.onChange(ctx)
+
+		assertCtxGroup(t, ctx, 0, 1)
+	})
+
+	t.Run("change_date_within_bucket", func(t *testing.T) {
+		sub := newDateSub(t)
+
+		ctx := &opCtx{c: sub.cache}
+		ctx.entries = append(ctx.entries, &entry{
+			id: "record_one", data: domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{dateKey: domain.Int64(firstWeek + 3600)}),
+		})
+		sub.onChange(ctx)
+
+		assertCtxGroup(t, ctx, 0, 0)
+	})
+}
@@ -0,0 +1,111 @@
+package subscription
+
+import (
+	"context"
+	"time"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	timeutil "github.com/anyproto/anytype-heart/util/time"
+)
+
+// dateRolloverCheckInterval is small enough to catch up quickly after the device wakes up, when timers could be late
+var dateRolloverCheckInterval = time.Minute
+
+// watchDateRollover re-evaluates relative date filters of all subscriptions when the day changes in the account time zone,
+// so views like "Due this week" stay correct without re-subscribing
+func (s *service) watchDateRollover(ctx context.Context) {
+	ticker := time.NewTicker(dateRolloverCheckInterval)
+	defer ticker.Stop()
+
+	day := s.currentDay()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if newDay := s.currentDay(); newDay != day {
+			day = newDay
+			s.refreshRelativeDateFilters()
+		}
+	}
+}
+
+func (s *service) refreshRelativeDateFilters() {
+	s.lock.Lock()
+	spaceSubs := make([]*spaceSubscriptions, 0, len(s.spaceSubs))
+	for _, spaceSub := range s.spaceSubs {
+		spaceSubs = append(spaceSubs, spaceSub)
+	}
+	s.lock.Unlock()
+
+	for _, spaceSub := range spaceSubs {
+		spaceSub.refreshRelativeDateFilters()
+	}
+}
+
+// currentDay identifies the current day along with the time zone, so changing the account time zone also counts as rollover
+func (s *service) currentDay() string {
+	loc := timeutil.AccountLocation(s.locationProvider)
+	return time.Now().In(loc).Format(time.DateOnly) + " " + loc.String()
+}
+
+// refreshRelativeDateFilters resolves relative date filters of subscriptions again and passes the objects
+// that could enter or leave the subscriptions through the regular records handler
+func (s *spaceSubscriptions) refreshRelativeDateFilters() {
+	s.m.Lock()
+	records := map[string]database.Record{}
+	for subId, req := range s.relativeDateReqs {
+		sub, ok := s.getSubscription(subId)
+		if !ok {
+			continue
+		}
+		f, err := s.makeFilters(req)
+		if err != nil {
+			log.With("subId", subId, "error", err).Errorf("refresh relative date filters")
+			continue
+		}
+
+		var sorted *sortedSub
+		filter := f.FilterObj
+		switch v := sub.(type) {
+		case *sortedSub:
+			sorted = v
+		case *collectionSub:
+			sorted = v.sortedSub
+			if filter == nil {
+				filter = v.observer
+			} else {
+				filter = database.FiltersAnd{v.observer, filter}
+			}
+		default:
+			continue
+		}
+
+		// objects that are in the subscription now could leave it
+		for el := sorted.skl.Front(); el != nil; el = el.Next() {
+			e := el.Key().(*entry)
+			records[e.id] = database.Record{Details: e.data}
+		}
+		sorted.filter = filter
+
+		// and objects matching the new window enter it
+		entries, err := queryEntries(s.objectStore, &database.Filters{FilterObj: filter})
+		if err != nil {
+			log.With("subId", subId, "error", err).Errorf("query entries for relative date filters")
+			continue
+		}
+		for _, e := range entries {
+			records[e.id] = database.Record{Details: e.data}
+		}
+	}
+	s.m.Unlock()
+
+	for _, rec := range records {
+		if err := s.recBatch.Add(s.ctx, rec); err != nil {
+			log.With("id", rec.Details.GetString(bundle.RelationKeyId), "error", err).Errorf("add record to batch")
+			return
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package subscription
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cheggaaa/mb/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestService_refreshRelativeDateFilters(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// given
+	subId := "due"
+	fx := newFixtureWithRealObjectStore(t)
+	fx.store.AddObjects(t, testSpaceId, []objectstore.TestObject{
+		{
+			bundle.RelationKeyId:      domain.String("today"),
+			bundle.RelationKeyDueDate: domain.Int64(time.Now().Unix()),
+		},
+		{
+			bundle.RelationKeyId:      domain.String("yesterday"),
+			bundle.RelationKeyDueDate: domain.Int64(time.Now().AddDate(0, 0, -1).Unix()),
+		},
+	})
+
+	queue := mb.New[*pb.EventMessage](0)
+	resp, err := fx.Search(SubscribeRequest{
+		SpaceId: testSpaceId,
+		SubId:   subId,
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyDueDate,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				QuickOption: model.BlockContentDataviewFilter_NumberOfDaysAgo,
+				Value:       domain.Int64(1),
+			},
+		},
+		Keys:          []string{bundle.RelationKeyId.String()},
+		Internal:      true,
+		InternalQueue: queue,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 1)
+	require.Equal(t, "yesterday", resp.Records[0].GetString(bundle.RelationKeyId))
+
+	// the window moves one day forward, like after midnight
+	spaceSub, err := fx.Service.(*service).getSpaceSubscriptions(testSpaceId)
+	require.NoError(t, err)
+	spaceSub.m.Lock()
+	req := spaceSub.relativeDateReqs[subId]
+	req.Filters[0].Value = domain.Int64(0)
+	spaceSub.m.Unlock()
+
+	// when
+	fx.Service.(*service).refreshRelativeDateFilters()
+
+	// then
+	msgs, err := queue.NewCond().WithMin(3).Wait(ctx)
+	require.NoError(t, err)
+	var added, removed []string
+	for _, msg := range msgs {
+		if add := msg.GetSubscriptionAdd(); add != nil {
+			added = append(added, add.Id)
+		}
+		if remove := msg.GetSubscriptionRemove(); remove != nil {
+			removed = append(removed, remove.Id)
+		}
+	}
+	require.Equal(t, []string{"today"}, added)
+	require.Equal(t, []string{"yesterday"}, removed)
+}
+
+func TestService_relativeDateReqs(t *testing.T) {
+	t.Run("unsubscribe forgets request", func(t *testing.T) {
+		// given
+		fx := newFixtureWithRealObjectStore(t)
+		_, err := fx.Search(SubscribeRequest{
+			SpaceId: testSpaceId,
+			SubId:   "week",
+			Filters: []database.FilterRequest{
+				{
+					RelationKey: bundle.RelationKeyDueDate,
+					Condition:   model.BlockContentDataviewFilter_Equal,
+					QuickOption: model.BlockContentDataviewFilter_CurrentWeek,
+				},
+			},
+			Keys: []string{bundle.RelationKeyId.String()},
+		})
+		require.NoError(t, err)
+		spaceSub, err := fx.Service.(*service).getSpaceSubscriptions(testSpaceId)
+		require.NoError(t, err)
+		require.Contains(t, spaceSub.relativeDateReqs, "week")
+
+		// when
+		require.NoError(t, fx.Unsubscribe("week"))
+
+		// then
+		require.NotContains(t, spaceSub.relativeDateReqs, "week")
+	})
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/slice"
+	timeutil "github.com/anyproto/anytype-heart/util/time"
 )
 
 const CName = "subscription"
@@ -55,6 +56,10 @@ type SubscribeRequest struct {
 	// disable dependent subscription
 	NoDepSubscription bool
 	CollectionId      string
+	// (optional) formulas to calculate over all records of the subscription
+	Formulas []FormulaRequest
+	// (optional) only objects intersecting the visible window of a timeline view
+	Timeline *TimelineRequest
 
 	// Internal indicates that subscription will send events into message queue instead of global client's event system
 	Internal bool
@@ -68,6 +73,7 @@ type SubscribeResponse struct {
 	Records      []*domain.Details
 	Dependencies []*domain.Details
 	Counters     *pb.EventObjectSubscriptionCounters
+	Formulas     *pb.EventObjectSubscriptionFormulas
 
 	// Used when Internal flag is set to true
 	Output *mb.MB[*pb.EventMessage]
@@ -110,7 +116,11 @@ type service struct {
 	kanban            kanban.Service
 	collectionService CollectionService
 	eventSender       event.Sender
+	locationProvider  timeutil.LocationProvider
 	arenaPool         *anyenc.ArenaPool
+
+	componentCtx       context.Context
+	componentCtxCancel context.CancelFunc
 }
 
 type internalSubOutput struct {
@@ -150,17 +160,23 @@ func (s *service) Init(a *app.App) (err error) {
 	s.kanban = app.MustComponent[kanban.Service](a)
 	s.collectionService = app.MustComponent[CollectionService](a)
 	s.eventSender = app.MustComponent[event.Sender](a)
+	s.locationProvider, _ = app.GetComponent[timeutil.LocationProvider](a)
 
 	s.spaceSubs = map[string]*spaceSubscriptions{}
 	s.arenaPool = &anyenc.ArenaPool{}
+	s.componentCtx, s.componentCtxCancel = context.WithCancel(context.Background())
 	return
 }
 
 func (s *service) Run(ctx context.Context) (err error) {
+	go s.watchDateRollover(s.componentCtx)
 	return
 }
 
 func (s *service) Close(ctx context.Context) error {
+	if s.componentCtxCancel != nil {
+		s.componentCtxCancel()
+	}
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	var err error
@@ -184,6 +200,7 @@ func (s *service) Search(req SubscribeRequest) (resp *SubscribeResponse, err err
 	if err != nil {
 		return nil, err
 	}
+	req.Filters = withTimelineFilter(req.Filters, req.Timeline)
 	return spaceSubs.Search(req)
 }
 
@@ -219,6 +236,7 @@ func (s *service) SubscribeGroups(req SubscribeGroupsRequest) (*pb.RpcObjectGrou
 	if err != nil {
 		return nil, err
 	}
+	req.Filters = withTimelineFilter(req.Filters, req.Timeline)
 	return spaceSubs.SubscribeGroups(req)
 }
 
@@ -277,6 +295,7 @@ func (s *service) getSpaceSubscriptions(spaceId string) (*spaceSubscriptions, er
 			cache:             cache,
 			subscriptionKeys:  make([]string, 0, 20),
 			subscriptions:     make(map[string]subscription, 20),
+			relativeDateReqs:  map[string]SubscribeRequest{},
 			customOutput:      map[string]*internalSubOutput{},
 			recBatch:          mb.New[database.Record](0),
 			objectStore:       s.objectStore.SpaceIndex(spaceId),
@@ -300,6 +319,8 @@ func (s *service) getSpaceSubscriptions(spaceId string) (*spaceSubscriptions, er
 type spaceSubscriptions struct {
 	subscriptionKeys []string
 	subscriptions    map[string]subscription
+	// relativeDateReqs keeps requests of subscriptions with filters relative to the current date, see refreshRelativeDateFilters
+	relativeDateReqs map[string]SubscribeRequest
 
 	customOutput map[string]*internalSubOutput
 	recBatch     *mb.MB[database.Record]
@@ -348,6 +369,7 @@ func (s *spaceSubscriptions) setSubscription(id string, sub subscription) {
 
 func (s *spaceSubscriptions) deleteSubscription(id string) {
 	delete(s.subscriptions, id)
+	delete(s.relativeDateReqs, id)
 	s.subscriptionKeys = slice.RemoveMut(s.subscriptionKeys, id)
 }
 
@@ -368,23 +390,9 @@ func (s *spaceSubscriptions) Search(req SubscribeRequest) (*SubscribeResponse, e
 		req.SubId = bson.NewObjectId().Hex()
 	}
 
-	q := database.Query{
-		Filters: req.Filters,
-		Sorts:   req.Sorts,
-		Limit:   int(req.Limit),
-	}
-
-	f, err := database.NewFilters(q, s.objectStore, &anyenc.Arena{}, &collate.Buffer{})
+	f, err := s.makeFilters(req)
 	if err != nil {
-		return nil, fmt.Errorf("new database filters: %w", err)
-	}
-
-	if len(req.Source) > 0 {
-		sourceFilter, err := s.filtersFromSource(req.SpaceId, req.Source)
-		if err != nil {
-			return nil, fmt.Errorf("can't make filter from source: %w", err)
-		}
-		f.FilterObj = database.FiltersAnd{f.FilterObj, sourceFilter}
+		return nil, err
 	}
 
 	qryEntries := func() ([]*entry, error) {
@@ -406,14 +414,43 @@ func (s *spaceSubscriptions) Search(req SubscribeRequest) (*SubscribeResponse, e
 		req.Limit = 0
 	}
 
+	var resp *SubscribeResponse
 	if req.CollectionId != "" {
-		return s.subscribeForCollection(req, f, filterDepIds)
+		resp, err = s.subscribeForCollection(req, f, filterDepIds)
+	} else {
+		resp, err = s.subscribeForQuery(req, f, qryEntries, filterDepIds)
+	}
+	if err == nil && database.HasRelativeDateFilters(req.Filters) {
+		s.relativeDateReqs[req.SubId] = req
+	}
+	return resp, err
+}
+
+func (s *spaceSubscriptions) makeFilters(req SubscribeRequest) (*database.Filters, error) {
+	q := database.Query{
+		Filters: req.Filters,
+		Sorts:   req.Sorts,
+		Limit:   int(req.Limit),
+	}
+
+	f, err := database.NewFilters(q, s.objectStore, &anyenc.Arena{}, &collate.Buffer{})
+	if err != nil {
+		return nil, fmt.Errorf("new database filters: %w", err)
 	}
-	return s.subscribeForQuery(req, f, qryEntries, filterDepIds)
+
+	if len(req.Source) > 0 {
+		sourceFilter, err := s.filtersFromSource(req.SpaceId, req.Source)
+		if err != nil {
+			return nil, fmt.Errorf("can't make filter from source: %w", err)
+		}
+		f.FilterObj = database.FiltersAnd{f.FilterObj, sourceFilter}
+	}
+	return f, nil
 }
 
 func (s *spaceSubscriptions) subscribeForQuery(req SubscribeRequest, f *database.Filters, queryEntries func() ([]*entry, error), filterDepIds []string) (*SubscribeResponse, error) {
 	sub := s.newSortedSub(req.SubId, req.SpaceId, slice.StringsInto[domain.RelationKey](req.Keys), f.FilterObj, f.Order, int(req.Limit), int(req.Offset))
+	sub.formulas = newFormulaCalculator(req.Formulas)
 	if req.NoDepSubscription {
 		sub.disableDep = true
 	} else {
@@ -508,7 +545,8 @@ func (s *spaceSubscriptions) subscribeForQuery(req SubscribeRequest, f *database
 			NextCount: int64(prev),
 			PrevCount: int64(next),
 		},
-		Output: outputQueue,
+		Formulas: sub.formulasEvent(),
+		Output:   outputQueue,
 	}, nil
 }
 
@@ -536,7 +574,7 @@ func queryEntries(objectStore spaceindex.Store, f *database.Filters) ([]*entry,
 }
 
 func (s *spaceSubscriptions) subscribeForCollection(req SubscribeRequest, f *database.Filters, filterDepIds []string) (*SubscribeResponse, error) {
-	sub, err := s.newCollectionSub(req.SubId, req.SpaceId, req.CollectionId, slice.StringsInto[domain.RelationKey](req.Keys), filterDepIds, f.FilterObj, f.Order, int(req.Limit), int(req.Offset), req.NoDepSubscription)
+	sub, err := s.newCollectionSub(req.SubId, req.SpaceId, req.CollectionId, slice.StringsInto[domain.RelationKey](req.Keys), filterDepIds, f.FilterObj, f.Order, int(req.Limit), int(req.Offset), req.NoDepSubscription, req.Formulas)
 	if err != nil {
 		return nil, err
 	}
@@ -569,7 +607,8 @@ func (s *spaceSubscriptions) subscribeForCollection(req SubscribeRequest, f *dat
 			NextCount: int64(prev),
 			PrevCount: int64(next),
 		},
-		Output: outputQueue,
+		Formulas: sub.sortedSub.formulasEvent(),
+		Output:   outputQueue,
 	}, nil
 }
 
@@ -631,6 +670,12 @@ type SubscribeGroupsRequest struct {
 	Filters      []database.FilterRequest
 	Source       []string
 	CollectionId string
+	// (optional) groups lanes of a timeline view, only objects intersecting its window are taken into account
+	Timeline *TimelineRequest
+	// DateBucket is used when grouped by date relation
+	DateBucket model.BlockContentDataviewDateBucket
+	// (optional) NumberRanges are ascending bounds of the groups when grouped by number relation
+	NumberRanges []float64
 }
 
 func (s *spaceSubscriptions) SubscribeGroups(req SubscribeGroupsRequest) (*pb.RpcObjectGroupsSubscribeResponse, error) {
@@ -672,7 +717,10 @@ func (s *spaceSubscriptions) SubscribeGroups(req SubscribeGroupsRequest) (*pb.Rp
 		}
 	}
 
-	grouper, err := s.kanban.Grouper(req.SpaceId, req.RelationKey)
+	grouper, err := s.kanban.Grouper(req.SpaceId, req.RelationKey, kanban.GroupOptions{
+		DateBucket:   req.DateBucket,
+		NumberRanges: req.NumberRanges,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -686,12 +734,7 @@ func (s *spaceSubscriptions) SubscribeGroups(req SubscribeGroupsRequest) (*pb.Rp
 		return nil, err
 	}
 
-	if tagGrouper, ok := grouper.(*kanban.GroupTag); ok {
-		groups, err := tagGrouper.MakeDataViewGroups()
-		if err != nil {
-			return nil, err
-		}
-
+	if recordsGrouper, ok := grouper.(kanban.RecordsGrouper); ok {
 		subId = req.SubId
 		if subId == "" {
 			subId = bson.NewObjectId().Hex()
@@ -702,13 +745,14 @@ func (s *spaceSubscriptions) SubscribeGroups(req SubscribeGroupsRequest) (*pb.Rp
 
 		var sub subscription
 		if colObserver != nil {
-			sub = s.newCollectionGroupSub(subId, domain.RelationKey(req.RelationKey), flt, groups, colObserver)
+			sub = s.newCollectionGroupSub(subId, domain.RelationKey(req.RelationKey), flt, dataViewGroups, recordsGrouper, colObserver)
 		} else {
-			sub = s.newGroupSub(subId, domain.RelationKey(req.RelationKey), flt, groups)
+			sub = s.newGroupSub(subId, domain.RelationKey(req.RelationKey), flt, dataViewGroups, recordsGrouper)
 		}
 
-		entries := make([]*entry, 0, len(tagGrouper.Records))
-		for _, r := range tagGrouper.Records {
+		records := recordsGrouper.GetRecords()
+		entries := make([]*entry, 0, len(records))
+		for _, r := range records {
 			entries = append(entries, newEntry(r.Details.GetString(bundle.RelationKeyId), r.Details))
 		}
 
@@ -786,6 +830,7 @@ func (s *spaceSubscriptions) UnsubscribeAll() (err error) {
 	}
 	s.subscriptions = make(map[string]subscription)
 	s.subscriptionKeys = s.subscriptionKeys[:0]
+	clear(s.relativeDateReqs)
 	return
 }
 
@@ -963,5 +1008,6 @@ func (s *spaceSubscriptions) Close(ctx context.Context) (err error) {
 		delete(s.subscriptions, subId)
 	}
 	s.subscriptionKeys = s.subscriptionKeys[:0]
+	clear(s.relativeDateReqs)
 	return
 }
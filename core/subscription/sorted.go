@@ -6,6 +6,7 @@ import (
 	"github.com/huandu/skiplist"
 
 	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
 )
@@ -60,6 +61,9 @@ type sortedSub struct {
 
 	compCountBefore, compCountAfter opCounter
 
+	// formulas is nil when client didn't request them
+	formulas *formulaCalculator
+
 	cache *cache
 	ds    *dependencyService
 
@@ -87,6 +91,11 @@ func (s *sortedSub) init(entries []*entry) (err error) {
 		e.SetSub(s.id, false, false)
 		s.skl.Set(e, nil)
 	}
+	if s.formulas != nil {
+		for el := s.skl.Front(); el != nil; el = el.Next() {
+			s.formulas.add(el.Key().(*entry).data)
+		}
+	}
 	if s.afterId != "" {
 		e := s.cache.Get(s.afterId)
 		if e == nil {
@@ -177,6 +186,12 @@ func (s *sortedSub) onChange(ctx *opCtx) {
 		s.compCountBefore = s.compCountAfter
 	}
 
+	if s.formulas != nil {
+		if results, changed := s.formulas.changedResults(); changed {
+			ctx.formulas = append(ctx.formulas, opFormulas{subId: s.id, formulas: results})
+		}
+	}
+
 	wasAddOrRemove, added, removed := s.diff.diff(ctx, s.id, s.keys)
 	s.ds.depEntriesByEntries(ctx, added)
 
@@ -236,6 +251,9 @@ func (s *sortedSub) onEntryChange(ctx *opCtx, e *entry) (noChange bool) {
 
 	curr := s.cache.Get(e.id)
 	curInSet := curr != nil
+	if s.formulas != nil {
+		s.updateFormulas(curr, e, newInSet)
+	}
 	// nothing
 	if !curInSet && !newInSet {
 		return true
@@ -262,6 +280,26 @@ func (s *sortedSub) onEntryChange(ctx *opCtx, e *entry) (noChange bool) {
 	panic("subscription: check algo")
 }
 
+// updateFormulas replaces the previous version of the entry with the new one in formula results
+func (s *sortedSub) updateFormulas(curr, e *entry, newInSet bool) {
+	if curr != nil {
+		// cached entry could belong to another subscription only
+		if el := s.skl.Get(curr); el != nil {
+			s.formulas.remove(el.Key().(*entry).data)
+		}
+	}
+	if newInSet {
+		s.formulas.add(e.data)
+	}
+}
+
+func (s *sortedSub) formulasEvent() *pb.EventObjectSubscriptionFormulas {
+	if s.formulas == nil {
+		return nil
+	}
+	return s.formulas.event(s.id)
+}
+
 func (s *sortedSub) counters() (prev, next int) {
 	if s.beforeEl == nil && s.afterEl == nil && s.limit <= 0 {
 		// no pagination - no counters
@@ -0,0 +1,89 @@
+package subscription
+
+import (
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// TimelineRequest restricts a subscription to objects whose date range intersects the visible window of a timeline view
+type TimelineRequest struct {
+	StartRelationKey domain.RelationKey
+	// EndRelationKey is optional, objects without end date take only their start date
+	EndRelationKey domain.RelationKey
+	From           int64
+	To             int64
+}
+
+func TimelineFromProto(window *model.BlockContentDataviewTimelineWindow) *TimelineRequest {
+	if window == nil || window.StartRelationKey == "" {
+		return nil
+	}
+	return &TimelineRequest{
+		StartRelationKey: domain.RelationKey(window.StartRelationKey),
+		EndRelationKey:   domain.RelationKey(window.EndRelationKey),
+		From:             window.From,
+		To:               window.To,
+	}
+}
+
+// filter matches objects that start before the window ends and end after it starts:
+// start <= to && (end >= from || end is empty && start >= from)
+func (t *TimelineRequest) filter() database.FilterRequest {
+	startsBeforeEnd := []database.FilterRequest{
+		{
+			RelationKey: t.StartRelationKey,
+			Condition:   model.BlockContentDataviewFilter_NotEmpty,
+		},
+		{
+			RelationKey: t.StartRelationKey,
+			Condition:   model.BlockContentDataviewFilter_LessOrEqual,
+			Value:       domain.Int64(t.To),
+		},
+	}
+	startsAfterFrom := database.FilterRequest{
+		RelationKey: t.StartRelationKey,
+		Condition:   model.BlockContentDataviewFilter_GreaterOrEqual,
+		Value:       domain.Int64(t.From),
+	}
+
+	if t.EndRelationKey == "" {
+		return database.FilterRequest{
+			Operator:      model.BlockContentDataviewFilter_And,
+			NestedFilters: append(startsBeforeEnd, startsAfterFrom),
+		}
+	}
+	return database.FilterRequest{
+		Operator: model.BlockContentDataviewFilter_And,
+		NestedFilters: append(startsBeforeEnd, database.FilterRequest{
+			Operator: model.BlockContentDataviewFilter_Or,
+			NestedFilters: []database.FilterRequest{
+				{
+					RelationKey: t.EndRelationKey,
+					Condition:   model.BlockContentDataviewFilter_GreaterOrEqual,
+					Value:       domain.Int64(t.From),
+				},
+				{
+					Operator: model.BlockContentDataviewFilter_And,
+					NestedFilters: []database.FilterRequest{
+						{
+							RelationKey: t.EndRelationKey,
+							Condition:   model.BlockContentDataviewFilter_Empty,
+						},
+						startsAfterFrom,
+					},
+				},
+			},
+		}),
+	}
+}
+
+// withTimelineFilter adds the window of the timeline to the filters without modifying the original slice
+func withTimelineFilter(filters []database.FilterRequest, timeline *TimelineRequest) []database.FilterRequest {
+	if timeline == nil {
+		return filters
+	}
+	res := make([]database.FilterRequest, 0, len(filters)+1)
+	res = append(res, filters...)
+	return append(res, timeline.filter())
+}
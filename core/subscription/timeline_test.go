@@ -0,0 +1,110 @@
+package subscription
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	relationKeyStart domain.RelationKey = "start"
+	relationKeyEnd   domain.RelationKey = "end"
+)
+
+func timelineObject(id string, start, end int64) objectstore.TestObject {
+	obj := objectstore.TestObject{
+		bundle.RelationKeyId: domain.String(id),
+	}
+	if start != 0 {
+		obj[relationKeyStart] = domain.Int64(start)
+	}
+	if end != 0 {
+		obj[relationKeyEnd] = domain.Int64(end)
+	}
+	return obj
+}
+
+func TestService_SearchTimeline(t *testing.T) {
+	objects := []objectstore.TestObject{
+		timelineObject("startsInside", 150, 0),
+		timelineObject("endsInside", 50, 150),
+		timelineObject("spansWindow", 50, 250),
+		timelineObject("endsBefore", 50, 90),
+		timelineObject("startsBeforeNoEnd", 50, 0),
+		timelineObject("startsAfter", 250, 300),
+		timelineObject("noStart", 0, 150),
+	}
+	timeline := &TimelineRequest{
+		StartRelationKey: relationKeyStart,
+		EndRelationKey:   relationKeyEnd,
+		From:             100,
+		To:               200,
+	}
+
+	t.Run("initial records intersect the window", func(t *testing.T) {
+		// given
+		fx := newFixtureWithRealObjectStore(t)
+		fx.store.AddObjects(t, testSpaceId, objects)
+
+		// when
+		resp, err := fx.Search(SubscribeRequest{
+			SpaceId:  testSpaceId,
+			SubId:    "timeline",
+			Keys:     []string{bundle.RelationKeyId.String()},
+			Sorts:    []database.SortRequest{{RelationKey: relationKeyStart, Type: model.BlockContentDataviewSort_Asc}},
+			Timeline: timeline,
+		})
+
+		// then
+		require.NoError(t, err)
+		var ids []string
+		for _, rec := range resp.Records {
+			ids = append(ids, rec.GetString(bundle.RelationKeyId))
+		}
+		assert.ElementsMatch(t, []string{"startsInside", "endsInside", "spansWindow"}, ids)
+	})
+
+	t.Run("object filter matches the same objects", func(t *testing.T) {
+		// given
+		fx := newFixtureWithRealObjectStore(t)
+		filter, err := database.MakeFilters(withTimelineFilter(nil, timeline), fx.store.SpaceIndex(testSpaceId))
+		require.NoError(t, err)
+
+		// when
+		var ids []string
+		for _, obj := range objects {
+			if filter.FilterObject(obj.Details()) {
+				ids = append(ids, obj.Id())
+			}
+		}
+
+		// then
+		assert.Equal(t, []string{"startsInside", "endsInside", "spansWindow"}, ids)
+	})
+
+	t.Run("without end relation objects take only their start date", func(t *testing.T) {
+		// given
+		fx := newFixtureWithRealObjectStore(t)
+		fx.store.AddObjects(t, testSpaceId, objects)
+
+		// when
+		resp, err := fx.Search(SubscribeRequest{
+			SpaceId:  testSpaceId,
+			SubId:    "timeline",
+			Keys:     []string{bundle.RelationKeyId.String()},
+			Timeline: &TimelineRequest{StartRelationKey: relationKeyStart, From: 100, To: 200},
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, resp.Records, 1)
+		assert.Equal(t, "startsInside", resp.Records[0].GetString(bundle.RelationKeyId))
+	})
+}
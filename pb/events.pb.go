@@ -4,6 +4,7 @@
 package pb
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	model "github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	proto "github.com/gogo/protobuf/proto"
@@ -348,6 +349,7 @@ type EventMessage struct {
 	//	*EventMessageValueOfSubscriptionPosition
 	//	*EventMessageValueOfSubscriptionCounters
 	//	*EventMessageValueOfSubscriptionGroups
+	//	*EventMessageValueOfSubscriptionFormulas
 	//	*EventMessageValueOfBlockAdd
 	//	*EventMessageValueOfBlockDelete
 	//	*EventMessageValueOfFilesUpload
@@ -409,6 +411,7 @@ type EventMessage struct {
 	//	*EventMessageValueOfChatUpdateMessageSyncStatus
 	//	*EventMessageValueOfChatDelete
 	//	*EventMessageValueOfChatStateUpdate
+	//	*EventMessageValueOfAiChunk
 	Value IsEventMessageValue `protobuf_oneof:"value"`
 }
 
@@ -508,6 +511,9 @@ type EventMessageValueOfSubscriptionCounters struct {
 type EventMessageValueOfSubscriptionGroups struct {
 	SubscriptionGroups *EventObjectSubscriptionGroups `protobuf:"bytes,64,opt,name=subscriptionGroups,proto3,oneof" json:"subscriptionGroups,omitempty"`
 }
+type EventMessageValueOfSubscriptionFormulas struct {
+	SubscriptionFormulas *EventObjectSubscriptionFormulas `protobuf:"bytes,66,opt,name=subscriptionFormulas,proto3,oneof" json:"subscriptionFormulas,omitempty"`
+}
 type EventMessageValueOfBlockAdd struct {
 	BlockAdd *EventBlockAdd `protobuf:"bytes,2,opt,name=blockAdd,proto3,oneof" json:"blockAdd,omitempty"`
 }
@@ -691,6 +697,9 @@ type EventMessageValueOfChatDelete struct {
 type EventMessageValueOfChatStateUpdate struct {
 	ChatStateUpdate *EventChatUpdateState `protobuf:"bytes,133,opt,name=chatStateUpdate,proto3,oneof" json:"chatStateUpdate,omitempty"`
 }
+type EventMessageValueOfAiChunk struct {
+	AiChunk *EventAIChunk `protobuf:"bytes,137,opt,name=aiChunk,proto3,oneof" json:"aiChunk,omitempty"`
+}
 
 func (*EventMessageValueOfAccountShow) IsEventMessageValue()                    {}
 func (*EventMessageValueOfAccountDetails) IsEventMessageValue()                 {}
@@ -711,6 +720,7 @@ func (*EventMessageValueOfSubscriptionRemove) IsEventMessageValue()
 func (*EventMessageValueOfSubscriptionPosition) IsEventMessageValue()           {}
 func (*EventMessageValueOfSubscriptionCounters) IsEventMessageValue()           {}
 func (*EventMessageValueOfSubscriptionGroups) IsEventMessageValue()             {}
+func (*EventMessageValueOfSubscriptionFormulas) IsEventMessageValue()           {}
 func (*EventMessageValueOfBlockAdd) IsEventMessageValue()                       {}
 func (*EventMessageValueOfBlockDelete) IsEventMessageValue()                    {}
 func (*EventMessageValueOfFilesUpload) IsEventMessageValue()                    {}
@@ -772,6 +782,7 @@ func (*EventMessageValueOfChatUpdateMentionReadStatus) IsEventMessageValue()
 func (*EventMessageValueOfChatUpdateMessageSyncStatus) IsEventMessageValue()    {}
 func (*EventMessageValueOfChatDelete) IsEventMessageValue()                     {}
 func (*EventMessageValueOfChatStateUpdate) IsEventMessageValue()                {}
+func (*EventMessageValueOfAiChunk) IsEventMessageValue()                        {}
 
 func (m *EventMessage) GetValue() IsEventMessageValue {
 	if m != nil {
@@ -920,6 +931,13 @@ func (m *EventMessage) GetSubscriptionGroups() *EventObjectSubscriptionGroups {
 	return nil
 }
 
+func (m *EventMessage) GetSubscriptionFormulas() *EventObjectSubscriptionFormulas {
+	if x, ok := m.GetValue().(*EventMessageValueOfSubscriptionFormulas); ok {
+		return x.SubscriptionFormulas
+	}
+	return nil
+}
+
 func (m *EventMessage) GetBlockAdd() *EventBlockAdd {
 	if x, ok := m.GetValue().(*EventMessageValueOfBlockAdd); ok {
 		return x.BlockAdd
@@ -1347,6 +1365,13 @@ func (m *EventMessage) GetChatStateUpdate() *EventChatUpdateState {
 	return nil
 }
 
+func (m *EventMessage) GetAiChunk() *EventAIChunk {
+	if x, ok := m.GetValue().(*EventMessageValueOfAiChunk); ok {
+		return x.AiChunk
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1369,6 +1394,7 @@ func (*EventMessage) XXX_OneofWrappers() []interface{} {
 		(*EventMessageValueOfSubscriptionPosition)(nil),
 		(*EventMessageValueOfSubscriptionCounters)(nil),
 		(*EventMessageValueOfSubscriptionGroups)(nil),
+		(*EventMessageValueOfSubscriptionFormulas)(nil),
 		(*EventMessageValueOfBlockAdd)(nil),
 		(*EventMessageValueOfBlockDelete)(nil),
 		(*EventMessageValueOfFilesUpload)(nil),
@@ -1430,6 +1456,7 @@ func (*EventMessage) XXX_OneofWrappers() []interface{} {
 		(*EventMessageValueOfChatUpdateMessageSyncStatus)(nil),
 		(*EventMessageValueOfChatDelete)(nil),
 		(*EventMessageValueOfChatStateUpdate)(nil),
+		(*EventMessageValueOfAiChunk)(nil),
 	}
 }
 
@@ -3063,6 +3090,122 @@ func (m *EventObjectSubscriptionGroups) GetRemove() bool {
 	return false
 }
 
+// Results of the formulas requested for the subscription, calculated over all records, not only the current page
+type EventObjectSubscriptionFormulas struct {
+	SubId    string                                    `protobuf:"bytes,1,opt,name=subId,proto3" json:"subId,omitempty"`
+	Formulas []*EventObjectSubscriptionFormulasFormula `protobuf:"bytes,2,rep,name=formulas,proto3" json:"formulas,omitempty"`
+}
+
+func (m *EventObjectSubscriptionFormulas) Reset()         { *m = EventObjectSubscriptionFormulas{} }
+func (m *EventObjectSubscriptionFormulas) String() string { return proto.CompactTextString(m) }
+func (*EventObjectSubscriptionFormulas) ProtoMessage()    {}
+func (*EventObjectSubscriptionFormulas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 1, 5}
+}
+func (m *EventObjectSubscriptionFormulas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObjectSubscriptionFormulas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObjectSubscriptionFormulas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObjectSubscriptionFormulas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObjectSubscriptionFormulas.Merge(m, src)
+}
+func (m *EventObjectSubscriptionFormulas) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObjectSubscriptionFormulas) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObjectSubscriptionFormulas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObjectSubscriptionFormulas proto.InternalMessageInfo
+
+func (m *EventObjectSubscriptionFormulas) GetSubId() string {
+	if m != nil {
+		return m.SubId
+	}
+	return ""
+}
+
+func (m *EventObjectSubscriptionFormulas) GetFormulas() []*EventObjectSubscriptionFormulasFormula {
+	if m != nil {
+		return m.Formulas
+	}
+	return nil
+}
+
+type EventObjectSubscriptionFormulasFormula struct {
+	RelationKey string                                        `protobuf:"bytes,1,opt,name=relationKey,proto3" json:"relationKey,omitempty"`
+	Formula     model.BlockContentDataviewRelationFormulaType `protobuf:"varint,2,opt,name=formula,proto3,enum=anytype.model.BlockContentDataviewRelationFormulaType" json:"formula,omitempty"`
+	// number; null when the formula can't be calculated, e.g. average of a column without numbers
+	Value *types.Value `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EventObjectSubscriptionFormulasFormula) Reset() {
+	*m = EventObjectSubscriptionFormulasFormula{}
+}
+func (m *EventObjectSubscriptionFormulasFormula) String() string { return proto.CompactTextString(m) }
+func (*EventObjectSubscriptionFormulasFormula) ProtoMessage()    {}
+func (*EventObjectSubscriptionFormulasFormula) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 1, 5, 0}
+}
+func (m *EventObjectSubscriptionFormulasFormula) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObjectSubscriptionFormulasFormula) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObjectSubscriptionFormulasFormula.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObjectSubscriptionFormulasFormula) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObjectSubscriptionFormulasFormula.Merge(m, src)
+}
+func (m *EventObjectSubscriptionFormulasFormula) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObjectSubscriptionFormulasFormula) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObjectSubscriptionFormulasFormula.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObjectSubscriptionFormulasFormula proto.InternalMessageInfo
+
+func (m *EventObjectSubscriptionFormulasFormula) GetRelationKey() string {
+	if m != nil {
+		return m.RelationKey
+	}
+	return ""
+}
+
+func (m *EventObjectSubscriptionFormulasFormula) GetFormula() model.BlockContentDataviewRelationFormulaType {
+	if m != nil {
+		return m.Formula
+	}
+	return model.BlockContentDataviewRelation_None
+}
+
+func (m *EventObjectSubscriptionFormulasFormula) GetValue() *types.Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 type EventObjectRelations struct {
 }
 
@@ -8423,18 +8566,21 @@ func (m *EventBlockDataviewViewUpdate) GetFields() *EventBlockDataviewViewUpdate
 }
 
 type EventBlockDataviewViewUpdateFields struct {
-	Type                  model.BlockContentDataviewViewType `protobuf:"varint,1,opt,name=type,proto3,enum=anytype.model.BlockContentDataviewViewType" json:"type,omitempty"`
-	Name                  string                             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CoverRelationKey      string                             `protobuf:"bytes,3,opt,name=coverRelationKey,proto3" json:"coverRelationKey,omitempty"`
-	HideIcon              bool                               `protobuf:"varint,4,opt,name=hideIcon,proto3" json:"hideIcon,omitempty"`
-	CardSize              model.BlockContentDataviewViewSize `protobuf:"varint,5,opt,name=cardSize,proto3,enum=anytype.model.BlockContentDataviewViewSize" json:"cardSize,omitempty"`
-	CoverFit              bool                               `protobuf:"varint,6,opt,name=coverFit,proto3" json:"coverFit,omitempty"`
-	GroupRelationKey      string                             `protobuf:"bytes,7,opt,name=groupRelationKey,proto3" json:"groupRelationKey,omitempty"`
-	EndRelationKey        string                             `protobuf:"bytes,16,opt,name=endRelationKey,proto3" json:"endRelationKey,omitempty"`
-	GroupBackgroundColors bool                               `protobuf:"varint,8,opt,name=groupBackgroundColors,proto3" json:"groupBackgroundColors,omitempty"`
-	PageLimit             int32                              `protobuf:"varint,9,opt,name=pageLimit,proto3" json:"pageLimit,omitempty"`
-	DefaultTemplateId     string                             `protobuf:"bytes,10,opt,name=defaultTemplateId,proto3" json:"defaultTemplateId,omitempty"`
-	DefaultObjectTypeId   string                             `protobuf:"bytes,15,opt,name=defaultObjectTypeId,proto3" json:"defaultObjectTypeId,omitempty"`
+	Type                  model.BlockContentDataviewViewType   `protobuf:"varint,1,opt,name=type,proto3,enum=anytype.model.BlockContentDataviewViewType" json:"type,omitempty"`
+	Name                  string                               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CoverRelationKey      string                               `protobuf:"bytes,3,opt,name=coverRelationKey,proto3" json:"coverRelationKey,omitempty"`
+	HideIcon              bool                                 `protobuf:"varint,4,opt,name=hideIcon,proto3" json:"hideIcon,omitempty"`
+	CardSize              model.BlockContentDataviewViewSize   `protobuf:"varint,5,opt,name=cardSize,proto3,enum=anytype.model.BlockContentDataviewViewSize" json:"cardSize,omitempty"`
+	CoverFit              bool                                 `protobuf:"varint,6,opt,name=coverFit,proto3" json:"coverFit,omitempty"`
+	GroupRelationKey      string                               `protobuf:"bytes,7,opt,name=groupRelationKey,proto3" json:"groupRelationKey,omitempty"`
+	EndRelationKey        string                               `protobuf:"bytes,16,opt,name=endRelationKey,proto3" json:"endRelationKey,omitempty"`
+	StartRelationKey      string                               `protobuf:"bytes,17,opt,name=startRelationKey,proto3" json:"startRelationKey,omitempty"`
+	GroupDateBucket       model.BlockContentDataviewDateBucket `protobuf:"varint,18,opt,name=groupDateBucket,proto3,enum=anytype.model.BlockContentDataviewDateBucket" json:"groupDateBucket,omitempty"`
+	GroupNumberRanges     []float64                            `protobuf:"fixed64,19,rep,packed,name=groupNumberRanges,proto3" json:"groupNumberRanges,omitempty"`
+	GroupBackgroundColors bool                                 `protobuf:"varint,8,opt,name=groupBackgroundColors,proto3" json:"groupBackgroundColors,omitempty"`
+	PageLimit             int32                                `protobuf:"varint,9,opt,name=pageLimit,proto3" json:"pageLimit,omitempty"`
+	DefaultTemplateId     string                               `protobuf:"bytes,10,opt,name=defaultTemplateId,proto3" json:"defaultTemplateId,omitempty"`
+	DefaultObjectTypeId   string                               `protobuf:"bytes,15,opt,name=defaultObjectTypeId,proto3" json:"defaultObjectTypeId,omitempty"`
 }
 
 func (m *EventBlockDataviewViewUpdateFields) Reset()         { *m = EventBlockDataviewViewUpdateFields{} }
@@ -8526,6 +8672,27 @@ func (m *EventBlockDataviewViewUpdateFields) GetEndRelationKey() string {
 	return ""
 }
 
+func (m *EventBlockDataviewViewUpdateFields) GetStartRelationKey() string {
+	if m != nil {
+		return m.StartRelationKey
+	}
+	return ""
+}
+
+func (m *EventBlockDataviewViewUpdateFields) GetGroupDateBucket() model.BlockContentDataviewDateBucket {
+	if m != nil {
+		return m.GroupDateBucket
+	}
+	return model.BlockContentDataviewDate_Day
+}
+
+func (m *EventBlockDataviewViewUpdateFields) GetGroupNumberRanges() []float64 {
+	if m != nil {
+		return m.GroupNumberRanges
+	}
+	return nil
+}
+
 func (m *EventBlockDataviewViewUpdateFields) GetGroupBackgroundColors() bool {
 	if m != nil {
 		return m.GroupBackgroundColors
@@ -12047,6 +12214,96 @@ func (m *EventImportFinish) GetImportType() model.ImportType {
 	return model.Import_Notion
 }
 
+type EventAI struct {
+}
+
+func (m *EventAI) Reset()         { *m = EventAI{} }
+func (m *EventAI) String() string { return proto.CompactTextString(m) }
+func (*EventAI) ProtoMessage()    {}
+func (*EventAI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 16}
+}
+func (m *EventAI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAI.Merge(m, src)
+}
+func (m *EventAI) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAI) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAI proto.InternalMessageInfo
+
+// Chunk is the piece of the answer of the AI request with the streamId, in the order it arrives from the
+// provider. The response of the request contains the whole cleaned answer
+type EventAIChunk struct {
+	StreamId string `protobuf:"bytes,1,opt,name=streamId,proto3" json:"streamId,omitempty"`
+	Text     string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (m *EventAIChunk) Reset()         { *m = EventAIChunk{} }
+func (m *EventAIChunk) String() string { return proto.CompactTextString(m) }
+func (*EventAIChunk) ProtoMessage()    {}
+func (*EventAIChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 16, 0}
+}
+func (m *EventAIChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAIChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAIChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAIChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAIChunk.Merge(m, src)
+}
+func (m *EventAIChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAIChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAIChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAIChunk proto.InternalMessageInfo
+
+func (m *EventAIChunk) GetStreamId() string {
+	if m != nil {
+		return m.StreamId
+	}
+	return ""
+}
+
+func (m *EventAIChunk) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
 type ResponseEvent struct {
 	Messages  []*EventMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	ContextId string          `protobuf:"bytes,2,opt,name=contextId,proto3" json:"contextId,omitempty"`
@@ -12588,6 +12845,8 @@ func init() {
 	proto.RegisterType((*EventObjectSubscriptionPosition)(nil), "anytype.Event.Object.Subscription.Position")
 	proto.RegisterType((*EventObjectSubscriptionCounters)(nil), "anytype.Event.Object.Subscription.Counters")
 	proto.RegisterType((*EventObjectSubscriptionGroups)(nil), "anytype.Event.Object.Subscription.Groups")
+	proto.RegisterType((*EventObjectSubscriptionFormulas)(nil), "anytype.Event.Object.Subscription.Formulas")
+	proto.RegisterType((*EventObjectSubscriptionFormulasFormula)(nil), "anytype.Event.Object.Subscription.Formulas.Formula")
 	proto.RegisterType((*EventObjectRelations)(nil), "anytype.Event.Object.Relations")
 	proto.RegisterType((*EventObjectRelationsAmend)(nil), "anytype.Event.Object.Relations.Amend")
 	proto.RegisterType((*EventObjectRelationsRemove)(nil), "anytype.Event.Object.Relations.Remove")
@@ -12761,6 +13020,8 @@ func init() {
 	proto.RegisterType((*EventP2PStatusUpdate)(nil), "anytype.Event.P2PStatus.Update")
 	proto.RegisterType((*EventImport)(nil), "anytype.Event.Import")
 	proto.RegisterType((*EventImportFinish)(nil), "anytype.Event.Import.Finish")
+	proto.RegisterType((*EventAI)(nil), "anytype.Event.AI")
+	proto.RegisterType((*EventAIChunk)(nil), "anytype.Event.AI.Chunk")
 	proto.RegisterType((*ResponseEvent)(nil), "anytype.ResponseEvent")
 	proto.RegisterType((*Model)(nil), "anytype.Model")
 	proto.RegisterType((*ModelProcess)(nil), "anytype.Model.Process")
@@ -12775,414 +13036,425 @@ func init() {
 func init() { proto.RegisterFile("pb/protos/events.proto", fileDescriptor_a966342d378ae5f5) }
 
 var fileDescriptor_a966342d378ae5f5 = []byte{
-	// 6503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x5b, 0x8c, 0x1d, 0xc9,
-	0x59, 0x3e, 0xf7, 0xcb, 0x3f, 0xe3, 0xf1, 0x71, 0xf9, 0xd6, 0xdb, 0xeb, 0xf5, 0x7a, 0x67, 0x6d,
-	0xaf, 0xb3, 0xeb, 0x3d, 0xf6, 0xfa, 0xba, 0xf1, 0xae, 0x2f, 0x73, 0xf3, 0x9e, 0xe3, 0xcb, 0x78,
-	0x52, 0x63, 0x3b, 0x9b, 0x4d, 0x04, 0xe9, 0x39, 0x5d, 0x33, 0xd3, 0xf1, 0x99, 0xee, 0x93, 0xee,
-	0x9e, 0xb1, 0x27, 0x17, 0x08, 0xb9, 0x10, 0x90, 0x40, 0x20, 0x84, 0x08, 0x82, 0x07, 0x24, 0x04,
-	0xe2, 0x05, 0x21, 0x24, 0xa4, 0x08, 0x78, 0x40, 0x08, 0x84, 0x20, 0x01, 0xa4, 0x44, 0xe2, 0x81,
-	0x17, 0x48, 0xb4, 0x79, 0xe1, 0x85, 0x07, 0x78, 0x40, 0x3c, 0xa2, 0xba, 0x74, 0x75, 0x55, 0x5f,
-	0x4e, 0x9f, 0xc9, 0x6e, 0xb8, 0x88, 0x3c, 0xcd, 0xa9, 0xaa, 0xff, 0xff, 0xfe, 0xba, 0xfc, 0xff,
-	0x5f, 0x55, 0x7f, 0x55, 0xd7, 0xc0, 0x91, 0xd1, 0xda, 0xb9, 0x91, 0xef, 0x85, 0x5e, 0x70, 0x8e,
-	0xec, 0x10, 0x37, 0x0c, 0xba, 0x2c, 0x85, 0x9a, 0x96, 0xbb, 0x1b, 0xee, 0x8e, 0x88, 0x79, 0x72,
-	0xf4, 0x64, 0xe3, 0xdc, 0xd0, 0x59, 0x3b, 0x37, 0x5a, 0x3b, 0xb7, 0xe5, 0xd9, 0x64, 0x18, 0x91,
-	0xb3, 0x84, 0x20, 0x37, 0x8f, 0x6d, 0x78, 0xde, 0xc6, 0x90, 0xf0, 0xb2, 0xb5, 0xed, 0xf5, 0x73,
-	0x41, 0xe8, 0x6f, 0x0f, 0x42, 0x5e, 0x3a, 0xfb, 0xcd, 0x6f, 0x97, 0xa1, 0xbe, 0x44, 0xe1, 0xd1,
-	0x05, 0x68, 0x6d, 0x91, 0x20, 0xb0, 0x36, 0x48, 0x60, 0x94, 0x4f, 0x54, 0xcf, 0x4c, 0x5d, 0x38,
-	0xd2, 0x15, 0xa2, 0xba, 0x8c, 0xa2, 0x7b, 0x9f, 0x17, 0x63, 0x49, 0x87, 0x8e, 0x41, 0x7b, 0xe0,
-	0xb9, 0x21, 0x79, 0x16, 0xf6, 0x6d, 0xa3, 0x72, 0xa2, 0x7c, 0xa6, 0x8d, 0xe3, 0x0c, 0x74, 0x09,
-	0xda, 0x8e, 0xeb, 0x84, 0x8e, 0x15, 0x7a, 0xbe, 0x51, 0x3d, 0x51, 0xd6, 0x20, 0x59, 0x25, 0xbb,
-	0x73, 0x83, 0x81, 0xb7, 0xed, 0x86, 0x38, 0x26, 0x44, 0x06, 0x34, 0x43, 0xdf, 0x1a, 0x90, 0xbe,
-	0x6d, 0xd4, 0x18, 0x62, 0x94, 0x34, 0xbf, 0x75, 0x19, 0x9a, 0xa2, 0x0e, 0xe8, 0x39, 0x68, 0x06,
-	0x23, 0x4e, 0xf5, 0xd5, 0x32, 0x27, 0x13, 0x69, 0x74, 0x13, 0xa6, 0x2c, 0x0e, 0xbb, 0xba, 0xe9,
-	0x3d, 0x35, 0xca, 0x4c, 0xf0, 0xf3, 0x89, 0xb6, 0x08, 0xc1, 0x5d, 0x4a, 0xd2, 0x2b, 0x61, 0x95,
-	0x03, 0xf5, 0x61, 0x46, 0x24, 0x17, 0x49, 0x68, 0x39, 0xc3, 0xc0, 0xf8, 0x16, 0x07, 0x39, 0x9e,
-	0x03, 0x22, 0xc8, 0x7a, 0x25, 0x9c, 0x60, 0x44, 0x9f, 0x80, 0x83, 0x22, 0x67, 0xc1, 0x73, 0xd7,
-	0x9d, 0x8d, 0x47, 0x23, 0xdb, 0x0a, 0x89, 0xf1, 0x6d, 0x8e, 0x77, 0x32, 0x07, 0x8f, 0xd3, 0x76,
-	0x39, 0x71, 0xaf, 0x84, 0xb3, 0x30, 0xd0, 0x6d, 0xd8, 0x27, 0xb2, 0x05, 0xe8, 0xdf, 0x72, 0xd0,
-	0x17, 0x72, 0x40, 0x25, 0x9a, 0xce, 0x86, 0x3e, 0x09, 0x87, 0x44, 0xc6, 0x3d, 0xc7, 0x7d, 0xb2,
-	0xb0, 0x69, 0x0d, 0x87, 0xc4, 0xdd, 0x20, 0xc6, 0xdf, 0x8d, 0xaf, 0xa3, 0x46, 0xdc, 0x2b, 0xe1,
-	0x4c, 0x10, 0xb4, 0x01, 0x46, 0x56, 0x7e, 0xcf, 0xb1, 0x89, 0xf1, 0xf7, 0x5c, 0xc0, 0x99, 0x89,
-	0x04, 0x38, 0x36, 0x15, 0x92, 0x0b, 0x86, 0x1e, 0x40, 0xc7, 0x5b, 0xfb, 0x0c, 0x19, 0x44, 0x3d,
-	0xbf, 0x4a, 0x42, 0xa3, 0xc3, 0xf0, 0x5f, 0x4a, 0xe0, 0x3f, 0x60, 0x64, 0xd1, 0x98, 0x75, 0x57,
-	0x49, 0xd8, 0x2b, 0xe1, 0x14, 0x33, 0x7a, 0x04, 0x48, 0xcb, 0x9b, 0xdb, 0x22, 0xae, 0x6d, 0x5c,
-	0x60, 0x90, 0x2f, 0x8f, 0x87, 0x64, 0xa4, 0xbd, 0x12, 0xce, 0x00, 0x48, 0xc1, 0x3e, 0x72, 0x03,
-	0x12, 0x1a, 0x17, 0x27, 0x81, 0x65, 0xa4, 0x29, 0x58, 0x96, 0x4b, 0x07, 0x91, 0xe7, 0x62, 0x32,
-	0xb4, 0x42, 0xc7, 0x73, 0x45, 0x7d, 0x2f, 0x31, 0xe0, 0x53, 0xd9, 0xc0, 0x92, 0x56, 0xd6, 0x38,
-	0x13, 0x04, 0xfd, 0x04, 0x1c, 0x4e, 0xe4, 0x63, 0xb2, 0xe5, 0xed, 0x10, 0xe3, 0x32, 0x43, 0x3f,
-	0x5d, 0x84, 0xce, 0xa9, 0x7b, 0x25, 0x9c, 0x0d, 0x83, 0xe6, 0x61, 0x3a, 0x2a, 0x60, 0xb0, 0x57,
-	0x18, 0xec, 0xb1, 0x3c, 0x58, 0x01, 0xa6, 0xf1, 0x50, 0xa3, 0xe7, 0xe9, 0x85, 0xa1, 0x17, 0x10,
-	0x63, 0x2e, 0xd3, 0xe8, 0x05, 0x04, 0x23, 0xa1, 0x46, 0xaf, 0x70, 0xa8, 0x8d, 0x0c, 0x42, 0xdf,
-	0x19, 0xb0, 0x0a, 0x52, 0x2d, 0xba, 0x3a, 0xbe, 0x91, 0x31, 0xb1, 0x50, 0xa5, 0x6c, 0x18, 0x84,
-	0x61, 0x7f, 0xb0, 0xbd, 0x16, 0x0c, 0x7c, 0x67, 0x44, 0xf3, 0xe6, 0x6c, 0xdb, 0x78, 0x7b, 0x1c,
-	0xf2, 0xaa, 0x42, 0xdc, 0x9d, 0xb3, 0xe9, 0xe8, 0x24, 0x01, 0xd0, 0x27, 0x01, 0xa9, 0x59, 0xa2,
-	0xfb, 0xae, 0x33, 0xd8, 0x8f, 0x4c, 0x00, 0x2b, 0xfb, 0x32, 0x03, 0x06, 0x59, 0x70, 0x48, 0xcd,
-	0x5d, 0xf1, 0x02, 0x87, 0xfe, 0x35, 0x6e, 0x30, 0xf8, 0xd7, 0x26, 0x80, 0x8f, 0x58, 0xa8, 0x62,
-	0x65, 0x41, 0x25, 0x45, 0x2c, 0x50, 0xd3, 0x26, 0x7e, 0x60, 0xdc, 0x9c, 0x58, 0x44, 0xc4, 0x92,
-	0x14, 0x11, 0xe5, 0x27, 0xbb, 0xe8, 0x1d, 0xdf, 0xdb, 0x1e, 0x05, 0xc6, 0xad, 0x89, 0xbb, 0x88,
-	0x33, 0x24, 0xbb, 0x88, 0xe7, 0xa2, 0x2b, 0xd0, 0x5a, 0x1b, 0x7a, 0x83, 0x27, 0x74, 0x30, 0x2b,
-	0x0c, 0xd2, 0x48, 0x40, 0xce, 0xd3, 0x62, 0x31, 0x7c, 0x92, 0x96, 0x2a, 0x2b, 0xfb, 0xbd, 0x48,
-	0x86, 0x24, 0x24, 0x62, 0x6a, 0x7c, 0x3e, 0x93, 0x95, 0x93, 0x50, 0x65, 0x55, 0x38, 0xd0, 0x22,
-	0x4c, 0xad, 0x3b, 0x43, 0x12, 0x3c, 0x1a, 0x0d, 0x3d, 0x8b, 0xcf, 0x93, 0x53, 0x17, 0x4e, 0x64,
-	0x02, 0xdc, 0x8e, 0xe9, 0x28, 0x8a, 0xc2, 0x86, 0x6e, 0x40, 0x7b, 0xcb, 0xf2, 0x9f, 0x04, 0x7d,
-	0x77, 0xdd, 0x33, 0xea, 0x99, 0x33, 0x1c, 0xc7, 0xb8, 0x1f, 0x51, 0xf5, 0x4a, 0x38, 0x66, 0xa1,
-	0xf3, 0x24, 0xab, 0xd4, 0x2a, 0x09, 0x6f, 0x3b, 0x64, 0x68, 0x07, 0x46, 0x83, 0x81, 0xbc, 0x98,
-	0x09, 0xb2, 0x4a, 0xc2, 0x2e, 0x27, 0xa3, 0xf3, 0xa4, 0xce, 0x88, 0xde, 0x85, 0x83, 0x51, 0xce,
-	0xc2, 0xa6, 0x33, 0xb4, 0x7d, 0xe2, 0xf6, 0xed, 0xc0, 0x68, 0x66, 0x4e, 0x41, 0x31, 0x9e, 0x42,
-	0x4b, 0xa7, 0xc9, 0x0c, 0x08, 0xea, 0x19, 0xa3, 0x6c, 0xd5, 0x24, 0x8d, 0x56, 0xa6, 0x67, 0x8c,
-	0xa1, 0x55, 0x62, 0xaa, 0x5d, 0x59, 0x20, 0xc8, 0x86, 0xa3, 0x51, 0xfe, 0xbc, 0x35, 0x78, 0xb2,
-	0xe1, 0x7b, 0xdb, 0xae, 0xbd, 0xe0, 0x0d, 0x3d, 0xdf, 0x68, 0x67, 0x4e, 0x6e, 0x31, 0x7e, 0x82,
-	0xbe, 0x57, 0xc2, 0x79, 0x50, 0x68, 0x01, 0xa6, 0xa3, 0xa2, 0x87, 0xe4, 0x59, 0x68, 0x40, 0xe6,
-	0x3c, 0x1f, 0x43, 0x53, 0x22, 0xea, 0x20, 0x55, 0x26, 0x15, 0x84, 0xaa, 0x84, 0x31, 0x55, 0x00,
-	0x42, 0x89, 0x54, 0x10, 0x9a, 0x56, 0x41, 0xe8, 0x14, 0x6c, 0xec, 0x2b, 0x00, 0xa1, 0x44, 0x2a,
-	0x08, 0x4d, 0xd3, 0xa9, 0x5a, 0xb6, 0xd4, 0xf3, 0x9e, 0x50, 0x7d, 0x32, 0x66, 0x32, 0xa7, 0x6a,
-	0xa5, 0xb7, 0x04, 0x21, 0x9d, 0xaa, 0x93, 0xcc, 0x74, 0x25, 0x14, 0xe5, 0xcd, 0x0d, 0x9d, 0x0d,
-	0xd7, 0xd8, 0x3f, 0x46, 0x97, 0x29, 0x1a, 0xa3, 0xa2, 0x2b, 0x21, 0x8d, 0x0d, 0xdd, 0x12, 0x66,
-	0xb9, 0x4a, 0xc2, 0x45, 0x67, 0xc7, 0x38, 0x90, 0x39, 0x0d, 0xc5, 0x28, 0x8b, 0xce, 0x8e, 0xb4,
-	0x4b, 0xce, 0xa2, 0x36, 0x2d, 0x9a, 0xe4, 0x8c, 0xc3, 0x05, 0x4d, 0x8b, 0x08, 0xd5, 0xa6, 0x45,
-	0x79, 0x6a, 0xd3, 0xee, 0x59, 0x21, 0x79, 0x66, 0x3c, 0x57, 0xd0, 0x34, 0x46, 0xa5, 0x36, 0x8d,
-	0x65, 0xd0, 0xd9, 0x2d, 0xca, 0x78, 0x4c, 0xfc, 0xd0, 0x19, 0x58, 0x43, 0xde, 0x55, 0x27, 0x33,
-	0xe7, 0xa0, 0x18, 0x4f, 0xa3, 0xa6, 0xb3, 0x5b, 0x26, 0x8c, 0xda, 0xf0, 0x87, 0xd6, 0xda, 0x90,
-	0x60, 0xef, 0xa9, 0x71, 0xaa, 0xa0, 0xe1, 0x11, 0xa1, 0xda, 0xf0, 0x28, 0x4f, 0xf5, 0x2d, 0x1f,
-	0x77, 0xec, 0x0d, 0x12, 0x1a, 0x67, 0x0a, 0x7c, 0x0b, 0x27, 0x53, 0x7d, 0x0b, 0xcf, 0x91, 0x1e,
-	0x60, 0xd1, 0x0a, 0xad, 0x1d, 0x87, 0x3c, 0x7d, 0xec, 0x90, 0xa7, 0x74, 0x62, 0x3f, 0x38, 0xc6,
-	0x03, 0x44, 0xb4, 0x5d, 0x41, 0x2c, 0x3d, 0x40, 0x02, 0x44, 0x7a, 0x00, 0x35, 0x5f, 0xb8, 0xf5,
-	0x43, 0x63, 0x3c, 0x80, 0x86, 0x2f, 0x7d, 0x7c, 0x1e, 0x14, 0xb2, 0xe0, 0x48, 0xaa, 0xe8, 0x81,
-	0x6f, 0x13, 0xdf, 0x78, 0x81, 0x09, 0x79, 0xa5, 0x58, 0x08, 0x23, 0xef, 0x95, 0x70, 0x0e, 0x50,
-	0x4a, 0xc4, 0xaa, 0xb7, 0xed, 0x0f, 0x08, 0xed, 0xa7, 0x97, 0x27, 0x11, 0x21, 0xc9, 0x53, 0x22,
-	0x64, 0x09, 0xda, 0x81, 0x17, 0x64, 0x09, 0x15, 0xcc, 0x66, 0x51, 0x26, 0x5d, 0xec, 0x60, 0x4e,
-	0x33, 0x49, 0xdd, 0xf1, 0x92, 0x92, 0x5c, 0xbd, 0x12, 0x1e, 0x0f, 0x8b, 0x76, 0xe1, 0xb8, 0x46,
-	0xc0, 0xe7, 0x79, 0x55, 0xf0, 0x2b, 0x4c, 0xf0, 0xb9, 0xf1, 0x82, 0x53, 0x6c, 0xbd, 0x12, 0x2e,
-	0x00, 0x46, 0x23, 0x78, 0x5e, 0xeb, 0x8c, 0xc8, 0xb0, 0x85, 0x8a, 0x7c, 0x81, 0xc9, 0x3d, 0x3b,
-	0x5e, 0xae, 0xce, 0xd3, 0x2b, 0xe1, 0x71, 0x90, 0x74, 0xc7, 0x95, 0x59, 0x4c, 0x47, 0xf2, 0xf3,
-	0x99, 0xcb, 0x9e, 0x1c, 0x71, 0x7c, 0x2c, 0x73, 0xc1, 0x32, 0x35, 0x5f, 0x74, 0xe7, 0x17, 0x27,
-	0xd5, 0x7c, 0xd9, 0x8f, 0x79, 0x50, 0xda, 0xd8, 0xd1, 0xa2, 0x87, 0x96, 0xbf, 0x41, 0x42, 0xde,
-	0xd1, 0x7d, 0x9b, 0x36, 0xea, 0xa7, 0x26, 0x19, 0xbb, 0x14, 0x9b, 0x36, 0x76, 0x99, 0xc0, 0x28,
-	0x80, 0x63, 0x1a, 0x45, 0x3f, 0x58, 0xf0, 0x86, 0x43, 0x32, 0x88, 0x7a, 0xf3, 0xa7, 0x99, 0xe0,
-	0xd7, 0xc7, 0x0b, 0x4e, 0x30, 0xf5, 0x4a, 0x78, 0x2c, 0x68, 0xaa, 0xbd, 0x0f, 0x86, 0x76, 0x42,
-	0x67, 0x8c, 0x89, 0x74, 0x35, 0xc9, 0x96, 0x6a, 0x6f, 0x8a, 0x22, 0xa5, 0xab, 0x0a, 0x05, 0x6d,
-	0xee, 0xd1, 0x49, 0x74, 0x55, 0xe7, 0x49, 0xe9, 0xaa, 0x5e, 0x4c, 0x67, 0xb7, 0xed, 0x80, 0xf8,
-	0x0c, 0xe3, 0x8e, 0xe7, 0xb8, 0xc6, 0x8b, 0x99, 0xb3, 0xdb, 0xa3, 0x80, 0xf8, 0x42, 0x10, 0xa5,
-	0xa2, 0xb3, 0x9b, 0xc6, 0xa6, 0xe1, 0xdc, 0x23, 0xeb, 0xa1, 0x71, 0xa2, 0x08, 0x87, 0x52, 0x69,
-	0x38, 0x34, 0x83, 0xce, 0x14, 0x32, 0x63, 0x95, 0xd0, 0x51, 0xc1, 0x96, 0xbb, 0x41, 0x8c, 0x97,
-	0x32, 0x67, 0x0a, 0x05, 0x4e, 0x21, 0xa6, 0x33, 0x45, 0x16, 0x08, 0xdd, 0xf9, 0xcb, 0x7c, 0xba,
-	0x22, 0xe3, 0xd0, 0xb3, 0x99, 0x3b, 0x7f, 0x05, 0x5a, 0x92, 0xd2, 0x3d, 0x48, 0x1a, 0x00, 0x7d,
-	0x04, 0x6a, 0x23, 0xc7, 0xdd, 0x30, 0x6c, 0x06, 0x74, 0x30, 0x01, 0xb4, 0xe2, 0xb8, 0x1b, 0xbd,
-	0x12, 0x66, 0x24, 0xe8, 0x6d, 0x80, 0x91, 0xef, 0x0d, 0x48, 0x10, 0x2c, 0x93, 0xa7, 0x06, 0x61,
-	0x0c, 0x66, 0x92, 0x81, 0x13, 0x74, 0x97, 0x09, 0x9d, 0x97, 0x15, 0x7a, 0xb4, 0x04, 0xfb, 0x44,
-	0x4a, 0x58, 0xf9, 0x7a, 0xe6, 0xe2, 0x2f, 0x02, 0x88, 0xc3, 0x4d, 0x1a, 0x17, 0xdd, 0xfb, 0x88,
-	0x8c, 0x45, 0xcf, 0x25, 0xc6, 0x46, 0xe6, 0xde, 0x27, 0x02, 0xa1, 0x24, 0x74, 0x8d, 0xa5, 0x70,
-	0xa0, 0x79, 0x98, 0x0e, 0x37, 0x7d, 0x62, 0xd9, 0xab, 0xa1, 0x15, 0x6e, 0x07, 0x86, 0x9b, 0xb9,
-	0x4c, 0xe3, 0x85, 0xdd, 0x87, 0x8c, 0x92, 0x2e, 0x41, 0x55, 0x1e, 0xb4, 0x0c, 0x1d, 0xba, 0x11,
-	0xba, 0xe7, 0x6c, 0x39, 0x21, 0x26, 0xd6, 0x60, 0x93, 0xd8, 0x86, 0x97, 0xb9, 0x89, 0xa2, 0xcb,
-	0xde, 0xae, 0x4a, 0x47, 0x57, 0x2b, 0x49, 0x5e, 0xd4, 0x83, 0x19, 0x9a, 0xb7, 0x3a, 0xb2, 0x06,
-	0xe4, 0x51, 0x60, 0x6d, 0x10, 0x63, 0x94, 0xa9, 0x81, 0x0c, 0x2d, 0xa6, 0xa2, 0x8b, 0x15, 0x9d,
-	0x2f, 0x42, 0xba, 0xe7, 0x0d, 0xac, 0x21, 0x47, 0xfa, 0x6c, 0x3e, 0x52, 0x4c, 0x15, 0x21, 0xc5,
-	0x39, 0x5a, 0x1b, 0x79, 0xdf, 0xdb, 0xc6, 0x4e, 0x41, 0x1b, 0x05, 0x9d, 0xd6, 0x46, 0x91, 0x47,
-	0xf1, 0x5c, 0x2f, 0x74, 0xd6, 0x9d, 0x81, 0xb0, 0x5f, 0xd7, 0x36, 0xfc, 0x4c, 0xbc, 0x65, 0x85,
-	0xac, 0xbb, 0xca, 0x23, 0x4b, 0x29, 0x5e, 0xf4, 0x10, 0x90, 0x9a, 0x27, 0x94, 0x2a, 0x60, 0x88,
-	0xb3, 0xe3, 0x10, 0xa5, 0x66, 0x65, 0xf0, 0xd3, 0x5a, 0x8e, 0xac, 0x5d, 0xba, 0xbd, 0x9d, 0xf7,
-	0x3d, 0xcb, 0x1e, 0x58, 0x41, 0x68, 0x84, 0x99, 0xb5, 0x5c, 0xe1, 0x64, 0x5d, 0x49, 0x47, 0x6b,
-	0x99, 0xe4, 0xa5, 0x78, 0x5b, 0x64, 0x6b, 0x8d, 0xf8, 0xc1, 0xa6, 0x33, 0x12, 0x75, 0xdc, 0xce,
-	0xc4, 0xbb, 0x2f, 0xc9, 0xe2, 0x1a, 0xa6, 0x78, 0xe9, 0x42, 0x9c, 0xc5, 0xa9, 0x57, 0x77, 0xdd,
-	0x01, 0x57, 0x46, 0x01, 0xfa, 0x34, 0x73, 0x21, 0xce, 0x34, 0xa3, 0x1b, 0x13, 0xc7, 0xd0, 0xd9,
-	0x30, 0xe8, 0x3d, 0x38, 0xc4, 0x0a, 0xe6, 0xb6, 0x43, 0x8f, 0xaf, 0x7f, 0xe7, 0x6c, 0x9b, 0xd8,
-	0xc6, 0xe7, 0x32, 0x77, 0xd2, 0x1c, 0x3e, 0x41, 0xcb, 0x62, 0x29, 0x19, 0x18, 0xe8, 0x2e, 0xec,
-	0x1f, 0x5d, 0x18, 0x69, 0xb5, 0x7e, 0x96, 0xb9, 0x28, 0x5f, 0xb9, 0xb0, 0x92, 0xac, 0x6e, 0x92,
-	0x93, 0x9a, 0xb1, 0xb3, 0x35, 0xf2, 0xfc, 0xf0, 0xb6, 0xe3, 0x3a, 0xc1, 0xa6, 0xb1, 0x9b, 0x69,
-	0xc6, 0x7d, 0x46, 0xd2, 0xe5, 0x34, 0xd4, 0x8c, 0x55, 0x1e, 0x74, 0x09, 0x9a, 0x83, 0x4d, 0x8b,
-	0xd6, 0xce, 0xf8, 0x12, 0x0f, 0x26, 0x1f, 0x4d, 0xf0, 0x2f, 0x6c, 0x5a, 0xa1, 0x08, 0xbf, 0x44,
-	0xa4, 0xe8, 0x3a, 0x00, 0xfd, 0x29, 0x5a, 0xf0, 0x33, 0xe5, 0x4c, 0x3f, 0xc8, 0x18, 0x65, 0xed,
-	0x15, 0x06, 0xf4, 0x2e, 0x1c, 0x8c, 0x53, 0xd4, 0x01, 0xf0, 0x78, 0xc2, 0x97, 0xcb, 0x99, 0x9e,
-	0x5c, 0xc1, 0x91, 0xb4, 0xbd, 0x12, 0xce, 0x82, 0xa0, 0x13, 0x70, 0x9c, 0x1d, 0x1d, 0xb6, 0xc4,
-	0x8e, 0xee, 0x67, 0xcb, 0x99, 0x61, 0x31, 0x45, 0x42, 0x8a, 0x87, 0x4e, 0xc0, 0x63, 0x20, 0x93,
-	0x12, 0x5d, 0x1e, 0xfe, 0x93, 0x12, 0xbf, 0x3e, 0x81, 0xc4, 0x04, 0x4f, 0x52, 0x62, 0xa2, 0x38,
-	0xb3, 0x8d, 0xb1, 0x12, 0x1b, 0x3f, 0x37, 0x69, 0x1b, 0x63, 0x9e, 0xcc, 0x36, 0xc6, 0xc5, 0xd1,
-	0x70, 0x8b, 0xd5, 0xd3, 0x57, 0xc6, 0x0c, 0xb7, 0x5c, 0x29, 0x29, 0x0c, 0xe8, 0x1e, 0xec, 0xa7,
-	0x29, 0x0a, 0x46, 0x84, 0xca, 0x7c, 0xad, 0x9c, 0xa9, 0xf5, 0x4a, 0x25, 0x19, 0x35, 0xd5, 0xfa,
-	0x04, 0xeb, 0x7c, 0x13, 0xea, 0x3b, 0xd6, 0x70, 0x9b, 0x98, 0xff, 0xde, 0x80, 0x1a, 0x65, 0x30,
-	0xff, 0xb9, 0x0c, 0x55, 0xaa, 0x95, 0x33, 0x50, 0x71, 0x6c, 0x83, 0x1f, 0x65, 0x55, 0x1c, 0x1b,
-	0x19, 0xd0, 0xf4, 0xe8, 0x46, 0x42, 0x1e, 0xac, 0x45, 0x49, 0x34, 0x0b, 0xd3, 0xd6, 0x7a, 0x48,
-	0xfc, 0x07, 0xa2, 0xb8, 0xc1, 0x8a, 0xb5, 0x3c, 0x6a, 0x19, 0xe2, 0x90, 0x4e, 0x44, 0x17, 0xcd,
-	0xc4, 0xc1, 0x1b, 0x95, 0x1d, 0xe9, 0x43, 0x44, 0x8a, 0x8e, 0x40, 0x23, 0xd8, 0x5e, 0xeb, 0xdb,
-	0x81, 0x51, 0x3b, 0x51, 0x3d, 0xd3, 0xc6, 0x22, 0x85, 0xde, 0x82, 0x69, 0x9b, 0x8c, 0x88, 0x6b,
-	0x13, 0x77, 0xe0, 0x90, 0xc0, 0xa8, 0xb3, 0xe3, 0xc1, 0xa3, 0x5d, 0x7e, 0xb4, 0xd8, 0x8d, 0x8e,
-	0x16, 0xbb, 0xab, 0xec, 0x68, 0x11, 0x6b, 0xc4, 0xe6, 0x79, 0x68, 0x88, 0xae, 0x4c, 0x36, 0x31,
-	0x16, 0x57, 0x51, 0xc5, 0x99, 0xeb, 0xd0, 0x10, 0xb6, 0x96, 0xe4, 0x50, 0x9a, 0x55, 0xf9, 0x61,
-	0x9a, 0x55, 0xd5, 0xe4, 0x7c, 0x11, 0xf6, 0x27, 0x4d, 0x30, 0x29, 0x70, 0x1e, 0xda, 0xbe, 0x34,
-	0xf1, 0x4a, 0xc2, 0x87, 0xa6, 0x44, 0x76, 0x25, 0x10, 0x8e, 0xd9, 0x72, 0xc5, 0x7f, 0x12, 0x8e,
-	0xe6, 0xd9, 0x65, 0x07, 0xaa, 0x8e, 0xcd, 0x8f, 0x61, 0xdb, 0x98, 0xfe, 0xa4, 0x20, 0x4e, 0x40,
-	0x29, 0x58, 0x2d, 0x5a, 0x58, 0xa4, 0x26, 0x01, 0x4f, 0x9a, 0xe0, 0x07, 0x07, 0xff, 0xc9, 0x44,
-	0xcd, 0x15, 0x6b, 0x4b, 0x83, 0x9b, 0xd0, 0x72, 0x02, 0x4a, 0x41, 0x22, 0x78, 0x99, 0xce, 0x15,
-	0xf0, 0x08, 0xa6, 0x14, 0x43, 0x42, 0x5d, 0xa8, 0x07, 0xf4, 0x87, 0x38, 0xcb, 0x35, 0x32, 0x46,
-	0x80, 0x11, 0x62, 0x4e, 0x96, 0xab, 0x58, 0x7f, 0xda, 0x80, 0xa6, 0x38, 0x5e, 0x34, 0x97, 0xa1,
-	0xc6, 0x0e, 0x7b, 0x0f, 0x41, 0xdd, 0x71, 0x6d, 0xf2, 0x8c, 0x61, 0xd7, 0x31, 0x4f, 0xa0, 0xf3,
-	0xd0, 0x14, 0x47, 0x8d, 0x62, 0xd4, 0xf3, 0x0e, 0xae, 0x23, 0x32, 0xf3, 0x3d, 0x68, 0x46, 0x87,
-	0xbe, 0xc7, 0xa0, 0x3d, 0xf2, 0x3d, 0xba, 0x80, 0xea, 0x47, 0xba, 0x14, 0x67, 0xa0, 0x37, 0xa0,
-	0x69, 0x8b, 0x63, 0xe5, 0x8a, 0x98, 0xb3, 0x72, 0xec, 0x28, 0xa2, 0x33, 0xbf, 0x54, 0x86, 0x06,
-	0x3f, 0xfb, 0x35, 0x77, 0xa4, 0x6d, 0x5c, 0x86, 0xc6, 0x80, 0xe5, 0x19, 0xc9, 0x73, 0x5f, 0xad,
-	0x86, 0xe2, 0x30, 0x19, 0x0b, 0x62, 0xca, 0x16, 0x70, 0x5f, 0x5b, 0x19, 0xcb, 0xc6, 0xc7, 0x13,
-	0x0b, 0xe2, 0xff, 0x31, 0xb9, 0xff, 0x50, 0x81, 0x7d, 0xfa, 0x91, 0xf2, 0x31, 0x68, 0x0f, 0xe4,
-	0x21, 0xb5, 0xe8, 0x5d, 0x99, 0x81, 0x1e, 0x00, 0x0c, 0x86, 0x0e, 0x71, 0x43, 0x76, 0xa8, 0x51,
-	0xc9, 0xdc, 0x2b, 0x67, 0x9e, 0x30, 0x77, 0x17, 0x24, 0x1b, 0x56, 0x20, 0xd0, 0x4d, 0xa8, 0x07,
-	0x03, 0x6f, 0xc4, 0xfd, 0xe8, 0x8c, 0x12, 0x3c, 0xd1, 0xab, 0x3d, 0xb7, 0x1d, 0x6e, 0xf2, 0xf5,
-	0xf8, 0xdc, 0xc8, 0x59, 0xa5, 0x0c, 0x98, 0xf3, 0x99, 0xbf, 0x52, 0x06, 0x88, 0xb1, 0xd1, 0x09,
-	0xb9, 0xff, 0x59, 0xb6, 0xb6, 0xa2, 0x06, 0xa8, 0x59, 0x0a, 0xc5, 0x8a, 0x15, 0x6e, 0x0a, 0xef,
-	0xaf, 0x66, 0x21, 0x04, 0x35, 0x97, 0x32, 0xf3, 0xfb, 0x11, 0xec, 0x37, 0x3a, 0x0b, 0x07, 0x02,
-	0x67, 0xc3, 0xb5, 0xc2, 0x6d, 0x9f, 0x3c, 0x26, 0xbe, 0xb3, 0xee, 0x10, 0x9b, 0xd5, 0xb9, 0x85,
-	0xd3, 0x05, 0xe6, 0x1b, 0x70, 0x20, 0x7d, 0x86, 0x3e, 0xb6, 0x67, 0xcd, 0x9f, 0x6f, 0x43, 0x83,
-	0x87, 0x47, 0xcc, 0xff, 0xa8, 0x48, 0x65, 0x37, 0xff, 0xb2, 0x0c, 0x75, 0x7e, 0x4c, 0x9c, 0xf4,
-	0x9d, 0xb7, 0x55, 0x45, 0xaf, 0x66, 0xc4, 0x0e, 0xb2, 0x8e, 0xcd, 0xbb, 0x77, 0xc9, 0xee, 0x63,
-	0x3a, 0x43, 0x4a, 0xed, 0xcf, 0x75, 0x12, 0x77, 0xa0, 0x15, 0x11, 0x53, 0xb7, 0xf3, 0x84, 0xec,
-	0x0a, 0xe1, 0xf4, 0x27, 0x3a, 0x2b, 0x66, 0x5a, 0x69, 0xbf, 0x49, 0x23, 0xe3, 0x52, 0xc4, 0x74,
-	0xfc, 0x69, 0xa8, 0xae, 0x92, 0x30, 0xd5, 0x84, 0xbd, 0xdb, 0x6a, 0x6e, 0x6d, 0x17, 0xa0, 0xce,
-	0x8f, 0xea, 0x93, 0x32, 0x10, 0xd4, 0x9e, 0x90, 0xdd, 0xc8, 0x55, 0xb1, 0xdf, 0xb9, 0x20, 0x7f,
-	0x5e, 0x85, 0x69, 0xf5, 0x78, 0xd2, 0x5c, 0xca, 0x5d, 0x3c, 0xb0, 0xe5, 0x40, 0xbc, 0x78, 0x10,
-	0x49, 0xea, 0xee, 0x18, 0x16, 0x53, 0x8d, 0x36, 0xe6, 0x09, 0xb3, 0x0b, 0x0d, 0x71, 0xea, 0x9b,
-	0x44, 0x92, 0xf4, 0x15, 0x95, 0xfe, 0x0e, 0xb4, 0xe4, 0x21, 0xee, 0x07, 0x95, 0xed, 0x43, 0x4b,
-	0x9e, 0xd6, 0x1e, 0x82, 0x7a, 0xe8, 0x85, 0xd6, 0x90, 0xc1, 0x55, 0x31, 0x4f, 0x50, 0xbd, 0x74,
-	0xc9, 0xb3, 0x70, 0x41, 0xba, 0xe3, 0x2a, 0x8e, 0x33, 0xb8, 0xb7, 0x25, 0x3b, 0xbc, 0xb4, 0xca,
-	0x4b, 0x65, 0x46, 0x2c, 0xb3, 0xa6, 0xca, 0xdc, 0x85, 0x86, 0x38, 0xc2, 0x95, 0xe5, 0x65, 0xa5,
-	0x1c, 0xcd, 0x41, 0x7d, 0x83, 0x96, 0x8b, 0x51, 0x7f, 0x2d, 0x61, 0xf4, 0x3c, 0x32, 0xb3, 0xe0,
-	0xb9, 0x21, 0x55, 0x63, 0x3d, 0x32, 0x8d, 0x39, 0x27, 0x1d, 0x42, 0x9f, 0x9f, 0xc7, 0x73, 0x23,
-	0x14, 0x29, 0xf3, 0x77, 0xcb, 0xd0, 0x96, 0x17, 0x20, 0xcc, 0xf7, 0xf2, 0x8c, 0x67, 0x0e, 0xf6,
-	0xf9, 0x82, 0x8a, 0x1a, 0x6a, 0x64, 0x42, 0xcf, 0x27, 0x6a, 0x82, 0x15, 0x1a, 0xac, 0x73, 0x98,
-	0x6f, 0xe7, 0x0e, 0xea, 0x2c, 0x4c, 0x47, 0xa4, 0x77, 0x63, 0xd5, 0xd3, 0xf2, 0x4c, 0x53, 0x72,
-	0xa7, 0xa6, 0x74, 0x73, 0x1d, 0xa6, 0xd5, 0x63, 0x50, 0xf3, 0x71, 0xb6, 0xf5, 0xdc, 0xa4, 0x62,
-	0x94, 0x23, 0xd7, 0x4a, 0x22, 0xd6, 0x13, 0x35, 0x21, 0x26, 0xc1, 0x1a, 0x83, 0x79, 0x14, 0xea,
-	0xfc, 0x72, 0x46, 0x02, 0xd9, 0xfc, 0x8b, 0x01, 0xd4, 0xd9, 0x20, 0x98, 0x17, 0xb9, 0x01, 0x9c,
-	0x85, 0x06, 0x0b, 0x34, 0x46, 0x57, 0xd7, 0x0e, 0x65, 0x8d, 0x18, 0x16, 0x34, 0xe6, 0x02, 0x4c,
-	0x29, 0xc7, 0xe2, 0x54, 0x63, 0x59, 0x81, 0xd4, 0x82, 0x28, 0x49, 0xd7, 0x2e, 0x74, 0xd6, 0x16,
-	0x7e, 0x98, 0xb6, 0x5f, 0xa6, 0xcd, 0x93, 0x72, 0x5d, 0x6b, 0x8a, 0x6b, 0x00, 0x7d, 0xd9, 0x4b,
-	0x32, 0x6d, 0x7e, 0x0a, 0xda, 0xf2, 0xf4, 0x1c, 0x3d, 0x80, 0x69, 0x71, 0x7a, 0xce, 0x83, 0x7f,
-	0x94, 0x78, 0xa6, 0x40, 0xbb, 0x1e, 0x92, 0x67, 0x21, 0x3b, 0x80, 0xef, 0x3e, 0xdc, 0x1d, 0x11,
-	0xac, 0x01, 0x98, 0x5f, 0x3b, 0xc3, 0x7a, 0xde, 0x1c, 0x41, 0x4b, 0x1e, 0x19, 0x26, 0x47, 0xe1,
-	0x2a, 0x77, 0x8d, 0x95, 0xc2, 0xf3, 0x6e, 0xce, 0x4f, 0x1d, 0x30, 0xf3, 0xa0, 0xe6, 0xf3, 0x50,
-	0xbd, 0x4b, 0x76, 0xa9, 0x85, 0x70, 0x47, 0x2a, 0x2c, 0x84, 0x3b, 0xcc, 0x3e, 0x34, 0xc4, 0xd1,
-	0x7d, 0x52, 0xde, 0x39, 0x68, 0xac, 0xf3, 0xdb, 0x00, 0x05, 0x2e, 0x53, 0x90, 0x99, 0x37, 0x61,
-	0x4a, 0x3d, 0xb0, 0x4f, 0xe2, 0x9d, 0x80, 0xa9, 0x81, 0x72, 0x25, 0x80, 0x0f, 0x83, 0x9a, 0x65,
-	0x12, 0x5d, 0x1d, 0x53, 0x08, 0x4b, 0x99, 0x7a, 0xf8, 0x52, 0x66, 0xb7, 0x8f, 0xd1, 0xc6, 0xbb,
-	0xb0, 0x3f, 0x79, 0x32, 0x9f, 0x94, 0x74, 0x06, 0xf6, 0xaf, 0x25, 0xee, 0x01, 0x70, 0x1f, 0x98,
-	0xcc, 0x36, 0xfb, 0x50, 0xe7, 0x27, 0xa7, 0x49, 0x88, 0xf3, 0x50, 0xb7, 0xd8, 0xc9, 0x6c, 0x85,
-	0xad, 0x37, 0xcc, 0xcc, 0x5a, 0x32, 0x56, 0xcc, 0x09, 0x4d, 0x07, 0xf6, 0xe9, 0x87, 0xb1, 0x49,
-	0xc8, 0x1e, 0xec, 0xdb, 0xd1, 0x0e, 0x7d, 0x39, 0xf4, 0x6c, 0x26, 0xb4, 0x06, 0x85, 0x75, 0x46,
-	0xf3, 0xcb, 0x0d, 0xa8, 0xb1, 0xdb, 0x04, 0x49, 0x11, 0x57, 0xa0, 0x16, 0x92, 0x67, 0xd1, 0x62,
-	0x79, 0x76, 0xec, 0xd5, 0x04, 0x1e, 0xd2, 0x66, 0xf4, 0xe8, 0xa3, 0x74, 0x65, 0xbf, 0x3b, 0x8c,
-	0x76, 0xa9, 0x2f, 0x8f, 0x67, 0x5c, 0xa5, 0xa4, 0x98, 0x73, 0x50, 0x56, 0x66, 0x0b, 0xe2, 0xf6,
-	0x4b, 0x01, 0x2b, 0x33, 0x42, 0xcc, 0x39, 0xd0, 0x4d, 0x68, 0x0e, 0x36, 0xc9, 0xe0, 0x09, 0xb1,
-	0xc5, 0xb5, 0x97, 0x53, 0xe3, 0x99, 0x17, 0x38, 0x31, 0x8e, 0xb8, 0xa8, 0xec, 0x01, 0x1b, 0xdd,
-	0xc6, 0x24, 0xb2, 0xd9, 0x88, 0x63, 0xce, 0x81, 0x96, 0xa0, 0xed, 0x0c, 0x3c, 0x77, 0x69, 0xcb,
-	0xfb, 0x8c, 0x23, 0xee, 0xb7, 0xbc, 0x32, 0x9e, 0xbd, 0x1f, 0x91, 0xe3, 0x98, 0x33, 0x82, 0xe9,
-	0x6f, 0xd1, 0xbd, 0x70, 0x6b, 0x52, 0x18, 0x46, 0x8e, 0x63, 0x4e, 0xf3, 0x98, 0x18, 0xcf, 0x6c,
-	0x23, 0xbf, 0x0d, 0x75, 0xd6, 0xe5, 0xe8, 0xba, 0x5a, 0x3c, 0xa3, 0x48, 0xca, 0xf5, 0x58, 0x62,
-	0xa8, 0x24, 0x0e, 0xeb, 0x7f, 0x1d, 0x67, 0x6a, 0x12, 0x1c, 0x31, 0x6e, 0x1c, 0xe7, 0x45, 0x68,
-	0x8a, 0xa1, 0xd0, 0x2b, 0xdc, 0x8a, 0x08, 0x5e, 0x80, 0x3a, 0x37, 0xcc, 0xec, 0xf6, 0xbc, 0x04,
-	0x6d, 0xd9, 0x99, 0xe3, 0x49, 0x58, 0xef, 0xe4, 0x90, 0x7c, 0xbd, 0x02, 0x75, 0x7e, 0xab, 0x22,
-	0xed, 0x6a, 0x55, 0x2b, 0x78, 0x79, 0xfc, 0x25, 0x0d, 0xd5, 0x0c, 0x6e, 0xb3, 0x1d, 0x23, 0x5d,
-	0xdf, 0xcb, 0x9b, 0xd2, 0x67, 0x0a, 0xb8, 0x57, 0x22, 0x7a, 0x1c, 0xb3, 0x16, 0x0c, 0xe7, 0x03,
-	0x68, 0x4b, 0x2e, 0x34, 0xaf, 0x0f, 0xe9, 0xd9, 0xb1, 0x43, 0x91, 0x14, 0x29, 0x00, 0x7f, 0xad,
-	0x0c, 0xd5, 0x45, 0x67, 0x27, 0xd5, 0x0f, 0x6f, 0x46, 0x56, 0x5d, 0xe4, 0x0e, 0x16, 0x9d, 0x1d,
-	0xcd, 0xa8, 0xcd, 0xa5, 0x48, 0xe3, 0xde, 0xd6, 0xab, 0x77, 0x7a, 0xfc, 0x0a, 0x2c, 0x86, 0xe1,
-	0x15, 0xfb, 0xa5, 0x26, 0xd4, 0xd8, 0x85, 0xa5, 0x2c, 0x3f, 0xb5, 0x3b, 0x2a, 0xae, 0x18, 0x3b,
-	0x12, 0x61, 0x13, 0x2e, 0xa3, 0xe7, 0x7e, 0xca, 0x0a, 0x8b, 0xfd, 0x14, 0x3f, 0xe1, 0x51, 0x83,
-	0x11, 0x57, 0xa0, 0xb6, 0xe5, 0x88, 0xcd, 0x5a, 0xa1, 0xc8, 0xfb, 0xce, 0x16, 0xc1, 0x8c, 0x9e,
-	0xf2, 0x6d, 0x5a, 0xc1, 0xa6, 0xf0, 0x50, 0x05, 0x7c, 0x3d, 0x2b, 0xd8, 0xc4, 0x8c, 0x9e, 0xf2,
-	0xb1, 0xcd, 0x61, 0x63, 0x12, 0x3e, 0xba, 0xe1, 0x14, 0x1b, 0xc8, 0x2b, 0x50, 0x0b, 0x9c, 0xcf,
-	0x11, 0xe1, 0x93, 0x0a, 0xf8, 0x56, 0x9d, 0xcf, 0x11, 0xcc, 0xe8, 0x63, 0x17, 0xde, 0x9a, 0xac,
-	0x6b, 0x14, 0x17, 0xfe, 0x10, 0x66, 0x42, 0xed, 0xd8, 0x5d, 0xdc, 0x9a, 0x3b, 0x5b, 0x30, 0x2e,
-	0x1a, 0x0f, 0x4e, 0x60, 0x50, 0x23, 0x60, 0xfb, 0xe8, 0x6c, 0x23, 0x78, 0x01, 0xea, 0x1f, 0x77,
-	0xec, 0x70, 0x53, 0x2f, 0xae, 0x6b, 0x2e, 0x8f, 0x0e, 0xdb, 0x9e, 0x5c, 0x9e, 0x3a, 0xea, 0x1c,
-	0x67, 0x11, 0x6a, 0x54, 0x7d, 0xf6, 0xa6, 0xc7, 0xb1, 0xd6, 0x7d, 0x20, 0x07, 0xac, 0x76, 0x34,
-	0xc7, 0x39, 0x06, 0x35, 0xaa, 0x21, 0x39, 0x5d, 0x72, 0x0c, 0x6a, 0x54, 0xef, 0xf2, 0x4b, 0xe9,
-	0x68, 0xeb, 0xa5, 0xd5, 0xa8, 0xf4, 0x34, 0xcc, 0xe8, 0xc3, 0x91, 0x83, 0xf2, 0x67, 0x4d, 0xa8,
-	0xb1, 0xdb, 0x7f, 0x49, 0x8b, 0xfc, 0x18, 0xec, 0xe3, 0xe3, 0x37, 0x2f, 0x96, 0xe0, 0x95, 0xcc,
-	0x13, 0x00, 0xfd, 0x4e, 0xa1, 0x50, 0x01, 0xc1, 0x82, 0x75, 0x84, 0xc9, 0x17, 0x15, 0x0c, 0x4a,
-	0xd3, 0xc8, 0xb7, 0xe5, 0xe2, 0xb5, 0x56, 0x70, 0xf5, 0x94, 0xf1, 0xf2, 0x25, 0x70, 0xb4, 0x92,
-	0x45, 0xf3, 0xd0, 0xa2, 0x53, 0x2b, 0xed, 0x2e, 0x61, 0xb6, 0xa7, 0xc7, 0xf3, 0xf7, 0x05, 0x35,
-	0x96, 0x7c, 0x74, 0x62, 0x1f, 0x58, 0xbe, 0xcd, 0x6a, 0x25, 0x6c, 0xf8, 0x95, 0xf1, 0x20, 0x0b,
-	0x11, 0x39, 0x8e, 0x39, 0xd1, 0x5d, 0x98, 0xb2, 0x89, 0x8c, 0x13, 0x08, 0xa3, 0xfe, 0xc8, 0x78,
-	0xa0, 0xc5, 0x98, 0x01, 0xab, 0xdc, 0xb4, 0x4e, 0xd1, 0xde, 0x30, 0x28, 0x5c, 0x6c, 0x30, 0xa8,
-	0xf8, 0x8a, 0x7f, 0xcc, 0x69, 0x9e, 0x82, 0x7d, 0xda, 0xb8, 0x7d, 0xa8, 0xab, 0x0e, 0x75, 0x2c,
-	0x39, 0xce, 0x55, 0xb9, 0x45, 0x79, 0x5d, 0x5f, 0x76, 0xe4, 0xee, 0x48, 0x04, 0xe3, 0x3d, 0x68,
-	0x45, 0x03, 0x83, 0x6e, 0xe9, 0x75, 0x78, 0xb5, 0xb8, 0x0e, 0x72, 0x4c, 0x05, 0xda, 0x32, 0xb4,
-	0xe5, 0x08, 0xa1, 0x39, 0x1d, 0xee, 0xb5, 0x62, 0xb8, 0x78, 0x74, 0x05, 0x1e, 0x86, 0x29, 0x65,
-	0xa0, 0xd0, 0x82, 0x8e, 0xf8, 0x7a, 0x31, 0xa2, 0x3a, 0xcc, 0xf1, 0xaa, 0x47, 0x8e, 0x98, 0x3a,
-	0x2a, 0xd5, 0x78, 0x54, 0xfe, 0xb0, 0x09, 0x2d, 0x79, 0xe3, 0x36, 0x63, 0x8f, 0xb9, 0xed, 0x0f,
-	0x0b, 0xf7, 0x98, 0x11, 0x7f, 0xf7, 0x91, 0x3f, 0xc4, 0x94, 0x83, 0x0e, 0x71, 0xe8, 0x84, 0xd2,
-	0x54, 0x5f, 0x29, 0x66, 0x7d, 0x48, 0xc9, 0x31, 0xe7, 0x42, 0x0f, 0x74, 0x2d, 0xaf, 0x8d, 0xb9,
-	0x91, 0xa5, 0x81, 0xe4, 0x6a, 0x7a, 0x1f, 0xda, 0x0e, 0x5d, 0xfa, 0xf5, 0xe2, 0x99, 0xf7, 0xb5,
-	0x62, 0xb8, 0x7e, 0xc4, 0x82, 0x63, 0x6e, 0x5a, 0xb7, 0x75, 0x6b, 0x87, 0xda, 0x35, 0x03, 0x6b,
-	0x4c, 0x5a, 0xb7, 0xdb, 0x31, 0x13, 0x56, 0x11, 0xd0, 0x35, 0xb1, 0x76, 0x69, 0x16, 0x78, 0x96,
-	0xb8, 0xab, 0xe2, 0xf5, 0xcb, 0xbb, 0xa9, 0x99, 0x96, 0x9b, 0xf1, 0xf9, 0x09, 0x50, 0xc6, 0xce,
-	0xb6, 0x74, 0x04, 0xf9, 0xca, 0xa8, 0x3d, 0xe9, 0x08, 0xaa, 0xab, 0x23, 0xf3, 0x79, 0xa8, 0x3e,
-	0xf2, 0x87, 0xf9, 0x73, 0x35, 0x1b, 0xee, 0x9c, 0xe2, 0x97, 0x75, 0x4b, 0xc8, 0x5f, 0xd0, 0xcb,
-	0x31, 0xc9, 0xc5, 0x51, 0x3a, 0x3d, 0x87, 0xe8, 0xba, 0x98, 0xd0, 0x2f, 0xeb, 0xf6, 0xf6, 0x62,
-	0xc2, 0xde, 0xa8, 0x85, 0xad, 0xf8, 0x84, 0x5f, 0x3a, 0x54, 0x66, 0xf2, 0x49, 0xe7, 0xc9, 0x3b,
-	0xd1, 0xfa, 0x63, 0x4f, 0x9e, 0x22, 0xd9, 0xb7, 0x1c, 0xeb, 0xab, 0x65, 0x68, 0xc9, 0x0b, 0xd5,
-	0xe9, 0xe8, 0x7c, 0xcb, 0x09, 0x7a, 0xc4, 0xb2, 0x89, 0x2f, 0xec, 0xf6, 0xd5, 0xc2, 0x9b, 0xda,
-	0xdd, 0xbe, 0xe0, 0xc0, 0x92, 0xd7, 0x3c, 0x01, 0xad, 0x28, 0x37, 0x67, 0x53, 0xf6, 0xfd, 0x0a,
-	0x34, 0xc4, 0x55, 0xec, 0x64, 0x25, 0x6e, 0x40, 0x63, 0x68, 0xed, 0x7a, 0xdb, 0xd1, 0x96, 0xe9,
-	0x74, 0xc1, 0xed, 0xee, 0xee, 0x3d, 0x46, 0x8d, 0x05, 0x17, 0x7a, 0x0b, 0xea, 0x43, 0x67, 0xcb,
-	0x09, 0x85, 0xfb, 0x38, 0x55, 0xc8, 0xce, 0x2e, 0x6d, 0x71, 0x1e, 0x2a, 0x9c, 0xdd, 0xc0, 0x8c,
-	0xbe, 0x9f, 0x29, 0x14, 0xfe, 0x98, 0x51, 0x63, 0xc1, 0x65, 0xde, 0x81, 0x06, 0xaf, 0xce, 0xde,
-	0x26, 0x09, 0xbd, 0x25, 0xb1, 0xa6, 0xb3, 0xba, 0xe5, 0xac, 0x4a, 0x8f, 0x43, 0x83, 0x0b, 0xcf,
-	0xd1, 0x9a, 0xef, 0x3d, 0xc7, 0xf6, 0x3b, 0x43, 0xf3, 0x5e, 0x7c, 0x0a, 0xf9, 0xc1, 0xcf, 0x32,
-	0xcc, 0x87, 0xb0, 0x7f, 0xd1, 0x0a, 0xad, 0x35, 0x2b, 0x20, 0x98, 0x0c, 0x3c, 0xdf, 0xce, 0x44,
-	0xf5, 0x79, 0x91, 0x88, 0x50, 0xe7, 0xa3, 0x0a, 0xba, 0x1f, 0x87, 0x0e, 0xff, 0xf7, 0x84, 0x0e,
-	0xff, 0xa8, 0x96, 0x13, 0xcf, 0x9b, 0x24, 0x92, 0x41, 0x15, 0x2e, 0x15, 0xd0, 0xbb, 0xa6, 0xaf,
-	0xbd, 0x4f, 0x16, 0x70, 0x6a, 0x8b, 0xef, 0x6b, 0x7a, 0x44, 0xaf, 0x88, 0x57, 0x0b, 0xe9, 0xdd,
-	0x4a, 0x86, 0xf4, 0x4e, 0x17, 0x70, 0xa7, 0x62, 0x7a, 0xd7, 0xf4, 0x98, 0x5e, 0x91, 0x74, 0x35,
-	0xa8, 0xf7, 0xff, 0x2c, 0x8c, 0xf6, 0x8d, 0x9c, 0xb0, 0xcf, 0x47, 0xf5, 0xb0, 0xcf, 0x18, 0xad,
-	0xf9, 0x51, 0xc5, 0x7d, 0x7e, 0xbd, 0x91, 0x13, 0xf7, 0xb9, 0xaa, 0xc5, 0x7d, 0xc6, 0xd4, 0x2c,
-	0x19, 0xf8, 0xb9, 0xa6, 0x07, 0x7e, 0x4e, 0x16, 0x70, 0x6a, 0x91, 0x9f, 0xab, 0x5a, 0xe4, 0xa7,
-	0x48, 0xa8, 0x12, 0xfa, 0xb9, 0xaa, 0x85, 0x7e, 0x8a, 0x18, 0x95, 0xd8, 0xcf, 0x55, 0x2d, 0xf6,
-	0x53, 0xc4, 0xa8, 0x04, 0x7f, 0xae, 0x6a, 0xc1, 0x9f, 0x22, 0x46, 0x25, 0xfa, 0x73, 0x4d, 0x8f,
-	0xfe, 0x14, 0xf7, 0x8f, 0x32, 0xe8, 0x3f, 0x0e, 0xd4, 0xfc, 0x37, 0x06, 0x6a, 0x7e, 0xb1, 0x9a,
-	0x13, 0x80, 0xc1, 0xd9, 0x01, 0x98, 0xb3, 0xf9, 0x23, 0x59, 0x1c, 0x81, 0x99, 0x7c, 0x16, 0x48,
-	0x87, 0x60, 0xae, 0x27, 0x42, 0x30, 0xa7, 0x0a, 0x98, 0xf5, 0x18, 0xcc, 0xff, 0x99, 0x20, 0xc3,
-	0xef, 0x37, 0xc6, 0xec, 0xa7, 0xdf, 0x54, 0xf7, 0xd3, 0x63, 0x66, 0xb2, 0xf4, 0x86, 0xfa, 0x86,
-	0xbe, 0xa1, 0x3e, 0x33, 0x01, 0xaf, 0xb6, 0xa3, 0x5e, 0xc9, 0xda, 0x51, 0x77, 0x27, 0x40, 0xc9,
-	0xdd, 0x52, 0xdf, 0x49, 0x6f, 0xa9, 0xcf, 0x4e, 0x80, 0x97, 0xb9, 0xa7, 0x5e, 0xc9, 0xda, 0x53,
-	0x4f, 0x52, 0xbb, 0xdc, 0x4d, 0xf5, 0x5b, 0xda, 0xa6, 0xfa, 0x95, 0x49, 0xba, 0x2b, 0x9e, 0x1c,
-	0x3e, 0x91, 0xb3, 0xab, 0x7e, 0x63, 0x12, 0x98, 0xf1, 0x41, 0xec, 0x1f, 0xef, 0x8b, 0x75, 0x31,
-	0xdf, 0x79, 0x11, 0x5a, 0xd1, 0x45, 0x1b, 0xf3, 0xb3, 0xd0, 0x8c, 0xbe, 0xbf, 0xcd, 0xb8, 0x53,
-	0x2c, 0x36, 0x75, 0x7c, 0xf5, 0x2c, 0x52, 0xe8, 0x06, 0xd4, 0xe8, 0x2f, 0x61, 0x16, 0xaf, 0x4e,
-	0x76, 0xa1, 0x87, 0x0a, 0xc1, 0x8c, 0xcf, 0xfc, 0xcd, 0xc3, 0x00, 0xca, 0x67, 0x89, 0x93, 0x8a,
-	0x7d, 0x87, 0x3a, 0xb3, 0x61, 0x48, 0x7c, 0x76, 0x91, 0xab, 0xf0, 0xb3, 0xbd, 0x58, 0x02, 0xd5,
-	0x96, 0x90, 0xf8, 0x58, 0xb0, 0xa3, 0xfb, 0xd0, 0x8a, 0x02, 0xa9, 0xec, 0x72, 0x76, 0x9e, 0x92,
-	0x65, 0x41, 0x45, 0xa1, 0x3d, 0x2c, 0x21, 0xd0, 0x1c, 0xd4, 0x02, 0xcf, 0x0f, 0xc5, 0x4d, 0xee,
-	0xd7, 0x27, 0x86, 0x5a, 0xf5, 0xfc, 0x10, 0x33, 0x56, 0xde, 0x34, 0xe5, 0xd5, 0x87, 0xbd, 0x34,
-	0x4d, 0xf3, 0xd8, 0xbf, 0x51, 0x93, 0x3e, 0x74, 0x41, 0x58, 0x23, 0xd7, 0xa1, 0x73, 0x93, 0x8f,
-	0x92, 0x6a, 0x95, 0xd1, 0xed, 0xc8, 0x8a, 0x72, 0x3b, 0xf2, 0x55, 0xe8, 0x0c, 0xbc, 0x1d, 0xe2,
-	0xe3, 0xf8, 0x8a, 0x93, 0xb8, 0x85, 0x96, 0xca, 0x47, 0x26, 0xb4, 0x36, 0x1d, 0x9b, 0xf4, 0x07,
-	0xc2, 0xff, 0xb5, 0xb0, 0x4c, 0xa3, 0xbb, 0xd0, 0x62, 0x31, 0xf6, 0x28, 0xc2, 0xbf, 0xb7, 0x4a,
-	0xf2, 0x50, 0x7f, 0x04, 0x40, 0x05, 0x31, 0xe1, 0xb7, 0x9d, 0x90, 0xf5, 0x61, 0x0b, 0xcb, 0x34,
-	0xad, 0x30, 0xbb, 0x47, 0xa6, 0x56, 0xb8, 0xc9, 0x2b, 0x9c, 0xcc, 0x47, 0xa7, 0x61, 0x86, 0xb8,
-	0xb6, 0x4a, 0xd9, 0x61, 0x94, 0x89, 0x5c, 0x74, 0x09, 0x0e, 0x33, 0xde, 0xc4, 0x56, 0x94, 0x87,
-	0xf4, 0x5b, 0x38, 0xbb, 0x90, 0xdd, 0xaf, 0xb3, 0x36, 0xf8, 0xb7, 0x60, 0x2c, 0xc8, 0x57, 0xc7,
-	0x71, 0x06, 0x3a, 0x0b, 0x07, 0x6c, 0xb2, 0x6e, 0x6d, 0x0f, 0xc3, 0x87, 0x64, 0x6b, 0x34, 0xb4,
-	0x42, 0xd2, 0xb7, 0xd9, 0x03, 0x15, 0x6d, 0x9c, 0x2e, 0x40, 0xe7, 0xe1, 0xa0, 0xc8, 0xe4, 0xe6,
-	0x4e, 0x47, 0xad, 0x6f, 0xb3, 0xf7, 0x1a, 0xda, 0x38, 0xab, 0xc8, 0xfc, 0x1e, 0x53, 0x0e, 0x66,
-	0x02, 0xef, 0x40, 0xd5, 0xb2, 0x6d, 0x31, 0xbd, 0x5e, 0xdc, 0xa3, 0x21, 0x89, 0x8f, 0x80, 0x28,
-	0x02, 0x5a, 0x91, 0x57, 0xf3, 0xf8, 0x04, 0x7b, 0x65, 0xaf, 0x58, 0xf2, 0xdd, 0x1c, 0x81, 0x43,
-	0x11, 0xb7, 0xf9, 0xb7, 0x21, 0xd5, 0x1f, 0x0e, 0x51, 0x7e, 0x69, 0x24, 0x70, 0xd0, 0x1d, 0xa8,
-	0xb1, 0x1a, 0xf2, 0x09, 0xf8, 0xd2, 0x5e, 0xf1, 0xee, 0xf3, 0xfa, 0x31, 0x0c, 0x73, 0xc0, 0xef,
-	0xc8, 0x29, 0x17, 0x33, 0xcb, 0xfa, 0xc5, 0xcc, 0x79, 0xa8, 0x3b, 0x21, 0xd9, 0x4a, 0xdf, 0xd3,
-	0x1d, 0xab, 0xd2, 0xc2, 0x43, 0x71, 0xd6, 0xb1, 0xf7, 0x05, 0xdf, 0xcb, 0xfd, 0xa0, 0xe3, 0x16,
-	0xd4, 0x28, 0x7b, 0x6a, 0xcd, 0x39, 0x89, 0x60, 0xc6, 0x69, 0x5e, 0x80, 0x1a, 0x6d, 0xec, 0x98,
-	0xd6, 0x89, 0xfa, 0x54, 0x64, 0x7d, 0xe6, 0xa7, 0xa0, 0xed, 0x8d, 0x88, 0xcf, 0x0c, 0xc3, 0xfc,
-	0xd7, 0x9a, 0x72, 0x79, 0xae, 0xaf, 0xea, 0xd8, 0xe5, 0x3d, 0x7b, 0x58, 0x55, 0xcb, 0x70, 0x42,
-	0xcb, 0xde, 0xdc, 0x3b, 0x5a, 0x4a, 0xcf, 0x70, 0x42, 0xcf, 0x7e, 0x08, 0xcc, 0x94, 0xa6, 0xdd,
-	0xd3, 0x34, 0xed, 0xca, 0xde, 0x11, 0x35, 0x5d, 0x23, 0x45, 0xba, 0xb6, 0xa8, 0xeb, 0x5a, 0x77,
-	0xb2, 0x21, 0x97, 0x53, 0xd8, 0x04, 0xda, 0xf6, 0xa9, 0x5c, 0x6d, 0x9b, 0xd7, 0xb4, 0x6d, 0xaf,
-	0xa2, 0x3f, 0x24, 0x7d, 0xfb, 0x6e, 0x0d, 0x6a, 0x74, 0x1a, 0x45, 0x4b, 0xaa, 0xae, 0xbd, 0xb1,
-	0xa7, 0x29, 0x58, 0xd5, 0xb3, 0xe5, 0x84, 0x9e, 0x5d, 0xda, 0x1b, 0x52, 0x4a, 0xc7, 0x96, 0x13,
-	0x3a, 0xb6, 0x47, 0xbc, 0x94, 0x7e, 0xf5, 0x34, 0xfd, 0xba, 0xb0, 0x37, 0x34, 0x4d, 0xb7, 0xac,
-	0x22, 0xdd, 0xba, 0xa5, 0xeb, 0xd6, 0x84, 0xab, 0x3c, 0xb6, 0xa6, 0x99, 0x40, 0xaf, 0xde, 0xcd,
-	0xd5, 0xab, 0x1b, 0x9a, 0x5e, 0xed, 0x45, 0xec, 0x87, 0xa4, 0x53, 0x97, 0xf8, 0xe2, 0x34, 0xff,
-	0x3b, 0xbb, 0xac, 0xc5, 0xa9, 0x79, 0x19, 0xda, 0xf1, 0xfb, 0x2f, 0x19, 0xd7, 0xf8, 0x39, 0x59,
-	0x24, 0x35, 0x4a, 0x9a, 0x17, 0xa1, 0x1d, 0xbf, 0xe9, 0x92, 0xf5, 0x4d, 0x1f, 0x2b, 0x94, 0x9f,
-	0x5e, 0xb1, 0x94, 0xb9, 0x04, 0x07, 0xd2, 0x2f, 0x4e, 0x64, 0xc4, 0xeb, 0x95, 0x3b, 0xe8, 0xd1,
-	0x97, 0x2f, 0x4a, 0x96, 0xf9, 0x14, 0x66, 0x12, 0x6f, 0x48, 0xec, 0x19, 0x03, 0x5d, 0x54, 0x96,
-	0xd2, 0xd5, 0xc4, 0x57, 0xc3, 0xfa, 0xad, 0xfa, 0x78, 0xc1, 0x6c, 0x2e, 0xc2, 0x4c, 0x41, 0xe5,
-	0x27, 0xb9, 0x54, 0xff, 0x69, 0x98, 0x1a, 0x57, 0xf7, 0x0f, 0xe1, 0xd2, 0x7f, 0x08, 0x9d, 0xd4,
-	0xfb, 0x37, 0x49, 0x31, 0x2b, 0x00, 0x1b, 0x92, 0x46, 0x28, 0xed, 0xf9, 0x3d, 0x7c, 0xe2, 0xc0,
-	0xf8, 0xb0, 0x82, 0x61, 0xfe, 0x4e, 0x19, 0x0e, 0xa4, 0x1f, 0xbf, 0x99, 0x74, 0x93, 0x64, 0x40,
-	0x93, 0x61, 0xc9, 0x2f, 0x43, 0xa2, 0x24, 0xba, 0x0f, 0xd3, 0xc1, 0xd0, 0x19, 0x90, 0x85, 0x4d,
-	0xcb, 0xdd, 0x20, 0x81, 0xd8, 0xf9, 0x14, 0x3c, 0x60, 0xb3, 0x1a, 0x73, 0x60, 0x8d, 0xdd, 0x7c,
-	0x0a, 0x53, 0x4a, 0x21, 0x7a, 0x1b, 0x2a, 0xde, 0x28, 0x75, 0xff, 0x31, 0x1f, 0xf3, 0x41, 0x64,
-	0x6f, 0xb8, 0xe2, 0x8d, 0xd2, 0x26, 0xa9, 0x9a, 0x6f, 0x55, 0x33, 0x5f, 0xf3, 0x2e, 0x1c, 0x48,
-	0xbf, 0x2f, 0x93, 0xec, 0x9e, 0xd3, 0xa9, 0x68, 0x02, 0xef, 0xa6, 0x64, 0x68, 0xe0, 0x2a, 0xec,
-	0x4f, 0xbe, 0x1a, 0x93, 0xf1, 0xd5, 0x4e, 0xfc, 0xf1, 0x53, 0x14, 0xd6, 0x9f, 0xfd, 0x85, 0x32,
-	0xcc, 0xe8, 0x0d, 0x41, 0x47, 0x00, 0xe9, 0x39, 0xcb, 0x9e, 0x4b, 0x3a, 0x25, 0x74, 0x18, 0x0e,
-	0xe8, 0xf9, 0x73, 0xb6, 0xdd, 0x29, 0xa7, 0xc9, 0xa9, 0xdb, 0xea, 0x54, 0x90, 0x01, 0x87, 0x12,
-	0x3d, 0xc4, 0x9c, 0x68, 0xa7, 0x8a, 0x9e, 0x83, 0xc3, 0xc9, 0x92, 0xd1, 0xd0, 0x1a, 0x90, 0x4e,
-	0xcd, 0xfc, 0xb7, 0x0a, 0xd4, 0x1e, 0x05, 0xc4, 0x37, 0xff, 0xa5, 0x12, 0x7d, 0xcd, 0xf1, 0x26,
-	0xd4, 0xd8, 0x83, 0x2e, 0xca, 0xe7, 0x97, 0xe5, 0xc4, 0xe7, 0x97, 0xda, 0x27, 0x7c, 0xf1, 0xe7,
-	0x97, 0x6f, 0x42, 0x8d, 0x3d, 0xe1, 0xb2, 0x77, 0xce, 0xaf, 0x94, 0xa1, 0x1d, 0x3f, 0xa7, 0xb2,
-	0x67, 0x7e, 0xf5, 0xeb, 0x91, 0x8a, 0xfe, 0xf5, 0xc8, 0xab, 0x50, 0xf7, 0xd9, 0x77, 0x1e, 0xdc,
-	0xcb, 0x24, 0xbf, 0x49, 0x61, 0x02, 0x31, 0x27, 0x31, 0x09, 0x4c, 0xa9, 0x8f, 0xc5, 0xec, 0xbd,
-	0x1a, 0x27, 0xc5, 0x4b, 0x71, 0x7d, 0x3b, 0x98, 0xf3, 0x7d, 0x6b, 0x57, 0x28, 0xa6, 0x9e, 0x69,
-	0x1e, 0x83, 0xda, 0x8a, 0xe3, 0x6e, 0x64, 0x7f, 0xf5, 0x6a, 0xfe, 0x71, 0x19, 0x9a, 0xe2, 0x92,
-	0xaf, 0x79, 0x15, 0xaa, 0xcb, 0xe4, 0x29, 0xad, 0x88, 0xb8, 0x5e, 0x9c, 0xaa, 0xc8, 0x7d, 0xd6,
-	0x0a, 0x41, 0x8f, 0x23, 0x32, 0xf3, 0x9a, 0x9c, 0x26, 0xf7, 0xce, 0xfb, 0x26, 0xd4, 0xd8, 0x1b,
-	0x2f, 0x7b, 0xe7, 0xfc, 0x93, 0x16, 0x34, 0xf8, 0xa7, 0xa3, 0xe6, 0x1f, 0xb4, 0xa0, 0xc1, 0xdf,
-	0x7d, 0x41, 0x37, 0xa0, 0x19, 0x6c, 0x6f, 0x6d, 0x59, 0xfe, 0xae, 0x91, 0xfd, 0x9a, 0xb1, 0xf6,
-	0x4c, 0x4c, 0x77, 0x95, 0xd3, 0xe2, 0x88, 0x09, 0x5d, 0x86, 0xda, 0xc0, 0x5a, 0x27, 0xa9, 0x63,
-	0xdf, 0x2c, 0xe6, 0x05, 0x6b, 0x9d, 0x60, 0x46, 0x8e, 0x6e, 0x41, 0x4b, 0x0c, 0x4b, 0x20, 0xe2,
-	0x3e, 0xe3, 0xe5, 0x46, 0x83, 0x29, 0xb9, 0xcc, 0x3b, 0xd0, 0x14, 0x95, 0x41, 0x37, 0xe5, 0x87,
-	0xb3, 0xc9, 0x08, 0x75, 0x66, 0x13, 0xe4, 0xa7, 0xd8, 0xf2, 0x13, 0xda, 0xbf, 0xaa, 0x40, 0x8d,
-	0x56, 0xee, 0x03, 0x23, 0xa1, 0xe3, 0x00, 0x43, 0x2b, 0x08, 0x57, 0xb6, 0x87, 0x43, 0xf1, 0x31,
-	0x77, 0x15, 0x2b, 0x39, 0xe8, 0x0c, 0xec, 0xe7, 0xa9, 0x60, 0x73, 0x75, 0x7b, 0x30, 0x20, 0xf2,
-	0x0b, 0xd4, 0x64, 0x36, 0x9a, 0x83, 0x3a, 0x7b, 0x89, 0x54, 0xac, 0x0a, 0x5f, 0x2b, 0xec, 0xd9,
-	0xee, 0x8a, 0xe3, 0x8a, 0xda, 0x70, 0x4e, 0xd3, 0x83, 0xb6, 0xcc, 0xa3, 0x46, 0x38, 0x72, 0x5c,
-	0xd7, 0x71, 0x37, 0x84, 0x46, 0x47, 0x49, 0x3a, 0xe9, 0xd0, 0x9f, 0xa2, 0xbe, 0x75, 0x2c, 0x52,
-	0x34, 0x7f, 0xdd, 0x72, 0x86, 0xa2, 0x8a, 0x75, 0x2c, 0x52, 0x14, 0x69, 0x5b, 0xbc, 0x96, 0x53,
-	0x63, 0x0d, 0x8c, 0x92, 0xe6, 0xfb, 0x65, 0xf9, 0xf5, 0x78, 0xd6, 0x47, 0x9c, 0xa9, 0x98, 0xd3,
-	0x31, 0x35, 0xf0, 0xcd, 0x27, 0x04, 0x25, 0x94, 0x7d, 0x04, 0x1a, 0x9e, 0x3b, 0x74, 0x5c, 0x22,
-	0x62, 0x4c, 0x22, 0x95, 0xe8, 0xe3, 0x7a, 0xaa, 0x8f, 0x45, 0xf9, 0x92, 0xed, 0xd0, 0x2a, 0x36,
-	0xe2, 0x72, 0x9e, 0x83, 0xae, 0x43, 0xd3, 0x26, 0x3b, 0xce, 0x80, 0x04, 0x46, 0x93, 0xa9, 0xde,
-	0xcb, 0x63, 0xfb, 0x76, 0x91, 0xd1, 0xe2, 0x88, 0xc7, 0x0c, 0xa1, 0xc1, 0xb3, 0x64, 0x93, 0xca,
-	0x4a, 0x93, 0xe2, 0x4a, 0x57, 0xc6, 0x54, 0xba, 0x5a, 0x50, 0xe9, 0x5a, 0xb2, 0xd2, 0xb3, 0x5f,
-	0x00, 0x50, 0xde, 0x10, 0x98, 0x82, 0xe6, 0x23, 0xf7, 0x89, 0xeb, 0x3d, 0x75, 0x3b, 0x25, 0x9a,
-	0x78, 0xb0, 0xbe, 0x4e, 0xa5, 0x74, 0xca, 0x34, 0x41, 0xe9, 0x1c, 0x77, 0xa3, 0x53, 0x41, 0x00,
-	0x0d, 0xfe, 0x8c, 0x40, 0xa7, 0x4a, 0x7f, 0xdf, 0x66, 0xe3, 0xd7, 0xa9, 0xa1, 0xa3, 0x70, 0xb0,
-	0xef, 0x0e, 0xbc, 0xad, 0x91, 0x15, 0x3a, 0x6b, 0x43, 0xf2, 0x98, 0xf8, 0x81, 0xe3, 0xb9, 0x9d,
-	0x3a, 0x9d, 0xbd, 0x96, 0x49, 0xf8, 0xd4, 0xf3, 0x9f, 0x2c, 0x13, 0x62, 0x8b, 0x87, 0x68, 0x3a,
-	0x0d, 0xf3, 0x3f, 0xcb, 0xfc, 0xd4, 0xd8, 0xbc, 0x05, 0xd3, 0xda, 0xb3, 0x4e, 0x46, 0xfc, 0xc8,
-	0x7c, 0xe2, 0x8d, 0xf9, 0x23, 0x2c, 0xae, 0x4b, 0xe2, 0xa5, 0x0c, 0x4f, 0x99, 0xb7, 0x01, 0x94,
-	0xc7, 0x9c, 0x8e, 0x03, 0xac, 0xed, 0x86, 0x24, 0xe0, 0x0f, 0x39, 0x51, 0x88, 0x1a, 0x56, 0x72,
-	0x54, 0xfc, 0x8a, 0x86, 0x6f, 0x5e, 0x01, 0x50, 0x9e, 0x72, 0xa2, 0x76, 0x45, 0x53, 0xf3, 0x49,
-	0xb0, 0x64, 0xb6, 0xd9, 0x15, 0x2d, 0x88, 0x1e, 0x6d, 0x8a, 0x6a, 0xc0, 0xa3, 0x77, 0x6a, 0x0d,
-	0x58, 0x8e, 0xb9, 0x04, 0x10, 0xbf, 0x5b, 0x64, 0x5e, 0x95, 0xae, 0xfb, 0x75, 0xa8, 0xd9, 0x56,
-	0x68, 0x09, 0xaf, 0xf9, 0x5c, 0x62, 0xe6, 0x8a, 0x59, 0x30, 0x23, 0x33, 0x7f, 0xbb, 0x0c, 0xd3,
-	0xea, 0x1b, 0x4d, 0xe6, 0x3b, 0x50, 0x63, 0x8f, 0x3c, 0xdd, 0x84, 0x69, 0xf5, 0x91, 0xa6, 0xd4,
-	0x63, 0xfc, 0x1c, 0x4f, 0x65, 0xc5, 0x1a, 0x83, 0xd9, 0x97, 0x55, 0xfa, 0xc0, 0x50, 0xe7, 0xa1,
-	0x29, 0xde, 0x7c, 0x32, 0x4f, 0x41, 0x3b, 0x7e, 0xe2, 0x89, 0xfa, 0x0e, 0x9e, 0x1f, 0x8d, 0xb2,
-	0x48, 0x9a, 0xdf, 0xa8, 0x43, 0x9d, 0x0d, 0xa7, 0xf9, 0xcd, 0x8a, 0xaa, 0xa1, 0xe6, 0xef, 0x55,
-	0x72, 0xf7, 0x82, 0x17, 0xb5, 0x77, 0x0e, 0x66, 0x52, 0x4f, 0x9b, 0x89, 0x17, 0x9d, 0x74, 0xc7,
-	0x7a, 0x05, 0x9a, 0x2e, 0xd7, 0x4c, 0xf1, 0xcc, 0xc0, 0xb1, 0x4c, 0x2e, 0xa1, 0xbd, 0x38, 0x22,
-	0x46, 0x97, 0xa0, 0x4e, 0x7c, 0xdf, 0xf3, 0x99, 0x49, 0xcd, 0xa4, 0x1e, 0x09, 0x8b, 0x5f, 0x8f,
-	0x5a, 0xa2, 0x54, 0x98, 0x13, 0xa3, 0x4b, 0x70, 0x38, 0xe0, 0x56, 0xc4, 0xd7, 0x94, 0x81, 0xf8,
-	0xfe, 0x5a, 0x78, 0x9b, 0xec, 0x42, 0xca, 0xe5, 0x7a, 0x21, 0xb7, 0x38, 0xf6, 0xf5, 0x6c, 0xc4,
-	0xc5, 0x7d, 0x50, 0x76, 0xa1, 0x19, 0xc0, 0xfe, 0xe4, 0x33, 0x52, 0x26, 0xb4, 0xf8, 0x8a, 0x56,
-	0x9a, 0x95, 0x4c, 0x53, 0x7d, 0xe5, 0xbf, 0x97, 0x63, 0x6f, 0xaa, 0xe4, 0xd0, 0x55, 0xce, 0x53,
-	0x06, 0x15, 0x1d, 0x56, 0x73, 0xbf, 0xaa, 0x67, 0xce, 0x7e, 0x2c, 0x5a, 0x0b, 0x28, 0x3e, 0xa2,
-	0xa4, 0x3a, 0x8f, 0x32, 0x6a, 0x43, 0x9d, 0xf5, 0x49, 0xa7, 0xa2, 0x7a, 0x98, 0x6a, 0x8e, 0x8f,
-	0xa8, 0xcd, 0x5e, 0x84, 0xa6, 0xc8, 0xa7, 0xf4, 0x73, 0xbc, 0x9b, 0x3b, 0x25, 0x34, 0x0d, 0xad,
-	0x55, 0x32, 0x5c, 0xef, 0x79, 0x41, 0xd8, 0x29, 0xa3, 0x7d, 0xd0, 0x66, 0x66, 0xfb, 0xc0, 0x1d,
-	0xee, 0x76, 0x2a, 0xb3, 0xef, 0x42, 0x5b, 0x76, 0x3e, 0x6a, 0x41, 0x6d, 0x79, 0x7b, 0x38, 0xec,
-	0x94, 0xd8, 0x2a, 0x3a, 0xf4, 0xfc, 0x28, 0x86, 0xbe, 0xf4, 0x8c, 0x4e, 0x89, 0x9d, 0x72, 0x9e,
-	0xe3, 0xaa, 0xa0, 0x0e, 0x4c, 0x0b, 0xe1, 0xbc, 0xce, 0x55, 0xf3, 0x9f, 0xca, 0xd0, 0x96, 0xaf,
-	0x6c, 0xd1, 0x25, 0x6c, 0xa4, 0x8e, 0xf9, 0x2e, 0xeb, 0x6a, 0x42, 0x31, 0xf3, 0x1f, 0xed, 0x4a,
-	0x28, 0xe7, 0x69, 0x98, 0x11, 0xb3, 0x43, 0x34, 0xe2, 0xdc, 0xc1, 0x27, 0x72, 0x67, 0xef, 0xc8,
-	0x5e, 0xef, 0x30, 0x6f, 0xb0, 0xe0, 0xb9, 0x2e, 0x19, 0x84, 0xac, 0xef, 0xf7, 0xc3, 0xd4, 0xb2,
-	0x17, 0xae, 0x78, 0x41, 0x40, 0x5b, 0xc6, 0x7b, 0x2a, 0x2e, 0xaf, 0xa0, 0x19, 0x80, 0xe8, 0xfa,
-	0x1c, 0xf5, 0xe7, 0xe6, 0x6f, 0x95, 0xa1, 0xc1, 0xdf, 0xfe, 0x32, 0x7f, 0xb5, 0x0c, 0x0d, 0xf1,
-	0xde, 0xd7, 0xab, 0xd0, 0xf1, 0x3d, 0x0a, 0x1c, 0xed, 0x7d, 0xfa, 0x8b, 0xa2, 0x95, 0xa9, 0x7c,
-	0xba, 0x1d, 0xf7, 0x14, 0x05, 0x16, 0xab, 0x15, 0x2d, 0x0f, 0x5d, 0x03, 0xe0, 0xef, 0x89, 0x3d,
-	0xdc, 0x95, 0x0f, 0x7c, 0x24, 0x6f, 0xcd, 0x89, 0x17, 0xc8, 0xd8, 0xf9, 0x92, 0x42, 0x3d, 0xfb,
-	0x79, 0xd8, 0x87, 0x49, 0x30, 0xf2, 0xdc, 0x80, 0xfc, 0xa8, 0xfe, 0x7f, 0x4a, 0xee, 0x7f, 0x42,
-	0x99, 0xfd, 0x6e, 0x1d, 0xea, 0x6c, 0x21, 0x6c, 0xfe, 0x75, 0x5d, 0x2e, 0xd9, 0x53, 0xae, 0xe8,
-	0x82, 0x7a, 0x77, 0x49, 0xf5, 0x29, 0xda, 0x1a, 0x5a, 0xbf, 0xb3, 0xf4, 0x16, 0xb4, 0x46, 0xbe,
-	0xb7, 0xe1, 0xd3, 0xa5, 0x77, 0x2d, 0xf1, 0xcc, 0x95, 0xce, 0xb6, 0x22, 0xc8, 0xb0, 0x64, 0x50,
-	0x95, 0xaf, 0xae, 0x2b, 0xdf, 0x2d, 0x68, 0xdb, 0xbe, 0x37, 0x62, 0xae, 0x41, 0x9c, 0x17, 0x9e,
-	0xc8, 0xc1, 0x5d, 0x8c, 0xe8, 0x7a, 0x25, 0x1c, 0x33, 0x51, 0xf5, 0xe5, 0xbd, 0x2f, 0x8e, 0xea,
-	0x5f, 0xc8, 0x61, 0xe7, 0xe3, 0xd5, 0x2b, 0x61, 0x41, 0x4e, 0x19, 0xc9, 0x33, 0xc6, 0xd8, 0x1a,
-	0xcb, 0xb8, 0xf4, 0x2c, 0x62, 0xe4, 0xe4, 0xe8, 0x3a, 0xb4, 0x02, 0x6b, 0x87, 0xb0, 0xd7, 0xd2,
-	0xdb, 0x63, 0xbb, 0x62, 0x55, 0x90, 0xf5, 0x4a, 0x58, 0xb2, 0xd0, 0x26, 0x6f, 0x39, 0x1b, 0x7c,
-	0xd3, 0x2b, 0x9e, 0x6c, 0xcf, 0x6b, 0xf2, 0xfd, 0x88, 0x8e, 0xbd, 0xaf, 0x1f, 0x25, 0xe8, 0x26,
-	0x8d, 0x7b, 0xf7, 0x29, 0x7e, 0x12, 0xce, 0x12, 0xe6, 0x14, 0xb4, 0x65, 0x17, 0x99, 0x2d, 0x69,
-	0x26, 0x2d, 0x68, 0xf0, 0x16, 0x98, 0x00, 0xad, 0xa8, 0x42, 0x94, 0x58, 0x82, 0x9b, 0xcb, 0xd0,
-	0x8a, 0x06, 0x2d, 0xe7, 0xa5, 0x0d, 0x04, 0x35, 0xdb, 0x13, 0xab, 0xbb, 0x2a, 0x66, 0xbf, 0xe9,
-	0xa0, 0xaa, 0x4f, 0x89, 0xb5, 0xe5, 0xbb, 0x5a, 0xb3, 0x73, 0xd1, 0x15, 0x2c, 0xea, 0xda, 0x78,
-	0xdc, 0x60, 0x0a, 0x9a, 0x78, 0x9b, 0x2d, 0xbc, 0x3b, 0x65, 0x9a, 0x4d, 0x77, 0x73, 0x9d, 0x0a,
-	0xf5, 0x92, 0x0b, 0x96, 0x3b, 0x20, 0x43, 0xb6, 0x58, 0x93, 0xbe, 0xb7, 0x36, 0xdf, 0x96, 0xe0,
-	0xf3, 0xc7, 0xfe, 0xe6, 0xfd, 0xe3, 0xe5, 0xef, 0xbc, 0x7f, 0xbc, 0xfc, 0xfd, 0xf7, 0x8f, 0x97,
-	0x7f, 0xf9, 0x07, 0xc7, 0x4b, 0xdf, 0xf9, 0xc1, 0xf1, 0xd2, 0x3f, 0xfe, 0xe0, 0x78, 0xe9, 0xbd,
-	0xca, 0x68, 0x6d, 0xad, 0xc1, 0xae, 0xd1, 0x5c, 0xfc, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x77,
-	0x40, 0x51, 0x3f, 0x16, 0x69, 0x00, 0x00,
+	// 6674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x59, 0x8c, 0x1c, 0xc7,
+	0x79, 0xff, 0xce, 0x3d, 0xf3, 0xed, 0x72, 0x39, 0x2c, 0x51, 0x62, 0xab, 0x45, 0x51, 0xd4, 0x4a,
+	0xa2, 0x28, 0x89, 0x1a, 0x52, 0x4b, 0x8a, 0x94, 0x29, 0xf1, 0xd8, 0x8b, 0xda, 0xe5, 0xb1, 0x5c,
+	0xf7, 0x92, 0xb2, 0x2c, 0x19, 0xff, 0xbf, 0x7b, 0xa7, 0x6b, 0x77, 0xdb, 0x9c, 0x9d, 0x1e, 0x77,
+	0xf7, 0x2c, 0xb9, 0x3e, 0x12, 0xc7, 0x47, 0x9c, 0x00, 0x09, 0x12, 0x04, 0x41, 0x9c, 0xb7, 0x00,
+	0x39, 0x90, 0x97, 0x20, 0x0e, 0x10, 0x20, 0x48, 0xf2, 0x90, 0x97, 0x20, 0xc8, 0x8d, 0xd8, 0x41,
+	0x1e, 0xf2, 0x92, 0xd8, 0x90, 0x81, 0x20, 0x40, 0x90, 0x87, 0xe4, 0x21, 0x48, 0xde, 0x82, 0xaf,
+	0x8e, 0xee, 0xaa, 0x3e, 0xa6, 0x67, 0x2c, 0x39, 0x07, 0xe2, 0x17, 0x72, 0xaa, 0xea, 0xfb, 0x7e,
+	0x5f, 0x1d, 0x5f, 0x7d, 0xf5, 0xd5, 0x57, 0xd5, 0xb5, 0xf0, 0xc4, 0x60, 0xeb, 0xec, 0xc0, 0xf7,
+	0x42, 0x2f, 0x38, 0x4b, 0xf7, 0x69, 0x3f, 0x0c, 0x3a, 0x2c, 0x45, 0x1a, 0x76, 0xff, 0x20, 0x3c,
+	0x18, 0x50, 0xf3, 0xf9, 0xc1, 0x83, 0x9d, 0xb3, 0x3d, 0x77, 0xeb, 0xec, 0x60, 0xeb, 0xec, 0x9e,
+	0xe7, 0xd0, 0x9e, 0x24, 0x67, 0x09, 0x41, 0x6e, 0x1e, 0xdf, 0xf1, 0xbc, 0x9d, 0x1e, 0xe5, 0x65,
+	0x5b, 0xc3, 0xed, 0xb3, 0x41, 0xe8, 0x0f, 0xbb, 0x21, 0x2f, 0x9d, 0xfb, 0xa7, 0xbf, 0x2a, 0x41,
+	0x6d, 0x05, 0xe1, 0xc9, 0x3c, 0x34, 0xf7, 0x68, 0x10, 0xd8, 0x3b, 0x34, 0x30, 0x4a, 0x27, 0x2b,
+	0xa7, 0xa7, 0xe7, 0x9f, 0xe8, 0x08, 0x51, 0x1d, 0x46, 0xd1, 0xb9, 0xc3, 0x8b, 0xad, 0x88, 0x8e,
+	0x1c, 0x87, 0x56, 0xd7, 0xeb, 0x87, 0xf4, 0x51, 0xb8, 0xe6, 0x18, 0xe5, 0x93, 0xa5, 0xd3, 0x2d,
+	0x2b, 0xce, 0x20, 0x17, 0xa0, 0xe5, 0xf6, 0xdd, 0xd0, 0xb5, 0x43, 0xcf, 0x37, 0x2a, 0x27, 0x4b,
+	0x1a, 0x24, 0xab, 0x64, 0x67, 0xa1, 0xdb, 0xf5, 0x86, 0xfd, 0xd0, 0x8a, 0x09, 0x89, 0x01, 0x8d,
+	0xd0, 0xb7, 0xbb, 0x74, 0xcd, 0x31, 0xaa, 0x0c, 0x51, 0x26, 0xcd, 0x0f, 0x2e, 0x42, 0x43, 0xd4,
+	0x81, 0x3c, 0x09, 0x8d, 0x60, 0xc0, 0xa9, 0xbe, 0x5a, 0xe2, 0x64, 0x22, 0x4d, 0xae, 0xc1, 0xb4,
+	0xcd, 0x61, 0x37, 0x77, 0xbd, 0x87, 0x46, 0x89, 0x09, 0x7e, 0x2a, 0xd1, 0x16, 0x21, 0xb8, 0x83,
+	0x24, 0xab, 0x53, 0x96, 0xca, 0x41, 0xd6, 0x60, 0x56, 0x24, 0x97, 0x69, 0x68, 0xbb, 0xbd, 0xc0,
+	0xf8, 0x53, 0x0e, 0x72, 0x22, 0x07, 0x44, 0x90, 0xad, 0x4e, 0x59, 0x09, 0x46, 0xf2, 0x49, 0x78,
+	0x4c, 0xe4, 0x2c, 0x79, 0xfd, 0x6d, 0x77, 0xe7, 0xfe, 0xc0, 0xb1, 0x43, 0x6a, 0xfc, 0x19, 0xc7,
+	0x7b, 0x3e, 0x07, 0x8f, 0xd3, 0x76, 0x38, 0xf1, 0xea, 0x94, 0x95, 0x85, 0x41, 0x6e, 0xc0, 0x21,
+	0x91, 0x2d, 0x40, 0xff, 0x9c, 0x83, 0x3e, 0x9d, 0x03, 0x1a, 0xa1, 0xe9, 0x6c, 0xe4, 0x7d, 0x38,
+	0x2a, 0x32, 0x6e, 0xbb, 0xfd, 0x07, 0x4b, 0xbb, 0x76, 0xaf, 0x47, 0xfb, 0x3b, 0xd4, 0xf8, 0x8b,
+	0xd1, 0x75, 0xd4, 0x88, 0x57, 0xa7, 0xac, 0x4c, 0x10, 0xb2, 0x03, 0x46, 0x56, 0xfe, 0xaa, 0xeb,
+	0x50, 0xe3, 0x2f, 0xb9, 0x80, 0xd3, 0x63, 0x09, 0x70, 0x1d, 0x14, 0x92, 0x0b, 0x46, 0xee, 0x42,
+	0xdb, 0xdb, 0xfa, 0x0c, 0xed, 0xca, 0x9e, 0xdf, 0xa4, 0xa1, 0xd1, 0x66, 0xf8, 0xcf, 0x26, 0xf0,
+	0xef, 0x32, 0x32, 0x39, 0x66, 0x9d, 0x4d, 0x1a, 0xae, 0x4e, 0x59, 0x29, 0x66, 0x72, 0x1f, 0x88,
+	0x96, 0xb7, 0xb0, 0x47, 0xfb, 0x8e, 0x31, 0xcf, 0x20, 0x9f, 0x1b, 0x0d, 0xc9, 0x48, 0x57, 0xa7,
+	0xac, 0x0c, 0x80, 0x14, 0xec, 0xfd, 0x7e, 0x40, 0x43, 0xe3, 0xfc, 0x38, 0xb0, 0x8c, 0x34, 0x05,
+	0xcb, 0x72, 0x71, 0x10, 0x79, 0xae, 0x45, 0x7b, 0x76, 0xe8, 0x7a, 0x7d, 0x51, 0xdf, 0x0b, 0x0c,
+	0xf8, 0x85, 0x6c, 0xe0, 0x88, 0x36, 0xaa, 0x71, 0x26, 0x08, 0xf9, 0x7f, 0xf0, 0x78, 0x22, 0xdf,
+	0xa2, 0x7b, 0xde, 0x3e, 0x35, 0x5e, 0x67, 0xe8, 0xa7, 0x8a, 0xd0, 0x39, 0xf5, 0xea, 0x94, 0x95,
+	0x0d, 0x43, 0x16, 0x61, 0x46, 0x16, 0x30, 0xd8, 0x8b, 0x0c, 0xf6, 0x78, 0x1e, 0xac, 0x00, 0xd3,
+	0x78, 0x70, 0xd2, 0xf3, 0xf4, 0x52, 0xcf, 0x0b, 0xa8, 0xb1, 0x90, 0x39, 0xe9, 0x05, 0x04, 0x23,
+	0xc1, 0x49, 0xaf, 0x70, 0xa8, 0x8d, 0x0c, 0x42, 0xdf, 0xed, 0xb2, 0x0a, 0xa2, 0x16, 0x5d, 0x1a,
+	0xdd, 0xc8, 0x98, 0x58, 0xa8, 0x52, 0x36, 0x0c, 0xb1, 0xe0, 0x70, 0x30, 0xdc, 0x0a, 0xba, 0xbe,
+	0x3b, 0xc0, 0xbc, 0x05, 0xc7, 0x31, 0xde, 0x1a, 0x85, 0xbc, 0xa9, 0x10, 0x77, 0x16, 0x1c, 0x1c,
+	0x9d, 0x24, 0x00, 0x79, 0x1f, 0x88, 0x9a, 0x25, 0xba, 0xef, 0x0a, 0x83, 0x7d, 0x69, 0x0c, 0xd8,
+	0xa8, 0x2f, 0x33, 0x60, 0x88, 0x0d, 0x47, 0xd5, 0xdc, 0x0d, 0x2f, 0x70, 0xf1, 0x7f, 0xe3, 0x2a,
+	0x83, 0x7f, 0x65, 0x0c, 0x78, 0xc9, 0x82, 0x8a, 0x95, 0x05, 0x95, 0x14, 0xb1, 0x84, 0x53, 0x9b,
+	0xfa, 0x81, 0x71, 0x6d, 0x6c, 0x11, 0x92, 0x25, 0x29, 0x42, 0xe6, 0x27, 0xbb, 0xe8, 0x6d, 0xdf,
+	0x1b, 0x0e, 0x02, 0xe3, 0xfa, 0xd8, 0x5d, 0xc4, 0x19, 0x92, 0x5d, 0xc4, 0x73, 0x93, 0xf5, 0xbf,
+	0xe1, 0xf9, 0x7b, 0xc3, 0x9e, 0x1d, 0x18, 0x8b, 0x63, 0xd7, 0x5f, 0xb2, 0x24, 0xeb, 0x2f, 0xf3,
+	0xc9, 0x45, 0x68, 0x6e, 0xf5, 0xbc, 0xee, 0x83, 0x05, 0x87, 0x2f, 0xb0, 0xd3, 0xf3, 0x46, 0x02,
+	0x76, 0x11, 0x8b, 0x85, 0x86, 0x44, 0xb4, 0x38, 0x1f, 0xd8, 0xef, 0x65, 0xda, 0xa3, 0x21, 0x35,
+	0x2a, 0x99, 0xf3, 0x81, 0xb3, 0x72, 0x12, 0x9c, 0x0f, 0x0a, 0x07, 0x59, 0x86, 0xe9, 0x6d, 0xb7,
+	0x47, 0x83, 0xfb, 0x83, 0x9e, 0x67, 0xf3, 0xa5, 0x78, 0x7a, 0xfe, 0x64, 0x26, 0xc0, 0x8d, 0x98,
+	0x0e, 0x51, 0x14, 0x36, 0x72, 0x15, 0x5a, 0x7b, 0xb6, 0xff, 0x20, 0x58, 0xeb, 0x6f, 0x7b, 0x46,
+	0x2d, 0x73, 0x11, 0xe5, 0x18, 0x77, 0x24, 0xd5, 0xea, 0x94, 0x15, 0xb3, 0xe0, 0x52, 0xcc, 0x2a,
+	0xb5, 0x49, 0xc3, 0x1b, 0x2e, 0xed, 0x39, 0x81, 0x51, 0x67, 0x20, 0xcf, 0x64, 0x82, 0x6c, 0xd2,
+	0xb0, 0xc3, 0xc9, 0x70, 0x29, 0xd6, 0x19, 0xc9, 0xbb, 0xf0, 0x98, 0xcc, 0x59, 0xda, 0x75, 0x7b,
+	0x8e, 0x4f, 0xfb, 0x6b, 0x4e, 0x60, 0x34, 0x32, 0x57, 0xb9, 0x18, 0x4f, 0xa1, 0xc5, 0x95, 0x38,
+	0x03, 0x02, 0x8d, 0xaf, 0xcc, 0x56, 0x67, 0xbd, 0xd1, 0xcc, 0x34, 0xbe, 0x31, 0xb4, 0x4a, 0x8c,
+	0x0a, 0x90, 0x05, 0x42, 0x1c, 0x38, 0x26, 0xf3, 0x17, 0xed, 0xee, 0x83, 0x1d, 0xdf, 0x1b, 0xf6,
+	0x9d, 0x25, 0xaf, 0xe7, 0xf9, 0x46, 0x2b, 0x73, 0xfd, 0x8c, 0xf1, 0x13, 0xf4, 0xab, 0x53, 0x56,
+	0x1e, 0x14, 0x59, 0x82, 0x19, 0x59, 0x74, 0x8f, 0x3e, 0x0a, 0x0d, 0xc8, 0x74, 0x25, 0x62, 0x68,
+	0x24, 0x42, 0x1b, 0xac, 0x32, 0xa9, 0x20, 0xa8, 0x12, 0xc6, 0x74, 0x01, 0x08, 0x12, 0xa9, 0x20,
+	0x98, 0x56, 0x41, 0x70, 0x95, 0x37, 0x0e, 0x15, 0x80, 0x20, 0x91, 0x0a, 0x82, 0x69, 0xf4, 0x06,
+	0xa2, 0x96, 0x7a, 0xde, 0x03, 0xd4, 0x27, 0x63, 0x36, 0xd3, 0x1b, 0x50, 0x7a, 0x4b, 0x10, 0xa2,
+	0x37, 0x90, 0x64, 0x46, 0x67, 0x4b, 0xe6, 0x2d, 0xf4, 0xdc, 0x9d, 0xbe, 0x71, 0x78, 0x84, 0x2e,
+	0x23, 0x1a, 0xa3, 0x42, 0x67, 0x4b, 0x63, 0x23, 0xd7, 0xc5, 0xb4, 0xdc, 0xa4, 0xe1, 0xb2, 0xbb,
+	0x6f, 0x1c, 0xc9, 0x5c, 0xe9, 0x62, 0x94, 0x65, 0x77, 0x3f, 0x9a, 0x97, 0x9c, 0x45, 0x6d, 0x9a,
+	0x5c, 0x47, 0x8d, 0xc7, 0x0b, 0x9a, 0x26, 0x09, 0xd5, 0xa6, 0xc9, 0x3c, 0xb5, 0x69, 0xb7, 0xed,
+	0x90, 0x3e, 0x32, 0x9e, 0x2c, 0x68, 0x1a, 0xa3, 0x52, 0x9b, 0xc6, 0x32, 0x70, 0x01, 0x95, 0x19,
+	0xef, 0x50, 0x3f, 0x74, 0xbb, 0x76, 0x8f, 0x77, 0xd5, 0xf3, 0x99, 0xcb, 0x5c, 0x8c, 0xa7, 0x51,
+	0xe3, 0x02, 0x9a, 0x09, 0xa3, 0x36, 0xfc, 0x9e, 0xbd, 0xd5, 0xa3, 0x96, 0xf7, 0xd0, 0x78, 0xa1,
+	0xa0, 0xe1, 0x92, 0x50, 0x6d, 0xb8, 0xcc, 0x53, 0x6d, 0xcb, 0x27, 0x5c, 0x67, 0x87, 0x86, 0xc6,
+	0xe9, 0x02, 0xdb, 0xc2, 0xc9, 0x54, 0xdb, 0xc2, 0x73, 0x22, 0x0b, 0xb0, 0x6c, 0x87, 0xf6, 0xbe,
+	0x4b, 0x1f, 0xbe, 0xe3, 0xd2, 0x87, 0xe8, 0x3b, 0x3c, 0x36, 0xc2, 0x02, 0x48, 0xda, 0x8e, 0x20,
+	0x8e, 0x2c, 0x40, 0x02, 0x24, 0xb2, 0x00, 0x6a, 0xbe, 0x30, 0xeb, 0x47, 0x47, 0x58, 0x00, 0x0d,
+	0x3f, 0xb2, 0xf1, 0x79, 0x50, 0xc4, 0x86, 0x27, 0x52, 0x45, 0x77, 0x7d, 0x87, 0xfa, 0xc6, 0xd3,
+	0x4c, 0xc8, 0x8b, 0xc5, 0x42, 0x18, 0xf9, 0xea, 0x94, 0x95, 0x03, 0x94, 0x12, 0xb1, 0xe9, 0x0d,
+	0xfd, 0x2e, 0xc5, 0x7e, 0x7a, 0x6e, 0x1c, 0x11, 0x11, 0x79, 0x4a, 0x44, 0x54, 0x42, 0xf6, 0xe1,
+	0xe9, 0xa8, 0x04, 0x05, 0xb3, 0x85, 0x9a, 0x49, 0x17, 0x9b, 0xa4, 0x53, 0x4c, 0x52, 0x67, 0xb4,
+	0xa4, 0x24, 0xd7, 0xea, 0x94, 0x35, 0x1a, 0x96, 0x1c, 0xc0, 0x09, 0x8d, 0x80, 0xaf, 0xf5, 0xaa,
+	0xe0, 0x17, 0x99, 0xe0, 0xb3, 0xa3, 0x05, 0xa7, 0xd8, 0x56, 0xa7, 0xac, 0x02, 0x60, 0x32, 0x80,
+	0xa7, 0xb4, 0xce, 0x90, 0x13, 0x5b, 0xa8, 0xc8, 0x17, 0x98, 0xdc, 0x33, 0xa3, 0xe5, 0xea, 0x3c,
+	0xab, 0x53, 0xd6, 0x28, 0x48, 0xdc, 0xd4, 0x65, 0x16, 0xe3, 0x48, 0x7e, 0x3e, 0xd3, 0xb3, 0xca,
+	0x11, 0xc7, 0xc7, 0x32, 0x17, 0x2c, 0x53, 0xf3, 0x45, 0x77, 0x7e, 0x71, 0x5c, 0xcd, 0x8f, 0xfa,
+	0x31, 0x0f, 0x4a, 0x1b, 0x3b, 0x2c, 0xba, 0x67, 0xfb, 0x3b, 0x34, 0xe4, 0x1d, 0xbd, 0xe6, 0x60,
+	0xa3, 0x7e, 0x64, 0x9c, 0xb1, 0x4b, 0xb1, 0x69, 0x63, 0x97, 0x09, 0x4c, 0x02, 0x38, 0xae, 0x51,
+	0xac, 0x05, 0x4b, 0x5e, 0xaf, 0x47, 0xbb, 0xb2, 0x37, 0x7f, 0x94, 0x09, 0x7e, 0x75, 0xb4, 0xe0,
+	0x04, 0xd3, 0xea, 0x94, 0x35, 0x12, 0x34, 0xd5, 0xde, 0xbb, 0x3d, 0x27, 0xa1, 0x33, 0xc6, 0x58,
+	0xba, 0x9a, 0x64, 0x4b, 0xb5, 0x37, 0x45, 0x91, 0xd2, 0x55, 0x85, 0x02, 0x9b, 0x7b, 0x6c, 0x1c,
+	0x5d, 0xd5, 0x79, 0x52, 0xba, 0xaa, 0x17, 0xe3, 0xea, 0x36, 0x0c, 0xa8, 0xcf, 0x30, 0x6e, 0x7a,
+	0x6e, 0xdf, 0x78, 0x26, 0x73, 0x75, 0xbb, 0x1f, 0x50, 0x5f, 0x08, 0x42, 0x2a, 0x5c, 0xdd, 0x34,
+	0x36, 0x0d, 0xe7, 0x36, 0xdd, 0x0e, 0x8d, 0x93, 0x45, 0x38, 0x48, 0xa5, 0xe1, 0x60, 0x06, 0xae,
+	0x14, 0x51, 0xc6, 0x26, 0xc5, 0x51, 0xb1, 0x6c, 0x8c, 0xb6, 0x3c, 0x9b, 0xb9, 0x52, 0x28, 0x70,
+	0x0a, 0x31, 0xae, 0x14, 0x59, 0x20, 0x18, 0x5c, 0x88, 0xf2, 0xd1, 0x23, 0xe3, 0xd0, 0x73, 0x99,
+	0xc1, 0x05, 0x05, 0x3a, 0x22, 0xc5, 0x6d, 0x4e, 0x1a, 0x80, 0xbc, 0x04, 0xd5, 0x81, 0xdb, 0xdf,
+	0x31, 0x1c, 0x06, 0xf4, 0x58, 0x02, 0x68, 0xc3, 0xed, 0xef, 0xac, 0x4e, 0x59, 0x8c, 0x84, 0xbc,
+	0x05, 0x30, 0xf0, 0xbd, 0x2e, 0x0d, 0x82, 0x75, 0xfa, 0xd0, 0xa0, 0x8c, 0xc1, 0x4c, 0x32, 0x70,
+	0x82, 0xce, 0x3a, 0xc5, 0x75, 0x59, 0xa1, 0x27, 0x2b, 0x70, 0x48, 0xa4, 0xc4, 0x2c, 0xdf, 0xce,
+	0x74, 0xfe, 0x24, 0x40, 0x1c, 0xd1, 0xd2, 0xb8, 0x70, 0xef, 0x23, 0x32, 0x96, 0xbd, 0x3e, 0x35,
+	0x76, 0x32, 0xf7, 0x3e, 0x12, 0x04, 0x49, 0xd0, 0xc7, 0x52, 0x38, 0x30, 0x20, 0x11, 0xee, 0xfa,
+	0xd4, 0x76, 0x36, 0x43, 0x3b, 0x1c, 0x06, 0x46, 0x3f, 0xd3, 0x4d, 0xe3, 0x85, 0x9d, 0x7b, 0x8c,
+	0x12, 0x5d, 0x50, 0x95, 0x87, 0xac, 0x43, 0x1b, 0x37, 0x42, 0xb7, 0xdd, 0x3d, 0x37, 0xb4, 0xa8,
+	0xdd, 0xdd, 0xa5, 0x8e, 0xe1, 0x65, 0x6e, 0xa2, 0xd0, 0xed, 0xed, 0xa8, 0x74, 0xe8, 0xad, 0x24,
+	0x79, 0xc9, 0x2a, 0xcc, 0x62, 0xde, 0xe6, 0xc0, 0xee, 0xd2, 0xfb, 0x18, 0x02, 0x35, 0x06, 0x99,
+	0x1a, 0xc8, 0xd0, 0x62, 0x2a, 0x74, 0x56, 0x74, 0x3e, 0x89, 0x74, 0xdb, 0xeb, 0xda, 0x3d, 0x8e,
+	0xf4, 0xd9, 0x7c, 0xa4, 0x98, 0x4a, 0x22, 0xc5, 0x39, 0x5a, 0x1b, 0x79, 0xdf, 0x3b, 0xc6, 0x7e,
+	0x41, 0x1b, 0x05, 0x9d, 0xd6, 0x46, 0x91, 0x87, 0x78, 0x7d, 0x2f, 0x74, 0xb7, 0xdd, 0xae, 0x98,
+	0xbf, 0x7d, 0xc7, 0xf0, 0x33, 0xf1, 0xd6, 0x15, 0xb2, 0xce, 0x26, 0x0f, 0x5e, 0xa5, 0x78, 0xc9,
+	0x3d, 0x20, 0x6a, 0x9e, 0x50, 0xaa, 0x80, 0x21, 0xce, 0x8d, 0x42, 0x8c, 0x34, 0x2b, 0x83, 0x1f,
+	0x6b, 0x39, 0xb0, 0x0f, 0x70, 0x7b, 0xbb, 0xe8, 0x7b, 0xb6, 0xd3, 0xb5, 0x83, 0xd0, 0x08, 0x33,
+	0x6b, 0xb9, 0xc1, 0xc9, 0x3a, 0x11, 0x1d, 0xd6, 0x32, 0xc9, 0x8b, 0x78, 0x7b, 0x74, 0x6f, 0x8b,
+	0xfa, 0xc1, 0xae, 0x3b, 0x10, 0x75, 0x1c, 0x66, 0xe2, 0xdd, 0x89, 0xc8, 0xe2, 0x1a, 0xa6, 0x78,
+	0xd1, 0x11, 0x67, 0xa1, 0xf0, 0xcd, 0x83, 0x7e, 0x97, 0x2b, 0xa3, 0x00, 0x7d, 0x98, 0xe9, 0x88,
+	0x33, 0xcd, 0xe8, 0xc4, 0xc4, 0x31, 0x74, 0x36, 0x0c, 0x79, 0x0f, 0x8e, 0xb2, 0x82, 0x85, 0x61,
+	0xe8, 0x71, 0xff, 0x77, 0xc1, 0x71, 0xa8, 0x63, 0x7c, 0x2e, 0x73, 0x27, 0xcd, 0xe1, 0x13, 0xb4,
+	0x2c, 0xdc, 0x91, 0x81, 0x41, 0x6e, 0xc1, 0xe1, 0xc1, 0xfc, 0x40, 0xab, 0xf5, 0xa3, 0x4c, 0xa7,
+	0x7c, 0x63, 0x7e, 0x23, 0x59, 0xdd, 0x24, 0x27, 0x4e, 0x63, 0x77, 0x6f, 0xe0, 0xf9, 0xe1, 0x0d,
+	0xb7, 0xef, 0x06, 0xbb, 0xc6, 0x41, 0xe6, 0x34, 0x5e, 0x63, 0x24, 0x1d, 0x4e, 0x83, 0xd3, 0x58,
+	0xe5, 0x21, 0x17, 0xa0, 0xd1, 0xdd, 0xb5, 0xb1, 0x76, 0xc6, 0x97, 0x78, 0xbc, 0xfa, 0x58, 0x82,
+	0x7f, 0x69, 0xd7, 0x0e, 0x45, 0xf8, 0x45, 0x92, 0x92, 0x2b, 0x00, 0xf8, 0x53, 0xb4, 0xe0, 0xc7,
+	0x4a, 0x99, 0x76, 0x90, 0x31, 0x46, 0xb5, 0x57, 0x18, 0x30, 0x54, 0x11, 0xa7, 0xd0, 0x00, 0xf0,
+	0x78, 0xc2, 0x97, 0x4b, 0x99, 0x96, 0x5c, 0xc1, 0x89, 0x68, 0x31, 0x54, 0x91, 0x01, 0x81, 0x0b,
+	0x70, 0x9c, 0x2d, 0xcf, 0x73, 0x62, 0x43, 0xf7, 0xe3, 0xa5, 0xcc, 0xc8, 0x95, 0x22, 0x21, 0xc5,
+	0x83, 0x0b, 0xf0, 0x08, 0xc8, 0xa4, 0xc4, 0x3e, 0x8f, 0x30, 0x46, 0x12, 0xbf, 0x3e, 0x86, 0xc4,
+	0x04, 0x4f, 0x52, 0x62, 0xa2, 0x38, 0xb3, 0x8d, 0xb1, 0x12, 0x1b, 0x3f, 0x31, 0x6e, 0x1b, 0x63,
+	0x9e, 0xcc, 0x36, 0xc6, 0xc5, 0x72, 0xb8, 0x85, 0xf7, 0xf4, 0x95, 0x11, 0xc3, 0x1d, 0x79, 0x4a,
+	0x0a, 0x03, 0xb9, 0x0d, 0x87, 0x31, 0x85, 0x60, 0x54, 0xa8, 0xcc, 0xd7, 0x4a, 0x99, 0x5a, 0xaf,
+	0x54, 0x72, 0x33, 0x14, 0x5a, 0x9f, 0x60, 0x45, 0x8d, 0xb5, 0xdd, 0xa5, 0xdd, 0x61, 0xff, 0x81,
+	0xf1, 0x93, 0xd9, 0x1a, 0xbb, 0xb0, 0xd6, 0x61, 0xe5, 0xa8, 0xb1, 0x82, 0x74, 0xb1, 0x01, 0xb5,
+	0x7d, 0xbb, 0x37, 0xa4, 0xe6, 0xbf, 0xd6, 0xa1, 0x8a, 0x62, 0xcc, 0xbf, 0x2f, 0x41, 0x05, 0x75,
+	0x79, 0x16, 0xca, 0xae, 0x63, 0xf0, 0x33, 0xb6, 0xb2, 0xeb, 0xe0, 0xf9, 0x9c, 0x87, 0xdb, 0x8f,
+	0xe8, 0xc4, 0x4f, 0x26, 0xc9, 0x1c, 0xcc, 0xd8, 0xdb, 0x21, 0xf5, 0xef, 0x8a, 0xe2, 0x3a, 0x2b,
+	0xd6, 0xf2, 0xb0, 0x76, 0xe2, 0xf4, 0xd0, 0xa8, 0x24, 0xba, 0x89, 0x9f, 0x08, 0xa2, 0x6c, 0xa9,
+	0x45, 0x92, 0x94, 0x3c, 0x01, 0xf5, 0x60, 0xb8, 0x85, 0xe1, 0xba, 0xea, 0xc9, 0xca, 0xe9, 0x96,
+	0x25, 0x52, 0xe4, 0x4d, 0x98, 0x71, 0xe8, 0x80, 0xf6, 0x1d, 0xda, 0xef, 0xba, 0x34, 0x30, 0x6a,
+	0xec, 0xdc, 0xf2, 0x58, 0x87, 0x9f, 0x79, 0x76, 0xe4, 0x99, 0x67, 0x67, 0x93, 0x9d, 0x79, 0x5a,
+	0x1a, 0xb1, 0x79, 0x0e, 0xea, 0x62, 0x00, 0x92, 0x4d, 0x8c, 0xc5, 0x95, 0x55, 0x71, 0xe6, 0x36,
+	0xd4, 0x45, 0x27, 0x27, 0x39, 0x94, 0x66, 0x95, 0xbf, 0x9f, 0x66, 0x55, 0x34, 0x39, 0x5f, 0x84,
+	0xc3, 0xc9, 0x89, 0x9b, 0x14, 0xb8, 0x08, 0x2d, 0x5f, 0x16, 0x1a, 0xe5, 0x84, 0xe5, 0x4d, 0x89,
+	0xec, 0x44, 0x40, 0x56, 0xcc, 0x96, 0x2b, 0xfe, 0x7d, 0x38, 0x96, 0x37, 0x9b, 0xdb, 0x50, 0x71,
+	0x1d, 0x7e, 0x3e, 0xdc, 0xb2, 0xf0, 0x27, 0x82, 0xb8, 0x01, 0x52, 0xb0, 0x5a, 0x34, 0x2d, 0x91,
+	0x1a, 0x07, 0x3c, 0x39, 0x71, 0x3f, 0x3c, 0xf8, 0xff, 0x87, 0x63, 0x79, 0x73, 0x34, 0x0d, 0x6e,
+	0x42, 0xd3, 0x0d, 0x90, 0x82, 0x4a, 0xf8, 0x28, 0x9d, 0x2b, 0xe0, 0x3e, 0x4c, 0x2b, 0xd3, 0x8f,
+	0x74, 0xa0, 0x16, 0xe0, 0x0f, 0xa3, 0x94, 0x08, 0xcd, 0xc7, 0x23, 0xc0, 0x08, 0x2d, 0x4e, 0x96,
+	0xab, 0x58, 0xbf, 0x5f, 0x87, 0x86, 0x38, 0xf7, 0x34, 0xd7, 0xa1, 0xca, 0x4e, 0xa1, 0x8f, 0x42,
+	0xcd, 0xed, 0x3b, 0xf4, 0x11, 0xc3, 0xae, 0x59, 0x3c, 0x41, 0xce, 0x41, 0x43, 0x9c, 0x81, 0x1a,
+	0xe5, 0x91, 0x27, 0xea, 0x92, 0xcc, 0x7c, 0x0f, 0x1a, 0xf2, 0x34, 0xfa, 0x38, 0xb4, 0x06, 0xbe,
+	0x87, 0x6e, 0xd7, 0x9a, 0xd4, 0xa5, 0x38, 0x83, 0xbc, 0x06, 0x0d, 0x87, 0x13, 0x0a, 0xe8, 0xdc,
+	0x79, 0x24, 0xe9, 0xcc, 0x2f, 0x95, 0xa0, 0xce, 0x0f, 0xa5, 0xcd, 0xfd, 0x68, 0x6e, 0xbc, 0x0e,
+	0xf5, 0x2e, 0xcb, 0x33, 0x92, 0x07, 0xd2, 0x5a, 0x0d, 0xc5, 0x29, 0xb7, 0x25, 0x88, 0x91, 0x2d,
+	0xe0, 0x16, 0xba, 0x3c, 0x92, 0x8d, 0x8f, 0xa7, 0x25, 0x88, 0xff, 0xdb, 0xe4, 0xfe, 0x4d, 0x19,
+	0x0e, 0xe9, 0x67, 0xdd, 0x78, 0x19, 0x42, 0x26, 0x64, 0xef, 0x46, 0x19, 0xe4, 0x2e, 0x40, 0xb7,
+	0xe7, 0xd2, 0x7e, 0xc8, 0x8e, 0x42, 0xca, 0x99, 0x3b, 0xec, 0xcc, 0xa3, 0xef, 0xce, 0x52, 0xc4,
+	0x66, 0x29, 0x10, 0xe4, 0x1a, 0xd4, 0x82, 0xae, 0x37, 0xe0, 0x76, 0x74, 0x76, 0xfe, 0xa5, 0x9c,
+	0x6a, 0x2f, 0x0c, 0xc3, 0x5d, 0xee, 0xc5, 0x2f, 0x0c, 0xdc, 0x4d, 0x64, 0xb0, 0x38, 0x9f, 0xf9,
+	0x73, 0x25, 0x80, 0x18, 0x9b, 0x9c, 0x8c, 0x76, 0x4d, 0xeb, 0xf6, 0x9e, 0x6c, 0x80, 0x9a, 0xa5,
+	0x50, 0x6c, 0xd8, 0xe1, 0xae, 0xb0, 0xfe, 0x6a, 0x16, 0x21, 0x50, 0xed, 0x23, 0x33, 0xbf, 0xb8,
+	0xc1, 0x7e, 0x93, 0x33, 0x70, 0x24, 0x70, 0x77, 0xfa, 0x76, 0x38, 0xf4, 0xe9, 0x3b, 0xd4, 0x77,
+	0xb7, 0x5d, 0xea, 0xb0, 0x3a, 0x37, 0xad, 0x74, 0x81, 0xf9, 0x1a, 0x1c, 0x49, 0x1f, 0xee, 0x8f,
+	0xec, 0x59, 0xf3, 0x9b, 0xd3, 0x50, 0xe7, 0x41, 0x15, 0xf3, 0xdf, 0xca, 0x91, 0xb2, 0x9b, 0x7f,
+	0x58, 0x82, 0x1a, 0x3f, 0xbf, 0x4e, 0xda, 0xce, 0x1b, 0xaa, 0xa2, 0x57, 0x32, 0x22, 0x0e, 0x59,
+	0xe7, 0xf9, 0x9d, 0x5b, 0xf4, 0xe0, 0x1d, 0x5c, 0x21, 0x23, 0xed, 0xcf, 0x35, 0x12, 0x37, 0xa1,
+	0x29, 0x89, 0xd1, 0xec, 0x3c, 0xa0, 0x07, 0x42, 0x38, 0xfe, 0x24, 0x67, 0xc4, 0x4a, 0x1b, 0xcd,
+	0xdf, 0xe4, 0x24, 0xe3, 0x52, 0xc4, 0x72, 0xfc, 0x69, 0xa8, 0x60, 0x18, 0x23, 0xd9, 0x84, 0xc9,
+	0xe7, 0x6a, 0x6e, 0x6d, 0x97, 0xa0, 0xc6, 0xef, 0x10, 0x24, 0x65, 0x10, 0xa8, 0x3e, 0xa0, 0x07,
+	0xd2, 0x54, 0xb1, 0xdf, 0xb9, 0x20, 0xff, 0x50, 0x83, 0x19, 0xf5, 0x60, 0xd3, 0x5c, 0xc9, 0x75,
+	0x1e, 0x98, 0x3b, 0x10, 0x3b, 0x0f, 0x22, 0x89, 0xe6, 0x8e, 0x61, 0x31, 0xd5, 0x68, 0x59, 0x3c,
+	0x61, 0x76, 0xa0, 0x2e, 0x8e, 0xa3, 0x93, 0x48, 0x11, 0x7d, 0x59, 0xa5, 0xbf, 0x09, 0xcd, 0xe8,
+	0x74, 0xf9, 0xc3, 0xca, 0xf6, 0xa1, 0x19, 0x1d, 0x23, 0x1f, 0x85, 0x5a, 0xe8, 0x85, 0x76, 0x8f,
+	0xc1, 0x55, 0x2c, 0x9e, 0x40, 0xbd, 0xec, 0xd3, 0x47, 0xe1, 0x52, 0x64, 0x8e, 0x2b, 0x56, 0x9c,
+	0xc1, 0xad, 0x2d, 0xdd, 0xe7, 0xa5, 0x15, 0x5e, 0x1a, 0x65, 0xc4, 0x32, 0xab, 0xaa, 0xcc, 0x03,
+	0xa8, 0x8b, 0xb3, 0xe5, 0xa8, 0xbc, 0xa4, 0x94, 0x93, 0x05, 0xa8, 0xe1, 0xb1, 0xdd, 0xc0, 0x28,
+	0x27, 0x9c, 0x58, 0x3e, 0xe9, 0x79, 0x3c, 0x67, 0xc9, 0xeb, 0x87, 0xa8, 0xc6, 0x7a, 0x3c, 0xdb,
+	0xe2, 0x9c, 0x38, 0x84, 0x3e, 0xbf, 0x28, 0xc0, 0x27, 0xa1, 0x48, 0x99, 0xbf, 0x52, 0x86, 0x66,
+	0x74, 0xec, 0x9c, 0x2d, 0xfd, 0x2e, 0x34, 0xb7, 0x05, 0x85, 0x98, 0x39, 0xe7, 0x27, 0x38, 0xe3,
+	0x96, 0x3f, 0xac, 0x08, 0xc4, 0xfc, 0x66, 0x09, 0x1a, 0x22, 0x17, 0xad, 0x8b, 0x2f, 0x02, 0x77,
+	0xb7, 0xa2, 0x19, 0xa3, 0x66, 0x91, 0x7b, 0xd0, 0x10, 0x9c, 0xac, 0xf9, 0xb3, 0xf3, 0x97, 0xc7,
+	0x6b, 0xbe, 0x8c, 0x07, 0xca, 0x0a, 0xdc, 0x3b, 0x18, 0x50, 0x4b, 0x42, 0xc5, 0xf3, 0xb1, 0x32,
+	0xce, 0x7c, 0xfc, 0xb5, 0x12, 0xb4, 0x24, 0x5e, 0x60, 0xbe, 0x97, 0x67, 0x62, 0x16, 0xe0, 0x90,
+	0xac, 0x39, 0x9a, 0x33, 0xd9, 0x5d, 0x4f, 0x25, 0x2a, 0x6c, 0x29, 0x34, 0x96, 0xce, 0x61, 0xbe,
+	0x95, 0xab, 0xfa, 0x73, 0x30, 0xa3, 0x74, 0x8b, 0x9c, 0xa0, 0x5a, 0x9e, 0x69, 0x46, 0xdc, 0x29,
+	0xc7, 0xc7, 0xdc, 0x86, 0x19, 0xf5, 0x88, 0xd9, 0x7c, 0x27, 0xdb, 0xc6, 0x5c, 0x43, 0x31, 0x31,
+	0x99, 0x50, 0xb9, 0x74, 0x13, 0x62, 0x12, 0x4b, 0x63, 0x30, 0x8f, 0x41, 0x8d, 0xdf, 0xad, 0x49,
+	0x20, 0x9b, 0xff, 0xe1, 0x40, 0x8d, 0x8d, 0x95, 0x79, 0x9e, 0x9b, 0x89, 0x33, 0x50, 0x67, 0x41,
+	0x5c, 0x79, 0xf3, 0xf0, 0x68, 0xd6, 0xc0, 0x5a, 0x82, 0xc6, 0x5c, 0x82, 0x69, 0xe5, 0xca, 0x01,
+	0xce, 0x6b, 0x56, 0x10, 0x69, 0xab, 0x4c, 0xa2, 0x87, 0x87, 0xbe, 0x8d, 0x58, 0xad, 0xb0, 0xfd,
+	0x51, 0xda, 0x7c, 0x3e, 0xf2, 0xfe, 0x4d, 0x71, 0xc5, 0x62, 0x2d, 0xea, 0xa5, 0x28, 0x6d, 0x7e,
+	0x0a, 0x5a, 0xd1, 0xcd, 0x04, 0x72, 0x17, 0x66, 0xc4, 0xcd, 0x04, 0x1e, 0x58, 0x45, 0xe2, 0xd9,
+	0x82, 0x39, 0x88, 0x51, 0x54, 0x76, 0xb9, 0xa1, 0xc3, 0xb4, 0x4e, 0x03, 0x30, 0xbf, 0x76, 0x9a,
+	0xf5, 0xbc, 0x39, 0x80, 0x66, 0x74, 0x1c, 0x9b, 0x1c, 0x85, 0x4b, 0x7c, 0x01, 0x29, 0x17, 0xde,
+	0x25, 0x10, 0x3a, 0x7e, 0x8b, 0x1e, 0xb0, 0x75, 0xc6, 0x7c, 0x0a, 0x2a, 0x38, 0x69, 0x8e, 0x4a,
+	0xf5, 0x16, 0x33, 0x99, 0xab, 0xf1, 0x1a, 0xd4, 0xc5, 0xb5, 0x88, 0xa4, 0xbc, 0xb3, 0x50, 0xdf,
+	0x66, 0x25, 0x45, 0x0b, 0x8b, 0x20, 0x33, 0xaf, 0xc1, 0xb4, 0x7a, 0x19, 0x22, 0x89, 0x77, 0x12,
+	0xa6, 0xbb, 0x71, 0xb1, 0x18, 0x06, 0x35, 0xcb, 0xa4, 0xba, 0x3a, 0xa6, 0x10, 0x56, 0x32, 0xf5,
+	0xf0, 0xd9, 0xcc, 0x6e, 0x1f, 0xa1, 0x8d, 0xb7, 0xe0, 0x70, 0xf2, 0xd6, 0x43, 0x52, 0xd2, 0x69,
+	0x38, 0xbc, 0xa5, 0x93, 0x88, 0x95, 0x22, 0x99, 0x6d, 0xae, 0x41, 0x8d, 0x9f, 0x4a, 0x27, 0x21,
+	0xce, 0x41, 0xcd, 0xc6, 0x02, 0x61, 0xa1, 0xcc, 0xcc, 0x5a, 0x32, 0x56, 0x8b, 0x13, 0x9a, 0x2e,
+	0x1c, 0xd2, 0x0f, 0xba, 0x93, 0x90, 0xab, 0x70, 0x68, 0x5f, 0x25, 0x10, 0xd0, 0x73, 0x99, 0xd0,
+	0x1a, 0x94, 0xa5, 0x33, 0x9a, 0x5f, 0xae, 0x43, 0x95, 0xdd, 0xd4, 0x48, 0x8a, 0xb8, 0x08, 0x55,
+	0xbc, 0xb3, 0x2b, 0xba, 0x76, 0x6e, 0xe4, 0xb5, 0x0f, 0xf6, 0x8f, 0xc5, 0xe8, 0xc9, 0xc7, 0x70,
+	0xff, 0x73, 0xd0, 0x93, 0xb6, 0xf3, 0xb9, 0xd1, 0x8c, 0x9b, 0x48, 0x6a, 0x71, 0x0e, 0x64, 0x65,
+	0x73, 0xc1, 0xa8, 0x8e, 0xc3, 0xca, 0x26, 0xa1, 0xc5, 0x39, 0xc8, 0x35, 0x8c, 0xc9, 0xd1, 0xee,
+	0x03, 0xea, 0x18, 0xb5, 0x82, 0x69, 0xc1, 0x98, 0x97, 0x38, 0xb1, 0x25, 0xb9, 0x50, 0x76, 0x97,
+	0x8d, 0x6e, 0x7d, 0x1c, 0xd9, 0x6c, 0xc4, 0x2d, 0xce, 0x41, 0x56, 0xa0, 0xe5, 0x76, 0xbd, 0xfe,
+	0xca, 0x9e, 0xf7, 0x19, 0xd7, 0x68, 0x8c, 0x38, 0xb6, 0x8e, 0xd8, 0xd7, 0x24, 0xb9, 0x15, 0x73,
+	0x4a, 0x98, 0xb5, 0x3d, 0x8c, 0x18, 0x34, 0xc7, 0x85, 0x61, 0xe4, 0x56, 0xcc, 0x69, 0x1e, 0x17,
+	0xe3, 0x99, 0x3d, 0xc9, 0x6f, 0x40, 0x8d, 0x75, 0x39, 0xb9, 0xa2, 0x16, 0xcf, 0xce, 0xbf, 0x98,
+	0xa9, 0x39, 0x9a, 0xc5, 0x12, 0x43, 0x15, 0xe1, 0xb0, 0xfe, 0xd7, 0x71, 0xa6, 0xc7, 0xc1, 0x11,
+	0xe3, 0xc6, 0x71, 0x9e, 0x81, 0x86, 0x18, 0x0a, 0xbd, 0xc2, 0x4d, 0x49, 0xf0, 0x34, 0xd4, 0xf8,
+	0xc4, 0xcc, 0x6e, 0xcf, 0xb3, 0xd0, 0x8a, 0x3a, 0x73, 0x34, 0x09, 0xeb, 0x9d, 0x1c, 0x92, 0xaf,
+	0x97, 0xa1, 0xc6, 0x6f, 0xac, 0xa4, 0x4d, 0xad, 0x3a, 0x0b, 0x9e, 0x1b, 0x7d, 0x01, 0x46, 0x9d,
+	0x06, 0x37, 0xa0, 0x25, 0x76, 0x41, 0xd1, 0x45, 0xf7, 0xd3, 0x05, 0xdc, 0x1b, 0x92, 0xde, 0x8a,
+	0x59, 0x0b, 0x86, 0xf3, 0x2e, 0xb4, 0x22, 0x2e, 0xb2, 0xa8, 0x0f, 0xe9, 0x99, 0x91, 0x43, 0x91,
+	0x14, 0x29, 0x00, 0x7f, 0xa1, 0x04, 0x15, 0xbc, 0x52, 0x94, 0xec, 0x87, 0x37, 0xe4, 0xac, 0x2e,
+	0x32, 0x07, 0xcb, 0xee, 0xbe, 0x36, 0xa9, 0xcd, 0x15, 0xa9, 0x71, 0x6f, 0xe9, 0xd5, 0x3b, 0x35,
+	0xda, 0x51, 0x8b, 0x61, 0x78, 0xc5, 0x7e, 0xa6, 0x01, 0x55, 0x76, 0x19, 0x2c, 0xcb, 0x4e, 0x1d,
+	0x0c, 0x8a, 0x2b, 0x86, 0xcc, 0x7c, 0xc1, 0x65, 0xf4, 0xe4, 0x63, 0x32, 0x4e, 0x53, 0x64, 0xa7,
+	0x18, 0xa3, 0x16, 0xb2, 0xb9, 0x08, 0xd5, 0x3d, 0x57, 0x6c, 0x69, 0x0b, 0x45, 0xde, 0x71, 0xf7,
+	0xa8, 0xc5, 0xe8, 0x91, 0x6f, 0xd7, 0x0e, 0x76, 0x8d, 0xda, 0x38, 0x7c, 0xab, 0x76, 0xb0, 0x6b,
+	0x31, 0x7a, 0xe4, 0x63, 0x5b, 0xe8, 0xfa, 0x38, 0x7c, 0xb8, 0x2d, 0x17, 0xdb, 0xec, 0x8b, 0x50,
+	0x0d, 0xdc, 0xcf, 0x51, 0xa3, 0x31, 0x0e, 0xdf, 0xa6, 0xfb, 0x39, 0x6a, 0x31, 0xfa, 0xd8, 0x84,
+	0x37, 0xc7, 0xeb, 0x1a, 0xc5, 0x84, 0xdf, 0x83, 0xd9, 0x50, 0xbb, 0xd2, 0x20, 0x6e, 0x24, 0x9e,
+	0x29, 0x18, 0x17, 0x8d, 0xc7, 0x4a, 0x60, 0xe0, 0x24, 0x60, 0xd1, 0x86, 0xec, 0x49, 0xf0, 0x34,
+	0xd4, 0x3e, 0xe1, 0x3a, 0xe1, 0xae, 0x5e, 0x5c, 0xd3, 0x4c, 0x1e, 0x0e, 0xdb, 0x44, 0x26, 0x4f,
+	0x1d, 0x75, 0x8e, 0xb3, 0x0c, 0x55, 0x54, 0x9f, 0xc9, 0xf4, 0x38, 0xd6, 0xba, 0x0f, 0x65, 0x80,
+	0xd5, 0x8e, 0xe6, 0x38, 0xc7, 0xa1, 0x8a, 0x1a, 0x92, 0xd3, 0x25, 0xc7, 0xa1, 0x8a, 0x7a, 0x97,
+	0x5f, 0x8a, 0xa3, 0xad, 0x97, 0x56, 0x64, 0xe9, 0x29, 0x98, 0xd5, 0x87, 0x23, 0x07, 0xe5, 0x0f,
+	0x1a, 0x50, 0x65, 0x37, 0x2b, 0x93, 0x33, 0xf2, 0xe3, 0x70, 0x88, 0x8f, 0xdf, 0xa2, 0x70, 0xc1,
+	0xcb, 0x99, 0xa7, 0x2b, 0xfa, 0x7d, 0x4d, 0xa1, 0x02, 0x82, 0xc5, 0xd2, 0x11, 0xc6, 0x77, 0x2a,
+	0x18, 0x94, 0xa6, 0x91, 0x6f, 0x45, 0xce, 0x6b, 0xb5, 0xe0, 0x5a, 0x2f, 0xe3, 0xe5, 0x2e, 0xb0,
+	0xf4, 0x64, 0xc9, 0x22, 0x34, 0x71, 0x69, 0xc5, 0xee, 0x12, 0xd3, 0xf6, 0xd4, 0x68, 0xfe, 0x35,
+	0x41, 0x6d, 0x45, 0x7c, 0xb8, 0xb0, 0x77, 0x6d, 0xdf, 0x61, 0xb5, 0x12, 0x73, 0xf8, 0xc5, 0xd1,
+	0x20, 0x4b, 0x92, 0xdc, 0x8a, 0x39, 0xc9, 0x2d, 0x98, 0x76, 0x68, 0xb4, 0x85, 0x16, 0x93, 0xfa,
+	0xa5, 0xd1, 0x40, 0xcb, 0x31, 0x83, 0xa5, 0x72, 0x63, 0x9d, 0xe4, 0xde, 0x30, 0x28, 0x74, 0x36,
+	0x18, 0x54, 0xfc, 0x85, 0x46, 0xcc, 0x69, 0xbe, 0x00, 0x87, 0xb4, 0x71, 0xfb, 0x48, 0xbd, 0x0e,
+	0x75, 0x2c, 0x39, 0xce, 0xa5, 0x68, 0x8b, 0xf2, 0xaa, 0xee, 0x76, 0xe4, 0xee, 0x48, 0x04, 0xe3,
+	0x6d, 0x68, 0xca, 0x81, 0x21, 0xd7, 0xf5, 0x3a, 0xbc, 0x5c, 0x5c, 0x87, 0x68, 0x4c, 0x05, 0xda,
+	0x3a, 0xb4, 0xa2, 0x11, 0xc2, 0xf0, 0x8b, 0x0a, 0xf7, 0x4a, 0x31, 0x5c, 0x3c, 0xba, 0x02, 0xcf,
+	0x82, 0x69, 0x65, 0xa0, 0xc8, 0x92, 0x8e, 0xf8, 0x6a, 0x31, 0xa2, 0x3a, 0xcc, 0xb1, 0xd7, 0x13,
+	0x8d, 0x98, 0x3a, 0x2a, 0x95, 0x78, 0x54, 0x7e, 0xab, 0x01, 0xcd, 0xe8, 0x36, 0x73, 0xc6, 0x1e,
+	0x73, 0xe8, 0xf7, 0x0a, 0xf7, 0x98, 0x92, 0xbf, 0x73, 0xdf, 0xef, 0x59, 0xc8, 0x81, 0x43, 0x1c,
+	0xba, 0x61, 0x34, 0x55, 0x5f, 0x2c, 0x66, 0xbd, 0x87, 0xe4, 0x16, 0xe7, 0x22, 0x77, 0x75, 0x2d,
+	0xaf, 0x8e, 0xb8, 0xed, 0xa6, 0x81, 0xe4, 0x6a, 0xfa, 0x1a, 0xb4, 0x5c, 0x74, 0xfd, 0x56, 0xe3,
+	0x95, 0xf7, 0x95, 0x62, 0xb8, 0x35, 0xc9, 0x62, 0xc5, 0xdc, 0x58, 0xb7, 0x6d, 0x7b, 0x1f, 0xe7,
+	0x35, 0x03, 0xab, 0x8f, 0x5b, 0xb7, 0x1b, 0x31, 0x93, 0xa5, 0x22, 0x90, 0xcb, 0xc2, 0x77, 0x69,
+	0x14, 0x58, 0x96, 0xb8, 0xab, 0x62, 0xff, 0xe5, 0xdd, 0xd4, 0x4a, 0xcb, 0xa7, 0xf1, 0xb9, 0x31,
+	0x50, 0x46, 0xae, 0xb6, 0x38, 0x82, 0xdc, 0x33, 0x6a, 0x8d, 0x3b, 0x82, 0xaa, 0x77, 0x84, 0x41,
+	0x86, 0xfb, 0x7e, 0x2f, 0x7f, 0xad, 0x66, 0xc3, 0x9d, 0x53, 0xfc, 0x9c, 0x3e, 0x13, 0xf2, 0x1d,
+	0xfa, 0x68, 0x4c, 0x72, 0x71, 0x94, 0x4e, 0xcf, 0x21, 0xba, 0x22, 0x16, 0xf4, 0xd7, 0xf5, 0xf9,
+	0xf6, 0x4c, 0x62, 0xbe, 0xe1, 0x0c, 0xdb, 0xf0, 0x29, 0xbf, 0xd0, 0xa9, 0xac, 0xe4, 0xe3, 0xae,
+	0x93, 0x37, 0xa5, 0xff, 0x31, 0x91, 0xa5, 0x48, 0xf6, 0x2d, 0xc7, 0xfa, 0x6a, 0x09, 0x9a, 0xd1,
+	0x65, 0xf5, 0xf4, 0x19, 0x46, 0xd3, 0x0d, 0x56, 0xa9, 0x8d, 0x17, 0xb4, 0xf9, 0xbc, 0x7d, 0xb9,
+	0xf0, 0x16, 0x7c, 0x67, 0x4d, 0x70, 0x58, 0x11, 0xaf, 0x79, 0x12, 0x9a, 0x32, 0x37, 0x67, 0x53,
+	0xf6, 0xdd, 0x32, 0xd4, 0xc5, 0x35, 0xf7, 0x64, 0x25, 0xae, 0x42, 0xbd, 0x67, 0x1f, 0x78, 0x43,
+	0xb9, 0x65, 0x3a, 0x55, 0x70, 0x73, 0xbe, 0x73, 0x9b, 0x51, 0x5b, 0x82, 0x8b, 0xbc, 0x09, 0xb5,
+	0x1e, 0xde, 0xff, 0x32, 0x2a, 0x05, 0x96, 0x47, 0xb2, 0x23, 0xb1, 0xc5, 0x79, 0x50, 0x38, 0xbb,
+	0xdd, 0x2a, 0xbf, 0x4d, 0x2a, 0x14, 0xfe, 0x0e, 0xa3, 0xb6, 0x04, 0x97, 0x79, 0x13, 0xea, 0xbc,
+	0x3a, 0x93, 0x2d, 0x12, 0x7a, 0x4b, 0x62, 0x4d, 0x67, 0x75, 0xcb, 0xf1, 0x4a, 0x4f, 0x40, 0x9d,
+	0x0b, 0xcf, 0xd1, 0x9a, 0xef, 0x3c, 0xc9, 0xf6, 0x3b, 0x3d, 0xf3, 0x76, 0x7c, 0x56, 0xfb, 0xe1,
+	0x4f, 0x7c, 0xcc, 0x7b, 0x70, 0x18, 0x63, 0xe0, 0x5b, 0x76, 0x40, 0x2d, 0xda, 0xf5, 0x7c, 0x27,
+	0x13, 0xd5, 0xe7, 0x45, 0x22, 0x42, 0x9d, 0x8f, 0x2a, 0xe8, 0x7e, 0x18, 0x3a, 0xfc, 0x9f, 0x13,
+	0x3a, 0xfc, 0xed, 0x6a, 0x4e, 0x3c, 0x6f, 0x9c, 0x48, 0x06, 0x2a, 0x5c, 0x2a, 0xa0, 0x77, 0x59,
+	0xf7, 0xbd, 0x9f, 0x2f, 0xe0, 0xd4, 0x9c, 0xef, 0xcb, 0x7a, 0x44, 0xaf, 0x88, 0x57, 0x0b, 0xe9,
+	0x5d, 0x4f, 0x86, 0xf4, 0x4e, 0x15, 0x70, 0xa7, 0x62, 0x7a, 0x97, 0xf5, 0x98, 0x5e, 0x91, 0x74,
+	0x35, 0xa8, 0xf7, 0x7f, 0x2c, 0x8c, 0xf6, 0x8d, 0x9c, 0xb0, 0xcf, 0xc7, 0xf4, 0xb0, 0xcf, 0x08,
+	0xad, 0xf9, 0x41, 0xc5, 0x7d, 0x7e, 0xb1, 0x9e, 0x13, 0xf7, 0xb9, 0xa4, 0xc5, 0x7d, 0x46, 0xd4,
+	0x2c, 0x19, 0xf8, 0xb9, 0xac, 0x07, 0x7e, 0x9e, 0x2f, 0xe0, 0xd4, 0x22, 0x3f, 0x97, 0xb4, 0xc8,
+	0x4f, 0x91, 0x50, 0x25, 0xf4, 0x73, 0x49, 0x0b, 0xfd, 0x14, 0x31, 0x2a, 0xb1, 0x9f, 0x4b, 0x5a,
+	0xec, 0xa7, 0x88, 0x51, 0x09, 0xfe, 0x5c, 0xd2, 0x82, 0x3f, 0x45, 0x8c, 0x4a, 0xf4, 0xe7, 0xb2,
+	0x1e, 0xfd, 0x29, 0xee, 0x1f, 0x65, 0xd0, 0x7f, 0x18, 0xa8, 0xf9, 0x2f, 0x0c, 0xd4, 0xfc, 0x74,
+	0x25, 0x27, 0x00, 0x63, 0x65, 0x07, 0x60, 0xce, 0xe4, 0x8f, 0x64, 0x71, 0x04, 0x66, 0xfc, 0x55,
+	0x20, 0x1d, 0x82, 0xb9, 0x92, 0x08, 0xc1, 0xbc, 0x50, 0xc0, 0xac, 0xc7, 0x60, 0xfe, 0xd7, 0x04,
+	0x19, 0x7e, 0xa3, 0x3e, 0x62, 0x3f, 0xfd, 0x86, 0xba, 0x9f, 0x1e, 0xb1, 0x92, 0xa5, 0x37, 0xd4,
+	0x57, 0xf5, 0x0d, 0xf5, 0xe9, 0x31, 0x78, 0xb5, 0x1d, 0xf5, 0x46, 0xd6, 0x8e, 0xba, 0x33, 0x06,
+	0x4a, 0xee, 0x96, 0xfa, 0x66, 0x7a, 0x4b, 0x7d, 0x66, 0x0c, 0xbc, 0xcc, 0x3d, 0xf5, 0x46, 0xd6,
+	0x9e, 0x7a, 0x9c, 0xda, 0xe5, 0x6e, 0xaa, 0xdf, 0xd4, 0x36, 0xd5, 0x2f, 0x8e, 0xd3, 0x5d, 0xf1,
+	0xe2, 0xf0, 0xc9, 0x9c, 0x5d, 0xf5, 0x6b, 0xe3, 0xc0, 0x8c, 0x0e, 0x62, 0xff, 0x70, 0x5f, 0x9c,
+	0x38, 0x74, 0x7b, 0x16, 0x9a, 0xf2, 0x3e, 0x8e, 0xf9, 0x59, 0x68, 0xc8, 0x6f, 0x9b, 0x33, 0x6e,
+	0x5e, 0x8b, 0x4d, 0x1d, 0xf7, 0x9e, 0x45, 0x8a, 0x5c, 0x85, 0x2a, 0xfe, 0x12, 0xd3, 0xe2, 0xe5,
+	0xf1, 0xee, 0xfd, 0xa0, 0x10, 0x8b, 0xf1, 0x99, 0x7f, 0xfd, 0x04, 0x80, 0xf2, 0xc9, 0xe7, 0xb8,
+	0x62, 0xdf, 0x46, 0x63, 0xd6, 0x0b, 0xa9, 0xcf, 0xae, 0xbb, 0x15, 0x7e, 0x12, 0x19, 0x4b, 0x40,
+	0x6d, 0x09, 0xa9, 0x6f, 0x09, 0x76, 0x72, 0x07, 0x9a, 0x32, 0x90, 0xca, 0xae, 0xb0, 0xe7, 0x29,
+	0x59, 0x16, 0x94, 0x0c, 0xed, 0x59, 0x11, 0x04, 0x59, 0x80, 0x6a, 0xe0, 0xf9, 0xa1, 0xb8, 0xef,
+	0xfe, 0xea, 0xd8, 0x50, 0x9b, 0x9e, 0x1f, 0x5a, 0x8c, 0x95, 0x37, 0x4d, 0x79, 0x51, 0x63, 0x92,
+	0xa6, 0x69, 0x16, 0xfb, 0xdb, 0xb5, 0xc8, 0x86, 0x2e, 0x89, 0xd9, 0xc8, 0x75, 0xe8, 0xec, 0xf8,
+	0xa3, 0xa4, 0xce, 0x4a, 0x79, 0x87, 0xb4, 0xac, 0xdc, 0x21, 0x7d, 0x19, 0xda, 0x5d, 0x6f, 0x9f,
+	0xfa, 0x96, 0x72, 0x41, 0x8c, 0xdf, 0xd5, 0x4b, 0xe5, 0xe3, 0x75, 0x9e, 0x5d, 0xd7, 0xa1, 0x6b,
+	0x5d, 0x61, 0xff, 0x9a, 0x56, 0x94, 0x26, 0xb7, 0xa0, 0xc9, 0x62, 0xec, 0x32, 0xc2, 0x3f, 0x59,
+	0x25, 0x79, 0xa8, 0x5f, 0x02, 0xa0, 0x20, 0x26, 0xfc, 0x86, 0x1b, 0xb2, 0x3e, 0x6c, 0x5a, 0x51,
+	0x1a, 0x2b, 0xcc, 0x6e, 0xdb, 0xa9, 0x15, 0x6e, 0xf0, 0x0a, 0x27, 0xf3, 0xc9, 0x29, 0x98, 0xa5,
+	0x7d, 0x47, 0xa5, 0x6c, 0x33, 0xca, 0x44, 0x2e, 0x62, 0x06, 0xa1, 0xed, 0x87, 0x2a, 0xe5, 0x11,
+	0x8e, 0x99, 0xcc, 0x27, 0xef, 0xc3, 0x61, 0x26, 0x67, 0xd9, 0x0e, 0xe9, 0xe2, 0xb0, 0xfb, 0x80,
+	0x86, 0x06, 0x61, 0xed, 0x7d, 0x6d, 0xbc, 0xf6, 0x22, 0x5f, 0x87, 0x33, 0x5a, 0x49, 0x24, 0xbc,
+	0xd1, 0xcb, 0xb2, 0xd6, 0x87, 0xf8, 0xe5, 0x19, 0xbb, 0xca, 0x14, 0x18, 0x8f, 0x9d, 0xac, 0x9c,
+	0x2e, 0x59, 0xe9, 0x02, 0x72, 0x01, 0x1e, 0x67, 0x99, 0x89, 0x1d, 0x34, 0x3f, 0x89, 0x68, 0x5a,
+	0xd9, 0x85, 0xec, 0xf2, 0xa4, 0xbd, 0xc3, 0x3f, 0x0f, 0x64, 0xb1, 0xc9, 0x9a, 0x15, 0x67, 0x60,
+	0x0d, 0x1c, 0xba, 0x6d, 0x0f, 0x7b, 0xe1, 0x3d, 0xba, 0x37, 0xe8, 0xd9, 0x21, 0x5e, 0x68, 0x07,
+	0xd6, 0x17, 0xe9, 0x02, 0x72, 0x0e, 0x1e, 0x13, 0x99, 0xdc, 0x4a, 0xa1, 0xb2, 0xad, 0x39, 0xec,
+	0x09, 0x8f, 0x96, 0x95, 0x55, 0x64, 0x7e, 0xa7, 0x8a, 0x3a, 0xcd, 0x66, 0xee, 0xdb, 0x50, 0xb1,
+	0x1d, 0x47, 0x78, 0x05, 0xe7, 0x27, 0x9c, 0xff, 0xe2, 0xbb, 0x30, 0x44, 0x20, 0x1b, 0xd1, 0xbd,
+	0x4b, 0xee, 0x17, 0x5c, 0x9c, 0x14, 0x2b, 0x7a, 0xad, 0x49, 0xe0, 0x20, 0xe2, 0x90, 0x51, 0x18,
+	0x95, 0xef, 0x0f, 0x31, 0xfa, 0xf8, 0x4c, 0xe0, 0x90, 0x9b, 0x50, 0x65, 0x35, 0xe4, 0x7e, 0xc3,
+	0x85, 0x49, 0xf1, 0xee, 0xf0, 0xfa, 0x31, 0x0c, 0xb3, 0xcb, 0xaf, 0xf6, 0x29, 0xb7, 0x6e, 0x4b,
+	0xfa, 0xad, 0xdb, 0x45, 0xa8, 0xb9, 0x21, 0xdd, 0x4b, 0x5f, 0xc2, 0x1e, 0xa9, 0x99, 0xc2, 0xb0,
+	0x72, 0xd6, 0x91, 0xd7, 0x1c, 0xdf, 0xcb, 0xfd, 0x5a, 0xe7, 0x3a, 0x54, 0x91, 0x3d, 0xe5, 0x2a,
+	0x8f, 0x23, 0x98, 0x71, 0x9a, 0xf3, 0x50, 0xc5, 0xc6, 0x8e, 0x68, 0x9d, 0xa8, 0x4f, 0x39, 0xaa,
+	0xcf, 0xe2, 0x34, 0xb4, 0xbc, 0x01, 0xf5, 0xd9, 0x1c, 0x35, 0xff, 0xb9, 0xaa, 0xdc, 0xf9, 0x5b,
+	0x53, 0x75, 0xec, 0xf5, 0x89, 0x17, 0x06, 0x55, 0xcb, 0xac, 0x84, 0x96, 0xbd, 0x31, 0x39, 0x5a,
+	0x4a, 0xcf, 0xac, 0x84, 0x9e, 0x7d, 0x1f, 0x98, 0x29, 0x4d, 0xbb, 0xad, 0x69, 0xda, 0xc5, 0xc9,
+	0x11, 0x35, 0x5d, 0xa3, 0x45, 0xba, 0xb6, 0xac, 0xeb, 0x5a, 0x67, 0xb2, 0x8b, 0xc3, 0xe3, 0x68,
+	0xdb, 0xa7, 0x72, 0xb5, 0x6d, 0x51, 0xd3, 0xb6, 0x49, 0x45, 0x7f, 0x44, 0xfa, 0xf6, 0xed, 0x2a,
+	0x54, 0x71, 0xf5, 0x27, 0x2b, 0xaa, 0xae, 0xbd, 0x36, 0x91, 0xe7, 0xa0, 0xea, 0xd9, 0x7a, 0x42,
+	0xcf, 0x2e, 0x4c, 0x86, 0x94, 0xd2, 0xb1, 0xf5, 0x84, 0x8e, 0x4d, 0x88, 0x97, 0xd2, 0xaf, 0x55,
+	0x4d, 0xbf, 0xe6, 0x27, 0x43, 0xd3, 0x74, 0xcb, 0x2e, 0xd2, 0xad, 0xeb, 0xba, 0x6e, 0x8d, 0xe9,
+	0x9c, 0xa2, 0xa0, 0x71, 0xf4, 0xea, 0xdd, 0x5c, 0xbd, 0xba, 0xaa, 0xe9, 0xd5, 0x24, 0x62, 0x3f,
+	0x22, 0x9d, 0xba, 0xc0, 0x7d, 0xea, 0xfc, 0x8f, 0x28, 0xb3, 0x7c, 0x6a, 0xf3, 0x75, 0x68, 0xc5,
+	0x4f, 0x02, 0x65, 0x7c, 0xa3, 0xc1, 0xc9, 0xa4, 0x54, 0x99, 0x34, 0xcf, 0x43, 0x2b, 0x7e, 0xe6,
+	0x27, 0x43, 0x56, 0xc0, 0x0a, 0xa3, 0xef, 0xea, 0x58, 0xca, 0x5c, 0x81, 0x23, 0xe9, 0x47, 0x48,
+	0x32, 0x8e, 0x19, 0xd4, 0x0f, 0x0f, 0xca, 0xa9, 0x0f, 0x0f, 0xcc, 0x87, 0x30, 0x9b, 0x78, 0x56,
+	0x64, 0x62, 0x0c, 0x72, 0x5e, 0xd9, 0x01, 0x54, 0x12, 0x9f, 0xe5, 0xea, 0x1f, 0x03, 0xc4, 0x7e,
+	0xbe, 0xb9, 0x0c, 0xb3, 0x05, 0x95, 0x1f, 0xe7, 0x5b, 0x80, 0x4f, 0xc3, 0xf4, 0xa8, 0xba, 0x7f,
+	0x04, 0xdf, 0x2a, 0x84, 0xd0, 0x4e, 0x3d, 0x89, 0x94, 0x14, 0xb3, 0x01, 0xb0, 0x13, 0xd1, 0x18,
+	0xe5, 0xc4, 0xf9, 0x75, 0xf1, 0xf7, 0x2b, 0x8c, 0xcf, 0x52, 0x30, 0xcc, 0x5f, 0x2d, 0xc1, 0x91,
+	0xf4, 0x7b, 0x48, 0xe3, 0xee, 0xed, 0x0c, 0x68, 0x30, 0xac, 0xe8, 0xb3, 0x1f, 0x99, 0x24, 0x77,
+	0x60, 0x26, 0xe8, 0xb9, 0x5d, 0xba, 0xb4, 0xcb, 0x5d, 0x5b, 0xbe, 0x61, 0x2b, 0x78, 0xd3, 0x68,
+	0x33, 0xe6, 0xb0, 0x34, 0x76, 0xf3, 0x21, 0x4c, 0x2b, 0x85, 0xe4, 0x2d, 0x28, 0x7b, 0x83, 0xd4,
+	0xb5, 0xcd, 0x7c, 0xcc, 0xbb, 0x72, 0xbe, 0x59, 0x65, 0x6f, 0x90, 0x9e, 0x92, 0xea, 0xf4, 0xad,
+	0x68, 0xd3, 0xd7, 0xbc, 0x05, 0x47, 0xd2, 0x4f, 0x0e, 0x25, 0xbb, 0xe7, 0x54, 0x2a, 0x08, 0xc2,
+	0xbb, 0x29, 0x91, 0x6b, 0x5e, 0x82, 0xc3, 0xc9, 0x87, 0x84, 0x32, 0x3e, 0xc9, 0x8a, 0xbf, 0x6c,
+	0x93, 0xa7, 0x11, 0x73, 0x3f, 0x55, 0x82, 0x59, 0xbd, 0x21, 0xe4, 0x09, 0x20, 0x7a, 0xce, 0xba,
+	0xd7, 0xa7, 0xed, 0x29, 0xf2, 0x38, 0x1c, 0xd1, 0xf3, 0x17, 0x1c, 0xa7, 0x5d, 0x4a, 0x93, 0xa3,
+	0xd9, 0x6a, 0x97, 0x89, 0x01, 0x47, 0x13, 0x3d, 0xc4, 0x8c, 0x68, 0xbb, 0x42, 0x9e, 0x84, 0xc7,
+	0x93, 0x25, 0x83, 0x9e, 0xdd, 0xa5, 0xed, 0xaa, 0xf9, 0x2f, 0x65, 0xa8, 0xe2, 0xdb, 0x37, 0xe6,
+	0x3f, 0x96, 0xe5, 0x47, 0x28, 0x6f, 0x40, 0x95, 0xbd, 0xf1, 0xa3, 0x7c, 0x5b, 0x5b, 0x4a, 0x7c,
+	0x5b, 0xab, 0x7d, 0x9f, 0x19, 0x7f, 0x5b, 0xfb, 0x06, 0x54, 0xd9, 0xab, 0x3e, 0x93, 0x73, 0x7e,
+	0xa5, 0x04, 0xad, 0xf8, 0x85, 0x9d, 0x89, 0xf9, 0xd5, 0x8f, 0x5e, 0xca, 0xfa, 0x47, 0x2f, 0x2f,
+	0x43, 0xcd, 0x47, 0x50, 0x61, 0x65, 0x92, 0x9f, 0xd2, 0x30, 0x81, 0x16, 0x27, 0x31, 0x29, 0x4c,
+	0xab, 0xef, 0x07, 0x4d, 0x5e, 0x8d, 0xe7, 0xc5, 0xe3, 0x81, 0x6b, 0x4e, 0xb0, 0xe0, 0xfb, 0xf6,
+	0x81, 0x50, 0x4c, 0x3d, 0x13, 0x43, 0xdb, 0xf8, 0x4a, 0x50, 0xf6, 0x27, 0xcd, 0xe6, 0xef, 0x96,
+	0xa0, 0x21, 0xee, 0x26, 0x9b, 0x97, 0xa0, 0x82, 0x0f, 0x01, 0x9d, 0x83, 0x86, 0xb8, 0x15, 0x9d,
+	0xaa, 0xc8, 0x1d, 0xd6, 0x0a, 0x41, 0x6f, 0x49, 0x32, 0xf3, 0x72, 0xb4, 0x4c, 0x4e, 0xce, 0xfb,
+	0x06, 0x54, 0xd9, 0xb3, 0x3f, 0x93, 0x73, 0xfe, 0x5e, 0x13, 0xea, 0xfc, 0xbb, 0x60, 0xf3, 0x37,
+	0x9b, 0x50, 0xe7, 0x4f, 0x01, 0x91, 0xab, 0xd0, 0x08, 0x86, 0x7b, 0x7b, 0xb6, 0x7f, 0x60, 0x64,
+	0xbf, 0xa1, 0xad, 0xbd, 0x1c, 0xd4, 0xd9, 0xe4, 0xb4, 0x96, 0x64, 0x22, 0xaf, 0x43, 0xb5, 0x6b,
+	0x6f, 0xd3, 0xd4, 0x69, 0x75, 0x16, 0xf3, 0x92, 0xbd, 0x4d, 0x2d, 0x46, 0x4e, 0xae, 0x43, 0x53,
+	0x0c, 0x4b, 0x20, 0xc2, 0x55, 0xa3, 0xe5, 0xca, 0xc1, 0x8c, 0xb8, 0xcc, 0x9b, 0xd0, 0x10, 0x95,
+	0x21, 0xd7, 0xa2, 0xaf, 0xa2, 0x93, 0x81, 0xf5, 0xcc, 0x26, 0x44, 0xdf, 0xd9, 0x47, 0xdf, 0x47,
+	0xff, 0x51, 0x19, 0xaa, 0x58, 0xb9, 0x0f, 0x8d, 0x44, 0x4e, 0x00, 0xf4, 0xec, 0x20, 0xdc, 0x18,
+	0xf6, 0x7a, 0xe2, 0x4b, 0xfd, 0x8a, 0xa5, 0xe4, 0xe0, 0xd1, 0x3b, 0x4f, 0x05, 0xbb, 0x9b, 0xc3,
+	0x6e, 0x97, 0x46, 0x9f, 0x17, 0x27, 0xb3, 0xf1, 0x52, 0x0e, 0x7b, 0x9c, 0x56, 0x78, 0x85, 0xaf,
+	0x14, 0xf6, 0x2c, 0x3e, 0x6e, 0x25, 0x6a, 0xc3, 0x39, 0x4d, 0x0f, 0x5a, 0x51, 0x1e, 0x4e, 0xc2,
+	0x81, 0xdb, 0xef, 0xe3, 0xdb, 0x58, 0x5c, 0xa3, 0x65, 0x12, 0x17, 0x1d, 0xfc, 0x29, 0xea, 0x5b,
+	0xb3, 0x44, 0x0a, 0xf3, 0xb7, 0x6d, 0xb7, 0x27, 0xaa, 0x58, 0xb3, 0x44, 0x0a, 0x91, 0x86, 0xe2,
+	0x01, 0xa5, 0x2a, 0x6b, 0xa0, 0x4c, 0x9a, 0x1f, 0x94, 0xa2, 0xa7, 0x01, 0xb2, 0xbe, 0xd0, 0x4d,
+	0x85, 0xca, 0x8e, 0xab, 0xf1, 0x7a, 0xbe, 0x20, 0xc4, 0x19, 0x28, 0xdf, 0xeb, 0xf7, 0xdc, 0x3e,
+	0x15, 0xa1, 0x31, 0x91, 0x4a, 0xf4, 0x71, 0x2d, 0xd5, 0xc7, 0xa2, 0x7c, 0xc5, 0x71, 0xb1, 0x8a,
+	0xf5, 0xb8, 0x9c, 0xe7, 0x90, 0x2b, 0x78, 0x3b, 0x65, 0xdf, 0xed, 0x52, 0x7c, 0x50, 0xb7, 0x92,
+	0x71, 0x06, 0xa9, 0xf7, 0xed, 0x32, 0xa3, 0xb5, 0x24, 0x8f, 0x19, 0xe2, 0xc7, 0x78, 0xf8, 0x33,
+	0x6a, 0x52, 0x49, 0x69, 0x52, 0x5c, 0xe9, 0xf2, 0x88, 0x4a, 0x57, 0x0a, 0x2a, 0x5d, 0x4d, 0x56,
+	0x7a, 0xee, 0x0b, 0x00, 0xb1, 0xba, 0x91, 0x69, 0x68, 0xdc, 0xef, 0x3f, 0xe8, 0x7b, 0x0f, 0xfb,
+	0xed, 0x29, 0x4c, 0xdc, 0xdd, 0xde, 0x46, 0x29, 0xed, 0x12, 0x26, 0x90, 0xce, 0xed, 0xef, 0xb4,
+	0xcb, 0x04, 0xa0, 0xbe, 0xc9, 0xde, 0x88, 0x68, 0x57, 0xf0, 0xf7, 0x0d, 0x36, 0x7e, 0xed, 0x2a,
+	0x39, 0x06, 0x8f, 0xad, 0xf5, 0xbb, 0xde, 0xde, 0xc0, 0x0e, 0xdd, 0xad, 0x1e, 0x7e, 0xd0, 0x1e,
+	0xb8, 0x5e, 0xbf, 0x5d, 0xc3, 0xd5, 0x6b, 0x9d, 0x86, 0x0f, 0x3d, 0xff, 0xc1, 0x3a, 0xa5, 0x8e,
+	0x78, 0x9b, 0xa8, 0x5d, 0x37, 0xff, 0xbd, 0xc4, 0x0f, 0xbb, 0xcd, 0xeb, 0x30, 0xa3, 0xbd, 0xf4,
+	0x65, 0xc4, 0x7f, 0xda, 0x20, 0xf1, 0x97, 0x0d, 0x9e, 0x60, 0xe1, 0x68, 0x1a, 0xbb, 0x32, 0x3c,
+	0x65, 0xde, 0x00, 0x50, 0xde, 0xf7, 0x3a, 0x01, 0xb0, 0x75, 0x10, 0xd2, 0x80, 0xa5, 0x18, 0x44,
+	0xd5, 0x52, 0x72, 0x54, 0xfc, 0xb2, 0x86, 0x6f, 0x5e, 0x04, 0x50, 0x5e, 0xf7, 0xc2, 0x79, 0x85,
+	0xa9, 0xc5, 0x24, 0x58, 0x32, 0xdb, 0xec, 0x88, 0x16, 0xc8, 0x77, 0xbc, 0x64, 0x0d, 0x58, 0xa6,
+	0x56, 0x03, 0x96, 0x63, 0xae, 0x00, 0xc4, 0x4f, 0x59, 0xe1, 0x19, 0x9c, 0x30, 0xdd, 0xaf, 0x42,
+	0xd5, 0xb1, 0x43, 0x5b, 0x58, 0xcd, 0x27, 0x13, 0x2b, 0x57, 0xcc, 0x62, 0x31, 0x32, 0xf3, 0x97,
+	0x4b, 0x30, 0xa3, 0x3e, 0xdb, 0x65, 0xbe, 0x0d, 0x55, 0xf6, 0xee, 0xd7, 0x35, 0x98, 0x51, 0xdf,
+	0xed, 0x4a, 0xfd, 0x09, 0x08, 0x8e, 0xa7, 0xb2, 0x5a, 0x1a, 0x83, 0xb9, 0x16, 0x55, 0xe9, 0x43,
+	0x43, 0x9d, 0x83, 0x86, 0x78, 0x06, 0xcc, 0x7c, 0x01, 0x5a, 0xf1, 0xab, 0x5f, 0x68, 0x3b, 0x78,
+	0xbe, 0x1c, 0x65, 0x91, 0x34, 0xbf, 0x51, 0x83, 0x1a, 0x1b, 0x4e, 0xf3, 0x77, 0xca, 0xaa, 0x86,
+	0x9a, 0xbf, 0x5e, 0xce, 0xdd, 0x0b, 0x9e, 0xd7, 0x1e, 0xb1, 0x98, 0x4d, 0xbd, 0x76, 0x27, 0x1e,
+	0xf9, 0xd2, 0x0d, 0xeb, 0x45, 0x68, 0xf4, 0xb9, 0x66, 0x8a, 0x37, 0x24, 0x8e, 0x67, 0x72, 0x09,
+	0xed, 0xb5, 0x24, 0x31, 0xb9, 0x00, 0x35, 0xea, 0xfb, 0x9e, 0xcf, 0xa6, 0xd4, 0xec, 0xfc, 0x89,
+	0x4c, 0x2e, 0xac, 0xf7, 0x0a, 0x52, 0x59, 0x9c, 0x18, 0xe3, 0xc0, 0x01, 0x9f, 0x45, 0xdc, 0xa7,
+	0x0c, 0xc4, 0xc7, 0xf5, 0xc2, 0xda, 0x64, 0x17, 0x22, 0x57, 0xdf, 0x0b, 0xf9, 0x8c, 0x63, 0x1f,
+	0xfd, 0x4a, 0x2e, 0x6e, 0x83, 0xb2, 0x0b, 0xcd, 0x00, 0x0e, 0x27, 0x5f, 0x16, 0x33, 0xa1, 0xc9,
+	0x3d, 0xda, 0x68, 0x5a, 0x45, 0x69, 0xd4, 0x57, 0xfe, 0x7b, 0x3d, 0xb6, 0xa6, 0x4a, 0x0e, 0x7a,
+	0x39, 0x0f, 0x19, 0x94, 0x3c, 0x63, 0xe7, 0x76, 0x55, 0xcf, 0x9c, 0xfb, 0xb8, 0xf4, 0x05, 0x14,
+	0x1b, 0x31, 0xa5, 0x1a, 0x8f, 0x12, 0x69, 0x41, 0x8d, 0xf5, 0x49, 0xbb, 0xac, 0x5a, 0x98, 0x4a,
+	0x8e, 0x8d, 0xa8, 0xce, 0x9d, 0x87, 0x86, 0xc8, 0x47, 0xfa, 0x05, 0xde, 0xcd, 0xed, 0x29, 0x32,
+	0x03, 0xcd, 0x4d, 0xda, 0xdb, 0x5e, 0xf5, 0x82, 0xb0, 0x5d, 0x22, 0x87, 0xa0, 0xc5, 0xa6, 0xed,
+	0xdd, 0x7e, 0xef, 0xa0, 0x5d, 0x9e, 0x7b, 0x17, 0x5a, 0x51, 0xe7, 0x93, 0x26, 0x54, 0xd7, 0x87,
+	0xbd, 0x5e, 0x7b, 0x8a, 0x79, 0xd1, 0xa1, 0xe7, 0xcb, 0x18, 0xfa, 0xca, 0x23, 0x5c, 0x12, 0xdb,
+	0xa5, 0x3c, 0xc3, 0x55, 0x26, 0x6d, 0x98, 0x11, 0xc2, 0x79, 0x9d, 0x2b, 0xe6, 0xdf, 0x95, 0xa0,
+	0x15, 0x3d, 0xbc, 0x86, 0x2e, 0xac, 0x54, 0xc7, 0x7c, 0x93, 0x75, 0x29, 0xa1, 0x98, 0xf9, 0xef,
+	0xb8, 0x25, 0x94, 0xf3, 0x14, 0xcc, 0x8a, 0xd5, 0x41, 0x8e, 0x38, 0x37, 0xf0, 0x89, 0xdc, 0xb9,
+	0x9b, 0x51, 0xaf, 0xb7, 0x99, 0x35, 0x58, 0xf2, 0xfa, 0x7d, 0xda, 0x0d, 0x59, 0xdf, 0x1f, 0x86,
+	0xe9, 0x75, 0x2f, 0xdc, 0xf0, 0x82, 0x00, 0x5b, 0xc6, 0x7b, 0x2a, 0x2e, 0x2f, 0x93, 0x59, 0x00,
+	0x79, 0xeb, 0x0f, 0xed, 0xb9, 0xf9, 0x4b, 0x25, 0xa8, 0xf3, 0xe7, 0xe0, 0xcc, 0x9f, 0x2f, 0x41,
+	0x5d, 0x3c, 0x01, 0xf7, 0x32, 0xb4, 0x7d, 0xcf, 0x0b, 0xe3, 0xbd, 0xcf, 0xda, 0xb2, 0x68, 0x65,
+	0x2a, 0x1f, 0xb7, 0xe3, 0x9e, 0xa2, 0xc0, 0xc2, 0x5b, 0xd1, 0xf2, 0xc8, 0x65, 0x00, 0xfe, 0xc4,
+	0x1c, 0x1e, 0x36, 0x88, 0x99, 0x97, 0xbc, 0xec, 0xc7, 0x6b, 0xc1, 0x8f, 0xc5, 0x14, 0x6a, 0xf3,
+	0x0a, 0x94, 0x17, 0xd6, 0xcc, 0x4b, 0x50, 0x63, 0xaf, 0x76, 0xa1, 0x52, 0x07, 0xa1, 0x4f, 0xed,
+	0xbd, 0x58, 0xa9, 0x65, 0x1a, 0x57, 0xd2, 0xe8, 0x0e, 0x60, 0x8b, 0x5f, 0xef, 0x9b, 0xfb, 0x3c,
+	0x1c, 0xb2, 0x68, 0x30, 0xf0, 0xfa, 0x01, 0xfd, 0x41, 0xfd, 0xd1, 0x9f, 0xdc, 0x3f, 0xdf, 0x33,
+	0xf7, 0xed, 0x1a, 0xd4, 0x98, 0x1f, 0x6d, 0xfe, 0x71, 0x2d, 0xf2, 0xf8, 0x53, 0x96, 0x6c, 0x5e,
+	0xbd, 0xb1, 0xa5, 0x9a, 0x24, 0xcd, 0x05, 0xd7, 0x6f, 0x6a, 0xbd, 0x09, 0xcd, 0x81, 0xef, 0xed,
+	0xf8, 0xe8, 0xb9, 0x57, 0x13, 0x0f, 0xa7, 0xe9, 0x6c, 0x1b, 0x82, 0xcc, 0x8a, 0x18, 0x54, 0xdd,
+	0xad, 0xe9, 0xba, 0x7b, 0x1d, 0x5a, 0x8e, 0xef, 0x0d, 0x98, 0x65, 0x31, 0xea, 0x89, 0x17, 0x19,
+	0x75, 0xdc, 0x65, 0x49, 0x87, 0x7f, 0xbe, 0x20, 0x62, 0x42, 0xed, 0xe7, 0x83, 0x67, 0x34, 0x12,
+	0x6f, 0x0b, 0xe9, 0xec, 0x7c, 0xb8, 0x31, 0x7c, 0xc9, 0xc9, 0x91, 0x91, 0x3e, 0x62, 0x8c, 0xcd,
+	0x91, 0x8c, 0x2b, 0x8f, 0x24, 0x23, 0x27, 0x27, 0x57, 0xa0, 0x19, 0xd8, 0xfb, 0x14, 0xc5, 0x1b,
+	0xad, 0x91, 0x5d, 0xb1, 0x29, 0xc8, 0xf0, 0xcf, 0x46, 0x48, 0x16, 0x6c, 0xf2, 0x9e, 0xbb, 0xc3,
+	0xf7, 0xcc, 0x06, 0x8c, 0x6c, 0xf2, 0x1d, 0x49, 0x87, 0x4d, 0x8e, 0x98, 0x70, 0x8f, 0xc7, 0x17,
+	0x87, 0x69, 0x7e, 0xfe, 0xcf, 0x12, 0xe6, 0x34, 0xb4, 0xa2, 0x2e, 0x32, 0x9b, 0xd1, 0x2c, 0x6b,
+	0x42, 0x9d, 0xb7, 0xc0, 0x04, 0x68, 0xca, 0x0a, 0x21, 0x71, 0x04, 0x6e, 0xae, 0x43, 0x53, 0x0e,
+	0x5a, 0xce, 0x2b, 0x2c, 0x04, 0xaa, 0x8e, 0x27, 0x9c, 0xc3, 0x8a, 0xc5, 0x7e, 0xe3, 0xa0, 0xaa,
+	0xcf, 0xcc, 0xb5, 0xa2, 0x37, 0xd7, 0xe6, 0x16, 0xe4, 0xc5, 0x33, 0xb4, 0x8c, 0x3c, 0xec, 0x30,
+	0x0d, 0x0d, 0x6b, 0xc8, 0xfc, 0xf6, 0x76, 0x89, 0x34, 0xf9, 0x66, 0xb0, 0x5d, 0x46, 0x23, 0xbb,
+	0x64, 0xf7, 0xbb, 0xb4, 0xc7, 0x7c, 0xbd, 0xc8, 0x74, 0x57, 0x17, 0x5b, 0x11, 0xf8, 0xe2, 0xf1,
+	0x3f, 0xf9, 0xe0, 0x44, 0xe9, 0x5b, 0x1f, 0x9c, 0x28, 0x7d, 0xf7, 0x83, 0x13, 0xa5, 0x9f, 0xfd,
+	0xde, 0x89, 0xa9, 0x6f, 0x7d, 0xef, 0xc4, 0xd4, 0xdf, 0x7e, 0xef, 0xc4, 0xd4, 0x7b, 0xe5, 0xc1,
+	0xd6, 0x56, 0x9d, 0x5d, 0x1e, 0x3a, 0xff, 0x9f, 0x03, 0x00, 0x8e, 0xc7, 0x16, 0x8f, 0xcb, 0x6b,
+	0x00, 0x00,
 }
 
 func (m *Event) Marshal() (dAtA []byte, err error) {
@@ -14296,6 +14568,29 @@ func (m *EventMessageValueOfObjectClose) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessageValueOfSubscriptionFormulas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfSubscriptionFormulas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SubscriptionFormulas != nil {
+		{
+			size, err := m.SubscriptionFormulas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x92
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventMessageValueOfPing) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -14986,6 +15281,29 @@ func (m *EventMessageValueOfChatUpdateMessageSyncStatus) MarshalToSizedBuffer(dA
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessageValueOfAiChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfAiChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AiChunk != nil {
+		{
+			size, err := m.AiChunk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x8
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventMessageValueOfAccountDetails) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -16379,6 +16697,97 @@ func (m *EventObjectSubscriptionGroups) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *EventObjectSubscriptionFormulas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObjectSubscriptionFormulas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObjectSubscriptionFormulas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Formulas) > 0 {
+		for iNdEx := len(m.Formulas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Formulas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SubId) > 0 {
+		i -= len(m.SubId)
+		copy(dAtA[i:], m.SubId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventObjectSubscriptionFormulasFormula) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObjectSubscriptionFormulasFormula) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObjectSubscriptionFormulasFormula) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Formula != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Formula))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventObjectRelations) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16764,20 +17173,20 @@ func (m *EventBlockMarksInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.MarksInRange) > 0 {
-		dAtA98 := make([]byte, len(m.MarksInRange)*10)
-		var j97 int
+		dAtA101 := make([]byte, len(m.MarksInRange)*10)
+		var j100 int
 		for _, num := range m.MarksInRange {
 			for num >= 1<<7 {
-				dAtA98[j97] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA101[j100] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j97++
+				j100++
 			}
-			dAtA98[j97] = uint8(num)
-			j97++
+			dAtA101[j100] = uint8(num)
+			j100++
 		}
-		i -= j97
-		copy(dAtA[i:], dAtA98[:j97])
-		i = encodeVarintEvents(dAtA, i, uint64(j97))
+		i -= j100
+		copy(dAtA[i:], dAtA101[:j100])
+		i = encodeVarintEvents(dAtA, i, uint64(j100))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -20547,6 +20956,34 @@ func (m *EventBlockDataviewViewUpdateFields) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupNumberRanges) > 0 {
+		for iNdEx := len(m.GroupNumberRanges) - 1; iNdEx >= 0; iNdEx-- {
+			f174 := math.Float64bits(float64(m.GroupNumberRanges[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f174))
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupNumberRanges)*8))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.GroupDateBucket != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GroupDateBucket))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.StartRelationKey) > 0 {
+		i -= len(m.StartRelationKey)
+		copy(dAtA[i:], m.StartRelationKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StartRelationKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if len(m.EndRelationKey) > 0 {
 		i -= len(m.EndRelationKey)
 		copy(dAtA[i:], m.EndRelationKey)
@@ -23297,6 +23734,66 @@ func (m *EventImportFinish) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventAI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAIChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAIChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAIChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StreamId) > 0 {
+		i -= len(m.StreamId)
+		copy(dAtA[i:], m.StreamId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StreamId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ResponseEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -24295,6 +24792,18 @@ func (m *EventMessageValueOfObjectClose) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessageValueOfSubscriptionFormulas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionFormulas != nil {
+		l = m.SubscriptionFormulas.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *EventMessageValueOfPing) Size() (n int) {
 	if m == nil {
 		return 0
@@ -24655,6 +25164,18 @@ func (m *EventMessageValueOfChatUpdateMessageSyncStatus) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessageValueOfAiChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AiChunk != nil {
+		l = m.AiChunk.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *EventMessageValueOfAccountDetails) Size() (n int) {
 	if m == nil {
 		return 0
@@ -25277,6 +25798,45 @@ func (m *EventObjectSubscriptionGroups) Size() (n int) {
 	return n
 }
 
+func (m *EventObjectSubscriptionFormulas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Formulas) > 0 {
+		for _, e := range m.Formulas {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventObjectSubscriptionFormulasFormula) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RelationKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Formula != 0 {
+		n += 1 + sovEvents(uint64(m.Formula))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventObjectRelations) Size() (n int) {
 	if m == nil {
 		return 0
@@ -27030,6 +27590,16 @@ func (m *EventBlockDataviewViewUpdateFields) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovEvents(uint64(l))
 	}
+	l = len(m.StartRelationKey)
+	if l > 0 {
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	if m.GroupDateBucket != 0 {
+		n += 2 + sovEvents(uint64(m.GroupDateBucket))
+	}
+	if len(m.GroupNumberRanges) > 0 {
+		n += 2 + sovEvents(uint64(len(m.GroupNumberRanges)*8)) + len(m.GroupNumberRanges)*8
+	}
 	return n
 }
 
@@ -28210,6 +28780,32 @@ func (m *EventImportFinish) Size() (n int) {
 	return n
 }
 
+func (m *EventAI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventAIChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StreamId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *ResponseEvent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -30191,6 +30787,41 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &EventMessageValueOfObjectClose{v}
 			iNdEx = postIndex
+		case 66:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionFormulas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventObjectSubscriptionFormulas{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfSubscriptionFormulas{v}
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Ping", wireType)
@@ -31273,6 +31904,41 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &EventMessageValueOfChatUpdateMessageSyncStatus{v}
 			iNdEx = postIndex
+		case 137:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AiChunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventAIChunk{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfAiChunk{v}
+			iNdEx = postIndex
 		case 201:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AccountDetails", wireType)
@@ -33812,11 +34478,159 @@ func (m *EventObjectDetailsAmend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = append(m.Details, &EventObjectDetailsAmendKeyValue{})
+			if err := m.Details[len(m.Details)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubIds = append(m.SubIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObjectDetailsAmendKeyValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33843,42 +34657,12 @@ func (m *EventObjectDetailsAmend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = append(m.Details, &EventObjectDetailsAmendKeyValue{})
-			if err := m.Details[len(m.Details)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubIds", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if m.Value == nil {
+				m.Value = &types.Value{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SubIds = append(m.SubIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33901,7 +34685,7 @@ func (m *EventObjectDetailsAmend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObjectDetailsAmendKeyValue) Unmarshal(dAtA []byte) error {
+func (m *EventObjectDetailsSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33924,15 +34708,15 @@ func (m *EventObjectDetailsAmendKeyValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: Set: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33960,11 +34744,11 @@ func (m *EventObjectDetailsAmendKeyValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33991,13 +34775,45 @@ func (m *EventObjectDetailsAmendKeyValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &types.Value{}
+			if m.Details == nil {
+				m.Details = &types.Struct{}
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubIds = append(m.SubIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -34019,7 +34835,7 @@ func (m *EventObjectDetailsAmendKeyValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObjectDetailsSet) Unmarshal(dAtA []byte) error {
+func (m *EventObjectDetailsUnset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34042,10 +34858,10 @@ func (m *EventObjectDetailsSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Set: wiretype end group for non-group")
+			return fmt.Errorf("proto: Unset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Unset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34082,9 +34898,9 @@ func (m *EventObjectDetailsSet) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -34094,27 +34910,23 @@ func (m *EventObjectDetailsSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &types.Struct{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -34169,7 +34981,7 @@ func (m *EventObjectDetailsSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObjectDetailsUnset) Unmarshal(dAtA []byte) error {
+func (m *EventObjectSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34192,10 +35004,60 @@ func (m *EventObjectDetailsUnset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Unset: wiretype end group for non-group")
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Unset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObjectSubscriptionAdd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Add: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Add: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34232,7 +35094,7 @@ func (m *EventObjectDetailsUnset) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34260,11 +35122,11 @@ func (m *EventObjectDetailsUnset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			m.AfterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34292,7 +35154,7 @@ func (m *EventObjectDetailsUnset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubIds = append(m.SubIds, string(dAtA[iNdEx:postIndex]))
+			m.SubId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34315,7 +35177,7 @@ func (m *EventObjectDetailsUnset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObjectSubscription) Unmarshal(dAtA []byte) error {
+func (m *EventObjectSubscriptionRemove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34338,12 +35200,76 @@ func (m *EventObjectSubscription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -34365,7 +35291,7 @@ func (m *EventObjectSubscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObjectSubscriptionAdd) Unmarshal(dAtA []byte) error {
+func (m *EventObjectSubscriptionPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34388,10 +35314,10 @@ func (m *EventObjectSubscriptionAdd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Add: wiretype end group for non-group")
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Add: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34511,7 +35437,7 @@ func (m *EventObjectSubscriptionAdd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObjectSubscriptionRemove) Unmarshal(dAtA []byte) error {
+func (m *EventObjectSubscriptionCounters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34534,17 +35460,17 @@ func (m *EventObjectSubscriptionRemove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
+			return fmt.Errorf("proto: Counters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Counters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -34554,25 +35480,50 @@ func (m *EventObjectSubscriptionRemove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Total |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCount", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.NextCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevCount", wireType)
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.PrevCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
 			}
@@ -34625,7 +35576,7 @@ func (m *EventObjectSubscriptionRemove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObjectSubscriptionPosition) Unmarshal(dAtA []byte) error {
+func (m *EventObjectSubscriptionGroups) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34648,15 +35599,15 @@ func (m *EventObjectSubscriptionPosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+			return fmt.Errorf("proto: Groups: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Groups: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34684,13 +35635,13 @@ func (m *EventObjectSubscriptionPosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.SubId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -34700,29 +35651,33 @@ func (m *EventObjectSubscriptionPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AfterId = string(dAtA[iNdEx:postIndex])
+			if m.Group == nil {
+				m.Group = &model.BlockContentDataviewGroup{}
+			}
+			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -34732,24 +35687,12 @@ func (m *EventObjectSubscriptionPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Remove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -34771,7 +35714,7 @@ func (m *EventObjectSubscriptionPosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObjectSubscriptionCounters) Unmarshal(dAtA []byte) error {
+func (m *EventObjectSubscriptionFormulas) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34794,17 +35737,17 @@ func (m *EventObjectSubscriptionCounters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Counters: wiretype end group for non-group")
+			return fmt.Errorf("proto: Formulas: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Counters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Formulas: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
 			}
-			m.Total = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -34814,54 +35757,29 @@ func (m *EventObjectSubscriptionCounters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Total |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextCount", wireType)
-			}
-			m.NextCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevCount", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.PrevCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrevCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			m.SubId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Formulas", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -34871,23 +35789,25 @@ func (m *EventObjectSubscriptionCounters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubId = string(dAtA[iNdEx:postIndex])
+			m.Formulas = append(m.Formulas, &EventObjectSubscriptionFormulasFormula{})
+			if err := m.Formulas[len(m.Formulas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34910,7 +35830,7 @@ func (m *EventObjectSubscriptionCounters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObjectSubscriptionGroups) Unmarshal(dAtA []byte) error {
+func (m *EventObjectSubscriptionFormulasFormula) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34933,15 +35853,15 @@ func (m *EventObjectSubscriptionGroups) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Groups: wiretype end group for non-group")
+			return fmt.Errorf("proto: Formula: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Groups: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Formula: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34969,11 +35889,30 @@ func (m *EventObjectSubscriptionGroups) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubId = string(dAtA[iNdEx:postIndex])
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formula", wireType)
+			}
+			m.Formula = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Formula |= model.BlockContentDataviewRelationFormulaType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35000,33 +35939,13 @@ func (m *EventObjectSubscriptionGroups) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Group == nil {
-				m.Group = &model.BlockContentDataviewGroup{}
+			if m.Value == nil {
+				m.Value = &types.Value{}
 			}
-			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Remove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -46627,8 +47546,113 @@ func (m *EventBlockDataviewViewUpdateFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndRelationKey = string(dAtA[iNdEx:postIndex])
+			m.EndRelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartRelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupDateBucket", wireType)
+			}
+			m.GroupDateBucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupDateBucket |= model.BlockContentDataviewDateBucket(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.GroupNumberRanges = append(m.GroupNumberRanges, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.GroupNumberRanges) == 0 {
+					m.GroupNumberRanges = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.GroupNumberRanges = append(m.GroupNumberRanges, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupNumberRanges", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -52889,13 +53913,222 @@ func (m *EventSpaceSyncStatusUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EventSpaceStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			}
+			m.Network = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Network |= EventSpaceNetwork(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			m.Error = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Error |= EventSpaceSyncError(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncingObjectsCounter", wireType)
+			}
+			m.SyncingObjectsCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncingObjectsCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotSyncedFilesCounter", wireType)
+			}
+			m.NotSyncedFilesCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotSyncedFilesCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSpaceAutoWidgetAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoWidgetAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoWidgetAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WidgetBlockId", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -52905,87 +54138,74 @@ func (m *EventSpaceSyncStatusUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= EventSpaceStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.Network = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Network |= EventSpaceNetwork(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Error = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Error |= EventSpaceSyncError(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.WidgetBlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncingObjectsCounter", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.SyncingObjectsCounter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SyncingObjectsCounter |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotSyncedFilesCounter", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventP2PStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			m.NotSyncedFilesCounter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NotSyncedFilesCounter |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: P2PStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: P2PStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -53007,7 +54227,7 @@ func (m *EventSpaceSyncStatusUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSpaceAutoWidgetAdded) Unmarshal(dAtA []byte) error {
+func (m *EventP2PStatusUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53030,15 +54250,15 @@ func (m *EventSpaceAutoWidgetAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AutoWidgetAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: Update: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AutoWidgetAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53066,13 +54286,13 @@ func (m *EventSpaceAutoWidgetAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetId = string(dAtA[iNdEx:postIndex])
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -53082,29 +54302,16 @@ func (m *EventSpaceAutoWidgetAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= EventP2PStatusStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WidgetBlockId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevicesCounter", wireType)
 			}
-			var stringLen uint64
+			m.DevicesCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -53114,24 +54321,11 @@ func (m *EventSpaceAutoWidgetAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DevicesCounter |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WidgetBlockId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -53153,7 +54347,7 @@ func (m *EventSpaceAutoWidgetAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventP2PStatus) Unmarshal(dAtA []byte) error {
+func (m *EventImport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53176,10 +54370,10 @@ func (m *EventP2PStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: P2PStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Import: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: P2PStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Import: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -53203,7 +54397,7 @@ func (m *EventP2PStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventP2PStatusUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventImportFinish) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53226,15 +54420,15 @@ func (m *EventP2PStatusUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Update: wiretype end group for non-group")
+			return fmt.Errorf("proto: Finish: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Finish: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RootCollectionID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53262,13 +54456,13 @@ func (m *EventP2PStatusUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			m.RootCollectionID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectsCount", wireType)
 			}
-			m.Status = 0
+			m.ObjectsCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -53278,16 +54472,16 @@ func (m *EventP2PStatusUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= EventP2PStatusStatus(b&0x7F) << shift
+				m.ObjectsCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicesCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportType", wireType)
 			}
-			m.DevicesCounter = 0
+			m.ImportType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -53297,7 +54491,7 @@ func (m *EventP2PStatusUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DevicesCounter |= int64(b&0x7F) << shift
+				m.ImportType |= model.ImportType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -53323,7 +54517,7 @@ func (m *EventP2PStatusUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventImport) Unmarshal(dAtA []byte) error {
+func (m *EventAI) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53346,10 +54540,10 @@ func (m *EventImport) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Import: wiretype end group for non-group")
+			return fmt.Errorf("proto: AI: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Import: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AI: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -53373,7 +54567,7 @@ func (m *EventImport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventImportFinish) Unmarshal(dAtA []byte) error {
+func (m *EventAIChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53396,15 +54590,15 @@ func (m *EventImportFinish) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Finish: wiretype end group for non-group")
+			return fmt.Errorf("proto: Chunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Finish: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Chunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootCollectionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53432,13 +54626,13 @@ func (m *EventImportFinish) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootCollectionID = string(dAtA[iNdEx:postIndex])
+			m.StreamId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectsCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
-			m.ObjectsCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -53448,30 +54642,24 @@ func (m *EventImportFinish) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObjectsCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImportType", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.ImportType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ImportType |= model.ImportType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -9,7 +9,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
-const RelationChecksum = "4e14a02496e8ccc15b9fe4287f48fcc5e3e4b157289e18554512da1b26fd4844"
+const RelationChecksum = "521ef5047219b392405d948d6a9b84f5134432dccbc286d1b8a673d019a5c362"
 const (
 	RelationKeyTag                                domain.RelationKey = "tag"
 	RelationKeyCamera                             domain.RelationKey = "camera"
@@ -178,6 +178,12 @@ const (
 	RelationKeySpacePushNotificationKey           domain.RelationKey = "spacePushNotificationKey"
 	RelationKeySpacePushNotificationEncryptionKey domain.RelationKey = "spacePushNotificationEncryptionKey"
 	RelationKeySpaceJoinDate                      domain.RelationKey = "spaceJoinDate"
+	RelationKeyRelationIsReminder                 domain.RelationKey = "relationIsReminder"
+	RelationKeyRecurrenceRule                     domain.RelationKey = "recurrenceRule"
+	RelationKeyDuration                           domain.RelationKey = "duration"
+	RelationKeyVideoCodec                         domain.RelationKey = "videoCodec"
+	RelationKeyAudioCodec                         domain.RelationKey = "audioCodec"
+	RelationKeyTemplateCounter                    domain.RelationKey = "templateCounter"
 )
 
 var (
@@ -277,6 +283,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyAudioCodec: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Codec of the audio stream",
+			Format:           model.RelationFormat_shorttext,
+			Id:               "_braudioCodec",
+			Key:              "audioCodec",
+			MaxCount:         1,
+			Name:             "Audio codec",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyAudioGenre: {
 
 			DataSource:       model.Relation_details,
@@ -605,6 +624,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyDuration: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Duration of audio or video in seconds",
+			Format:           model.RelationFormat_number,
+			Id:               "_brduration",
+			Key:              "duration",
+			MaxCount:         1,
+			Name:             "Duration",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyEmail: {
 
 			DataSource:       model.Relation_details,
@@ -1665,6 +1697,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyRecurrenceRule: {
+
+			DataSource:       model.Relation_details,
+			Description:      "RRULE-style recurrence rule. Next instance of the object is created when the current one is done",
+			Format:           model.RelationFormat_longtext,
+			Id:               "_brrecurrenceRule",
+			Key:              "recurrenceRule",
+			MaxCount:         1,
+			Name:             "Recurrence",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyRelationDefaultValue: {
 
 			DataSource:       model.Relation_details,
@@ -1720,6 +1765,20 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyRelationIsReminder: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Date values of the relation trigger reminders",
+			Format:           model.RelationFormat_checkbox,
+			Hidden:           true,
+			Id:               "_brrelationIsReminder",
+			Key:              "relationIsReminder",
+			MaxCount:         1,
+			Name:             "Reminder",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyRelationKey: {
 
 			DataSource:       model.Relation_details,
@@ -2345,6 +2404,20 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyTemplateCounter: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Number of objects created from the template, used by the counter placeholder",
+			Format:           model.RelationFormat_number,
+			Hidden:           true,
+			Id:               "_brtemplateCounter",
+			Key:              "templateCounter",
+			MaxCount:         1,
+			Name:             "Template counter",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyTemplateIsBundled: {
 
 			DataSource:       model.Relation_derived,
@@ -2441,6 +2514,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyVideoCodec: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Codec of the video stream",
+			Format:           model.RelationFormat_shorttext,
+			Id:               "_brvideoCodec",
+			Key:              "videoCodec",
+			MaxCount:         1,
+			Name:             "Video codec",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyWidthInPixels: {
 
 			DataSource:       model.Relation_details,
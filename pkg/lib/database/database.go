@@ -330,6 +330,7 @@ func (r FulltextResult) Model() model.SearchMeta {
 		HighlightRanges: r.HighlightRanges,
 		RelationKey:     r.Path.RelationKey,
 		BlockId:         r.Path.BlockId,
+		Page:            int32(r.Path.Page),
 	}
 }
 
@@ -2,6 +2,7 @@ package database
 
 import (
 	"testing"
+	"time"
 
 	"github.com/anyproto/any-store/anyenc"
 	"github.com/stretchr/testify/assert"
@@ -33,6 +34,7 @@ func TestDatabase(t *testing.T) {
 
 type stubSpaceObjectStore struct {
 	queryRawResult []Record
+	fulltextResult []domain.ObjectPath
 }
 
 func (s *stubSpaceObjectStore) SpaceId() string {
@@ -59,6 +61,14 @@ func (s *stubSpaceObjectStore) ListRelationOptions(relationKey domain.RelationKe
 	return nil, nil
 }
 
+func (s *stubSpaceObjectStore) SearchFulltext(text string) (paths []domain.ObjectPath, err error) {
+	return s.fulltextResult, nil
+}
+
+func (s *stubSpaceObjectStore) AccountLocation() *time.Location {
+	return time.Local
+}
+
 func newTestQueryBuilder(t *testing.T) queryBuilder {
 	objectStore := &stubSpaceObjectStore{}
 	return queryBuilder{
@@ -18,6 +18,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/dateutil"
 	"github.com/anyproto/anytype-heart/util/debug"
+	timeutil "github.com/anyproto/anytype-heart/util/time"
 )
 
 var (
@@ -70,7 +71,7 @@ func makeFilter(spaceID string, rawFilter FilterRequest, store ObjectStore) (Fil
 	if rawFilter.Condition == model.BlockContentDataviewFilter_None {
 		return nil, nil
 	}
-	rawFilters := transformQuickOption(rawFilter)
+	rawFilters := transformQuickOption(rawFilter, timeutil.AccountLocation(store))
 
 	if len(rawFilters) == 1 {
 		return makeFilterByCondition(spaceID, rawFilters[0], store)
@@ -233,6 +234,26 @@ func makeFilterByCondition(spaceID string, rawFilter FilterRequest, store Object
 		return FilterExists{
 			Key: rawFilter.RelationKey,
 		}, nil
+	case model.BlockContentDataviewFilter_Regex:
+		re, err := regexp.Compile(rawFilter.Value.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex: %w", err)
+		}
+		return FilterRegex{
+			Key:    rawFilter.RelationKey,
+			Regexp: re,
+		}, nil
+	case model.BlockContentDataviewFilter_NotRegex:
+		re, err := regexp.Compile(rawFilter.Value.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex: %w", err)
+		}
+		return FilterNot{FilterRegex{
+			Key:    rawFilter.RelationKey,
+			Regexp: re,
+		}}, nil
+	case model.BlockContentDataviewFilter_MatchesFullText:
+		return newFilterFulltext(rawFilter.RelationKey, rawFilter.Value.String(), store)
 	default:
 		return nil, fmt.Errorf("unexpected filter cond: %v", rawFilter.Condition)
 	}
@@ -580,6 +601,75 @@ func (l FilterLike) AnystoreFilter() query.Filter {
 	}
 }
 
+type FilterRegex struct {
+	Key    domain.RelationKey
+	Regexp *regexp.Regexp
+}
+
+func (r FilterRegex) FilterObject(g *domain.Details) bool {
+	if val, ok := g.TryString(r.Key); ok {
+		return r.Regexp.MatchString(val)
+	}
+	if list, ok := g.TryStringList(r.Key); ok {
+		return slices.ContainsFunc(list, r.Regexp.MatchString)
+	}
+	return false
+}
+
+func (r FilterRegex) AnystoreFilter() query.Filter {
+	return query.Key{
+		Path: []string{string(r.Key)},
+		Filter: query.Regexp{
+			Regexp: r.Regexp,
+		},
+	}
+}
+
+// FilterFulltext matches the objects found in the fulltext index. The matches are resolved when the filter is built,
+// so objects indexed afterward are not matched until the filter is built again
+type FilterFulltext struct {
+	Key domain.RelationKey
+	Ids []string
+	ids map[string]struct{}
+}
+
+// newFilterFulltext searches the fulltext index for the text. Hits are restricted to the given relation,
+// except for the id relation, which matches hits in any block or relation of the object
+func newFilterFulltext(key domain.RelationKey, text string, store ObjectStore) (Filter, error) {
+	paths, err := store.SearchFulltext(text)
+	if err != nil {
+		return nil, fmt.Errorf("fulltext filter: %w", err)
+	}
+	f := FilterFulltext{Key: key, Ids: make([]string, 0, len(paths)), ids: make(map[string]struct{}, len(paths))}
+	for _, path := range paths {
+		if key != bundle.RelationKeyId && path.RelationKey != string(key) {
+			continue
+		}
+		if _, ok := f.ids[path.ObjectId]; !ok {
+			f.ids[path.ObjectId] = struct{}{}
+			f.Ids = append(f.Ids, path.ObjectId)
+		}
+	}
+	return f, nil
+}
+
+func (f FilterFulltext) FilterObject(g *domain.Details) bool {
+	_, ok := f.ids[g.GetString(bundle.RelationKeyId)]
+	return ok
+}
+
+func (f FilterFulltext) AnystoreFilter() query.Filter {
+	arena := &anyenc.Arena{}
+	inVals := make([]*anyenc.Value, 0, len(f.Ids))
+	for _, id := range f.Ids {
+		inVals = append(inVals, arena.NewString(id))
+	}
+	return query.Key{
+		Path:   []string{bundle.RelationKeyId.String()},
+		Filter: query.NewInValue(inVals...),
+	}
+}
+
 type FilterExists struct {
 	Key domain.RelationKey
 }
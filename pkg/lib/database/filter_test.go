@@ -1,6 +1,7 @@
 package database
 
 import (
+	"regexp"
 	"testing"
 	"time"
 
@@ -206,6 +207,65 @@ func TestLike_FilterObject(t *testing.T) {
 	})
 }
 
+func TestRegex_FilterObject(t *testing.T) {
+	regex := FilterRegex{Key: "k", Regexp: regexp.MustCompile(`^[A-Z]+-\d+`)}
+	t.Run("ok", func(t *testing.T) {
+		g := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{"k": domain.String("ANY-123 fix sync")})
+		assertFilter(t, regex, g, true)
+	})
+	t.Run("not ok", func(t *testing.T) {
+		g := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{"k": domain.String("fix sync ANY-123")})
+		assertFilter(t, regex, g, false)
+	})
+	t.Run("ok list", func(t *testing.T) {
+		g := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{"k": domain.StringList([]string{"other", "ANY-1"})})
+		assertFilter(t, regex, g, true)
+	})
+	t.Run("not ok number", func(t *testing.T) {
+		g := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{"k": domain.Int64(123)})
+		assertFilter(t, regex, g, false)
+	})
+	t.Run("not regex", func(t *testing.T) {
+		g := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{"k": domain.String("fix sync")})
+		assertFilter(t, FilterNot{regex}, g, true)
+	})
+}
+
+func TestFulltext_FilterObject(t *testing.T) {
+	store := &stubSpaceObjectStore{fulltextResult: []domain.ObjectPath{
+		domain.NewObjectPathWithRelation("obj1", bundle.RelationKeyName.String()),
+		domain.NewObjectPathWithBlock("obj2", "block1"),
+	}}
+	obj1 := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyId: domain.String("obj1")})
+	obj2 := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyId: domain.String("obj2")})
+	obj3 := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyId: domain.String("obj3")})
+
+	t.Run("relation", func(t *testing.T) {
+		f, err := MakeFilters([]FilterRequest{
+			{RelationKey: bundle.RelationKeyName, Condition: model.BlockContentDataviewFilter_MatchesFullText, Value: domain.String("query")},
+		}, store)
+		require.NoError(t, err)
+		assertFilter(t, f, obj1, true)
+		assertFilter(t, f, obj2, false)
+	})
+	t.Run("whole object", func(t *testing.T) {
+		f, err := MakeFilters([]FilterRequest{
+			{RelationKey: bundle.RelationKeyId, Condition: model.BlockContentDataviewFilter_MatchesFullText, Value: domain.String("query")},
+		}, store)
+		require.NoError(t, err)
+		assertFilter(t, f, obj1, true)
+		assertFilter(t, f, obj2, true)
+		assertFilter(t, f, obj3, false)
+	})
+	t.Run("no matches", func(t *testing.T) {
+		f, err := MakeFilters([]FilterRequest{
+			{RelationKey: bundle.RelationKeyDescription, Condition: model.BlockContentDataviewFilter_MatchesFullText, Value: domain.String("query")},
+		}, store)
+		require.NoError(t, err)
+		assertFilter(t, f, obj1, false)
+	})
+}
+
 func TestEmpty_FilterObject(t *testing.T) {
 	empty := FilterEmpty{Key: "k"}
 	var emptyVals = []domain.Value{
@@ -398,6 +458,17 @@ func TestMakeAndFilter(t *testing.T) {
 		}
 
 	})
+	t.Run("invalid regex", func(t *testing.T) {
+		for _, cond := range []model.BlockContentDataviewFilterCondition{
+			model.BlockContentDataviewFilter_Regex,
+			model.BlockContentDataviewFilter_NotRegex,
+		} {
+			_, err := MakeFilters([]FilterRequest{
+				{RelationKey: "k", Condition: cond, Value: domain.String("[a-")},
+			}, store)
+			assert.Error(t, err)
+		}
+	})
 	t.Run("unexpected condition", func(t *testing.T) {
 		_, err := MakeFilters([]FilterRequest{
 			{Condition: 10000},
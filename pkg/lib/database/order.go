@@ -26,6 +26,9 @@ type ObjectStore interface {
 	QueryRaw(filters *Filters, limit int, offset int) ([]Record, error)
 	GetRelationFormatByKey(key domain.RelationKey) (model.RelationFormat, error)
 	ListRelationOptions(relationKey domain.RelationKey) (options []*model.RelationOption, err error)
+	SearchFulltext(text string) (paths []domain.ObjectPath, err error)
+	// AccountLocation returns the time zone, which dates relative to the current day are resolved in
+	AccountLocation() *time.Location
 }
 
 type SetOrder []Order
@@ -8,12 +8,12 @@ import (
 	timeutil "github.com/anyproto/anytype-heart/util/time"
 )
 
-func transformQuickOption(protoFilter FilterRequest) []FilterRequest {
+func transformQuickOption(protoFilter FilterRequest, loc *time.Location) []FilterRequest {
 	if protoFilter.QuickOption == 0 && protoFilter.Format != model.RelationFormat_date {
 		return []FilterRequest{protoFilter}
 	}
 
-	from, to := getDateRange(protoFilter, time.Now())
+	from, to := getDateRange(protoFilter, time.Now().In(loc))
 	switch protoFilter.Condition {
 	case model.BlockContentDataviewFilter_Equal, model.BlockContentDataviewFilter_In:
 		return []FilterRequest{{
@@ -38,6 +38,7 @@ func transformQuickOption(protoFilter FilterRequest) []FilterRequest {
 	return []FilterRequest{protoFilter}
 }
 
+// getDateRange resolves the range in the location of now
 func getDateRange(f FilterRequest, now time.Time) (from, to time.Time) {
 	calendar := timeutil.NewCalendar(now, nil)
 	switch f.QuickOption {
@@ -65,6 +66,18 @@ func getDateRange(f FilterRequest, now time.Time) (from, to time.Time) {
 	case model.BlockContentDataviewFilter_NumberOfDaysNow:
 		daysCnt := f.Value.Int64()
 		return calendar.DayNumStart(int(daysCnt)), calendar.DayNumEnd(int(daysCnt))
+	case model.BlockContentDataviewFilter_LastNumberOfDays:
+		daysCnt := max(int(f.Value.Int64()), 1)
+		return calendar.DayNumStart(1 - daysCnt), calendar.DayNumEnd(0)
+	case model.BlockContentDataviewFilter_NextNumberOfDays:
+		daysCnt := max(int(f.Value.Int64()), 1)
+		return calendar.DayNumStart(0), calendar.DayNumEnd(daysCnt - 1)
+	case model.BlockContentDataviewFilter_LastQuarter:
+		return calendar.QuarterNumStart(-1), calendar.QuarterNumEnd(-1)
+	case model.BlockContentDataviewFilter_CurrentQuarter:
+		return calendar.QuarterNumStart(0), calendar.QuarterNumEnd(0)
+	case model.BlockContentDataviewFilter_NextQuarter:
+		return calendar.QuarterNumStart(1), calendar.QuarterNumEnd(1)
 	case model.BlockContentDataviewFilter_LastYear:
 		return calendar.YearNumStart(-1), calendar.YearNumEnd(-1)
 	case model.BlockContentDataviewFilter_CurrentYear:
@@ -73,8 +86,22 @@ func getDateRange(f FilterRequest, now time.Time) (from, to time.Time) {
 		return calendar.YearNumStart(1), calendar.YearNumEnd(1)
 	default:
 		timestamp := f.Value.Int64()
-		t := time.Unix(timestamp, 0)
+		t := time.Unix(timestamp, 0).In(now.Location())
 		calendar = timeutil.NewCalendar(t, nil)
 		return calendar.DayNumStart(0), calendar.DayNumEnd(0)
 	}
 }
+
+// HasRelativeDateFilters reports whether any of the filters is resolved relative to the current date,
+// so its result changes when the day rolls over
+func HasRelativeDateFilters(filters []FilterRequest) bool {
+	for _, f := range filters {
+		if f.QuickOption != model.BlockContentDataviewFilter_ExactDate && f.Condition != model.BlockContentDataviewFilter_None {
+			return true
+		}
+		if HasRelativeDateFilters(f.NestedFilters) {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
@@ -32,6 +33,17 @@ func calculateMonthEnd(base time.Time, monthsOffset int) int64 {
 	return firstDayOfMonth.AddDate(0, 1, 0).Add(-1 * time.Nanosecond).Unix()
 }
 
+func calculateQuarterStart(base time.Time, quartersOffset int) int64 {
+	firstMonth := (int(base.Month())-1)/3*3 + 1
+	return time.Date(base.Year(), time.Month(firstMonth+quartersOffset*3), 1, 0, 0, 0, 0, base.Location()).Unix()
+}
+
+func calculateQuarterEnd(base time.Time, quartersOffset int) int64 {
+	firstMonth := (int(base.Month())-1)/3*3 + 1
+	firstDayOfQuarter := time.Date(base.Year(), time.Month(firstMonth+quartersOffset*3), 1, 0, 0, 0, 0, base.Location())
+	return firstDayOfQuarter.AddDate(0, 3, 0).Add(-1 * time.Nanosecond).Unix()
+}
+
 func calculateWeekStartTime(base time.Time, weeksOffset int) time.Time {
 	shift := -1 * ((base.Weekday() + 6) % 7)
 	monday := time.Date(base.Year(), base.Month(), base.Day(), 0, 0, 0, 0, base.Location()).AddDate(0, 0, int(shift))
@@ -517,9 +529,75 @@ func TestQuickOption(t *testing.T) {
 			},
 			expectedFilters: []FilterRequest{{Condition: model.BlockContentDataviewFilter_LessOrEqual, Value: domain.Int64(calculateDayEnd(now, 7))}},
 		},
+
+		// rolling windows
+		{
+			name: "last 7 days",
+			inputFilter: FilterRequest{
+				QuickOption: model.BlockContentDataviewFilter_LastNumberOfDays,
+				RelationKey: relationKey,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(7),
+			},
+			expectedFilters: []FilterRequest{
+				{Condition: model.BlockContentDataviewFilter_LessOrEqual, Value: domain.Int64(calculateDayEnd(now, 0))},
+				{Condition: model.BlockContentDataviewFilter_GreaterOrEqual, Value: domain.Int64(calculateDayStart(now, -6))},
+			},
+		}, {
+			name: "next 3 days",
+			inputFilter: FilterRequest{
+				QuickOption: model.BlockContentDataviewFilter_NextNumberOfDays,
+				RelationKey: relationKey,
+				Condition:   model.BlockContentDataviewFilter_In,
+				Value:       domain.Int64(3),
+			},
+			expectedFilters: []FilterRequest{
+				{Condition: model.BlockContentDataviewFilter_LessOrEqual, Value: domain.Int64(calculateDayEnd(now, 2))},
+				{Condition: model.BlockContentDataviewFilter_GreaterOrEqual, Value: domain.Int64(calculateDayStart(now, 0))},
+			},
+		}, {
+			name: "strictly after next 0 days",
+			inputFilter: FilterRequest{
+				QuickOption: model.BlockContentDataviewFilter_NextNumberOfDays,
+				RelationKey: relationKey,
+				Condition:   model.BlockContentDataviewFilter_Greater,
+				Value:       domain.Int64(0),
+			},
+			expectedFilters: []FilterRequest{{Condition: model.BlockContentDataviewFilter_Greater, Value: domain.Int64(calculateDayEnd(now, 0))}},
+		},
+
+		// quarters
+		{
+			name: "current quarter",
+			inputFilter: FilterRequest{
+				QuickOption: model.BlockContentDataviewFilter_CurrentQuarter,
+				RelationKey: relationKey,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+			},
+			expectedFilters: []FilterRequest{
+				{Condition: model.BlockContentDataviewFilter_LessOrEqual, Value: domain.Int64(calculateQuarterEnd(now, 0))},
+				{Condition: model.BlockContentDataviewFilter_GreaterOrEqual, Value: domain.Int64(calculateQuarterStart(now, 0))},
+			},
+		}, {
+			name: "strictly before last quarter",
+			inputFilter: FilterRequest{
+				QuickOption: model.BlockContentDataviewFilter_LastQuarter,
+				RelationKey: relationKey,
+				Condition:   model.BlockContentDataviewFilter_Less,
+			},
+			expectedFilters: []FilterRequest{{Condition: model.BlockContentDataviewFilter_Less, Value: domain.Int64(calculateQuarterStart(now, -1))}},
+		}, {
+			name: "next quarter or before",
+			inputFilter: FilterRequest{
+				QuickOption: model.BlockContentDataviewFilter_NextQuarter,
+				RelationKey: relationKey,
+				Condition:   model.BlockContentDataviewFilter_LessOrEqual,
+			},
+			expectedFilters: []FilterRequest{{Condition: model.BlockContentDataviewFilter_LessOrEqual, Value: domain.Int64(calculateQuarterEnd(now, 1))}},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
-			filters := transformQuickOption(tc.inputFilter)
+			filters := transformQuickOption(tc.inputFilter, time.Local)
 			assert.Len(t, filters, len(tc.expectedFilters))
 			for i, f := range filters {
 				assert.Equal(t, tc.expectedFilters[i].Condition, f.Condition)
@@ -530,6 +608,40 @@ func TestQuickOption(t *testing.T) {
 	}
 }
 
+func TestQuickOptionAccountLocation(t *testing.T) {
+	// given
+	loc, err := time.LoadLocation("Pacific/Kiritimati")
+	require.NoError(t, err)
+
+	// when
+	from, to := getDateRange(FilterRequest{QuickOption: model.BlockContentDataviewFilter_Today}, time.Date(2025, 3, 31, 20, 0, 0, 0, time.UTC).In(loc))
+
+	// then
+	assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, loc).Unix(), from.Unix())
+	assert.Equal(t, time.Date(2025, 4, 1, 23, 59, 59, 0, loc).Unix(), to.Unix())
+}
+
+func TestHasRelativeDateFilters(t *testing.T) {
+	t.Run("exact dates", func(t *testing.T) {
+		assert.False(t, HasRelativeDateFilters([]FilterRequest{{
+			RelationKey: bundle.RelationKeyDueDate,
+			Condition:   model.BlockContentDataviewFilter_Greater,
+			Value:       domain.Int64(1700000000),
+		}}))
+	})
+
+	t.Run("nested quick option", func(t *testing.T) {
+		assert.True(t, HasRelativeDateFilters([]FilterRequest{{
+			Operator: model.BlockContentDataviewFilter_Or,
+			NestedFilters: []FilterRequest{{
+				RelationKey: bundle.RelationKeyDueDate,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				QuickOption: model.BlockContentDataviewFilter_CurrentWeek,
+			}},
+		}}))
+	})
+}
+
 func lastMondayStart(t time.Time) int64 {
 	shift := -1 * ((t.Weekday() + 6) % 7)
 	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).Add(24 * time.Duration(shift) * time.Hour).Unix()
@@ -44,6 +44,10 @@ func (k systemKeys) AccountStatus() string {
 	return "account_status"
 }
 
+func (k systemKeys) RemindersLastCheck() string {
+	return "reminders_last_check"
+}
+
 var SystemKeys = systemKeys{}
 
 type Provider interface {
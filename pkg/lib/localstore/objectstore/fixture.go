@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/anyproto/any-sync/app"
 	"github.com/stretchr/testify/require"
@@ -14,6 +15,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/datastore/anystoreprovider"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
+	timeutil "github.com/anyproto/anytype-heart/util/time"
 )
 
 type StoreFixture struct {
@@ -135,6 +137,11 @@ func NewStoreFixture(t testing.TB) *StoreFixture {
 	}
 }
 
+// SetLocation sets the time zone of the account for space indexes, which are not opened yet
+func (fx *StoreFixture) SetLocation(loc *time.Location) {
+	fx.locationProvider = timeutil.FixedLocation{Location: loc}
+}
+
 func (fx *StoreFixture) Init(a *app.App) (err error) {
 	return nil
 }
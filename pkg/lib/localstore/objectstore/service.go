@@ -23,6 +23,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/keyvaluestore"
+	timeutil "github.com/anyproto/anytype-heart/util/time"
 )
 
 var log = logging.Logger("anytype-localstore")
@@ -114,6 +115,7 @@ type dsObjectStore struct {
 	subManager          *spaceindex.SubscriptionManager
 	sourceService       spaceindex.SourceDetailsFromID
 	techSpaceIdProvider TechSpaceIdProvider
+	locationProvider    timeutil.LocationProvider
 
 	spaceStoreDirsCheck sync.Once
 
@@ -170,6 +172,7 @@ func (s *dsObjectStore) Init(a *app.App) (err error) {
 	s.arenaPool = &anyenc.ArenaPool{}
 
 	s.techSpaceIdProvider = app.MustComponent[TechSpaceIdProvider](a)
+	s.locationProvider, _ = app.GetComponent[timeutil.LocationProvider](a)
 	statService, _ := app.GetComponent[debugstat.StatService](a)
 	if statService != nil {
 		statService.AddProvider(s)
@@ -265,11 +268,12 @@ func (s *dsObjectStore) getOrInitSpaceIndex(spaceId string) spaceindex.Store {
 	store, ok := s.spaceIndexes[spaceId]
 	if !ok {
 		store = spaceindex.New(s.componentCtx, spaceId, spaceindex.Deps{
-			DbProvider:    s.anystoreProvider,
-			SourceService: s.sourceService,
-			Fts:           s.fts,
-			SubManager:    s.subManager,
-			FulltextQueue: s,
+			DbProvider:       s.anystoreProvider,
+			SourceService:    s.sourceService,
+			Fts:              s.fts,
+			SubManager:       s.subManager,
+			FulltextQueue:    s,
+			LocationProvider: s.locationProvider,
 		})
 		s.spaceIndexes[spaceId] = store
 	}
@@ -423,3 +427,7 @@ func (s *dsObjectStore) QueryCrossSpace(q database.Query) ([]database.Record, er
 func (s *dsObjectStore) SubscribeLinksUpdate(callback func(info spaceindex.LinksUpdateInfo)) {
 	s.subManager.SubscribeLinksUpdate(callback)
 }
+
+func (s *dsObjectStore) SubscribeDetailsUpdate(callback func(info spaceindex.DetailsUpdateInfo)) {
+	s.subManager.SubscribeDetailsUpdate(callback)
+}
@@ -29,6 +29,11 @@ func (s *dsObjectStore) DeleteDetails(ctx context.Context, ids []string) error {
 		if err != nil && !errors.Is(err, anystore.ErrDocNotFound) {
 			return fmt.Errorf("delete headsState %s: %w", id, err)
 		}
+
+		err = s.fileTexts.DeleteId(txn.Context(), id)
+		if err != nil && !errors.Is(err, anystore.ErrDocNotFound) {
+			return fmt.Errorf("delete fileText %s: %w", id, err)
+		}
 	}
 	return txn.Commit()
 }
@@ -58,6 +63,10 @@ func (s *dsObjectStore) DeleteObject(id string) error {
 	if err != nil && !errors.Is(err, anystore.ErrDocNotFound) {
 		return fmt.Errorf("delete: heads state delete: %w", err)
 	}
+	err = s.fileTexts.DeleteId(txn.Context(), id)
+	if err != nil && !errors.Is(err, anystore.ErrDocNotFound) {
+		return fmt.Errorf("delete: file text delete: %w", err)
+	}
 	err = s.eraseLinksForObject(txn.Context(), id)
 	if err != nil {
 		return fmt.Errorf("delete: erase links: %w", err)
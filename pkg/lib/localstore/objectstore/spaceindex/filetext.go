@@ -0,0 +1,53 @@
+package spaceindex
+
+import (
+	"errors"
+	"fmt"
+
+	anystore "github.com/anyproto/any-store"
+)
+
+const fileTextPagesField = "pages"
+
+// SetFileText stores the text extracted from the file content split by pages. Empty pages remove the text
+func (s *dsObjectStore) SetFileText(objectId string, pages []string) error {
+	if len(pages) == 0 {
+		err := s.fileTexts.DeleteId(s.componentCtx, objectId)
+		if err != nil && !errors.Is(err, anystore.ErrDocNotFound) {
+			return fmt.Errorf("delete file text: %w", err)
+		}
+		return nil
+	}
+
+	arena := s.arenaPool.Get()
+	defer func() {
+		arena.Reset()
+		s.arenaPool.Put(arena)
+	}()
+
+	arr := arena.NewArray()
+	for i, page := range pages {
+		arr.SetArrayItem(i, arena.NewString(page))
+	}
+	doc := arena.NewObject()
+	doc.Set("id", arena.NewString(objectId))
+	doc.Set(fileTextPagesField, arr)
+	return s.fileTexts.UpsertOne(s.componentCtx, doc)
+}
+
+// GetFileText returns the pages of text extracted from the file content or nil if the text was not extracted
+func (s *dsObjectStore) GetFileText(objectId string) ([]string, error) {
+	doc, err := s.fileTexts.FindId(s.componentCtx, objectId)
+	if errors.Is(err, anystore.ErrDocNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get file text: %w", err)
+	}
+	values := doc.Value().GetArray(fileTextPagesField)
+	pages := make([]string, 0, len(values))
+	for _, v := range values {
+		pages = append(pages, string(v.GetStringBytes()))
+	}
+	return pages, nil
+}
@@ -0,0 +1,57 @@
+package spaceindex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDsObjectStore_FileText(t *testing.T) {
+	t.Run("text is not extracted", func(t *testing.T) {
+		s := NewStoreFixture(t)
+
+		pages, err := s.GetFileText("file1")
+
+		require.NoError(t, err)
+		assert.Nil(t, pages)
+	})
+
+	t.Run("set, get and remove text", func(t *testing.T) {
+		// given
+		s := NewStoreFixture(t)
+
+		// when
+		err := s.SetFileText("file1", []string{"first page", "", "third page"})
+		require.NoError(t, err)
+
+		// then
+		pages, err := s.GetFileText("file1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"first page", "", "third page"}, pages)
+
+		// when
+		err = s.SetFileText("file1", nil)
+		require.NoError(t, err)
+
+		// then
+		pages, err = s.GetFileText("file1")
+		require.NoError(t, err)
+		assert.Nil(t, pages)
+	})
+
+	t.Run("text is removed with object details", func(t *testing.T) {
+		// given
+		s := NewStoreFixture(t)
+		require.NoError(t, s.SetFileText("file1", []string{"text"}))
+
+		// when
+		err := s.DeleteDetails(s.componentCtx, []string{"file1"})
+
+		// then
+		require.NoError(t, err)
+		pages, err := s.GetFileText("file1")
+		require.NoError(t, err)
+		assert.Nil(t, pages)
+	})
+}
@@ -2,6 +2,7 @@ package spaceindex
 
 import (
 	"context"
+	"time"
 
 	anystore "github.com/anyproto/any-store"
 	"github.com/anyproto/any-store/anyenc"
@@ -147,6 +148,14 @@ func (s *invalidStore) GetActiveViews(objectId string) (map[string]string, error
 	return nil, s.err
 }
 
+func (s *invalidStore) SetFileText(objectId string, pages []string) error {
+	return s.err
+}
+
+func (s *invalidStore) GetFileText(objectId string) ([]string, error) {
+	return nil, s.err
+}
+
 func (s *invalidStore) GetRelationLink(key string) (*model.RelationLink, error) {
 	return nil, s.err
 }
@@ -183,6 +192,18 @@ func (s *invalidStore) ListRelationOptions(relationKey domain.RelationKey) (opti
 	return nil, s.err
 }
 
+func (s *invalidStore) SearchFulltext(text string) (paths []domain.ObjectPath, err error) {
+	return nil, s.err
+}
+
+func (s *invalidStore) AccountLocation() *time.Location {
+	return time.Local
+}
+
+func (s *invalidStore) SearchChatMessages(chatObjectId string, text string) (results []database.FulltextResult, err error) {
+	return nil, s.err
+}
+
 func (s *invalidStore) GetObjectType(id string) (*model.ObjectType, error) {
 	return nil, s.err
 }
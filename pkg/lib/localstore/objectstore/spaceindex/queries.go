@@ -21,6 +21,7 @@ import (
 	"github.com/anyproto/anytype-heart/space/spacecore/typeprovider"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
 	text2 "github.com/anyproto/anytype-heart/util/text"
+	timeutil "github.com/anyproto/anytype-heart/util/time"
 )
 
 var pluralNameId = domain.ObjectPath{
@@ -387,6 +388,85 @@ func (s *dsObjectStore) performFulltextSearch(search func() (results []*ftsearch
 	return results, nil
 }
 
+func (s *dsObjectStore) AccountLocation() *time.Location {
+	return timeutil.AccountLocation(s.locationProvider)
+}
+
+// SearchFulltext returns the paths of the blocks and relations of the space matching the fulltext query
+func (s *dsObjectStore) SearchFulltext(text string) ([]domain.ObjectPath, error) {
+	if s.fts == nil {
+		return nil, fmt.Errorf("fullText search: index is not available")
+	}
+	ftsResults, err := s.fts.Search(s.SpaceId(), strings.TrimSpace(text))
+	if err != nil {
+		return nil, fmt.Errorf("fullText search: %w", err)
+	}
+
+	paths := make([]domain.ObjectPath, 0, len(ftsResults))
+	for _, result := range ftsResults {
+		if result.Score < minFulltextScore && !hasHighlightRanges(result) {
+			continue
+		}
+		path, err := domain.NewFromPath(result.ID)
+		if err != nil {
+			return nil, fmt.Errorf("fullText search: %w", err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// SearchChatMessages returns the chat messages of the space matching the fulltext query, ordered by score.
+// Pass an empty chatObjectId to search in all chats of the space
+func (s *dsObjectStore) SearchChatMessages(chatObjectId string, text string) ([]database.FulltextResult, error) {
+	if s.fts == nil {
+		return nil, fmt.Errorf("fullText search: index is not available")
+	}
+	ftsResults, err := s.fts.Search(s.SpaceId(), strings.TrimSpace(text))
+	if err != nil {
+		return nil, fmt.Errorf("fullText search: %w", err)
+	}
+
+	var results []database.FulltextResult
+	for _, result := range ftsResults {
+		path, err := domain.NewFromPath(result.ID)
+		if err != nil {
+			return nil, fmt.Errorf("fullText search: %w", err)
+		}
+		if !path.HasMessage() || (chatObjectId != "" && path.ObjectId != chatObjectId) {
+			continue
+		}
+		if result.Score < minFulltextScore && !hasHighlightRanges(result) {
+			continue
+		}
+		res := database.FulltextResult{
+			Path:  path,
+			Score: result.Score,
+		}
+		for _, v := range result.Fragments {
+			if len(v.Ranges) > 0 {
+				res.Highlight = v.Text
+				res.HighlightRanges = convertToHighlightRanges(v.Ranges, v.Text)
+				break
+			}
+		}
+		results = append(results, res)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	return results, nil
+}
+
+func hasHighlightRanges(result *ftsearch.DocumentMatch) bool {
+	for _, fragment := range result.Fragments {
+		if len(fragment.Ranges) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func preferPluralNameRelation(objectPerBlockResults []*ftsearch.DocumentMatch) *ftsearch.DocumentMatch {
 	doc, found := lo.Find(objectPerBlockResults, func(item *ftsearch.DocumentMatch) bool {
 		return strings.HasSuffix(item.ID, pluralNameId)
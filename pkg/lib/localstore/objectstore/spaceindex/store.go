@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	anystore "github.com/anyproto/any-store"
 	"github.com/anyproto/any-store/anyenc"
@@ -19,6 +20,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
+	timeutil "github.com/anyproto/anytype-heart/util/time"
 )
 
 var log = logging.Logger("objectstore.spaceindex")
@@ -72,6 +74,9 @@ type Store interface {
 	SetActiveViews(objectId string, views map[string]string) error
 	GetActiveViews(objectId string) (map[string]string, error)
 
+	SetFileText(objectId string, pages []string) error
+	GetFileText(objectId string) ([]string, error)
+
 	GetRelationLink(key string) (*model.RelationLink, error)
 	FetchRelationByKey(key string) (relation *relationutils.Relation, err error)
 	FetchRelationByKeys(keys ...domain.RelationKey) (relations relationutils.Relations, err error)
@@ -81,6 +86,9 @@ type Store interface {
 	GetRelationByKey(key string) (*model.Relation, error)
 	GetRelationFormatByKey(key domain.RelationKey) (model.RelationFormat, error)
 	ListRelationOptions(relationKey domain.RelationKey) (options []*model.RelationOption, err error)
+	SearchFulltext(text string) (paths []domain.ObjectPath, err error)
+	SearchChatMessages(chatObjectId string, text string) (results []database.FulltextResult, err error)
+	AccountLocation() *time.Location
 
 	GetObjectType(id string) (*model.ObjectType, error)
 
@@ -110,14 +118,16 @@ type dsObjectStore struct {
 
 	activeViews    anystore.Collection
 	pendingDetails anystore.Collection
+	fileTexts      anystore.Collection
 	collections    []anystore.Collection
 
 	// Deps
-	fts           ftsearch.FTSearch
-	sourceService SourceDetailsFromID
-	subManager    *SubscriptionManager
-	fulltextQueue FulltextQueue
-	dbProvider    anystoreprovider.Provider
+	fts              ftsearch.FTSearch
+	sourceService    SourceDetailsFromID
+	subManager       *SubscriptionManager
+	fulltextQueue    FulltextQueue
+	dbProvider       anystoreprovider.Provider
+	locationProvider timeutil.LocationProvider
 
 	componentCtx       context.Context
 	arenaPool          *anyenc.ArenaPool
@@ -136,6 +146,8 @@ type Deps struct {
 	SourceService SourceDetailsFromID
 	SubManager    *SubscriptionManager
 	FulltextQueue FulltextQueue
+	// LocationProvider is optional, the time zone of the device is used without it
+	LocationProvider timeutil.LocationProvider
 }
 
 func New(componentCtx context.Context, spaceId string, deps Deps) Store {
@@ -149,6 +161,7 @@ func New(componentCtx context.Context, spaceId string, deps Deps) Store {
 		subManager:         deps.SubManager,
 		fulltextQueue:      deps.FulltextQueue,
 		dbProvider:         deps.DbProvider,
+		locationProvider:   deps.LocationProvider,
 	}
 
 	return s
@@ -177,6 +190,13 @@ type LinksUpdateInfo struct {
 	Added, Removed []string
 }
 
+type DetailsUpdateInfo struct {
+	Id domain.FullID
+	// OldDetails is nil when the object is indexed for the first time
+	OldDetails *domain.Details
+	NewDetails *domain.Details
+}
+
 var _ Store = (*dsObjectStore)(nil)
 
 func (s *dsObjectStore) WriteTx(ctx context.Context) (anystore.WriteTx, error) {
@@ -204,6 +224,10 @@ func (s *dsObjectStore) initCollections(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("open pendingDetails collection: %w", err)
 	}
+	fileTexts, err := s.newCollection(ctx, "fileTexts")
+	if err != nil {
+		return fmt.Errorf("open fileTexts collection: %w", err)
+	}
 
 	objectIndexes := []anystore.IndexInfo{
 		{
@@ -272,6 +296,7 @@ func (s *dsObjectStore) initCollections(ctx context.Context) error {
 	s.headsState = headsState
 	s.activeViews = activeViews
 	s.pendingDetails = pendingDetails
+	s.fileTexts = fileTexts
 
 	return nil
 }
@@ -7,8 +7,9 @@ import (
 )
 
 type SubscriptionManager struct {
-	lock                  sync.RWMutex
-	onLinksUpdateCallback func(info LinksUpdateInfo)
+	lock                    sync.RWMutex
+	onLinksUpdateCallback   func(info LinksUpdateInfo)
+	onDetailsUpdateCallback func(info DetailsUpdateInfo)
 }
 
 func (s *SubscriptionManager) SubscribeLinksUpdate(callback func(info LinksUpdateInfo)) {
@@ -28,3 +29,28 @@ func (s *SubscriptionManager) updateObjectLinks(fromId domain.FullID, added []st
 		})
 	}
 }
+
+// SubscribeDetailsUpdate sets the callback that receives previous and new details of updated objects, nil callback unsubscribes
+func (s *SubscriptionManager) SubscribeDetailsUpdate(callback func(info DetailsUpdateInfo)) {
+	s.lock.Lock()
+	s.onDetailsUpdateCallback = callback
+	s.lock.Unlock()
+}
+
+func (s *SubscriptionManager) hasDetailsSubscription() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.onDetailsUpdateCallback != nil
+}
+
+func (s *SubscriptionManager) updateObjectDetails(id domain.FullID, oldDetails, newDetails *domain.Details) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.onDetailsUpdateCallback != nil {
+		s.onDetailsUpdateCallback(DetailsUpdateInfo{
+			Id:         id,
+			OldDetails: oldDetails,
+			NewDetails: newDetails,
+		})
+	}
+}
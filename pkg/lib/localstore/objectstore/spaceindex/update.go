@@ -34,16 +34,28 @@ func (s *dsObjectStore) UpdateObjectDetails(ctx context.Context, id string, deta
 		s.arenaPool.Put(arena)
 	}()
 	newVal := details.ToAnyEnc(arena)
-	var isModified bool
+	var (
+		isModified bool
+		oldDetails *domain.Details
+	)
+	withOldDetails := s.subManager != nil && s.subManager.hasDetailsSubscription()
 	_, err := s.objects.UpsertId(ctx, id, query.ModifyFunc(func(arena *anyenc.Arena, val *anyenc.Value) (*anyenc.Value, bool, error) {
 		if anyencutil.Equal(val, newVal) {
 			return nil, false, nil
 		}
 		isModified = true
+		// a new document contains only id
+		if withOldDetails && val.Type() == anyenc.TypeObject && val.GetObject().Len() > 1 {
+			// the value is only valid inside the modifier, so it is converted here
+			oldDetails, _ = domain.NewDetailsFromAnyEnc(val)
+		}
 		return newVal, true, nil
 	}))
 	if isModified {
 		s.sendUpdatesToSubscriptions(id, details)
+		if withOldDetails && err == nil {
+			s.subManager.updateObjectDetails(domain.FullID{SpaceID: s.SpaceId(), ObjectID: id}, oldDetails, details.Copy())
+		}
 	}
 
 	if err != nil {
@@ -139,6 +139,43 @@ func TestSendUpdatesToSubscriptions(t *testing.T) {
 	})
 }
 
+func TestSubscribeDetailsUpdate(t *testing.T) {
+	t.Run("with new details expect no old details", func(t *testing.T) {
+		s := NewStoreFixture(t)
+		obj := makeObjectWithName("id1", "foo")
+
+		var updates []DetailsUpdateInfo
+		s.subManager.SubscribeDetailsUpdate(func(info DetailsUpdateInfo) {
+			updates = append(updates, info)
+		})
+
+		s.AddObjects(t, []TestObject{obj})
+
+		require.Len(t, updates, 1)
+		assert.Equal(t, domain.FullID{SpaceID: s.SpaceId(), ObjectID: "id1"}, updates[0].Id)
+		assert.Nil(t, updates[0].OldDetails)
+		assert.Equal(t, makeDetails(obj), updates[0].NewDetails)
+	})
+
+	t.Run("with updated details expect old and new details", func(t *testing.T) {
+		s := NewStoreFixture(t)
+		obj := makeObjectWithName("id1", "foo")
+		s.AddObjects(t, []TestObject{obj})
+
+		var updates []DetailsUpdateInfo
+		s.subManager.SubscribeDetailsUpdate(func(info DetailsUpdateInfo) {
+			updates = append(updates, info)
+		})
+
+		updatedObj := makeObjectWithNameAndDescription("id1", "foobar", "bar")
+		s.AddObjects(t, []TestObject{obj, updatedObj})
+
+		require.Len(t, updates, 1)
+		assert.Equal(t, makeDetails(obj), updates[0].OldDetails)
+		assert.Equal(t, makeDetails(updatedObj), updates[0].NewDetails)
+	})
+}
+
 func TestUpdatePendingLocalDetails(t *testing.T) {
 	t.Run("with error in process function expect previous details are not touched", func(t *testing.T) {
 		s := NewStoreFixture(t)
@@ -0,0 +1,224 @@
+package mediaprobe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	id3v2HeaderSize = 10
+	id3v1Size       = 128
+	// mp3FrameSearchLimit limits the search of the first frame after ID3 tags
+	mp3FrameSearchLimit = 64 * 1024
+	// oggTailSize is the size of the end of the file that contains the last page with the final granule position
+	oggTailSize = 64 * 1024
+)
+
+var (
+	mp3BitratesV1 = [16]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0}
+	mp3BitratesV2 = [16]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0}
+	mp3SampleRate = [3]int{44100, 48000, 32000}
+)
+
+type mp3Frame struct {
+	offset int64
+	// mpeg1 is false for MPEG 2 and 2.5
+	mpeg1      bool
+	mono       bool
+	bitrate    int
+	sampleRate int
+}
+
+func (f mp3Frame) samplesPerFrame() int {
+	if f.mpeg1 {
+		return 1152
+	}
+	return 576
+}
+
+// sideInfoSize is the size of the layer III side information that precedes the Xing header
+func (f mp3Frame) sideInfoSize() int {
+	switch {
+	case f.mpeg1 && f.mono:
+		return 17
+	case f.mpeg1:
+		return 32
+	case f.mono:
+		return 9
+	}
+	return 17
+}
+
+// parseMP3FrameHeader supports only layer III frames
+func parseMP3FrameHeader(header []byte) (mp3Frame, bool) {
+	if len(header) < 4 || header[0] != 0xFF || header[1]&0xE0 != 0xE0 {
+		return mp3Frame{}, false
+	}
+	version := (header[1] >> 3) & 0x3
+	layer := (header[1] >> 1) & 0x3
+	bitrateIdx := header[2] >> 4
+	sampleRateIdx := (header[2] >> 2) & 0x3
+	// version 1 is reserved, layer 1 is layer III
+	if version == 1 || layer != 1 || bitrateIdx == 0 || bitrateIdx == 15 || sampleRateIdx == 3 {
+		return mp3Frame{}, false
+	}
+	frame := mp3Frame{
+		mpeg1: version == 3,
+		mono:  header[3]>>6 == 3,
+	}
+	frame.sampleRate = mp3SampleRate[sampleRateIdx]
+	if frame.mpeg1 {
+		frame.bitrate = mp3BitratesV1[bitrateIdx]
+	} else {
+		frame.bitrate = mp3BitratesV2[bitrateIdx]
+		frame.sampleRate /= 2
+		if version == 0 {
+			// MPEG 2.5
+			frame.sampleRate /= 2
+		}
+	}
+	return frame, true
+}
+
+func findMP3Frame(r io.ReadSeeker, size int64) (mp3Frame, error) {
+	var start int64
+	header := make([]byte, id3v2HeaderSize)
+	if _, err := readAt(r, header, 0); err == nil && bytes.HasPrefix(header, []byte("ID3")) {
+		// tag size is a syncsafe integer, footer flag adds another 10 bytes
+		tagSize := int64(header[6])<<21 | int64(header[7])<<14 | int64(header[8])<<7 | int64(header[9])
+		start = id3v2HeaderSize + tagSize
+		if header[5]&0x10 != 0 {
+			start += id3v2HeaderSize
+		}
+	}
+	buf := make([]byte, min(mp3FrameSearchLimit, max(size-start, 0)))
+	n, err := readAt(r, buf, start)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return mp3Frame{}, err
+	}
+	buf = buf[:n]
+	for i := 0; i+4 <= len(buf); i++ {
+		if frame, ok := parseMP3FrameHeader(buf[i:]); ok {
+			frame.offset = start + int64(i)
+			return frame, nil
+		}
+	}
+	return mp3Frame{}, ErrUnsupportedFormat
+}
+
+// probeMP3 takes the number of frames from Xing or VBRI headers of VBR files, otherwise the bitrate of the first frame is used
+func probeMP3(r io.ReadSeeker, size int64, info *Info) error {
+	frame, err := findMP3Frame(r, size)
+	if err != nil {
+		return err
+	}
+	info.AudioCodec = "mp3"
+
+	buf := make([]byte, 4+frame.sideInfoSize()+8)
+	if _, err = readAt(r, buf, frame.offset); err != nil {
+		return nil
+	}
+	xing := buf[4+frame.sideInfoSize():]
+	if bytes.HasPrefix(xing, []byte("Xing")) || bytes.HasPrefix(xing, []byte("Info")) {
+		flags := be32(xing[4:8])
+		if flags&0x1 != 0 {
+			frames := make([]byte, 4)
+			if _, err = readAt(r, frames, frame.offset+int64(4+frame.sideInfoSize()+8)); err == nil {
+				info.Duration = framesDuration(frame, be32(frames))
+				return nil
+			}
+		}
+	}
+	vbri := make([]byte, 18)
+	if _, err = readAt(r, vbri, frame.offset+36); err == nil && bytes.HasPrefix(vbri, []byte("VBRI")) {
+		info.Duration = framesDuration(frame, be32(vbri[14:18]))
+		return nil
+	}
+
+	audioSize := size - frame.offset
+	tail := make([]byte, 3)
+	if _, err = readAt(r, tail, size-id3v1Size); err == nil && string(tail) == "TAG" {
+		audioSize -= id3v1Size
+	}
+	info.Duration = secondsToDuration(float64(audioSize) * 8 / float64(frame.bitrate*1000))
+	return nil
+}
+
+func framesDuration(frame mp3Frame, frames uint32) time.Duration {
+	return secondsToDuration(float64(frames) * float64(frame.samplesPerFrame()) / float64(frame.sampleRate))
+}
+
+// probeFLAC reads the STREAMINFO block that is always the first metadata block
+func probeFLAC(r io.ReadSeeker, info *Info) error {
+	buf := make([]byte, 4+4+34)
+	if _, err := readAt(r, buf, 0); err != nil {
+		return fmt.Errorf("read stream info: %w", err)
+	}
+	if buf[4]&0x7F != 0 {
+		return fmt.Errorf("stream info block is missing")
+	}
+	info.AudioCodec = "flac"
+	// sample rate (20 bits), channels (3), bits per sample (5) and total samples (36) follow the frame sizes
+	packed := binary.BigEndian.Uint64(buf[8+10 : 8+18])
+	sampleRate := packed >> 44
+	totalSamples := packed & (1<<36 - 1)
+	if sampleRate > 0 {
+		info.Duration = secondsToDuration(float64(totalSamples) / float64(sampleRate))
+	}
+	return nil
+}
+
+// probeOGG takes the codec from the first packet and the duration from the granule position of the last page
+func probeOGG(r io.ReadSeeker, size int64, info *Info) error {
+	page := make([]byte, 27+255)
+	n, err := readAt(r, page, 0)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return err
+	}
+	page = page[:n]
+	if len(page) < 27 {
+		return ErrUnsupportedFormat
+	}
+	segments := int(page[26])
+	packetStart := int64(27 + segments)
+	packet := make([]byte, 20)
+	if _, err = readAt(r, packet, packetStart); err != nil {
+		return fmt.Errorf("read first packet: %w", err)
+	}
+
+	var sampleRate, preSkip uint64
+	switch {
+	case bytes.HasPrefix(packet, []byte("\x01vorbis")):
+		info.AudioCodec = "vorbis"
+		sampleRate = uint64(binary.LittleEndian.Uint32(packet[12:16]))
+	case bytes.HasPrefix(packet, []byte("OpusHead")):
+		info.AudioCodec = "opus"
+		// opus granule position is always measured at 48 kHz
+		sampleRate = 48000
+		preSkip = uint64(binary.LittleEndian.Uint16(packet[10:12]))
+	case bytes.HasPrefix(packet, []byte("\x7fFLAC")):
+		info.AudioCodec = "flac"
+		return nil
+	default:
+		return nil
+	}
+
+	tailStart := max(size-oggTailSize, 0)
+	tail := make([]byte, size-tailStart)
+	if _, err = readAt(r, tail, tailStart); err != nil {
+		return fmt.Errorf("read last page: %w", err)
+	}
+	last := bytes.LastIndex(tail, []byte("OggS"))
+	if last < 0 || last+14 > len(tail) {
+		return nil
+	}
+	granule := binary.LittleEndian.Uint64(tail[last+6 : last+14])
+	if sampleRate > 0 && granule > preSkip && granule != ^uint64(0) {
+		info.Duration = secondsToDuration(float64(granule-preSkip) / float64(sampleRate))
+	}
+	return nil
+}
@@ -0,0 +1,352 @@
+package mediaprobe
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// Matroska element ids, see https://www.matroska.org/technical/elements.html
+const (
+	ebmlSegment        = 0x18538067
+	ebmlInfo           = 0x1549A966
+	ebmlTimecodeScale  = 0x2AD7B1
+	ebmlDuration       = 0x4489
+	ebmlTitle          = 0x7BA9
+	ebmlTracks         = 0x1654AE6B
+	ebmlTrackEntry     = 0xAE
+	ebmlTrackType      = 0x83
+	ebmlCodecId        = 0x86
+	ebmlVideo          = 0xE0
+	ebmlPixelWidth     = 0xB0
+	ebmlPixelHeight    = 0xBA
+	ebmlTags           = 0x1254C367
+	ebmlTag            = 0x7373
+	ebmlSimpleTag      = 0x67C8
+	ebmlTagName        = 0x45A3
+	ebmlTagString      = 0x4487
+	ebmlAttachments    = 0x1941A469
+	ebmlAttachedFile   = 0x61A7
+	ebmlFileName       = 0x466E
+	ebmlFileMimeType   = 0x4660
+	ebmlFileData       = 0x465C
+	ebmlUnknownSize    = -1
+	matroskaTrackVideo = 1
+	matroskaTrackAudio = 2
+)
+
+// matroskaCodecs lists codecs which names can't be derived from the codec id, e.g. A_AAC/MPEG4/LC is aac
+var matroskaCodecs = map[string]string{
+	"V_MPEG4/ISO/AVC":  "h264",
+	"V_MPEGH/ISO/HEVC": "hevc",
+	"V_MPEG4/ISO/ASP":  "mpeg4",
+	"A_MPEG/L3":        "mp3",
+	"A_PCM/INT/LIT":    "pcm",
+}
+
+type ebmlElement struct {
+	id        uint32
+	dataStart int64
+	// size is ebmlUnknownSize for live streams
+	size int64
+}
+
+func readEBMLElement(r io.ReadSeeker, offset int64) (ebmlElement, error) {
+	buf := make([]byte, 12)
+	n, err := readAt(r, buf, offset)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return ebmlElement{}, err
+	}
+	buf = buf[:n]
+	idLen := vintLength(buf)
+	if idLen == 0 || idLen > 4 || len(buf) < idLen {
+		return ebmlElement{}, fmt.Errorf("invalid element id")
+	}
+	id := uint32(readUint(buf[:idLen]))
+	sizeLen := vintLength(buf[idLen:])
+	if sizeLen == 0 || len(buf) < idLen+sizeLen {
+		return ebmlElement{}, fmt.Errorf("invalid element size")
+	}
+	sizeBytes := buf[idLen : idLen+sizeLen]
+	size := int64(sizeBytes[0] & (0xFF >> sizeLen))
+	allOnes := size == int64(0xFF>>sizeLen)
+	for _, b := range sizeBytes[1:] {
+		size = size<<8 | int64(b)
+		allOnes = allOnes && b == 0xFF
+	}
+	if allOnes {
+		size = ebmlUnknownSize
+	}
+	return ebmlElement{id: id, dataStart: offset + int64(idLen+sizeLen), size: size}, nil
+}
+
+// vintLength returns the length of the variable size integer encoded by the leading zero bits of the first byte
+func vintLength(buf []byte) int {
+	if len(buf) == 0 || buf[0] == 0 {
+		return 0
+	}
+	length := 1
+	for mask := byte(0x80); buf[0]&mask == 0; mask >>= 1 {
+		length++
+	}
+	return length
+}
+
+// walkEBML calls fn for each child element. Elements of unknown size end the walk, except the segment
+func walkEBML(r io.ReadSeeker, start, end int64, fn func(el ebmlElement) error) error {
+	for offset := start; offset < end; {
+		el, err := readEBMLElement(r, offset)
+		if err != nil {
+			return err
+		}
+		if el.size == ebmlUnknownSize {
+			if el.id != ebmlSegment {
+				return nil
+			}
+			el.size = end - el.dataStart
+		}
+		if el.dataStart+el.size > end {
+			// truncated file, use what is available
+			el.size = end - el.dataStart
+		}
+		if err = fn(el); err != nil {
+			return err
+		}
+		offset = el.dataStart + el.size
+	}
+	return nil
+}
+
+func probeMatroska(r io.ReadSeeker, size int64, info *Info) error {
+	var found bool
+	err := walkEBML(r, 0, size, func(el ebmlElement) error {
+		if el.id != ebmlSegment {
+			return nil
+		}
+		found = true
+		return walkEBML(r, el.dataStart, el.dataStart+el.size, func(el ebmlElement) error {
+			switch el.id {
+			case ebmlInfo:
+				return readMatroskaInfo(r, el, info)
+			case ebmlTracks:
+				return walkEBML(r, el.dataStart, el.dataStart+el.size, func(el ebmlElement) error {
+					if el.id != ebmlTrackEntry {
+						return nil
+					}
+					return readMatroskaTrack(r, el, info)
+				})
+			case ebmlTags:
+				return readMatroskaTags(r, el, info)
+			case ebmlAttachments:
+				return readMatroskaAttachments(r, el, info)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrUnsupportedFormat
+	}
+	return nil
+}
+
+func readMatroskaInfo(r io.ReadSeeker, el ebmlElement, info *Info) error {
+	var (
+		timecodeScale uint64 = 1000000
+		duration      float64
+	)
+	err := walkEBML(r, el.dataStart, el.dataStart+el.size, func(el ebmlElement) error {
+		switch el.id {
+		case ebmlTimecodeScale, ebmlDuration, ebmlTitle:
+		default:
+			return nil
+		}
+		data, err := readBytes(r, el.dataStart, el.size)
+		if err != nil {
+			return err
+		}
+		switch el.id {
+		case ebmlTimecodeScale:
+			timecodeScale = readUint(data)
+		case ebmlDuration:
+			switch len(data) {
+			case 4:
+				duration = float64(math.Float32frombits(binary.BigEndian.Uint32(data)))
+			case 8:
+				duration = math.Float64frombits(binary.BigEndian.Uint64(data))
+			}
+		case ebmlTitle:
+			info.Title = ebmlString(data)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// duration is measured in ticks of timecode scale nanoseconds
+	info.Duration = secondsToDuration(duration * float64(timecodeScale) / 1e9)
+	return nil
+}
+
+func readMatroskaTrack(r io.ReadSeeker, el ebmlElement, info *Info) error {
+	var (
+		trackType     uint64
+		codec         string
+		width, height int
+	)
+	err := walkEBML(r, el.dataStart, el.dataStart+el.size, func(el ebmlElement) error {
+		switch el.id {
+		case ebmlTrackType, ebmlCodecId:
+			data, err := readBytes(r, el.dataStart, el.size)
+			if err != nil {
+				return err
+			}
+			if el.id == ebmlTrackType {
+				trackType = readUint(data)
+			} else {
+				codec = matroskaCodec(ebmlString(data))
+			}
+		case ebmlVideo:
+			return walkEBML(r, el.dataStart, el.dataStart+el.size, func(el ebmlElement) error {
+				if el.id != ebmlPixelWidth && el.id != ebmlPixelHeight {
+					return nil
+				}
+				data, err := readBytes(r, el.dataStart, el.size)
+				if err != nil {
+					return err
+				}
+				if el.id == ebmlPixelWidth {
+					width = int(readUint(data))
+				} else {
+					height = int(readUint(data))
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	switch trackType {
+	case matroskaTrackVideo:
+		if info.VideoCodec == "" {
+			info.VideoCodec = codec
+			info.Width, info.Height = width, height
+		}
+	case matroskaTrackAudio:
+		if info.AudioCodec == "" {
+			info.AudioCodec = codec
+		}
+	}
+	return nil
+}
+
+func matroskaCodec(codecId string) string {
+	if codec, ok := matroskaCodecs[codecId]; ok {
+		return codec
+	}
+	_, codec, _ := strings.Cut(codecId, "_")
+	codec, _, _ = strings.Cut(codec, "/")
+	return strings.ToLower(codec)
+}
+
+func readMatroskaTags(r io.ReadSeeker, el ebmlElement, info *Info) error {
+	return walkEBML(r, el.dataStart, el.dataStart+el.size, func(el ebmlElement) error {
+		if el.id != ebmlTag {
+			return nil
+		}
+		return walkEBML(r, el.dataStart, el.dataStart+el.size, func(el ebmlElement) error {
+			if el.id != ebmlSimpleTag {
+				return nil
+			}
+			var name, value string
+			err := walkEBML(r, el.dataStart, el.dataStart+el.size, func(el ebmlElement) error {
+				if el.id != ebmlTagName && el.id != ebmlTagString {
+					return nil
+				}
+				data, err := readBytes(r, el.dataStart, el.size)
+				if err != nil {
+					return err
+				}
+				if el.id == ebmlTagName {
+					name = ebmlString(data)
+				} else {
+					value = ebmlString(data)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			switch strings.ToUpper(name) {
+			case "TITLE":
+				if info.Title == "" {
+					info.Title = value
+				}
+			case "ARTIST":
+				info.Artist = value
+			case "ALBUM":
+				info.Album = value
+			}
+			return nil
+		})
+	})
+}
+
+// readMatroskaAttachments uses the attached image as a cover. Files named "cover" are preferred
+func readMatroskaAttachments(r io.ReadSeeker, el ebmlElement, info *Info) error {
+	var preferredFound bool
+	return walkEBML(r, el.dataStart, el.dataStart+el.size, func(el ebmlElement) error {
+		if el.id != ebmlAttachedFile || preferredFound {
+			return nil
+		}
+		var (
+			name, mimeType string
+			data           ebmlElement
+		)
+		err := walkEBML(r, el.dataStart, el.dataStart+el.size, func(el ebmlElement) error {
+			switch el.id {
+			case ebmlFileName, ebmlFileMimeType:
+				value, err := readBytes(r, el.dataStart, el.size)
+				if err != nil {
+					return err
+				}
+				if el.id == ebmlFileName {
+					name = ebmlString(value)
+				} else {
+					mimeType = ebmlString(value)
+				}
+			case ebmlFileData:
+				data = el
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if !strings.HasPrefix(mimeType, "image/") || data.size <= 0 || data.size > maxCoverSize {
+			return nil
+		}
+		preferred := strings.HasPrefix(strings.ToLower(name), "cover")
+		if info.Cover != nil && !preferred {
+			return nil
+		}
+		cover, err := readBytes(r, data.dataStart, data.size)
+		if err != nil {
+			return err
+		}
+		info.Cover = cover
+		preferredFound = preferred
+		return nil
+	})
+}
+
+func ebmlString(data []byte) string {
+	return strings.TrimSpace(strings.TrimRight(string(data), "\x00"))
+}
@@ -0,0 +1,165 @@
+package mediaprobe
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxBoxDataSize limits the size of boxes with technical metadata that are read into memory
+const maxBoxDataSize = 1024 * 1024
+
+var mp4ContainerBoxes = map[string]struct{}{
+	"moov": {}, "trak": {}, "mdia": {}, "minf": {}, "stbl": {},
+}
+
+var mp4Codecs = map[string]string{
+	"avc1": "h264", "avc3": "h264",
+	"hvc1": "hevc", "hev1": "hevc",
+	"vp08": "vp8", "vp09": "vp9",
+	"av01": "av1",
+	"mp4v": "mpeg4",
+	"jpeg": "mjpeg",
+	"apcn": "prores", "apch": "prores", "apcs": "prores", "apco": "prores", "ap4h": "prores",
+	"mp4a": "aac",
+	"Opus": "opus",
+	"fLaC": "flac",
+	"ac-3": "ac3",
+	"ec-3": "eac3",
+	".mp3": "mp3",
+	"alac": "alac",
+}
+
+type mp4Track struct {
+	handler string
+	codec   string
+	width   int
+	height  int
+}
+
+// probeMP4 walks the ISO base media file boxes. Tags and cover art are read by the tag library
+func probeMP4(r io.ReadSeeker, size int64, info *Info) error {
+	var track *mp4Track
+	finishTrack := func() {
+		if track == nil {
+			return
+		}
+		switch track.handler {
+		case "vide":
+			if info.VideoCodec == "" {
+				info.VideoCodec = track.codec
+				info.Width, info.Height = track.width, track.height
+			}
+		case "soun":
+			if info.AudioCodec == "" {
+				info.AudioCodec = track.codec
+			}
+		}
+		track = nil
+	}
+
+	var walk func(start, end int64) error
+	walk = func(start, end int64) error {
+		for offset := start; offset+8 <= end; {
+			header := make([]byte, 16)
+			if _, err := readAt(r, header[:8], offset); err != nil {
+				return err
+			}
+			boxSize := int64(be32(header[:4]))
+			boxType := string(header[4:8])
+			dataStart := offset + 8
+			switch boxSize {
+			case 0:
+				boxSize = end - offset
+			case 1:
+				if _, err := readAt(r, header[8:16], offset+8); err != nil {
+					return err
+				}
+				boxSize = int64(binary.BigEndian.Uint64(header[8:16]))
+				dataStart += 8
+			}
+			if boxSize < dataStart-offset || offset+boxSize > end {
+				return fmt.Errorf("invalid size of box %q", boxType)
+			}
+			dataEnd := offset + boxSize
+
+			if _, ok := mp4ContainerBoxes[boxType]; ok {
+				if boxType == "trak" {
+					finishTrack()
+					track = &mp4Track{}
+				}
+				if err := walk(dataStart, dataEnd); err != nil {
+					return err
+				}
+				if boxType == "trak" {
+					finishTrack()
+				}
+			} else if err := readMP4Box(r, boxType, dataStart, dataEnd, info, track); err != nil {
+				return err
+			}
+			offset = dataEnd
+		}
+		return nil
+	}
+	return walk(0, size)
+}
+
+func readMP4Box(r io.ReadSeeker, boxType string, start, end int64, info *Info, track *mp4Track) error {
+	switch boxType {
+	case "mvhd":
+	case "tkhd", "hdlr", "stsd":
+		if track == nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+	if end-start > maxBoxDataSize {
+		return nil
+	}
+	data, err := readBytes(r, start, end-start)
+	if err != nil {
+		return err
+	}
+	if len(data) < 4 {
+		return nil
+	}
+	version := data[0]
+
+	switch boxType {
+	case "mvhd":
+		var timescale, duration uint64
+		if version == 1 && len(data) >= 32 {
+			timescale = uint64(be32(data[20:24]))
+			duration = binary.BigEndian.Uint64(data[24:32])
+		} else if len(data) >= 20 {
+			timescale = uint64(be32(data[12:16]))
+			duration = uint64(be32(data[16:20]))
+		}
+		if timescale > 0 {
+			info.Duration = secondsToDuration(float64(duration) / float64(timescale))
+		}
+	case "tkhd":
+		// width and height are 16.16 fixed-point numbers at the end of the box
+		if len(data) >= 84 {
+			track.width = int(be32(data[len(data)-8:]) >> 16)
+			track.height = int(be32(data[len(data)-4:]) >> 16)
+		}
+	case "hdlr":
+		if len(data) >= 12 {
+			track.handler = string(data[8:12])
+		}
+	case "stsd":
+		// the first sample entry defines the codec
+		if len(data) >= 16 {
+			fourcc := string(data[12:16])
+			codec, ok := mp4Codecs[fourcc]
+			if !ok {
+				codec = strings.TrimSpace(strings.ToLower(fourcc))
+			}
+			track.codec = codec
+		}
+	}
+	return nil
+}
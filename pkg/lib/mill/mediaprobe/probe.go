@@ -0,0 +1,160 @@
+// Package mediaprobe reads technical metadata and tags of audio and video files without decoding them.
+// Supported containers are MP4/MOV, WebM/Matroska, MP3, FLAC and OGG
+package mediaprobe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/dhowden/tag"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported media format")
+
+// maxCoverSize limits the size of embedded cover art that is read into memory
+const maxCoverSize = 10 * 1024 * 1024
+
+type Info struct {
+	Duration   time.Duration
+	Width      int
+	Height     int
+	VideoCodec string
+	AudioCodec string
+
+	Title  string
+	Artist string
+	Album  string
+	// Cover is the embedded cover art or poster image
+	Cover []byte
+}
+
+type container int
+
+const (
+	containerUnknown container = iota
+	containerMP4
+	containerMatroska
+	containerMP3
+	containerFLAC
+	containerOGG
+)
+
+func detectContainer(header []byte) container {
+	switch {
+	case len(header) >= 8 && isMP4Box(string(header[4:8])):
+		return containerMP4
+	case bytes.HasPrefix(header, []byte{0x1A, 0x45, 0xDF, 0xA3}):
+		return containerMatroska
+	case bytes.HasPrefix(header, []byte("fLaC")):
+		return containerFLAC
+	case bytes.HasPrefix(header, []byte("OggS")):
+		return containerOGG
+	case bytes.HasPrefix(header, []byte("ID3")):
+		return containerMP3
+	case len(header) >= 2 && header[0] == 0xFF && header[1]&0xE0 == 0xE0:
+		return containerMP3
+	}
+	return containerUnknown
+}
+
+func isMP4Box(name string) bool {
+	switch name {
+	case "ftyp", "moov", "mdat", "free", "wide", "skip":
+		return true
+	}
+	return false
+}
+
+// Probe detects the container format and reads duration, dimensions, codecs and tags
+func Probe(r io.ReadSeeker) (*Info, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, 12)
+	n, err := readAt(r, header, 0)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+
+	info := &Info{}
+	switch detectContainer(header[:n]) {
+	case containerMP4:
+		err = probeMP4(r, size, info)
+	case containerMatroska:
+		// tags of matroska are not supported by the tag library, so they are read along with the tracks
+		return info, probeMatroska(r, size, info)
+	case containerMP3:
+		err = probeMP3(r, size, info)
+	case containerFLAC:
+		err = probeFLAC(r, info)
+	case containerOGG:
+		err = probeOGG(r, size, info)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	readTags(r, info)
+	return info, nil
+}
+
+// readTags fills the tags using ID3, MP4, FLAC and Vorbis comments. Missing or broken tags are not an error
+func readTags(r io.ReadSeeker, info *Info) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return
+	}
+	t, err := tag.ReadFrom(r)
+	if err != nil {
+		return
+	}
+	info.Title = strings.TrimSpace(t.Title())
+	info.Artist = strings.TrimSpace(t.Artist())
+	info.Album = strings.TrimSpace(t.Album())
+	if pic := t.Picture(); pic != nil && len(pic.Data) <= maxCoverSize {
+		info.Cover = pic.Data
+	}
+}
+
+func readAt(r io.ReadSeeker, buf []byte, offset int64) (int, error) {
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return io.ReadFull(r, buf)
+}
+
+func readBytes(r io.ReadSeeker, offset, size int64) ([]byte, error) {
+	if size < 0 || size > maxCoverSize {
+		return nil, fmt.Errorf("invalid element size %d", size)
+	}
+	buf := make([]byte, size)
+	if _, err := readAt(r, buf, offset); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+func readUint(data []byte) uint64 {
+	var v uint64
+	for _, b := range data {
+		v = v<<8 | uint64(b)
+	}
+	return v
+}
+
+func be32(data []byte) uint32 {
+	return binary.BigEndian.Uint32(data)
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	if seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
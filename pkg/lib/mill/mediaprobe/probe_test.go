@@ -0,0 +1,237 @@
+package mediaprobe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mp4Box(boxType string, children ...[]byte) []byte {
+	data := bytes.Join(children, nil)
+	box := binary.BigEndian.AppendUint32(nil, uint32(8+len(data)))
+	box = append(box, boxType...)
+	return append(box, data...)
+}
+
+func mp4TrackBox(handler, codec string, width, height uint32) []byte {
+	tkhd := make([]byte, 84)
+	binary.BigEndian.PutUint32(tkhd[76:], width<<16)
+	binary.BigEndian.PutUint32(tkhd[80:], height<<16)
+	hdlr := append(make([]byte, 8), handler...)
+	stsd := binary.BigEndian.AppendUint32(make([]byte, 4), 1)
+	stsd = append(stsd, mp4Box(codec, make([]byte, 16))...)
+	return mp4Box("trak",
+		mp4Box("tkhd", tkhd),
+		mp4Box("mdia", mp4Box("hdlr", hdlr, make([]byte, 12)), mp4Box("minf", mp4Box("stbl", mp4Box("stsd", stsd)))),
+	)
+}
+
+func ebml(id uint32, children ...[]byte) []byte {
+	data := bytes.Join(children, nil)
+	var res []byte
+	switch {
+	case id > 0xFFFFFF:
+		res = binary.BigEndian.AppendUint32(nil, id)
+	case id > 0xFFFF:
+		res = []byte{byte(id >> 16), byte(id >> 8), byte(id)}
+	case id > 0xFF:
+		res = []byte{byte(id >> 8), byte(id)}
+	default:
+		res = []byte{byte(id)}
+	}
+	// 8-byte size
+	res = append(res, 0x01)
+	res = append(res, binary.BigEndian.AppendUint64(nil, uint64(len(data)))[1:]...)
+	return append(res, data...)
+}
+
+func ebmlUint(id uint32, v uint64) []byte {
+	return ebml(id, binary.BigEndian.AppendUint64(nil, v))
+}
+
+func TestProbe_MP4(t *testing.T) {
+	// given
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:], 1000)
+	binary.BigEndian.PutUint32(mvhd[16:], 95500)
+	data := bytes.Join([][]byte{
+		mp4Box("ftyp", []byte("isom\x00\x00\x02\x00")),
+		mp4Box("mdat", make([]byte, 1024)),
+		mp4Box("moov",
+			mp4Box("mvhd", mvhd),
+			mp4TrackBox("soun", "mp4a", 0, 0),
+			mp4TrackBox("vide", "avc1", 1920, 1080),
+		),
+	}, nil)
+
+	// when
+	info, err := Probe(bytes.NewReader(data))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 95500*time.Millisecond, info.Duration)
+	assert.Equal(t, "h264", info.VideoCodec)
+	assert.Equal(t, "aac", info.AudioCodec)
+	assert.Equal(t, 1920, info.Width)
+	assert.Equal(t, 1080, info.Height)
+}
+
+func TestProbe_Matroska(t *testing.T) {
+	// given
+	cover := []byte("\x89PNG cover")
+	data := bytes.Join([][]byte{
+		ebml(0x1A45DFA3, ebml(0x4282, []byte("webm"))),
+		ebml(ebmlSegment,
+			ebml(ebmlInfo,
+				ebmlUint(ebmlTimecodeScale, 1000000),
+				ebml(ebmlDuration, binary.BigEndian.AppendUint64(nil, math.Float64bits(12345))),
+			),
+			ebml(ebmlTracks,
+				ebml(ebmlTrackEntry,
+					ebmlUint(ebmlTrackType, matroskaTrackVideo),
+					ebml(ebmlCodecId, []byte("V_VP9")),
+					ebml(ebmlVideo, ebmlUint(ebmlPixelWidth, 640), ebmlUint(ebmlPixelHeight, 360)),
+				),
+				ebml(ebmlTrackEntry,
+					ebmlUint(ebmlTrackType, matroskaTrackAudio),
+					ebml(ebmlCodecId, []byte("A_AAC/MPEG4/LC")),
+				),
+			),
+			ebml(0x1F43B675, make([]byte, 512)),
+			ebml(ebmlTags, ebml(ebmlTag,
+				ebml(ebmlSimpleTag, ebml(ebmlTagName, []byte("ARTIST")), ebml(ebmlTagString, []byte("The Band"))),
+				ebml(ebmlSimpleTag, ebml(ebmlTagName, []byte("ALBUM")), ebml(ebmlTagString, []byte("Live"))),
+			)),
+			ebml(ebmlAttachments,
+				ebml(ebmlAttachedFile,
+					ebml(ebmlFileName, []byte("font.ttf")),
+					ebml(ebmlFileMimeType, []byte("font/ttf")),
+					ebml(ebmlFileData, []byte("font")),
+				),
+				ebml(ebmlAttachedFile,
+					ebml(ebmlFileName, []byte("cover.png")),
+					ebml(ebmlFileMimeType, []byte("image/png")),
+					ebml(ebmlFileData, cover),
+				),
+			),
+		),
+	}, nil)
+
+	// when
+	info, err := Probe(bytes.NewReader(data))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 12345*time.Millisecond, info.Duration)
+	assert.Equal(t, "vp9", info.VideoCodec)
+	assert.Equal(t, "aac", info.AudioCodec)
+	assert.Equal(t, 640, info.Width)
+	assert.Equal(t, 360, info.Height)
+	assert.Equal(t, "The Band", info.Artist)
+	assert.Equal(t, "Live", info.Album)
+	assert.Equal(t, cover, info.Cover)
+}
+
+func id3Frame(id string, data []byte) []byte {
+	frame := append([]byte(id), binary.BigEndian.AppendUint32(nil, uint32(len(data)))...)
+	frame = append(frame, 0, 0)
+	return append(frame, data...)
+}
+
+func TestProbe_MP3(t *testing.T) {
+	// MPEG1 layer III, 128 kbps, 44.1 kHz, stereo
+	frameHeader := []byte{0xFF, 0xFB, 0x90, 0x00}
+
+	t.Run("constant bitrate with tags", func(t *testing.T) {
+		// given
+		frames := bytes.Repeat(append(frameHeader, make([]byte, 413)...), 100)
+		tags := bytes.Join([][]byte{
+			id3Frame("TIT2", []byte("\x00Song")),
+			id3Frame("TPE1", []byte("\x00Singer")),
+			id3Frame("TALB", []byte("\x00Debut")),
+		}, nil)
+		size := len(tags)
+		id3 := []byte{'I', 'D', '3', 3, 0, 0, byte(size >> 21 & 0x7F), byte(size >> 14 & 0x7F), byte(size >> 7 & 0x7F), byte(size & 0x7F)}
+		data := bytes.Join([][]byte{id3, tags, frames}, nil)
+
+		// when
+		info, err := Probe(bytes.NewReader(data))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "mp3", info.AudioCodec)
+		assert.Equal(t, 2606, int(info.Duration.Milliseconds()))
+		assert.Equal(t, "Song", info.Title)
+		assert.Equal(t, "Singer", info.Artist)
+		assert.Equal(t, "Debut", info.Album)
+	})
+
+	t.Run("variable bitrate with Xing header", func(t *testing.T) {
+		// given
+		first := append(frameHeader, make([]byte, 32)...)
+		first = append(first, "Xing"...)
+		first = binary.BigEndian.AppendUint32(first, 0x1)
+		first = binary.BigEndian.AppendUint32(first, 3445)
+		data := append(first, make([]byte, 1000)...)
+
+		// when
+		info, err := Probe(bytes.NewReader(data))
+
+		// then
+		require.NoError(t, err)
+		assert.InDelta(t, 90, info.Duration.Seconds(), 0.01)
+	})
+}
+
+func TestProbe_FLAC(t *testing.T) {
+	// given
+	streamInfo := make([]byte, 34)
+	// 48 kHz, 2 channels, 16 bits per sample, 480000 samples
+	packed := uint64(48000)<<44 | uint64(1)<<41 | uint64(15)<<36 | 480000
+	binary.BigEndian.PutUint64(streamInfo[10:], packed)
+	data := append([]byte("fLaC"), 0x80, 0, 0, 34)
+	data = append(data, streamInfo...)
+
+	// when
+	info, err := Probe(bytes.NewReader(data))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "flac", info.AudioCodec)
+	assert.Equal(t, 10*time.Second, info.Duration)
+}
+
+func oggPage(granule uint64, packet []byte) []byte {
+	page := []byte("OggS\x00\x00")
+	page = binary.LittleEndian.AppendUint64(page, granule)
+	page = append(page, make([]byte, 12)...)
+	page = append(page, 1, byte(len(packet)))
+	return append(page, packet...)
+}
+
+func TestProbe_OGG(t *testing.T) {
+	// given
+	head := append([]byte("OpusHead\x01\x02"), 0x38, 0x01)
+	head = append(head, make([]byte, 8)...)
+	data := append(oggPage(0, head), oggPage(312, []byte("OpusTags"))...)
+	data = append(data, oggPage(48000*3+312, make([]byte, 100))...)
+
+	// when
+	info, err := Probe(bytes.NewReader(data))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "opus", info.AudioCodec)
+	assert.Equal(t, 3*time.Second, info.Duration)
+}
+
+func TestProbe_Unsupported(t *testing.T) {
+	_, err := Probe(strings.NewReader("plain text file"))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
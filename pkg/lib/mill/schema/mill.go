@@ -31,6 +31,22 @@ func GetMill(id string, opts map[string]string) (mill.Mill, error) {
 		}, nil
 	case "/image/exif":
 		return &mill.ImageExif{}, nil
+	case "/video/thumbnail":
+		// defaults are the same as in the video schema
+		width := opts["width"]
+		if width == "" {
+			width = "1280"
+		}
+		quality := opts["quality"]
+		if quality == "" {
+			quality = "85"
+		}
+		return &mill.VideoThumbnail{
+			Opts: mill.ImageResizeOpts{
+				Width:   width,
+				Quality: quality,
+			},
+		}, nil
 
 	default:
 		return nil, nil
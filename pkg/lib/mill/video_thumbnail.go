@@ -0,0 +1,104 @@
+package mill
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"strconv"
+
+	"github.com/kovidgoyal/imaging"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/mill/mediaprobe"
+)
+
+// ErrNoPoster is returned when the media file has no embedded image to use as a poster
+var ErrNoPoster = errors.New("media file has no poster")
+
+const VideoThumbnailId = "/video/thumbnail"
+
+// maxPosterPixels limits the size of embedded cover art, so a crafted file can't make us allocate a huge image
+const maxPosterPixels = 40_000_000
+
+// VideoThumbnail makes a poster from the cover art embedded into video and audio containers
+// (tag pictures of MP4 and audio files, image attachments of Matroska/WebM).
+// Decoding of video frames requires codecs that are not available in pure Go, so it is not supported:
+// files without embedded cover art get no poster and ErrNoPoster is returned
+type VideoThumbnail struct {
+	Opts ImageResizeOpts
+}
+
+func (m *VideoThumbnail) ID() string {
+	return VideoThumbnailId
+}
+
+func (m *VideoThumbnail) Pin() bool {
+	return false
+}
+
+func (m *VideoThumbnail) AcceptMedia(media string) error {
+	return accepts([]string{
+		"video/mp4",
+		"video/quicktime",
+		"video/webm",
+		"video/x-matroska",
+		"audio/mpeg",
+		"audio/mp4",
+		"audio/x-m4a",
+		"audio/flac",
+		"audio/x-flac",
+		"audio/ogg",
+		"audio/webm",
+	}, media)
+}
+
+func (m *VideoThumbnail) Options(add map[string]interface{}) (string, error) {
+	return hashOpts(m.Opts, add)
+}
+
+func (m *VideoThumbnail) Mill(r io.ReadSeeker, name string) (*Result, error) {
+	width, err := strconv.Atoi(m.Opts.Width)
+	if err != nil {
+		return nil, fmt.Errorf("invalid width: %s", m.Opts.Width)
+	}
+	quality, err := strconv.Atoi(m.Opts.Quality)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quality: %s", m.Opts.Quality)
+	}
+
+	info, err := mediaprobe.Probe(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(info.Cover) == 0 {
+		return nil, ErrNoPoster
+	}
+	conf, _, err := image.DecodeConfig(bytes.NewReader(info.Cover))
+	if err != nil {
+		return nil, fmt.Errorf("decode poster config: %w", err)
+	}
+	if conf.Width <= 0 || conf.Height <= 0 || conf.Width*conf.Height > maxPosterPixels {
+		return nil, fmt.Errorf("poster dimensions %dx%d are not supported", conf.Width, conf.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(info.Cover))
+	if err != nil {
+		return nil, fmt.Errorf("decode poster: %w", err)
+	}
+	if width > 0 && img.Bounds().Dx() > width {
+		img = imaging.Resize(img, width, 0, imaging.Lanczos)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err = jpeg.Encode(buf, img, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, err
+	}
+	return &Result{
+		File: noopCloser(bytes.NewReader(buf.Bytes())),
+		Meta: map[string]interface{}{
+			"width":  img.Bounds().Dx(),
+			"height": img.Bounds().Dy(),
+		},
+	}, nil
+}
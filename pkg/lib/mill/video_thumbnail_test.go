@@ -0,0 +1,95 @@
+package mill
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func flacBlock(blockType byte, last bool, data []byte) []byte {
+	if last {
+		blockType |= 0x80
+	}
+	return append([]byte{blockType, byte(len(data) >> 16), byte(len(data) >> 8), byte(len(data))}, data...)
+}
+
+func flacWithCover(t *testing.T, cover []byte) []byte {
+	streamInfo := make([]byte, 34)
+	binary.BigEndian.PutUint64(streamInfo[10:], uint64(44100)<<44|uint64(1)<<41|uint64(15)<<36|441000)
+
+	var picture []byte
+	// front cover picture type
+	picture = binary.BigEndian.AppendUint32(picture, 3)
+	picture = binary.BigEndian.AppendUint32(picture, uint32(len("image/png")))
+	picture = append(picture, "image/png"...)
+	picture = binary.BigEndian.AppendUint32(picture, 0)
+	picture = append(picture, make([]byte, 16)...)
+	picture = binary.BigEndian.AppendUint32(picture, uint32(len(cover)))
+	picture = append(picture, cover...)
+
+	data := []byte("fLaC")
+	data = append(data, flacBlock(0, false, streamInfo)...)
+	data = append(data, flacBlock(6, true, picture)...)
+	return data
+}
+
+func TestVideoThumbnail_Mill(t *testing.T) {
+	m := &VideoThumbnail{Opts: ImageResizeOpts{Width: "100", Quality: "85"}}
+
+	t.Run("resize embedded cover", func(t *testing.T) {
+		// given
+		img := image.NewRGBA(image.Rect(0, 0, 400, 200))
+		for x := 0; x < 400; x++ {
+			img.Set(x, x%200, color.RGBA{R: 255, A: 255})
+		}
+		cover := bytes.NewBuffer(nil)
+		require.NoError(t, png.Encode(cover, img))
+
+		// when
+		res, err := m.Mill(bytes.NewReader(flacWithCover(t, cover.Bytes())), "song.flac")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 100, res.Meta["width"])
+		assert.Equal(t, 50, res.Meta["height"])
+		conf, err := jpeg.DecodeConfig(res.File)
+		require.NoError(t, err)
+		assert.Equal(t, 100, conf.Width)
+	})
+
+	t.Run("no cover", func(t *testing.T) {
+		data := flacWithCover(t, nil)[:4+4+34]
+		data[4] |= 0x80
+
+		_, err := m.Mill(bytes.NewReader(data), "song.flac")
+
+		assert.ErrorIs(t, err, ErrNoPoster)
+	})
+	t.Run("cover is too big", func(t *testing.T) {
+		// given
+		ihdr := []byte("IHDR")
+		ihdr = binary.BigEndian.AppendUint32(ihdr, 100000)
+		ihdr = binary.BigEndian.AppendUint32(ihdr, 100000)
+		// bit depth, color type, compression, filter, interlace
+		ihdr = append(ihdr, 8, 6, 0, 0, 0)
+		cover := []byte("\x89PNG\r\n\x1a\n")
+		cover = binary.BigEndian.AppendUint32(cover, uint32(len(ihdr)-4))
+		cover = append(cover, ihdr...)
+		cover = binary.BigEndian.AppendUint32(cover, crc32.ChecksumIEEE(ihdr))
+
+		// when
+		_, err := m.Mill(bytes.NewReader(flacWithCover(t, cover)), "song.flac")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "dimensions")
+	})
+}
@@ -4,6 +4,7 @@
 package model
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
@@ -1190,6 +1191,7 @@ const (
 	BlockContentDataviewView_Kanban   BlockContentDataviewViewType = 3
 	BlockContentDataviewView_Calendar BlockContentDataviewViewType = 4
 	BlockContentDataviewView_Graph    BlockContentDataviewViewType = 5
+	BlockContentDataviewView_Timeline BlockContentDataviewViewType = 6
 )
 
 var BlockContentDataviewViewType_name = map[int32]string{
@@ -1199,6 +1201,7 @@ var BlockContentDataviewViewType_name = map[int32]string{
 	3: "Kanban",
 	4: "Calendar",
 	5: "Graph",
+	6: "Timeline",
 }
 
 var BlockContentDataviewViewType_value = map[string]int32{
@@ -1208,6 +1211,7 @@ var BlockContentDataviewViewType_value = map[string]int32{
 	"Kanban":   3,
 	"Calendar": 4,
 	"Graph":    5,
+	"Timeline": 6,
 }
 
 func (x BlockContentDataviewViewType) String() string {
@@ -1453,24 +1457,27 @@ func (BlockContentDataviewFilterOperator) EnumDescriptor() ([]byte, []int) {
 type BlockContentDataviewFilterCondition int32
 
 const (
-	BlockContentDataviewFilter_None           BlockContentDataviewFilterCondition = 0
-	BlockContentDataviewFilter_Equal          BlockContentDataviewFilterCondition = 1
-	BlockContentDataviewFilter_NotEqual       BlockContentDataviewFilterCondition = 2
-	BlockContentDataviewFilter_Greater        BlockContentDataviewFilterCondition = 3
-	BlockContentDataviewFilter_Less           BlockContentDataviewFilterCondition = 4
-	BlockContentDataviewFilter_GreaterOrEqual BlockContentDataviewFilterCondition = 5
-	BlockContentDataviewFilter_LessOrEqual    BlockContentDataviewFilterCondition = 6
-	BlockContentDataviewFilter_Like           BlockContentDataviewFilterCondition = 7
-	BlockContentDataviewFilter_NotLike        BlockContentDataviewFilterCondition = 8
-	BlockContentDataviewFilter_In             BlockContentDataviewFilterCondition = 9
-	BlockContentDataviewFilter_NotIn          BlockContentDataviewFilterCondition = 10
-	BlockContentDataviewFilter_Empty          BlockContentDataviewFilterCondition = 11
-	BlockContentDataviewFilter_NotEmpty       BlockContentDataviewFilterCondition = 12
-	BlockContentDataviewFilter_AllIn          BlockContentDataviewFilterCondition = 13
-	BlockContentDataviewFilter_NotAllIn       BlockContentDataviewFilterCondition = 14
-	BlockContentDataviewFilter_ExactIn        BlockContentDataviewFilterCondition = 15
-	BlockContentDataviewFilter_NotExactIn     BlockContentDataviewFilterCondition = 16
-	BlockContentDataviewFilter_Exists         BlockContentDataviewFilterCondition = 17
+	BlockContentDataviewFilter_None            BlockContentDataviewFilterCondition = 0
+	BlockContentDataviewFilter_Equal           BlockContentDataviewFilterCondition = 1
+	BlockContentDataviewFilter_NotEqual        BlockContentDataviewFilterCondition = 2
+	BlockContentDataviewFilter_Greater         BlockContentDataviewFilterCondition = 3
+	BlockContentDataviewFilter_Less            BlockContentDataviewFilterCondition = 4
+	BlockContentDataviewFilter_GreaterOrEqual  BlockContentDataviewFilterCondition = 5
+	BlockContentDataviewFilter_LessOrEqual     BlockContentDataviewFilterCondition = 6
+	BlockContentDataviewFilter_Like            BlockContentDataviewFilterCondition = 7
+	BlockContentDataviewFilter_NotLike         BlockContentDataviewFilterCondition = 8
+	BlockContentDataviewFilter_In              BlockContentDataviewFilterCondition = 9
+	BlockContentDataviewFilter_NotIn           BlockContentDataviewFilterCondition = 10
+	BlockContentDataviewFilter_Empty           BlockContentDataviewFilterCondition = 11
+	BlockContentDataviewFilter_NotEmpty        BlockContentDataviewFilterCondition = 12
+	BlockContentDataviewFilter_AllIn           BlockContentDataviewFilterCondition = 13
+	BlockContentDataviewFilter_NotAllIn        BlockContentDataviewFilterCondition = 14
+	BlockContentDataviewFilter_ExactIn         BlockContentDataviewFilterCondition = 15
+	BlockContentDataviewFilter_NotExactIn      BlockContentDataviewFilterCondition = 16
+	BlockContentDataviewFilter_Exists          BlockContentDataviewFilterCondition = 17
+	BlockContentDataviewFilter_Regex           BlockContentDataviewFilterCondition = 18
+	BlockContentDataviewFilter_NotRegex        BlockContentDataviewFilterCondition = 19
+	BlockContentDataviewFilter_MatchesFullText BlockContentDataviewFilterCondition = 20
 )
 
 var BlockContentDataviewFilterCondition_name = map[int32]string{
@@ -1492,27 +1499,33 @@ var BlockContentDataviewFilterCondition_name = map[int32]string{
 	15: "ExactIn",
 	16: "NotExactIn",
 	17: "Exists",
+	18: "Regex",
+	19: "NotRegex",
+	20: "MatchesFullText",
 }
 
 var BlockContentDataviewFilterCondition_value = map[string]int32{
-	"None":           0,
-	"Equal":          1,
-	"NotEqual":       2,
-	"Greater":        3,
-	"Less":           4,
-	"GreaterOrEqual": 5,
-	"LessOrEqual":    6,
-	"Like":           7,
-	"NotLike":        8,
-	"In":             9,
-	"NotIn":          10,
-	"Empty":          11,
-	"NotEmpty":       12,
-	"AllIn":          13,
-	"NotAllIn":       14,
-	"ExactIn":        15,
-	"NotExactIn":     16,
-	"Exists":         17,
+	"None":            0,
+	"Equal":           1,
+	"NotEqual":        2,
+	"Greater":         3,
+	"Less":            4,
+	"GreaterOrEqual":  5,
+	"LessOrEqual":     6,
+	"Like":            7,
+	"NotLike":         8,
+	"In":              9,
+	"NotIn":           10,
+	"Empty":           11,
+	"NotEmpty":        12,
+	"AllIn":           13,
+	"NotAllIn":        14,
+	"ExactIn":         15,
+	"NotExactIn":      16,
+	"Exists":          17,
+	"Regex":           18,
+	"NotRegex":        19,
+	"MatchesFullText": 20,
 }
 
 func (x BlockContentDataviewFilterCondition) String() string {
@@ -1526,21 +1539,26 @@ func (BlockContentDataviewFilterCondition) EnumDescriptor() ([]byte, []int) {
 type BlockContentDataviewFilterQuickOption int32
 
 const (
-	BlockContentDataviewFilter_ExactDate       BlockContentDataviewFilterQuickOption = 0
-	BlockContentDataviewFilter_Yesterday       BlockContentDataviewFilterQuickOption = 1
-	BlockContentDataviewFilter_Today           BlockContentDataviewFilterQuickOption = 2
-	BlockContentDataviewFilter_Tomorrow        BlockContentDataviewFilterQuickOption = 3
-	BlockContentDataviewFilter_LastWeek        BlockContentDataviewFilterQuickOption = 4
-	BlockContentDataviewFilter_CurrentWeek     BlockContentDataviewFilterQuickOption = 5
-	BlockContentDataviewFilter_NextWeek        BlockContentDataviewFilterQuickOption = 6
-	BlockContentDataviewFilter_LastMonth       BlockContentDataviewFilterQuickOption = 7
-	BlockContentDataviewFilter_CurrentMonth    BlockContentDataviewFilterQuickOption = 8
-	BlockContentDataviewFilter_NextMonth       BlockContentDataviewFilterQuickOption = 9
-	BlockContentDataviewFilter_NumberOfDaysAgo BlockContentDataviewFilterQuickOption = 10
-	BlockContentDataviewFilter_NumberOfDaysNow BlockContentDataviewFilterQuickOption = 11
-	BlockContentDataviewFilter_LastYear        BlockContentDataviewFilterQuickOption = 12
-	BlockContentDataviewFilter_CurrentYear     BlockContentDataviewFilterQuickOption = 13
-	BlockContentDataviewFilter_NextYear        BlockContentDataviewFilterQuickOption = 14
+	BlockContentDataviewFilter_ExactDate        BlockContentDataviewFilterQuickOption = 0
+	BlockContentDataviewFilter_Yesterday        BlockContentDataviewFilterQuickOption = 1
+	BlockContentDataviewFilter_Today            BlockContentDataviewFilterQuickOption = 2
+	BlockContentDataviewFilter_Tomorrow         BlockContentDataviewFilterQuickOption = 3
+	BlockContentDataviewFilter_LastWeek         BlockContentDataviewFilterQuickOption = 4
+	BlockContentDataviewFilter_CurrentWeek      BlockContentDataviewFilterQuickOption = 5
+	BlockContentDataviewFilter_NextWeek         BlockContentDataviewFilterQuickOption = 6
+	BlockContentDataviewFilter_LastMonth        BlockContentDataviewFilterQuickOption = 7
+	BlockContentDataviewFilter_CurrentMonth     BlockContentDataviewFilterQuickOption = 8
+	BlockContentDataviewFilter_NextMonth        BlockContentDataviewFilterQuickOption = 9
+	BlockContentDataviewFilter_NumberOfDaysAgo  BlockContentDataviewFilterQuickOption = 10
+	BlockContentDataviewFilter_NumberOfDaysNow  BlockContentDataviewFilterQuickOption = 11
+	BlockContentDataviewFilter_LastYear         BlockContentDataviewFilterQuickOption = 12
+	BlockContentDataviewFilter_CurrentYear      BlockContentDataviewFilterQuickOption = 13
+	BlockContentDataviewFilter_NextYear         BlockContentDataviewFilterQuickOption = 14
+	BlockContentDataviewFilter_LastNumberOfDays BlockContentDataviewFilterQuickOption = 15
+	BlockContentDataviewFilter_NextNumberOfDays BlockContentDataviewFilterQuickOption = 16
+	BlockContentDataviewFilter_LastQuarter      BlockContentDataviewFilterQuickOption = 17
+	BlockContentDataviewFilter_CurrentQuarter   BlockContentDataviewFilterQuickOption = 18
+	BlockContentDataviewFilter_NextQuarter      BlockContentDataviewFilterQuickOption = 19
 )
 
 var BlockContentDataviewFilterQuickOption_name = map[int32]string{
@@ -1559,24 +1577,34 @@ var BlockContentDataviewFilterQuickOption_name = map[int32]string{
 	12: "LastYear",
 	13: "CurrentYear",
 	14: "NextYear",
+	15: "LastNumberOfDays",
+	16: "NextNumberOfDays",
+	17: "LastQuarter",
+	18: "CurrentQuarter",
+	19: "NextQuarter",
 }
 
 var BlockContentDataviewFilterQuickOption_value = map[string]int32{
-	"ExactDate":       0,
-	"Yesterday":       1,
-	"Today":           2,
-	"Tomorrow":        3,
-	"LastWeek":        4,
-	"CurrentWeek":     5,
-	"NextWeek":        6,
-	"LastMonth":       7,
-	"CurrentMonth":    8,
-	"NextMonth":       9,
-	"NumberOfDaysAgo": 10,
-	"NumberOfDaysNow": 11,
-	"LastYear":        12,
-	"CurrentYear":     13,
-	"NextYear":        14,
+	"ExactDate":        0,
+	"Yesterday":        1,
+	"Today":            2,
+	"Tomorrow":         3,
+	"LastWeek":         4,
+	"CurrentWeek":      5,
+	"NextWeek":         6,
+	"LastMonth":        7,
+	"CurrentMonth":     8,
+	"NextMonth":        9,
+	"NumberOfDaysAgo":  10,
+	"NumberOfDaysNow":  11,
+	"LastYear":         12,
+	"CurrentYear":      13,
+	"NextYear":         14,
+	"LastNumberOfDays": 15,
+	"NextNumberOfDays": 16,
+	"LastQuarter":      17,
+	"CurrentQuarter":   18,
+	"NextQuarter":      19,
 }
 
 func (x BlockContentDataviewFilterQuickOption) String() string {
@@ -1587,6 +1615,34 @@ func (BlockContentDataviewFilterQuickOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_98a910b73321e591, []int{2, 1, 9, 3, 2}
 }
 
+type BlockContentDataviewDateBucket int32
+
+const (
+	BlockContentDataviewDate_Day   BlockContentDataviewDateBucket = 0
+	BlockContentDataviewDate_Week  BlockContentDataviewDateBucket = 1
+	BlockContentDataviewDate_Month BlockContentDataviewDateBucket = 2
+)
+
+var BlockContentDataviewDateBucket_name = map[int32]string{
+	0: "Day",
+	1: "Week",
+	2: "Month",
+}
+
+var BlockContentDataviewDateBucket_value = map[string]int32{
+	"Day":   0,
+	"Week":  1,
+	"Month": 2,
+}
+
+func (x BlockContentDataviewDateBucket) String() string {
+	return proto.EnumName(BlockContentDataviewDateBucket_name, int32(x))
+}
+
+func (BlockContentDataviewDateBucket) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{2, 1, 9, 11, 0}
+}
+
 type BlockContentLatexProcessor int32
 
 const (
@@ -2177,6 +2233,65 @@ func (NotificationExportCode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_98a910b73321e591, []int{21, 1, 0}
 }
 
+type NotificationDigestGrouping int32
+
+const (
+	NotificationDigest_None   NotificationDigestGrouping = 0
+	NotificationDigest_Object NotificationDigestGrouping = 1
+	NotificationDigest_Space  NotificationDigestGrouping = 2
+)
+
+var NotificationDigestGrouping_name = map[int32]string{
+	0: "None",
+	1: "Object",
+	2: "Space",
+}
+
+var NotificationDigestGrouping_value = map[string]int32{
+	"None":   0,
+	"Object": 1,
+	"Space":  2,
+}
+
+func (x NotificationDigestGrouping) String() string {
+	return proto.EnumName(NotificationDigestGrouping_name, int32(x))
+}
+
+func (NotificationDigestGrouping) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{22, 0}
+}
+
+type NotificationRuleTrigger int32
+
+const (
+	// value of the relation is changed
+	NotificationRule_RelationChanged NotificationRuleTrigger = 0
+	// the current user is added to the value of the object relation
+	NotificationRule_Assigned NotificationRuleTrigger = 1
+	// the current user is mentioned in the object
+	NotificationRule_Mentioned NotificationRuleTrigger = 2
+)
+
+var NotificationRuleTrigger_name = map[int32]string{
+	0: "RelationChanged",
+	1: "Assigned",
+	2: "Mentioned",
+}
+
+var NotificationRuleTrigger_value = map[string]int32{
+	"RelationChanged": 0,
+	"Assigned":        1,
+	"Mentioned":       2,
+}
+
+func (x NotificationRuleTrigger) String() string {
+	return proto.EnumName(NotificationRuleTrigger_name, int32(x))
+}
+
+func (NotificationRuleTrigger) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{24, 0}
+}
+
 type ExportFormat int32
 
 const (
@@ -2186,6 +2301,10 @@ const (
 	Export_DOT        ExportFormat = 3
 	Export_SVG        ExportFormat = 4
 	Export_GRAPH_JSON ExportFormat = 5
+	Export_HTML       ExportFormat = 6
+	Export_ICS        ExportFormat = 7
+	Export_CSV        ExportFormat = 8
+	Export_XLSX       ExportFormat = 9
 )
 
 var ExportFormat_name = map[int32]string{
@@ -2195,6 +2314,10 @@ var ExportFormat_name = map[int32]string{
 	3: "DOT",
 	4: "SVG",
 	5: "GRAPH_JSON",
+	6: "HTML",
+	7: "ICS",
+	8: "CSV",
+	9: "XLSX",
 }
 
 var ExportFormat_value = map[string]int32{
@@ -2204,6 +2327,10 @@ var ExportFormat_value = map[string]int32{
 	"DOT":        3,
 	"SVG":        4,
 	"GRAPH_JSON": 5,
+	"HTML":       6,
+	"ICS":        7,
+	"CSV":        8,
+	"XLSX":       9,
 }
 
 func (x ExportFormat) String() string {
@@ -2211,7 +2338,7 @@ func (x ExportFormat) String() string {
 }
 
 func (ExportFormat) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{22, 0}
+	return fileDescriptor_98a910b73321e591, []int{25, 0}
 }
 
 type ImportType int32
@@ -2225,17 +2352,25 @@ const (
 	Import_Txt      ImportType = 5
 	Import_Csv      ImportType = 6
 	Import_Obsidian ImportType = 7
+	Import_Enex     ImportType = 8
+	Import_Logseq   ImportType = 9
+	Import_Roam     ImportType = 10
+	Import_Ics      ImportType = 11
 )
 
 var ImportType_name = map[int32]string{
-	0: "Notion",
-	1: "Markdown",
-	2: "External",
-	3: "Pb",
-	4: "Html",
-	5: "Txt",
-	6: "Csv",
-	7: "Obsidian",
+	0:  "Notion",
+	1:  "Markdown",
+	2:  "External",
+	3:  "Pb",
+	4:  "Html",
+	5:  "Txt",
+	6:  "Csv",
+	7:  "Obsidian",
+	8:  "Enex",
+	9:  "Logseq",
+	10: "Roam",
+	11: "Ics",
 }
 
 var ImportType_value = map[string]int32{
@@ -2247,6 +2382,10 @@ var ImportType_value = map[string]int32{
 	"Txt":      5,
 	"Csv":      6,
 	"Obsidian": 7,
+	"Enex":     8,
+	"Logseq":   9,
+	"Roam":     10,
+	"Ics":      11,
 }
 
 func (x ImportType) String() string {
@@ -2254,7 +2393,7 @@ func (x ImportType) String() string {
 }
 
 func (ImportType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{23, 0}
+	return fileDescriptor_98a910b73321e591, []int{26, 0}
 }
 
 type ImportErrorCode int32
@@ -2318,7 +2457,7 @@ func (x ImportErrorCode) String() string {
 }
 
 func (ImportErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{23, 1}
+	return fileDescriptor_98a910b73321e591, []int{26, 1}
 }
 
 type MembershipStatus int32
@@ -2359,7 +2498,7 @@ func (x MembershipStatus) String() string {
 }
 
 func (MembershipStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{30, 0}
+	return fileDescriptor_98a910b73321e591, []int{33, 0}
 }
 
 type MembershipPaymentMethod int32
@@ -2393,7 +2532,7 @@ func (x MembershipPaymentMethod) String() string {
 }
 
 func (MembershipPaymentMethod) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{30, 1}
+	return fileDescriptor_98a910b73321e591, []int{33, 1}
 }
 
 type MembershipEmailVerificationStatus int32
@@ -2426,7 +2565,7 @@ func (x MembershipEmailVerificationStatus) String() string {
 }
 
 func (MembershipEmailVerificationStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{30, 2}
+	return fileDescriptor_98a910b73321e591, []int{33, 2}
 }
 
 type MembershipTierDataPeriodType int32
@@ -2463,7 +2602,7 @@ func (x MembershipTierDataPeriodType) String() string {
 }
 
 func (MembershipTierDataPeriodType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{31, 0}
+	return fileDescriptor_98a910b73321e591, []int{34, 0}
 }
 
 type ChatMessageAttachmentAttachmentType int32
@@ -2491,7 +2630,7 @@ func (x ChatMessageAttachmentAttachmentType) String() string {
 }
 
 func (ChatMessageAttachmentAttachmentType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{35, 1, 0}
+	return fileDescriptor_98a910b73321e591, []int{38, 1, 0}
 }
 
 type SmartBlockSnapshotBase struct {
@@ -2722,6 +2861,7 @@ type SearchMeta struct {
 	BlockId         string        `protobuf:"bytes,3,opt,name=blockId,proto3" json:"blockId,omitempty"`
 	RelationKey     string        `protobuf:"bytes,4,opt,name=relationKey,proto3" json:"relationKey,omitempty"`
 	RelationDetails *types.Struct `protobuf:"bytes,5,opt,name=relationDetails,proto3" json:"relationDetails,omitempty"`
+	Page            int32         `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
 }
 
 func (m *SearchMeta) Reset()         { *m = SearchMeta{} }
@@ -2792,6 +2932,13 @@ func (m *SearchMeta) GetRelationDetails() *types.Struct {
 	return nil
 }
 
+func (m *SearchMeta) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
 type Block struct {
 	Id              string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Fields          *types.Struct      `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
@@ -4089,6 +4236,9 @@ type BlockContentDataviewView struct {
 	DefaultTemplateId     string                          `protobuf:"bytes,14,opt,name=defaultTemplateId,proto3" json:"defaultTemplateId,omitempty"`
 	DefaultObjectTypeId   string                          `protobuf:"bytes,15,opt,name=defaultObjectTypeId,proto3" json:"defaultObjectTypeId,omitempty"`
 	EndRelationKey        string                          `protobuf:"bytes,16,opt,name=endRelationKey,proto3" json:"endRelationKey,omitempty"`
+	StartRelationKey      string                          `protobuf:"bytes,17,opt,name=startRelationKey,proto3" json:"startRelationKey,omitempty"`
+	GroupDateBucket       BlockContentDataviewDateBucket  `protobuf:"varint,18,opt,name=groupDateBucket,proto3,enum=anytype.model.BlockContentDataviewDateBucket" json:"groupDateBucket,omitempty"`
+	GroupNumberRanges     []float64                       `protobuf:"fixed64,19,rep,packed,name=groupNumberRanges,proto3" json:"groupNumberRanges,omitempty"`
 }
 
 func (m *BlockContentDataviewView) Reset()         { *m = BlockContentDataviewView{} }
@@ -4236,6 +4386,27 @@ func (m *BlockContentDataviewView) GetEndRelationKey() string {
 	return ""
 }
 
+func (m *BlockContentDataviewView) GetStartRelationKey() string {
+	if m != nil {
+		return m.StartRelationKey
+	}
+	return ""
+}
+
+func (m *BlockContentDataviewView) GetGroupDateBucket() BlockContentDataviewDateBucket {
+	if m != nil {
+		return m.GroupDateBucket
+	}
+	return BlockContentDataviewDate_Day
+}
+
+func (m *BlockContentDataviewView) GetGroupNumberRanges() []float64 {
+	if m != nil {
+		return m.GroupNumberRanges
+	}
+	return nil
+}
+
 type BlockContentDataviewRelation struct {
 	Key       string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	IsVisible bool   `protobuf:"varint,2,opt,name=isVisible,proto3" json:"isVisible,omitempty"`
@@ -4741,6 +4912,8 @@ type BlockContentDataviewGroup struct {
 	//	*BlockContentDataviewGroupValueOfTag
 	//	*BlockContentDataviewGroupValueOfCheckbox
 	//	*BlockContentDataviewGroupValueOfDate
+	//	*BlockContentDataviewGroupValueOfNumber
+	//	*BlockContentDataviewGroupValueOfObject
 	Value IsBlockContentDataviewGroupValue `protobuf_oneof:"Value"`
 }
 
@@ -4795,11 +4968,19 @@ type BlockContentDataviewGroupValueOfCheckbox struct {
 type BlockContentDataviewGroupValueOfDate struct {
 	Date *BlockContentDataviewDate `protobuf:"bytes,5,opt,name=date,proto3,oneof" json:"date,omitempty"`
 }
+type BlockContentDataviewGroupValueOfNumber struct {
+	Number *BlockContentDataviewNumber `protobuf:"bytes,6,opt,name=number,proto3,oneof" json:"number,omitempty"`
+}
+type BlockContentDataviewGroupValueOfObject struct {
+	Object *BlockContentDataviewObject `protobuf:"bytes,7,opt,name=object,proto3,oneof" json:"object,omitempty"`
+}
 
 func (*BlockContentDataviewGroupValueOfStatus) IsBlockContentDataviewGroupValue()   {}
 func (*BlockContentDataviewGroupValueOfTag) IsBlockContentDataviewGroupValue()      {}
 func (*BlockContentDataviewGroupValueOfCheckbox) IsBlockContentDataviewGroupValue() {}
 func (*BlockContentDataviewGroupValueOfDate) IsBlockContentDataviewGroupValue()     {}
+func (*BlockContentDataviewGroupValueOfNumber) IsBlockContentDataviewGroupValue()   {}
+func (*BlockContentDataviewGroupValueOfObject) IsBlockContentDataviewGroupValue()   {}
 
 func (m *BlockContentDataviewGroup) GetValue() IsBlockContentDataviewGroupValue {
 	if m != nil {
@@ -4843,6 +5024,20 @@ func (m *BlockContentDataviewGroup) GetDate() *BlockContentDataviewDate {
 	return nil
 }
 
+func (m *BlockContentDataviewGroup) GetNumber() *BlockContentDataviewNumber {
+	if x, ok := m.GetValue().(*BlockContentDataviewGroupValueOfNumber); ok {
+		return x.Number
+	}
+	return nil
+}
+
+func (m *BlockContentDataviewGroup) GetObject() *BlockContentDataviewObject {
+	if x, ok := m.GetValue().(*BlockContentDataviewGroupValueOfObject); ok {
+		return x.Object
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*BlockContentDataviewGroup) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -4850,6 +5045,8 @@ func (*BlockContentDataviewGroup) XXX_OneofWrappers() []interface{} {
 		(*BlockContentDataviewGroupValueOfTag)(nil),
 		(*BlockContentDataviewGroupValueOfCheckbox)(nil),
 		(*BlockContentDataviewGroupValueOfDate)(nil),
+		(*BlockContentDataviewGroupValueOfNumber)(nil),
+		(*BlockContentDataviewGroupValueOfObject)(nil),
 	}
 }
 
@@ -4986,6 +5183,8 @@ func (m *BlockContentDataviewCheckbox) GetChecked() bool {
 }
 
 type BlockContentDataviewDate struct {
+	From int64 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
 }
 
 func (m *BlockContentDataviewDate) Reset()         { *m = BlockContentDataviewDate{} }
@@ -5021,6 +5220,202 @@ func (m *BlockContentDataviewDate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BlockContentDataviewDate proto.InternalMessageInfo
 
+func (m *BlockContentDataviewDate) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *BlockContentDataviewDate) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+// range of numbers, group of a single value has equal bounds
+type BlockContentDataviewNumber struct {
+	From    float64 `protobuf:"fixed64,1,opt,name=from,proto3" json:"from,omitempty"`
+	To      float64 `protobuf:"fixed64,2,opt,name=to,proto3" json:"to,omitempty"`
+	HasFrom bool    `protobuf:"varint,3,opt,name=hasFrom,proto3" json:"hasFrom,omitempty"`
+	HasTo   bool    `protobuf:"varint,4,opt,name=hasTo,proto3" json:"hasTo,omitempty"`
+}
+
+func (m *BlockContentDataviewNumber) Reset()         { *m = BlockContentDataviewNumber{} }
+func (m *BlockContentDataviewNumber) String() string { return proto.CompactTextString(m) }
+func (*BlockContentDataviewNumber) ProtoMessage()    {}
+func (*BlockContentDataviewNumber) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{2, 1, 9, 12}
+}
+func (m *BlockContentDataviewNumber) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockContentDataviewNumber) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockContentDataviewNumber.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockContentDataviewNumber) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockContentDataviewNumber.Merge(m, src)
+}
+func (m *BlockContentDataviewNumber) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockContentDataviewNumber) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockContentDataviewNumber.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockContentDataviewNumber proto.InternalMessageInfo
+
+func (m *BlockContentDataviewNumber) GetFrom() float64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *BlockContentDataviewNumber) GetTo() float64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *BlockContentDataviewNumber) GetHasFrom() bool {
+	if m != nil {
+		return m.HasFrom
+	}
+	return false
+}
+
+func (m *BlockContentDataviewNumber) GetHasTo() bool {
+	if m != nil {
+		return m.HasTo
+	}
+	return false
+}
+
+type BlockContentDataviewObject struct {
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *BlockContentDataviewObject) Reset()         { *m = BlockContentDataviewObject{} }
+func (m *BlockContentDataviewObject) String() string { return proto.CompactTextString(m) }
+func (*BlockContentDataviewObject) ProtoMessage()    {}
+func (*BlockContentDataviewObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{2, 1, 9, 13}
+}
+func (m *BlockContentDataviewObject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockContentDataviewObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockContentDataviewObject.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockContentDataviewObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockContentDataviewObject.Merge(m, src)
+}
+func (m *BlockContentDataviewObject) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockContentDataviewObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockContentDataviewObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockContentDataviewObject proto.InternalMessageInfo
+
+func (m *BlockContentDataviewObject) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+// visible time window of a timeline view
+type BlockContentDataviewTimelineWindow struct {
+	StartRelationKey string `protobuf:"bytes,1,opt,name=startRelationKey,proto3" json:"startRelationKey,omitempty"`
+	EndRelationKey   string `protobuf:"bytes,2,opt,name=endRelationKey,proto3" json:"endRelationKey,omitempty"`
+	From             int64  `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To               int64  `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (m *BlockContentDataviewTimelineWindow) Reset()         { *m = BlockContentDataviewTimelineWindow{} }
+func (m *BlockContentDataviewTimelineWindow) String() string { return proto.CompactTextString(m) }
+func (*BlockContentDataviewTimelineWindow) ProtoMessage()    {}
+func (*BlockContentDataviewTimelineWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{2, 1, 9, 14}
+}
+func (m *BlockContentDataviewTimelineWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockContentDataviewTimelineWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockContentDataviewTimelineWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockContentDataviewTimelineWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockContentDataviewTimelineWindow.Merge(m, src)
+}
+func (m *BlockContentDataviewTimelineWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockContentDataviewTimelineWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockContentDataviewTimelineWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockContentDataviewTimelineWindow proto.InternalMessageInfo
+
+func (m *BlockContentDataviewTimelineWindow) GetStartRelationKey() string {
+	if m != nil {
+		return m.StartRelationKey
+	}
+	return ""
+}
+
+func (m *BlockContentDataviewTimelineWindow) GetEndRelationKey() string {
+	if m != nil {
+		return m.EndRelationKey
+	}
+	return ""
+}
+
+func (m *BlockContentDataviewTimelineWindow) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *BlockContentDataviewTimelineWindow) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
 type BlockContentRelation struct {
 	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
@@ -7575,9 +7970,13 @@ type Notification struct {
 	//	*NotificationPayloadOfParticipantRemove
 	//	*NotificationPayloadOfParticipantRequestDecline
 	//	*NotificationPayloadOfParticipantPermissionsChange
+	//	*NotificationPayloadOfReminder
+	//	*NotificationPayloadOfRuleTriggered
 	Payload   IsNotificationPayload `protobuf_oneof:"payload"`
 	Space     string                `protobuf:"bytes,7,opt,name=space,proto3" json:"space,omitempty"`
 	AclHeadId string                `protobuf:"bytes,14,opt,name=aclHeadId,proto3" json:"aclHeadId,omitempty"`
+	// notification is hidden from the list until this time in seconds, kept only on the current device
+	SnoozeUntil int64 `protobuf:"varint,21,opt,name=snoozeUntil,proto3" json:"snoozeUntil,omitempty"`
 }
 
 func (m *Notification) Reset()         { *m = Notification{} }
@@ -7649,6 +8048,12 @@ type NotificationPayloadOfParticipantRequestDecline struct {
 type NotificationPayloadOfParticipantPermissionsChange struct {
 	ParticipantPermissionsChange *NotificationParticipantPermissionsChange `protobuf:"bytes,18,opt,name=participantPermissionsChange,proto3,oneof" json:"participantPermissionsChange,omitempty"`
 }
+type NotificationPayloadOfReminder struct {
+	Reminder *NotificationReminder `protobuf:"bytes,19,opt,name=reminder,proto3,oneof" json:"reminder,omitempty"`
+}
+type NotificationPayloadOfRuleTriggered struct {
+	RuleTriggered *NotificationRuleTriggered `protobuf:"bytes,20,opt,name=ruleTriggered,proto3,oneof" json:"ruleTriggered,omitempty"`
+}
 
 func (*NotificationPayloadOfImport) IsNotificationPayload()                       {}
 func (*NotificationPayloadOfExport) IsNotificationPayload()                       {}
@@ -7660,6 +8065,8 @@ func (*NotificationPayloadOfRequestToLeave) IsNotificationPayload()
 func (*NotificationPayloadOfParticipantRemove) IsNotificationPayload()            {}
 func (*NotificationPayloadOfParticipantRequestDecline) IsNotificationPayload()    {}
 func (*NotificationPayloadOfParticipantPermissionsChange) IsNotificationPayload() {}
+func (*NotificationPayloadOfReminder) IsNotificationPayload()                     {}
+func (*NotificationPayloadOfRuleTriggered) IsNotificationPayload()                {}
 
 func (m *Notification) GetPayload() IsNotificationPayload {
 	if m != nil {
@@ -7766,6 +8173,20 @@ func (m *Notification) GetParticipantPermissionsChange() *NotificationParticipan
 	return nil
 }
 
+func (m *Notification) GetReminder() *NotificationReminder {
+	if x, ok := m.GetPayload().(*NotificationPayloadOfReminder); ok {
+		return x.Reminder
+	}
+	return nil
+}
+
+func (m *Notification) GetRuleTriggered() *NotificationRuleTriggered {
+	if x, ok := m.GetPayload().(*NotificationPayloadOfRuleTriggered); ok {
+		return x.RuleTriggered
+	}
+	return nil
+}
+
 func (m *Notification) GetSpace() string {
 	if m != nil {
 		return m.Space
@@ -7780,6 +8201,13 @@ func (m *Notification) GetAclHeadId() string {
 	return ""
 }
 
+func (m *Notification) GetSnoozeUntil() int64 {
+	if m != nil {
+		return m.SnoozeUntil
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Notification) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -7793,6 +8221,8 @@ func (*Notification) XXX_OneofWrappers() []interface{} {
 		(*NotificationPayloadOfParticipantRemove)(nil),
 		(*NotificationPayloadOfParticipantRequestDecline)(nil),
 		(*NotificationPayloadOfParticipantPermissionsChange)(nil),
+		(*NotificationPayloadOfReminder)(nil),
+		(*NotificationPayloadOfRuleTriggered)(nil),
 	}
 }
 
@@ -8448,6 +8878,496 @@ func (m *NotificationParticipantPermissionsChange) GetSpaceName() string {
 	return ""
 }
 
+type NotificationReminder struct {
+	SpaceId      string `protobuf:"bytes,1,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
+	ObjectId     string `protobuf:"bytes,2,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	ObjectName   string `protobuf:"bytes,3,opt,name=objectName,proto3" json:"objectName,omitempty"`
+	RelationKey  string `protobuf:"bytes,4,opt,name=relationKey,proto3" json:"relationKey,omitempty"`
+	RelationName string `protobuf:"bytes,5,opt,name=relationName,proto3" json:"relationName,omitempty"`
+	// time of the reminder in seconds
+	Date      int64  `protobuf:"varint,6,opt,name=date,proto3" json:"date,omitempty"`
+	SpaceName string `protobuf:"bytes,7,opt,name=spaceName,proto3" json:"spaceName,omitempty"`
+}
+
+func (m *NotificationReminder) Reset()         { *m = NotificationReminder{} }
+func (m *NotificationReminder) String() string { return proto.CompactTextString(m) }
+func (*NotificationReminder) ProtoMessage()    {}
+func (*NotificationReminder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{21, 10}
+}
+func (m *NotificationReminder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationReminder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationReminder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NotificationReminder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationReminder.Merge(m, src)
+}
+func (m *NotificationReminder) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationReminder) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationReminder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationReminder proto.InternalMessageInfo
+
+func (m *NotificationReminder) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetRelationKey() string {
+	if m != nil {
+		return m.RelationKey
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetRelationName() string {
+	if m != nil {
+		return m.RelationName
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetDate() int64 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
+func (m *NotificationReminder) GetSpaceName() string {
+	if m != nil {
+		return m.SpaceName
+	}
+	return ""
+}
+
+type NotificationRuleTriggered struct {
+	RuleId       string                  `protobuf:"bytes,1,opt,name=ruleId,proto3" json:"ruleId,omitempty"`
+	RuleName     string                  `protobuf:"bytes,2,opt,name=ruleName,proto3" json:"ruleName,omitempty"`
+	Trigger      NotificationRuleTrigger `protobuf:"varint,3,opt,name=trigger,proto3,enum=anytype.model.NotificationRuleTrigger" json:"trigger,omitempty"`
+	SpaceId      string                  `protobuf:"bytes,4,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
+	SpaceName    string                  `protobuf:"bytes,5,opt,name=spaceName,proto3" json:"spaceName,omitempty"`
+	ObjectId     string                  `protobuf:"bytes,6,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	ObjectName   string                  `protobuf:"bytes,7,opt,name=objectName,proto3" json:"objectName,omitempty"`
+	RelationKey  string                  `protobuf:"bytes,8,opt,name=relationKey,proto3" json:"relationKey,omitempty"`
+	RelationName string                  `protobuf:"bytes,9,opt,name=relationName,proto3" json:"relationName,omitempty"`
+	// participant who made the change
+	ActorId   string `protobuf:"bytes,10,opt,name=actorId,proto3" json:"actorId,omitempty"`
+	ActorName string `protobuf:"bytes,11,opt,name=actorName,proto3" json:"actorName,omitempty"`
+	// e.g. anytype://object?objectId=...&spaceId=...
+	DeepLink string `protobuf:"bytes,12,opt,name=deepLink,proto3" json:"deepLink,omitempty"`
+}
+
+func (m *NotificationRuleTriggered) Reset()         { *m = NotificationRuleTriggered{} }
+func (m *NotificationRuleTriggered) String() string { return proto.CompactTextString(m) }
+func (*NotificationRuleTriggered) ProtoMessage()    {}
+func (*NotificationRuleTriggered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{21, 11}
+}
+func (m *NotificationRuleTriggered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationRuleTriggered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationRuleTriggered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NotificationRuleTriggered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationRuleTriggered.Merge(m, src)
+}
+func (m *NotificationRuleTriggered) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationRuleTriggered) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationRuleTriggered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationRuleTriggered proto.InternalMessageInfo
+
+func (m *NotificationRuleTriggered) GetRuleId() string {
+	if m != nil {
+		return m.RuleId
+	}
+	return ""
+}
+
+func (m *NotificationRuleTriggered) GetRuleName() string {
+	if m != nil {
+		return m.RuleName
+	}
+	return ""
+}
+
+func (m *NotificationRuleTriggered) GetTrigger() NotificationRuleTrigger {
+	if m != nil {
+		return m.Trigger
+	}
+	return NotificationRule_RelationChanged
+}
+
+func (m *NotificationRuleTriggered) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *NotificationRuleTriggered) GetSpaceName() string {
+	if m != nil {
+		return m.SpaceName
+	}
+	return ""
+}
+
+func (m *NotificationRuleTriggered) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *NotificationRuleTriggered) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *NotificationRuleTriggered) GetRelationKey() string {
+	if m != nil {
+		return m.RelationKey
+	}
+	return ""
+}
+
+func (m *NotificationRuleTriggered) GetRelationName() string {
+	if m != nil {
+		return m.RelationName
+	}
+	return ""
+}
+
+func (m *NotificationRuleTriggered) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *NotificationRuleTriggered) GetActorName() string {
+	if m != nil {
+		return m.ActorName
+	}
+	return ""
+}
+
+func (m *NotificationRuleTriggered) GetDeepLink() string {
+	if m != nil {
+		return m.DeepLink
+	}
+	return ""
+}
+
+// NotificationDigest is a group of related notifications shown as a single entry
+type NotificationDigest struct {
+	// object id, space id or notification id, depending on grouping
+	Id          string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Grouping    NotificationDigestGrouping `protobuf:"varint,2,opt,name=grouping,proto3,enum=anytype.model.NotificationDigestGrouping" json:"grouping,omitempty"`
+	SpaceId     string                     `protobuf:"bytes,3,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
+	ObjectId    string                     `protobuf:"bytes,4,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	Count       int64                      `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	UnreadCount int64                      `protobuf:"varint,6,opt,name=unreadCount,proto3" json:"unreadCount,omitempty"`
+	// the most recent notification of the group
+	Latest          *Notification `protobuf:"bytes,7,opt,name=latest,proto3" json:"latest,omitempty"`
+	NotificationIds []string      `protobuf:"bytes,8,rep,name=notificationIds,proto3" json:"notificationIds,omitempty"`
+}
+
+func (m *NotificationDigest) Reset()         { *m = NotificationDigest{} }
+func (m *NotificationDigest) String() string { return proto.CompactTextString(m) }
+func (*NotificationDigest) ProtoMessage()    {}
+func (*NotificationDigest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{22}
+}
+func (m *NotificationDigest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationDigest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationDigest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NotificationDigest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationDigest.Merge(m, src)
+}
+func (m *NotificationDigest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationDigest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationDigest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationDigest proto.InternalMessageInfo
+
+func (m *NotificationDigest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *NotificationDigest) GetGrouping() NotificationDigestGrouping {
+	if m != nil {
+		return m.Grouping
+	}
+	return NotificationDigest_None
+}
+
+func (m *NotificationDigest) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *NotificationDigest) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *NotificationDigest) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *NotificationDigest) GetUnreadCount() int64 {
+	if m != nil {
+		return m.UnreadCount
+	}
+	return 0
+}
+
+func (m *NotificationDigest) GetLatest() *Notification {
+	if m != nil {
+		return m.Latest
+	}
+	return nil
+}
+
+func (m *NotificationDigest) GetNotificationIds() []string {
+	if m != nil {
+		return m.NotificationIds
+	}
+	return nil
+}
+
+// NotificationExpiryPolicy defines when notifications are removed from the notification center
+type NotificationExpiryPolicy struct {
+	// seconds after creation when read notifications expire, 0 means never
+	ReadTtl int64 `protobuf:"varint,1,opt,name=readTtl,proto3" json:"readTtl,omitempty"`
+	// seconds after creation when unread notifications expire, 0 means never
+	UnreadTtl int64 `protobuf:"varint,2,opt,name=unreadTtl,proto3" json:"unreadTtl,omitempty"`
+}
+
+func (m *NotificationExpiryPolicy) Reset()         { *m = NotificationExpiryPolicy{} }
+func (m *NotificationExpiryPolicy) String() string { return proto.CompactTextString(m) }
+func (*NotificationExpiryPolicy) ProtoMessage()    {}
+func (*NotificationExpiryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{23}
+}
+func (m *NotificationExpiryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationExpiryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationExpiryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NotificationExpiryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationExpiryPolicy.Merge(m, src)
+}
+func (m *NotificationExpiryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationExpiryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationExpiryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationExpiryPolicy proto.InternalMessageInfo
+
+func (m *NotificationExpiryPolicy) GetReadTtl() int64 {
+	if m != nil {
+		return m.ReadTtl
+	}
+	return 0
+}
+
+func (m *NotificationExpiryPolicy) GetUnreadTtl() int64 {
+	if m != nil {
+		return m.UnreadTtl
+	}
+	return 0
+}
+
+// NotificationRule is a user-defined condition for notifications about changes of objects made by other participants
+type NotificationRule struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// empty means all spaces
+	SpaceId string `protobuf:"bytes,3,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
+	// empty means objects of any type
+	ObjectTypeKeys []string                `protobuf:"bytes,4,rep,name=objectTypeKeys,proto3" json:"objectTypeKeys,omitempty"`
+	Trigger        NotificationRuleTrigger `protobuf:"varint,5,opt,name=trigger,proto3,enum=anytype.model.NotificationRuleTrigger" json:"trigger,omitempty"`
+	// relation to watch, required for RelationChanged and Assigned triggers
+	RelationKey string `protobuf:"bytes,6,opt,name=relationKey,proto3" json:"relationKey,omitempty"`
+	// notify only about objects created by the current user
+	OnlyCreatedByMe bool `protobuf:"varint,7,opt,name=onlyCreatedByMe,proto3" json:"onlyCreatedByMe,omitempty"`
+	Disabled        bool `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (m *NotificationRule) Reset()         { *m = NotificationRule{} }
+func (m *NotificationRule) String() string { return proto.CompactTextString(m) }
+func (*NotificationRule) ProtoMessage()    {}
+func (*NotificationRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{24}
+}
+func (m *NotificationRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NotificationRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationRule.Merge(m, src)
+}
+func (m *NotificationRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationRule proto.InternalMessageInfo
+
+func (m *NotificationRule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *NotificationRule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NotificationRule) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *NotificationRule) GetObjectTypeKeys() []string {
+	if m != nil {
+		return m.ObjectTypeKeys
+	}
+	return nil
+}
+
+func (m *NotificationRule) GetTrigger() NotificationRuleTrigger {
+	if m != nil {
+		return m.Trigger
+	}
+	return NotificationRule_RelationChanged
+}
+
+func (m *NotificationRule) GetRelationKey() string {
+	if m != nil {
+		return m.RelationKey
+	}
+	return ""
+}
+
+func (m *NotificationRule) GetOnlyCreatedByMe() bool {
+	if m != nil {
+		return m.OnlyCreatedByMe
+	}
+	return false
+}
+
+func (m *NotificationRule) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
 type Export struct {
 }
 
@@ -8455,7 +9375,7 @@ func (m *Export) Reset()         { *m = Export{} }
 func (m *Export) String() string { return proto.CompactTextString(m) }
 func (*Export) ProtoMessage()    {}
 func (*Export) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{22}
+	return fileDescriptor_98a910b73321e591, []int{25}
 }
 func (m *Export) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8491,7 +9411,7 @@ func (m *Import) Reset()         { *m = Import{} }
 func (m *Import) String() string { return proto.CompactTextString(m) }
 func (*Import) ProtoMessage()    {}
 func (*Import) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{23}
+	return fileDescriptor_98a910b73321e591, []int{26}
 }
 func (m *Import) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8529,7 +9449,7 @@ func (m *Invite) Reset()         { *m = Invite{} }
 func (m *Invite) String() string { return proto.CompactTextString(m) }
 func (*Invite) ProtoMessage()    {}
 func (*Invite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{24}
+	return fileDescriptor_98a910b73321e591, []int{27}
 }
 func (m *Invite) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8588,7 +9508,7 @@ func (m *InvitePayload) Reset()         { *m = InvitePayload{} }
 func (m *InvitePayload) String() string { return proto.CompactTextString(m) }
 func (*InvitePayload) ProtoMessage()    {}
 func (*InvitePayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{25}
+	return fileDescriptor_98a910b73321e591, []int{28}
 }
 func (m *InvitePayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8693,7 +9613,7 @@ func (m *IdentityProfile) Reset()         { *m = IdentityProfile{} }
 func (m *IdentityProfile) String() string { return proto.CompactTextString(m) }
 func (*IdentityProfile) ProtoMessage()    {}
 func (*IdentityProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{26}
+	return fileDescriptor_98a910b73321e591, []int{29}
 }
 func (m *IdentityProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8773,7 +9693,7 @@ func (m *FileInfo) Reset()         { *m = FileInfo{} }
 func (m *FileInfo) String() string { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()    {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{27}
+	return fileDescriptor_98a910b73321e591, []int{30}
 }
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8825,7 +9745,7 @@ func (m *FileEncryptionKey) Reset()         { *m = FileEncryptionKey{} }
 func (m *FileEncryptionKey) String() string { return proto.CompactTextString(m) }
 func (*FileEncryptionKey) ProtoMessage()    {}
 func (*FileEncryptionKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{28}
+	return fileDescriptor_98a910b73321e591, []int{31}
 }
 func (m *FileEncryptionKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8887,7 +9807,7 @@ func (m *ManifestInfo) Reset()         { *m = ManifestInfo{} }
 func (m *ManifestInfo) String() string { return proto.CompactTextString(m) }
 func (*ManifestInfo) ProtoMessage()    {}
 func (*ManifestInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{29}
+	return fileDescriptor_98a910b73321e591, []int{32}
 }
 func (m *ManifestInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9020,7 +9940,7 @@ func (m *Membership) Reset()         { *m = Membership{} }
 func (m *Membership) String() string { return proto.CompactTextString(m) }
 func (*Membership) ProtoMessage()    {}
 func (*Membership) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{30}
+	return fileDescriptor_98a910b73321e591, []int{33}
 }
 func (m *Membership) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9161,7 +10081,7 @@ func (m *MembershipTierData) Reset()         { *m = MembershipTierData{} }
 func (m *MembershipTierData) String() string { return proto.CompactTextString(m) }
 func (*MembershipTierData) ProtoMessage()    {}
 func (*MembershipTierData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{31}
+	return fileDescriptor_98a910b73321e591, []int{34}
 }
 func (m *MembershipTierData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9325,7 +10245,7 @@ func (m *Detail) Reset()         { *m = Detail{} }
 func (m *Detail) String() string { return proto.CompactTextString(m) }
 func (*Detail) ProtoMessage()    {}
 func (*Detail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{32}
+	return fileDescriptor_98a910b73321e591, []int{35}
 }
 func (m *Detail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9380,7 +10300,7 @@ func (m *DeviceInfo) Reset()         { *m = DeviceInfo{} }
 func (m *DeviceInfo) String() string { return proto.CompactTextString(m) }
 func (*DeviceInfo) ProtoMessage()    {}
 func (*DeviceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{33}
+	return fileDescriptor_98a910b73321e591, []int{36}
 }
 func (m *DeviceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9445,17 +10365,18 @@ func (m *DeviceInfo) GetIsConnected() bool {
 }
 
 type ChatState struct {
-	Messages    *ChatStateUnreadState `protobuf:"bytes,1,opt,name=messages,proto3" json:"messages,omitempty"`
-	Mentions    *ChatStateUnreadState `protobuf:"bytes,2,opt,name=mentions,proto3" json:"mentions,omitempty"`
-	LastStateId string                `protobuf:"bytes,3,opt,name=lastStateId,proto3" json:"lastStateId,omitempty"`
-	Order       int64                 `protobuf:"varint,4,opt,name=order,proto3" json:"order,omitempty"`
+	Messages    *ChatStateUnreadState            `protobuf:"bytes,1,opt,name=messages,proto3" json:"messages,omitempty"`
+	Mentions    *ChatStateUnreadState            `protobuf:"bytes,2,opt,name=mentions,proto3" json:"mentions,omitempty"`
+	LastStateId string                           `protobuf:"bytes,3,opt,name=lastStateId,proto3" json:"lastStateId,omitempty"`
+	Order       int64                            `protobuf:"varint,4,opt,name=order,proto3" json:"order,omitempty"`
+	Threads     map[string]*ChatStateUnreadState `protobuf:"bytes,5,rep,name=threads,proto3" json:"threads,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *ChatState) Reset()         { *m = ChatState{} }
 func (m *ChatState) String() string { return proto.CompactTextString(m) }
 func (*ChatState) ProtoMessage()    {}
 func (*ChatState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{34}
+	return fileDescriptor_98a910b73321e591, []int{37}
 }
 func (m *ChatState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9512,6 +10433,13 @@ func (m *ChatState) GetOrder() int64 {
 	return 0
 }
 
+func (m *ChatState) GetThreads() map[string]*ChatStateUnreadState {
+	if m != nil {
+		return m.Threads
+	}
+	return nil
+}
+
 type ChatStateUnreadState struct {
 	OldestOrderId string `protobuf:"bytes,1,opt,name=oldestOrderId,proto3" json:"oldestOrderId,omitempty"`
 	Counter       int32  `protobuf:"varint,2,opt,name=counter,proto3" json:"counter,omitempty"`
@@ -9521,7 +10449,7 @@ func (m *ChatStateUnreadState) Reset()         { *m = ChatStateUnreadState{} }
 func (m *ChatStateUnreadState) String() string { return proto.CompactTextString(m) }
 func (*ChatStateUnreadState) ProtoMessage()    {}
 func (*ChatStateUnreadState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{34, 0}
+	return fileDescriptor_98a910b73321e591, []int{37, 0}
 }
 func (m *ChatStateUnreadState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9579,13 +10507,15 @@ type ChatMessage struct {
 	Read             bool                       `protobuf:"varint,10,opt,name=read,proto3" json:"read,omitempty"`
 	MentionRead      bool                       `protobuf:"varint,12,opt,name=mentionRead,proto3" json:"mentionRead,omitempty"`
 	Synced           bool                       `protobuf:"varint,13,opt,name=synced,proto3" json:"synced,omitempty"`
+	ThreadId         string                     `protobuf:"bytes,14,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Thread           *ChatMessageThread         `protobuf:"bytes,15,opt,name=thread,proto3" json:"thread,omitempty"`
 }
 
 func (m *ChatMessage) Reset()         { *m = ChatMessage{} }
 func (m *ChatMessage) String() string { return proto.CompactTextString(m) }
 func (*ChatMessage) ProtoMessage()    {}
 func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{35}
+	return fileDescriptor_98a910b73321e591, []int{38}
 }
 func (m *ChatMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9705,6 +10635,20 @@ func (m *ChatMessage) GetSynced() bool {
 	return false
 }
 
+func (m *ChatMessage) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
+	}
+	return ""
+}
+
+func (m *ChatMessage) GetThread() *ChatMessageThread {
+	if m != nil {
+		return m.Thread
+	}
+	return nil
+}
+
 type ChatMessageMessageContent struct {
 	Text  string                  `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	Style BlockContentTextStyle   `protobuf:"varint,2,opt,name=style,proto3,enum=anytype.model.BlockContentTextStyle" json:"style,omitempty"`
@@ -9715,7 +10659,7 @@ func (m *ChatMessageMessageContent) Reset()         { *m = ChatMessageMessageCon
 func (m *ChatMessageMessageContent) String() string { return proto.CompactTextString(m) }
 func (*ChatMessageMessageContent) ProtoMessage()    {}
 func (*ChatMessageMessageContent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{35, 0}
+	return fileDescriptor_98a910b73321e591, []int{38, 0}
 }
 func (m *ChatMessageMessageContent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9774,7 +10718,7 @@ func (m *ChatMessageAttachment) Reset()         { *m = ChatMessageAttachment{} }
 func (m *ChatMessageAttachment) String() string { return proto.CompactTextString(m) }
 func (*ChatMessageAttachment) ProtoMessage()    {}
 func (*ChatMessageAttachment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{35, 1}
+	return fileDescriptor_98a910b73321e591, []int{38, 1}
 }
 func (m *ChatMessageAttachment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9825,7 +10769,7 @@ func (m *ChatMessageReactions) Reset()         { *m = ChatMessageReactions{} }
 func (m *ChatMessageReactions) String() string { return proto.CompactTextString(m) }
 func (*ChatMessageReactions) ProtoMessage()    {}
 func (*ChatMessageReactions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{35, 2}
+	return fileDescriptor_98a910b73321e591, []int{38, 2}
 }
 func (m *ChatMessageReactions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9869,7 +10813,7 @@ func (m *ChatMessageReactionsIdentityList) Reset()         { *m = ChatMessageRea
 func (m *ChatMessageReactionsIdentityList) String() string { return proto.CompactTextString(m) }
 func (*ChatMessageReactionsIdentityList) ProtoMessage()    {}
 func (*ChatMessageReactionsIdentityList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{35, 2, 1}
+	return fileDescriptor_98a910b73321e591, []int{38, 2, 1}
 }
 func (m *ChatMessageReactionsIdentityList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9905,6 +10849,134 @@ func (m *ChatMessageReactionsIdentityList) GetIds() []string {
 	return nil
 }
 
+type ChatMessageThread struct {
+	ReplyCount int32                       `protobuf:"varint,1,opt,name=replyCount,proto3" json:"replyCount,omitempty"`
+	LastReply  *ChatMessageThreadLastReply `protobuf:"bytes,2,opt,name=lastReply,proto3" json:"lastReply,omitempty"`
+}
+
+func (m *ChatMessageThread) Reset()         { *m = ChatMessageThread{} }
+func (m *ChatMessageThread) String() string { return proto.CompactTextString(m) }
+func (*ChatMessageThread) ProtoMessage()    {}
+func (*ChatMessageThread) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{38, 3}
+}
+func (m *ChatMessageThread) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChatMessageThread) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChatMessageThread.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChatMessageThread) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatMessageThread.Merge(m, src)
+}
+func (m *ChatMessageThread) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChatMessageThread) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatMessageThread.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatMessageThread proto.InternalMessageInfo
+
+func (m *ChatMessageThread) GetReplyCount() int32 {
+	if m != nil {
+		return m.ReplyCount
+	}
+	return 0
+}
+
+func (m *ChatMessageThread) GetLastReply() *ChatMessageThreadLastReply {
+	if m != nil {
+		return m.LastReply
+	}
+	return nil
+}
+
+type ChatMessageThreadLastReply struct {
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId   string `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Creator   string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreatedAt int64  `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Text      string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (m *ChatMessageThreadLastReply) Reset()         { *m = ChatMessageThreadLastReply{} }
+func (m *ChatMessageThreadLastReply) String() string { return proto.CompactTextString(m) }
+func (*ChatMessageThreadLastReply) ProtoMessage()    {}
+func (*ChatMessageThreadLastReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{38, 3, 0}
+}
+func (m *ChatMessageThreadLastReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChatMessageThreadLastReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChatMessageThreadLastReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChatMessageThreadLastReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatMessageThreadLastReply.Merge(m, src)
+}
+func (m *ChatMessageThreadLastReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChatMessageThreadLastReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatMessageThreadLastReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatMessageThreadLastReply proto.InternalMessageInfo
+
+func (m *ChatMessageThreadLastReply) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ChatMessageThreadLastReply) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *ChatMessageThreadLastReply) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ChatMessageThreadLastReply) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ChatMessageThreadLastReply) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("anytype.model.SmartBlockType", SmartBlockType_name, SmartBlockType_value)
 	proto.RegisterEnum("anytype.model.RelationFormat", RelationFormat_name, RelationFormat_value)
@@ -9947,6 +11019,7 @@ func init() {
 	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterOperator", BlockContentDataviewFilterOperator_name, BlockContentDataviewFilterOperator_value)
 	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterCondition", BlockContentDataviewFilterCondition_name, BlockContentDataviewFilterCondition_value)
 	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterQuickOption", BlockContentDataviewFilterQuickOption_name, BlockContentDataviewFilterQuickOption_value)
+	proto.RegisterEnum("anytype.model.BlockContentDataviewDateBucket", BlockContentDataviewDateBucket_name, BlockContentDataviewDateBucket_value)
 	proto.RegisterEnum("anytype.model.BlockContentLatexProcessor", BlockContentLatexProcessor_name, BlockContentLatexProcessor_value)
 	proto.RegisterEnum("anytype.model.BlockContentWidgetLayout", BlockContentWidgetLayout_name, BlockContentWidgetLayout_value)
 	proto.RegisterEnum("anytype.model.AccountStatusType", AccountStatusType_name, AccountStatusType_value)
@@ -9961,6 +11034,8 @@ func init() {
 	proto.RegisterEnum("anytype.model.NotificationStatus", NotificationStatus_name, NotificationStatus_value)
 	proto.RegisterEnum("anytype.model.NotificationActionType", NotificationActionType_name, NotificationActionType_value)
 	proto.RegisterEnum("anytype.model.NotificationExportCode", NotificationExportCode_name, NotificationExportCode_value)
+	proto.RegisterEnum("anytype.model.NotificationDigestGrouping", NotificationDigestGrouping_name, NotificationDigestGrouping_value)
+	proto.RegisterEnum("anytype.model.NotificationRuleTrigger", NotificationRuleTrigger_name, NotificationRuleTrigger_value)
 	proto.RegisterEnum("anytype.model.ExportFormat", ExportFormat_name, ExportFormat_value)
 	proto.RegisterEnum("anytype.model.ImportType", ImportType_name, ImportType_value)
 	proto.RegisterEnum("anytype.model.ImportErrorCode", ImportErrorCode_name, ImportErrorCode_value)
@@ -10000,6 +11075,9 @@ func init() {
 	proto.RegisterType((*BlockContentDataviewTag)(nil), "anytype.model.Block.Content.Dataview.Tag")
 	proto.RegisterType((*BlockContentDataviewCheckbox)(nil), "anytype.model.Block.Content.Dataview.Checkbox")
 	proto.RegisterType((*BlockContentDataviewDate)(nil), "anytype.model.Block.Content.Dataview.Date")
+	proto.RegisterType((*BlockContentDataviewNumber)(nil), "anytype.model.Block.Content.Dataview.Number")
+	proto.RegisterType((*BlockContentDataviewObject)(nil), "anytype.model.Block.Content.Dataview.Object")
+	proto.RegisterType((*BlockContentDataviewTimelineWindow)(nil), "anytype.model.Block.Content.Dataview.TimelineWindow")
 	proto.RegisterType((*BlockContentRelation)(nil), "anytype.model.Block.Content.Relation")
 	proto.RegisterType((*BlockContentLatex)(nil), "anytype.model.Block.Content.Latex")
 	proto.RegisterType((*BlockContentTableOfContents)(nil), "anytype.model.Block.Content.TableOfContents")
@@ -10051,6 +11129,11 @@ func init() {
 	proto.RegisterType((*NotificationParticipantRemove)(nil), "anytype.model.Notification.ParticipantRemove")
 	proto.RegisterType((*NotificationParticipantRequestDecline)(nil), "anytype.model.Notification.ParticipantRequestDecline")
 	proto.RegisterType((*NotificationParticipantPermissionsChange)(nil), "anytype.model.Notification.ParticipantPermissionsChange")
+	proto.RegisterType((*NotificationReminder)(nil), "anytype.model.Notification.Reminder")
+	proto.RegisterType((*NotificationRuleTriggered)(nil), "anytype.model.Notification.RuleTriggered")
+	proto.RegisterType((*NotificationDigest)(nil), "anytype.model.NotificationDigest")
+	proto.RegisterType((*NotificationExpiryPolicy)(nil), "anytype.model.NotificationExpiryPolicy")
+	proto.RegisterType((*NotificationRule)(nil), "anytype.model.NotificationRule")
 	proto.RegisterType((*Export)(nil), "anytype.model.Export")
 	proto.RegisterType((*Import)(nil), "anytype.model.Import")
 	proto.RegisterType((*Invite)(nil), "anytype.model.Invite")
@@ -10064,6 +11147,7 @@ func init() {
 	proto.RegisterType((*Detail)(nil), "anytype.model.Detail")
 	proto.RegisterType((*DeviceInfo)(nil), "anytype.model.DeviceInfo")
 	proto.RegisterType((*ChatState)(nil), "anytype.model.ChatState")
+	proto.RegisterMapType((map[string]*ChatStateUnreadState)(nil), "anytype.model.ChatState.ThreadsEntry")
 	proto.RegisterType((*ChatStateUnreadState)(nil), "anytype.model.ChatState.UnreadState")
 	proto.RegisterType((*ChatMessage)(nil), "anytype.model.ChatMessage")
 	proto.RegisterType((*ChatMessageMessageContent)(nil), "anytype.model.ChatMessage.MessageContent")
@@ -10071,6 +11155,8 @@ func init() {
 	proto.RegisterType((*ChatMessageReactions)(nil), "anytype.model.ChatMessage.Reactions")
 	proto.RegisterMapType((map[string]*ChatMessageReactionsIdentityList)(nil), "anytype.model.ChatMessage.Reactions.ReactionsEntry")
 	proto.RegisterType((*ChatMessageReactionsIdentityList)(nil), "anytype.model.ChatMessage.Reactions.IdentityList")
+	proto.RegisterType((*ChatMessageThread)(nil), "anytype.model.ChatMessage.Thread")
+	proto.RegisterType((*ChatMessageThreadLastReply)(nil), "anytype.model.ChatMessage.Thread.LastReply")
 }
 
 func init() {
@@ -10078,604 +11164,656 @@ func init() {
 }
 
 var fileDescriptor_98a910b73321e591 = []byte{
-	// 9547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x5b, 0x8c, 0x24, 0x59,
-	0x76, 0x50, 0xe5, 0x3b, 0xf3, 0x64, 0x65, 0xd5, 0xad, 0xdb, 0xaf, 0x9c, 0xdc, 0x76, 0xd3, 0xce,
-	0x9d, 0x9d, 0xe9, 0xe9, 0x9d, 0xad, 0x99, 0xe9, 0x99, 0xd9, 0x99, 0x1d, 0xef, 0xcc, 0x6e, 0x56,
-	0x55, 0x56, 0x57, 0x4e, 0xd7, 0x6b, 0x22, 0xb3, 0xbb, 0x77, 0x46, 0x36, 0xe5, 0xa8, 0x8c, 0x5b,
-	0x99, 0xb1, 0x15, 0x19, 0x91, 0x8e, 0xb8, 0x59, 0x5d, 0x35, 0x02, 0x64, 0x0c, 0xd8, 0x98, 0xaf,
-	0x05, 0x61, 0x03, 0x02, 0xe4, 0xdd, 0x0f, 0x04, 0x02, 0x4b, 0xfe, 0xb2, 0xc0, 0x3c, 0x3e, 0x80,
-	0x1f, 0x24, 0x84, 0xb4, 0x88, 0x1f, 0x23, 0x3e, 0x8c, 0x76, 0x25, 0x7e, 0x00, 0x5b, 0x46, 0x7c,
-	0x2c, 0x12, 0x42, 0xe8, 0x9c, 0x7b, 0xe3, 0x95, 0x99, 0x55, 0x9d, 0x3d, 0xb6, 0x11, 0x5f, 0x95,
-	0xf7, 0xc4, 0x39, 0xe7, 0xbe, 0xcf, 0x3d, 0xaf, 0x7b, 0x0b, 0x5e, 0x1e, 0x9f, 0x0e, 0xde, 0x70,
-	0xec, 0xe3, 0x37, 0xc6, 0xc7, 0x6f, 0x8c, 0x3c, 0x4b, 0x38, 0x6f, 0x8c, 0x7d, 0x4f, 0x7a, 0x81,
-	0x2a, 0x04, 0xeb, 0x54, 0xe2, 0x35, 0xd3, 0xbd, 0x90, 0x17, 0x63, 0xb1, 0x4e, 0xd0, 0xc6, 0xed,
-	0x81, 0xe7, 0x0d, 0x1c, 0xa1, 0x50, 0x8f, 0x27, 0x27, 0x6f, 0x04, 0xd2, 0x9f, 0xf4, 0xa5, 0x42,
-	0x6e, 0xfe, 0x30, 0x0f, 0x37, 0xbb, 0x23, 0xd3, 0x97, 0x1b, 0x8e, 0xd7, 0x3f, 0xed, 0xba, 0xe6,
-	0x38, 0x18, 0x7a, 0x72, 0xc3, 0x0c, 0x04, 0x7f, 0x1d, 0x8a, 0xc7, 0x08, 0x0c, 0xea, 0x99, 0xbb,
-	0xb9, 0x7b, 0xd5, 0x07, 0xd7, 0xd7, 0x53, 0x8c, 0xd7, 0x89, 0xc2, 0xd0, 0x38, 0xfc, 0x2d, 0x28,
-	0x59, 0x42, 0x9a, 0xb6, 0x13, 0xd4, 0xb3, 0x77, 0x33, 0xf7, 0xaa, 0x0f, 0x6e, 0xad, 0xab, 0x8a,
-	0xd7, 0xc3, 0x8a, 0xd7, 0xbb, 0x54, 0xb1, 0x11, 0xe2, 0xf1, 0xf7, 0xa0, 0x7c, 0x62, 0x3b, 0xe2,
-	0x91, 0xb8, 0x08, 0xea, 0xb9, 0x2b, 0x69, 0x36, 0xb2, 0xf5, 0x8c, 0x11, 0x21, 0xf3, 0x4d, 0x58,
-	0x11, 0xe7, 0xd2, 0x37, 0x0d, 0xe1, 0x98, 0xd2, 0xf6, 0xdc, 0xa0, 0x9e, 0xa7, 0x16, 0xde, 0x9a,
-	0x6a, 0x61, 0xf8, 0x9d, 0xc8, 0xa7, 0x48, 0xf8, 0x5d, 0xa8, 0x7a, 0xc7, 0xdf, 0x15, 0x7d, 0xd9,
-	0xbb, 0x18, 0x8b, 0xa0, 0x5e, 0xb8, 0x9b, 0xbb, 0x57, 0x31, 0x92, 0x20, 0xfe, 0x0d, 0xa8, 0xf6,
-	0x3d, 0xc7, 0x11, 0x7d, 0x55, 0x47, 0xf1, 0xea, 0x6e, 0x25, 0x71, 0xf9, 0x3b, 0x70, 0xc3, 0x17,
-	0x23, 0xef, 0x4c, 0x58, 0x9b, 0x11, 0x94, 0xfa, 0x59, 0xa6, 0x6a, 0xe6, 0x7f, 0xe4, 0x2d, 0xa8,
-	0xf9, 0xba, 0x7d, 0xbb, 0xb6, 0x7b, 0x1a, 0xd4, 0x4b, 0xd4, 0xad, 0x2f, 0x5d, 0xd2, 0x2d, 0xc4,
-	0x31, 0xd2, 0x14, 0x9c, 0x41, 0xee, 0x54, 0x5c, 0xd4, 0x2b, 0x77, 0x33, 0xf7, 0x2a, 0x06, 0xfe,
-	0xe4, 0x1f, 0x40, 0xdd, 0xf3, 0xed, 0x81, 0xed, 0x9a, 0xce, 0xa6, 0x2f, 0x4c, 0x29, 0xac, 0x9e,
-	0x3d, 0x12, 0x81, 0x34, 0x47, 0xe3, 0x3a, 0xdc, 0xcd, 0xdc, 0xcb, 0x19, 0x97, 0x7e, 0xe7, 0x6f,
-	0xab, 0x19, 0xea, 0xb8, 0x27, 0x5e, 0xbd, 0xaa, 0xbb, 0x9f, 0x6e, 0xcb, 0xb6, 0xfe, 0x6c, 0x44,
-	0x88, 0xcd, 0x9f, 0x64, 0xa1, 0xd8, 0x15, 0xa6, 0xdf, 0x1f, 0x36, 0x7e, 0x25, 0x03, 0x45, 0x43,
-	0x04, 0x13, 0x47, 0xf2, 0x06, 0x94, 0xd5, 0xd8, 0x76, 0xac, 0x7a, 0x86, 0x5a, 0x17, 0x95, 0xbf,
-	0xc8, 0xda, 0x59, 0x87, 0xfc, 0x48, 0x48, 0xb3, 0x9e, 0xa3, 0x11, 0x6a, 0x4c, 0xb5, 0x4a, 0x55,
-	0xbf, 0xbe, 0x27, 0xa4, 0x69, 0x10, 0x5e, 0xe3, 0xc7, 0x19, 0xc8, 0x63, 0x91, 0xdf, 0x86, 0xca,
-	0xd0, 0x1e, 0x0c, 0x1d, 0x7b, 0x30, 0x94, 0xba, 0x21, 0x31, 0x80, 0x7f, 0x04, 0xab, 0x51, 0xc1,
-	0x30, 0xdd, 0x81, 0xc0, 0x16, 0xcd, 0x5b, 0xfc, 0xf4, 0xd1, 0x98, 0x46, 0xe6, 0x75, 0x28, 0xd1,
-	0x7e, 0xe8, 0x58, 0xb4, 0xa2, 0x2b, 0x46, 0x58, 0xc4, 0xe5, 0x16, 0xce, 0xd4, 0x23, 0x71, 0x51,
-	0xcf, 0xd3, 0xd7, 0x24, 0x88, 0xb7, 0x60, 0x35, 0x2c, 0x6e, 0xe9, 0xd1, 0x28, 0x5c, 0x3d, 0x1a,
-	0xd3, 0xf8, 0xcd, 0xdf, 0xdf, 0x83, 0x02, 0x6d, 0x4b, 0xbe, 0x02, 0x59, 0x3b, 0x1c, 0xe8, 0xac,
-	0x6d, 0xf1, 0x37, 0xa0, 0x78, 0x62, 0x0b, 0xc7, 0x7a, 0xee, 0x08, 0x6b, 0x34, 0xde, 0x86, 0x65,
-	0x5f, 0x04, 0xd2, 0xb7, 0xf5, 0xea, 0x57, 0x1b, 0xf4, 0xa7, 0xe7, 0xc9, 0x80, 0x75, 0x23, 0x81,
-	0x68, 0xa4, 0xc8, 0xb0, 0xdb, 0xfd, 0xa1, 0xed, 0x58, 0xbe, 0x70, 0x3b, 0x96, 0xda, 0xa7, 0x15,
-	0x23, 0x09, 0xe2, 0xf7, 0x60, 0xf5, 0xd8, 0xec, 0x9f, 0x0e, 0x7c, 0x6f, 0xe2, 0xe2, 0x86, 0xf0,
-	0x7c, 0xea, 0x76, 0xc5, 0x98, 0x06, 0xf3, 0x37, 0xa1, 0x60, 0x3a, 0xf6, 0xc0, 0xa5, 0x9d, 0xb8,
-	0x32, 0x33, 0xe9, 0xaa, 0x2d, 0x2d, 0xc4, 0x30, 0x14, 0x22, 0xdf, 0x81, 0xda, 0x99, 0xf0, 0xa5,
-	0xdd, 0x37, 0x1d, 0x82, 0xd7, 0x4b, 0x44, 0xd9, 0x9c, 0x4b, 0xf9, 0x24, 0x89, 0x69, 0xa4, 0x09,
-	0x79, 0x07, 0x20, 0x40, 0x31, 0x49, 0xd3, 0xa9, 0xf7, 0xc2, 0xab, 0x73, 0xd9, 0x6c, 0x7a, 0xae,
-	0x14, 0xae, 0x5c, 0xef, 0x46, 0xe8, 0x3b, 0x4b, 0x46, 0x82, 0x98, 0xbf, 0x07, 0x79, 0x29, 0xce,
-	0x65, 0x7d, 0xe5, 0x8a, 0x11, 0x0d, 0x99, 0xf4, 0xc4, 0xb9, 0xdc, 0x59, 0x32, 0x88, 0x00, 0x09,
-	0x71, 0x93, 0xd5, 0x57, 0x17, 0x20, 0xc4, 0x7d, 0x89, 0x84, 0x48, 0xc0, 0x3f, 0x84, 0xa2, 0x63,
-	0x5e, 0x78, 0x13, 0x59, 0x67, 0x44, 0xfa, 0xe5, 0x2b, 0x49, 0x77, 0x09, 0x75, 0x67, 0xc9, 0xd0,
-	0x44, 0xfc, 0x1d, 0xc8, 0x59, 0xf6, 0x59, 0x7d, 0x8d, 0x68, 0xef, 0x5e, 0x49, 0xbb, 0x65, 0x9f,
-	0xed, 0x2c, 0x19, 0x88, 0xce, 0x37, 0xa1, 0x7c, 0xec, 0x79, 0xa7, 0x23, 0xd3, 0x3f, 0xad, 0x73,
-	0x22, 0xfd, 0xca, 0x95, 0xa4, 0x1b, 0x1a, 0x79, 0x67, 0xc9, 0x88, 0x08, 0xb1, 0xcb, 0x76, 0xdf,
-	0x73, 0xeb, 0xd7, 0x16, 0xe8, 0x72, 0xa7, 0xef, 0xb9, 0xd8, 0x65, 0x24, 0x40, 0x42, 0xc7, 0x76,
-	0x4f, 0xeb, 0xd7, 0x17, 0x20, 0x44, 0xc9, 0x89, 0x84, 0x48, 0x80, 0xcd, 0xb6, 0x4c, 0x69, 0x9e,
-	0xd9, 0xe2, 0x59, 0xfd, 0xc6, 0x02, 0xcd, 0xde, 0xd2, 0xc8, 0xd8, 0xec, 0x90, 0x10, 0x99, 0x84,
-	0x5b, 0xb3, 0x7e, 0x73, 0x01, 0x26, 0xa1, 0x44, 0x47, 0x26, 0x21, 0x21, 0xff, 0xd3, 0xb0, 0x76,
-	0x22, 0x4c, 0x39, 0xf1, 0x85, 0x15, 0x1f, 0x74, 0xb7, 0x88, 0xdb, 0xfa, 0xd5, 0x73, 0x3f, 0x4d,
-	0xb5, 0xb3, 0x64, 0xcc, 0xb2, 0xe2, 0x1f, 0x40, 0xc1, 0x31, 0xa5, 0x38, 0xaf, 0xd7, 0x89, 0x67,
-	0xf3, 0x39, 0x8b, 0x42, 0x8a, 0xf3, 0x9d, 0x25, 0x43, 0x91, 0xf0, 0xef, 0xc0, 0xaa, 0x34, 0x8f,
-	0x1d, 0x71, 0x70, 0xa2, 0x11, 0x82, 0xfa, 0x4b, 0xc4, 0xe5, 0xf5, 0xab, 0x97, 0x73, 0x9a, 0x66,
-	0x67, 0xc9, 0x98, 0x66, 0x83, 0xad, 0x22, 0x50, 0xbd, 0xb1, 0x40, 0xab, 0x88, 0x1f, 0xb6, 0x8a,
-	0x48, 0xf8, 0x2e, 0x54, 0xe9, 0xc7, 0xa6, 0xe7, 0x4c, 0x46, 0x6e, 0xfd, 0x4b, 0xc4, 0xe1, 0xde,
-	0xf3, 0x39, 0x28, 0xfc, 0x9d, 0x25, 0x23, 0x49, 0x8e, 0x93, 0x48, 0x45, 0xc3, 0x7b, 0x56, 0xbf,
-	0xbd, 0xc0, 0x24, 0xf6, 0x34, 0x32, 0x4e, 0x62, 0x48, 0x88, 0x5b, 0xef, 0x99, 0x6d, 0x0d, 0x84,
-	0xac, 0xff, 0xd4, 0x02, 0x5b, 0xef, 0x29, 0xa1, 0xe2, 0xd6, 0x53, 0x44, 0xb8, 0x8c, 0xfb, 0x43,
-	0x53, 0xd6, 0xef, 0x2c, 0xb0, 0x8c, 0x37, 0x87, 0x26, 0xc9, 0x0a, 0x24, 0x68, 0x7c, 0x0e, 0xcb,
-	0x49, 0xa9, 0xcc, 0x39, 0xe4, 0x7d, 0x61, 0xaa, 0x13, 0xa1, 0x6c, 0xd0, 0x6f, 0x84, 0x09, 0xcb,
-	0x96, 0x74, 0x22, 0x94, 0x0d, 0xfa, 0xcd, 0x6f, 0x42, 0x51, 0xe9, 0x26, 0x24, 0xf0, 0xcb, 0x86,
-	0x2e, 0x21, 0xae, 0xe5, 0x9b, 0x03, 0x3a, 0xb7, 0xca, 0x06, 0xfd, 0x46, 0x5c, 0xcb, 0xf7, 0xc6,
-	0x07, 0x2e, 0x09, 0xec, 0xb2, 0xa1, 0x4b, 0x8d, 0x7f, 0xff, 0x11, 0x94, 0x74, 0xa3, 0x1a, 0x7f,
-	0x37, 0x03, 0x45, 0x25, 0x50, 0xf8, 0xb7, 0xa0, 0x10, 0xc8, 0x0b, 0x47, 0x50, 0x1b, 0x56, 0x1e,
-	0xbc, 0xb6, 0x80, 0x10, 0x5a, 0xef, 0x22, 0x81, 0xa1, 0xe8, 0x9a, 0x06, 0x14, 0xa8, 0xcc, 0x4b,
-	0x90, 0x33, 0xbc, 0x67, 0x6c, 0x89, 0x03, 0x14, 0xd5, 0x64, 0xb1, 0x0c, 0x02, 0xb7, 0xec, 0x33,
-	0x96, 0x45, 0xe0, 0x8e, 0x30, 0x2d, 0xe1, 0xb3, 0x1c, 0xaf, 0x41, 0x25, 0x9c, 0x96, 0x80, 0xe5,
-	0x39, 0x83, 0xe5, 0xc4, 0x84, 0x07, 0xac, 0xd0, 0xf8, 0x1f, 0x79, 0xc8, 0xe3, 0xfe, 0xe7, 0x2f,
-	0x43, 0x4d, 0x9a, 0xfe, 0x40, 0x28, 0x45, 0x38, 0x52, 0x52, 0xd2, 0x40, 0xfe, 0x61, 0xd8, 0x87,
-	0x2c, 0xf5, 0xe1, 0xd5, 0xe7, 0xca, 0x95, 0x54, 0x0f, 0x12, 0xa7, 0x70, 0x6e, 0xb1, 0x53, 0x78,
-	0x1b, 0xca, 0x28, 0xce, 0xba, 0xf6, 0xe7, 0x82, 0x86, 0x7e, 0xe5, 0xc1, 0xfd, 0xe7, 0x57, 0xd9,
-	0xd1, 0x14, 0x46, 0x44, 0xcb, 0x3b, 0x50, 0xe9, 0x9b, 0xbe, 0x45, 0x8d, 0xa1, 0xd9, 0x5a, 0x79,
-	0xf0, 0xd5, 0xe7, 0x33, 0xda, 0x0c, 0x49, 0x8c, 0x98, 0x9a, 0x1f, 0x40, 0xd5, 0x12, 0x41, 0xdf,
-	0xb7, 0xc7, 0x24, 0xde, 0xd4, 0x59, 0xfc, 0xb5, 0xe7, 0x33, 0xdb, 0x8a, 0x89, 0x8c, 0x24, 0x07,
-	0xd4, 0xc8, 0xfc, 0x48, 0xbe, 0x95, 0x48, 0x41, 0x88, 0x01, 0xcd, 0xf7, 0xa0, 0x1c, 0xf6, 0x87,
-	0x2f, 0x43, 0x19, 0xff, 0xee, 0x7b, 0xae, 0x60, 0x4b, 0x38, 0xb7, 0x58, 0xea, 0x8e, 0x4c, 0xc7,
-	0x61, 0x19, 0xbe, 0x02, 0x80, 0xc5, 0x3d, 0x61, 0xd9, 0x93, 0x11, 0xcb, 0x36, 0x7f, 0x26, 0x5c,
-	0x2d, 0x65, 0xc8, 0x1f, 0x9a, 0x03, 0xa4, 0x58, 0x86, 0x72, 0x28, 0xae, 0x59, 0x06, 0xe9, 0xb7,
-	0xcc, 0x60, 0x78, 0xec, 0x99, 0xbe, 0xc5, 0xb2, 0xbc, 0x0a, 0xa5, 0x96, 0xdf, 0x1f, 0xda, 0x67,
-	0x82, 0xe5, 0x9a, 0x6f, 0x40, 0x35, 0xd1, 0x5e, 0x64, 0xa1, 0x2b, 0xad, 0x40, 0xa1, 0x65, 0x59,
-	0xc2, 0x62, 0x19, 0x24, 0xd0, 0x1d, 0x64, 0xd9, 0xe6, 0x57, 0xa1, 0x12, 0x8d, 0x16, 0xa2, 0xe3,
-	0xc1, 0xcd, 0x96, 0xf0, 0x17, 0x82, 0x59, 0x06, 0x57, 0x65, 0xc7, 0x75, 0x6c, 0x57, 0xb0, 0x6c,
-	0xe3, 0xe7, 0x69, 0xa9, 0xf2, 0x6f, 0xa6, 0x37, 0xc4, 0x2b, 0xcf, 0x3b, 0x59, 0xd3, 0xbb, 0xe1,
-	0x4b, 0x89, 0xfe, 0xed, 0xda, 0xd4, 0xb8, 0x32, 0xe4, 0xb7, 0x3c, 0x19, 0xb0, 0x4c, 0xe3, 0xbf,
-	0x66, 0xa1, 0x1c, 0x1e, 0xa8, 0x68, 0x13, 0x4c, 0x7c, 0x47, 0x2f, 0x68, 0xfc, 0xc9, 0xaf, 0x43,
-	0x41, 0xda, 0x52, 0x2f, 0xe3, 0x8a, 0xa1, 0x0a, 0xa8, 0xab, 0x25, 0x67, 0x56, 0x29, 0xb0, 0xd3,
-	0x53, 0x65, 0x8f, 0xcc, 0x81, 0xd8, 0x31, 0x83, 0xa1, 0x56, 0x61, 0x63, 0x00, 0xd2, 0x9f, 0x98,
-	0x67, 0xb8, 0xe6, 0xe8, 0xbb, 0xd2, 0xe2, 0x92, 0x20, 0xfe, 0x36, 0xe4, 0xb1, 0x83, 0x7a, 0xd1,
-	0xfc, 0xa9, 0xa9, 0x0e, 0xe3, 0x32, 0x39, 0xf4, 0x05, 0x4e, 0xcf, 0x3a, 0x5a, 0x60, 0x06, 0x21,
-	0xf3, 0x57, 0x60, 0x45, 0x6d, 0xc2, 0x83, 0xd0, 0x7e, 0x28, 0x11, 0xe7, 0x29, 0x28, 0x6f, 0xe1,
-	0x70, 0x9a, 0x52, 0xd4, 0xcb, 0x0b, 0xac, 0xef, 0x70, 0x70, 0xd6, 0xbb, 0x48, 0x62, 0x28, 0xca,
-	0xe6, 0xbb, 0x38, 0xa6, 0xa6, 0x14, 0x38, 0xcd, 0xed, 0xd1, 0x58, 0x5e, 0xa8, 0x45, 0xb3, 0x2d,
-	0x64, 0x7f, 0x68, 0xbb, 0x03, 0x96, 0x51, 0x43, 0x8c, 0x93, 0x48, 0x28, 0xbe, 0xef, 0xf9, 0x2c,
-	0xd7, 0x68, 0x40, 0x1e, 0xd7, 0x28, 0x0a, 0x49, 0xd7, 0x1c, 0x09, 0x3d, 0xd2, 0xf4, 0xbb, 0x71,
-	0x0d, 0xd6, 0x66, 0xce, 0xe3, 0xc6, 0xef, 0x14, 0xd5, 0x0a, 0x41, 0x0a, 0xd2, 0x05, 0x35, 0x05,
-	0xa9, 0x79, 0x2f, 0x24, 0x63, 0x90, 0x4b, 0x5a, 0xc6, 0x7c, 0x08, 0x05, 0xec, 0x58, 0x28, 0x62,
-	0x16, 0x20, 0xdf, 0x43, 0x74, 0x43, 0x51, 0xa1, 0x05, 0xd3, 0x1f, 0x8a, 0xfe, 0xa9, 0xb0, 0xb4,
-	0xac, 0x0f, 0x8b, 0xb8, 0x68, 0xfa, 0x09, 0xf5, 0x5c, 0x15, 0x68, 0x49, 0xf4, 0x3d, 0xb7, 0x3d,
-	0xf2, 0xbe, 0x6b, 0xd3, 0xbc, 0xe2, 0x92, 0x08, 0x01, 0xe1, 0xd7, 0x0e, 0xae, 0x11, 0x3d, 0x6d,
-	0x31, 0xa0, 0xd1, 0x86, 0x02, 0xd5, 0x8d, 0x3b, 0x41, 0xb5, 0x59, 0x79, 0x1a, 0x5e, 0x59, 0xac,
-	0xcd, 0xba, 0xc9, 0x8d, 0xdf, 0xcc, 0x42, 0x1e, 0xcb, 0xfc, 0x3e, 0x14, 0x7c, 0xb4, 0xc3, 0x68,
-	0x38, 0x2f, 0xb3, 0xd9, 0x14, 0x0a, 0xff, 0x96, 0x5e, 0x8a, 0xd9, 0x05, 0x16, 0x4b, 0x54, 0x63,
-	0x72, 0x59, 0x5e, 0x87, 0xc2, 0xd8, 0xf4, 0xcd, 0x91, 0xde, 0x27, 0xaa, 0xd0, 0xfc, 0x7e, 0x06,
-	0xf2, 0x88, 0xc4, 0xd7, 0xa0, 0xd6, 0x95, 0xbe, 0x7d, 0x2a, 0xe4, 0xd0, 0xf7, 0x26, 0x83, 0xa1,
-	0x5a, 0x49, 0x8f, 0xc4, 0x85, 0x92, 0x37, 0x4a, 0x20, 0x48, 0xd3, 0xb1, 0xfb, 0x2c, 0x8b, 0xab,
-	0x6a, 0xc3, 0x73, 0x2c, 0x96, 0xe3, 0xab, 0x50, 0x7d, 0xec, 0x5a, 0xc2, 0x0f, 0xfa, 0x9e, 0x2f,
-	0x2c, 0x96, 0xd7, 0xbb, 0xfb, 0x94, 0x15, 0xe8, 0x2c, 0x13, 0xe7, 0x92, 0x6c, 0x21, 0x56, 0xe4,
-	0xd7, 0x60, 0x75, 0x23, 0x6d, 0x20, 0xb1, 0x12, 0xca, 0xa4, 0x3d, 0xe1, 0xe2, 0x22, 0x63, 0x65,
-	0xb5, 0x88, 0xbd, 0xef, 0xda, 0xac, 0x82, 0x95, 0xa9, 0x7d, 0xc2, 0xa0, 0xf9, 0xcf, 0x33, 0xa1,
-	0xe4, 0xa8, 0x41, 0xe5, 0xd0, 0xf4, 0xcd, 0x81, 0x6f, 0x8e, 0xb1, 0x7d, 0x55, 0x28, 0xa9, 0x83,
-	0xf3, 0x2d, 0x25, 0xdd, 0x54, 0xe1, 0x81, 0x92, 0x8d, 0xaa, 0xf0, 0x36, 0xcb, 0xc5, 0x85, 0x77,
-	0x58, 0x1e, 0xeb, 0xf8, 0x64, 0xe2, 0x49, 0xc1, 0x0a, 0x24, 0xeb, 0x3c, 0x4b, 0xb0, 0x22, 0x02,
-	0x7b, 0x28, 0x51, 0x58, 0x09, 0xfb, 0xbc, 0x89, 0xeb, 0xe7, 0xd8, 0x3b, 0x67, 0x65, 0x6c, 0x06,
-	0x0e, 0xa3, 0xb0, 0x58, 0x05, 0xbf, 0xec, 0x4f, 0x46, 0xc7, 0x02, 0xbb, 0x09, 0xf8, 0xa5, 0xe7,
-	0x0d, 0x06, 0x8e, 0x60, 0x55, 0x1c, 0x83, 0x84, 0xf0, 0x65, 0xcb, 0x24, 0x69, 0x4d, 0xc7, 0xf1,
-	0x26, 0x92, 0xd5, 0x1a, 0x3f, 0xc9, 0x41, 0x1e, 0xad, 0x1b, 0xdc, 0x3b, 0x43, 0x94, 0x33, 0x7a,
-	0xef, 0xe0, 0xef, 0x68, 0x07, 0x66, 0xe3, 0x1d, 0xc8, 0x3f, 0xd0, 0x33, 0x9d, 0x5b, 0x40, 0xca,
-	0x22, 0xe3, 0xe4, 0x24, 0x73, 0xc8, 0x8f, 0xec, 0x91, 0xd0, 0xb2, 0x8e, 0x7e, 0x23, 0x2c, 0xc0,
-	0xf3, 0xb8, 0x40, 0xce, 0x13, 0xfa, 0x8d, 0xbb, 0xc6, 0xc4, 0x63, 0xa1, 0x25, 0x69, 0x0f, 0xe4,
-	0x8c, 0xb0, 0x38, 0x47, 0x7a, 0x55, 0xe6, 0x4a, 0xaf, 0x0f, 0x43, 0xe9, 0x55, 0x5a, 0x60, 0xd7,
-	0x53, 0x33, 0x93, 0x92, 0x2b, 0x16, 0x1a, 0xe5, 0xc5, 0xc9, 0x13, 0x87, 0xc9, 0x96, 0x5e, 0xb5,
-	0xf1, 0x41, 0x57, 0x56, 0xa3, 0xcc, 0x32, 0x38, 0x9b, 0xb4, 0x5d, 0x95, 0xcc, 0x7b, 0x62, 0x5b,
-	0xc2, 0x63, 0x39, 0x3a, 0x08, 0x27, 0x96, 0xed, 0xb1, 0x3c, 0x6a, 0x5e, 0x87, 0x5b, 0xdb, 0xac,
-	0xd0, 0x7c, 0x25, 0x71, 0x24, 0xb5, 0x26, 0xd2, 0x53, 0x6c, 0x68, 0xf9, 0x66, 0xd4, 0x6a, 0x3c,
-	0x16, 0x16, 0xcb, 0x36, 0xbf, 0x3e, 0x47, 0xcc, 0xd6, 0xa0, 0xf2, 0x78, 0xec, 0x78, 0xa6, 0x75,
-	0x85, 0x9c, 0x5d, 0x06, 0x88, 0xad, 0xea, 0xc6, 0xbf, 0xfb, 0x72, 0x7c, 0x9c, 0xa3, 0x2e, 0x1a,
-	0x78, 0x13, 0xbf, 0x2f, 0x48, 0x84, 0x54, 0x0c, 0x5d, 0xe2, 0xdf, 0x86, 0x02, 0x7e, 0x0f, 0xdd,
-	0x38, 0xf7, 0x17, 0xb2, 0xe5, 0xd6, 0x9f, 0xd8, 0xe2, 0x99, 0xa1, 0x08, 0xf9, 0x1d, 0x00, 0xb3,
-	0x2f, 0xed, 0x33, 0x81, 0x40, 0xbd, 0xd9, 0x13, 0x10, 0xfe, 0x6e, 0x52, 0x7d, 0xb9, 0xda, 0x0f,
-	0x99, 0xd0, 0x6b, 0xb8, 0x01, 0x55, 0xdc, 0xba, 0xe3, 0x03, 0x1f, 0x77, 0x7b, 0x7d, 0x99, 0x08,
-	0xdf, 0x5c, 0xac, 0x79, 0x0f, 0x23, 0x42, 0x23, 0xc9, 0x84, 0x3f, 0x86, 0x65, 0xe5, 0x53, 0xd3,
-	0x4c, 0x6b, 0xc4, 0xf4, 0xad, 0xc5, 0x98, 0x1e, 0xc4, 0x94, 0x46, 0x8a, 0xcd, 0xac, 0x5b, 0xb2,
-	0xf0, 0xc2, 0x6e, 0xc9, 0x57, 0x60, 0xa5, 0x97, 0xde, 0x05, 0xea, 0xa8, 0x98, 0x82, 0xf2, 0x26,
-	0x2c, 0xdb, 0x41, 0xec, 0x15, 0x25, 0x1f, 0x49, 0xd9, 0x48, 0xc1, 0x1a, 0xff, 0xab, 0x08, 0x79,
-	0x1a, 0xf9, 0x69, 0x1f, 0xd7, 0x66, 0x4a, 0xa4, 0xbf, 0xb1, 0xf8, 0x54, 0x4f, 0xed, 0x78, 0x92,
-	0x20, 0xb9, 0x84, 0x04, 0xf9, 0x36, 0x14, 0x02, 0xcf, 0x97, 0xe1, 0xf4, 0x2e, 0xb8, 0x88, 0xba,
-	0x9e, 0x2f, 0x0d, 0x45, 0xc8, 0xb7, 0xa1, 0x74, 0x62, 0x3b, 0x12, 0x27, 0x45, 0x0d, 0xde, 0xeb,
-	0x8b, 0xf1, 0xd8, 0x26, 0x22, 0x23, 0x24, 0xe6, 0xbb, 0xc9, 0xc5, 0x56, 0x24, 0x4e, 0xeb, 0x8b,
-	0x71, 0x9a, 0xb7, 0x06, 0xef, 0x03, 0xeb, 0x7b, 0x67, 0xc2, 0x37, 0x12, 0x8e, 0x49, 0x75, 0x48,
-	0xcf, 0xc0, 0x79, 0x03, 0xca, 0x43, 0xdb, 0x12, 0xa8, 0xe7, 0x90, 0x8c, 0x29, 0x1b, 0x51, 0x99,
-	0x3f, 0x82, 0x32, 0xd9, 0x07, 0x28, 0x15, 0x2b, 0x2f, 0x3c, 0xf8, 0xca, 0x54, 0x09, 0x19, 0x60,
-	0x45, 0x54, 0xf9, 0xb6, 0x2d, 0xc9, 0x3f, 0x5d, 0x36, 0xa2, 0x32, 0x36, 0x98, 0xd6, 0x7b, 0xb2,
-	0xc1, 0x55, 0xd5, 0xe0, 0x69, 0x38, 0x7f, 0x07, 0x6e, 0x10, 0x6c, 0xea, 0x90, 0xc4, 0xad, 0x86,
-	0x4c, 0xe7, 0x7f, 0x44, 0x85, 0x65, 0x6c, 0x0e, 0xc4, 0xae, 0x3d, 0xb2, 0x65, 0xbd, 0x76, 0x37,
-	0x73, 0xaf, 0x60, 0xc4, 0x00, 0xfe, 0x3a, 0xac, 0x59, 0xe2, 0xc4, 0x9c, 0x38, 0xb2, 0x27, 0x46,
-	0x63, 0xc7, 0x94, 0xa2, 0x63, 0xd1, 0x1a, 0xad, 0x18, 0xb3, 0x1f, 0xf8, 0x9b, 0x70, 0x4d, 0x03,
-	0x0f, 0xa2, 0xa8, 0x42, 0xc7, 0x22, 0xf7, 0x5d, 0xc5, 0x98, 0xf7, 0x09, 0xb7, 0x89, 0x70, 0xad,
-	0x64, 0xef, 0x98, 0xda, 0x26, 0x69, 0x68, 0x73, 0x4f, 0x8b, 0x6b, 0x3c, 0x68, 0xd1, 0x9e, 0x0d,
-	0x05, 0x6d, 0x20, 0xd5, 0xc9, 0xfd, 0xd0, 0x74, 0x1c, 0xe1, 0x5f, 0x28, 0x63, 0xf8, 0x91, 0xe9,
-	0x1e, 0x9b, 0x2e, 0xcb, 0xd1, 0x59, 0x6c, 0x3a, 0xc2, 0xb5, 0x4c, 0x5f, 0x9d, 0xdc, 0x0f, 0xe9,
-	0xe0, 0x2f, 0x34, 0xef, 0x41, 0x9e, 0x86, 0xbe, 0x02, 0x05, 0x65, 0x4d, 0x91, 0x65, 0xad, 0x2d,
-	0x29, 0x92, 0xdc, 0xbb, 0xb8, 0x4d, 0x59, 0xb6, 0xf1, 0xf7, 0x8a, 0x50, 0x0e, 0x1b, 0x12, 0xc6,
-	0x1a, 0x32, 0x71, 0xac, 0x01, 0xd5, 0xbd, 0xe0, 0x89, 0x1d, 0xd8, 0xc7, 0x5a, 0x7d, 0x2d, 0x1b,
-	0x31, 0x00, 0x35, 0xa6, 0x67, 0xb6, 0x25, 0x87, 0xb4, 0xb7, 0x0a, 0x86, 0x2a, 0xf0, 0x7b, 0xb0,
-	0x6a, 0xe1, 0x78, 0xb9, 0x7d, 0x67, 0x62, 0x89, 0x1e, 0x9e, 0xb6, 0xca, 0x9d, 0x30, 0x0d, 0xe6,
-	0x9f, 0x02, 0x48, 0x7b, 0x24, 0xb6, 0x3d, 0x7f, 0x64, 0x4a, 0x6d, 0x43, 0x7c, 0xe3, 0xc5, 0x56,
-	0xff, 0x7a, 0x2f, 0x62, 0x60, 0x24, 0x98, 0x21, 0x6b, 0xac, 0x4d, 0xb3, 0x2e, 0x7d, 0x21, 0xd6,
-	0x5b, 0x11, 0x03, 0x23, 0xc1, 0x8c, 0xf7, 0xa0, 0x74, 0xe2, 0xf9, 0xa3, 0x89, 0x63, 0xea, 0xb3,
-	0xf9, 0x83, 0x17, 0xe4, 0xbb, 0xad, 0xa8, 0x49, 0x46, 0x85, 0xac, 0x62, 0x5f, 0x78, 0x65, 0x41,
-	0x5f, 0x78, 0xf3, 0x67, 0x01, 0xe2, 0x16, 0xf2, 0x9b, 0xc0, 0xf7, 0x3c, 0x57, 0x0e, 0x5b, 0xc7,
-	0xc7, 0xfe, 0x86, 0x38, 0xf1, 0x7c, 0xb1, 0x65, 0xe2, 0x31, 0x7c, 0x03, 0xd6, 0x22, 0x78, 0xeb,
-	0x44, 0x0a, 0x1f, 0xc1, 0xb4, 0x04, 0xba, 0x43, 0xcf, 0x97, 0x4a, 0x17, 0xa4, 0x9f, 0x8f, 0xbb,
-	0x2c, 0x87, 0x47, 0x7f, 0xa7, 0x7b, 0xc0, 0xf2, 0xcd, 0x7b, 0x00, 0xf1, 0xd0, 0x92, 0xcd, 0x44,
-	0xbf, 0xde, 0x7a, 0xa0, 0x2d, 0x28, 0x2a, 0x3d, 0x78, 0x87, 0x65, 0x9a, 0x3f, 0xca, 0x40, 0x35,
-	0xd1, 0xa5, 0xb4, 0x6d, 0xbd, 0xe9, 0x4d, 0x5c, 0xa9, 0x8c, 0x79, 0xfa, 0xf9, 0xc4, 0x74, 0x26,
-	0xa8, 0x04, 0xac, 0x41, 0x8d, 0xca, 0x5b, 0x76, 0x20, 0x6d, 0xb7, 0x2f, 0x59, 0x2e, 0x42, 0x51,
-	0x0a, 0x44, 0x3e, 0x42, 0xd9, 0xf7, 0x34, 0xa8, 0xc0, 0x19, 0x2c, 0x1f, 0x0a, 0xbf, 0x2f, 0x42,
-	0x24, 0x52, 0x9a, 0x35, 0x24, 0x42, 0x53, 0x4a, 0xb3, 0x29, 0x87, 0xdd, 0xc9, 0x88, 0x95, 0x51,
-	0xf9, 0xc4, 0x42, 0xeb, 0x4c, 0xf8, 0xa8, 0xf3, 0x54, 0xb0, 0x1e, 0x04, 0xe0, 0x6e, 0x30, 0x5d,
-	0x06, 0x21, 0xf6, 0x9e, 0xed, 0xb2, 0x6a, 0x54, 0x30, 0xcf, 0xd9, 0x32, 0xb6, 0x9f, 0x4c, 0x0c,
-	0x56, 0x6b, 0xfc, 0x97, 0x1c, 0xe4, 0x51, 0xfe, 0xa3, 0x4d, 0x9c, 0xdc, 0xce, 0x6a, 0xaf, 0x24,
-	0x41, 0x5f, 0xec, 0xd4, 0x42, 0xde, 0xc9, 0x53, 0xeb, 0x7d, 0xa8, 0xf6, 0x27, 0x81, 0xf4, 0x46,
-	0x74, 0x64, 0xeb, 0xa8, 0xd8, 0xcd, 0x19, 0xef, 0x12, 0x0d, 0xa7, 0x91, 0x44, 0xe5, 0xef, 0x42,
-	0xf1, 0x44, 0xad, 0x7a, 0xe5, 0x5f, 0xfa, 0xa9, 0x4b, 0x4e, 0x75, 0xbd, 0xb2, 0x35, 0x32, 0xf6,
-	0xcb, 0x9e, 0xd9, 0xb1, 0x49, 0x90, 0x3e, 0x9d, 0x8b, 0xd1, 0xe9, 0xfc, 0xb3, 0xb0, 0x22, 0x70,
-	0xc0, 0x0f, 0x1d, 0xb3, 0x2f, 0x46, 0xc2, 0x0d, 0xb7, 0xd9, 0x3b, 0x2f, 0xd0, 0x63, 0x9a, 0x31,
-	0xea, 0xf6, 0x14, 0x2f, 0x94, 0x3c, 0xae, 0x87, 0x4a, 0x42, 0xe8, 0x00, 0x28, 0x1b, 0x31, 0xa0,
-	0xf9, 0x15, 0x2d, 0x2f, 0x4b, 0x90, 0x6b, 0x05, 0x7d, 0xed, 0x29, 0x11, 0x41, 0x5f, 0x99, 0x61,
-	0x9b, 0x34, 0x1c, 0x2c, 0xdb, 0x7c, 0x0b, 0x2a, 0x51, 0x0d, 0xb8, 0x78, 0xf6, 0x3d, 0xd9, 0x1d,
-	0x8b, 0xbe, 0x7d, 0x62, 0x0b, 0x4b, 0xad, 0xcf, 0xae, 0x34, 0x7d, 0xa9, 0x9c, 0x8d, 0x6d, 0xd7,
-	0x62, 0xd9, 0xc6, 0xef, 0x96, 0xa1, 0xa8, 0x0e, 0x69, 0xdd, 0xe1, 0x4a, 0xd4, 0xe1, 0x4f, 0xa0,
-	0xec, 0x8d, 0x85, 0x6f, 0x4a, 0xcf, 0xd7, 0x1e, 0x9e, 0x77, 0x5f, 0xe4, 0xd0, 0x5f, 0x3f, 0xd0,
-	0xc4, 0x46, 0xc4, 0x66, 0x7a, 0x35, 0x65, 0x67, 0x57, 0xd3, 0x7d, 0x60, 0xe1, 0xf9, 0x7e, 0xe8,
-	0x23, 0x9d, 0xbc, 0xd0, 0xf6, 0xfa, 0x0c, 0x9c, 0xf7, 0xa0, 0xd2, 0xf7, 0x5c, 0xcb, 0x8e, 0xbc,
-	0x3d, 0x2b, 0x0f, 0xbe, 0xfe, 0x42, 0x2d, 0xdc, 0x0c, 0xa9, 0x8d, 0x98, 0x11, 0x7f, 0x1d, 0x0a,
-	0x67, 0xb8, 0xcc, 0x68, 0x3d, 0x5d, 0xbe, 0x08, 0x15, 0x12, 0xff, 0x0c, 0xaa, 0xbf, 0x30, 0xb1,
-	0xfb, 0xa7, 0x07, 0x49, 0x6f, 0xe2, 0xfb, 0x2f, 0xd4, 0x8a, 0x4f, 0x62, 0x7a, 0x23, 0xc9, 0x2c,
-	0xb1, 0xb4, 0x4b, 0x7f, 0x84, 0xa5, 0x5d, 0x9e, 0x5d, 0xda, 0x06, 0xd4, 0x5c, 0x11, 0x48, 0x61,
-	0x6d, 0x6b, 0x9d, 0x0e, 0xbe, 0x80, 0x4e, 0x97, 0x66, 0xd1, 0xfc, 0x32, 0x94, 0xc3, 0x09, 0xe7,
-	0x45, 0xc8, 0xee, 0xa3, 0xf1, 0x54, 0x84, 0xec, 0x81, 0xaf, 0x56, 0x5b, 0x0b, 0x57, 0x5b, 0xf3,
-	0x0f, 0x32, 0x50, 0x89, 0x06, 0x3d, 0x2d, 0x39, 0xdb, 0xbf, 0x30, 0x31, 0x1d, 0x96, 0x21, 0xb3,
-	0xda, 0x93, 0xaa, 0x44, 0xc2, 0xfa, 0x21, 0x05, 0xf5, 0x7d, 0x96, 0x23, 0x15, 0x41, 0x04, 0x01,
-	0xcb, 0x73, 0x0e, 0x2b, 0x1a, 0x7c, 0xe0, 0x2b, 0xd4, 0x02, 0x0a, 0x3e, 0xfc, 0x1a, 0x02, 0x8a,
-	0x4a, 0xa3, 0x38, 0x15, 0x4a, 0x40, 0xee, 0x7b, 0x92, 0x0a, 0x65, 0x6c, 0x54, 0xc7, 0x65, 0x15,
-	0xac, 0x73, 0xdf, 0x93, 0x1d, 0x14, 0x89, 0x91, 0x19, 0x57, 0x0d, 0xab, 0xa7, 0x12, 0x49, 0xc4,
-	0x96, 0xe3, 0x74, 0x5c, 0x56, 0xd3, 0x1f, 0x54, 0x69, 0x05, 0x39, 0xb6, 0xcf, 0xcd, 0x3e, 0x92,
-	0xaf, 0xa2, 0x84, 0x45, 0x1a, 0x5d, 0x66, 0xb8, 0x25, 0xdb, 0xe7, 0x76, 0x20, 0x03, 0xb6, 0xd6,
-	0xfc, 0x49, 0x06, 0xaa, 0x89, 0x09, 0x46, 0x33, 0x91, 0x10, 0xf1, 0x28, 0x53, 0x56, 0xe3, 0xa7,
-	0x38, 0x8c, 0xbe, 0x15, 0x1e, 0x53, 0x3d, 0x0f, 0x7f, 0x66, 0xb1, 0xbe, 0x9e, 0x37, 0xf2, 0x7c,
-	0xdf, 0x7b, 0xa6, 0x54, 0x9f, 0x5d, 0x33, 0x90, 0x4f, 0x85, 0x38, 0x65, 0x79, 0xec, 0xea, 0xe6,
-	0xc4, 0xf7, 0x85, 0xab, 0x00, 0x05, 0x6a, 0x9c, 0x38, 0x57, 0xa5, 0x22, 0x32, 0x45, 0x64, 0x3a,
-	0x07, 0x59, 0x09, 0x05, 0x81, 0xc6, 0x56, 0x90, 0x32, 0x22, 0x20, 0xba, 0x2a, 0x56, 0xf0, 0x50,
-	0x51, 0x9e, 0x8c, 0x83, 0x93, 0x2d, 0xf3, 0x22, 0x68, 0x0d, 0x3c, 0x06, 0xd3, 0xc0, 0x7d, 0xef,
-	0x99, 0x1a, 0x1d, 0xe4, 0xfc, 0xa9, 0x30, 0x7d, 0xb6, 0x9c, 0x68, 0x06, 0x01, 0x6a, 0x61, 0x33,
-	0xa8, 0xb4, 0xd2, 0x98, 0x00, 0xc4, 0x86, 0x1e, 0x1a, 0xb8, 0xb8, 0x7a, 0xa2, 0xc0, 0x84, 0x2e,
-	0xf1, 0x03, 0x00, 0xfc, 0x45, 0x98, 0xa1, 0x95, 0xfb, 0x02, 0xda, 0x37, 0xd1, 0x19, 0x09, 0x16,
-	0x8d, 0x3f, 0x0b, 0x95, 0xe8, 0x03, 0xaf, 0x43, 0x89, 0xf4, 0xe4, 0xa8, 0xda, 0xb0, 0x88, 0xca,
-	0x9c, 0xed, 0x5a, 0xe2, 0x9c, 0x84, 0x50, 0xc1, 0x50, 0x05, 0x6c, 0xe5, 0xd0, 0xb6, 0x2c, 0xe1,
-	0x86, 0xe1, 0x23, 0x55, 0x9a, 0x17, 0xe4, 0xcf, 0xcf, 0x0d, 0xf2, 0x37, 0x7e, 0x0e, 0xaa, 0x09,
-	0x4b, 0xf4, 0xd2, 0x6e, 0x27, 0x1a, 0x96, 0x4d, 0x37, 0xec, 0x36, 0x54, 0xc2, 0xc4, 0x92, 0x80,
-	0x0e, 0xc2, 0x8a, 0x11, 0x03, 0x1a, 0xff, 0x38, 0x8b, 0x6a, 0x2f, 0x76, 0x6d, 0xda, 0x7a, 0xdc,
-	0x86, 0x62, 0x20, 0x4d, 0x39, 0x09, 0x33, 0x24, 0x16, 0xdc, 0xcd, 0x5d, 0xa2, 0xd9, 0x59, 0x32,
-	0x34, 0x35, 0xff, 0x10, 0x72, 0xd2, 0x1c, 0x68, 0xef, 0xeb, 0x6b, 0x8b, 0x31, 0xe9, 0x99, 0x83,
-	0x9d, 0x25, 0x03, 0xe9, 0xf8, 0x2e, 0x94, 0xfb, 0xda, 0x61, 0xa6, 0x25, 0xe8, 0x82, 0x06, 0x5e,
-	0xe8, 0x66, 0xdb, 0x59, 0x32, 0x22, 0x0e, 0xfc, 0xdb, 0x90, 0x47, 0x55, 0x54, 0x27, 0x92, 0x2c,
-	0x68, 0xb8, 0xe2, 0xde, 0xda, 0x59, 0x32, 0x88, 0x72, 0xa3, 0x04, 0x05, 0x12, 0xd8, 0x8d, 0x3a,
-	0x14, 0x55, 0x5f, 0xa7, 0x47, 0xae, 0x71, 0x0b, 0x72, 0x3d, 0x73, 0x80, 0xe6, 0x80, 0x6d, 0x05,
-	0xda, 0xff, 0x82, 0x3f, 0x1b, 0x2f, 0xc7, 0xce, 0xbf, 0xa4, 0x5f, 0x39, 0x93, 0xf2, 0x2b, 0x37,
-	0x8a, 0x90, 0xc7, 0x1a, 0x1b, 0xb7, 0xaf, 0x32, 0x2d, 0x1a, 0xff, 0x3a, 0x87, 0x56, 0x88, 0x14,
-	0xe7, 0x73, 0x7d, 0xe6, 0x1f, 0x43, 0x65, 0xec, 0x7b, 0x7d, 0x11, 0x04, 0x9e, 0xaf, 0x35, 0xa9,
-	0xd7, 0x9f, 0x1f, 0xcf, 0x5e, 0x3f, 0x0c, 0x69, 0x8c, 0x98, 0xbc, 0xf9, 0x1f, 0xb3, 0x50, 0x89,
-	0x3e, 0x28, 0xe3, 0x47, 0x8a, 0x73, 0xe5, 0x1f, 0xdd, 0x13, 0xfe, 0xc8, 0xb4, 0x2d, 0x25, 0x6a,
-	0x36, 0x87, 0x66, 0xa8, 0x11, 0x7f, 0xea, 0x4d, 0xe4, 0xe4, 0x58, 0x28, 0xbf, 0xd8, 0x13, 0x7b,
-	0x24, 0x3c, 0x96, 0xa7, 0x88, 0x14, 0x2e, 0xec, 0xbe, 0xe3, 0x4d, 0x2c, 0x56, 0xc0, 0xf2, 0x43,
-	0x3a, 0x0b, 0xf7, 0xcc, 0x71, 0xa0, 0x04, 0xec, 0x9e, 0xed, 0x7b, 0xac, 0x84, 0x44, 0xdb, 0xf6,
-	0x60, 0x64, 0xb2, 0x32, 0x32, 0xeb, 0x3d, 0xb3, 0x25, 0x4a, 0xec, 0x0a, 0xea, 0xb4, 0x07, 0x63,
-	0xe1, 0x76, 0xa5, 0x2f, 0x84, 0xdc, 0x33, 0xc7, 0xca, 0x51, 0x6a, 0x08, 0xcb, 0xb2, 0xa5, 0x12,
-	0x27, 0xdb, 0x66, 0x5f, 0x1c, 0x7b, 0xde, 0x29, 0x5b, 0x46, 0xa9, 0xd4, 0x71, 0x03, 0x69, 0x0e,
-	0x7c, 0x73, 0xa4, 0x84, 0x49, 0x4f, 0x38, 0x82, 0x4a, 0x2b, 0x54, 0xb7, 0x2d, 0x87, 0x93, 0xe3,
-	0x87, 0x68, 0x24, 0xae, 0xaa, 0xe0, 0x95, 0x25, 0xc6, 0x02, 0x05, 0xee, 0x32, 0x94, 0x37, 0x6c,
-	0xc7, 0x3e, 0xb6, 0x1d, 0x9b, 0xad, 0x21, 0x6a, 0xfb, 0xbc, 0x6f, 0x3a, 0xb6, 0xe5, 0x9b, 0xcf,
-	0x18, 0xc7, 0xc6, 0x3d, 0xf2, 0xbd, 0x53, 0x9b, 0x5d, 0x43, 0x44, 0xb2, 0x19, 0xcf, 0xec, 0xcf,
-	0xd9, 0x75, 0x0a, 0xc0, 0x9d, 0x0a, 0xd9, 0x1f, 0x9e, 0x98, 0xc7, 0xec, 0x46, 0xec, 0x27, 0xbc,
-	0x89, 0x8d, 0xdc, 0xf2, 0xcd, 0x67, 0xb6, 0xc7, 0x6e, 0x91, 0xbd, 0x30, 0xf6, 0xa4, 0x7d, 0x72,
-	0xc1, 0xea, 0x8d, 0x35, 0x58, 0x9d, 0xca, 0x01, 0x68, 0x94, 0xb4, 0x0d, 0xdb, 0xa8, 0x41, 0x35,
-	0x11, 0x9c, 0x6d, 0xbc, 0x02, 0xe5, 0x30, 0x74, 0xcb, 0x1b, 0x50, 0xb6, 0x03, 0xe5, 0x74, 0xd6,
-	0xab, 0x27, 0x2a, 0x37, 0xfe, 0x53, 0x06, 0x8a, 0x2a, 0x6e, 0xce, 0x37, 0xa2, 0x3c, 0x97, 0xcc,
-	0x02, 0xb1, 0x52, 0x45, 0xa4, 0x23, 0xcd, 0x51, 0xb2, 0xcb, 0x75, 0x28, 0x38, 0x64, 0xfc, 0x6b,
-	0xb9, 0x46, 0x85, 0x84, 0x18, 0xca, 0xa5, 0xc4, 0xd0, 0x6d, 0xa8, 0x98, 0x13, 0xe9, 0x51, 0x48,
-	0x50, 0xc7, 0x4b, 0x62, 0x40, 0xb3, 0x15, 0xc5, 0xbe, 0x43, 0x37, 0x28, 0x69, 0x9e, 0x3d, 0x5f,
-	0x08, 0xe5, 0xe2, 0x24, 0x8b, 0x3d, 0x4b, 0x07, 0x81, 0x37, 0x1a, 0x9b, 0x7d, 0x49, 0x00, 0x3a,
-	0xa9, 0x51, 0x06, 0xb3, 0x3c, 0x6e, 0x8e, 0xcd, 0xa1, 0x29, 0x9b, 0x27, 0x50, 0x3e, 0xf4, 0x82,
-	0xe9, 0x73, 0xbf, 0x04, 0xb9, 0x9e, 0x37, 0x56, 0x5a, 0xec, 0x86, 0x27, 0x49, 0x8b, 0x55, 0xc7,
-	0xfc, 0x89, 0x54, 0x6b, 0xd1, 0xb0, 0x07, 0x43, 0xa9, 0xac, 0xfd, 0x8e, 0xeb, 0x0a, 0x9f, 0x15,
-	0x70, 0x42, 0x0c, 0x31, 0x46, 0xcd, 0x99, 0x15, 0x71, 0xb2, 0x09, 0xbe, 0x6d, 0xfb, 0x81, 0x64,
-	0xa5, 0x66, 0x07, 0x4f, 0x6c, 0x7b, 0x40, 0x07, 0x2d, 0xfd, 0x20, 0x56, 0x4b, 0xd8, 0x44, 0x2a,
-	0x6e, 0x0a, 0x17, 0x97, 0x26, 0x59, 0x68, 0xca, 0xbc, 0xa4, 0x0a, 0xb2, 0x78, 0x4a, 0x52, 0xf9,
-	0xe3, 0x49, 0x40, 0x73, 0x9d, 0x6b, 0x3e, 0x85, 0x5a, 0x2a, 0xa5, 0x8a, 0x5f, 0x07, 0x96, 0x02,
-	0x60, 0xd3, 0x97, 0xf8, 0x2d, 0xb8, 0x96, 0x82, 0xee, 0xd9, 0x96, 0x45, 0x7e, 0xe7, 0xe9, 0x0f,
-	0x61, 0x07, 0x37, 0x2a, 0x50, 0xea, 0xab, 0x39, 0x6c, 0x1e, 0x42, 0x8d, 0x26, 0x75, 0x4f, 0x48,
-	0xf3, 0xc0, 0x75, 0x2e, 0xfe, 0xc8, 0x79, 0x6f, 0xcd, 0xaf, 0x6a, 0x23, 0x0e, 0xc5, 0xcc, 0x89,
-	0xef, 0x8d, 0x88, 0x57, 0xc1, 0xa0, 0xdf, 0xc8, 0x5d, 0x7a, 0x7a, 0x65, 0x64, 0xa5, 0xd7, 0xfc,
-	0x2b, 0xcb, 0x50, 0x6a, 0xf5, 0xfb, 0x68, 0x76, 0xce, 0xd4, 0xfc, 0x2e, 0x14, 0xfb, 0x9e, 0x7b,
-	0x62, 0x0f, 0xb4, 0x18, 0x9f, 0xd6, 0x3e, 0x35, 0x1d, 0x2e, 0xc7, 0x13, 0x7b, 0x60, 0x68, 0x64,
-	0x24, 0xd3, 0xc7, 0x50, 0xe1, 0x4a, 0x32, 0x25, 0x8b, 0xa3, 0x53, 0xe7, 0x0d, 0xc8, 0xdb, 0xee,
-	0x89, 0xa7, 0x93, 0x54, 0xbf, 0x74, 0x09, 0x11, 0x65, 0x6a, 0x12, 0x62, 0xe3, 0xf7, 0x32, 0x50,
-	0x54, 0x55, 0x2b, 0xaf, 0x13, 0x6e, 0xb5, 0xf0, 0x04, 0xd0, 0x7b, 0x6c, 0x0a, 0x8a, 0x8a, 0xb1,
-	0x86, 0x88, 0xe3, 0xc9, 0x40, 0xfb, 0x77, 0x92, 0x20, 0xfe, 0x3e, 0xdc, 0x52, 0xc5, 0x43, 0x5f,
-	0xf8, 0xc2, 0x11, 0x66, 0x20, 0x36, 0x87, 0xa6, 0xeb, 0x0a, 0x47, 0xeb, 0x03, 0x97, 0x7d, 0xe6,
-	0x4d, 0x58, 0x56, 0x9f, 0xba, 0x63, 0xb3, 0x2f, 0x02, 0xbd, 0x97, 0x52, 0x30, 0xfe, 0x35, 0x28,
-	0x50, 0x0e, 0x6f, 0xdd, 0xba, 0x7a, 0x2a, 0x15, 0x56, 0xc3, 0x8b, 0x0e, 0xac, 0x16, 0x80, 0x1a,
-	0x26, 0x34, 0xec, 0xb4, 0x6c, 0xf8, 0xe9, 0x2b, 0xc7, 0x95, 0x6c, 0xcc, 0x04, 0x11, 0xb6, 0xcf,
-	0x12, 0x8e, 0xa0, 0x64, 0x4b, 0x3c, 0x50, 0xb3, 0x14, 0xe5, 0x49, 0xc1, 0x1a, 0xff, 0x27, 0x0f,
-	0x79, 0x1c, 0x61, 0x44, 0x1e, 0x7a, 0x23, 0x11, 0xf9, 0xba, 0x95, 0x86, 0x92, 0x82, 0xa1, 0x46,
-	0x64, 0xaa, 0x74, 0x83, 0x08, 0x4d, 0x89, 0x96, 0x69, 0x30, 0x62, 0x8e, 0x7d, 0xef, 0xc4, 0x76,
-	0x62, 0x4c, 0xad, 0x3b, 0x4d, 0x81, 0xf9, 0xd7, 0xe1, 0xe6, 0xc8, 0xf4, 0x4f, 0x85, 0xa4, 0xdd,
-	0xfd, 0xd4, 0xf3, 0x4f, 0x03, 0x1c, 0xb9, 0x8e, 0xa5, 0x9d, 0xa4, 0x97, 0x7c, 0xe5, 0xaf, 0xc3,
-	0xda, 0xb3, 0xb0, 0x18, 0xd5, 0xa1, 0xdc, 0x94, 0xb3, 0x1f, 0x70, 0x19, 0x10, 0x00, 0xe5, 0x52,
-	0xc7, 0xd2, 0x1e, 0xca, 0x24, 0x08, 0xc5, 0xb5, 0x25, 0xce, 0x6c, 0xaa, 0xb9, 0xac, 0x72, 0x7d,
-	0xc3, 0x32, 0x2e, 0x36, 0x53, 0x0d, 0x75, 0x57, 0xb7, 0x4d, 0xc7, 0xc3, 0xd2, 0x50, 0x94, 0xac,
-	0x2a, 0x07, 0x2a, 0xe8, 0x58, 0xe4, 0x07, 0xae, 0x18, 0x31, 0x20, 0x6a, 0xc3, 0x13, 0x25, 0x94,
-	0x6b, 0x89, 0x36, 0x28, 0x10, 0x62, 0x48, 0xd1, 0x1f, 0x86, 0x95, 0x28, 0x27, 0x6d, 0x12, 0xc4,
-	0xef, 0x00, 0x0c, 0x4c, 0x29, 0x9e, 0x99, 0x17, 0x8f, 0x7d, 0xa7, 0x2e, 0x54, 0x60, 0x27, 0x86,
-	0xa0, 0x29, 0xed, 0x78, 0x7d, 0xd3, 0xe9, 0x4a, 0xcf, 0x37, 0x07, 0xe2, 0xd0, 0x94, 0xc3, 0xfa,
-	0x40, 0x99, 0xd2, 0xd3, 0x70, 0xec, 0xb1, 0xb4, 0x47, 0xe2, 0x33, 0xcf, 0x15, 0xf5, 0xa1, 0xea,
-	0x71, 0x58, 0xc6, 0x96, 0x98, 0xae, 0xe9, 0x5c, 0x48, 0xbb, 0x8f, 0x7d, 0xb1, 0x55, 0x4b, 0x12,
-	0x20, 0x72, 0x5e, 0x08, 0x89, 0x23, 0xdd, 0xb1, 0xea, 0xdf, 0x55, 0x7d, 0x8d, 0x00, 0x38, 0xff,
-	0x42, 0x0e, 0x85, 0x2f, 0x26, 0xa3, 0x96, 0x65, 0xf9, 0x22, 0x08, 0xea, 0xa7, 0x6a, 0xfe, 0xa7,
-	0xc0, 0x8d, 0x7f, 0x98, 0xa5, 0xb8, 0xdb, 0xb0, 0xf1, 0xdf, 0x32, 0x50, 0x6a, 0x8d, 0xc7, 0xb4,
-	0x18, 0xeb, 0x50, 0x32, 0xc7, 0xe3, 0x9d, 0x38, 0x52, 0x1a, 0x16, 0xf5, 0x97, 0xfd, 0x38, 0x5e,
-	0x1a, 0x16, 0xf1, 0xb8, 0x33, 0xc7, 0xe3, 0x38, 0x4f, 0x59, 0x97, 0xb0, 0xa1, 0x7d, 0x95, 0x23,
-	0xde, 0x92, 0x3a, 0xfe, 0x19, 0x03, 0x70, 0x10, 0xc4, 0xf9, 0xd8, 0xf6, 0x45, 0x14, 0x05, 0x8d,
-	0xca, 0x94, 0xfc, 0xd5, 0xf7, 0xc6, 0x61, 0x78, 0xf3, 0xb5, 0x4b, 0x76, 0x1f, 0xb6, 0x7e, 0x7d,
-	0x17, 0x47, 0xb7, 0x35, 0xb6, 0xbb, 0x48, 0x60, 0x28, 0x3a, 0xa5, 0x02, 0xb4, 0x28, 0xec, 0x16,
-	0xc6, 0x1f, 0xc2, 0x72, 0xf3, 0x6d, 0xa8, 0xa5, 0x68, 0xf0, 0x88, 0x23, 0x87, 0x3d, 0xb9, 0x6d,
-	0xaa, 0x50, 0xfa, 0x38, 0xf0, 0xdc, 0xd6, 0x61, 0x47, 0x1d, 0xba, 0xdb, 0x13, 0xc7, 0x61, 0xd9,
-	0xe6, 0x01, 0x40, 0xbc, 0xd7, 0xf1, 0x00, 0x55, 0xcc, 0xd8, 0x92, 0x72, 0x12, 0xba, 0x96, 0xed,
-	0x0e, 0xb6, 0xf4, 0xf6, 0x66, 0x19, 0x04, 0x92, 0xf3, 0x47, 0x58, 0x11, 0x90, 0x34, 0x3f, 0x2a,
-	0x09, 0x8b, 0xe5, 0x9a, 0xff, 0x3b, 0x03, 0xd5, 0x44, 0x0a, 0xcb, 0x1f, 0x63, 0xda, 0x0d, 0xf6,
-	0x1d, 0x35, 0x2b, 0x5c, 0xa7, 0x6a, 0x42, 0xa2, 0x32, 0xae, 0x62, 0x9d, 0x61, 0x83, 0x5f, 0x95,
-	0xab, 0x27, 0x01, 0xf9, 0x42, 0x29, 0x37, 0xcd, 0x07, 0xda, 0x5f, 0x56, 0x85, 0xd2, 0x63, 0xf7,
-	0xd4, 0xf5, 0x9e, 0xb9, 0x4a, 0x73, 0xa1, 0x3c, 0xaa, 0x54, 0x44, 0x38, 0x4c, 0x75, 0xca, 0x35,
-	0xff, 0x59, 0x7e, 0x2a, 0xe5, 0xb0, 0x0d, 0x45, 0x65, 0x77, 0x91, 0x49, 0x30, 0x9b, 0x23, 0x96,
-	0x44, 0xd6, 0xd1, 0xc7, 0x04, 0xc8, 0xd0, 0xc4, 0x68, 0x10, 0x45, 0x09, 0xb9, 0xd9, 0xb9, 0x51,
-	0xd2, 0x14, 0xa3, 0xf0, 0xb4, 0x4a, 0xe5, 0xa4, 0x47, 0x1c, 0x1a, 0x7f, 0x29, 0x03, 0xd7, 0xe7,
-	0xa1, 0x24, 0x33, 0xf7, 0x33, 0xe9, 0xcc, 0xfd, 0xee, 0x54, 0x26, 0x7c, 0x96, 0x7a, 0xf3, 0xc6,
-	0x0b, 0x36, 0x22, 0x9d, 0x17, 0xdf, 0xfc, 0x9d, 0x0c, 0xac, 0xcd, 0xf4, 0x39, 0xa1, 0xd9, 0xa1,
-	0x06, 0x4d, 0x2b, 0x4b, 0x25, 0xaa, 0x45, 0xa9, 0x43, 0x2a, 0xa4, 0x43, 0x3a, 0x4f, 0xa0, 0x72,
-	0x31, 0x74, 0xee, 0xbf, 0xb2, 0x37, 0x70, 0xd6, 0xf0, 0x48, 0x1d, 0x08, 0xe5, 0xfe, 0x56, 0xea,
-	0xa7, 0x86, 0x14, 0x95, 0x4d, 0xa0, 0xe2, 0x53, 0xac, 0x44, 0x09, 0x70, 0x93, 0xb1, 0x63, 0xf7,
-	0xb1, 0x58, 0xe6, 0x0d, 0xb8, 0xa9, 0x2e, 0x80, 0x68, 0xfb, 0xfb, 0xa4, 0x37, 0xb4, 0x69, 0x73,
-	0xb0, 0x0a, 0xd6, 0x73, 0x38, 0x39, 0x76, 0xec, 0x60, 0xc8, 0xa0, 0x69, 0xc0, 0xb5, 0x39, 0x1d,
-	0xa4, 0x26, 0x3f, 0xd1, 0xcd, 0x5f, 0x01, 0xd8, 0x7a, 0x12, 0x36, 0x9a, 0x65, 0x38, 0x87, 0x95,
-	0xad, 0x27, 0x49, 0xee, 0x7a, 0xf3, 0x3c, 0x41, 0x69, 0x1d, 0xb0, 0x5c, 0xf3, 0x97, 0x33, 0x61,
-	0x86, 0x4a, 0xe3, 0xcf, 0x40, 0x4d, 0x35, 0xf8, 0xd0, 0xbc, 0x70, 0x3c, 0xd3, 0xe2, 0x6d, 0x58,
-	0x09, 0xa2, 0x2b, 0x4a, 0x89, 0x23, 0x7c, 0x5a, 0x35, 0xea, 0xa6, 0x90, 0x8c, 0x29, 0xa2, 0xd0,
-	0xa6, 0xcc, 0xc6, 0xe1, 0x2a, 0x4e, 0xd6, 0xb1, 0x49, 0x5b, 0x6e, 0x99, 0xec, 0x5d, 0xb3, 0xf9,
-	0x35, 0x58, 0xeb, 0xc6, 0xc7, 0x9d, 0xb2, 0x31, 0x70, 0x71, 0xa8, 0xb3, 0x72, 0x2b, 0x5c, 0x1c,
-	0xba, 0xd8, 0xfc, 0xbd, 0x12, 0x40, 0x1c, 0xc2, 0x9b, 0xb3, 0xe7, 0xe7, 0x65, 0xa4, 0xcc, 0x04,
-	0xd4, 0x73, 0x2f, 0x1c, 0x50, 0x7f, 0x3f, 0x32, 0x75, 0x94, 0xdb, 0x7e, 0x3a, 0x2d, 0x3f, 0x6e,
-	0xd3, 0xb4, 0x81, 0x93, 0x4a, 0xd8, 0x2a, 0x4c, 0x27, 0x6c, 0xdd, 0x9d, 0xcd, 0xee, 0x9c, 0x12,
-	0x46, 0xb1, 0x8b, 0xa7, 0x94, 0x72, 0xf1, 0x34, 0xa0, 0xec, 0x0b, 0xd3, 0xf2, 0x5c, 0xe7, 0x22,
-	0x8c, 0xdb, 0x86, 0x65, 0xfe, 0x36, 0x14, 0x24, 0xdd, 0xb2, 0x2a, 0xd3, 0xde, 0x79, 0xce, 0xc4,
-	0x29, 0x5c, 0x94, 0x6c, 0x76, 0xa0, 0x53, 0x32, 0x95, 0x96, 0x50, 0x36, 0x12, 0x10, 0xbe, 0x0e,
-	0xdc, 0x46, 0x7b, 0xd7, 0x71, 0x84, 0xb5, 0x71, 0xb1, 0xa5, 0xc2, 0xa9, 0xa4, 0xe9, 0x94, 0x8d,
-	0x39, 0x5f, 0xc2, 0xf9, 0x5f, 0x8e, 0xe7, 0x9f, 0x9a, 0x7c, 0x66, 0x07, 0xd8, 0xd3, 0x9a, 0x3a,
-	0xb0, 0xc2, 0x32, 0xea, 0x52, 0xe1, 0x86, 0x55, 0x63, 0x49, 0xab, 0x37, 0xce, 0x49, 0xb8, 0xe4,
-	0x6b, 0x38, 0xbc, 0xca, 0xc7, 0xb5, 0xaa, 0x8e, 0xc8, 0x08, 0x40, 0x92, 0xbc, 0xef, 0xb9, 0x74,
-	0xe6, 0x32, 0x2d, 0xc9, 0x75, 0x19, 0xfb, 0x3b, 0x76, 0x26, 0xbe, 0xe9, 0xd0, 0xd7, 0x35, 0x25,
-	0xc9, 0x63, 0x48, 0xf3, 0x7f, 0x66, 0x23, 0x73, 0xb2, 0x02, 0x85, 0x63, 0x33, 0xb0, 0xfb, 0xea,
-	0x74, 0xd3, 0x6a, 0xa0, 0x3a, 0xdd, 0xa4, 0x67, 0x79, 0x2c, 0x8b, 0x96, 0x61, 0x20, 0x74, 0x98,
-	0x2c, 0xbe, 0xd3, 0xc6, 0xf2, 0x28, 0x02, 0xc2, 0x95, 0xa4, 0x72, 0xb6, 0x88, 0x94, 0x9c, 0x9e,
-	0x56, 0x94, 0x0d, 0x4b, 0x1e, 0x09, 0x3a, 0x62, 0x58, 0x19, 0x71, 0x5c, 0x4f, 0x0a, 0xe5, 0xf2,
-	0xa5, 0x75, 0xcf, 0x00, 0xd9, 0x84, 0x97, 0x34, 0x58, 0x15, 0x4d, 0xb5, 0x90, 0xa9, 0xf2, 0xd3,
-	0x06, 0x64, 0xc8, 0x2e, 0xe3, 0xbe, 0x4f, 0x7f, 0x60, 0x35, 0x6c, 0x51, 0x7c, 0x55, 0x8e, 0xad,
-	0x20, 0x57, 0x93, 0x32, 0x89, 0x56, 0xf1, 0xe7, 0x19, 0xe5, 0x17, 0x31, 0xac, 0xd5, 0x42, 0xb9,
-	0xb4, 0x86, 0x2d, 0x8b, 0x14, 0x3b, 0xc6, 0xd1, 0x12, 0x1d, 0x9b, 0x68, 0x16, 0xda, 0x63, 0xd3,
-	0x95, 0xec, 0x1a, 0x76, 0x75, 0x6c, 0x9d, 0xb0, 0xeb, 0x48, 0xd2, 0x1f, 0x9a, 0x92, 0xdd, 0x40,
-	0x1c, 0xfc, 0xb5, 0x25, 0x7c, 0x5c, 0x29, 0xec, 0x26, 0xe2, 0x48, 0x73, 0xc0, 0x6e, 0xa1, 0x4c,
-	0x74, 0x3d, 0x69, 0x9f, 0xa0, 0xd0, 0xc3, 0xea, 0xeb, 0x7c, 0x0d, 0x6a, 0x23, 0x3b, 0x08, 0x6c,
-	0x77, 0xa0, 0x25, 0xd3, 0x4b, 0x38, 0xa6, 0x4a, 0x5f, 0x0d, 0x58, 0xa3, 0xf9, 0x6b, 0x71, 0x06,
-	0xfb, 0x9b, 0x91, 0x89, 0xb7, 0xc8, 0x86, 0x43, 0x23, 0x70, 0xde, 0xee, 0x6f, 0xc3, 0x9a, 0x2f,
-	0x7e, 0x61, 0x62, 0xa7, 0xee, 0x75, 0xe4, 0xae, 0x4e, 0x1c, 0x9a, 0xa5, 0x68, 0x9e, 0xc1, 0x5a,
-	0x58, 0x78, 0x6a, 0xcb, 0x21, 0x39, 0xe9, 0xf8, 0xdb, 0x89, 0x8b, 0x27, 0x99, 0xb9, 0x17, 0xf6,
-	0x22, 0x96, 0xf1, 0x45, 0x93, 0x28, 0x62, 0x93, 0x5d, 0x20, 0x62, 0xd3, 0xfc, 0x3b, 0xa5, 0x84,
-	0x9f, 0x4e, 0x19, 0xbd, 0x56, 0x64, 0xf4, 0xce, 0xa6, 0x04, 0xc4, 0x41, 0x98, 0xec, 0x8b, 0x04,
-	0x61, 0xe6, 0xa5, 0xe1, 0x7c, 0x80, 0x36, 0x18, 0xed, 0xe5, 0x27, 0x0b, 0x04, 0x98, 0x52, 0xb8,
-	0x7c, 0x83, 0x02, 0xfc, 0x66, 0x57, 0xe5, 0x88, 0x15, 0xe6, 0x5e, 0x03, 0x4b, 0x46, 0xf2, 0x35,
-	0xa6, 0x91, 0xa0, 0x4a, 0x48, 0xbe, 0xe2, 0x3c, 0xc9, 0x77, 0x80, 0x92, 0xaf, 0x14, 0x4b, 0x3e,
-	0xf2, 0x47, 0x50, 0x3c, 0x4e, 0xfd, 0x0e, 0xd9, 0x93, 0x54, 0x28, 0x1b, 0x33, 0x70, 0x54, 0x0f,
-	0x47, 0x13, 0x47, 0xda, 0x5a, 0xbf, 0x55, 0x85, 0xe9, 0x7b, 0xaa, 0x95, 0xd9, 0x7b, 0xaa, 0x1f,
-	0x01, 0x04, 0x02, 0xf7, 0xd3, 0x96, 0xdd, 0x97, 0x3a, 0x93, 0xec, 0xce, 0x65, 0x7d, 0xd3, 0x81,
-	0xb2, 0x04, 0x05, 0xb6, 0x7f, 0x64, 0x9e, 0x53, 0xf0, 0x5c, 0xa7, 0xbc, 0x44, 0xe5, 0xe9, 0xf3,
-	0x60, 0x65, 0xf6, 0x3c, 0x78, 0x3b, 0xd4, 0xec, 0xaf, 0x5f, 0x39, 0xbf, 0xeb, 0x29, 0x6d, 0xbe,
-	0x0e, 0x25, 0xb2, 0x1b, 0x3c, 0x9f, 0x2e, 0x59, 0x55, 0x8c, 0xb0, 0x98, 0x92, 0xc9, 0x37, 0xa7,
-	0x64, 0xf2, 0x54, 0x64, 0xee, 0xd6, 0x4c, 0x64, 0xae, 0x61, 0x41, 0x51, 0x07, 0x8a, 0xa6, 0xdd,
-	0x31, 0xa1, 0xd7, 0x38, 0x9b, 0xf0, 0x1a, 0x47, 0x19, 0xcd, 0xb9, 0x64, 0x46, 0xf3, 0xd4, 0x4d,
-	0xcd, 0xc2, 0xcc, 0x4d, 0xcd, 0xe6, 0x67, 0x50, 0x50, 0x76, 0x06, 0x84, 0x2a, 0xae, 0x52, 0x8f,
-	0xb1, 0xdb, 0x2c, 0xc3, 0xaf, 0x03, 0x0b, 0x04, 0xe9, 0x4f, 0xa2, 0x6b, 0x8e, 0x04, 0x09, 0xde,
-	0x2c, 0xaf, 0xc3, 0x75, 0x85, 0x1b, 0xa4, 0xbf, 0x90, 0x12, 0xe7, 0xd8, 0xc7, 0xbe, 0xe9, 0x5f,
-	0xb0, 0x7c, 0xf3, 0x23, 0x4a, 0xd3, 0x08, 0x97, 0x5c, 0x35, 0xba, 0x19, 0xab, 0x44, 0xbd, 0xa5,
-	0x25, 0x1a, 0x65, 0xf9, 0x68, 0x8b, 0x59, 0xe5, 0x48, 0x92, 0x49, 0x4a, 0x5e, 0xb7, 0xe5, 0xa4,
-	0xde, 0xf0, 0xc7, 0xb6, 0x23, 0x9b, 0x1b, 0x09, 0x2d, 0x34, 0x9d, 0xf4, 0x98, 0x59, 0x34, 0xe9,
-	0xb1, 0xf9, 0x08, 0x56, 0x8d, 0xf4, 0x39, 0xc1, 0xdf, 0x87, 0x92, 0x37, 0x4e, 0xf2, 0x79, 0xde,
-	0xca, 0x0d, 0xd1, 0x9b, 0xbf, 0x9d, 0x81, 0xe5, 0x8e, 0x2b, 0x85, 0xef, 0x9a, 0xce, 0xb6, 0x63,
-	0x0e, 0xf8, 0x7b, 0xa1, 0x1c, 0x9b, 0xef, 0xe1, 0x49, 0xe2, 0xa6, 0x45, 0x9a, 0xa3, 0x63, 0x1c,
-	0xfc, 0x06, 0xac, 0x09, 0xcb, 0x96, 0x9e, 0xaf, 0x74, 0xef, 0x30, 0x37, 0xf5, 0x3a, 0x30, 0x05,
-	0xee, 0xd2, 0xa6, 0xe9, 0xa9, 0x69, 0xae, 0xc3, 0xf5, 0x14, 0x34, 0x54, 0xac, 0xb3, 0xfc, 0x36,
-	0xd4, 0xe3, 0x13, 0x6e, 0xcb, 0x73, 0x65, 0xc7, 0xb5, 0xc4, 0x39, 0x29, 0x6e, 0x2c, 0xd7, 0xfc,
-	0xd5, 0x48, 0x65, 0x7c, 0xa2, 0x33, 0x57, 0x7d, 0xcf, 0x8b, 0xaf, 0x45, 0xeb, 0x52, 0xe2, 0xfa,
-	0x7d, 0x76, 0x81, 0xeb, 0xf7, 0x1f, 0xc5, 0x57, 0xa8, 0xd5, 0x51, 0xf2, 0xf2, 0xdc, 0xf3, 0x89,
-	0x12, 0xee, 0xb4, 0xc5, 0xd0, 0x15, 0x89, 0xfb, 0xd4, 0x6f, 0x69, 0x33, 0x31, 0xbf, 0x88, 0x66,
-	0xad, 0x72, 0x4e, 0xde, 0x9d, 0xbe, 0xb7, 0xb3, 0x58, 0xe2, 0xeb, 0x8c, 0xf2, 0x0b, 0x2f, 0xac,
-	0xfc, 0x7e, 0x6b, 0xca, 0x22, 0x2b, 0xcf, 0x75, 0x7a, 0x5e, 0x71, 0x2b, 0xf9, 0x5b, 0x50, 0x1a,
-	0xda, 0x81, 0xf4, 0x7c, 0x75, 0x53, 0x7e, 0xf6, 0x66, 0x5f, 0x62, 0xb4, 0x76, 0x14, 0x22, 0x65,
-	0x29, 0x86, 0x54, 0xfc, 0x3b, 0xb0, 0x46, 0x03, 0x7f, 0x18, 0x6b, 0x22, 0x41, 0xbd, 0x3a, 0x37,
-	0x3b, 0x34, 0xc1, 0x6a, 0x63, 0x8a, 0xc4, 0x98, 0x65, 0xd2, 0x18, 0x00, 0xc4, 0xf3, 0x33, 0x23,
-	0xc5, 0xbe, 0xc0, 0x4d, 0xf9, 0x9b, 0x50, 0x0c, 0x26, 0xc7, 0x71, 0x30, 0x54, 0x97, 0x1a, 0xe7,
-	0xd0, 0x98, 0xd1, 0x1f, 0x0e, 0x85, 0xaf, 0x9a, 0x7b, 0xe5, 0x75, 0xfd, 0x8f, 0x92, 0x13, 0xaf,
-	0x16, 0xe7, 0xdd, 0x4b, 0x66, 0x2f, 0xe2, 0x9c, 0x58, 0x01, 0x8d, 0x77, 0xa1, 0x9a, 0x18, 0x54,
-	0x94, 0xcc, 0x13, 0xd7, 0xf2, 0x42, 0x47, 0x3b, 0xfe, 0x56, 0xd7, 0x15, 0xad, 0xd0, 0xd5, 0x4e,
-	0xbf, 0x1b, 0x06, 0xb0, 0xe9, 0x01, 0xbc, 0xc2, 0x6a, 0x7f, 0x19, 0x6a, 0x09, 0x35, 0x31, 0x72,
-	0xc2, 0xa6, 0x81, 0xcd, 0x33, 0xf8, 0x52, 0x82, 0xdd, 0xa1, 0xf0, 0x49, 0x15, 0xf4, 0x5c, 0x65,
-	0x80, 0x92, 0xba, 0x6e, 0x09, 0x57, 0xda, 0x32, 0x94, 0xa0, 0x51, 0x99, 0xff, 0x0c, 0x14, 0xc6,
-	0xc2, 0x1f, 0x05, 0x5a, 0x8a, 0x4e, 0xaf, 0xa0, 0xb9, 0x6c, 0x03, 0x43, 0xd1, 0x34, 0xff, 0x41,
-	0x06, 0xca, 0x7b, 0x42, 0x9a, 0xa8, 0x5d, 0xf0, 0xbd, 0xa9, 0x5a, 0x66, 0x03, 0xf8, 0x21, 0xea,
-	0xba, 0x36, 0x89, 0xd7, 0x3b, 0x1a, 0x5f, 0x97, 0x77, 0x96, 0xe2, 0x86, 0x35, 0x36, 0xa0, 0xa4,
-	0xc1, 0x8d, 0xf7, 0x60, 0x75, 0x0a, 0x93, 0xc6, 0x45, 0xd9, 0x0b, 0xdd, 0x8b, 0x51, 0x98, 0x92,
-	0xb6, 0x6c, 0xa4, 0x81, 0x1b, 0x15, 0x28, 0x8d, 0x15, 0x41, 0xf3, 0x5f, 0xdd, 0xa0, 0x44, 0xa8,
-	0x48, 0x65, 0x9e, 0x59, 0x93, 0x77, 0x00, 0x94, 0x0f, 0x90, 0x0e, 0x65, 0xe5, 0x18, 0x4f, 0x40,
-	0xf8, 0x07, 0x51, 0x44, 0x23, 0x3f, 0x57, 0xed, 0x4a, 0x32, 0x9f, 0x0e, 0x6b, 0xd4, 0xa1, 0x64,
-	0x07, 0xe4, 0xdb, 0xd3, 0x29, 0x66, 0x61, 0x91, 0x7f, 0x13, 0x8a, 0xf6, 0x68, 0xec, 0xf9, 0x52,
-	0x87, 0x3c, 0xae, 0xe4, 0xda, 0x21, 0xcc, 0x9d, 0x25, 0x43, 0xd3, 0x20, 0xb5, 0x38, 0x27, 0xea,
-	0xf2, 0xf3, 0xa9, 0xdb, 0xe7, 0x21, 0xb5, 0xa2, 0xe1, 0x9f, 0x40, 0x6d, 0xa0, 0x32, 0x6c, 0x15,
-	0x63, 0x2d, 0x44, 0x5e, 0xbb, 0x8a, 0xc9, 0xc3, 0x24, 0xc1, 0xce, 0x92, 0x91, 0xe6, 0x80, 0x2c,
-	0x51, 0xc5, 0x17, 0x81, 0xec, 0x79, 0x1f, 0x7b, 0xb6, 0x4b, 0x26, 0xf4, 0x73, 0x58, 0x1a, 0x49,
-	0x02, 0x64, 0x99, 0xe2, 0xc0, 0xbf, 0x8e, 0x1a, 0x4f, 0x20, 0xf5, 0x63, 0x05, 0x77, 0xaf, 0xe2,
-	0xd4, 0x13, 0x81, 0x7e, 0x66, 0x20, 0x90, 0xfc, 0x1c, 0x1a, 0x89, 0x4d, 0xa2, 0x2b, 0x69, 0x8d,
-	0xc7, 0xbe, 0x87, 0x76, 0x78, 0x8d, 0xb8, 0x7d, 0xfd, 0x2a, 0x6e, 0x87, 0x97, 0x52, 0xef, 0x2c,
-	0x19, 0x57, 0xf0, 0xe6, 0x3d, 0xb4, 0x16, 0x75, 0x17, 0x76, 0x85, 0x79, 0x16, 0x3e, 0x75, 0x70,
-	0x7f, 0xa1, 0x51, 0x20, 0x8a, 0x9d, 0x25, 0x63, 0x8a, 0x07, 0xff, 0x39, 0x58, 0x4b, 0xd5, 0x49,
-	0xb7, 0x9b, 0xd5, 0x43, 0x08, 0x5f, 0x5b, 0xb8, 0x1b, 0x48, 0xb4, 0xb3, 0x64, 0xcc, 0x72, 0xe2,
-	0x13, 0x78, 0x69, 0xb6, 0x4b, 0x5b, 0xa2, 0xef, 0xd8, 0xae, 0xd0, 0x6f, 0x26, 0xbc, 0xfb, 0x62,
-	0xa3, 0xa5, 0x89, 0x77, 0x96, 0x8c, 0xcb, 0x39, 0xf3, 0x3f, 0x07, 0xb7, 0xc7, 0x73, 0x45, 0x8c,
-	0x12, 0x5d, 0xfa, 0xc9, 0x85, 0xf7, 0x17, 0xac, 0x79, 0x86, 0x7e, 0x67, 0xc9, 0xb8, 0x92, 0x3f,
-	0xea, 0xce, 0x64, 0x95, 0xeb, 0x0b, 0x03, 0xaa, 0x40, 0xf1, 0xf0, 0xbe, 0xb3, 0x23, 0x4c, 0x2b,
-	0x8a, 0xb9, 0xc4, 0x80, 0xc6, 0x7f, 0xcf, 0x40, 0x51, 0xaf, 0xf7, 0xdb, 0x51, 0xc2, 0x46, 0x24,
-	0xba, 0x63, 0x00, 0xff, 0x10, 0x2a, 0xc2, 0xf7, 0x3d, 0x7f, 0xd3, 0xb3, 0xc2, 0xc4, 0xd8, 0x69,
-	0xcf, 0xb5, 0xe2, 0xb3, 0xde, 0x0e, 0xd1, 0x8c, 0x98, 0x82, 0x7f, 0x00, 0xa0, 0xf6, 0x79, 0x2f,
-	0xbe, 0xf7, 0xd5, 0x98, 0x4f, 0xaf, 0x02, 0x7d, 0x31, 0x76, 0xec, 0xea, 0x0b, 0xa3, 0x6c, 0x61,
-	0x31, 0x32, 0x49, 0x0b, 0x09, 0x93, 0xf4, 0xb6, 0xf6, 0x4d, 0x90, 0xcb, 0x46, 0xdf, 0x7e, 0x8c,
-	0x00, 0x8d, 0x7f, 0x99, 0x81, 0xa2, 0x12, 0x1e, 0xbc, 0x3d, 0xdb, 0xa3, 0x57, 0x9f, 0x2f, 0x73,
-	0xd6, 0xa7, 0x7b, 0xf6, 0x4d, 0x00, 0x25, 0x83, 0x12, 0x3d, 0xbb, 0x3d, 0xc5, 0x47, 0x93, 0x86,
-	0xa9, 0xe8, 0x31, 0x7e, 0xf3, 0x81, 0xba, 0xa1, 0x47, 0x6e, 0xe6, 0xc7, 0xbb, 0xbb, 0x6c, 0x89,
-	0xaf, 0x41, 0xed, 0xf1, 0xfe, 0xa3, 0xfd, 0x83, 0xa7, 0xfb, 0x47, 0x6d, 0xc3, 0x38, 0x30, 0x94,
-	0xb7, 0x79, 0xa3, 0xb5, 0x75, 0xd4, 0xd9, 0x3f, 0x7c, 0xdc, 0x63, 0xd9, 0xc6, 0x3f, 0xc9, 0x40,
-	0x2d, 0x25, 0xbb, 0xfe, 0x64, 0xa7, 0x2e, 0x31, 0xfc, 0xb9, 0xf9, 0xc3, 0x9f, 0xbf, 0x6c, 0xf8,
-	0x0b, 0xd3, 0xc3, 0xff, 0x8f, 0x32, 0x50, 0x4b, 0xc9, 0xc8, 0x24, 0xf7, 0x4c, 0x9a, 0x7b, 0xf2,
-	0xa4, 0xcf, 0x4e, 0x9d, 0xf4, 0x4d, 0x58, 0x0e, 0x7f, 0xef, 0xc7, 0x3e, 0x89, 0x14, 0x2c, 0x89,
-	0x43, 0x57, 0x64, 0xf2, 0x69, 0x1c, 0xba, 0x26, 0x73, 0x75, 0x6b, 0xe9, 0x4a, 0x70, 0x40, 0x2f,
-	0x26, 0x34, 0x2e, 0x97, 0xa0, 0x57, 0x74, 0xe1, 0x21, 0x54, 0xc7, 0xf1, 0x36, 0x7d, 0x31, 0xb5,
-	0x24, 0x49, 0xf9, 0x9c, 0x76, 0xfe, 0x66, 0x06, 0x56, 0xd2, 0x32, 0xf7, 0xff, 0xeb, 0x61, 0xfd,
-	0xad, 0x0c, 0xac, 0xcd, 0x48, 0xf2, 0x2b, 0x15, 0xbb, 0xe9, 0x76, 0x65, 0x17, 0x68, 0x57, 0x6e,
-	0x4e, 0xbb, 0x2e, 0x97, 0x24, 0x57, 0xb7, 0xb8, 0x0b, 0x2f, 0x5d, 0x7a, 0x26, 0x5c, 0x31, 0xd4,
-	0x29, 0xa6, 0xb9, 0x69, 0xa6, 0xbf, 0x91, 0x81, 0xdb, 0x57, 0xc9, 0xfb, 0xff, 0xe7, 0xeb, 0x6a,
-	0xba, 0x85, 0xcd, 0xf7, 0xa2, 0x74, 0x8d, 0x2a, 0x94, 0xf4, 0x4b, 0x64, 0x3a, 0xe9, 0x7e, 0xe8,
-	0x3d, 0x73, 0x95, 0x77, 0xdb, 0x10, 0xa6, 0x7e, 0xab, 0xc1, 0x10, 0x63, 0xc7, 0xa6, 0xb8, 0xeb,
-	0x2d, 0x80, 0x16, 0xd9, 0x75, 0xe1, 0x95, 0xa8, 0xcd, 0xdd, 0x83, 0x6e, 0x9b, 0x2d, 0x25, 0x95,
-	0xd8, 0xcf, 0x42, 0x41, 0xdc, 0x3c, 0x84, 0x62, 0x7c, 0x49, 0x65, 0xcf, 0xf4, 0x4f, 0x2d, 0x15,
-	0xdd, 0x5c, 0x86, 0xf2, 0xa1, 0x36, 0xa1, 0x54, 0x55, 0x1f, 0x77, 0x0f, 0xf6, 0x95, 0x23, 0x7d,
-	0xeb, 0xa0, 0xa7, 0xae, 0xba, 0x74, 0x9f, 0x3c, 0x54, 0x61, 0xb6, 0x87, 0x46, 0xeb, 0x70, 0xe7,
-	0x88, 0x30, 0x0a, 0xcd, 0xbf, 0x9f, 0x0f, 0x4f, 0xb5, 0xe6, 0xcf, 0xeb, 0xb8, 0x29, 0x40, 0x11,
-	0xa5, 0xb9, 0xa7, 0x19, 0x47, 0xd5, 0x50, 0x7a, 0x76, 0xfb, 0x5c, 0xf9, 0x21, 0x58, 0x96, 0x17,
-	0x21, 0x7b, 0x78, 0xac, 0xf2, 0xbd, 0x76, 0xe4, 0xc8, 0x51, 0x77, 0x69, 0x7b, 0xe7, 0x92, 0x15,
-	0xf0, 0xc7, 0x66, 0x70, 0xa6, 0x62, 0x76, 0x07, 0xc7, 0x81, 0x4d, 0xb7, 0x4f, 0x4a, 0xcd, 0x7f,
-	0x9a, 0x83, 0x4a, 0x24, 0x38, 0x5f, 0x44, 0x90, 0x73, 0x0e, 0x2b, 0x9d, 0xfd, 0x5e, 0xdb, 0xd8,
-	0x6f, 0xed, 0x6a, 0x94, 0x1c, 0xbf, 0x06, 0xab, 0xdb, 0x9d, 0xdd, 0xf6, 0xd1, 0xee, 0x41, 0x6b,
-	0x4b, 0x03, 0xcb, 0xfc, 0x26, 0xf0, 0xce, 0xde, 0xe1, 0x81, 0xd1, 0x3b, 0xea, 0x74, 0x8f, 0x36,
-	0x5b, 0xfb, 0x9b, 0xed, 0xdd, 0xf6, 0x16, 0x2b, 0xf2, 0x97, 0xe1, 0xee, 0xfe, 0x41, 0xaf, 0x73,
-	0xb0, 0x7f, 0xb4, 0x7f, 0x70, 0x74, 0xb0, 0xf1, 0x71, 0x7b, 0xb3, 0xd7, 0x3d, 0xea, 0xec, 0x1f,
-	0x21, 0xd7, 0x87, 0x46, 0x0b, 0xbf, 0xb0, 0x02, 0xbf, 0x0b, 0xb7, 0x35, 0x56, 0xb7, 0x6d, 0x3c,
-	0x69, 0x1b, 0xc8, 0xe4, 0xf1, 0x7e, 0xeb, 0x49, 0xab, 0xb3, 0xdb, 0xda, 0xd8, 0x6d, 0xb3, 0x65,
-	0x7e, 0x07, 0x1a, 0x1a, 0xc3, 0x68, 0xf5, 0xda, 0x47, 0xbb, 0x9d, 0xbd, 0x4e, 0xef, 0xa8, 0xfd,
-	0x9d, 0xcd, 0x76, 0x7b, 0xab, 0xbd, 0xc5, 0x6a, 0xfc, 0x35, 0xf8, 0x0a, 0x35, 0x4a, 0x37, 0x22,
-	0x5d, 0xd9, 0x67, 0x9d, 0xc3, 0xa3, 0x96, 0xb1, 0xb9, 0xd3, 0x79, 0xd2, 0x66, 0x2b, 0xfc, 0x55,
-	0xf8, 0xf2, 0xe5, 0xa8, 0x5b, 0x1d, 0xa3, 0xbd, 0xd9, 0x3b, 0x30, 0x3e, 0x65, 0x6b, 0xfc, 0xa7,
-	0xe0, 0xa5, 0x9d, 0xde, 0xde, 0xee, 0xd1, 0x53, 0xe3, 0x60, 0xff, 0xe1, 0x11, 0xfd, 0xec, 0xf6,
-	0x8c, 0xc7, 0x9b, 0xbd, 0xc7, 0x46, 0x9b, 0x01, 0x6f, 0xc0, 0xcd, 0xc3, 0x8d, 0xa3, 0xfd, 0x83,
-	0xde, 0x51, 0x6b, 0xff, 0xd3, 0x8d, 0xdd, 0x83, 0xcd, 0x47, 0x47, 0xdb, 0x07, 0xc6, 0x5e, 0xab,
-	0xc7, 0xaa, 0xfc, 0xab, 0xf0, 0xea, 0x66, 0xf7, 0x89, 0x6e, 0xe6, 0xc1, 0xf6, 0x91, 0x71, 0xf0,
-	0xb4, 0x7b, 0x74, 0x60, 0x1c, 0x19, 0xed, 0x5d, 0xea, 0x73, 0x37, 0x6e, 0x7b, 0x89, 0xdf, 0x86,
-	0x7a, 0x67, 0xbf, 0xfb, 0x78, 0x7b, 0xbb, 0xb3, 0xd9, 0x69, 0xef, 0xf7, 0x8e, 0x0e, 0xdb, 0xc6,
-	0x5e, 0xa7, 0xdb, 0x45, 0x34, 0x56, 0x69, 0x7e, 0x1b, 0x8a, 0x1d, 0xf7, 0xcc, 0x96, 0xb4, 0xdb,
-	0xf4, 0xd2, 0xd4, 0xf6, 0x57, 0x58, 0xa4, 0x4d, 0x62, 0x0f, 0x5c, 0x7a, 0x31, 0x82, 0xf6, 0xda,
-	0xb2, 0x11, 0x03, 0x9a, 0xbf, 0x94, 0x83, 0x9a, 0x62, 0x11, 0xda, 0x73, 0xf7, 0x60, 0x55, 0xbb,
-	0x4e, 0x3b, 0x69, 0x81, 0x36, 0x0d, 0xa6, 0xa7, 0xd8, 0x14, 0x28, 0x21, 0xd6, 0x92, 0x20, 0x4a,
-	0xfb, 0xe8, 0x3b, 0x68, 0x14, 0xaa, 0x88, 0xa8, 0x2e, 0x7d, 0x51, 0x49, 0x86, 0x52, 0x52, 0x21,
-	0xf6, 0x3d, 0x77, 0x33, 0xba, 0x0c, 0x94, 0x82, 0xf1, 0xcf, 0xe0, 0x56, 0x54, 0x6e, 0xbb, 0x7d,
-	0xff, 0x62, 0x1c, 0xbd, 0x95, 0x58, 0x9a, 0xeb, 0x5a, 0xd8, 0xb6, 0x1d, 0x91, 0x42, 0x34, 0x2e,
-	0x63, 0xc0, 0xbf, 0x01, 0x60, 0xd3, 0x60, 0x91, 0xb6, 0xa4, 0x6e, 0xdf, 0xbd, 0x34, 0xe3, 0x15,
-	0x0c, 0x11, 0x8c, 0x04, 0x32, 0x1e, 0x10, 0x03, 0x94, 0xbb, 0x8f, 0xf4, 0x63, 0x8a, 0xcb, 0x46,
-	0x54, 0x6e, 0xfe, 0x41, 0x26, 0x61, 0x56, 0x2b, 0xb3, 0xf9, 0xca, 0x03, 0x65, 0x5e, 0x10, 0x08,
-	0x0d, 0x5b, 0x3d, 0x2a, 0x5a, 0xcf, 0xd1, 0x45, 0x7e, 0x08, 0xdc, 0x9e, 0x1d, 0x8b, 0xfc, 0x82,
-	0x63, 0x31, 0x87, 0x76, 0xda, 0x87, 0x5f, 0x98, 0xf5, 0xe1, 0xdf, 0x01, 0x18, 0x38, 0xde, 0xb1,
-	0x0e, 0x3d, 0x16, 0x75, 0x2a, 0x54, 0x04, 0x69, 0x3a, 0x50, 0x0e, 0x1f, 0x7a, 0xc4, 0x45, 0x42,
-	0x4f, 0x3d, 0x46, 0xfe, 0x4a, 0x55, 0xe2, 0x3b, 0xb0, 0x22, 0xd2, 0x6d, 0xce, 0x2e, 0xd8, 0xe6,
-	0x29, 0xba, 0xe6, 0x37, 0x60, 0x6d, 0x06, 0x09, 0x07, 0x71, 0x6c, 0xca, 0xe8, 0xb5, 0x07, 0xfc,
-	0x3d, 0x1b, 0xd1, 0x6f, 0xfe, 0x87, 0x2c, 0x2c, 0xef, 0x99, 0xae, 0x7d, 0x22, 0x02, 0x49, 0xad,
-	0xbd, 0x05, 0xc5, 0xa0, 0x3f, 0x14, 0x23, 0x33, 0x3c, 0xd5, 0x5e, 0x56, 0x45, 0xed, 0xc5, 0xc8,
-	0x26, 0xe3, 0x03, 0x33, 0x01, 0x27, 0xdc, 0x0f, 0x13, 0x39, 0x8c, 0x2e, 0x2b, 0xe8, 0x12, 0x4e,
-	0x9e, 0x63, 0xf7, 0x85, 0x1b, 0x84, 0x6b, 0x3e, 0x2c, 0xc6, 0x19, 0x3e, 0xc5, 0x2b, 0x32, 0x7c,
-	0x4a, 0xb3, 0x13, 0x70, 0x17, 0xaa, 0x41, 0xdf, 0x17, 0xc2, 0x0d, 0x86, 0x9e, 0x0c, 0x5f, 0x09,
-	0x4d, 0x82, 0x28, 0x01, 0xd1, 0x7b, 0xe6, 0xe2, 0x9e, 0xdf, 0xb5, 0xdd, 0x53, 0x9d, 0x35, 0x97,
-	0x82, 0xe1, 0x22, 0x24, 0x1f, 0x8e, 0xfd, 0xb9, 0x20, 0xff, 0x41, 0xc1, 0x88, 0xca, 0xe4, 0xa5,
-	0x31, 0xa5, 0x18, 0x78, 0xbe, 0x2d, 0x94, 0xab, 0xb2, 0x62, 0x24, 0x20, 0x48, 0xeb, 0x98, 0xee,
-	0x60, 0x62, 0x0e, 0x84, 0x0e, 0x91, 0x47, 0xe5, 0xe6, 0xef, 0x17, 0x00, 0xf6, 0xc4, 0xe8, 0x58,
-	0xf8, 0xc1, 0xd0, 0x1e, 0x53, 0x28, 0xc5, 0xd6, 0x99, 0xd8, 0x35, 0x83, 0x7e, 0xf3, 0xf7, 0x53,
-	0xb7, 0x27, 0x66, 0xc3, 0xa3, 0x31, 0xf9, 0xb4, 0x8b, 0x07, 0x07, 0xc7, 0x94, 0x42, 0x27, 0x57,
-	0xd1, 0xf8, 0xe7, 0x8d, 0x24, 0x88, 0xd2, 0x09, 0x4d, 0x29, 0xda, 0xae, 0xa5, 0x5c, 0x48, 0x79,
-	0x23, 0x2a, 0x53, 0x48, 0x28, 0x68, 0x4d, 0xa4, 0x67, 0x08, 0x57, 0x3c, 0x8b, 0xee, 0x21, 0xc6,
-	0x20, 0xbe, 0x07, 0xb5, 0xb1, 0x79, 0x31, 0x12, 0xae, 0xdc, 0x13, 0x72, 0xe8, 0x59, 0x3a, 0x13,
-	0xea, 0xd5, 0xcb, 0x1b, 0x78, 0x98, 0x44, 0x37, 0xd2, 0xd4, 0xb8, 0x26, 0xdc, 0x80, 0xb6, 0x89,
-	0x9a, 0x46, 0x5d, 0xe2, 0x1b, 0x00, 0xea, 0x57, 0x42, 0xd6, 0xcc, 0x78, 0x95, 0xcc, 0x91, 0x08,
-	0x84, 0x7f, 0x66, 0x2b, 0xf9, 0xa8, 0x84, 0x4e, 0x4c, 0x85, 0xd2, 0x74, 0x12, 0x08, 0xbf, 0x3d,
-	0x32, 0x6d, 0x47, 0x4f, 0x70, 0x0c, 0xe0, 0xef, 0xc0, 0x8d, 0x60, 0x72, 0x8c, 0x6b, 0xe6, 0x58,
-	0xf4, 0xbc, 0x7d, 0xf1, 0x2c, 0x70, 0x84, 0x94, 0xc2, 0xd7, 0xd9, 0x16, 0xf3, 0x3f, 0x36, 0x07,
-	0x91, 0x5a, 0x45, 0x2f, 0xd2, 0xe0, 0xaf, 0x38, 0xa5, 0x2b, 0x02, 0xe9, 0x7c, 0x37, 0x96, 0xe1,
-	0x0c, 0x96, 0x15, 0x48, 0xa7, 0xc3, 0x65, 0xf9, 0x57, 0xe0, 0xa7, 0x53, 0x48, 0x86, 0x0a, 0x45,
-	0x07, 0xdb, 0xb6, 0x6b, 0x3a, 0xf6, 0xe7, 0x2a, 0x8e, 0x9e, 0x6b, 0x8e, 0xa1, 0x96, 0x1a, 0x38,
-	0xba, 0x38, 0x4b, 0xbf, 0x74, 0x4e, 0x10, 0x83, 0x65, 0x55, 0xee, 0x4a, 0xdf, 0xa6, 0x08, 0x4a,
-	0x04, 0xd9, 0xc4, 0x8d, 0xee, 0xb1, 0x2c, 0xbf, 0x0e, 0x4c, 0x41, 0x3a, 0xae, 0x39, 0x1e, 0xb7,
-	0xc6, 0x63, 0x47, 0xb0, 0x1c, 0x5d, 0x4a, 0x8e, 0xa1, 0xea, 0x0e, 0x05, 0xcb, 0x37, 0xbf, 0x03,
-	0xb7, 0x68, 0x64, 0x9e, 0x08, 0x3f, 0x32, 0x9c, 0x75, 0x5f, 0x6f, 0xc0, 0x9a, 0xfa, 0xb5, 0xef,
-	0x49, 0xf5, 0x99, 0x94, 0x49, 0x0e, 0x2b, 0x0a, 0x8c, 0xda, 0x53, 0x57, 0xd0, 0x55, 0xe3, 0x08,
-	0x16, 0xe1, 0x65, 0x9b, 0xff, 0xb6, 0x08, 0x3c, 0x5e, 0x10, 0x3d, 0x5b, 0xf8, 0x5b, 0xa6, 0x34,
-	0x13, 0x9e, 0xcf, 0xda, 0xa5, 0xd1, 0xfd, 0xe7, 0x67, 0xf3, 0xdd, 0x84, 0xa2, 0x1d, 0xa0, 0xa9,
-	0xa7, 0x93, 0x9c, 0x75, 0x89, 0xef, 0x02, 0x8c, 0x85, 0x6f, 0x7b, 0x16, 0xad, 0xa0, 0xc2, 0xdc,
-	0x4b, 0x2c, 0xb3, 0x8d, 0x5a, 0x3f, 0x8c, 0x68, 0x8c, 0x04, 0x3d, 0xb6, 0x43, 0x95, 0x54, 0xac,
-	0xbc, 0x48, 0x8d, 0x4e, 0x82, 0xf8, 0x9b, 0x70, 0x6d, 0xec, 0xdb, 0x7d, 0xa1, 0xa6, 0xe3, 0x71,
-	0x60, 0x6d, 0xd2, 0x3b, 0x8e, 0x25, 0xc2, 0x9c, 0xf7, 0x09, 0x57, 0xa0, 0xe9, 0x92, 0x01, 0x14,
-	0x50, 0x74, 0x58, 0x5f, 0xce, 0x57, 0x49, 0xbe, 0x35, 0x63, 0xfe, 0x47, 0x7e, 0x1f, 0x98, 0xfe,
-	0xb0, 0x67, 0xbb, 0xbb, 0xc2, 0x1d, 0xc8, 0x21, 0x2d, 0xee, 0x9a, 0x31, 0x03, 0x27, 0x09, 0xa6,
-	0x5e, 0xcb, 0x52, 0x71, 0xa1, 0x8a, 0x11, 0x95, 0xd5, 0xc3, 0x10, 0x8e, 0xe7, 0x77, 0xa5, 0xaf,
-	0xf3, 0x99, 0xa3, 0x32, 0x6a, 0x41, 0x01, 0xb5, 0xf5, 0xd0, 0xf7, 0xac, 0x09, 0x45, 0x2d, 0x94,
-	0x10, 0x9b, 0x06, 0xc7, 0x98, 0x7b, 0xa6, 0xab, 0x53, 0x2a, 0x6b, 0x49, 0xcc, 0x08, 0x4c, 0x36,
-	0x9e, 0x17, 0xc4, 0x0c, 0x57, 0xb5, 0x8d, 0x97, 0x80, 0x69, 0x9c, 0x98, 0x15, 0x8b, 0x70, 0x62,
-	0x3e, 0xd4, 0x7f, 0xcb, 0xf7, 0x6c, 0x2b, 0xe6, 0xa5, 0xb2, 0x7b, 0x66, 0xe0, 0x09, 0xdc, 0x98,
-	0x27, 0x4f, 0xe1, 0xc6, 0x7c, 0xaf, 0x43, 0xc1, 0x3b, 0x39, 0x11, 0x3e, 0x3d, 0x8e, 0x5a, 0x31,
-	0x54, 0xa1, 0xf9, 0xbd, 0x0c, 0x40, 0xbc, 0x24, 0x70, 0x23, 0xc4, 0xa5, 0x78, 0xe3, 0xdf, 0x82,
-	0x6b, 0x49, 0xb0, 0xa3, 0x93, 0x65, 0x69, 0x37, 0xc4, 0x1f, 0xb6, 0xcc, 0x8b, 0x80, 0x65, 0xf5,
-	0xa5, 0x79, 0x0d, 0x7b, 0x2a, 0x04, 0x65, 0x1e, 0x5e, 0x07, 0x16, 0x03, 0xe9, 0x26, 0x64, 0xc0,
-	0xf2, 0x69, 0xd4, 0x4f, 0x85, 0xe9, 0x07, 0xac, 0xd0, 0xdc, 0x81, 0xa2, 0x0a, 0x69, 0xcd, 0x09,
-	0x46, 0xbf, 0x58, 0xee, 0xc9, 0x5f, 0xce, 0x00, 0x6c, 0xa9, 0x4c, 0x72, 0x3c, 0xdc, 0xe7, 0xc4,
-	0xf8, 0xe7, 0x29, 0x5a, 0xa6, 0x65, 0x51, 0xce, 0x7e, 0x2e, 0x7a, 0x99, 0x09, 0x8b, 0xb8, 0x9e,
-	0xcc, 0x30, 0xbb, 0x4c, 0xed, 0xc4, 0xa8, 0xac, 0x8e, 0x95, 0x4d, 0xcf, 0x75, 0x45, 0x1f, 0x0f,
-	0xa5, 0xe8, 0x58, 0x89, 0x40, 0xcd, 0x5f, 0xcf, 0x42, 0x65, 0x73, 0x68, 0x4a, 0xf5, 0x90, 0xd1,
-	0xb7, 0xa1, 0x3c, 0x12, 0x41, 0x60, 0x0e, 0x44, 0xa0, 0x43, 0x38, 0xd3, 0xf1, 0xd7, 0x08, 0x77,
-	0xfd, 0xb1, 0xeb, 0x0b, 0xd3, 0x52, 0xaf, 0x37, 0x45, 0x54, 0x8a, 0x83, 0x2b, 0x23, 0x13, 0xfb,
-	0x05, 0x38, 0xb8, 0xd1, 0x53, 0xcb, 0x8e, 0x19, 0x28, 0x94, 0xc8, 0x7d, 0x96, 0x04, 0xd1, 0x8a,
-	0xa1, 0xf7, 0x01, 0xf2, 0x34, 0x12, 0xaa, 0xd0, 0xd8, 0x83, 0x6a, 0x82, 0x21, 0x7f, 0x19, 0x6a,
-	0x9e, 0x63, 0x89, 0x40, 0x5d, 0xc0, 0x8c, 0x1f, 0xc2, 0x4c, 0x01, 0x29, 0x81, 0x03, 0x77, 0xb9,
-	0xf0, 0x75, 0x8c, 0x2e, 0x2c, 0x36, 0x7f, 0xab, 0x0c, 0x55, 0x6c, 0xea, 0x9e, 0xea, 0xd9, 0xcc,
-	0x24, 0xd5, 0xa1, 0xe4, 0x69, 0xce, 0x3a, 0xeb, 0xdc, 0x4b, 0xf0, 0xd4, 0x49, 0x21, 0xb9, 0x74,
-	0x52, 0x48, 0x2a, 0xef, 0x3c, 0x3f, 0x9d, 0x77, 0x7e, 0x07, 0x60, 0xe4, 0x59, 0x24, 0xbb, 0x5b,
-	0x2a, 0x16, 0x93, 0x33, 0x12, 0x10, 0x32, 0x5f, 0xf4, 0xa0, 0x54, 0xb5, 0xf9, 0xa2, 0x07, 0x84,
-	0xb2, 0x73, 0xc6, 0xce, 0x45, 0xcf, 0xd3, 0xad, 0xed, 0x58, 0xf1, 0x6d, 0xf9, 0x34, 0x9c, 0x6f,
-	0x42, 0x49, 0x4f, 0x96, 0x8e, 0x38, 0xbd, 0x36, 0x67, 0x7e, 0x34, 0xfa, 0xba, 0xfe, 0xab, 0xaf,
-	0x9a, 0x19, 0x21, 0x25, 0x7f, 0x08, 0x55, 0x53, 0x4a, 0xb3, 0x3f, 0x1c, 0x69, 0x59, 0x9b, 0x9b,
-	0x13, 0x7c, 0x4e, 0x32, 0x6a, 0x45, 0xd8, 0x46, 0x92, 0x92, 0x6f, 0x40, 0xc5, 0x17, 0x66, 0x2a,
-	0xfe, 0xfd, 0xf2, 0x15, 0x6c, 0x8c, 0x10, 0xd7, 0x88, 0xc9, 0xa2, 0x37, 0x61, 0x21, 0xf1, 0x26,
-	0xec, 0x5d, 0xa8, 0xea, 0x05, 0x65, 0xe0, 0x27, 0xf5, 0x56, 0x4e, 0x12, 0x44, 0xf1, 0xe4, 0x0b,
-	0xb7, 0xaf, 0x43, 0x41, 0x65, 0x43, 0x97, 0x1a, 0x3f, 0xc8, 0xc0, 0x4a, 0xba, 0xdb, 0x7f, 0x12,
-	0xaf, 0x1b, 0x7e, 0x33, 0x7e, 0xdd, 0xf0, 0x0b, 0xbc, 0x14, 0xf8, 0x1b, 0x19, 0x80, 0x78, 0x44,
-	0xb1, 0x2b, 0xea, 0x15, 0xb6, 0xd0, 0x94, 0x51, 0x25, 0xbe, 0x93, 0x7a, 0x92, 0xe3, 0x9d, 0x85,
-	0xa6, 0x27, 0xf1, 0x33, 0x91, 0x48, 0xff, 0x06, 0xac, 0xa4, 0xe1, 0x74, 0x01, 0xa1, 0xb3, 0xdb,
-	0x56, 0x9e, 0xad, 0xce, 0x5e, 0xeb, 0x61, 0x5b, 0x5f, 0x05, 0xec, 0xec, 0x3f, 0x62, 0xd9, 0xc6,
-	0x1f, 0x66, 0xa0, 0x12, 0x4d, 0x16, 0xff, 0x24, 0x39, 0xcb, 0x2a, 0x3d, 0xe6, 0xed, 0x45, 0x66,
-	0x39, 0xfe, 0xd5, 0x76, 0xa5, 0x7f, 0x91, 0x98, 0xf4, 0x86, 0x07, 0x2b, 0xe9, 0x8f, 0x73, 0x84,
-	0xf2, 0xc3, 0xb4, 0x50, 0x7e, 0x6b, 0xa1, 0x2a, 0x43, 0x8b, 0x78, 0xd7, 0x0e, 0xa4, 0x96, 0xd7,
-	0x1f, 0x64, 0xdf, 0xcf, 0x34, 0xee, 0xc2, 0x72, 0xf2, 0xd3, 0xec, 0x35, 0xe1, 0xfb, 0x7f, 0x98,
-	0x83, 0x95, 0x74, 0x86, 0x09, 0xdd, 0x2e, 0x54, 0xd9, 0x4d, 0x07, 0x8e, 0x95, 0xb8, 0x7b, 0xc0,
-	0xf8, 0x2a, 0x54, 0xb5, 0xcd, 0x4d, 0x80, 0x35, 0xf2, 0x9d, 0x79, 0x23, 0xc1, 0xee, 0x26, 0x5f,
-	0x70, 0x7d, 0x93, 0x43, 0x78, 0x2b, 0x94, 0x8d, 0x79, 0x45, 0xbf, 0x65, 0xf7, 0x8b, 0x59, 0x5e,
-	0x4b, 0x64, 0xc0, 0x7f, 0x1f, 0xf5, 0xcd, 0xd5, 0x8d, 0x89, 0x6b, 0x39, 0xc2, 0x8a, 0xa0, 0x3f,
-	0x48, 0x42, 0xa3, 0x14, 0xf6, 0x5f, 0xcc, 0xf3, 0x15, 0xa8, 0x74, 0x27, 0xc7, 0x3a, 0x49, 0xf4,
-	0xcf, 0xe7, 0xf9, 0x4d, 0x58, 0xd3, 0x58, 0x71, 0xee, 0x27, 0xfb, 0x25, 0x3c, 0x03, 0x57, 0x5a,
-	0x6a, 0xbc, 0x74, 0x43, 0xd9, 0x5f, 0xc8, 0x63, 0x13, 0xe8, 0xc9, 0x82, 0xbf, 0x48, 0x7c, 0xa2,
-	0xcb, 0x57, 0xec, 0x97, 0xf3, 0x7c, 0x15, 0xa0, 0xdb, 0x8b, 0x2a, 0xfa, 0xd5, 0x3c, 0xaf, 0x42,
-	0xb1, 0xdb, 0x23, 0x6e, 0xdf, 0xcb, 0xf3, 0x1b, 0xc0, 0xe2, 0xaf, 0x3a, 0x87, 0xf6, 0xaf, 0xaa,
-	0xc6, 0x44, 0x49, 0xb1, 0x7f, 0x2d, 0x8f, 0xfd, 0x0a, 0x47, 0x99, 0xfd, 0xf5, 0x3c, 0x67, 0x50,
-	0x4d, 0x78, 0x64, 0xd9, 0xaf, 0xe7, 0x39, 0x87, 0xda, 0x5e, 0x2a, 0xcd, 0xf5, 0x57, 0xa8, 0xe6,
-	0xed, 0xe8, 0xfe, 0x18, 0xfb, 0xb5, 0x3c, 0xbf, 0x05, 0x3c, 0x19, 0x85, 0xd2, 0x1f, 0xfe, 0x06,
-	0x51, 0xab, 0x73, 0x37, 0xd0, 0xb0, 0xbf, 0x49, 0xd4, 0xb8, 0x12, 0x34, 0xe0, 0x6f, 0xd1, 0x80,
-	0x6c, 0xc6, 0x59, 0xb7, 0x1a, 0xfe, 0x7d, 0x22, 0x0e, 0x27, 0x53, 0xc1, 0x7e, 0x90, 0xbf, 0xff,
-	0xdb, 0x14, 0x45, 0x48, 0x26, 0x9a, 0xf1, 0x65, 0x28, 0x3b, 0x9e, 0x3b, 0x90, 0xea, 0xe5, 0xdc,
-	0x1a, 0x54, 0x82, 0xa1, 0xe7, 0x4b, 0x2a, 0xd2, 0x05, 0x57, 0x97, 0x9e, 0x53, 0x50, 0x17, 0x20,
-	0x94, 0xed, 0xa8, 0xbc, 0xb2, 0xd2, 0x1c, 0xb0, 0x6a, 0x94, 0x2f, 0x9c, 0x8f, 0x72, 0x9a, 0xe9,
-	0x59, 0x87, 0xf0, 0x26, 0x3c, 0x2b, 0x22, 0xea, 0xc4, 0x77, 0x54, 0x6e, 0xb3, 0x40, 0xbb, 0x41,
-	0x3d, 0x91, 0x39, 0x1e, 0xa2, 0x79, 0x52, 0x51, 0x50, 0xef, 0xbb, 0xb6, 0xba, 0x63, 0xad, 0xd3,
-	0xfa, 0x2c, 0x6c, 0x47, 0x94, 0xb9, 0xc2, 0xc4, 0xfd, 0xbf, 0x9d, 0x81, 0xe5, 0xf0, 0x7d, 0x02,
-	0x7b, 0x60, 0xbb, 0x2a, 0x3b, 0x3a, 0x7c, 0x8f, 0xb8, 0xef, 0xd8, 0xe3, 0xf0, 0x7d, 0xcf, 0x55,
-	0xa8, 0x5a, 0xbe, 0x39, 0x68, 0xb9, 0xd6, 0x96, 0xef, 0x8d, 0x55, 0xb3, 0x55, 0x9c, 0x51, 0x65,
-	0x65, 0x3f, 0x13, 0xc7, 0x88, 0x3e, 0x16, 0x3e, 0xcb, 0x53, 0xca, 0xe0, 0xd0, 0xf4, 0x6d, 0x77,
-	0xd0, 0x3e, 0x97, 0xc2, 0x0d, 0x54, 0x76, 0x76, 0x15, 0x4a, 0x93, 0x40, 0xf4, 0xcd, 0x40, 0xb0,
-	0x22, 0x16, 0x8e, 0x27, 0xb6, 0x23, 0x6d, 0x57, 0x3d, 0xab, 0x19, 0xa5, 0x5f, 0x97, 0xb1, 0x67,
-	0xe6, 0xd8, 0x66, 0x95, 0xfb, 0xff, 0x22, 0x03, 0x55, 0x5a, 0x16, 0xb1, 0x27, 0x3d, 0xd6, 0xf9,
-	0xaa, 0x50, 0xda, 0x8d, 0xde, 0x57, 0x2c, 0x42, 0xf6, 0xe0, 0x54, 0x79, 0xd2, 0xf5, 0xb2, 0x50,
-	0xf7, 0x85, 0xd5, 0x53, 0x8b, 0x79, 0xfe, 0x12, 0xdc, 0x30, 0xc4, 0xc8, 0x93, 0xe2, 0xa9, 0x69,
-	0xcb, 0xe4, 0x4d, 0xa8, 0x02, 0x1a, 0x8d, 0xea, 0x53, 0x78, 0xf5, 0xa9, 0x48, 0x46, 0x23, 0x56,
-	0x1b, 0x42, 0x4a, 0xd8, 0x7b, 0x82, 0x68, 0x2b, 0xb2, 0x1c, 0xa1, 0x7c, 0xec, 0xd9, 0x2e, 0xd6,
-	0x46, 0x97, 0xdb, 0x09, 0x42, 0x21, 0x19, 0x04, 0xc1, 0xfd, 0x7d, 0xb8, 0x39, 0x3f, 0x90, 0xa0,
-	0xae, 0xbd, 0xd3, 0xa3, 0xde, 0x74, 0x37, 0xe6, 0xa9, 0x6f, 0xab, 0x6b, 0xc8, 0x15, 0x28, 0x1c,
-	0x3c, 0x73, 0x69, 0x59, 0xac, 0x41, 0x6d, 0xdf, 0x4b, 0xd0, 0xb0, 0xdc, 0xfd, 0xf7, 0x00, 0x62,
-	0xff, 0x9e, 0x7a, 0xd3, 0x8c, 0xd6, 0x10, 0x09, 0xdf, 0x87, 0x13, 0x11, 0x68, 0x03, 0xf0, 0xa9,
-	0x2d, 0x87, 0xde, 0x24, 0x0c, 0xb2, 0xb1, 0xec, 0xfd, 0x7e, 0x2a, 0x68, 0x14, 0x8f, 0x66, 0xd8,
-	0xfa, 0xa5, 0xc4, 0x85, 0xb1, 0x8c, 0x0a, 0x47, 0xd0, 0x3f, 0x74, 0x51, 0x0f, 0x8f, 0xe8, 0x60,
-	0x8d, 0xa5, 0x1e, 0x1e, 0x89, 0xfa, 0x97, 0x57, 0x2f, 0xb0, 0xb9, 0x7d, 0xe1, 0x08, 0x8b, 0x15,
-	0xee, 0xbf, 0x0f, 0xab, 0x7a, 0x8c, 0xfa, 0x22, 0x08, 0xc2, 0x0b, 0x57, 0x87, 0xbe, 0x7d, 0xa6,
-	0x1e, 0x37, 0x59, 0x86, 0xf2, 0xa1, 0xf0, 0x03, 0xcf, 0xa5, 0x87, 0x5d, 0x00, 0x8a, 0xdd, 0xa1,
-	0xe9, 0x63, 0x1d, 0xf7, 0xdf, 0xd3, 0xa3, 0xfb, 0xf8, 0x7c, 0xf6, 0xd5, 0x4e, 0x34, 0x0a, 0x35,
-	0xba, 0xf4, 0x85, 0xa9, 0x6f, 0x84, 0xe3, 0xc6, 0x64, 0xb9, 0xfb, 0x9b, 0x50, 0xa1, 0x9b, 0x5b,
-	0x8f, 0x6c, 0xd7, 0xc2, 0x31, 0xd8, 0xd0, 0xb7, 0x08, 0xe8, 0xe9, 0xad, 0x33, 0x1a, 0xd1, 0xb2,
-	0x7a, 0xcc, 0x98, 0x65, 0xf9, 0x4d, 0xe0, 0xad, 0x89, 0xf4, 0x46, 0x26, 0xdd, 0xc1, 0x76, 0x2e,
-	0xd4, 0xc3, 0xd7, 0xb9, 0xfb, 0xdf, 0x02, 0xae, 0xbc, 0x81, 0x96, 0x38, 0xb7, 0xdd, 0x41, 0xf4,
-	0x2a, 0x04, 0xd0, 0x7b, 0x30, 0x96, 0x38, 0x0f, 0xaf, 0xdd, 0x85, 0x85, 0xf0, 0x55, 0x9a, 0x6d,
-	0x6f, 0xe2, 0x62, 0xf3, 0x9f, 0xc0, 0x75, 0xb5, 0x4a, 0xb1, 0x3f, 0x74, 0xc1, 0xf7, 0x52, 0x0f,
-	0x85, 0xba, 0x76, 0x27, 0x27, 0x41, 0x84, 0xcb, 0x32, 0xd8, 0xb0, 0xc8, 0xba, 0x8f, 0xe1, 0xd9,
-	0xfb, 0x4d, 0xb8, 0x36, 0xc7, 0xc5, 0x42, 0xe7, 0x82, 0x32, 0x34, 0xd9, 0xd2, 0xfd, 0x8f, 0x60,
-	0x4d, 0x49, 0xb2, 0x7d, 0x75, 0xc1, 0x32, 0x1c, 0xc0, 0xa7, 0x9d, 0xed, 0x8e, 0x1a, 0xf3, 0xcd,
-	0xf6, 0xee, 0xee, 0xe3, 0xdd, 0x96, 0xc1, 0x32, 0xb4, 0xa4, 0x0e, 0x7a, 0x47, 0x9b, 0x07, 0xfb,
-	0xfb, 0xed, 0xcd, 0x5e, 0x7b, 0x8b, 0x65, 0xef, 0x5b, 0x00, 0xdd, 0x0b, 0xb7, 0xaf, 0x5b, 0x7c,
-	0x1d, 0x58, 0x5c, 0xea, 0x92, 0x22, 0xa4, 0x1e, 0x51, 0x4b, 0x43, 0xd5, 0x9e, 0xc3, 0xbe, 0x44,
-	0x60, 0xb5, 0xd1, 0xb2, 0x69, 0x0e, 0x9f, 0x4c, 0xc4, 0x84, 0x86, 0x38, 0x80, 0x0a, 0x42, 0x09,
-	0x89, 0x86, 0x25, 0x2c, 0xec, 0x4f, 0xe8, 0x79, 0xbe, 0xbb, 0x70, 0x3b, 0x02, 0x75, 0xdc, 0xbe,
-	0x37, 0x1a, 0x9b, 0xd2, 0x3e, 0x76, 0xc4, 0x13, 0xe1, 0x07, 0xea, 0x6a, 0xe2, 0x4b, 0x70, 0x23,
-	0x26, 0x52, 0x5d, 0xd5, 0xcf, 0xa8, 0xd2, 0xf0, 0x85, 0x9f, 0x0e, 0xce, 0x90, 0xe2, 0x73, 0x61,
-	0xb1, 0xfc, 0xc6, 0xfd, 0x7f, 0xf3, 0xa3, 0x3b, 0x99, 0x1f, 0xfe, 0xe8, 0x4e, 0xe6, 0x3f, 0xff,
-	0xe8, 0x4e, 0xe6, 0x7b, 0x3f, 0xbe, 0xb3, 0xf4, 0xc3, 0x1f, 0xdf, 0x59, 0xfa, 0xdd, 0x1f, 0xdf,
-	0x59, 0xfa, 0x8c, 0x4d, 0xff, 0xe3, 0xa9, 0xe3, 0x22, 0x19, 0x68, 0x6f, 0xff, 0xdf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x50, 0xe3, 0x00, 0xa7, 0x93, 0x6a, 0x00, 0x00,
+	// 10371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0xbd, 0x5d, 0x6c, 0x24, 0xc9,
+	0x91, 0x18, 0xcc, 0xfe, 0xef, 0x8e, 0xe6, 0x4f, 0xb2, 0x66, 0x76, 0xa6, 0xb7, 0x77, 0x34, 0xdf,
+	0xa8, 0xb4, 0x5a, 0x8d, 0xb8, 0x2b, 0xae, 0x76, 0x76, 0x57, 0xbb, 0x5a, 0x69, 0x57, 0x6a, 0x92,
+	0xcd, 0x61, 0xef, 0x90, 0x6c, 0x6e, 0x75, 0x0f, 0x67, 0x77, 0x4f, 0xf7, 0xf1, 0x8a, 0x5d, 0xc9,
+	0xee, 0xd2, 0x54, 0x57, 0xb5, 0xaa, 0xaa, 0x39, 0xe4, 0xc2, 0x36, 0x74, 0x77, 0xf6, 0xe9, 0x64,
+	0xf8, 0x41, 0x36, 0xee, 0xfc, 0x03, 0xfb, 0x70, 0x12, 0x60, 0x03, 0x86, 0x7d, 0x80, 0x9f, 0x0e,
+	0xb0, 0xfc, 0x07, 0x18, 0x7e, 0xb0, 0x01, 0xbf, 0xc8, 0xf0, 0xcb, 0xd9, 0x7e, 0xb8, 0x83, 0x04,
+	0x1c, 0x0c, 0xd8, 0x67, 0xe3, 0x0c, 0x03, 0xe7, 0x07, 0xc3, 0x30, 0x22, 0x32, 0xb3, 0x7e, 0xba,
+	0x9b, 0x64, 0x73, 0xef, 0x07, 0x7e, 0xea, 0xca, 0xa8, 0x88, 0xa8, 0xfc, 0x89, 0x8c, 0x8c, 0x8c,
+	0x88, 0xcc, 0x86, 0x17, 0x47, 0x4f, 0xfb, 0xaf, 0x3a, 0xf6, 0xf1, 0xab, 0xa3, 0xe3, 0x57, 0x87,
+	0x9e, 0xc5, 0x9d, 0x57, 0x47, 0xbe, 0x17, 0x7a, 0x81, 0x28, 0x04, 0xeb, 0x54, 0xd2, 0x96, 0x4c,
+	0xf7, 0x3c, 0x3c, 0x1f, 0xf1, 0x75, 0x82, 0xd6, 0xef, 0xf4, 0x3d, 0xaf, 0xef, 0x70, 0x81, 0x7a,
+	0x3c, 0x3e, 0x79, 0x35, 0x08, 0xfd, 0x71, 0x2f, 0x14, 0xc8, 0xfa, 0x4f, 0xf2, 0x70, 0xab, 0x33,
+	0x34, 0xfd, 0x70, 0xc3, 0xf1, 0x7a, 0x4f, 0x3b, 0xae, 0x39, 0x0a, 0x06, 0x5e, 0xb8, 0x61, 0x06,
+	0x5c, 0x7b, 0x05, 0x8a, 0xc7, 0x08, 0x0c, 0x6a, 0x99, 0x7b, 0xb9, 0xfb, 0xd5, 0x07, 0x37, 0xd7,
+	0x53, 0x8c, 0xd7, 0x89, 0xc2, 0x90, 0x38, 0xda, 0x6b, 0x50, 0xb2, 0x78, 0x68, 0xda, 0x4e, 0x50,
+	0xcb, 0xde, 0xcb, 0xdc, 0xaf, 0x3e, 0xb8, 0xbd, 0x2e, 0x3e, 0xbc, 0xae, 0x3e, 0xbc, 0xde, 0xa1,
+	0x0f, 0x1b, 0x0a, 0x4f, 0x7b, 0x0b, 0xca, 0x27, 0xb6, 0xc3, 0x1f, 0xf1, 0xf3, 0xa0, 0x96, 0xbb,
+	0x94, 0x66, 0x23, 0x5b, 0xcb, 0x18, 0x11, 0xb2, 0xb6, 0x09, 0xcb, 0xfc, 0x2c, 0xf4, 0x4d, 0x83,
+	0x3b, 0x66, 0x68, 0x7b, 0x6e, 0x50, 0xcb, 0x53, 0x0d, 0x6f, 0x4f, 0xd4, 0x50, 0xbd, 0x27, 0xf2,
+	0x09, 0x12, 0xed, 0x1e, 0x54, 0xbd, 0xe3, 0x6f, 0xf3, 0x5e, 0xd8, 0x3d, 0x1f, 0xf1, 0xa0, 0x56,
+	0xb8, 0x97, 0xbb, 0x5f, 0x31, 0x92, 0x20, 0xed, 0xab, 0x50, 0xed, 0x79, 0x8e, 0xc3, 0x7b, 0xe2,
+	0x1b, 0xc5, 0xcb, 0x9b, 0x95, 0xc4, 0xd5, 0xde, 0x80, 0xe7, 0x7c, 0x3e, 0xf4, 0x4e, 0xb9, 0xb5,
+	0x19, 0x41, 0xa9, 0x9d, 0x65, 0xfa, 0xcc, 0xec, 0x97, 0x5a, 0x03, 0x96, 0x7c, 0x59, 0xbf, 0x5d,
+	0xdb, 0x7d, 0x1a, 0xd4, 0x4a, 0xd4, 0xac, 0x17, 0x2e, 0x68, 0x16, 0xe2, 0x18, 0x69, 0x0a, 0x8d,
+	0x41, 0xee, 0x29, 0x3f, 0xaf, 0x55, 0xee, 0x65, 0xee, 0x57, 0x0c, 0x7c, 0xd4, 0xde, 0x81, 0x9a,
+	0xe7, 0xdb, 0x7d, 0xdb, 0x35, 0x9d, 0x4d, 0x9f, 0x9b, 0x21, 0xb7, 0xba, 0xf6, 0x90, 0x07, 0xa1,
+	0x39, 0x1c, 0xd5, 0xe0, 0x5e, 0xe6, 0x7e, 0xce, 0xb8, 0xf0, 0xbd, 0xf6, 0xba, 0x18, 0xa1, 0x96,
+	0x7b, 0xe2, 0xd5, 0xaa, 0xb2, 0xf9, 0xe9, 0xba, 0x6c, 0xcb, 0xd7, 0x46, 0x84, 0xa8, 0xff, 0x95,
+	0x1c, 0x14, 0x3b, 0xdc, 0xf4, 0x7b, 0x83, 0xfa, 0xf7, 0x32, 0x50, 0x34, 0x78, 0x30, 0x76, 0x42,
+	0xad, 0x0e, 0x65, 0xd1, 0xb7, 0x2d, 0xab, 0x96, 0xa1, 0xda, 0x45, 0xe5, 0x4f, 0x23, 0x3b, 0xeb,
+	0x90, 0x1f, 0xf2, 0xd0, 0xac, 0xe5, 0xa8, 0x87, 0xea, 0x13, 0xb5, 0x12, 0x9f, 0x5f, 0xdf, 0xe3,
+	0xa1, 0x69, 0x10, 0x5e, 0xfd, 0x8f, 0x32, 0x90, 0xc7, 0xa2, 0x76, 0x07, 0x2a, 0x03, 0xbb, 0x3f,
+	0x70, 0xec, 0xfe, 0x20, 0x94, 0x15, 0x89, 0x01, 0xda, 0x7b, 0xb0, 0x12, 0x15, 0x0c, 0xd3, 0xed,
+	0x73, 0xac, 0xd1, 0x2c, 0xe1, 0xa7, 0x97, 0xc6, 0x24, 0xb2, 0x56, 0x83, 0x12, 0xcd, 0x87, 0x96,
+	0x45, 0x12, 0x5d, 0x31, 0x54, 0x11, 0xc5, 0x4d, 0x8d, 0xd4, 0x23, 0x7e, 0x5e, 0xcb, 0xd3, 0xdb,
+	0x24, 0x48, 0x6b, 0xc0, 0x8a, 0x2a, 0x6e, 0xc9, 0xde, 0x28, 0x5c, 0xde, 0x1b, 0x93, 0xf8, 0x9a,
+	0x06, 0xf9, 0x91, 0xd9, 0xe7, 0x24, 0xaa, 0x05, 0x83, 0x9e, 0xf5, 0xbf, 0xdb, 0x85, 0x02, 0x4d,
+	0x55, 0x6d, 0x19, 0xb2, 0xb6, 0xea, 0xfc, 0xac, 0x6d, 0x69, 0xaf, 0x42, 0xf1, 0xc4, 0xe6, 0x8e,
+	0x75, 0x65, 0xaf, 0x4b, 0x34, 0xad, 0x09, 0x8b, 0x3e, 0x0f, 0x42, 0xdf, 0x96, 0x33, 0x42, 0x4c,
+	0xda, 0xcf, 0xce, 0xd2, 0x0b, 0xeb, 0x46, 0x02, 0xd1, 0x48, 0x91, 0x61, 0x57, 0xf4, 0x06, 0xb6,
+	0x63, 0xf9, 0xdc, 0x6d, 0x59, 0x62, 0xee, 0x56, 0x8c, 0x24, 0x48, 0xbb, 0x0f, 0x2b, 0xc7, 0x66,
+	0xef, 0x69, 0xdf, 0xf7, 0xc6, 0x2e, 0x4e, 0x12, 0xcf, 0xa7, 0xae, 0xa8, 0x18, 0x93, 0x60, 0xed,
+	0xcb, 0x50, 0x30, 0x1d, 0xbb, 0xef, 0x52, 0x93, 0x97, 0x1f, 0xd4, 0x67, 0xd6, 0xa5, 0x81, 0x18,
+	0x86, 0x40, 0xd4, 0x76, 0x60, 0xe9, 0x94, 0xfb, 0xa1, 0xdd, 0x33, 0x1d, 0x82, 0xd7, 0x4a, 0x44,
+	0xa9, 0xcf, 0xa4, 0x3c, 0x4c, 0x62, 0x1a, 0x69, 0x42, 0xad, 0x05, 0x10, 0xa0, 0xea, 0xa4, 0x21,
+	0x96, 0xf3, 0xe3, 0x0b, 0x33, 0xd9, 0x6c, 0x7a, 0x6e, 0xc8, 0xdd, 0x70, 0xbd, 0x13, 0xa1, 0xef,
+	0x2c, 0x18, 0x09, 0x62, 0xed, 0x2d, 0xc8, 0x87, 0xfc, 0x2c, 0xac, 0x2d, 0x5f, 0xd2, 0xa3, 0x8a,
+	0x49, 0x97, 0x9f, 0x85, 0x3b, 0x0b, 0x06, 0x11, 0x20, 0x21, 0x4e, 0xbc, 0xda, 0xca, 0x1c, 0x84,
+	0x38, 0x57, 0x91, 0x10, 0x09, 0xb4, 0x77, 0xa1, 0xe8, 0x98, 0xe7, 0xde, 0x38, 0xac, 0x31, 0x22,
+	0xfd, 0xdc, 0xa5, 0xa4, 0xbb, 0x84, 0xba, 0xb3, 0x60, 0x48, 0x22, 0xed, 0x0d, 0xc8, 0x59, 0xf6,
+	0x69, 0x6d, 0x95, 0x68, 0xef, 0x5d, 0x4a, 0xbb, 0x65, 0x9f, 0xee, 0x2c, 0x18, 0x88, 0xae, 0x6d,
+	0x42, 0xf9, 0xd8, 0xf3, 0x9e, 0x0e, 0x4d, 0xff, 0x69, 0x4d, 0x23, 0xd2, 0xcf, 0x5f, 0x4a, 0xba,
+	0x21, 0x91, 0x77, 0x16, 0x8c, 0x88, 0x10, 0x9b, 0x6c, 0xf7, 0x3c, 0xb7, 0x76, 0x63, 0x8e, 0x26,
+	0xb7, 0x7a, 0x9e, 0x8b, 0x4d, 0x46, 0x02, 0x24, 0x74, 0x6c, 0xf7, 0x69, 0xed, 0xe6, 0x1c, 0x84,
+	0xa8, 0x4d, 0x91, 0x10, 0x09, 0xb0, 0xda, 0x96, 0x19, 0x9a, 0xa7, 0x36, 0x7f, 0x56, 0x7b, 0x6e,
+	0x8e, 0x6a, 0x6f, 0x49, 0x64, 0xac, 0xb6, 0x22, 0x44, 0x26, 0x6a, 0xba, 0xd6, 0x6e, 0xcd, 0xc1,
+	0x44, 0x69, 0x79, 0x64, 0xa2, 0x08, 0xb5, 0xff, 0x1f, 0x56, 0x4f, 0xb8, 0x19, 0x8e, 0x7d, 0x6e,
+	0xc5, 0x8b, 0xdf, 0x6d, 0xe2, 0xb6, 0x7e, 0xf9, 0xd8, 0x4f, 0x52, 0xed, 0x2c, 0x18, 0xd3, 0xac,
+	0xb4, 0x77, 0xa0, 0xe0, 0x98, 0x21, 0x3f, 0xab, 0xd5, 0x88, 0xa7, 0x7e, 0x85, 0x50, 0x84, 0xfc,
+	0x6c, 0x67, 0xc1, 0x10, 0x24, 0xda, 0x87, 0xb0, 0x12, 0x9a, 0xc7, 0x0e, 0x6f, 0x9f, 0x48, 0x84,
+	0xa0, 0xf6, 0x3c, 0x71, 0x79, 0xe5, 0x72, 0x71, 0x4e, 0xd3, 0xec, 0x2c, 0x18, 0x93, 0x6c, 0xb0,
+	0x56, 0x04, 0xaa, 0xd5, 0xe7, 0xa8, 0x15, 0xf1, 0xc3, 0x5a, 0x11, 0x89, 0xb6, 0x0b, 0x55, 0x7a,
+	0xd8, 0xf4, 0x9c, 0xf1, 0xd0, 0xad, 0xbd, 0x40, 0x1c, 0xee, 0x5f, 0xcd, 0x41, 0xe0, 0xef, 0x2c,
+	0x18, 0x49, 0x72, 0x1c, 0x44, 0x2a, 0x1a, 0xde, 0xb3, 0xda, 0x9d, 0x39, 0x06, 0xb1, 0x2b, 0x91,
+	0x71, 0x10, 0x15, 0x21, 0x4e, 0xbd, 0x67, 0xb6, 0xd5, 0xe7, 0x61, 0xed, 0x33, 0x73, 0x4c, 0xbd,
+	0x27, 0x84, 0x8a, 0x53, 0x4f, 0x10, 0xa1, 0x18, 0xf7, 0x06, 0x66, 0x58, 0xbb, 0x3b, 0x87, 0x18,
+	0x6f, 0x0e, 0x4c, 0xd2, 0x15, 0x48, 0x50, 0xff, 0x04, 0x16, 0x93, 0x5a, 0x19, 0x57, 0x0b, 0x9f,
+	0x9b, 0x62, 0x45, 0x28, 0x1b, 0xf4, 0x8c, 0x30, 0x6e, 0xd9, 0x21, 0xad, 0x08, 0x65, 0x83, 0x9e,
+	0xb5, 0x5b, 0x50, 0x14, 0xf6, 0x0a, 0x29, 0xfc, 0xb2, 0x21, 0x4b, 0x88, 0x6b, 0xf9, 0x66, 0x9f,
+	0xd6, 0xb2, 0xb2, 0x41, 0xcf, 0x88, 0x6b, 0xf9, 0xde, 0xa8, 0xed, 0x92, 0xc2, 0x2e, 0x1b, 0xb2,
+	0x54, 0xff, 0x5e, 0x13, 0x4a, 0xb2, 0x52, 0xf5, 0xdf, 0xc8, 0x40, 0x51, 0x28, 0x14, 0xed, 0x1b,
+	0x50, 0x08, 0xc2, 0x73, 0x87, 0x53, 0x1d, 0x96, 0x1f, 0x7c, 0x71, 0x0e, 0x25, 0xb4, 0xde, 0x41,
+	0x02, 0x43, 0xd0, 0xe9, 0x06, 0x14, 0xa8, 0xac, 0x95, 0x20, 0x67, 0x78, 0xcf, 0xd8, 0x82, 0x06,
+	0x50, 0x14, 0x83, 0xc5, 0x32, 0x08, 0xdc, 0xb2, 0x4f, 0x59, 0x16, 0x81, 0x3b, 0xdc, 0xb4, 0xb8,
+	0xcf, 0x72, 0xda, 0x12, 0x54, 0xd4, 0xb0, 0x04, 0x2c, 0xaf, 0x31, 0x58, 0x4c, 0x0c, 0x78, 0xc0,
+	0x0a, 0xf5, 0xff, 0x91, 0x87, 0x3c, 0xce, 0x7f, 0xed, 0x45, 0x58, 0x0a, 0x4d, 0xbf, 0xcf, 0x85,
+	0x71, 0x1c, 0x19, 0x2e, 0x69, 0xa0, 0xf6, 0xae, 0x6a, 0x43, 0x96, 0xda, 0xf0, 0x85, 0x2b, 0xf5,
+	0x4a, 0xaa, 0x05, 0x89, 0x55, 0x38, 0x37, 0xdf, 0x2a, 0xbc, 0x0d, 0x65, 0x54, 0x67, 0x1d, 0xfb,
+	0x13, 0x4e, 0x5d, 0xbf, 0xfc, 0x60, 0xed, 0xea, 0x4f, 0xb6, 0x24, 0x85, 0x11, 0xd1, 0x6a, 0x2d,
+	0xa8, 0xf4, 0x4c, 0xdf, 0xa2, 0xca, 0xd0, 0x68, 0x2d, 0x3f, 0x78, 0xf9, 0x6a, 0x46, 0x9b, 0x8a,
+	0xc4, 0x88, 0xa9, 0xb5, 0x36, 0x54, 0x2d, 0x1e, 0xf4, 0x7c, 0x7b, 0x44, 0xea, 0x4d, 0xac, 0xc5,
+	0x5f, 0xba, 0x9a, 0xd9, 0x56, 0x4c, 0x64, 0x24, 0x39, 0xa0, 0x95, 0xe6, 0x47, 0xfa, 0xad, 0x44,
+	0x06, 0x42, 0x0c, 0xd0, 0xdf, 0x82, 0xb2, 0x6a, 0x8f, 0xb6, 0x08, 0x65, 0xfc, 0xdd, 0xf7, 0x5c,
+	0xce, 0x16, 0x70, 0x6c, 0xb1, 0xd4, 0x19, 0x9a, 0x8e, 0xc3, 0x32, 0xda, 0x32, 0x00, 0x16, 0xf7,
+	0xb8, 0x65, 0x8f, 0x87, 0x2c, 0xab, 0x7f, 0x4d, 0x49, 0x4b, 0x19, 0xf2, 0x07, 0x66, 0x1f, 0x29,
+	0x16, 0xa1, 0xac, 0xd4, 0x35, 0xcb, 0x20, 0xfd, 0x96, 0x19, 0x0c, 0x8e, 0x3d, 0xd3, 0xb7, 0x58,
+	0x56, 0xab, 0x42, 0xa9, 0xe1, 0xf7, 0x06, 0xf6, 0x29, 0x67, 0x39, 0xfd, 0x55, 0xa8, 0x26, 0xea,
+	0x8b, 0x2c, 0xe4, 0x47, 0x2b, 0x50, 0x68, 0x58, 0x16, 0xb7, 0x58, 0x06, 0x09, 0x64, 0x03, 0x59,
+	0x56, 0x7f, 0x19, 0x2a, 0x51, 0x6f, 0x21, 0x3a, 0x2e, 0xdc, 0x6c, 0x01, 0x9f, 0x10, 0xcc, 0x32,
+	0x28, 0x95, 0x2d, 0xd7, 0xb1, 0x5d, 0xce, 0xb2, 0xf5, 0x5f, 0x20, 0x51, 0xd5, 0xbe, 0x9e, 0x9e,
+	0x10, 0x2f, 0x5d, 0xb5, 0xb2, 0xa6, 0x67, 0xc3, 0x0b, 0x89, 0xf6, 0xed, 0xda, 0x54, 0xb9, 0x32,
+	0xe4, 0xb7, 0xbc, 0x30, 0x60, 0x99, 0xfa, 0x7f, 0xc9, 0x42, 0x59, 0x2d, 0xa8, 0xb8, 0x4f, 0x18,
+	0xfb, 0x8e, 0x14, 0x68, 0x7c, 0xd4, 0x6e, 0x42, 0x21, 0xb4, 0x43, 0x29, 0xc6, 0x15, 0x43, 0x14,
+	0xd0, 0x56, 0x4b, 0x8e, 0xac, 0x30, 0x6a, 0x27, 0x87, 0xca, 0x1e, 0x9a, 0x7d, 0xbe, 0x63, 0x06,
+	0x03, 0x69, 0xd6, 0xc6, 0x00, 0xa4, 0x3f, 0x31, 0x4f, 0x51, 0xe6, 0xe8, 0xbd, 0xb0, 0xe2, 0x92,
+	0x20, 0xed, 0x75, 0xc8, 0x63, 0x03, 0xa5, 0xd0, 0xfc, 0x7f, 0x13, 0x0d, 0x46, 0x31, 0x39, 0xf0,
+	0x39, 0x0e, 0xcf, 0x3a, 0xee, 0xca, 0x0c, 0x42, 0xd6, 0x5e, 0x82, 0x65, 0x31, 0x09, 0xdb, 0x6a,
+	0x4f, 0x51, 0x22, 0xce, 0x13, 0x50, 0xad, 0x81, 0xdd, 0x69, 0x86, 0xbc, 0x56, 0x9e, 0x43, 0xbe,
+	0x55, 0xe7, 0xac, 0x77, 0x90, 0xc4, 0x10, 0x94, 0xfa, 0x9b, 0xd8, 0xa7, 0x66, 0xc8, 0x71, 0x98,
+	0x9b, 0xc3, 0x51, 0x78, 0x2e, 0x84, 0x66, 0x9b, 0x87, 0xbd, 0x81, 0xed, 0xf6, 0x59, 0x46, 0x74,
+	0x31, 0x0e, 0x22, 0xa1, 0xf8, 0xbe, 0xe7, 0xb3, 0x5c, 0xbd, 0x0e, 0x79, 0x94, 0x51, 0x54, 0x92,
+	0xae, 0x39, 0xe4, 0xb2, 0xa7, 0xe9, 0xb9, 0x7e, 0x03, 0x56, 0xa7, 0xd6, 0xe3, 0xfa, 0x8f, 0x8b,
+	0x42, 0x42, 0x90, 0x82, 0x6c, 0x41, 0x49, 0x81, 0xcf, 0xd7, 0xd3, 0x31, 0xc8, 0x25, 0xad, 0x63,
+	0xde, 0x85, 0x02, 0x36, 0x4c, 0xa9, 0x98, 0x39, 0xc8, 0xf7, 0x10, 0xdd, 0x10, 0x54, 0xb8, 0xab,
+	0xe9, 0x0d, 0x78, 0xef, 0x29, 0xb7, 0xa4, 0xae, 0x57, 0x45, 0x14, 0x9a, 0x5e, 0xc2, 0x3c, 0x17,
+	0x05, 0x12, 0x89, 0x9e, 0xe7, 0x36, 0x87, 0xde, 0xb7, 0xed, 0x5a, 0x51, 0x8a, 0x84, 0x02, 0xa8,
+	0xb7, 0x2d, 0x94, 0x11, 0x39, 0x6c, 0x31, 0xa0, 0xde, 0x84, 0x02, 0x7d, 0x1b, 0x67, 0x82, 0xa8,
+	0xb3, 0xf0, 0x3e, 0xbc, 0x34, 0x5f, 0x9d, 0x65, 0x95, 0xeb, 0xbf, 0x95, 0x85, 0x3c, 0x96, 0xb5,
+	0x35, 0x28, 0xf8, 0xb8, 0x37, 0xa3, 0xee, 0xbc, 0x68, 0x1f, 0x27, 0x50, 0xb4, 0x6f, 0x48, 0x51,
+	0xcc, 0xce, 0x21, 0x2c, 0xd1, 0x17, 0x93, 0x62, 0x79, 0x13, 0x0a, 0x23, 0xd3, 0x37, 0x87, 0x72,
+	0x9e, 0x88, 0x82, 0xfe, 0xc3, 0x0c, 0xe4, 0x11, 0x49, 0x5b, 0x85, 0xa5, 0x4e, 0xe8, 0xdb, 0x4f,
+	0x79, 0x38, 0xf0, 0xbd, 0x71, 0x7f, 0x20, 0x24, 0xe9, 0x11, 0x3f, 0x3f, 0xf6, 0x62, 0x85, 0x10,
+	0x9a, 0x8e, 0xdd, 0x63, 0x59, 0x94, 0xaa, 0x0d, 0xcf, 0xb1, 0x58, 0x4e, 0x5b, 0x81, 0xea, 0x63,
+	0xd7, 0xe2, 0x7e, 0xd0, 0xf3, 0x7c, 0x6e, 0xb1, 0xbc, 0x9c, 0xdd, 0x4f, 0x59, 0x81, 0xd6, 0x32,
+	0x7e, 0x16, 0xd2, 0x5e, 0x88, 0x15, 0xb5, 0x1b, 0xb0, 0xb2, 0x91, 0xde, 0x20, 0xb1, 0x12, 0xea,
+	0xa4, 0x3d, 0xee, 0xa2, 0x90, 0xb1, 0xb2, 0x10, 0x62, 0xef, 0xdb, 0x36, 0xab, 0xe0, 0xc7, 0xc4,
+	0x3c, 0x61, 0xa0, 0xff, 0xb3, 0x8c, 0xd2, 0x1c, 0x4b, 0x50, 0x39, 0x30, 0x7d, 0xb3, 0xef, 0x9b,
+	0x23, 0xac, 0x5f, 0x15, 0x4a, 0x62, 0xe1, 0x7c, 0x8d, 0x65, 0xe2, 0xc2, 0x03, 0x96, 0x8d, 0x0b,
+	0xaf, 0xb3, 0x5c, 0x5c, 0x78, 0x83, 0xe5, 0xf1, 0x1b, 0x1f, 0x8c, 0xbd, 0x90, 0xb3, 0x02, 0xe9,
+	0x3a, 0xcf, 0xe2, 0xac, 0x88, 0xc0, 0x2e, 0x6a, 0x14, 0x56, 0xc2, 0x36, 0x6f, 0xa2, 0xfc, 0x1c,
+	0x7b, 0x67, 0xac, 0x8c, 0xd5, 0xc0, 0x6e, 0xe4, 0x16, 0xab, 0xe0, 0x9b, 0xfd, 0xf1, 0xf0, 0x98,
+	0x63, 0x33, 0x01, 0xdf, 0x74, 0xbd, 0x7e, 0xdf, 0xe1, 0xac, 0xaa, 0xad, 0xa4, 0x94, 0x2f, 0x5b,
+	0x24, 0x4d, 0x6b, 0x3a, 0x8e, 0x37, 0x0e, 0xd9, 0x52, 0xfd, 0x7f, 0xe5, 0x20, 0x8f, 0xbb, 0x1b,
+	0x9c, 0x3b, 0x03, 0xd4, 0x33, 0x72, 0xee, 0xe0, 0x73, 0x34, 0x03, 0xb3, 0xf1, 0x0c, 0xd4, 0xde,
+	0x91, 0x23, 0x9d, 0x9b, 0x43, 0xcb, 0x22, 0xe3, 0xe4, 0x20, 0x6b, 0x90, 0x1f, 0xda, 0x43, 0x2e,
+	0x75, 0x1d, 0x3d, 0x23, 0x2c, 0xc0, 0xf5, 0xb8, 0x40, 0x0e, 0x15, 0x7a, 0xc6, 0x59, 0x63, 0xe2,
+	0xb2, 0xd0, 0x08, 0x69, 0x0e, 0xe4, 0x0c, 0x55, 0x9c, 0xa1, 0xbd, 0x2a, 0x33, 0xb5, 0xd7, 0xbb,
+	0x4a, 0x7b, 0x95, 0xe6, 0x98, 0xf5, 0x54, 0xcd, 0xa4, 0xe6, 0x8a, 0x95, 0x46, 0x79, 0x7e, 0xf2,
+	0xc4, 0x62, 0xb2, 0x25, 0xa5, 0x36, 0x5e, 0xe8, 0xca, 0xa2, 0x97, 0x59, 0x06, 0x47, 0x93, 0xa6,
+	0xab, 0xd0, 0x79, 0x87, 0xb6, 0xc5, 0x3d, 0x96, 0xa3, 0x85, 0x70, 0x6c, 0xd9, 0x1e, 0xcb, 0xa3,
+	0xe5, 0x75, 0xb0, 0xb5, 0xcd, 0x0a, 0xfa, 0x4b, 0x89, 0x25, 0xa9, 0x31, 0x0e, 0x3d, 0xb6, 0x10,
+	0x89, 0x6f, 0x46, 0x48, 0xe3, 0x31, 0xb7, 0x58, 0x56, 0xff, 0xca, 0x0c, 0x35, 0xbb, 0x04, 0x95,
+	0xc7, 0x23, 0xc7, 0x33, 0xad, 0x4b, 0xf4, 0xec, 0x22, 0x40, 0xbc, 0xab, 0xae, 0xff, 0xf2, 0x5a,
+	0xbc, 0x9c, 0xa3, 0x2d, 0x1a, 0x78, 0x63, 0xbf, 0xc7, 0x49, 0x85, 0x54, 0x0c, 0x59, 0xd2, 0xbe,
+	0x09, 0x05, 0x7c, 0xaf, 0x5c, 0x3b, 0x6b, 0x73, 0xed, 0xe5, 0xd6, 0x0f, 0x6d, 0xfe, 0xcc, 0x10,
+	0x84, 0xda, 0x5d, 0x00, 0xb3, 0x17, 0xda, 0xa7, 0x1c, 0x81, 0x72, 0xb2, 0x27, 0x20, 0xda, 0x9b,
+	0x49, 0xf3, 0xe5, 0x72, 0xdf, 0x64, 0xc2, 0xae, 0xd1, 0x0c, 0xa8, 0xe2, 0xd4, 0x1d, 0xb5, 0x7d,
+	0x9c, 0xed, 0xb5, 0x45, 0x22, 0xfc, 0xf2, 0x7c, 0xd5, 0x7b, 0x18, 0x11, 0x1a, 0x49, 0x26, 0xda,
+	0x63, 0x58, 0x14, 0x7e, 0x36, 0xc9, 0x74, 0x89, 0x98, 0xbe, 0x36, 0x1f, 0xd3, 0x76, 0x4c, 0x69,
+	0xa4, 0xd8, 0x4c, 0xbb, 0x2a, 0x0b, 0xd7, 0x76, 0x55, 0xbe, 0x04, 0xcb, 0xdd, 0xf4, 0x2c, 0x10,
+	0x4b, 0xc5, 0x04, 0x54, 0xd3, 0x61, 0xd1, 0x0e, 0x62, 0x4f, 0x29, 0xf9, 0x48, 0xca, 0x46, 0x0a,
+	0x56, 0xff, 0x17, 0x65, 0xc8, 0x53, 0xcf, 0x4f, 0xfa, 0xb8, 0x36, 0x53, 0x2a, 0xfd, 0xd5, 0xf9,
+	0x87, 0x7a, 0x62, 0xc6, 0x93, 0x06, 0xc9, 0x25, 0x34, 0xc8, 0x37, 0xa1, 0x10, 0x78, 0x7e, 0xa8,
+	0x86, 0x77, 0x4e, 0x21, 0xea, 0x78, 0x7e, 0x68, 0x08, 0x42, 0x6d, 0x1b, 0x4a, 0x27, 0xb6, 0x13,
+	0x72, 0x5f, 0x75, 0xde, 0x2b, 0xf3, 0xf1, 0xd8, 0x26, 0x22, 0x43, 0x11, 0x6b, 0xbb, 0x49, 0x61,
+	0x2b, 0xde, 0xcb, 0x5d, 0xe9, 0x0b, 0x88, 0x38, 0xcd, 0x92, 0xc1, 0x35, 0x60, 0x3d, 0xef, 0x94,
+	0xfb, 0x46, 0xc2, 0x59, 0x29, 0x16, 0xe9, 0x29, 0x38, 0xfa, 0x74, 0x07, 0xb6, 0xc5, 0xd1, 0xce,
+	0x21, 0x1d, 0x53, 0x36, 0xa2, 0xb2, 0xf6, 0x08, 0xca, 0xb4, 0x3f, 0x40, 0xad, 0x58, 0xb9, 0x76,
+	0xe7, 0x8b, 0xad, 0x8a, 0x62, 0x80, 0x1f, 0xa2, 0x8f, 0x6f, 0xdb, 0x21, 0xf9, 0xac, 0xcb, 0x46,
+	0x54, 0xc6, 0x0a, 0x93, 0xbc, 0x27, 0x2b, 0x5c, 0x15, 0x15, 0x9e, 0x84, 0xa3, 0x5b, 0x9e, 0x60,
+	0x13, 0x8b, 0x24, 0x4e, 0x35, 0x64, 0x3a, 0xfb, 0x25, 0x1a, 0x2c, 0xe8, 0x49, 0xdd, 0xb5, 0x87,
+	0x76, 0x58, 0x5b, 0x22, 0xd7, 0x6a, 0x0c, 0xd0, 0x5e, 0x81, 0x55, 0x8b, 0x9f, 0x98, 0x63, 0x27,
+	0xec, 0xf2, 0xe1, 0xc8, 0x31, 0x43, 0xde, 0xb2, 0x48, 0x46, 0x2b, 0xc6, 0xf4, 0x0b, 0xed, 0xcb,
+	0x70, 0x43, 0x02, 0xdb, 0x51, 0xa4, 0xa1, 0x65, 0x91, 0xfb, 0xae, 0x62, 0xcc, 0x7a, 0x85, 0xd3,
+	0x84, 0xbb, 0x56, 0xb2, 0x75, 0x4c, 0x4c, 0x93, 0x34, 0x14, 0xfb, 0x21, 0x08, 0x4d, 0x3f, 0x4c,
+	0x62, 0xae, 0x8a, 0x7e, 0x98, 0x84, 0x6b, 0x3f, 0x07, 0x2b, 0xd4, 0xd4, 0x2d, 0x33, 0xe4, 0x1b,
+	0xe3, 0xde, 0x53, 0x1e, 0x92, 0x3b, 0x6e, 0x79, 0x5e, 0xbd, 0x80, 0x74, 0xeb, 0x82, 0xd0, 0x98,
+	0xe4, 0x84, 0x1d, 0x42, 0x20, 0xb1, 0x92, 0x4b, 0x2f, 0xfa, 0x8d, 0x7b, 0xb9, 0xfb, 0x19, 0x63,
+	0xfa, 0x85, 0xfe, 0x73, 0x72, 0x95, 0x41, 0xfb, 0x00, 0xb7, 0xe1, 0x6a, 0x7d, 0x08, 0x42, 0x61,
+	0x70, 0x3c, 0x34, 0x1d, 0x87, 0xfb, 0xe7, 0x62, 0x0f, 0xff, 0xc8, 0x74, 0x8f, 0x4d, 0x97, 0xe5,
+	0xc8, 0x84, 0x30, 0x1d, 0xee, 0x5a, 0xa6, 0x2f, 0x0c, 0x8e, 0x87, 0x64, 0xaf, 0x14, 0xf0, 0x05,
+	0x86, 0x2f, 0x68, 0x53, 0x55, 0xd4, 0xef, 0x43, 0x9e, 0xe4, 0xa7, 0x02, 0x05, 0xb1, 0x25, 0x24,
+	0xf7, 0x80, 0xdc, 0x0e, 0xd2, 0xf2, 0xb3, 0x8b, 0xba, 0x86, 0x65, 0xeb, 0x7f, 0xaf, 0x08, 0x65,
+	0xd5, 0x43, 0x2a, 0x88, 0x92, 0x89, 0x83, 0x28, 0x68, 0xb3, 0x06, 0x87, 0x76, 0x60, 0x1f, 0x4b,
+	0x1b, 0xbc, 0x6c, 0xc4, 0x00, 0x34, 0xfb, 0x9e, 0xd9, 0x56, 0x38, 0x20, 0x05, 0x51, 0x30, 0x44,
+	0x01, 0x9d, 0xd8, 0x16, 0x0e, 0xba, 0xdb, 0x73, 0xc6, 0x16, 0xc7, 0x5a, 0x49, 0x9f, 0xc8, 0x24,
+	0x58, 0xfb, 0x08, 0x20, 0xb4, 0x87, 0x7c, 0xdb, 0xf3, 0x87, 0x66, 0x28, 0x37, 0x42, 0x5f, 0xbd,
+	0xde, 0x14, 0x5e, 0xef, 0x46, 0x0c, 0x8c, 0x04, 0x33, 0x64, 0x8d, 0x5f, 0x93, 0xac, 0x4b, 0x9f,
+	0x8a, 0xf5, 0x56, 0xc4, 0xc0, 0x48, 0x30, 0xd3, 0xba, 0x50, 0x3a, 0xf1, 0xfc, 0xe1, 0xd8, 0x31,
+	0xa5, 0x81, 0xf1, 0xce, 0x35, 0xf9, 0x6e, 0x0b, 0x6a, 0x52, 0xb4, 0x8a, 0x55, 0xec, 0xd0, 0xaf,
+	0xcc, 0xe9, 0xd0, 0xd7, 0xbf, 0x05, 0x10, 0xd7, 0x50, 0xbb, 0x05, 0xda, 0x9e, 0xe7, 0x86, 0x83,
+	0xc6, 0xf1, 0xb1, 0xbf, 0xc1, 0x4f, 0x3c, 0x9f, 0x6f, 0x99, 0x68, 0x4b, 0x3c, 0x07, 0xab, 0x11,
+	0xbc, 0x71, 0x12, 0x72, 0x1f, 0xc1, 0x24, 0x02, 0x9d, 0x81, 0xe7, 0x87, 0xc2, 0xa0, 0xa5, 0xc7,
+	0xc7, 0x1d, 0x96, 0x43, 0xfb, 0xa5, 0xd5, 0x69, 0xb3, 0xbc, 0x7e, 0x1f, 0x20, 0xee, 0x5a, 0xda,
+	0xf8, 0xd1, 0xd3, 0x6b, 0x0f, 0xd8, 0x42, 0x5c, 0x7a, 0xf0, 0x06, 0xcb, 0xe8, 0x3f, 0xcd, 0x40,
+	0x35, 0xd1, 0xa4, 0xb4, 0x83, 0x60, 0xd3, 0x1b, 0xbb, 0xa1, 0xf0, 0x48, 0xd0, 0xe3, 0xa1, 0xe9,
+	0x8c, 0xd1, 0x92, 0x59, 0x85, 0x25, 0x2a, 0x6f, 0xd9, 0x41, 0x68, 0xbb, 0xbd, 0x90, 0xe5, 0x22,
+	0x14, 0x61, 0x05, 0xe5, 0x23, 0x94, 0x7d, 0x4f, 0x82, 0x0a, 0xe8, 0xb3, 0x3a, 0xe0, 0x7e, 0x8f,
+	0x2b, 0x24, 0xb2, 0xfc, 0x25, 0x24, 0x42, 0x13, 0x96, 0xbf, 0x19, 0x0e, 0x3a, 0xe3, 0x21, 0x2b,
+	0xa3, 0x05, 0x8d, 0x85, 0xc6, 0x29, 0xf7, 0xd1, 0x70, 0xab, 0xe0, 0x77, 0x10, 0x80, 0xb3, 0xc1,
+	0x74, 0x19, 0x28, 0xec, 0x3d, 0xdb, 0x65, 0xd5, 0xa8, 0x60, 0x9e, 0xb1, 0x45, 0xac, 0x3f, 0xcd,
+	0x56, 0xb6, 0x54, 0xff, 0xfd, 0x1c, 0xe4, 0x71, 0x11, 0xc3, 0x8d, 0x7d, 0x52, 0xd3, 0x88, 0xb9,
+	0x92, 0x04, 0x7d, 0xba, 0xa5, 0x17, 0x79, 0x27, 0x97, 0xde, 0xb7, 0xa1, 0xda, 0x1b, 0x07, 0xa1,
+	0x37, 0x24, 0xbb, 0x43, 0x86, 0xfb, 0x6e, 0x4d, 0xb9, 0xc8, 0xa8, 0x3b, 0x8d, 0x24, 0xaa, 0xf6,
+	0x26, 0x14, 0x4f, 0x84, 0xd4, 0x0b, 0x27, 0xd9, 0x67, 0x2e, 0x30, 0x4d, 0xa4, 0x64, 0x4b, 0x64,
+	0x6c, 0x97, 0x3d, 0x35, 0x63, 0x93, 0x20, 0x69, 0x62, 0x14, 0x23, 0x13, 0xe3, 0x5b, 0xb0, 0xcc,
+	0xb1, 0xc3, 0x0f, 0x1c, 0xb3, 0xc7, 0x87, 0xdc, 0x55, 0xd3, 0xec, 0x8d, 0x6b, 0xb4, 0x98, 0x46,
+	0x8c, 0x9a, 0x3d, 0xc1, 0x0b, 0x35, 0x8f, 0xeb, 0xa1, 0xa5, 0xa3, 0xbc, 0x18, 0x65, 0x23, 0x06,
+	0xe8, 0x9f, 0x97, 0xda, 0xb3, 0x04, 0xb9, 0x46, 0xd0, 0x93, 0xee, 0x1e, 0x1e, 0xf4, 0xc4, 0x5e,
+	0x72, 0x93, 0xba, 0x83, 0x65, 0xf5, 0xd7, 0xa0, 0x12, 0x7d, 0x01, 0x85, 0x67, 0xdf, 0x0b, 0x3b,
+	0x23, 0xde, 0xb3, 0x4f, 0x6c, 0x6e, 0x09, 0xf9, 0xec, 0xe0, 0x12, 0x21, 0x3c, 0xa6, 0x4d, 0xd7,
+	0x62, 0xd9, 0xfa, 0x7f, 0xae, 0x40, 0x51, 0x58, 0x1a, 0xb2, 0xc1, 0x95, 0xa8, 0xc1, 0x1f, 0x40,
+	0xd9, 0x1b, 0x71, 0xdf, 0x0c, 0x3d, 0x5f, 0xba, 0xa9, 0xde, 0xbc, 0x8e, 0xe5, 0xb2, 0xde, 0x96,
+	0xc4, 0x46, 0xc4, 0x66, 0x52, 0x9a, 0xb2, 0xd3, 0xd2, 0xb4, 0x06, 0x4c, 0x19, 0x29, 0x07, 0x3e,
+	0xd2, 0x85, 0xe7, 0xd2, 0xe9, 0x30, 0x05, 0xd7, 0xba, 0x50, 0xe9, 0x79, 0xae, 0x65, 0x47, 0x2e,
+	0xab, 0xe5, 0x07, 0x5f, 0xb9, 0x56, 0x0d, 0x37, 0x15, 0xb5, 0x11, 0x33, 0xd2, 0x5e, 0x81, 0xc2,
+	0x29, 0x8a, 0x19, 0xc9, 0xd3, 0xc5, 0x42, 0x28, 0x90, 0xb4, 0x8f, 0xa1, 0xfa, 0x9d, 0xb1, 0xdd,
+	0x7b, 0xda, 0x4e, 0xba, 0x44, 0xdf, 0xbe, 0x56, 0x2d, 0x3e, 0x88, 0xe9, 0x8d, 0x24, 0xb3, 0x84,
+	0x68, 0x97, 0xfe, 0x18, 0xa2, 0x5d, 0x9e, 0x16, 0x6d, 0x03, 0x96, 0x5c, 0x1e, 0x84, 0xdc, 0xda,
+	0x96, 0x86, 0x29, 0x7c, 0x0a, 0xc3, 0x34, 0xcd, 0x42, 0xff, 0x1c, 0x94, 0xd5, 0x80, 0x6b, 0x45,
+	0xc8, 0xee, 0xe3, 0x0e, 0xb0, 0x08, 0xd9, 0xb6, 0x2f, 0xa4, 0xad, 0x81, 0xd2, 0xa6, 0xff, 0x46,
+	0x16, 0x2a, 0x51, 0xa7, 0xa7, 0x35, 0x67, 0xf3, 0x3b, 0x63, 0x13, 0x7d, 0xb9, 0xe8, 0x1b, 0xf0,
+	0x42, 0x51, 0x22, 0x65, 0xfd, 0x90, 0xb2, 0x15, 0xd0, 0xa3, 0x8f, 0x06, 0x03, 0x0f, 0xd0, 0x99,
+	0xaf, 0xc1, 0xb2, 0x04, 0xb7, 0x7d, 0x81, 0x5a, 0x40, 0xc5, 0x87, 0x6f, 0x15, 0xa0, 0x48, 0xe8,
+	0xf6, 0x53, 0x2e, 0x14, 0xe4, 0xbe, 0x17, 0x52, 0xa1, 0x8c, 0x95, 0x6a, 0xb9, 0xac, 0x82, 0xdf,
+	0xdc, 0xf7, 0xc2, 0x16, 0xaa, 0xc4, 0x68, 0x2f, 0x5a, 0x55, 0x9f, 0xa7, 0x12, 0x69, 0xc4, 0x86,
+	0xe3, 0xb4, 0x5c, 0xb6, 0x24, 0x5f, 0x88, 0xd2, 0x32, 0x72, 0x6c, 0x9e, 0x99, 0x3d, 0x24, 0x5f,
+	0x41, 0x0d, 0x8b, 0x34, 0xb2, 0xcc, 0x70, 0x4a, 0x36, 0xcf, 0xec, 0x20, 0x0c, 0xd8, 0x2a, 0xe9,
+	0x54, 0xde, 0xe7, 0x67, 0x4c, 0x93, 0x1c, 0x44, 0xe9, 0x06, 0x6a, 0xf2, 0x3d, 0x33, 0xec, 0x0d,
+	0x78, 0xb0, 0x3d, 0x76, 0x1c, 0xf2, 0x19, 0xdf, 0xd4, 0xff, 0x63, 0x16, 0xaa, 0x09, 0x71, 0xc0,
+	0x9d, 0x31, 0xb1, 0xc5, 0x85, 0x4f, 0x6c, 0x94, 0x3f, 0xc2, 0x4e, 0xf7, 0x2d, 0xb5, 0xa8, 0x75,
+	0x3d, 0x7c, 0xcc, 0x92, 0x3d, 0xe4, 0x0d, 0x3d, 0xdf, 0xf7, 0x9e, 0x09, 0xb3, 0x69, 0xd7, 0x0c,
+	0xc2, 0x27, 0x9c, 0x3f, 0x65, 0x79, 0xec, 0x98, 0xcd, 0xb1, 0xef, 0x73, 0x57, 0x00, 0xc8, 0x78,
+	0xda, 0xe7, 0x67, 0xa2, 0x54, 0x44, 0xa6, 0x88, 0x4c, 0xab, 0x26, 0x2b, 0xa1, 0xda, 0x90, 0xd8,
+	0x02, 0x52, 0x46, 0x04, 0x44, 0x17, 0xc5, 0x0a, 0x56, 0x5c, 0x58, 0x76, 0xed, 0x93, 0x2d, 0xf3,
+	0x3c, 0x68, 0xf4, 0x3d, 0x06, 0x93, 0xc0, 0x7d, 0xef, 0x99, 0xe8, 0x4b, 0xe4, 0xfc, 0x11, 0x37,
+	0x7d, 0xb6, 0x98, 0xa8, 0x06, 0x01, 0x96, 0x54, 0x35, 0xa8, 0xb4, 0xac, 0xdd, 0x04, 0x86, 0xc8,
+	0x49, 0x2e, 0x6c, 0x05, 0xa1, 0x88, 0x93, 0x82, 0x32, 0x1a, 0x6a, 0x33, 0x08, 0x3f, 0x18, 0x9b,
+	0x3e, 0x4a, 0xc6, 0x2a, 0xca, 0x83, 0xe4, 0xad, 0x60, 0x1a, 0x22, 0x21, 0xa9, 0x02, 0xdc, 0xa8,
+	0x8f, 0x01, 0xe2, 0xdd, 0x33, 0x7a, 0x0d, 0x50, 0x9a, 0xa3, 0x68, 0x8f, 0x2c, 0x69, 0x6d, 0x00,
+	0x7c, 0x22, 0x4c, 0xe5, 0x3a, 0xb8, 0xc6, 0x96, 0x86, 0xe8, 0x8c, 0x04, 0x8b, 0xfa, 0x9f, 0x87,
+	0x4a, 0xf4, 0x02, 0x9d, 0x45, 0x64, 0x1b, 0x47, 0x9f, 0x55, 0x45, 0x34, 0x2e, 0x6d, 0xd7, 0xe2,
+	0x67, 0xa4, 0x14, 0x0b, 0x86, 0x28, 0x60, 0x2d, 0x07, 0xb6, 0x65, 0x71, 0x57, 0xc5, 0xe4, 0x44,
+	0x69, 0x56, 0xe6, 0x44, 0x7e, 0x66, 0xe6, 0x44, 0xfd, 0xe7, 0xa1, 0x9a, 0xd8, 0xde, 0x5f, 0xd8,
+	0xec, 0x44, 0xc5, 0xb2, 0xe9, 0x8a, 0xdd, 0x81, 0x8a, 0xca, 0xe0, 0x09, 0x68, 0x61, 0xae, 0x18,
+	0x31, 0xa0, 0xfe, 0xaf, 0x73, 0x50, 0x10, 0x4d, 0x9b, 0xdc, 0x92, 0x6f, 0x43, 0x31, 0x08, 0xcd,
+	0x70, 0xac, 0xd2, 0x4e, 0xe6, 0xd4, 0x2e, 0x1d, 0xa2, 0xc1, 0x38, 0xa8, 0xa0, 0xd6, 0xde, 0x85,
+	0x5c, 0x68, 0xf6, 0xa5, 0x4b, 0xfb, 0x8b, 0xf3, 0x31, 0xe9, 0x9a, 0x7d, 0xcc, 0x45, 0x08, 0xcd,
+	0xbe, 0xb6, 0x0b, 0xe5, 0x9e, 0xf4, 0x42, 0x4a, 0x8d, 0x3e, 0xe7, 0xae, 0x59, 0xf9, 0x2e, 0x31,
+	0xa6, 0xab, 0x38, 0x68, 0xdf, 0x84, 0xbc, 0x85, 0x2b, 0xb4, 0xc8, 0xd8, 0x59, 0x9b, 0x7f, 0x1b,
+	0x85, 0xd1, 0x59, 0xa4, 0xc4, 0x6e, 0x71, 0x49, 0x9a, 0x6b, 0xc5, 0xeb, 0x74, 0x8b, 0x98, 0x01,
+	0xd8, 0x2d, 0x82, 0x1a, 0xf9, 0x88, 0x51, 0xa8, 0x95, 0xae, 0xc3, 0x47, 0xc8, 0x02, 0xf2, 0x11,
+	0xd4, 0x1b, 0x25, 0x28, 0xd0, 0x82, 0x56, 0xaf, 0x41, 0x51, 0xf4, 0xfd, 0xe4, 0x48, 0xd6, 0x6f,
+	0x43, 0xae, 0x6b, 0xf6, 0x71, 0xbb, 0x64, 0x5b, 0x81, 0x74, 0xb2, 0xe1, 0x63, 0xfd, 0xc5, 0xd8,
+	0xc3, 0x9b, 0x0c, 0x1e, 0x64, 0x52, 0xc1, 0x83, 0xba, 0x01, 0x79, 0xec, 0x01, 0x74, 0xaf, 0x9c,
+	0xf8, 0xde, 0x90, 0x5e, 0xe7, 0x0c, 0x7a, 0xc6, 0x4f, 0x85, 0x1e, 0x09, 0x48, 0xce, 0xc8, 0x86,
+	0x9e, 0xfe, 0x12, 0x14, 0xe5, 0xf6, 0x12, 0x63, 0xba, 0x64, 0xd1, 0x97, 0x21, 0x4f, 0x9a, 0x8a,
+	0xf4, 0x9d, 0x50, 0x42, 0xd9, 0xfa, 0xb7, 0xa0, 0x28, 0x7a, 0x24, 0xc5, 0x35, 0x33, 0xc5, 0x35,
+	0x83, 0x5c, 0xb1, 0x6e, 0x03, 0x33, 0xd8, 0x46, 0x34, 0x31, 0x8d, 0x54, 0x11, 0x67, 0xdd, 0xc0,
+	0x0c, 0xba, 0x9e, 0x0c, 0x78, 0x88, 0x42, 0xbd, 0xae, 0x5c, 0xe6, 0x33, 0xda, 0xfc, 0xab, 0x19,
+	0x58, 0x56, 0x5b, 0xcf, 0x27, 0xb6, 0x6b, 0x79, 0xcf, 0x66, 0x6e, 0xc9, 0x33, 0x17, 0x6c, 0xc9,
+	0xa7, 0xb7, 0xf9, 0xd9, 0x99, 0xdb, 0x7c, 0xd5, 0xac, 0xdc, 0x54, 0x67, 0xe5, 0x55, 0x67, 0xd5,
+	0xef, 0x5c, 0xb6, 0x97, 0xad, 0xff, 0xab, 0x1c, 0x6e, 0x7b, 0x31, 0x63, 0x63, 0x56, 0xa4, 0xe9,
+	0x7d, 0xa8, 0x8c, 0x7c, 0xaf, 0xc7, 0x83, 0xc0, 0xf3, 0xa5, 0xe9, 0xfe, 0xca, 0xd5, 0x59, 0x20,
+	0xeb, 0x07, 0x8a, 0xc6, 0x88, 0xc9, 0xf5, 0xff, 0x90, 0x85, 0x4a, 0xf4, 0x42, 0xec, 0xb6, 0x43,
+	0x7e, 0x26, 0xa2, 0x0a, 0x7b, 0xdc, 0x1f, 0x9a, 0xb6, 0x25, 0x46, 0x6f, 0x73, 0x60, 0xaa, 0x2d,
+	0xd8, 0x47, 0xde, 0x38, 0x1c, 0x1f, 0x73, 0xe1, 0x4d, 0x3e, 0xb4, 0x87, 0x1c, 0xbd, 0xc9, 0x18,
+	0xc7, 0x45, 0xcd, 0xd5, 0x73, 0xbc, 0xb1, 0xc5, 0x0a, 0x58, 0x7e, 0x48, 0xc6, 0xd7, 0x9e, 0x39,
+	0x0a, 0xc4, 0x8a, 0xbe, 0x67, 0xfb, 0x1e, 0x2b, 0x21, 0xd1, 0xb6, 0xdd, 0x1f, 0x9a, 0xac, 0x8c,
+	0xcc, 0xba, 0xcf, 0xec, 0x10, 0x75, 0x7c, 0x05, 0x37, 0x51, 0xed, 0x11, 0x77, 0x3b, 0xa1, 0xcf,
+	0x79, 0xb8, 0x67, 0x8e, 0x44, 0x78, 0xc1, 0xe0, 0x96, 0x65, 0x87, 0x62, 0x45, 0xda, 0x36, 0x7b,
+	0x1c, 0x73, 0x8c, 0xd8, 0x22, 0x2e, 0x6c, 0x2d, 0x37, 0x08, 0x31, 0x08, 0x32, 0x14, 0xeb, 0x51,
+	0x97, 0x3b, 0x9c, 0x4a, 0xcb, 0xf4, 0x6d, 0x3b, 0x1c, 0x8c, 0x8f, 0x1f, 0xa2, 0x8f, 0x62, 0x45,
+	0x84, 0x7c, 0x2d, 0x3e, 0xe2, 0xb8, 0xc2, 0x2f, 0x42, 0x79, 0xc3, 0x76, 0xec, 0x63, 0xdb, 0xb1,
+	0xd9, 0x2a, 0xa2, 0x36, 0xcf, 0x7a, 0xa6, 0x63, 0x5b, 0xbe, 0xf9, 0x8c, 0x69, 0x58, 0xb9, 0x47,
+	0xbe, 0xf7, 0xd4, 0x66, 0x37, 0x10, 0x91, 0x5c, 0x16, 0xa7, 0xf6, 0x27, 0xec, 0x26, 0x85, 0xad,
+	0x9f, 0x62, 0x40, 0xf1, 0xc4, 0x3c, 0x66, 0xcf, 0xc5, 0xde, 0xf5, 0x5b, 0x58, 0xc9, 0x2d, 0xdf,
+	0x7c, 0x66, 0x7b, 0xec, 0x36, 0x6d, 0x50, 0x47, 0x5e, 0x68, 0x9f, 0x9c, 0xb3, 0x5a, 0x7d, 0x15,
+	0x56, 0x26, 0x32, 0x67, 0xea, 0x25, 0xe9, 0x42, 0xa9, 0x2f, 0x41, 0x35, 0x91, 0xd2, 0x50, 0x7f,
+	0x09, 0xca, 0x2a, 0xe1, 0x01, 0x3d, 0x69, 0x76, 0x20, 0x42, 0x35, 0x72, 0x3a, 0x46, 0xe5, 0xfa,
+	0x7f, 0xca, 0x40, 0x51, 0x64, 0x9b, 0x68, 0x1b, 0x51, 0x76, 0x58, 0x66, 0x8e, 0x0c, 0x03, 0x41,
+	0x24, 0xf3, 0x33, 0xa2, 0x14, 0xb1, 0x9b, 0x50, 0x70, 0xc8, 0x65, 0x26, 0x17, 0x2e, 0x2a, 0x24,
+	0xd6, 0x99, 0x5c, 0x6a, 0x9d, 0xb9, 0x03, 0x15, 0x73, 0x1c, 0x7a, 0x14, 0x48, 0x97, 0x93, 0x2e,
+	0x06, 0xe8, 0x8d, 0x28, 0x63, 0x44, 0x05, 0x0f, 0x68, 0xfe, 0x77, 0x7d, 0xce, 0x59, 0x26, 0x72,
+	0x18, 0x65, 0xc9, 0x96, 0xf0, 0x86, 0x23, 0xb3, 0x17, 0x12, 0x80, 0x4c, 0x43, 0x5c, 0x64, 0x59,
+	0xbe, 0x5e, 0x84, 0x3c, 0x66, 0xc3, 0xe8, 0x27, 0x50, 0x3e, 0xf0, 0x82, 0x49, 0x43, 0xb3, 0x04,
+	0xb9, 0xae, 0x37, 0x12, 0xdb, 0xa6, 0x0d, 0x2f, 0xa4, 0x6d, 0x13, 0xf1, 0xe5, 0x27, 0xa1, 0x90,
+	0x45, 0x03, 0xd3, 0x3c, 0x85, 0xb3, 0xa9, 0xe5, 0xba, 0xdc, 0x67, 0x05, 0x1c, 0x10, 0x83, 0x8f,
+	0x70, 0xab, 0xc6, 0x8a, 0x38, 0xd8, 0x04, 0xdf, 0xb6, 0xfd, 0x20, 0x64, 0x25, 0xbd, 0x05, 0x05,
+	0x91, 0x26, 0xb8, 0x04, 0x15, 0x7a, 0x20, 0x56, 0x0b, 0x58, 0x45, 0x2a, 0x6e, 0x72, 0x17, 0x45,
+	0x93, 0x5c, 0x02, 0x04, 0x10, 0x1f, 0xc8, 0xa2, 0xa1, 0x45, 0xe5, 0xf7, 0xc7, 0x01, 0x8d, 0x75,
+	0x4e, 0x7f, 0x02, 0x4b, 0xa9, 0x44, 0x44, 0xb4, 0x7e, 0x52, 0x00, 0xac, 0xfa, 0x82, 0x76, 0x1b,
+	0x6e, 0xa4, 0xa0, 0x7b, 0xb6, 0x65, 0x51, 0xb4, 0x66, 0xf2, 0x85, 0x6a, 0xe0, 0x46, 0x05, 0x4a,
+	0x3d, 0x31, 0x86, 0xfa, 0x01, 0x2c, 0xd1, 0xa0, 0x62, 0x92, 0x6c, 0xdb, 0x75, 0xce, 0xff, 0xd8,
+	0xd9, 0xa2, 0xfa, 0xcb, 0xd2, 0x6b, 0x90, 0xd2, 0xc4, 0x85, 0x29, 0x4d, 0x5c, 0x20, 0xfd, 0xfe,
+	0x97, 0x17, 0xa1, 0xd4, 0xe8, 0xf5, 0xd0, 0xcf, 0x31, 0xf5, 0xe5, 0x37, 0xa1, 0xd8, 0xf3, 0xdc,
+	0x13, 0xbb, 0x2f, 0xd7, 0xe9, 0xc9, 0xed, 0x8e, 0xa4, 0x43, 0x71, 0x3c, 0xb1, 0xfb, 0x86, 0x44,
+	0x46, 0x32, 0x69, 0x67, 0x14, 0x2e, 0x25, 0x13, 0x8b, 0x5b, 0x64, 0x56, 0xbc, 0x0a, 0x79, 0x1b,
+	0xf3, 0x9d, 0xc5, 0x2a, 0xfc, 0xc2, 0x05, 0x44, 0x94, 0xf3, 0x4c, 0x88, 0xf5, 0xdf, 0xcd, 0x60,
+	0xc6, 0x11, 0x7d, 0x92, 0x94, 0x38, 0x4e, 0x35, 0xb5, 0xac, 0xca, 0x39, 0x36, 0x01, 0xc5, 0x9d,
+	0x98, 0x84, 0xf0, 0xe3, 0x71, 0x5f, 0x3a, 0x14, 0x93, 0x20, 0xed, 0x6d, 0xb8, 0x2d, 0x8a, 0x07,
+	0x3e, 0xf7, 0xb9, 0xc3, 0xcd, 0x80, 0x6f, 0x0e, 0x4c, 0xd7, 0xe5, 0x8e, 0x5c, 0xa9, 0x2e, 0x7a,
+	0x8d, 0xe1, 0x12, 0xf1, 0xaa, 0x33, 0x32, 0x7b, 0x3c, 0x90, 0x73, 0x29, 0x05, 0xd3, 0xbe, 0x04,
+	0x05, 0xca, 0x86, 0xaf, 0x59, 0x97, 0x0f, 0xa5, 0xc0, 0xaa, 0x7b, 0x91, 0x05, 0xd0, 0x00, 0x10,
+	0xdd, 0x84, 0x9e, 0x04, 0xa9, 0x1b, 0x3e, 0x7b, 0x69, 0xbf, 0x22, 0xa2, 0x91, 0x20, 0xc2, 0xfa,
+	0x59, 0xdc, 0xe1, 0x94, 0xb6, 0x8c, 0x16, 0x93, 0x58, 0xe3, 0x53, 0xb0, 0xfa, 0xff, 0xc9, 0x43,
+	0x1e, 0x7b, 0x18, 0x91, 0x07, 0xde, 0x90, 0x47, 0x11, 0x22, 0xb1, 0x26, 0xa6, 0x60, 0x68, 0xf2,
+	0x9a, 0x22, 0x49, 0x27, 0x42, 0x13, 0xaa, 0x65, 0x12, 0x8c, 0x98, 0x23, 0xdf, 0xc3, 0xf4, 0xd7,
+	0x08, 0x53, 0x1a, 0xc7, 0x13, 0x60, 0xed, 0x2b, 0x70, 0x0b, 0xf3, 0x08, 0x78, 0x48, 0xb3, 0xfb,
+	0x89, 0xe7, 0x3f, 0x0d, 0xb0, 0xe7, 0x5a, 0x96, 0x0c, 0x2d, 0x5c, 0xf0, 0x16, 0x7d, 0xdf, 0xcf,
+	0x54, 0x31, 0xfa, 0x86, 0x70, 0xee, 0x4f, 0xbf, 0x40, 0x31, 0x20, 0x00, 0xea, 0xa5, 0x96, 0x25,
+	0xfd, 0xfa, 0x49, 0x10, 0xaa, 0x6b, 0x8b, 0x9f, 0xda, 0xf4, 0xe5, 0x32, 0xbd, 0x8e, 0xca, 0x28,
+	0x6c, 0xa6, 0xe8, 0xea, 0x8e, 0xac, 0x9b, 0x8c, 0x22, 0xa7, 0xa1, 0xa8, 0x59, 0x45, 0xe6, 0x60,
+	0xd0, 0xb2, 0x28, 0x7a, 0x52, 0x31, 0x62, 0x40, 0x54, 0x87, 0x43, 0xa1, 0x94, 0x97, 0x12, 0x75,
+	0x10, 0x20, 0xc4, 0x08, 0x79, 0x6f, 0xa0, 0x3e, 0x22, 0x42, 0x1b, 0x49, 0x10, 0x86, 0x43, 0xfb,
+	0x66, 0xc8, 0x9f, 0x99, 0xe7, 0x8f, 0x7d, 0xa7, 0xc6, 0x09, 0x21, 0x01, 0x41, 0x3b, 0xc8, 0xf1,
+	0x7a, 0xa6, 0xd3, 0x09, 0x3d, 0xf4, 0x3d, 0x1e, 0x98, 0xe1, 0xa0, 0xd6, 0x27, 0xac, 0x29, 0x38,
+	0xb6, 0x18, 0xdd, 0xd7, 0x1f, 0x7b, 0x2e, 0xaf, 0x0d, 0x44, 0x8b, 0x55, 0x19, 0x6b, 0x62, 0xba,
+	0xa6, 0x73, 0x1e, 0xda, 0x3d, 0x6c, 0x8b, 0x2d, 0x6a, 0x92, 0x00, 0x61, 0x5b, 0x5d, 0x1e, 0x62,
+	0x4f, 0xb7, 0xac, 0xda, 0xb7, 0x45, 0x5b, 0x23, 0x00, 0x8e, 0x3f, 0x0f, 0x07, 0xdc, 0xe7, 0xe3,
+	0x61, 0xc3, 0xb2, 0x7c, 0x1e, 0x04, 0xb5, 0xa7, 0x62, 0xfc, 0x27, 0xc0, 0xf5, 0x7f, 0x90, 0xa5,
+	0x68, 0xf5, 0xa0, 0xfe, 0x5f, 0x33, 0x50, 0x6a, 0x8c, 0x46, 0x24, 0x8c, 0x18, 0xd0, 0x1f, 0x8d,
+	0x76, 0xe2, 0xfc, 0x02, 0x55, 0x94, 0x6f, 0xf6, 0xe3, 0x2c, 0x03, 0x55, 0xc4, 0xe5, 0xce, 0x1c,
+	0x8d, 0xe2, 0x8c, 0x7f, 0x59, 0xc2, 0x8a, 0xf6, 0xc4, 0x69, 0x8b, 0x46, 0x28, 0xb3, 0x06, 0x62,
+	0x00, 0x76, 0x02, 0x3f, 0x1b, 0xd9, 0x3e, 0x8f, 0x72, 0x07, 0xa2, 0x32, 0xa5, 0x4c, 0xf6, 0xbc,
+	0x91, 0x4a, 0x0a, 0xf8, 0xe2, 0x05, 0xb3, 0x0f, 0x6b, 0xbf, 0xbe, 0x8b, 0xbd, 0xdb, 0x18, 0xd9,
+	0x1d, 0x24, 0x30, 0x04, 0x9d, 0x30, 0x01, 0x1a, 0x14, 0xac, 0x56, 0x51, 0x3b, 0x55, 0xd6, 0x5f,
+	0x87, 0xa5, 0x14, 0x0d, 0x2e, 0x71, 0x14, 0xe6, 0x22, 0x3f, 0x61, 0x15, 0x4a, 0xef, 0x07, 0x9e,
+	0xdb, 0x38, 0x68, 0x89, 0x45, 0x17, 0x1d, 0x14, 0x2c, 0xab, 0xb7, 0x01, 0xe2, 0xb9, 0x8e, 0x0b,
+	0xa8, 0x60, 0xc6, 0x16, 0x84, 0x57, 0xda, 0xc5, 0xf0, 0xfd, 0x96, 0x9c, 0xde, 0x2c, 0x83, 0x40,
+	0xf2, 0x36, 0x72, 0x2b, 0x02, 0x92, 0xe5, 0x47, 0x25, 0x6e, 0xb1, 0x9c, 0xfe, 0xbf, 0x33, 0x50,
+	0x4d, 0x24, 0x7e, 0xfd, 0x09, 0x26, 0xab, 0x61, 0xdb, 0xd1, 0xb2, 0x42, 0x39, 0x15, 0x03, 0x12,
+	0x95, 0x51, 0x8a, 0x65, 0x5e, 0x1a, 0xbe, 0x15, 0xbe, 0xc5, 0x04, 0xe4, 0x53, 0x25, 0xaa, 0xe9,
+	0x0f, 0xa4, 0x83, 0xb6, 0x0a, 0xa5, 0xc7, 0xee, 0x53, 0xd7, 0x7b, 0xe6, 0xb2, 0x85, 0x28, 0xfb,
+	0x30, 0x95, 0x47, 0xa1, 0x12, 0x04, 0x73, 0xfa, 0x3f, 0xcd, 0x4f, 0x24, 0xea, 0x36, 0xa3, 0x2d,
+	0x1d, 0xee, 0x37, 0xa6, 0x33, 0x2b, 0x93, 0xc8, 0x72, 0x23, 0x97, 0x00, 0xa9, 0x1d, 0x1d, 0xee,
+	0x78, 0xa3, 0x34, 0xf6, 0xec, 0xcc, 0xdc, 0x82, 0x14, 0x23, 0xb5, 0x5a, 0x25, 0x81, 0x71, 0x3e,
+	0x7b, 0xfd, 0x2f, 0x65, 0xe0, 0xe6, 0x2c, 0x94, 0xe4, 0x19, 0x98, 0x4c, 0xfa, 0x0c, 0x4c, 0x67,
+	0xe2, 0xfc, 0x48, 0x96, 0x5a, 0xf3, 0xea, 0x35, 0x2b, 0x91, 0x3e, 0x4d, 0xa2, 0xff, 0x38, 0x03,
+	0xab, 0x53, 0x6d, 0x4e, 0x58, 0x76, 0x68, 0x41, 0x93, 0x64, 0x89, 0xf4, 0xce, 0x28, 0xe1, 0x4e,
+	0x44, 0x14, 0xc9, 0xe6, 0x09, 0x44, 0x06, 0x93, 0x3c, 0x45, 0x23, 0xf6, 0x1b, 0x38, 0x6a, 0xb8,
+	0xa4, 0xf6, 0xb9, 0x88, 0xb7, 0x08, 0xf3, 0x53, 0x42, 0x8a, 0x62, 0x4f, 0x20, 0xa2, 0xba, 0xac,
+	0x44, 0x69, 0xa3, 0xe3, 0x91, 0x63, 0xf7, 0xb0, 0x58, 0xd6, 0xea, 0x70, 0x4b, 0x1c, 0xa5, 0x92,
+	0x0e, 0x96, 0x93, 0xee, 0xc0, 0xa6, 0xc9, 0xc1, 0x2a, 0xf8, 0x9d, 0x83, 0xf1, 0xb1, 0x63, 0x07,
+	0x03, 0x06, 0xba, 0x01, 0x37, 0x66, 0x34, 0x90, 0xaa, 0x7c, 0x28, 0xab, 0xbf, 0x0c, 0xb0, 0x75,
+	0xa8, 0x2a, 0xcd, 0x32, 0xe8, 0xd1, 0xda, 0x3a, 0x4c, 0x72, 0x97, 0x93, 0xe7, 0x10, 0xb5, 0x75,
+	0xc0, 0x72, 0xfa, 0xaf, 0x64, 0xd4, 0x26, 0xb5, 0xfe, 0xe7, 0x60, 0x49, 0x54, 0xf8, 0xc0, 0x3c,
+	0x77, 0x3c, 0xd3, 0xd2, 0x9a, 0xb0, 0x1c, 0x44, 0x87, 0xfd, 0x12, 0x4b, 0xf8, 0xa4, 0x69, 0xd4,
+	0x49, 0x21, 0x19, 0x13, 0x44, 0x6a, 0x4f, 0x99, 0x8d, 0xe3, 0xa3, 0x1a, 0xb9, 0x3f, 0x4c, 0x9a,
+	0x72, 0x8b, 0xe4, 0xd0, 0x30, 0xf5, 0x2f, 0xc1, 0x6a, 0x27, 0x5e, 0xee, 0xc4, 0x1e, 0x03, 0x85,
+	0x43, 0xac, 0x95, 0x5b, 0x4a, 0x38, 0x64, 0x51, 0xff, 0xdd, 0x12, 0x40, 0x1c, 0xf8, 0x9e, 0x31,
+	0xe7, 0x67, 0xe5, 0x71, 0x4d, 0xa5, 0xa1, 0xe4, 0xae, 0x9d, 0x86, 0xf2, 0x76, 0xb4, 0xd5, 0x11,
+	0x71, 0xa2, 0xc9, 0xc3, 0x2c, 0x71, 0x9d, 0x26, 0x37, 0x38, 0xa9, 0x34, 0xc7, 0xc2, 0x64, 0x9a,
+	0xe3, 0xbd, 0xe9, 0x9c, 0xe8, 0x09, 0x65, 0x14, 0xfb, 0xf0, 0x4a, 0x29, 0x1f, 0x5e, 0x1d, 0x4f,
+	0x8a, 0x98, 0x96, 0xe7, 0x3a, 0xe7, 0x2a, 0xdb, 0x41, 0x95, 0xb5, 0xd7, 0xa1, 0x10, 0xd2, 0x79,
+	0xc5, 0xf2, 0xbd, 0xdc, 0xd5, 0x03, 0x27, 0x70, 0x51, 0xb3, 0xd9, 0x81, 0x4c, 0x64, 0x16, 0x56,
+	0x42, 0xd9, 0x48, 0x40, 0xb4, 0x75, 0xd0, 0x6c, 0xdc, 0xef, 0x3a, 0x0e, 0xb7, 0x36, 0xce, 0xb7,
+	0x44, 0x12, 0x02, 0x59, 0x3a, 0x65, 0x63, 0xc6, 0x1b, 0x35, 0xfe, 0x8b, 0xf1, 0xf8, 0x53, 0x95,
+	0x4f, 0xed, 0x00, 0x5b, 0xba, 0x24, 0x16, 0x2c, 0x55, 0x46, 0x5b, 0x4a, 0x4d, 0x58, 0xd1, 0x97,
+	0x24, 0xbd, 0x71, 0x26, 0xcf, 0x05, 0x6f, 0x55, 0xf7, 0x0a, 0x27, 0xe6, 0x8a, 0x58, 0x22, 0x23,
+	0x00, 0x69, 0xf2, 0x9e, 0xe7, 0xd2, 0x9a, 0xcb, 0xa4, 0x26, 0x97, 0x65, 0x6c, 0xef, 0xc8, 0x19,
+	0xfb, 0xa6, 0x43, 0x6f, 0x45, 0x12, 0x44, 0x02, 0xa2, 0xff, 0xcf, 0x6c, 0xb4, 0x9d, 0xac, 0x40,
+	0xe1, 0xd8, 0x0c, 0xec, 0x9e, 0x58, 0xdd, 0xa4, 0x19, 0x28, 0x56, 0xb7, 0xd0, 0xb3, 0x3c, 0x96,
+	0xc5, 0x9d, 0x61, 0xc0, 0x65, 0x5c, 0x36, 0x3e, 0x1d, 0xca, 0xf2, 0xa8, 0x02, 0x94, 0x24, 0x89,
+	0x4c, 0x47, 0x22, 0x25, 0xbf, 0xb9, 0x15, 0xe5, 0x90, 0x93, 0x47, 0x82, 0x96, 0x18, 0x56, 0x46,
+	0x1c, 0xd7, 0x0b, 0xb9, 0x88, 0x31, 0x90, 0xdc, 0x33, 0x40, 0x36, 0xea, 0x68, 0x13, 0xab, 0xe2,
+	0x56, 0x4d, 0x31, 0x15, 0xae, 0xfe, 0x80, 0x36, 0xb2, 0x8b, 0x38, 0xef, 0xd3, 0x2f, 0xd8, 0x12,
+	0xd6, 0x28, 0x3e, 0x74, 0xca, 0x96, 0x91, 0xab, 0x49, 0xf9, 0x77, 0x2b, 0xf8, 0x78, 0x4a, 0x59,
+	0x79, 0x0c, 0xbf, 0x6a, 0xa1, 0x5e, 0x5a, 0xc5, 0x9a, 0x45, 0x86, 0x9d, 0x70, 0x84, 0x8f, 0x4c,
+	0xdc, 0x16, 0xda, 0x23, 0xd3, 0x0d, 0xd9, 0x0d, 0x6c, 0xea, 0xc8, 0x3a, 0x61, 0x37, 0x91, 0x04,
+	0x4f, 0x8c, 0xb0, 0xe7, 0x10, 0x07, 0x9f, 0xb6, 0xb8, 0x8f, 0x92, 0xc2, 0x6e, 0x21, 0x4e, 0x68,
+	0xf6, 0xd9, 0x6d, 0xd4, 0x89, 0x2e, 0x3a, 0x23, 0x50, 0xe9, 0xe1, 0xe7, 0x6b, 0xe8, 0x63, 0x19,
+	0xda, 0x41, 0x60, 0xbb, 0x7d, 0xa9, 0x99, 0x9e, 0xc7, 0x3e, 0x15, 0xf6, 0x6a, 0xc0, 0xea, 0xfa,
+	0xaf, 0xc7, 0xe7, 0x3e, 0xbe, 0x1c, 0x6d, 0xf1, 0xe6, 0x99, 0x70, 0xb8, 0x09, 0x9c, 0x35, 0xfb,
+	0x9b, 0xb0, 0xea, 0xf3, 0xef, 0x8c, 0xed, 0xd4, 0x69, 0xa8, 0xdc, 0xe5, 0xe9, 0x76, 0xd3, 0x14,
+	0xfa, 0x29, 0xac, 0xaa, 0xc2, 0x13, 0x3b, 0x1c, 0x90, 0xd7, 0x13, 0x8f, 0xbe, 0x46, 0xc7, 0xb5,
+	0x32, 0x33, 0x8f, 0xbe, 0x46, 0x2c, 0x23, 0xc4, 0x38, 0x44, 0x98, 0x9d, 0x23, 0x44, 0xa8, 0xff,
+	0x9d, 0x52, 0xc2, 0x4f, 0x27, 0x36, 0xbd, 0x56, 0xb4, 0xe9, 0x9d, 0xce, 0x41, 0x89, 0xa3, 0x7e,
+	0xd9, 0xeb, 0x44, 0xfd, 0x66, 0x25, 0xaf, 0xbd, 0x83, 0x7b, 0x30, 0x9a, 0xcb, 0x87, 0x73, 0x44,
+	0x34, 0x53, 0xb8, 0xda, 0x06, 0x65, 0x94, 0x98, 0x1d, 0x91, 0x59, 0x59, 0x98, 0x79, 0x78, 0x32,
+	0x99, 0x3a, 0x22, 0x31, 0x8d, 0x04, 0x55, 0x42, 0xf3, 0x15, 0x67, 0x69, 0x3e, 0xf4, 0x3f, 0x48,
+	0x9d, 0x18, 0x95, 0x45, 0x00, 0x58, 0x3c, 0x2b, 0xf6, 0xa4, 0x15, 0xca, 0xc6, 0x14, 0x1c, 0xcd,
+	0xc3, 0xe1, 0xd8, 0x09, 0x6d, 0x69, 0xdf, 0x8a, 0xc2, 0xe4, 0x89, 0xef, 0xca, 0xf4, 0x89, 0xef,
+	0xf7, 0x00, 0x02, 0x8e, 0xf3, 0x69, 0xcb, 0xee, 0x85, 0x32, 0xff, 0xf2, 0xee, 0x45, 0x6d, 0x93,
+	0x91, 0xd9, 0x04, 0x05, 0xd6, 0x7f, 0x68, 0x9e, 0x51, 0xb6, 0x86, 0x4c, 0x14, 0x8b, 0xca, 0x93,
+	0xeb, 0xc1, 0xf2, 0xf4, 0x7a, 0xf0, 0xba, 0xb2, 0xec, 0x6f, 0x5e, 0x3a, 0xbe, 0xeb, 0x29, 0x6b,
+	0x1e, 0xdd, 0xeb, 0xa8, 0x31, 0x3d, 0x9f, 0x8e, 0x26, 0x56, 0x0c, 0x55, 0x4c, 0xe9, 0xe4, 0x5b,
+	0x13, 0x3a, 0x79, 0x22, 0x14, 0x7c, 0x7b, 0x2a, 0x14, 0x5c, 0xb7, 0xa0, 0xd8, 0x1e, 0x25, 0x24,
+	0x33, 0x76, 0xc7, 0x28, 0xaf, 0x71, 0x36, 0xe1, 0x35, 0x8e, 0xce, 0x01, 0xe4, 0x92, 0xe7, 0x00,
+	0x26, 0xce, 0x3c, 0x17, 0xa6, 0xce, 0x3c, 0xeb, 0x1f, 0x43, 0x41, 0xec, 0x33, 0x40, 0x99, 0xb8,
+	0xc2, 0x3c, 0xc6, 0x66, 0xb3, 0x0c, 0xfa, 0xb9, 0x02, 0x4e, 0xf6, 0x13, 0xef, 0x98, 0x43, 0x4e,
+	0x8a, 0x37, 0xab, 0xd5, 0xe0, 0xa6, 0xc0, 0x0d, 0xd2, 0x6f, 0xc8, 0x88, 0x73, 0xec, 0x63, 0xdf,
+	0xf4, 0xcf, 0x59, 0x5e, 0x7f, 0x8f, 0xf2, 0x82, 0x94, 0xc8, 0x55, 0xa3, 0x33, 0xe6, 0x42, 0xd5,
+	0x5b, 0x52, 0xa3, 0x51, 0x92, 0x99, 0xdc, 0x31, 0x8b, 0xcc, 0x62, 0xda, 0x92, 0x92, 0xd7, 0x6d,
+	0x31, 0x69, 0x37, 0xfc, 0x89, 0xcd, 0x48, 0x7d, 0x23, 0x61, 0x85, 0xa6, 0x53, 0x85, 0x33, 0xf3,
+	0xa6, 0x0a, 0xeb, 0x8f, 0x60, 0xc5, 0x48, 0xaf, 0x13, 0xda, 0xdb, 0x50, 0xf2, 0x46, 0x49, 0x3e,
+	0x57, 0x49, 0xae, 0x42, 0xd7, 0x7f, 0x3b, 0x03, 0x8b, 0x2d, 0x37, 0xe4, 0xbe, 0x6b, 0x3a, 0xdb,
+	0x8e, 0xd9, 0xd7, 0xde, 0x52, 0x7a, 0x6c, 0xb6, 0x87, 0x27, 0x89, 0x9b, 0x56, 0x69, 0x8e, 0x0c,
+	0x1a, 0x61, 0xba, 0x15, 0xb7, 0xec, 0xd0, 0xf3, 0x85, 0xed, 0xad, 0x32, 0xba, 0x6f, 0x02, 0x13,
+	0xe0, 0x0e, 0x4d, 0x9a, 0xae, 0x18, 0xe6, 0x1a, 0xdc, 0x4c, 0x41, 0x95, 0x61, 0x9d, 0xd5, 0xee,
+	0x40, 0x2d, 0x5e, 0xe1, 0xb6, 0x3c, 0x37, 0x6c, 0x61, 0xf4, 0x93, 0x0c, 0x37, 0x96, 0xd3, 0xbf,
+	0x1f, 0x99, 0x8c, 0x87, 0x32, 0xdf, 0xdb, 0xf7, 0xbc, 0xf8, 0x82, 0x01, 0x59, 0x4a, 0x5c, 0x64,
+	0x91, 0x9d, 0xe3, 0x22, 0x8b, 0xf7, 0xe2, 0xcb, 0x08, 0xc4, 0x52, 0xf2, 0xe2, 0xcc, 0xf5, 0xe9,
+	0x90, 0x02, 0x78, 0x02, 0xb1, 0xc3, 0x13, 0x37, 0x13, 0xbc, 0x26, 0xb7, 0x89, 0xf9, 0x79, 0x2c,
+	0x6b, 0x42, 0xd5, 0xde, 0x9c, 0x3c, 0xed, 0x36, 0x5f, 0xba, 0xf8, 0x94, 0xf1, 0x0b, 0xd7, 0x36,
+	0x7e, 0xbf, 0x31, 0xb1, 0x23, 0x2b, 0xcf, 0x74, 0x7a, 0x5e, 0x72, 0x96, 0xff, 0x1b, 0x50, 0x1a,
+	0xd8, 0x41, 0xe8, 0xf9, 0xe2, 0xce, 0x89, 0xe9, 0xf3, 0xb0, 0x89, 0xde, 0xda, 0x11, 0x88, 0x94,
+	0xdb, 0xab, 0xa8, 0xb4, 0x0f, 0x61, 0x95, 0x3a, 0xfe, 0x20, 0xb6, 0x44, 0x82, 0x5a, 0x75, 0x66,
+	0x4e, 0x75, 0x82, 0xd5, 0xc6, 0x04, 0x89, 0x31, 0xcd, 0xa4, 0xde, 0x07, 0x88, 0xc7, 0x67, 0x4a,
+	0x8b, 0x7d, 0x8a, 0x3b, 0x27, 0xf0, 0x3c, 0xc1, 0xf8, 0x38, 0x8e, 0x76, 0xcb, 0x52, 0xfd, 0x0c,
+	0xea, 0x53, 0xf6, 0xc3, 0x01, 0xf7, 0x45, 0x75, 0x2f, 0xbd, 0xf8, 0xe2, 0xbd, 0xe4, 0xc0, 0x0b,
+	0xe1, 0xbc, 0x77, 0xc1, 0xe8, 0x45, 0x9c, 0x13, 0x12, 0x50, 0x7f, 0x13, 0xaa, 0x89, 0x4e, 0x45,
+	0xcd, 0x3c, 0x76, 0x2d, 0x4f, 0x39, 0xda, 0xf1, 0x59, 0xa3, 0x43, 0xbe, 0x96, 0x72, 0xb5, 0xd3,
+	0x73, 0xdd, 0x00, 0x36, 0xd9, 0x81, 0x97, 0xec, 0xda, 0x5f, 0x84, 0xa5, 0x84, 0x99, 0x18, 0x39,
+	0x61, 0xd3, 0x40, 0xfd, 0x14, 0x5e, 0x48, 0xb0, 0x3b, 0xe0, 0x3e, 0x99, 0x82, 0x9e, 0x2b, 0x36,
+	0xa0, 0x64, 0xae, 0x5b, 0xdc, 0x0d, 0xed, 0x50, 0x69, 0xd0, 0xa8, 0xac, 0x7d, 0x0d, 0x0a, 0x23,
+	0xee, 0x0f, 0x03, 0xa9, 0x45, 0x27, 0x25, 0x68, 0x26, 0xdb, 0xc0, 0x10, 0x34, 0xfa, 0xdf, 0xcf,
+	0x40, 0x19, 0x63, 0x16, 0x96, 0x19, 0x9a, 0xda, 0xde, 0xc4, 0x57, 0xa6, 0x33, 0x34, 0x14, 0xea,
+	0xba, 0xdc, 0x12, 0xaf, 0xb7, 0x24, 0xbe, 0x2c, 0x63, 0x50, 0x5f, 0xb1, 0xa8, 0x6f, 0x40, 0x49,
+	0x82, 0xeb, 0x6f, 0xc1, 0xca, 0x04, 0x26, 0xf5, 0x8b, 0xd8, 0x2f, 0x74, 0xce, 0x87, 0x2a, 0xb4,
+	0xbb, 0x68, 0xa4, 0x81, 0x18, 0x62, 0x19, 0x09, 0x02, 0xfd, 0x0f, 0x5e, 0xa0, 0xcc, 0xbb, 0xc8,
+	0x64, 0x9e, 0x92, 0xc9, 0xbb, 0x00, 0xc2, 0x07, 0x48, 0x8b, 0xb2, 0x70, 0x8c, 0x27, 0x20, 0xda,
+	0x3b, 0x51, 0x44, 0x23, 0x3f, 0xd3, 0xec, 0x4a, 0x32, 0x9f, 0x0c, 0x6b, 0xd4, 0xa0, 0x64, 0x07,
+	0xe4, 0xdb, 0x93, 0x39, 0x8d, 0xaa, 0xa8, 0x7d, 0x1d, 0x8a, 0xf6, 0x70, 0xe4, 0xf9, 0xa1, 0x0c,
+	0x79, 0x5c, 0xca, 0xb5, 0x45, 0x98, 0x98, 0x26, 0x20, 0x68, 0x90, 0x9a, 0x9f, 0x11, 0x75, 0xf9,
+	0x6a, 0xea, 0xe6, 0x99, 0xa2, 0x16, 0x34, 0xda, 0x07, 0xb0, 0xd4, 0x17, 0x09, 0xde, 0x82, 0xb1,
+	0x54, 0x22, 0x5f, 0xbc, 0x8c, 0xc9, 0xc3, 0x24, 0xc1, 0xce, 0x82, 0x91, 0xe6, 0x80, 0x2c, 0xd1,
+	0xc4, 0xe7, 0x41, 0xd8, 0xf5, 0xde, 0xf7, 0x6c, 0xb7, 0x06, 0x57, 0xb3, 0x34, 0x92, 0x04, 0xc8,
+	0x32, 0xc5, 0x41, 0xfb, 0x0a, 0x5a, 0x3c, 0x41, 0x28, 0xaf, 0xf8, 0xb8, 0x77, 0x19, 0xa7, 0x2e,
+	0x0f, 0xe4, 0xe5, 0x1c, 0x41, 0xa8, 0x9d, 0x41, 0x3d, 0x31, 0x49, 0xe4, 0x47, 0x1a, 0xa3, 0x91,
+	0x8f, 0x77, 0xff, 0x90, 0x81, 0x58, 0x7d, 0xf0, 0x95, 0xcb, 0xb8, 0x1d, 0x5c, 0x48, 0xbd, 0xb3,
+	0x60, 0x5c, 0xc2, 0x5b, 0xeb, 0xe2, 0x6e, 0x51, 0x36, 0x61, 0x97, 0x9b, 0xa7, 0xea, 0x82, 0x90,
+	0xb5, 0xb9, 0x7a, 0x81, 0x28, 0x76, 0x16, 0x8c, 0x09, 0x1e, 0xda, 0xcf, 0xc3, 0x6a, 0xea, 0x9b,
+	0x74, 0x27, 0x80, 0xb8, 0x3e, 0xe4, 0x4b, 0x73, 0x37, 0x03, 0x89, 0xf0, 0xf2, 0x89, 0x29, 0x4e,
+	0xda, 0x18, 0x9e, 0x9f, 0x6e, 0xd2, 0x16, 0xef, 0x39, 0xb6, 0xcb, 0xe5, 0x4d, 0x23, 0x6f, 0x5e,
+	0xaf, 0xb7, 0x24, 0xf1, 0xce, 0x82, 0x71, 0x31, 0x67, 0xed, 0x2f, 0xc0, 0x9d, 0xd1, 0x4c, 0x15,
+	0x23, 0x54, 0x97, 0xbc, 0xa8, 0xe4, 0xed, 0x39, 0xbf, 0x3c, 0x45, 0xbf, 0xb3, 0x60, 0x5c, 0xca,
+	0x5f, 0xdb, 0x40, 0x3b, 0x7d, 0x68, 0xbb, 0x18, 0x92, 0x17, 0x77, 0x9a, 0xbc, 0x78, 0xf9, 0x28,
+	0x09, 0x5c, 0x71, 0x2f, 0x88, 0x78, 0x26, 0xa1, 0x1f, 0x3b, 0xbc, 0xeb, 0xdb, 0xfd, 0x3e, 0xf7,
+	0xb9, 0x55, 0xbb, 0x39, 0x87, 0xd0, 0x27, 0x09, 0x48, 0xe8, 0x93, 0x00, 0x34, 0xe9, 0xc9, 0x59,
+	0x20, 0x4f, 0xff, 0x88, 0x02, 0x85, 0xe9, 0x7b, 0x0e, 0x3a, 0xf3, 0xa2, 0x50, 0x50, 0x0c, 0xa0,
+	0x60, 0x92, 0xeb, 0x79, 0x9f, 0xf0, 0xc7, 0x6e, 0x68, 0x3b, 0xb4, 0x21, 0xc9, 0x19, 0x49, 0x50,
+	0xfd, 0x0f, 0x32, 0x50, 0x94, 0x13, 0xf5, 0x4e, 0x94, 0x69, 0x12, 0xad, 0x39, 0x31, 0x40, 0x7b,
+	0x17, 0x2a, 0xdc, 0xf7, 0x3d, 0x1f, 0x73, 0x2b, 0x6a, 0xd9, 0x99, 0x2e, 0x77, 0xc1, 0x67, 0xbd,
+	0xa9, 0xd0, 0x8c, 0x98, 0x42, 0x7b, 0x07, 0x40, 0x28, 0xa8, 0x6e, 0x7c, 0xcc, 0xb3, 0x3e, 0x9b,
+	0x5e, 0x44, 0x28, 0x63, 0xec, 0xd8, 0x47, 0xa9, 0xc2, 0x83, 0xaa, 0x18, 0xed, 0xa5, 0x0b, 0x89,
+	0xbd, 0xf4, 0x1d, 0xe9, 0x54, 0x21, 0x5f, 0x93, 0x3c, 0xec, 0x1c, 0x01, 0xea, 0xff, 0x32, 0x83,
+	0x39, 0x9f, 0xd4, 0xde, 0xe6, 0x74, 0x8b, 0xbe, 0x70, 0xb5, 0xb2, 0x5c, 0x9f, 0x6c, 0xd9, 0xd7,
+	0x01, 0xf8, 0x99, 0xaa, 0xab, 0x6c, 0xd9, 0x9d, 0x09, 0x3e, 0x92, 0x54, 0x1d, 0xda, 0x88, 0xf1,
+	0x31, 0x1e, 0x41, 0x5c, 0xd0, 0x3f, 0xfe, 0x78, 0x77, 0x97, 0x2d, 0xa0, 0xd7, 0xe6, 0xf1, 0xfe,
+	0xa3, 0xfd, 0xf6, 0x93, 0xfd, 0xa3, 0xa6, 0x61, 0xb4, 0x0d, 0xe1, 0x26, 0xdf, 0x68, 0x6c, 0x1d,
+	0xb5, 0xf6, 0x0f, 0x1e, 0x77, 0x59, 0xb6, 0xfe, 0x8f, 0x33, 0xb0, 0x94, 0x52, 0xba, 0x7f, 0xba,
+	0x43, 0x97, 0xe8, 0xfe, 0xdc, 0xec, 0xee, 0xcf, 0x5f, 0xd4, 0xfd, 0x85, 0xc9, 0xee, 0xff, 0x87,
+	0x19, 0x58, 0x4a, 0x29, 0xf7, 0x24, 0xf7, 0x4c, 0x9a, 0x7b, 0xd2, 0x44, 0xc9, 0x4e, 0x98, 0x28,
+	0x78, 0x06, 0x51, 0x3e, 0xef, 0xc7, 0xce, 0x94, 0x14, 0x2c, 0x89, 0x43, 0x27, 0xe2, 0xf2, 0x69,
+	0x1c, 0x84, 0x5d, 0x51, 0x5b, 0xba, 0x01, 0x20, 0xa0, 0x0b, 0x52, 0xea, 0x17, 0xab, 0xfe, 0x4b,
+	0x9a, 0xf0, 0x10, 0xaa, 0xa3, 0x58, 0xbf, 0x5c, 0xcf, 0x9e, 0x4a, 0x52, 0x5e, 0x51, 0xcf, 0xdf,
+	0xca, 0xc0, 0x72, 0x7a, 0xb1, 0xf8, 0x7f, 0xba, 0x5b, 0xff, 0x51, 0x06, 0x56, 0xa7, 0x96, 0xa0,
+	0x4b, 0x2d, 0xd2, 0xc9, 0x7a, 0x65, 0xe7, 0xa8, 0x57, 0x6e, 0x46, 0xbd, 0x2e, 0xd6, 0x24, 0x97,
+	0xd7, 0xb8, 0x03, 0xcf, 0x5f, 0xb8, 0x98, 0x5d, 0xd2, 0xd5, 0x29, 0xa6, 0xb9, 0x49, 0xa6, 0xbf,
+	0x99, 0x81, 0x3b, 0x97, 0x2d, 0x54, 0x7f, 0xe6, 0x72, 0x35, 0x55, 0xc3, 0xdf, 0xc9, 0xa0, 0x43,
+	0x54, 0x2e, 0x69, 0x97, 0x4a, 0x94, 0x97, 0x4e, 0x08, 0x89, 0xca, 0x68, 0x42, 0x8b, 0xe7, 0xc4,
+	0x17, 0x12, 0x90, 0x39, 0xae, 0xe1, 0xd3, 0x61, 0x51, 0x15, 0x13, 0x83, 0x93, 0x82, 0xc9, 0x70,
+	0x17, 0x97, 0xb1, 0x79, 0x7a, 0x4e, 0x37, 0xad, 0x34, 0xd9, 0xb4, 0x5f, 0xcb, 0xc1, 0x52, 0x6a,
+	0xc1, 0x25, 0x6f, 0xc5, 0xd8, 0xe1, 0x09, 0x6f, 0xc5, 0xd8, 0x91, 0xad, 0xc3, 0xa7, 0x84, 0xdc,
+	0x45, 0x65, 0xad, 0x01, 0xa5, 0x50, 0x30, 0xa8, 0xe5, 0xae, 0x5c, 0x40, 0xf0, 0x73, 0xeb, 0xf2,
+	0x7b, 0x86, 0xa2, 0xfb, 0xb4, 0x22, 0x99, 0xea, 0xf4, 0xe2, 0xa5, 0x9d, 0x5e, 0xba, 0xaa, 0xd3,
+	0xcb, 0x57, 0x77, 0x7a, 0x65, 0x46, 0xa7, 0x63, 0x92, 0x45, 0x2f, 0xf4, 0xfc, 0x28, 0x8b, 0x45,
+	0x15, 0x85, 0x51, 0x12, 0x7a, 0x3e, 0x91, 0x56, 0x95, 0x51, 0x22, 0x01, 0x22, 0x87, 0x86, 0x8f,
+	0xd0, 0xe1, 0x21, 0x43, 0x56, 0x51, 0x59, 0x7f, 0x2b, 0xca, 0x6c, 0xc2, 0x34, 0x4e, 0x91, 0x7f,
+	0x21, 0x0f, 0x44, 0x0d, 0x30, 0x56, 0x4f, 0x81, 0x20, 0x83, 0x9b, 0xf2, 0x32, 0x20, 0xcc, 0xf6,
+	0xb3, 0x29, 0x45, 0xe1, 0x36, 0x40, 0x83, 0x5c, 0x20, 0xea, 0xf0, 0xea, 0xe6, 0x6e, 0xbb, 0xd3,
+	0x64, 0x0b, 0xc9, 0xfd, 0xde, 0xef, 0x67, 0x41, 0x4b, 0x0e, 0xc8, 0x96, 0xdd, 0xc7, 0x5d, 0xc1,
+	0x74, 0x3e, 0x7c, 0x99, 0x52, 0xea, 0x6d, 0xb7, 0x2f, 0x67, 0xd6, 0x65, 0x56, 0xba, 0x60, 0x22,
+	0x8e, 0xfb, 0xdb, 0x6e, 0xdf, 0x88, 0x68, 0x2f, 0x59, 0x37, 0x93, 0x63, 0x97, 0x9f, 0x18, 0x3b,
+	0xf2, 0xdc, 0xa2, 0xbf, 0x5a, 0x24, 0xa1, 0x88, 0x02, 0x8e, 0xd8, 0xd8, 0xf5, 0xb9, 0x69, 0x09,
+	0x5f, 0xb6, 0x90, 0xf3, 0x24, 0x48, 0x7b, 0x1d, 0xc3, 0xa6, 0xb4, 0x2b, 0x2a, 0xcd, 0xf4, 0x19,
+	0xa5, 0x24, 0x51, 0xa2, 0x62, 0x02, 0x4e, 0x32, 0x66, 0xd4, 0xb2, 0x44, 0x1c, 0xb3, 0x62, 0x4c,
+	0x82, 0xf5, 0x97, 0x31, 0x3f, 0x56, 0x36, 0x2c, 0x15, 0xcb, 0x97, 0xa1, 0x25, 0x71, 0x72, 0x93,
+	0x42, 0x68, 0x59, 0xdd, 0x80, 0x5a, 0xf2, 0x73, 0x4d, 0x4c, 0x95, 0x39, 0x3f, 0xf0, 0x1c, 0xbb,
+	0x77, 0x8e, 0xbd, 0x82, 0x95, 0xee, 0x86, 0x8e, 0xcc, 0x2f, 0x57, 0x45, 0x94, 0x9a, 0xb1, 0xab,
+	0xde, 0x89, 0xcd, 0x76, 0x0c, 0xd0, 0x7f, 0x2f, 0x0b, 0x6c, 0x72, 0x36, 0xcd, 0x72, 0x85, 0x4f,
+	0x05, 0xa5, 0x2e, 0x1e, 0x86, 0x97, 0x60, 0x39, 0x0e, 0x36, 0xd0, 0x6d, 0xb0, 0xe2, 0xea, 0xcb,
+	0x09, 0x68, 0x72, 0x96, 0x17, 0x3e, 0xe5, 0x2c, 0x9f, 0x98, 0x71, 0xc5, 0xe9, 0x19, 0x77, 0x1f,
+	0x56, 0x30, 0xa0, 0x2c, 0x65, 0x7e, 0xe3, 0x7c, 0x8f, 0xcb, 0x88, 0xcb, 0x24, 0x98, 0xe6, 0x8f,
+	0x1d, 0x60, 0xf6, 0xa0, 0xa5, 0xf2, 0x85, 0x54, 0x59, 0xff, 0x1a, 0x94, 0xe4, 0xb7, 0x31, 0xad,
+	0x47, 0xf9, 0xaa, 0xc4, 0x7a, 0x62, 0x89, 0x13, 0xb2, 0x8d, 0x20, 0xb0, 0xfb, 0x2e, 0x79, 0xda,
+	0x97, 0xa0, 0x22, 0x6f, 0xa2, 0xa1, 0x2b, 0x3f, 0xbe, 0x1b, 0xd9, 0xc6, 0xfa, 0x29, 0x14, 0xe3,
+	0x13, 0xb6, 0x78, 0x1d, 0x8c, 0x25, 0x32, 0x65, 0x16, 0xa1, 0x7c, 0x20, 0xdd, 0x71, 0x62, 0x2e,
+	0xbe, 0xdf, 0x69, 0xef, 0x8b, 0xa0, 0xec, 0x56, 0xbb, 0x2b, 0xce, 0xe9, 0x76, 0x0e, 0x1f, 0x8a,
+	0x94, 0x8d, 0x87, 0x46, 0xe3, 0x60, 0xe7, 0x88, 0x30, 0x28, 0x1e, 0xbb, 0xd3, 0xdd, 0xdb, 0x65,
+	0x45, 0x44, 0x69, 0x6d, 0x76, 0x58, 0x09, 0x1f, 0x36, 0x3b, 0x87, 0x22, 0x0e, 0xfb, 0xe1, 0x6e,
+	0xe7, 0x43, 0x56, 0xd1, 0xff, 0x5d, 0x5e, 0x6d, 0x47, 0xf4, 0xef, 0xab, 0x5b, 0x7a, 0x00, 0x8a,
+	0xd8, 0xc1, 0x9e, 0xfc, 0x7e, 0x54, 0x1b, 0x3a, 0x82, 0xd6, 0x3c, 0x13, 0xae, 0x6f, 0x96, 0xc5,
+	0xf3, 0x62, 0x07, 0xc7, 0x22, 0xc5, 0x78, 0x27, 0x1c, 0x3a, 0xe2, 0xd2, 0x93, 0xee, 0x59, 0xc8,
+	0x0a, 0xf4, 0xa5, 0xe0, 0x54, 0xa4, 0x89, 0xb4, 0x8f, 0x03, 0x9b, 0x4e, 0xd8, 0x96, 0x10, 0xb3,
+	0xe9, 0x72, 0x79, 0xe9, 0xcd, 0xae, 0xd7, 0x0f, 0xf8, 0x77, 0x58, 0x05, 0xa1, 0x86, 0x67, 0x0e,
+	0x19, 0x50, 0x4d, 0x7b, 0x01, 0xab, 0xea, 0xff, 0x24, 0x07, 0x95, 0xc8, 0x36, 0xbe, 0x8e, 0xad,
+	0x8e, 0x61, 0xe2, 0xd6, 0x7e, 0xb7, 0x69, 0xec, 0x37, 0x76, 0x25, 0x4a, 0x0e, 0x47, 0x66, 0xbb,
+	0xb5, 0xdb, 0x3c, 0xda, 0x6d, 0x37, 0xb6, 0x24, 0xb0, 0x8c, 0xe7, 0xa4, 0x5b, 0x7b, 0x07, 0x6d,
+	0xa3, 0x7b, 0xd4, 0xea, 0x1c, 0x6d, 0x36, 0xf6, 0x37, 0x9b, 0xbb, 0xcd, 0x2d, 0x56, 0xd4, 0x5e,
+	0x84, 0x7b, 0xfb, 0xed, 0x6e, 0xab, 0xbd, 0x7f, 0xb4, 0xdf, 0x3e, 0x6a, 0x6f, 0xbc, 0xdf, 0xdc,
+	0xec, 0x76, 0x8e, 0x5a, 0xfb, 0x47, 0xc8, 0xf5, 0xa1, 0xd1, 0xc0, 0x37, 0xac, 0xa0, 0xdd, 0x83,
+	0x3b, 0x12, 0xab, 0xd3, 0x34, 0x0e, 0x9b, 0x06, 0x32, 0x79, 0xbc, 0xdf, 0x38, 0x6c, 0xb4, 0x76,
+	0x1b, 0x1b, 0xbb, 0x4d, 0xb6, 0xa8, 0xdd, 0x85, 0xba, 0xc4, 0x30, 0x1a, 0xdd, 0xe6, 0xd1, 0x6e,
+	0x6b, 0xaf, 0xd5, 0x3d, 0x6a, 0x7e, 0xb8, 0xd9, 0x6c, 0x6e, 0x35, 0xb7, 0xd8, 0x92, 0xf6, 0x45,
+	0xf8, 0x3c, 0x55, 0x4a, 0x56, 0x22, 0xfd, 0xb1, 0x8f, 0x5b, 0x07, 0x47, 0x0d, 0x63, 0x73, 0xa7,
+	0x75, 0xd8, 0x64, 0xcb, 0xda, 0x17, 0xe0, 0x73, 0x17, 0xa3, 0x6e, 0xb5, 0x8c, 0xe6, 0x66, 0xb7,
+	0x6d, 0x7c, 0xc4, 0x56, 0xb5, 0xcf, 0xc0, 0xf3, 0x38, 0xe6, 0x47, 0x4f, 0x8c, 0xf6, 0xfe, 0xc3,
+	0x23, 0x7a, 0xec, 0x74, 0x8d, 0xc7, 0x9b, 0xdd, 0xc7, 0x46, 0x93, 0x01, 0xa6, 0xe5, 0x1c, 0x6c,
+	0x1c, 0xed, 0xb7, 0xbb, 0x47, 0x8d, 0xfd, 0x8f, 0x36, 0x76, 0xdb, 0x9b, 0x8f, 0x8e, 0xb6, 0xdb,
+	0xc6, 0x5e, 0xa3, 0xcb, 0xaa, 0xda, 0xcb, 0xf0, 0x85, 0xcd, 0xce, 0xa1, 0xac, 0x66, 0x7b, 0xfb,
+	0xc8, 0x68, 0x3f, 0xe9, 0x1c, 0xb5, 0x8d, 0x23, 0xa3, 0xb9, 0x4b, 0x6d, 0xee, 0xc4, 0x75, 0x2f,
+	0x61, 0x54, 0xa2, 0xb5, 0xdf, 0x79, 0xbc, 0xbd, 0xdd, 0xda, 0x6c, 0x35, 0xf7, 0xbb, 0x47, 0x07,
+	0x4d, 0x63, 0xaf, 0xd5, 0xe9, 0x20, 0x1a, 0xab, 0xe8, 0xdf, 0xc4, 0x5b, 0xdd, 0x4e, 0xed, 0x90,
+	0x54, 0x81, 0x5c, 0x0b, 0xa4, 0x6f, 0x50, 0x15, 0x69, 0xad, 0xb5, 0xfb, 0x2e, 0xdd, 0x01, 0x46,
+	0xda, 0x63, 0xd1, 0x88, 0x01, 0xfa, 0x2f, 0xe5, 0x60, 0x49, 0xb0, 0x50, 0xbe, 0xc6, 0xfb, 0xb0,
+	0x22, 0xc3, 0x7a, 0xad, 0xb4, 0xcd, 0x3a, 0x09, 0xc6, 0x99, 0x2f, 0x41, 0x09, 0x0b, 0x22, 0x09,
+	0xa2, 0x94, 0xc4, 0x9e, 0x83, 0x6a, 0x41, 0x64, 0xeb, 0xc8, 0xd2, 0xa7, 0xb6, 0x0c, 0x74, 0x58,
+	0x14, 0x88, 0x98, 0x9b, 0x11, 0x9d, 0x8c, 0x4e, 0xc1, 0xb4, 0x8f, 0xe1, 0x76, 0x54, 0x6e, 0xba,
+	0x3d, 0xff, 0x7c, 0x14, 0xdd, 0x88, 0x5d, 0x9a, 0xe9, 0xf6, 0xc6, 0x7b, 0x86, 0x52, 0x88, 0xc6,
+	0x45, 0x0c, 0xb4, 0xaf, 0x02, 0xd8, 0xd4, 0x59, 0xb4, 0x21, 0x16, 0x57, 0x11, 0x3c, 0x3f, 0x15,
+	0xb1, 0x52, 0x08, 0x46, 0x02, 0x19, 0x55, 0x5b, 0x1f, 0x4d, 0xeb, 0x47, 0xf2, 0xca, 0xec, 0x45,
+	0x23, 0x2a, 0xeb, 0xff, 0x3d, 0x93, 0x70, 0xf9, 0x0a, 0x97, 0xee, 0xa5, 0x7b, 0x86, 0x0b, 0xd6,
+	0x02, 0x5b, 0xf6, 0x8a, 0x5c, 0x0b, 0x64, 0x51, 0x3b, 0x00, 0xcd, 0x9e, 0xee, 0x8b, 0xfc, 0x9c,
+	0x7d, 0x31, 0x83, 0x76, 0x32, 0xbe, 0x5c, 0x98, 0x8e, 0x2f, 0x63, 0x9a, 0xae, 0xe3, 0x1d, 0xcb,
+	0xb4, 0x98, 0xa2, 0x4c, 0xd3, 0x8d, 0x20, 0xba, 0x03, 0x65, 0x75, 0x9d, 0x37, 0x0a, 0xc9, 0x89,
+	0x9d, 0xb4, 0x4e, 0x45, 0x49, 0xdb, 0xc1, 0x0c, 0xf7, 0x54, 0x9d, 0xb3, 0x73, 0xd6, 0x79, 0x82,
+	0x4e, 0xff, 0x2a, 0xac, 0x4e, 0x21, 0x89, 0x0b, 0xac, 0xc3, 0xe8, 0xfe, 0x2e, 0x7c, 0x9e, 0xce,
+	0x36, 0xd3, 0xff, 0x7d, 0x16, 0x16, 0xf7, 0x4c, 0xd7, 0x3e, 0xe1, 0x41, 0x48, 0xb5, 0xbd, 0x0d,
+	0xc5, 0xa0, 0x37, 0xe0, 0x43, 0x53, 0x6d, 0x15, 0x5e, 0x14, 0x45, 0xb9, 0x60, 0x67, 0xa7, 0x16,
+	0xec, 0x64, 0x32, 0x04, 0xce, 0x87, 0x71, 0x38, 0x88, 0x4e, 0x4a, 0xca, 0x12, 0x0e, 0x9e, 0x63,
+	0xf7, 0xb8, 0x1b, 0x28, 0x99, 0x57, 0xc5, 0x38, 0xfb, 0xb4, 0x78, 0x49, 0xf6, 0x69, 0x69, 0x7a,
+	0x00, 0xd0, 0x3d, 0xd6, 0xf3, 0x39, 0x77, 0x83, 0x81, 0x17, 0x2a, 0xd3, 0x27, 0x09, 0xa2, 0xe4,
+	0x78, 0xef, 0x99, 0x8b, 0x73, 0x9e, 0xec, 0x55, 0x69, 0x07, 0x27, 0x61, 0x28, 0x84, 0x14, 0x5f,
+	0xc0, 0x9b, 0x75, 0x40, 0x24, 0x19, 0xa8, 0x32, 0x45, 0x10, 0xcc, 0x90, 0xf7, 0x3d, 0xdf, 0xe6,
+	0x22, 0x8c, 0x56, 0x31, 0x12, 0x10, 0xa4, 0x75, 0x4c, 0xb7, 0x3f, 0xc6, 0xab, 0xf7, 0xa4, 0x2d,
+	0xac, 0xca, 0xfa, 0x7f, 0x2b, 0x00, 0xec, 0x71, 0x3c, 0x3b, 0x17, 0x0c, 0xec, 0x11, 0x76, 0x55,
+	0x68, 0xcb, 0x53, 0x42, 0x4b, 0x06, 0x3d, 0x63, 0xae, 0x5c, 0xe2, 0xe8, 0xe6, 0x74, 0xea, 0x4e,
+	0x4c, 0x3e, 0x19, 0x7e, 0xc0, 0xce, 0x31, 0x43, 0x2e, 0x13, 0x7f, 0xa9, 0xff, 0xf3, 0x46, 0x12,
+	0x44, 0x66, 0x86, 0x19, 0xf2, 0xa6, 0x6b, 0x89, 0xf0, 0x46, 0xde, 0x88, 0xca, 0x48, 0x6d, 0x07,
+	0x78, 0x7b, 0x98, 0xc1, 0x5d, 0xfe, 0x2c, 0xba, 0x94, 0x21, 0x06, 0x69, 0x7b, 0x18, 0xa4, 0x3a,
+	0x1f, 0xe2, 0xe9, 0x64, 0x1e, 0x0e, 0x3c, 0xab, 0x56, 0x9c, 0x69, 0x39, 0x25, 0x2a, 0x78, 0x90,
+	0x44, 0x37, 0xd2, 0xd4, 0x28, 0x13, 0x6e, 0x90, 0xd8, 0xcd, 0xc8, 0x12, 0x26, 0xbf, 0x88, 0xa7,
+	0x84, 0xae, 0x99, 0x8a, 0x78, 0x98, 0x43, 0x1e, 0x70, 0x1f, 0xb3, 0x9e, 0x14, 0xa6, 0x91, 0xa0,
+	0x22, 0xbb, 0x33, 0xe0, 0x7e, 0x73, 0x68, 0xda, 0x8e, 0x1c, 0xe0, 0x18, 0x80, 0x57, 0x14, 0x05,
+	0xe3, 0x63, 0x94, 0x99, 0x63, 0xde, 0xf5, 0xf6, 0xf9, 0xb3, 0xc0, 0xe1, 0x61, 0xc8, 0x7d, 0x99,
+	0x09, 0x38, 0xfb, 0xa5, 0xde, 0x8f, 0xf6, 0x31, 0x74, 0xc7, 0x20, 0x3e, 0xc5, 0xe9, 0xc6, 0x11,
+	0x48, 0xe6, 0x62, 0xb3, 0x0c, 0x26, 0x6f, 0x09, 0x90, 0x4c, 0xd5, 0xce, 0x6a, 0x9f, 0x87, 0xcf,
+	0xa6, 0x90, 0x0c, 0x91, 0x26, 0x15, 0x6c, 0xdb, 0xae, 0xe9, 0xd8, 0x9f, 0x88, 0x1c, 0xaf, 0x9c,
+	0x3e, 0x82, 0xa5, 0x54, 0xc7, 0xd1, 0x2d, 0x22, 0xf4, 0x24, 0x4d, 0x74, 0x06, 0x8b, 0xa2, 0x8c,
+	0x37, 0x1d, 0x52, 0x74, 0x3f, 0x82, 0x6c, 0xe2, 0x44, 0xc7, 0x94, 0xba, 0x9b, 0xc0, 0x04, 0xa4,
+	0xe5, 0x9a, 0xa3, 0x51, 0x63, 0x34, 0x72, 0x30, 0x79, 0x03, 0x6f, 0x68, 0x89, 0xa1, 0xe2, 0x7c,
+	0x1f, 0xcb, 0xeb, 0x1f, 0xc2, 0x6d, 0xea, 0x99, 0x43, 0xee, 0x47, 0x46, 0xaf, 0x6c, 0xeb, 0x73,
+	0xb0, 0x2a, 0x9e, 0xf6, 0xbd, 0x50, 0xbc, 0x26, 0xa3, 0x53, 0x83, 0x65, 0x01, 0x46, 0xeb, 0xa9,
+	0xc3, 0xe9, 0xde, 0x95, 0x08, 0x16, 0xe1, 0x65, 0xf5, 0x7f, 0x5b, 0x04, 0x2d, 0x16, 0x88, 0xae,
+	0x8d, 0x77, 0xc2, 0x84, 0x66, 0xc2, 0xc8, 0x5f, 0xba, 0xd0, 0xc8, 0xbf, 0x3a, 0xd3, 0xfc, 0x16,
+	0x14, 0xed, 0x00, 0xbd, 0x79, 0xf2, 0x00, 0x8e, 0x2c, 0x69, 0xbb, 0x00, 0x23, 0xee, 0xdb, 0x9e,
+	0x45, 0x12, 0x54, 0x98, 0x79, 0xc0, 0x72, 0xba, 0x52, 0xeb, 0x07, 0x11, 0x8d, 0x91, 0xa0, 0xc7,
+	0x7a, 0x88, 0x92, 0xc8, 0xe3, 0x2a, 0x52, 0xa5, 0x93, 0x20, 0xbc, 0x70, 0x6a, 0xe4, 0xdb, 0x3d,
+	0x2e, 0x86, 0xe3, 0x71, 0x60, 0x6d, 0xd2, 0xcd, 0xdc, 0x25, 0xc2, 0x9c, 0xf5, 0x0a, 0x25, 0xd0,
+	0x74, 0xc9, 0xc7, 0x15, 0xd0, 0x56, 0x4f, 0xde, 0x54, 0x24, 0x8c, 0xff, 0x25, 0x63, 0xf6, 0x4b,
+	0x4c, 0xcf, 0x92, 0x2f, 0xf6, 0x6c, 0x77, 0x97, 0xbb, 0xfd, 0x70, 0x40, 0xc2, 0xbd, 0x64, 0x4c,
+	0xc1, 0x49, 0x83, 0x89, 0xfb, 0x4f, 0x45, 0xce, 0x42, 0xc5, 0x88, 0xca, 0x1a, 0x5d, 0xf5, 0xe5,
+	0x78, 0x7e, 0x27, 0xf4, 0xe5, 0x56, 0x3e, 0x2a, 0xa3, 0x15, 0x14, 0x50, 0x5d, 0x0f, 0x7c, 0xcf,
+	0x1a, 0xd3, 0x76, 0x56, 0x28, 0xb1, 0x49, 0x70, 0x8c, 0xb9, 0x67, 0xba, 0x32, 0xdd, 0x7f, 0x29,
+	0x89, 0x19, 0x81, 0xc9, 0x8d, 0xe7, 0x05, 0x31, 0xc3, 0x15, 0xe9, 0xc6, 0x4b, 0xc0, 0x24, 0x4e,
+	0xcc, 0x8a, 0x45, 0x38, 0x31, 0x1f, 0x6a, 0xbf, 0xe5, 0x7b, 0xb6, 0x15, 0xf3, 0x92, 0xd7, 0x6f,
+	0x4d, 0xc2, 0x13, 0xb8, 0x31, 0x4f, 0x2d, 0x85, 0x1b, 0xf3, 0xbd, 0x09, 0x05, 0xef, 0xe4, 0x44,
+	0x86, 0x86, 0x2a, 0x86, 0x28, 0xe8, 0x3f, 0xc8, 0x00, 0xc4, 0x22, 0x81, 0x13, 0x21, 0x2e, 0xc5,
+	0x13, 0xff, 0x36, 0xdc, 0x48, 0x82, 0x1d, 0x79, 0x90, 0x83, 0x66, 0x43, 0xfc, 0x82, 0xee, 0x4e,
+	0xc8, 0xca, 0x1b, 0x84, 0x24, 0x0c, 0x0f, 0x56, 0x63, 0x56, 0xfc, 0x4d, 0x60, 0x31, 0x90, 0xce,
+	0x58, 0x63, 0x7a, 0x7c, 0x0a, 0x15, 0xaf, 0x69, 0x08, 0x58, 0x41, 0xdf, 0xc1, 0x3c, 0xfb, 0x10,
+	0x55, 0xd8, 0x74, 0xa2, 0xd4, 0xf5, 0xf2, 0x22, 0x7f, 0x35, 0x83, 0x99, 0x1b, 0x74, 0xca, 0x09,
+	0x17, 0xf7, 0x39, 0x37, 0xdd, 0xa6, 0x65, 0xd1, 0x79, 0xb2, 0x5c, 0x74, 0xd7, 0x26, 0x16, 0x51,
+	0x9e, 0x4c, 0x95, 0xf9, 0x2c, 0x66, 0x62, 0x54, 0x16, 0xcb, 0xca, 0xa6, 0xe7, 0xba, 0xbc, 0x87,
+	0x8b, 0x52, 0xb4, 0xac, 0x44, 0x20, 0xfd, 0xc7, 0x39, 0xa8, 0xe0, 0x51, 0x2c, 0x71, 0x35, 0xe5,
+	0x37, 0xa1, 0x3c, 0xe4, 0x41, 0x60, 0xe2, 0x85, 0x66, 0x99, 0x99, 0x91, 0xba, 0x08, 0x77, 0xfd,
+	0x31, 0x79, 0x12, 0xe8, 0xd9, 0x88, 0xa8, 0x04, 0x07, 0x37, 0x8c, 0xbc, 0xa8, 0xd7, 0xe0, 0xe0,
+	0x46, 0x7f, 0x9e, 0xe1, 0x98, 0x81, 0x40, 0x89, 0x5c, 0x0c, 0x49, 0x10, 0x49, 0x0c, 0x5d, 0x96,
+	0x24, 0x0e, 0x84, 0x8b, 0x02, 0x26, 0xea, 0x84, 0x03, 0x64, 0xa8, 0x6e, 0x1b, 0xfc, 0xfc, 0x85,
+	0x1f, 0xee, 0x0a, 0xbc, 0xa6, 0x1b, 0xfa, 0xe7, 0x86, 0xa2, 0xaa, 0xef, 0xe1, 0x2d, 0xb4, 0x51,
+	0x8d, 0x30, 0xfb, 0xc1, 0x73, 0x2c, 0x1e, 0x88, 0xeb, 0x23, 0xe2, 0xbb, 0xd1, 0x53, 0x40, 0x1c,
+	0x17, 0x72, 0x28, 0x71, 0x5f, 0x26, 0xa0, 0xa8, 0x62, 0xfd, 0x17, 0x60, 0x31, 0xf9, 0x9d, 0x19,
+	0x42, 0xf3, 0x4e, 0x5a, 0x68, 0xe6, 0xeb, 0x28, 0x41, 0xf2, 0x4e, 0xf6, 0xed, 0x8c, 0xfe, 0xdd,
+	0x2a, 0x54, 0x11, 0x69, 0x4f, 0x74, 0xfe, 0x94, 0x1c, 0xd5, 0xa0, 0xe4, 0xc9, 0xba, 0xcb, 0x43,
+	0x5b, 0x5e, 0xa2, 0xd6, 0x32, 0xa7, 0x32, 0x97, 0xce, 0xa9, 0x4c, 0x1d, 0xdb, 0xca, 0x4f, 0x1e,
+	0xdb, 0xba, 0x0b, 0x30, 0xf4, 0x2c, 0x5a, 0x5e, 0x1a, 0x22, 0x95, 0x21, 0x67, 0x24, 0x20, 0xc8,
+	0x37, 0x90, 0xe3, 0x26, 0x54, 0x9b, 0x2a, 0x8a, 0xe4, 0xd6, 0x91, 0x73, 0xde, 0xf5, 0x64, 0x6d,
+	0x5b, 0x56, 0x7c, 0xbb, 0x51, 0x1a, 0xae, 0x6d, 0x42, 0x49, 0xca, 0x53, 0xad, 0x38, 0x33, 0xca,
+	0x9b, 0x68, 0xf4, 0xba, 0xfc, 0x95, 0x27, 0xb5, 0x0d, 0x45, 0x89, 0x1e, 0x7d, 0x33, 0x0c, 0xcd,
+	0xde, 0x60, 0x28, 0x97, 0x83, 0x8b, 0x44, 0x42, 0x31, 0x6a, 0x44, 0xd8, 0x46, 0x92, 0x52, 0xdb,
+	0xc0, 0x14, 0x26, 0x33, 0x95, 0x3e, 0xf6, 0xe2, 0x25, 0x6c, 0x0c, 0x85, 0x6b, 0xc4, 0x64, 0xd1,
+	0x1f, 0x11, 0x40, 0xe2, 0x8f, 0x08, 0xee, 0x41, 0x55, 0xca, 0xbc, 0xc1, 0x4d, 0xa1, 0xe7, 0xcb,
+	0x46, 0x12, 0x44, 0xe9, 0x58, 0xe7, 0x6e, 0x4f, 0x66, 0x52, 0x94, 0x0d, 0x59, 0xc2, 0x19, 0x2f,
+	0x64, 0x36, 0x8a, 0x50, 0x47, 0x65, 0xed, 0xab, 0x50, 0x14, 0xcf, 0x17, 0xfc, 0x61, 0x4a, 0xb2,
+	0xaa, 0x42, 0x3c, 0x0d, 0x49, 0x50, 0xff, 0x51, 0x06, 0x96, 0xd3, 0xbd, 0xf9, 0xa7, 0x71, 0x53,
+	0xf7, 0xd7, 0xe3, 0x9b, 0xba, 0x3f, 0xc5, 0xad, 0xd7, 0xbf, 0x99, 0x01, 0x88, 0x07, 0x0a, 0x7b,
+	0x48, 0xdc, 0x28, 0xac, 0x36, 0x71, 0xa2, 0xa4, 0xed, 0xa4, 0x6e, 0x66, 0x7b, 0x63, 0xae, 0x51,
+	0x4f, 0x3c, 0x26, 0x8e, 0xb7, 0xbd, 0x0a, 0xcb, 0x69, 0x38, 0x1d, 0x0b, 0x6c, 0xed, 0x36, 0x85,
+	0x13, 0xbd, 0xb5, 0xd7, 0x78, 0xd8, 0x94, 0x07, 0xf4, 0x5b, 0xfb, 0x8f, 0x58, 0xb6, 0xfe, 0x87,
+	0x19, 0x4c, 0x7e, 0x55, 0x03, 0xff, 0x41, 0x52, 0x78, 0x44, 0xd2, 0xea, 0xeb, 0xf3, 0x08, 0x4f,
+	0xfc, 0x24, 0x94, 0x54, 0xcc, 0xa5, 0xee, 0x61, 0x68, 0x32, 0xf9, 0x72, 0x86, 0x66, 0x79, 0x98,
+	0xd6, 0x2c, 0xaf, 0xcd, 0xf5, 0x49, 0xe5, 0x0b, 0xc0, 0xf3, 0x18, 0x09, 0x35, 0x53, 0xbf, 0x07,
+	0x8b, 0xc9, 0x57, 0x33, 0x6e, 0x06, 0xf9, 0xa3, 0x0c, 0x14, 0x85, 0x30, 0xa1, 0x86, 0xa0, 0xf9,
+	0x2c, 0xdc, 0xea, 0x22, 0x4f, 0x2f, 0x01, 0xc1, 0xff, 0x64, 0x40, 0x55, 0x8e, 0x71, 0x86, 0x73,
+	0x59, 0xbb, 0x97, 0xaf, 0x14, 0xd1, 0xf5, 0x5d, 0x45, 0x62, 0xc4, 0xd4, 0xf5, 0x5f, 0xcc, 0x88,
+	0xfb, 0x9b, 0xa8, 0xf4, 0x67, 0xa0, 0xfc, 0xd4, 0x64, 0x28, 0xc4, 0x93, 0x61, 0xed, 0x0f, 0x73,
+	0xb0, 0x9c, 0xce, 0x78, 0xa5, 0xdb, 0x0e, 0x44, 0xb6, 0x75, 0xdb, 0xb1, 0x12, 0x67, 0x21, 0xe9,
+	0xf2, 0x26, 0xe9, 0x67, 0x21, 0xc0, 0x2a, 0x39, 0x56, 0xbd, 0x21, 0x67, 0xf7, 0x92, 0xff, 0xc3,
+	0xf0, 0x65, 0xf4, 0x9d, 0x8a, 0x0b, 0x27, 0xd8, 0x48, 0xab, 0xc8, 0x1b, 0xa9, 0xbf, 0x9b, 0xd5,
+	0x96, 0x12, 0x27, 0xf2, 0x7e, 0x88, 0x7b, 0x8c, 0x95, 0x8d, 0xb1, 0x6b, 0x39, 0xdc, 0x8a, 0xa0,
+	0x3f, 0x4a, 0x42, 0xa3, 0x23, 0x75, 0xdf, 0x45, 0xdf, 0x71, 0xa5, 0x33, 0x3e, 0x96, 0x91, 0x85,
+	0x5f, 0xcc, 0x6b, 0xb7, 0x60, 0x55, 0x62, 0xc5, 0x67, 0x51, 0xd8, 0x2f, 0xa1, 0xdd, 0xb3, 0xdc,
+	0x10, 0x63, 0x21, 0x2b, 0xca, 0x7e, 0x19, 0xef, 0x83, 0xa0, 0x5b, 0x6c, 0xd8, 0x5f, 0x24, 0x3e,
+	0xd1, 0x61, 0x70, 0xf6, 0x2b, 0x78, 0xc1, 0x16, 0x74, 0xba, 0xd1, 0x87, 0xbe, 0x9f, 0xd7, 0xaa,
+	0x50, 0xec, 0x74, 0x89, 0xdb, 0x0f, 0xf2, 0xda, 0x73, 0xc0, 0xe2, 0xb7, 0xf2, 0x4c, 0xcf, 0x5f,
+	0x15, 0x95, 0x89, 0x0e, 0xe9, 0xfc, 0xb5, 0x3c, 0xb6, 0x4b, 0xc9, 0x17, 0xfb, 0x35, 0xfc, 0xbb,
+	0x92, 0x6a, 0x22, 0xd0, 0xca, 0xfe, 0x3a, 0xde, 0x79, 0xb6, 0xb4, 0x97, 0x3a, 0x76, 0xf3, 0x3d,
+	0xfa, 0xf2, 0x76, 0x74, 0x9e, 0x9d, 0xfd, 0x7a, 0x5e, 0xbb, 0x9d, 0x0e, 0x45, 0xc9, 0x17, 0x7f,
+	0x83, 0xa8, 0x85, 0xad, 0x15, 0x48, 0xd8, 0xdf, 0x24, 0x6a, 0x94, 0x32, 0x09, 0xf8, 0x5b, 0xd4,
+	0x21, 0x9b, 0xf1, 0x29, 0x20, 0x09, 0xff, 0x21, 0x11, 0xab, 0xc1, 0x14, 0xb0, 0x1f, 0xe5, 0xd7,
+	0x7e, 0x9b, 0x92, 0x03, 0x92, 0x89, 0xef, 0xe8, 0x05, 0x77, 0x3c, 0xb7, 0x1f, 0x8a, 0xff, 0xbf,
+	0xc0, 0x53, 0x48, 0x03, 0xcf, 0x0f, 0xa9, 0x48, 0x17, 0x6e, 0x88, 0x9b, 0x8b, 0xc4, 0x81, 0x4c,
+	0xe1, 0x2f, 0x60, 0x39, 0x75, 0xd0, 0xa8, 0x1a, 0x9d, 0x5f, 0xca, 0x47, 0x67, 0xac, 0xe8, 0xa6,
+	0x32, 0x75, 0xf5, 0x92, 0xf0, 0xf0, 0x8f, 0x7d, 0x47, 0x9c, 0xb5, 0xe2, 0xb8, 0x57, 0x14, 0x17,
+	0xdd, 0x8f, 0x06, 0x9e, 0x2b, 0x0f, 0x5b, 0x71, 0xba, 0xf3, 0x1e, 0x12, 0xc7, 0x0c, 0x2c, 0xac,
+	0x47, 0x94, 0x49, 0xcb, 0xf8, 0xda, 0xdf, 0xce, 0xc0, 0xa2, 0xba, 0x10, 0x0b, 0xff, 0x0e, 0x4f,
+	0x9c, 0xd6, 0x52, 0xff, 0x2a, 0xd2, 0x73, 0xec, 0x91, 0xba, 0xa5, 0x7f, 0x05, 0xaa, 0xf8, 0x5f,
+	0x37, 0x0d, 0xd7, 0xda, 0xf2, 0xbd, 0x91, 0xa8, 0xb6, 0x48, 0x1f, 0x12, 0xa7, 0xc4, 0x9e, 0xf1,
+	0x63, 0x44, 0x1f, 0x71, 0xbc, 0x9b, 0x16, 0x8f, 0x30, 0x0c, 0x4c, 0xdf, 0x76, 0xfb, 0x18, 0x3a,
+	0x70, 0x03, 0x71, 0x5a, 0xac, 0x0a, 0xa5, 0x71, 0xc0, 0x7b, 0x66, 0x80, 0x07, 0xc6, 0xaa, 0x50,
+	0x3a, 0x1e, 0xdb, 0x4e, 0x68, 0xbb, 0xac, 0x94, 0x3a, 0x0e, 0x56, 0xc6, 0x96, 0x99, 0x23, 0x9b,
+	0x55, 0xd6, 0xfe, 0x79, 0x06, 0xaa, 0x24, 0x16, 0x71, 0xb8, 0x32, 0xb6, 0xf3, 0xf1, 0x90, 0x76,
+	0x74, 0x4b, 0x3a, 0xde, 0xad, 0xf7, 0x54, 0x84, 0x2b, 0xa5, 0x58, 0x88, 0xfb, 0x4b, 0xc4, 0x85,
+	0xe9, 0x79, 0xed, 0x79, 0x78, 0xce, 0xe0, 0x43, 0x2f, 0xe4, 0x4f, 0x4c, 0x3b, 0x4c, 0x9e, 0xcc,
+	0x2e, 0xa0, 0xa3, 0x40, 0xbc, 0x52, 0x47, 0xb1, 0x8b, 0xe4, 0x28, 0xc0, 0xcf, 0x2a, 0x48, 0x09,
+	0x5b, 0x4f, 0x10, 0xe9, 0x39, 0x28, 0x47, 0x28, 0x98, 0x5e, 0x83, 0x5f, 0xa3, 0xcb, 0x76, 0x08,
+	0x42, 0x99, 0x16, 0x08, 0x82, 0xb5, 0x7d, 0xb8, 0x35, 0x3b, 0x3f, 0x40, 0x5c, 0xc3, 0x43, 0x7f,
+	0xcd, 0x43, 0xf1, 0xbd, 0x27, 0xbe, 0x2d, 0xae, 0x45, 0xa9, 0x40, 0xa1, 0xfd, 0xcc, 0x25, 0xb1,
+	0x58, 0x85, 0xa5, 0x7d, 0x2f, 0x41, 0xc3, 0x72, 0x6b, 0x6f, 0xe1, 0x6d, 0x2b, 0x91, 0x1f, 0x97,
+	0x2e, 0xf5, 0x25, 0x19, 0xa2, 0x65, 0xe7, 0x21, 0xfa, 0x70, 0xc5, 0x36, 0x07, 0xd3, 0xa6, 0xbd,
+	0xb1, 0xca, 0x9d, 0x61, 0xd9, 0xb5, 0x5e, 0x2a, 0x17, 0x24, 0xee, 0x4d, 0x55, 0xfb, 0x85, 0xc4,
+	0x01, 0xf6, 0x8c, 0x88, 0xf9, 0xd2, 0x5f, 0x35, 0x8a, 0xbb, 0xf4, 0x64, 0x0e, 0x86, 0x25, 0xee,
+	0xd2, 0x8b, 0xda, 0x47, 0x87, 0x01, 0x37, 0x4d, 0xb7, 0xc7, 0x1d, 0x6e, 0xb1, 0xc2, 0xda, 0xdb,
+	0xb0, 0x22, 0xfb, 0xa8, 0xc7, 0x83, 0x40, 0x1d, 0x00, 0x3f, 0xf0, 0xed, 0x53, 0x71, 0x5f, 0x1f,
+	0x86, 0xb5, 0xb8, 0x1f, 0x78, 0x2e, 0xdd, 0x6c, 0x08, 0x50, 0xec, 0x0c, 0x4c, 0x1f, 0xbf, 0xb1,
+	0xf6, 0x96, 0xec, 0xdd, 0xc7, 0x67, 0xd3, 0x77, 0xef, 0xa3, 0x23, 0x40, 0xa2, 0x87, 0x3e, 0x37,
+	0xe5, 0x0d, 0x35, 0x38, 0x31, 0x59, 0x6e, 0x6d, 0x13, 0x2a, 0x74, 0x92, 0xfc, 0x91, 0xed, 0x5a,
+	0xd8, 0x07, 0x1b, 0xf2, 0x54, 0x23, 0xdd, 0x3d, 0x7b, 0x4a, 0x3d, 0x5a, 0x16, 0x7f, 0x49, 0xc2,
+	0xb2, 0x18, 0xfc, 0x41, 0xd7, 0xd9, 0xd0, 0xa4, 0x3b, 0x61, 0x9c, 0x73, 0xf1, 0xf7, 0x35, 0xb9,
+	0xb5, 0x6f, 0x80, 0x26, 0x3c, 0xc0, 0x16, 0x3f, 0xb3, 0xdd, 0x7e, 0x74, 0xed, 0x17, 0xd0, 0x85,
+	0x88, 0x16, 0x3f, 0x53, 0xd7, 0x00, 0xa8, 0x82, 0xba, 0x96, 0x71, 0xdb, 0x1b, 0xe3, 0x3d, 0x8e,
+	0x6b, 0x87, 0x70, 0x53, 0x48, 0x29, 0xb6, 0x87, 0x2e, 0x1c, 0xb9, 0xd0, 0x2b, 0x25, 0xae, 0x01,
+	0x08, 0xc7, 0x41, 0x84, 0xcb, 0x32, 0x58, 0xb1, 0xc8, 0xa3, 0x13, 0xc3, 0xb3, 0x6b, 0x3a, 0xdc,
+	0x98, 0xe1, 0x56, 0xa3, 0x75, 0x41, 0x38, 0x17, 0xd8, 0xc2, 0xda, 0x7b, 0xb0, 0x2a, 0x34, 0xd9,
+	0xbe, 0xb8, 0xf0, 0x41, 0x75, 0xe0, 0x93, 0xd6, 0x76, 0x4b, 0xf4, 0xf9, 0x66, 0x73, 0x77, 0xf7,
+	0xf1, 0x6e, 0x03, 0xc3, 0x66, 0x28, 0x52, 0xed, 0xee, 0xd1, 0x66, 0x7b, 0x7f, 0xbf, 0xb9, 0xd9,
+	0x6d, 0x6e, 0xb1, 0xec, 0x9a, 0x05, 0xd0, 0x39, 0x77, 0x7b, 0xb2, 0xc6, 0x37, 0x81, 0xc5, 0xa5,
+	0x0e, 0x59, 0x96, 0xe2, 0x16, 0xe1, 0x34, 0x54, 0xcc, 0x39, 0x6c, 0x4b, 0x04, 0x16, 0x13, 0x2d,
+	0x9b, 0xe6, 0xf0, 0xc1, 0x98, 0x8f, 0xa9, 0x8b, 0x03, 0xa8, 0x20, 0x94, 0x90, 0xa8, 0x5b, 0x54,
+	0x61, 0x7f, 0x4c, 0xf7, 0x53, 0xdf, 0x83, 0x3b, 0x11, 0xa8, 0xe5, 0xf6, 0xbc, 0xe1, 0xc8, 0x0c,
+	0xf1, 0x92, 0xe9, 0x43, 0xee, 0x07, 0xe2, 0xaa, 0x84, 0xe7, 0xe1, 0xb9, 0x98, 0x48, 0x34, 0x55,
+	0x7c, 0x32, 0x47, 0xdd, 0xa7, 0x5e, 0xb5, 0x4f, 0x91, 0xe2, 0x13, 0xfc, 0xc3, 0x90, 0x8d, 0xb5,
+	0x7f, 0xf3, 0xd3, 0xbb, 0x99, 0x9f, 0xfc, 0xf4, 0x6e, 0xe6, 0xf7, 0x7e, 0x7a, 0x37, 0xf3, 0x83,
+	0x9f, 0xdd, 0x5d, 0xf8, 0xc9, 0xcf, 0xee, 0x2e, 0xfc, 0xce, 0xcf, 0xee, 0x2e, 0x7c, 0xcc, 0x26,
+	0xff, 0x52, 0xf6, 0xb8, 0x48, 0x9b, 0xf2, 0xd7, 0xff, 0xef, 0x00, 0x5e, 0xe9, 0xd1, 0x54, 0x6d,
+	0x76, 0x00, 0x00,
 }
 
 func (m *SmartBlockSnapshotBase) Marshal() (dAtA []byte, err error) {
@@ -10920,6 +12058,11 @@ func (m *SearchMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Page != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.RelationDetails != nil {
 		{
 			size, err := m.RelationDetails.MarshalToSizedBuffer(dAtA[:i])
@@ -12225,6 +13368,34 @@ func (m *BlockContentDataviewView) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupNumberRanges) > 0 {
+		for iNdEx := len(m.GroupNumberRanges) - 1; iNdEx >= 0; iNdEx-- {
+			f30 := math.Float64bits(float64(m.GroupNumberRanges[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f30))
+		}
+		i = encodeVarintModels(dAtA, i, uint64(len(m.GroupNumberRanges)*8))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.GroupDateBucket != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.GroupDateBucket))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.StartRelationKey) > 0 {
+		i -= len(m.StartRelationKey)
+		copy(dAtA[i:], m.StartRelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.StartRelationKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if len(m.EndRelationKey) > 0 {
 		i -= len(m.EndRelationKey)
 		copy(dAtA[i:], m.EndRelationKey)
@@ -12892,6 +14063,48 @@ func (m *BlockContentDataviewGroupValueOfDate) MarshalToSizedBuffer(dAtA []byte)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *BlockContentDataviewGroupValueOfNumber) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentDataviewGroupValueOfNumber) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Number != nil {
+		{
+			size, err := m.Number.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlockContentDataviewGroupValueOfObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentDataviewGroupValueOfObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Object != nil {
+		{
+			size, err := m.Object.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *BlockContentDataviewStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13007,6 +14220,150 @@ func (m *BlockContentDataviewDate) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.To != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.From != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockContentDataviewNumber) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockContentDataviewNumber) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentDataviewNumber) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasTo {
+		i--
+		if m.HasTo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HasFrom {
+		i--
+		if m.HasFrom {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.To != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.To))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.From != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.From))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockContentDataviewObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockContentDataviewObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentDataviewObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockContentDataviewTimelineWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockContentDataviewTimelineWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentDataviewTimelineWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.To != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.From != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EndRelationKey) > 0 {
+		i -= len(m.EndRelationKey)
+		copy(dAtA[i:], m.EndRelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.EndRelationKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StartRelationKey) > 0 {
+		i -= len(m.StartRelationKey)
+		copy(dAtA[i:], m.StartRelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.StartRelationKey)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -13847,20 +15204,20 @@ func (m *Restrictions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Object) > 0 {
-		dAtA41 := make([]byte, len(m.Object)*10)
-		var j40 int
+		dAtA44 := make([]byte, len(m.Object)*10)
+		var j43 int
 		for _, num := range m.Object {
 			for num >= 1<<7 {
-				dAtA41[j40] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j40++
+				j43++
 			}
-			dAtA41[j40] = uint8(num)
-			j40++
+			dAtA44[j43] = uint8(num)
+			j43++
 		}
-		i -= j40
-		copy(dAtA[i:], dAtA41[:j40])
-		i = encodeVarintModels(dAtA, i, uint64(j40))
+		i -= j43
+		copy(dAtA[i:], dAtA44[:j43])
+		i = encodeVarintModels(dAtA, i, uint64(j43))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -13888,20 +15245,20 @@ func (m *RestrictionsDataviewRestrictions) MarshalToSizedBuffer(dAtA []byte) (in
 	var l int
 	_ = l
 	if len(m.Restrictions) > 0 {
-		dAtA43 := make([]byte, len(m.Restrictions)*10)
-		var j42 int
+		dAtA46 := make([]byte, len(m.Restrictions)*10)
+		var j45 int
 		for _, num := range m.Restrictions {
 			for num >= 1<<7 {
-				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j42++
+				j45++
 			}
-			dAtA43[j42] = uint8(num)
-			j42++
+			dAtA46[j45] = uint8(num)
+			j45++
 		}
-		i -= j42
-		copy(dAtA[i:], dAtA43[:j42])
-		i = encodeVarintModels(dAtA, i, uint64(j42))
+		i -= j45
+		copy(dAtA[i:], dAtA46[:j45])
+		i = encodeVarintModels(dAtA, i, uint64(j45))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -14106,20 +15463,20 @@ func (m *ObjectType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x48
 	}
 	if len(m.Types) > 0 {
-		dAtA45 := make([]byte, len(m.Types)*10)
-		var j44 int
+		dAtA48 := make([]byte, len(m.Types)*10)
+		var j47 int
 		for _, num := range m.Types {
 			for num >= 1<<7 {
-				dAtA45[j44] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA48[j47] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j44++
+				j47++
 			}
-			dAtA45[j44] = uint8(num)
-			j44++
+			dAtA48[j47] = uint8(num)
+			j47++
 		}
-		i -= j44
-		copy(dAtA[i:], dAtA45[:j44])
-		i = encodeVarintModels(dAtA, i, uint64(j44))
+		i -= j47
+		copy(dAtA[i:], dAtA48[:j47])
+		i = encodeVarintModels(dAtA, i, uint64(j47))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -15100,6 +16457,13 @@ func (m *Notification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SnoozeUntil != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SnoozeUntil))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if m.Payload != nil {
 		{
 			size := m.Payload.Size()
@@ -15369,6 +16733,52 @@ func (m *NotificationPayloadOfParticipantPermissionsChange) MarshalToSizedBuffer
 	}
 	return len(dAtA) - i, nil
 }
+func (m *NotificationPayloadOfReminder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationPayloadOfReminder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Reminder != nil {
+		{
+			size, err := m.Reminder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *NotificationPayloadOfRuleTriggered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationPayloadOfRuleTriggered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RuleTriggered != nil {
+		{
+			size, err := m.RuleTriggered.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *NotificationImport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15837,6 +17247,379 @@ func (m *NotificationParticipantPermissionsChange) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
+func (m *NotificationReminder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationReminder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationReminder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpaceName) > 0 {
+		i -= len(m.SpaceName)
+		copy(dAtA[i:], m.SpaceName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Date != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Date))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RelationName) > 0 {
+		i -= len(m.RelationName)
+		copy(dAtA[i:], m.RelationName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NotificationRuleTriggered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationRuleTriggered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationRuleTriggered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeepLink) > 0 {
+		i -= len(m.DeepLink)
+		copy(dAtA[i:], m.DeepLink)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.DeepLink)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ActorName) > 0 {
+		i -= len(m.ActorName)
+		copy(dAtA[i:], m.ActorName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ActorName)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RelationName) > 0 {
+		i -= len(m.RelationName)
+		copy(dAtA[i:], m.RelationName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationName)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SpaceName) > 0 {
+		i -= len(m.SpaceName)
+		copy(dAtA[i:], m.SpaceName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Trigger != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Trigger))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RuleName) > 0 {
+		i -= len(m.RuleName)
+		copy(dAtA[i:], m.RuleName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RuleId) > 0 {
+		i -= len(m.RuleId)
+		copy(dAtA[i:], m.RuleId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RuleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NotificationDigest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationDigest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationDigest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NotificationIds) > 0 {
+		for iNdEx := len(m.NotificationIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NotificationIds[iNdEx])
+			copy(dAtA[i:], m.NotificationIds[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.NotificationIds[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Latest != nil {
+		{
+			size, err := m.Latest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.UnreadCount != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.UnreadCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Count != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Grouping != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Grouping))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NotificationExpiryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationExpiryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationExpiryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnreadTtl != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.UnreadTtl))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReadTtl != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ReadTtl))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NotificationRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OnlyCreatedByMe {
+		i--
+		if m.OnlyCreatedByMe {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Trigger != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Trigger))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ObjectTypeKeys) > 0 {
+		for iNdEx := len(m.ObjectTypeKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObjectTypeKeys[iNdEx])
+			copy(dAtA[i:], m.ObjectTypeKeys[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectTypeKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Export) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16634,6 +18417,32 @@ func (m *ChatState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Threads) > 0 {
+		for k := range m.Threads {
+			v := m.Threads[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintModels(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintModels(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintModels(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Order != 0 {
 		i = encodeVarintModels(dAtA, i, uint64(m.Order))
 		i--
@@ -16728,6 +18537,25 @@ func (m *ChatMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Thread != nil {
+		{
+			size, err := m.Thread.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Synced {
 		i--
 		if m.Synced {
@@ -17009,6 +18837,102 @@ func (m *ChatMessageReactionsIdentityList) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *ChatMessageThread) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChatMessageThread) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChatMessageThread) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastReply != nil {
+		{
+			size, err := m.LastReply.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ReplyCount != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ReplyCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChatMessageThreadLastReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChatMessageThreadLastReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChatMessageThreadLastReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
 	offset -= sovModels(v)
 	base := offset
@@ -17142,6 +19066,9 @@ func (m *SearchMeta) Size() (n int) {
 		l = m.RelationDetails.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
+	if m.Page != 0 {
+		n += 1 + sovModels(uint64(m.Page))
+	}
 	return n
 }
 
@@ -17799,6 +19726,16 @@ func (m *BlockContentDataviewView) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovModels(uint64(l))
 	}
+	l = len(m.StartRelationKey)
+	if l > 0 {
+		n += 2 + l + sovModels(uint64(l))
+	}
+	if m.GroupDateBucket != 0 {
+		n += 2 + sovModels(uint64(m.GroupDateBucket))
+	}
+	if len(m.GroupNumberRanges) > 0 {
+		n += 2 + sovModels(uint64(len(m.GroupNumberRanges)*8)) + len(m.GroupNumberRanges)*8
+	}
 	return n
 }
 
@@ -18049,6 +19986,30 @@ func (m *BlockContentDataviewGroupValueOfDate) Size() (n int) {
 	}
 	return n
 }
+func (m *BlockContentDataviewGroupValueOfNumber) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != nil {
+		l = m.Number.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+func (m *BlockContentDataviewGroupValueOfObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
 func (m *BlockContentDataviewStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18095,6 +20056,71 @@ func (m *BlockContentDataviewDate) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.From != 0 {
+		n += 1 + sovModels(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovModels(uint64(m.To))
+	}
+	return n
+}
+
+func (m *BlockContentDataviewNumber) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != 0 {
+		n += 9
+	}
+	if m.To != 0 {
+		n += 9
+	}
+	if m.HasFrom {
+		n += 2
+	}
+	if m.HasTo {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlockContentDataviewObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockContentDataviewTimelineWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartRelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.EndRelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.From != 0 {
+		n += 1 + sovModels(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovModels(uint64(m.To))
+	}
 	return n
 }
 
@@ -19020,6 +21046,9 @@ func (m *Notification) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
+	if m.SnoozeUntil != 0 {
+		n += 2 + sovModels(uint64(m.SnoozeUntil))
+	}
 	return n
 }
 
@@ -19143,6 +21172,30 @@ func (m *NotificationPayloadOfParticipantPermissionsChange) Size() (n int) {
 	}
 	return n
 }
+func (m *NotificationPayloadOfReminder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reminder != nil {
+		l = m.Reminder.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
+	return n
+}
+func (m *NotificationPayloadOfRuleTriggered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RuleTriggered != nil {
+		l = m.RuleTriggered.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
+	return n
+}
 func (m *NotificationImport) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19370,6 +21423,193 @@ func (m *NotificationParticipantPermissionsChange) Size() (n int) {
 	return n
 }
 
+func (m *NotificationReminder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.RelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.RelationName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Date != 0 {
+		n += 1 + sovModels(uint64(m.Date))
+	}
+	l = len(m.SpaceName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *NotificationRuleTriggered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RuleId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.RuleName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Trigger != 0 {
+		n += 1 + sovModels(uint64(m.Trigger))
+	}
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.SpaceName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.RelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.RelationName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ActorName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.DeepLink)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *NotificationDigest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Grouping != 0 {
+		n += 1 + sovModels(uint64(m.Grouping))
+	}
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovModels(uint64(m.Count))
+	}
+	if m.UnreadCount != 0 {
+		n += 1 + sovModels(uint64(m.UnreadCount))
+	}
+	if m.Latest != nil {
+		l = m.Latest.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.NotificationIds) > 0 {
+		for _, s := range m.NotificationIds {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NotificationExpiryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReadTtl != 0 {
+		n += 1 + sovModels(uint64(m.ReadTtl))
+	}
+	if m.UnreadTtl != 0 {
+		n += 1 + sovModels(uint64(m.UnreadTtl))
+	}
+	return n
+}
+
+func (m *NotificationRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.ObjectTypeKeys) > 0 {
+		for _, s := range m.ObjectTypeKeys {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.Trigger != 0 {
+		n += 1 + sovModels(uint64(m.Trigger))
+	}
+	l = len(m.RelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.OnlyCreatedByMe {
+		n += 2
+	}
+	if m.Disabled {
+		n += 2
+	}
+	return n
+}
+
 func (m *Export) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19763,6 +22003,19 @@ func (m *ChatState) Size() (n int) {
 	if m.Order != 0 {
 		n += 1 + sovModels(uint64(m.Order))
 	}
+	if len(m.Threads) > 0 {
+		for k, v := range m.Threads {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovModels(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovModels(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovModels(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -19837,6 +22090,14 @@ func (m *ChatMessage) Size() (n int) {
 	if m.Synced {
 		n += 2
 	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Thread != nil {
+		l = m.Thread.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
 	return n
 }
 
@@ -19915,6 +22176,50 @@ func (m *ChatMessageReactionsIdentityList) Size() (n int) {
 	return n
 }
 
+func (m *ChatMessageThread) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplyCount != 0 {
+		n += 1 + sovModels(uint64(m.ReplyCount))
+	}
+	if m.LastReply != nil {
+		l = m.LastReply.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *ChatMessageThreadLastReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovModels(uint64(m.CreatedAt))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
 func sovModels(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -20729,6 +23034,25 @@ func (m *SearchMeta) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -24217,59 +26541,9 @@ func (m *BlockContentDataviewView) Unmarshal(dAtA []byte) error {
 			}
 			m.EndRelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContentDataviewRelation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Relation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Relation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRelationKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24297,52 +26571,13 @@ func (m *BlockContentDataviewRelation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.StartRelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsVisible", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsVisible = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
-			}
-			m.Width = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Width |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DateIncludeTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupDateBucket", wireType)
 			}
-			var v int
+			m.GroupDateBucket = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -24352,87 +26587,64 @@ func (m *BlockContentDataviewRelation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GroupDateBucket |= BlockContentDataviewDateBucket(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DateIncludeTime = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeFormat", wireType)
-			}
-			m.TimeFormat = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
+		case 19:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeFormat |= BlockContentDataviewRelationTimeFormat(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DateFormat", wireType)
-			}
-			m.DateFormat = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.GroupNumberRanges = append(m.GroupNumberRanges, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DateFormat |= BlockContentDataviewRelationDateFormat(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
 				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Formula", wireType)
-			}
-			m.Formula = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Formula |= BlockContentDataviewRelationFormulaType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Align", wireType)
-			}
-			m.Align = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.GroupNumberRanges) == 0 {
+					m.GroupNumberRanges = make([]float64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Align |= BlockAlign(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.GroupNumberRanges = append(m.GroupNumberRanges, v2)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupNumberRanges", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -24455,7 +26667,7 @@ func (m *BlockContentDataviewRelation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
+func (m *BlockContentDataviewRelation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24478,15 +26690,15 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sort: wiretype end group for non-group")
+			return fmt.Errorf("proto: Relation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sort: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Relation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24514,13 +26726,13 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelationKey = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVisible", wireType)
 			}
-			m.Type = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -24530,16 +26742,17 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= BlockContentDataviewSortType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsVisible = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomOrder", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
 			}
-			var msglen int
+			m.Width = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -24549,31 +26762,16 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Width |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CustomOrder = append(m.CustomOrder, &types.Value{})
-			if err := m.CustomOrder[len(m.CustomOrder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DateIncludeTime", wireType)
 			}
-			m.Format = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -24583,16 +26781,17 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Format |= RelationFormat(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.DateIncludeTime = bool(v != 0)
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeFormat", wireType)
 			}
-			var v int
+			m.TimeFormat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -24602,17 +26801,16 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TimeFormat |= BlockContentDataviewRelationTimeFormat(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeTime = bool(v != 0)
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateFormat", wireType)
 			}
-			var stringLen uint64
+			m.DateFormat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -24622,29 +26820,16 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DateFormat |= BlockContentDataviewRelationDateFormat(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmptyPlacement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Formula", wireType)
 			}
-			m.EmptyPlacement = 0
+			m.Formula = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -24654,16 +26839,16 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EmptyPlacement |= BlockContentDataviewSortEmptyType(b&0x7F) << shift
+				m.Formula |= BlockContentDataviewRelationFormulaType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCollate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Align", wireType)
 			}
-			var v int
+			m.Align = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -24673,12 +26858,11 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Align |= BlockAlign(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoCollate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -24700,7 +26884,7 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
+func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24723,32 +26907,13 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Filter: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sort: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Filter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sort: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
-			}
-			m.Operator = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Operator |= BlockContentDataviewFilterOperator(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
 			}
@@ -24780,11 +26945,11 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 			}
 			m.RelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Condition = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -24794,14 +26959,14 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Condition |= BlockContentDataviewFilterCondition(b&0x7F) << shift
+				m.Type |= BlockContentDataviewSortType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomOrder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24828,65 +26993,12 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &types.Value{}
-			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CustomOrder = append(m.CustomOrder, &types.Value{})
+			if err := m.CustomOrder[len(m.CustomOrder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationProperty", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RelationProperty = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuickOption", wireType)
-			}
-			m.QuickOption = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QuickOption |= BlockContentDataviewFilterQuickOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
@@ -24905,7 +27017,7 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 8:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IncludeTime", wireType)
 			}
@@ -24925,7 +27037,7 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IncludeTime = bool(v != 0)
-		case 9:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
@@ -24957,11 +27069,11 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NestedFilters", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyPlacement", wireType)
 			}
-			var msglen int
+			m.EmptyPlacement = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -24971,26 +27083,31 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EmptyPlacement |= BlockContentDataviewSortEmptyType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCollate", wireType)
 			}
-			m.NestedFilters = append(m.NestedFilters, &BlockContentDataviewFilter{})
-			if err := m.NestedFilters[len(m.NestedFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.NoCollate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -25012,7 +27129,7 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentDataviewGroupOrder) Unmarshal(dAtA []byte) error {
+func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25035,15 +27152,327 @@ func (m *BlockContentDataviewGroupOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: Filter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Filter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= BlockContentDataviewFilterOperator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ViewId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			m.Condition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Condition |= BlockContentDataviewFilterCondition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &types.Value{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationProperty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationProperty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuickOption", wireType)
+			}
+			m.QuickOption = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuickOption |= BlockContentDataviewFilterQuickOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= RelationFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeTime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeTime = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NestedFilters = append(m.NestedFilters, &BlockContentDataviewFilter{})
+			if err := m.NestedFilters[len(m.NestedFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentDataviewGroupOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25628,6 +28057,76 @@ func (m *BlockContentDataviewGroup) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &BlockContentDataviewGroupValueOfDate{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentDataviewNumber{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &BlockContentDataviewGroupValueOfNumber{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentDataviewObject{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &BlockContentDataviewGroupValueOfObject{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -25912,6 +28411,390 @@ func (m *BlockContentDataviewDate) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Date: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentDataviewNumber) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Number: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Number: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.From = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.To = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasFrom", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasFrom = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasTo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasTo = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentDataviewObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentDataviewTimelineWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelineWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelineWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartRelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndRelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndRelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -32321,6 +35204,95 @@ func (m *Notification) Unmarshal(dAtA []byte) error {
 			}
 			m.Payload = &NotificationPayloadOfParticipantPermissionsChange{v}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reminder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NotificationReminder{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &NotificationPayloadOfReminder{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleTriggered", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NotificationRuleTriggered{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &NotificationPayloadOfRuleTriggered{v}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnoozeUntil", wireType)
+			}
+			m.SnoozeUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnoozeUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -32756,11 +35728,1313 @@ func (m *NotificationGalleryImport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestToJoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestToJoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityIcon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityIcon = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationTest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Test: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Test: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationParticipantRequestApproved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantRequestApproved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantRequestApproved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			m.Permissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permissions |= ParticipantPermissions(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationRequestToLeave) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestToLeave: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestToLeave: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityIcon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityIcon = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantRemove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityIcon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityIcon = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationParticipantRequestDecline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantRequestDecline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantRequestDecline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationParticipantPermissionsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantPermissionsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantPermissionsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			m.Permissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permissions |= ParticipantPermissions(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationReminder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reminder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reminder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32788,9 +37062,28 @@ func (m *NotificationGalleryImport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RelationName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			m.Date = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Date |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
 			}
@@ -32843,7 +37136,7 @@ func (m *NotificationGalleryImport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
+func (m *NotificationRuleTriggered) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32866,15 +37159,15 @@ func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestToJoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuleTriggered: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestToJoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuleTriggered: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32902,11 +37195,11 @@ func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			m.RuleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32934,13 +37227,13 @@ func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.RuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
 			}
-			var stringLen uint64
+			m.Trigger = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -32950,27 +37243,14 @@ func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Trigger |= NotificationRuleTrigger(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IdentityName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityIcon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32998,7 +37278,7 @@ func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdentityIcon = string(dAtA[iNdEx:postIndex])
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -33032,109 +37312,9 @@ func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
 			}
 			m.SpaceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NotificationTest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Test: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Test: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NotificationParticipantRequestApproved) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParticipantRequestApproved: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParticipantRequestApproved: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33162,30 +37342,11 @@ func (m *NotificationParticipantRequestApproved) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
-			}
-			m.Permissions = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Permissions |= ParticipantPermissions(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33213,61 +37374,11 @@ func (m *NotificationParticipantRequestApproved) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NotificationRequestToLeave) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestToLeave: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestToLeave: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33295,11 +37406,11 @@ func (m *NotificationRequestToLeave) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33327,11 +37438,11 @@ func (m *NotificationRequestToLeave) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.RelationName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33359,11 +37470,11 @@ func (m *NotificationRequestToLeave) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdentityName = string(dAtA[iNdEx:postIndex])
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityIcon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33391,11 +37502,11 @@ func (m *NotificationRequestToLeave) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdentityIcon = string(dAtA[iNdEx:postIndex])
+			m.ActorName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeepLink", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33423,7 +37534,7 @@ func (m *NotificationRequestToLeave) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			m.DeepLink = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33446,7 +37557,7 @@ func (m *NotificationRequestToLeave) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
+func (m *NotificationDigest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33469,15 +37580,15 @@ func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParticipantRemove: wiretype end group for non-group")
+			return fmt.Errorf("proto: NotificationDigest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParticipantRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NotificationDigest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33505,11 +37616,30 @@ func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grouping", wireType)
+			}
+			m.Grouping = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Grouping |= NotificationDigestGrouping(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33537,11 +37667,11 @@ func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdentityName = string(dAtA[iNdEx:postIndex])
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityIcon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33569,13 +37699,51 @@ func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdentityIcon = string(dAtA[iNdEx:postIndex])
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnreadCount", wireType)
+			}
+			m.UnreadCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnreadCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Latest", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -33585,27 +37753,31 @@ func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			if m.Latest == nil {
+				m.Latest = &Notification{}
+			}
+			if err := m.Latest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33633,7 +37805,7 @@ func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			m.NotificationIds = append(m.NotificationIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33656,7 +37828,95 @@ func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NotificationParticipantRequestDecline) Unmarshal(dAtA []byte) error {
+func (m *NotificationExpiryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NotificationExpiryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NotificationExpiryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTtl", wireType)
+			}
+			m.ReadTtl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTtl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnreadTtl", wireType)
+			}
+			m.UnreadTtl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnreadTtl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33679,15 +37939,15 @@ func (m *NotificationParticipantRequestDecline) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParticipantRequestDecline: wiretype end group for non-group")
+			return fmt.Errorf("proto: NotificationRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParticipantRequestDecline: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NotificationRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33715,11 +37975,11 @@ func (m *NotificationParticipantRequestDecline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33747,61 +38007,43 @@ func (m *NotificationParticipantRequestDecline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NotificationParticipantPermissionsChange) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParticipantPermissionsChange: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParticipantPermissionsChange: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectTypeKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33829,13 +38071,13 @@ func (m *NotificationParticipantPermissionsChange) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			m.ObjectTypeKeys = append(m.ObjectTypeKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
 			}
-			m.Permissions = 0
+			m.Trigger = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -33845,14 +38087,14 @@ func (m *NotificationParticipantPermissionsChange) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permissions |= ParticipantPermissions(b&0x7F) << shift
+				m.Trigger |= NotificationRuleTrigger(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33880,8 +38122,48 @@ func (m *NotificationParticipantPermissionsChange) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyCreatedByMe", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyCreatedByMe = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -36594,6 +40876,135 @@ func (m *ChatState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Threads == nil {
+				m.Threads = make(map[string]*ChatStateUnreadState)
+			}
+			var mapkey string
+			var mapvalue *ChatStateUnreadState
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthModels
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthModels
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthModels
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthModels
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ChatStateUnreadState{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipModels(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthModels
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Threads[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -37109,6 +41520,74 @@ func (m *ChatMessage) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Synced = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thread", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Thread == nil {
+				m.Thread = &ChatMessageThread{}
+			}
+			if err := m.Thread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -37627,6 +42106,308 @@ func (m *ChatMessageReactionsIdentityList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChatMessageThread) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Thread: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Thread: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplyCount", wireType)
+			}
+			m.ReplyCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplyCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastReply == nil {
+				m.LastReply = &ChatMessageThreadLastReply{}
+			}
+			if err := m.LastReply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChatMessageThreadLastReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipModels(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
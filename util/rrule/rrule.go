@@ -0,0 +1,210 @@
+// Package rrule implements a subset of RFC 5545 recurrence rules: FREQ, INTERVAL, BYDAY, COUNT and UNTIL
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+const (
+	untilLayout     = "20060102T150405Z"
+	untilDateLayout = "20060102"
+	// maxSkippedPeriods limits the search of the next valid date, e.g. 31st day of the month
+	maxSkippedPeriods = 48
+)
+
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	// ByDay is used only with weekly frequency
+	ByDay []time.Weekday
+	// Count is the number of remaining occurrences including the current one, zero means no limit
+	Count int
+	// Until is the last possible occurrence, zero means no limit
+	Until time.Time
+}
+
+// Parse parses rules like "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH". Optional "RRULE:" prefix is allowed
+func Parse(rule string) (Rule, error) {
+	rule = strings.TrimSpace(rule)
+	rule = strings.TrimPrefix(rule, "RRULE:")
+	if rule == "" {
+		return Rule{}, fmt.Errorf("%w: empty rule", ErrInvalidRule)
+	}
+	r := Rule{Interval: 1}
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Rule{}, fmt.Errorf("%w: malformed part '%s'", ErrInvalidRule, part)
+		}
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.Freq = Frequency(strings.ToUpper(value))
+			switch r.Freq {
+			case Daily, Weekly, Monthly, Yearly:
+			default:
+				return Rule{}, fmt.Errorf("%w: unsupported frequency '%s'", ErrInvalidRule, value)
+			}
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if err != nil || r.Interval < 1 {
+				return Rule{}, fmt.Errorf("%w: invalid interval '%s'", ErrInvalidRule, value)
+			}
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+			if err != nil || r.Count < 1 {
+				return Rule{}, fmt.Errorf("%w: invalid count '%s'", ErrInvalidRule, value)
+			}
+		case "UNTIL":
+			r.Until, err = parseUntil(value)
+			if err != nil {
+				return Rule{}, fmt.Errorf("%w: invalid until '%s'", ErrInvalidRule, value)
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := weekdays[strings.ToUpper(strings.TrimSpace(day))]
+				if !ok {
+					return Rule{}, fmt.Errorf("%w: invalid day '%s'", ErrInvalidRule, day)
+				}
+				r.ByDay = append(r.ByDay, weekday)
+			}
+		case "WKST":
+			// weeks always start on Monday
+		default:
+			return Rule{}, fmt.Errorf("%w: unsupported part '%s'", ErrInvalidRule, key)
+		}
+	}
+	if r.Freq == "" {
+		return Rule{}, fmt.Errorf("%w: frequency is not set", ErrInvalidRule)
+	}
+	return r, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse(untilLayout, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(untilDateLayout, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// the whole day is included
+	return t.Add(24*time.Hour - time.Second), nil
+}
+
+// String returns the rule in RRULE format without prefix
+func (r Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, weekday := range r.ByDay {
+			days = append(days, strings.ToUpper(weekday.String()[:2]))
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilLayout))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next returns the occurrence following the current one. It returns false if the current occurrence is the last one
+func (r Rule) Next(current time.Time) (time.Time, bool) {
+	if r.Count == 1 {
+		return time.Time{}, false
+	}
+	interval := max(r.Interval, 1)
+	var next time.Time
+	switch r.Freq {
+	case Daily:
+		next = current.AddDate(0, 0, interval)
+	case Weekly:
+		next = r.nextWeekly(current, interval)
+	case Monthly:
+		next = addSkippingInvalid(current, 0, interval)
+	case Yearly:
+		next = addSkippingInvalid(current, interval, 0)
+	default:
+		return time.Time{}, false
+	}
+	if next.IsZero() || (!r.Until.IsZero() && next.After(r.Until)) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+// Advance returns the rule for the next occurrence, i.e. with decremented count
+func (r Rule) Advance() Rule {
+	if r.Count > 1 {
+		r.Count--
+	}
+	return r
+}
+
+func (r Rule) nextWeekly(current time.Time, interval int) time.Time {
+	if len(r.ByDay) == 0 {
+		return current.AddDate(0, 0, 7*interval)
+	}
+	// days since Monday
+	currentOffset := (int(current.Weekday()) + 6) % 7
+	nextOffset := -1
+	firstOffset := 7
+	for _, weekday := range r.ByDay {
+		offset := (int(weekday) + 6) % 7
+		if offset > currentOffset && (nextOffset == -1 || offset < nextOffset) {
+			nextOffset = offset
+		}
+		firstOffset = min(firstOffset, offset)
+	}
+	if nextOffset != -1 {
+		return current.AddDate(0, 0, nextOffset-currentOffset)
+	}
+	weekStart := current.AddDate(0, 0, -currentOffset)
+	return weekStart.AddDate(0, 0, 7*interval+firstOffset)
+}
+
+// addSkippingInvalid adds years or months keeping the day of month. Periods without such day, e.g. February 30, are skipped
+func addSkippingInvalid(current time.Time, years, months int) time.Time {
+	for i := 1; i <= maxSkippedPeriods; i++ {
+		next := time.Date(current.Year()+years*i, current.Month()+time.Month(months*i), current.Day(),
+			current.Hour(), current.Minute(), current.Second(), current.Nanosecond(), current.Location())
+		if next.Day() == current.Day() {
+			return next
+		}
+	}
+	return time.Time{}
+}
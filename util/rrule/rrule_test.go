@@ -0,0 +1,78 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("full rule", func(t *testing.T) {
+		r, err := Parse("RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;COUNT=5;UNTIL=20240601T100000Z")
+		require.NoError(t, err)
+
+		assert.Equal(t, Weekly, r.Freq)
+		assert.Equal(t, 2, r.Interval)
+		assert.Equal(t, []time.Weekday{time.Monday, time.Thursday}, r.ByDay)
+		assert.Equal(t, 5, r.Count)
+		assert.Equal(t, time.Date(2024, time.June, 1, 10, 0, 0, 0, time.UTC), r.Until)
+		assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;COUNT=5;UNTIL=20240601T100000Z", r.String())
+	})
+
+	for _, rule := range []string{"", "INTERVAL=2", "FREQ=HOURLY", "FREQ=DAILY;INTERVAL=0", "FREQ=WEEKLY;BYDAY=XX", "FREQ=DAILY;BYSETPOS=1"} {
+		t.Run("invalid rule "+rule, func(t *testing.T) {
+			_, err := Parse(rule)
+			assert.ErrorIs(t, err, ErrInvalidRule)
+		})
+	}
+}
+
+func TestRule_Next(t *testing.T) {
+	// Wednesday
+	current := time.Date(2024, time.January, 31, 9, 30, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		rule     string
+		expected time.Time
+	}{
+		{"FREQ=DAILY", time.Date(2024, time.February, 1, 9, 30, 0, 0, time.UTC)},
+		{"FREQ=DAILY;INTERVAL=3", time.Date(2024, time.February, 3, 9, 30, 0, 0, time.UTC)},
+		{"FREQ=WEEKLY", time.Date(2024, time.February, 7, 9, 30, 0, 0, time.UTC)},
+		{"FREQ=WEEKLY;BYDAY=MO,FR", time.Date(2024, time.February, 2, 9, 30, 0, 0, time.UTC)},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", time.Date(2024, time.February, 12, 9, 30, 0, 0, time.UTC)},
+		{"FREQ=MONTHLY", time.Date(2024, time.March, 31, 9, 30, 0, 0, time.UTC)},
+		{"FREQ=YEARLY", time.Date(2025, time.January, 31, 9, 30, 0, 0, time.UTC)},
+	} {
+		t.Run(tc.rule, func(t *testing.T) {
+			r, err := Parse(tc.rule)
+			require.NoError(t, err)
+
+			next, ok := r.Next(current)
+			require.True(t, ok)
+			assert.Equal(t, tc.expected, next)
+		})
+	}
+
+	t.Run("last occurrence by count", func(t *testing.T) {
+		r, err := Parse("FREQ=DAILY;COUNT=2")
+		require.NoError(t, err)
+
+		_, ok := r.Next(current)
+		require.True(t, ok)
+
+		r = r.Advance()
+		assert.Equal(t, 1, r.Count)
+		_, ok = r.Next(current)
+		assert.False(t, ok)
+	})
+
+	t.Run("last occurrence by until", func(t *testing.T) {
+		r, err := Parse("FREQ=DAILY;UNTIL=20240131")
+		require.NoError(t, err)
+
+		_, ok := r.Next(current)
+		assert.False(t, ok)
+	})
+}
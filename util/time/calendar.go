@@ -7,9 +7,9 @@ var Week = Day * 7
 
 func NewCalendar(t time.Time, loc *time.Location) Calendar {
 	if loc == nil {
-		loc = time.Now().Location()
+		loc = t.Location()
 	}
-	return Calendar{t: t, loc: loc}
+	return Calendar{t: t.In(loc), loc: loc}
 }
 
 type Calendar struct {
@@ -63,6 +63,17 @@ func (c *Calendar) MonthNumEnd(monthNum int) time.Time {
 	return firstDay.AddDate(0, 1, 0).Add(time.Nanosecond * -1)
 }
 
+func (c *Calendar) QuarterNumStart(quarterNum int) time.Time {
+	firstMonth := (c.t.Month()-1)/3*3 + 1
+	needMonth := firstMonth + time.Month(quarterNum*3)
+	return time.Date(c.t.Year(), needMonth, 1, 0, 0, 0, 0, c.loc)
+}
+
+func (c *Calendar) QuarterNumEnd(quarterNum int) time.Time {
+	firstDay := c.QuarterNumStart(quarterNum)
+	return firstDay.AddDate(0, 3, 0).Add(time.Nanosecond * -1)
+}
+
 func (c *Calendar) YearNumStart(yearDelta int) time.Time {
 	needYear := c.t.Year() + yearDelta
 	return time.Date(needYear, time.January, 1, 0, 0, 0, 0, c.loc)
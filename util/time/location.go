@@ -0,0 +1,30 @@
+package time
+
+import (
+	"time"
+)
+
+// LocationProvider gives the time zone of the account, relative dates are resolved in it
+type LocationProvider interface {
+	AccountLocation() *time.Location
+}
+
+// AccountLocation returns the time zone of the account or the time zone of the device when it is not set
+func AccountLocation(provider LocationProvider) *time.Location {
+	if provider == nil {
+		return time.Local
+	}
+	if loc := provider.AccountLocation(); loc != nil {
+		return loc
+	}
+	return time.Local
+}
+
+// FixedLocation provides the same time zone for any account
+type FixedLocation struct {
+	Location *time.Location
+}
+
+func (l FixedLocation) AccountLocation() *time.Location {
+	return l.Location
+}